@@ -14,6 +14,20 @@ type Collector struct {
 	disk    *DiskCollector
 	network *NetworkCollector
 	traffic *TrafficCollector
+	process *ProcessCollector // Nil unless top processes are enabled
+	custom  CustomMetricSource
+}
+
+// CustomMetric is a gauge reported by a metric plugin
+type CustomMetric struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// CustomMetricSource provides the latest values of the custom metrics
+type CustomMetricSource interface {
+	Snapshot() []CustomMetric
 }
 
 // NewCollector creates a new aggregated collector
@@ -28,6 +42,19 @@ func NewCollector(agentVersion string) *Collector {
 	}
 }
 
+// EnableTopProcesses reports the n processes using the most CPU, memory and
+// disk I/O with each host state
+func (c *Collector) EnableTopProcesses(n int) {
+	if n > 0 {
+		c.process = NewProcessCollector(n)
+	}
+}
+
+// SetCustomMetricSource reports the metrics of src with each host state
+func (c *Collector) SetCustomMetricSource(src CustomMetricSource) {
+	c.custom = src
+}
+
 // CollectHostInfo collects static host information
 func (c *Collector) CollectHostInfo() (*HostInfo, error) {
 	return c.system.CollectHostInfo()
@@ -65,6 +92,9 @@ func (c *Collector) CollectHostState() (*HostState, error) {
 	}
 	state.DiskUsed = diskStats.Used
 	state.DiskUsage = diskStats.Usage
+	if partitions, err := c.disk.CollectAllPartitions(); err == nil {
+		state.Disks = partitions
+	}
 
 	// Network metrics
 	netStats, err := c.network.CollectNetwork()
@@ -75,6 +105,9 @@ func (c *Collector) CollectHostState() (*HostState, error) {
 	state.NetOutTransfer = netStats.NetOutTransfer
 	state.NetInSpeed = netStats.NetInSpeed
 	state.NetOutSpeed = netStats.NetOutSpeed
+	if interfaces, err := c.network.CollectInterfaces(); err == nil {
+		state.Interfaces = interfaces
+	}
 
 	// Connection metrics
 	connStats, err := c.network.CollectConnections()
@@ -87,6 +120,11 @@ func (c *Collector) CollectHostState() (*HostState, error) {
 	processes, err := process.Processes()
 	if err == nil {
 		state.ProcessCount = uint64(len(processes))
+		if c.process != nil {
+			if top, err := c.process.CollectTopProcesses(processes); err == nil {
+				state.TopProcesses = top
+			}
+		}
 	}
 
 	// System uptime
@@ -95,6 +133,11 @@ func (c *Collector) CollectHostState() (*HostState, error) {
 		state.Uptime = uptime
 	}
 
+	// Custom metrics from plugins
+	if c.custom != nil {
+		state.CustomMetrics = c.custom.Snapshot()
+	}
+
 	// Traffic statistics
 	trafficStats, err := c.traffic.CollectTraffic()
 	if err == nil && trafficStats != nil {
@@ -149,4 +192,11 @@ type HostState struct {
 	// Optional
 	Temperatures string  // JSON-encoded temperature sensors
 	GPUUsage     float64 // GPU usage percentage
+
+	// Breakdowns
+	Disks        []*DiskStats      // Usage of each partition
+	Interfaces   []*InterfaceStats // Traffic of each network interface
+	TopProcesses []*ProcessStats   // Processes using the most resources, if enabled
+
+	CustomMetrics []CustomMetric // Latest values of the metric plugins
 }
@@ -48,20 +48,30 @@ func (c *DiskCollector) CollectAllPartitions() ([]*DiskStats, error) {
 	}
 
 	var allStats []*DiskStats
+	seen := make(map[string]bool)
 	for _, partition := range partitions {
+		// Bind mounts report the same device several times
+		if seen[partition.Mountpoint] {
+			continue
+		}
+		seen[partition.Mountpoint] = true
+
 		usage, err := disk.Usage(partition.Mountpoint)
-		if err != nil {
+		if err != nil || usage.Total == 0 {
 			continue // Skip partitions we can't read
 		}
 
 		stats := &DiskStats{
-			MountPoint: partition.Mountpoint,
-			Device:     partition.Device,
-			Fstype:     partition.Fstype,
-			Total:      usage.Total,
-			Used:       usage.Used,
-			Free:       usage.Free,
-			Usage:      usage.UsedPercent,
+			MountPoint:  partition.Mountpoint,
+			Device:      partition.Device,
+			Fstype:      partition.Fstype,
+			Total:       usage.Total,
+			Used:        usage.Used,
+			Free:        usage.Free,
+			Usage:       usage.UsedPercent,
+			InodesTotal: usage.InodesTotal,
+			InodesUsed:  usage.InodesUsed,
+			InodesUsage: usage.InodesUsedPercent,
 		}
 		allStats = append(allStats, stats)
 	}
@@ -78,6 +88,11 @@ type DiskStats struct {
 	Used       uint64  // Used disk space in bytes
 	Free       uint64  // Free disk space in bytes
 	Usage      float64 // Disk usage percentage (0-100)
+
+	// Inodes are not reported on Windows
+	InodesTotal uint64
+	InodesUsed  uint64
+	InodesUsage float64 // Inode usage percentage (0-100)
 }
 
 // getTotalDiskSpace returns total disk space for root partition (helper function)
@@ -1,6 +1,7 @@
 package collector
 
 import (
+	"strings"
 	"sync"
 	"time"
 
@@ -12,12 +13,17 @@ type NetworkCollector struct {
 	mu              sync.Mutex
 	lastIOCounters  []net.IOCountersStat
 	lastCollectTime time.Time
+
+	// Per-interface counters for interface speeds
+	lastNICCounters map[string]net.IOCountersStat
+	lastNICTime     time.Time
 }
 
 // NewNetworkCollector creates a new network collector
 func NewNetworkCollector() *NetworkCollector {
 	return &NetworkCollector{
 		lastCollectTime: time.Now(),
+		lastNICCounters: make(map[string]net.IOCountersStat),
 	}
 }
 
@@ -64,6 +70,59 @@ func (c *NetworkCollector) CollectNetwork() (*NetworkStats, error) {
 	return stats, nil
 }
 
+// CollectInterfaces returns statistics for each network interface, skipping
+// loopback and interfaces that never carried traffic
+func (c *NetworkCollector) CollectInterfaces() ([]*InterfaceStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counters, err := net.IOCounters(true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	timeDiff := now.Sub(c.lastNICTime).Seconds()
+	current := make(map[string]net.IOCountersStat, len(counters))
+
+	var allStats []*InterfaceStats
+	for _, counter := range counters {
+		current[counter.Name] = counter
+		if isLoopbackInterface(counter.Name) || (counter.BytesRecv == 0 && counter.BytesSent == 0) {
+			continue
+		}
+
+		stats := &InterfaceStats{
+			Name:     counter.Name,
+			RxBytes:  counter.BytesRecv,
+			TxBytes:  counter.BytesSent,
+			RxErrors: counter.Errin,
+			TxErrors: counter.Errout,
+		}
+
+		// Counters reset when an interface is recreated
+		if last, ok := c.lastNICCounters[counter.Name]; ok && timeDiff > 0 {
+			if counter.BytesRecv >= last.BytesRecv {
+				stats.RxSpeed = uint64(float64(counter.BytesRecv-last.BytesRecv) / timeDiff)
+			}
+			if counter.BytesSent >= last.BytesSent {
+				stats.TxSpeed = uint64(float64(counter.BytesSent-last.BytesSent) / timeDiff)
+			}
+		}
+		allStats = append(allStats, stats)
+	}
+
+	c.lastNICCounters = current
+	c.lastNICTime = now
+
+	return allStats, nil
+}
+
+// isLoopbackInterface reports whether name is a loopback interface
+func isLoopbackInterface(name string) bool {
+	return name == "lo" || strings.HasPrefix(name, "lo0") || strings.HasPrefix(name, "Loopback")
+}
+
 // CollectConnections returns TCP/UDP connection counts
 func (c *NetworkCollector) CollectConnections() (*ConnectionStats, error) {
 	stats := &ConnectionStats{}
@@ -91,6 +150,17 @@ type NetworkStats struct {
 	NetOutSpeed    uint64 // Send speed in bytes/sec
 }
 
+// InterfaceStats represents statistics of a single network interface
+type InterfaceStats struct {
+	Name     string
+	RxBytes  uint64 // Total bytes received
+	TxBytes  uint64 // Total bytes sent
+	RxSpeed  uint64 // Receive speed in bytes/sec
+	TxSpeed  uint64 // Send speed in bytes/sec
+	RxErrors uint64
+	TxErrors uint64
+}
+
 // ConnectionStats represents connection statistics
 type ConnectionStats struct {
 	TCPCount uint64 // Number of TCP connections
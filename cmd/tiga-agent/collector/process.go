@@ -0,0 +1,159 @@
+package collector
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// ProcessCollector ranks processes by resource usage
+type ProcessCollector struct {
+	mu       sync.Mutex
+	topN     int
+	last     map[int32]processSample
+	lastTime time.Time
+}
+
+// processSample holds the cumulative counters of a process at the last collection
+type processSample struct {
+	createTime int64 // Detects PID reuse
+	cpuTime    float64
+	ioRead     uint64
+	ioWrite    uint64
+}
+
+// NewProcessCollector creates a collector reporting up to topN processes per resource
+func NewProcessCollector(topN int) *ProcessCollector {
+	return &ProcessCollector{
+		topN: topN,
+		last: make(map[int32]processSample),
+	}
+}
+
+// CollectTopProcesses returns the topN processes with the highest CPU,
+// memory and disk I/O usage. CPU and I/O rates are measured since the
+// previous call, so the first call only ranks by memory.
+func (c *ProcessCollector) CollectTopProcesses(processes []*process.Process) ([]*ProcessStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var totalMem uint64
+	if vm, err := mem.VirtualMemory(); err == nil {
+		totalMem = vm.Total
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(c.lastTime).Seconds()
+	current := make(map[int32]processSample, len(processes))
+	candidates := make(map[*ProcessStats]*process.Process, len(processes))
+
+	var allStats []*ProcessStats
+	for _, p := range processes {
+		sample := processSample{}
+		sample.createTime, _ = p.CreateTime()
+		if times, err := p.Times(); err == nil {
+			sample.cpuTime = times.User + times.System
+		}
+		if io, err := p.IOCounters(); err == nil {
+			sample.ioRead = io.ReadBytes
+			sample.ioWrite = io.WriteBytes
+		}
+		current[p.Pid] = sample
+
+		stats := &ProcessStats{PID: p.Pid}
+		if memInfo, err := p.MemoryInfo(); err == nil {
+			stats.MemRSS = memInfo.RSS
+			if totalMem > 0 {
+				stats.MemUsage = float64(memInfo.RSS) / float64(totalMem) * 100
+			}
+		}
+		if last, ok := c.last[p.Pid]; ok && last.createTime == sample.createTime && elapsed > 0 {
+			if sample.cpuTime >= last.cpuTime {
+				stats.CPUUsage = (sample.cpuTime - last.cpuTime) / elapsed * 100
+			}
+			if sample.ioRead >= last.ioRead {
+				stats.IOReadSpeed = uint64(float64(sample.ioRead-last.ioRead) / elapsed)
+			}
+			if sample.ioWrite >= last.ioWrite {
+				stats.IOWriteSpeed = uint64(float64(sample.ioWrite-last.ioWrite) / elapsed)
+			}
+		}
+
+		allStats = append(allStats, stats)
+		candidates[stats] = p
+	}
+
+	c.last = current
+	c.lastTime = now
+
+	// Names and users are only looked up for the processes reported
+	top := selectTopProcesses(allStats, c.topN)
+	for _, stats := range top {
+		p := candidates[stats]
+		stats.Name, _ = p.Name()
+		stats.User, _ = p.Username()
+	}
+
+	return top, nil
+}
+
+// selectTopProcesses returns the union of the n processes using the most
+// CPU, memory and disk I/O, ordered by CPU usage
+func selectTopProcesses(all []*ProcessStats, n int) []*ProcessStats {
+	if n <= 0 || len(all) == 0 {
+		return nil
+	}
+
+	selected := make(map[*ProcessStats]bool)
+	pick := func(less func(a, b *ProcessStats) bool, used func(s *ProcessStats) bool) {
+		ranked := make([]*ProcessStats, 0, len(all))
+		for _, s := range all {
+			if used(s) {
+				ranked = append(ranked, s)
+			}
+		}
+		sort.SliceStable(ranked, func(i, j int) bool { return less(ranked[i], ranked[j]) })
+		if len(ranked) > n {
+			ranked = ranked[:n]
+		}
+		for _, s := range ranked {
+			selected[s] = true
+		}
+	}
+
+	pick(func(a, b *ProcessStats) bool { return a.CPUUsage > b.CPUUsage },
+		func(s *ProcessStats) bool { return s.CPUUsage > 0 })
+	pick(func(a, b *ProcessStats) bool { return a.MemRSS > b.MemRSS },
+		func(s *ProcessStats) bool { return s.MemRSS > 0 })
+	pick(func(a, b *ProcessStats) bool { return a.IOReadSpeed+a.IOWriteSpeed > b.IOReadSpeed+b.IOWriteSpeed },
+		func(s *ProcessStats) bool { return s.IOReadSpeed+s.IOWriteSpeed > 0 })
+
+	top := make([]*ProcessStats, 0, len(selected))
+	for _, s := range all {
+		if selected[s] {
+			top = append(top, s)
+		}
+	}
+	sort.SliceStable(top, func(i, j int) bool {
+		if top[i].CPUUsage != top[j].CPUUsage {
+			return top[i].CPUUsage > top[j].CPUUsage
+		}
+		return top[i].MemRSS > top[j].MemRSS
+	})
+	return top
+}
+
+// ProcessStats represents the resource usage of a single process
+type ProcessStats struct {
+	PID          int32
+	Name         string
+	User         string
+	CPUUsage     float64 // CPU usage percentage, above 100 on several cores
+	MemUsage     float64 // Memory usage percentage (0-100)
+	MemRSS       uint64  // Resident memory in bytes
+	IOReadSpeed  uint64  // Disk read speed in bytes/sec
+	IOWriteSpeed uint64  // Disk write speed in bytes/sec
+}
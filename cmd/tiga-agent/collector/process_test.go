@@ -0,0 +1,33 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectTopProcesses(t *testing.T) {
+	all := []*ProcessStats{
+		{PID: 1, CPUUsage: 1, MemRSS: 100},
+		{PID: 2, CPUUsage: 90, MemRSS: 10},
+		{PID: 3, CPUUsage: 0, MemRSS: 5000},
+		{PID: 4, CPUUsage: 50, MemRSS: 20},
+		{PID: 5, CPUUsage: 0, MemRSS: 1, IOWriteSpeed: 1 << 20},
+		{PID: 6, CPUUsage: 0, MemRSS: 2},
+	}
+
+	pids := func(stats []*ProcessStats) []int32 {
+		var result []int32
+		for _, s := range stats {
+			result = append(result, s.PID)
+		}
+		return result
+	}
+
+	// Top CPU: 2, 4. Top memory: 3, 1. Top I/O: 5. Idle processes are not
+	// ranked by CPU or I/O.
+	assert.Equal(t, []int32{2, 4, 1, 3, 5}, pids(selectTopProcesses(all, 2)))
+	assert.Equal(t, []int32{2, 3, 5}, pids(selectTopProcesses(all, 1)))
+	assert.Empty(t, selectTopProcesses(all, 0))
+	assert.Empty(t, selectTopProcesses(nil, 5))
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"syscall"
@@ -15,10 +16,14 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/ysicing/tiga/cmd/tiga-agent/collector"
+	"github.com/ysicing/tiga/cmd/tiga-agent/plugin"
+	"github.com/ysicing/tiga/internal/docker"
 	"github.com/ysicing/tiga/internal/version"
 	"github.com/ysicing/tiga/proto"
 
@@ -30,9 +35,27 @@ type Config struct {
 	UUID                string
 	SecretKey           string
 	LogLevel            string
-	ReportInterval      int  // Report interval in seconds
-	DisableWebSSH       bool // Disable WebSSH terminal functionality
-	DisableDockerReport bool // Disable Docker instance reporting
+	ReportInterval      int    // Report interval in seconds
+	DisableWebSSH       bool   // Disable WebSSH terminal functionality
+	DisableDockerReport bool   // Disable Docker instance reporting
+	TopProcesses        int    // Processes reported per resource (0 disables)
+	PluginsConfig       string // YAML file configuring custom metric plugins
+
+	// Transport security
+	TLS                bool   // Connect over TLS
+	CAFile             string // CA bundle to verify the server certificate
+	ServerPin          string // sha256:<hex> pin of the server or CA public key
+	ServerName         string // Override the name used to verify the server certificate
+	CertDir            string // Directory holding the enrolled client certificate
+	InsecureSkipVerify bool   // Skip server certificate verification
+
+	// Self-update
+	UpdatePublicKey     string // Base64 Ed25519 key that signs agent binaries
+	AllowUnsignedUpdate bool   // Install agent binaries without a valid signature
+
+	// Offline buffering
+	StateBufferDir  string // Directory buffering host states while disconnected
+	StateBufferSize int    // Maximum number of buffered host states (0 disables)
 }
 
 func main() {
@@ -56,16 +79,48 @@ func main() {
 	}).Info("Starting Tiga Agent")
 	logrus.Infof("Connecting to server: %s", config.ServerAddr)
 
+	updater, err := newUpdater(config)
+	if err != nil {
+		logrus.Fatalf("Failed to initialize self-update: %v", err)
+	}
+	updater.CheckPendingUpgrade()
+
+	var stateBuffer *StateBuffer
+	if config.StateBufferSize > 0 {
+		stateBuffer, err = NewStateBuffer(config.StateBufferDir, config.StateBufferSize)
+		if err != nil {
+			logrus.Warnf("Failed to open state buffer, states collected while disconnected will be lost: %v", err)
+			stateBuffer = nil
+		} else if n := stateBuffer.Len(); n > 0 {
+			logrus.Infof("Found %d buffered states to replay", n)
+		}
+	}
+
 	// Create context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// The collector tracks traffic deltas, so it lives across reconnects
+	col := collector.NewCollector(version.Version)
+	col.EnableTopProcesses(config.TopProcesses)
+
+	if config.PluginsConfig != "" {
+		plugins, err := plugin.LoadConfig(config.PluginsConfig)
+		if err != nil {
+			logrus.Fatalf("Failed to load metric plugins: %v", err)
+		}
+		pluginManager := plugin.NewManager(plugins)
+		pluginManager.Start(ctx)
+		defer pluginManager.Stop()
+		col.SetCustomMetricSource(pluginManager)
+	}
+
 	// Setup signal handling
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 
 	// Start agent with reconnection loop
-	go runAgentWithReconnect(ctx, config)
+	go runAgentWithReconnect(ctx, config, col, updater, stateBuffer)
 
 	// Wait for shutdown signal
 	<-sigCh
@@ -78,11 +133,13 @@ func main() {
 }
 
 // runAgentWithReconnect runs the agent with automatic reconnection
-func runAgentWithReconnect(ctx context.Context, config *Config) {
+func runAgentWithReconnect(ctx context.Context, config *Config, col *collector.Collector, updater *Updater, stateBuffer *StateBuffer) {
 	retryDelay := 5 * time.Second
 	maxRetryDelay := 5 * time.Minute
 	backoffFactor := 2.0
 
+	interval := time.Duration(config.ReportInterval) * time.Second
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -90,6 +147,9 @@ func runAgentWithReconnect(ctx context.Context, config *Config) {
 		default:
 		}
 
+		// Buffer states on disk until the agent is connected again
+		stopSampling := startStateSampler(ctx, col, stateBuffer, interval)
+
 		// Connect to gRPC server
 		conn, err := connectToServer(ctx, config)
 		if err != nil {
@@ -98,6 +158,7 @@ func runAgentWithReconnect(ctx context.Context, config *Config) {
 
 			select {
 			case <-ctx.Done():
+				stopSampling()
 				return
 			case <-time.After(retryDelay):
 				// Exponential backoff
@@ -105,6 +166,7 @@ func runAgentWithReconnect(ctx context.Context, config *Config) {
 				if retryDelay > maxRetryDelay {
 					retryDelay = maxRetryDelay
 				}
+				stopSampling()
 				continue
 			}
 		}
@@ -115,33 +177,42 @@ func runAgentWithReconnect(ctx context.Context, config *Config) {
 		// Create gRPC client
 		client := proto.NewHostMonitorClient(conn)
 
-		// Initialize collector
-		col := collector.NewCollector(version.Version)
-
 		// Register agent and get host info
 		if err := registerAgent(ctx, client, config, col); err != nil {
 			logrus.Errorf("Failed to register agent: %v", err)
 			conn.Close()
+			if status.Code(err) == codes.Unauthenticated && useTLS(config) {
+				resetClientCertificate(config)
+			}
 
 			logrus.Infof("Retrying in %v...", retryDelay)
 			select {
 			case <-ctx.Done():
+				stopSampling()
 				return
 			case <-time.After(retryDelay):
 				retryDelay = time.Duration(float64(retryDelay) * backoffFactor)
 				if retryDelay > maxRetryDelay {
 					retryDelay = maxRetryDelay
 				}
+				stopSampling()
 				continue
 			}
 		}
 
+		// The server accepted this binary, a pending upgrade is done
+		updater.ConfirmUpgrade()
+
+		// Connected, the reporting loop takes over the collector
+		stopSampling()
+
 		// Start reporting loop
-		runReportingLoop(ctx, client, col, config)
+		runReportingLoop(ctx, client, col, config, updater, stateBuffer)
 
 		// If we reach here, the reporting loop ended (connection lost)
 		conn.Close()
 		logrus.Warn("Connection lost, reconnecting...")
+		stopSampling = startStateSampler(ctx, col, stateBuffer, interval)
 
 		select {
 		case <-ctx.Done():
@@ -153,6 +224,7 @@ func runAgentWithReconnect(ctx context.Context, config *Config) {
 				retryDelay = maxRetryDelay
 			}
 		}
+		stopSampling()
 	}
 }
 
@@ -165,6 +237,8 @@ func parseFlags() *Config {
 	flag.StringVar(&config.LogLevel, "log-level", "info", "Log level (debug, info, warn, error)")
 	flag.IntVar(&config.ReportInterval, "interval", 10, "Report interval in seconds (default: 10)")
 	flag.BoolVar(&config.DisableWebSSH, "disable-webssh", false, "Disable WebSSH terminal functionality")
+	flag.StringVar(&config.PluginsConfig, "plugins-config", "", "YAML file configuring custom metric plugins (exec scripts or Prometheus endpoints)")
+	flag.IntVar(&config.TopProcesses, "top-processes", 0, "Report the N processes using the most CPU, memory and disk I/O (0 disables)")
 
 	// Set platform-specific default for Docker reporting
 	// Windows: disabled by default (Docker Desktop runs in WSL2/VM, not accessible from Windows host)
@@ -172,6 +246,17 @@ func parseFlags() *Config {
 	defaultDisableDockerReport := (runtime.GOOS == "windows")
 	flag.BoolVar(&config.DisableDockerReport, "disable-docker-report", defaultDisableDockerReport, "Disable Docker instance reporting (default: true on Windows, false on Linux/macOS)")
 
+	flag.BoolVar(&config.TLS, "tls", false, "Connect over TLS (implied by --ca-file, --server-pin and ports 443/8443)")
+	flag.StringVar(&config.CAFile, "ca-file", "", "CA bundle used to verify the server certificate")
+	flag.StringVar(&config.ServerPin, "server-pin", "", "Pin of the server certificate or CA public key (sha256:<hex>)")
+	flag.StringVar(&config.ServerName, "server-name", "", "Server name used for certificate verification (default: host of --server)")
+	flag.StringVar(&config.CertDir, "cert-dir", defaultCertDir(), "Directory for the enrolled client certificate")
+	flag.BoolVar(&config.InsecureSkipVerify, "insecure-skip-verify", false, "Skip server certificate verification (not recommended)")
+	flag.StringVar(&config.UpdatePublicKey, "update-public-key", "", "Base64 Ed25519 public key that verifies self-update binaries (default: built-in key)")
+	flag.StringVar(&config.StateBufferDir, "state-buffer-dir", filepath.Join(filepath.Dir(defaultCertDir()), "state-buffer"), "Directory buffering host states while disconnected")
+	flag.IntVar(&config.StateBufferSize, "state-buffer-size", 8640, "Maximum number of host states buffered while disconnected, 0 disables (default: 24h at 10s)")
+	flag.BoolVar(&config.AllowUnsignedUpdate, "allow-unsigned-update", false, "Allow self-update with unsigned binaries (not recommended)")
+
 	showVersion := flag.Bool("version", false, "Show version information")
 	flag.Parse()
 
@@ -228,29 +313,35 @@ func setupLogger(level string) {
 }
 
 func connectToServer(ctx context.Context, config *Config) (*grpc.ClientConn, error) {
-	// Auto-detect TLS based on port
-	useTLS := shouldUseTLS(config.ServerAddr)
-
-	var dialOpts []grpc.DialOption
+	if !useTLS(config) {
+		logrus.Info("Using insecure connection (TLS not enabled)")
+		return dialServer(ctx, config, nil)
+	}
 
-	if useTLS {
-		// Use TLS credentials (skip server certificate verification for self-signed certs)
-		tlsConfig := &tls.Config{
-			InsecureSkipVerify: true, // Allow self-signed certificates
-		}
-		creds := credentials.NewTLS(tlsConfig)
-		dialOpts = []grpc.DialOption{
-			grpc.WithTransportCredentials(creds),
-			grpc.WithBlock(), // Block until connection established or timeout
-		}
-		logrus.Infof("Using TLS connection (detected port 443/8443)")
+	clientCert := ensureClientCertificate(ctx, config)
+	if clientCert != nil {
+		logrus.Info("Using TLS connection with client certificate")
 	} else {
-		// Use insecure connection for development
-		dialOpts = []grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithBlock(), // Block until connection established or timeout
+		logrus.Info("Using TLS connection")
+	}
+	return dialServer(ctx, config, clientCert)
+}
+
+// dialServer opens a connection to the server, over TLS when enabled.
+// clientCert is presented for mTLS when not nil.
+func dialServer(ctx context.Context, config *Config, clientCert *tls.Certificate) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if useTLS(config) {
+		tlsConfig, err := buildTLSConfig(config, clientCert)
+		if err != nil {
+			return nil, err
 		}
-		logrus.Info("Using insecure connection (non-TLS port)")
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithBlock(), // Block until connection established or timeout
 	}
 
 	// Use shorter timeout for reconnection attempts
@@ -370,7 +461,7 @@ func registerAgent(ctx context.Context, client proto.HostMonitorClient, config *
 }
 
 // runReportingLoop continuously collects and reports host state
-func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *collector.Collector, config *Config) {
+func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *collector.Collector, config *Config, updater *Updater, stateBuffer *StateBuffer) {
 	// Create and start probe handler for batch reporting
 	probeHandler := NewProbeTaskHandler(config.UUID, client)
 	probeHandler.Start()
@@ -378,7 +469,7 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 
 	// Initialize Docker task handler if Docker is available and reporting is enabled
 	var dockerHandler *DockerTaskHandler
-	var dockerStreamHandler *DockerStreamHandler
+	var dockerStreamHandler *docker.DockerStreamHandler
 
 	if shouldReportDocker(config) {
 		if dockerInfo := col.CollectDockerInfo(); dockerInfo.Installed {
@@ -396,7 +487,7 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 				}).Info("Docker task handler initialized successfully")
 
 				// Create Docker stream handler using the same Docker client
-				dockerStreamHandler = NewDockerStreamHandler(dockerHandler.dockerClient)
+				dockerStreamHandler = docker.NewDockerStreamHandler(dockerHandler.dockerClient)
 				logrus.Info("Docker stream handler initialized successfully")
 			}
 		} else {
@@ -419,6 +510,13 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 	// Channel for task results
 	taskResults := make(chan *proto.TaskResult, 100)
 
+	// Responses acknowledge requests in order, the replay of buffered states
+	// waits for them before discarding anything
+	replay := newStateReplay(stateBuffer)
+	acks := make(chan bool, 16)
+	done := make(chan struct{})
+	defer close(done)
+
 	// Start receiving responses in a separate goroutine
 	go func() {
 		for {
@@ -436,10 +534,15 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 			if !resp.Success {
 				logrus.Warnf("Server response: %s", resp.Message)
 			}
+			select {
+			case acks <- resp.Success:
+			case <-done:
+				return
+			}
 
 			// Handle tasks from server
 			for _, task := range resp.Tasks {
-				go handleTask(client, probeHandler, dockerHandler, dockerStreamHandler, taskResults, task, config)
+				go handleTask(client, probeHandler, dockerHandler, dockerStreamHandler, updater, taskResults, task, config)
 			}
 		}
 	}()
@@ -449,7 +552,7 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 	defer ticker.Stop()
 
 	// Report immediately on start
-	if err := reportState(stream, col, config, nil); err != nil {
+	if err := reportState(stream, col, config, nil, replay); err != nil {
 		logrus.Errorf("Failed to send initial state: %v", err)
 		return
 	}
@@ -463,6 +566,15 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 			logrus.Warnf("Stream error detected: %v, will reconnect", err)
 			stream.CloseSend()
 			return
+		case success := <-acks:
+			// Keep replaying without waiting for the next report
+			if replay.ack(success) {
+				if err := replayStates(stream, config, replay); err != nil {
+					logrus.Errorf("Failed to replay buffered states: %v, will reconnect", err)
+					stream.CloseSend()
+					return
+				}
+			}
 		case <-ticker.C:
 			// Collect any pending task results
 			var results []*proto.TaskResult
@@ -475,7 +587,7 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 				}
 			}
 		sendState:
-			if err := reportState(stream, col, config, results); err != nil {
+			if err := reportState(stream, col, config, results, replay); err != nil {
 				logrus.Errorf("Failed to send state: %v, will reconnect", err)
 				stream.CloseSend()
 				return
@@ -484,15 +596,65 @@ func runReportingLoop(ctx context.Context, client proto.HostMonitorClient, col *
 	}
 }
 
-// reportState collects current state and sends it to server
-func reportState(stream proto.HostMonitor_ReportStateClient, col *collector.Collector, config *Config, taskResults []*proto.TaskResult) error {
+// reportState collects current state and sends it to server along with the
+// next batch of buffered states
+func reportState(stream proto.HostMonitor_ReportStateClient, col *collector.Collector, config *Config, taskResults []*proto.TaskResult, replay *stateReplay) error {
+	protoState, err := collectProtoState(col)
+	if err != nil {
+		return err
+	}
+	backfill := replay.next()
+
+	// Send to server with task results
+	req := &proto.ReportStateRequest{
+		Uuid:        config.UUID,
+		State:       protoState,
+		TaskResults: taskResults,
+		Backfill:    backfill,
+	}
+
+	if err := stream.Send(req); err != nil {
+		return fmt.Errorf("failed to send state: %w", err)
+	}
+	replay.sent(len(backfill))
+
+	logrus.Debugf("Reported state: CPU=%.2f%%, Mem=%.2f%%, Disk=%.2f%%, Traffic=%d/%d bytes",
+		protoState.CpuUsage, protoState.MemUsage, protoState.DiskUsage,
+		protoState.TrafficDeltaSent, protoState.TrafficDeltaRecv)
+
+	if len(taskResults) > 0 {
+		logrus.Debugf("Sent %d task results with state report", len(taskResults))
+	}
+
+	return nil
+}
+
+// replayStates sends the next batch of buffered states on its own
+func replayStates(stream proto.HostMonitor_ReportStateClient, config *Config, replay *stateReplay) error {
+	backfill := replay.next()
+	if len(backfill) == 0 {
+		return nil
+	}
+
+	req := &proto.ReportStateRequest{
+		Uuid:     config.UUID,
+		Backfill: backfill,
+	}
+	if err := stream.Send(req); err != nil {
+		return fmt.Errorf("failed to send buffered states: %w", err)
+	}
+	replay.sent(len(backfill))
+	return nil
+}
+
+// collectProtoState collects the current host state in its wire format
+func collectProtoState(col *collector.Collector) (*proto.HostState, error) {
 	state, err := col.CollectHostState()
 	if err != nil {
-		return fmt.Errorf("failed to collect host state: %w", err)
+		return nil, fmt.Errorf("failed to collect host state: %w", err)
 	}
 
-	// Convert to proto
-	protoState := &proto.HostState{
+	return &proto.HostState{
 		Timestamp:        time.Now().UnixMilli(),
 		CpuUsage:         state.CPUUsage,
 		Load_1:           state.Load1,
@@ -521,32 +683,78 @@ func reportState(stream proto.HostMonitor_ReportStateClient, col *collector.Coll
 			BuildTime: version.BuildTime,
 			CommitId:  version.CommitID,
 		},
-	}
-
-	// Send to server with task results
-	req := &proto.ReportStateRequest{
-		Uuid:        config.UUID,
-		State:       protoState,
-		TaskResults: taskResults,
-	}
+		Disks:         diskPartitionsToProto(state.Disks),
+		NetInterfaces: netInterfacesToProto(state.Interfaces),
+		TopProcesses:  processesToProto(state.TopProcesses),
+		CustomMetrics: customMetricsToProto(state.CustomMetrics),
+	}, nil
+}
 
-	if err := stream.Send(req); err != nil {
-		return fmt.Errorf("failed to send state: %w", err)
+func customMetricsToProto(metrics []collector.CustomMetric) []*proto.CustomMetric {
+	result := make([]*proto.CustomMetric, 0, len(metrics))
+	for _, m := range metrics {
+		result = append(result, &proto.CustomMetric{
+			Name:   m.Name,
+			Labels: m.Labels,
+			Value:  m.Value,
+		})
 	}
+	return result
+}
 
-	logrus.Debugf("Reported state: CPU=%.2f%%, Mem=%.2f%%, Disk=%.2f%%, Traffic=%d/%d bytes",
-		state.CPUUsage, state.MemUsage, state.DiskUsage,
-		state.TrafficDeltaSent, state.TrafficDeltaRecv)
+func diskPartitionsToProto(disks []*collector.DiskStats) []*proto.DiskPartitionState {
+	result := make([]*proto.DiskPartitionState, 0, len(disks))
+	for _, d := range disks {
+		result = append(result, &proto.DiskPartitionState{
+			MountPoint:  d.MountPoint,
+			Device:      d.Device,
+			Fstype:      d.Fstype,
+			Total:       d.Total,
+			Used:        d.Used,
+			Usage:       d.Usage,
+			InodesTotal: d.InodesTotal,
+			InodesUsed:  d.InodesUsed,
+			InodesUsage: d.InodesUsage,
+		})
+	}
+	return result
+}
 
-	if len(taskResults) > 0 {
-		logrus.Debugf("Sent %d task results with state report", len(taskResults))
-	}
+func netInterfacesToProto(interfaces []*collector.InterfaceStats) []*proto.NetInterfaceState {
+	result := make([]*proto.NetInterfaceState, 0, len(interfaces))
+	for _, nic := range interfaces {
+		result = append(result, &proto.NetInterfaceState{
+			Name:     nic.Name,
+			RxBytes:  nic.RxBytes,
+			TxBytes:  nic.TxBytes,
+			RxSpeed:  nic.RxSpeed,
+			TxSpeed:  nic.TxSpeed,
+			RxErrors: nic.RxErrors,
+			TxErrors: nic.TxErrors,
+		})
+	}
+	return result
+}
 
-	return nil
+func processesToProto(processes []*collector.ProcessStats) []*proto.ProcessState {
+	result := make([]*proto.ProcessState, 0, len(processes))
+	for _, p := range processes {
+		result = append(result, &proto.ProcessState{
+			Pid:          p.PID,
+			Name:         p.Name,
+			User:         p.User,
+			CpuUsage:     p.CPUUsage,
+			MemUsage:     p.MemUsage,
+			MemRss:       p.MemRSS,
+			IoReadSpeed:  p.IOReadSpeed,
+			IoWriteSpeed: p.IOWriteSpeed,
+		})
+	}
+	return result
 }
 
 // handleTask processes tasks sent by the server
-func handleTask(client proto.HostMonitorClient, probeHandler *ProbeTaskHandler, dockerHandler *DockerTaskHandler, dockerStreamHandler *DockerStreamHandler, taskResults chan<- *proto.TaskResult, task *proto.AgentTask, config *Config) {
+func handleTask(client proto.HostMonitorClient, probeHandler *ProbeTaskHandler, dockerHandler *DockerTaskHandler, dockerStreamHandler *docker.DockerStreamHandler, updater *Updater, taskResults chan<- *proto.TaskResult, task *proto.AgentTask, config *Config) {
 	logrus.Infof("[Task] Received task: id=%s type=%s", task.TaskId, task.TaskType)
 	logrus.Debugf("[Task] Task params: %+v", task.Params)
 
@@ -598,7 +806,7 @@ func handleTask(client proto.HostMonitorClient, probeHandler *ProbeTaskHandler,
 
 			// Successfully initialized, update the handler
 			dockerHandler = handler
-			dockerStreamHandler = NewDockerStreamHandler(dockerHandler.dockerClient)
+			dockerStreamHandler = docker.NewDockerStreamHandler(dockerHandler.dockerClient)
 			logrus.Info("[Task:Docker] Docker handler lazy initialization successful")
 		}
 
@@ -621,6 +829,20 @@ func handleTask(client proto.HostMonitorClient, probeHandler *ProbeTaskHandler,
 		// Initiate DockerStream connection
 		go handleDockerStreamSession(client, dockerStreamHandler, task)
 
+	case "upgrade":
+		// Self-update; on success the process restarts and never returns here
+		targetVersion := task.Params["version"]
+		logrus.Infof("[Task:Upgrade] Upgrading agent from %s to %s", version.Version, targetVersion)
+		if err := updater.Upgrade(client, config.UUID, targetVersion); err != nil {
+			logrus.Errorf("[Task:Upgrade] Upgrade failed: %v", err)
+			taskResults <- &proto.TaskResult{
+				TaskId:    task.TaskId,
+				Success:   false,
+				Error:     err.Error(),
+				Timestamp: time.Now().UnixMilli(),
+			}
+		}
+
 	case "command":
 		// TODO: Handle command execution tasks
 		logrus.Warnf("[Task:Command] Command tasks not yet implemented")
@@ -631,7 +853,7 @@ func handleTask(client proto.HostMonitorClient, probeHandler *ProbeTaskHandler,
 }
 
 // handleDockerStreamSession initiates and handles a Docker stream connection
-func handleDockerStreamSession(client proto.HostMonitorClient, dockerStreamHandler *DockerStreamHandler, task *proto.AgentTask) {
+func handleDockerStreamSession(client proto.HostMonitorClient, dockerStreamHandler *docker.DockerStreamHandler, task *proto.AgentTask) {
 	logrus.Info("[DockerStream] Initiating DockerStream connection to server")
 
 	// Extract session_id from task params
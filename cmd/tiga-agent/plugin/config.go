@@ -0,0 +1,134 @@
+package plugin
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PluginType is the way a plugin produces metrics
+type PluginType string
+
+const (
+	// PluginTypeExec runs a command printing metrics in the Prometheus text format
+	PluginTypeExec PluginType = "exec"
+	// PluginTypePrometheus scrapes a Prometheus /metrics endpoint
+	PluginTypePrometheus PluginType = "prometheus"
+)
+
+const (
+	defaultInterval = 60 * time.Second
+	minInterval     = 5 * time.Second
+	defaultTimeout  = 10 * time.Second
+)
+
+// Config configures a metric plugin
+//
+// Example:
+//
+//	plugins:
+//	  - name: redis
+//	    type: exec
+//	    command: /usr/local/bin/redis-metrics.sh
+//	    interval: 30s
+//	  - name: app
+//	    type: prometheus
+//	    url: http://127.0.0.1:9100/metrics
+//	    allow: ["http_requests_total", "queue_*"]
+type Config struct {
+	Name     string            `yaml:"name"`
+	Type     PluginType        `yaml:"type"`
+	Command  string            `yaml:"command"`  // Exec only
+	Args     []string          `yaml:"args"`     // Exec only
+	URL      string            `yaml:"url"`      // Prometheus only
+	Interval time.Duration     `yaml:"interval"` // Default 60s, at least 5s
+	Timeout  time.Duration     `yaml:"timeout"`  // Default 10s, at most the interval
+	Allow    []string          `yaml:"allow"`    // Metric name patterns (path.Match), empty allows all
+	Labels   map[string]string `yaml:"labels"`   // Labels added to every metric
+}
+
+// LoadConfig reads the plugin configuration file
+func LoadConfig(file string) ([]Config, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin config: %w", err)
+	}
+
+	var parsed struct {
+		Plugins []Config `yaml:"plugins"`
+	}
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse plugin config: %w", err)
+	}
+
+	names := make(map[string]bool)
+	for i := range parsed.Plugins {
+		cfg := &parsed.Plugins[i]
+		if err := cfg.validate(); err != nil {
+			return nil, fmt.Errorf("plugin %d: %w", i+1, err)
+		}
+		if names[cfg.Name] {
+			return nil, fmt.Errorf("plugin %d: duplicate name %q", i+1, cfg.Name)
+		}
+		names[cfg.Name] = true
+	}
+	return parsed.Plugins, nil
+}
+
+// validate checks the configuration and applies defaults
+func (c *Config) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	switch c.Type {
+	case PluginTypeExec:
+		if c.Command == "" {
+			return fmt.Errorf("command is required for exec plugins")
+		}
+	case PluginTypePrometheus:
+		u, err := url.Parse(c.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid url %q", c.URL)
+		}
+	default:
+		return fmt.Errorf("unknown type %q (exec or prometheus)", c.Type)
+	}
+
+	for _, pattern := range c.Allow {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid allow pattern %q", pattern)
+		}
+	}
+
+	if c.Interval == 0 {
+		c.Interval = defaultInterval
+	}
+	if c.Interval < minInterval {
+		return fmt.Errorf("interval must be at least %s", minInterval)
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.Timeout > c.Interval {
+		c.Timeout = c.Interval
+	}
+	return nil
+}
+
+// allowed reports whether a metric name passes the allow-list
+func (c *Config) allowed(name string) bool {
+	if len(c.Allow) == 0 {
+		return true
+	}
+	for _, pattern := range c.Allow {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,245 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/cmd/tiga-agent/collector"
+)
+
+const (
+	// maxSeriesPerPlugin bounds the metrics a single plugin can report
+	maxSeriesPerPlugin = 500
+	// maxOutputSize bounds the output read from a plugin
+	maxOutputSize = 10 << 20
+	// pluginLabel names the plugin that reported a metric
+	pluginLabel = "plugin"
+)
+
+// Manager runs the configured plugins and keeps their latest metrics
+type Manager struct {
+	plugins []*plugin
+	client  *http.Client
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+type plugin struct {
+	config Config
+
+	mu      sync.RWMutex
+	metrics []collector.CustomMetric
+}
+
+// NewManager creates a manager for the given plugins
+func NewManager(configs []Config) *Manager {
+	m := &Manager{client: &http.Client{}}
+	for _, cfg := range configs {
+		m.plugins = append(m.plugins, &plugin{config: cfg})
+	}
+	return m
+}
+
+// Start runs every plugin at its interval until Stop is called
+func (m *Manager) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+	for _, p := range m.plugins {
+		m.wg.Add(1)
+		go m.run(ctx, p)
+	}
+	logrus.Infof("[Plugin] Started %d metric plugins", len(m.plugins))
+}
+
+// Stop stops the plugins and waits for them to exit
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+}
+
+// Snapshot returns the latest metrics of all plugins
+func (m *Manager) Snapshot() []collector.CustomMetric {
+	var metrics []collector.CustomMetric
+	for _, p := range m.plugins {
+		p.mu.RLock()
+		metrics = append(metrics, p.metrics...)
+		p.mu.RUnlock()
+	}
+	return metrics
+}
+
+func (m *Manager) run(ctx context.Context, p *plugin) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		metrics, err := m.collect(ctx, &p.config)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// Stale values would hide the failure, drop them
+			logrus.Warnf("[Plugin] %s failed: %v", p.config.Name, err)
+			metrics = nil
+		}
+		p.mu.Lock()
+		p.metrics = metrics
+		p.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect runs a plugin once and returns its allowed metrics
+func (m *Manager) collect(ctx context.Context, cfg *Config) ([]collector.CustomMetric, error) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	var output []byte
+	var err error
+	switch cfg.Type {
+	case PluginTypeExec:
+		output, err = runCommand(ctx, cfg)
+	case PluginTypePrometheus:
+		output, err = m.scrape(ctx, cfg)
+	default:
+		err = fmt.Errorf("unknown plugin type %q", cfg.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMetrics(output, cfg)
+}
+
+func runCommand(ctx context.Context, cfg *Config) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, cfg.Command, cfg.Args...)
+	cmd.Stdout = &limitedBuffer{buf: &stdout, remaining: maxOutputSize}
+	cmd.Stderr = &limitedBuffer{buf: &stderr, remaining: 4096}
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+func (m *Manager) scrape(ctx context.Context, cfg *Config) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain;version=0.0.4")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxOutputSize))
+}
+
+// parseMetrics parses output in the Prometheus text format. Counters and
+// untyped samples are reported as they are; summaries and histograms as
+// their _sum and _count.
+func parseMetrics(output []byte, cfg *Config) ([]collector.CustomMetric, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(output))
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics output: %w", err)
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var metrics []collector.CustomMetric
+	add := func(name string, labels []*dto.LabelPair, value float64) {
+		if !cfg.allowed(name) || math.IsNaN(value) || math.IsInf(value, 0) {
+			return
+		}
+		metric := collector.CustomMetric{
+			Name:   name,
+			Labels: make(map[string]string, len(labels)+len(cfg.Labels)+1),
+			Value:  value,
+		}
+		for _, label := range labels {
+			metric.Labels[label.GetName()] = label.GetValue()
+		}
+		for k, v := range cfg.Labels {
+			metric.Labels[k] = v
+		}
+		metric.Labels[pluginLabel] = cfg.Name
+		metrics = append(metrics, metric)
+	}
+
+	for _, name := range names {
+		family := families[name]
+		for _, m := range family.GetMetric() {
+			switch family.GetType() {
+			case dto.MetricType_GAUGE:
+				add(name, m.GetLabel(), m.GetGauge().GetValue())
+			case dto.MetricType_COUNTER:
+				add(name, m.GetLabel(), m.GetCounter().GetValue())
+			case dto.MetricType_SUMMARY:
+				add(name+"_sum", m.GetLabel(), m.GetSummary().GetSampleSum())
+				add(name+"_count", m.GetLabel(), float64(m.GetSummary().GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				add(name+"_sum", m.GetLabel(), m.GetHistogram().GetSampleSum())
+				add(name+"_count", m.GetLabel(), float64(m.GetHistogram().GetSampleCount()))
+			default:
+				add(name, m.GetLabel(), m.GetUntyped().GetValue())
+			}
+		}
+	}
+
+	if len(metrics) > maxSeriesPerPlugin {
+		logrus.Warnf("[Plugin] %s reported %d series, keeping the first %d", cfg.Name, len(metrics), maxSeriesPerPlugin)
+		metrics = metrics[:maxSeriesPerPlugin]
+	}
+	return metrics, nil
+}
+
+// limitedBuffer drops writes beyond its remaining capacity
+type limitedBuffer struct {
+	buf       *bytes.Buffer
+	remaining int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(p) > b.remaining {
+		p = p[:b.remaining]
+	}
+	b.buf.Write(p)
+	b.remaining -= len(p)
+	return n, nil
+}
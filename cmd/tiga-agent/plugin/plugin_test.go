@@ -0,0 +1,149 @@
+package plugin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/cmd/tiga-agent/collector"
+)
+
+const sampleMetrics = `# HELP queue_depth Jobs waiting
+# TYPE queue_depth gauge
+queue_depth{queue="mail"} 12
+queue_depth{queue="billing"} 3
+# TYPE http_requests_total counter
+http_requests_total{code="200"} 1027
+# TYPE request_seconds histogram
+request_seconds_bucket{le="1"} 5
+request_seconds_bucket{le="+Inf"} 6
+request_seconds_sum 4.5
+request_seconds_count 6
+go_goroutines 42
+broken_ratio NaN
+`
+
+func findMetric(metrics []collector.CustomMetric, name string, labels map[string]string) (collector.CustomMetric, bool) {
+	for _, m := range metrics {
+		if m.Name != name {
+			continue
+		}
+		match := true
+		for k, v := range labels {
+			if m.Labels[k] != v {
+				match = false
+			}
+		}
+		if match {
+			return m, true
+		}
+	}
+	return collector.CustomMetric{}, false
+}
+
+func TestParseMetrics(t *testing.T) {
+	cfg := &Config{Name: "app", Labels: map[string]string{"env": "prod"}}
+	metrics, err := parseMetrics([]byte(sampleMetrics), cfg)
+	require.NoError(t, err)
+	assert.Len(t, metrics, 6)
+
+	m, ok := findMetric(metrics, "queue_depth", map[string]string{"queue": "mail"})
+	require.True(t, ok)
+	assert.Equal(t, 12.0, m.Value)
+	assert.Equal(t, map[string]string{"queue": "mail", "env": "prod", "plugin": "app"}, m.Labels)
+
+	m, ok = findMetric(metrics, "request_seconds_count", nil)
+	require.True(t, ok)
+	assert.Equal(t, 6.0, m.Value)
+	_, ok = findMetric(metrics, "broken_ratio", nil)
+	assert.False(t, ok, "NaN values are dropped")
+
+	// Allow-list
+	cfg.Allow = []string{"queue_*", "go_goroutines"}
+	metrics, err = parseMetrics([]byte(sampleMetrics), cfg)
+	require.NoError(t, err)
+	assert.Len(t, metrics, 3)
+
+	_, err = parseMetrics([]byte("not a metric line {"), cfg)
+	assert.Error(t, err)
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		file := filepath.Join(dir, "plugins.yaml")
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+		return file
+	}
+
+	plugins, err := LoadConfig(write(`
+plugins:
+  - name: redis
+    type: exec
+    command: /usr/local/bin/redis-metrics.sh
+    interval: 30s
+    timeout: 1m
+  - name: app
+    type: prometheus
+    url: http://127.0.0.1:9100/metrics
+    allow: ["queue_*"]
+`))
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, 30*time.Second, plugins[0].Interval)
+	assert.Equal(t, 30*time.Second, plugins[0].Timeout, "timeout capped at the interval")
+	assert.Equal(t, defaultInterval, plugins[1].Interval)
+	assert.Equal(t, defaultTimeout, plugins[1].Timeout)
+
+	for name, content := range map[string]string{
+		"no name":        "plugins:\n  - type: exec\n    command: x\n",
+		"unknown type":   "plugins:\n  - name: a\n    type: snmp\n",
+		"no command":     "plugins:\n  - name: a\n    type: exec\n",
+		"bad url":        "plugins:\n  - name: a\n    type: prometheus\n    url: ftp://x\n",
+		"short interval": "plugins:\n  - name: a\n    type: exec\n    command: x\n    interval: 1s\n",
+		"bad pattern":    "plugins:\n  - name: a\n    type: exec\n    command: x\n    allow: ['[']\n",
+		"duplicate":      "plugins:\n  - name: a\n    type: exec\n    command: x\n  - name: a\n    type: exec\n    command: y\n",
+	} {
+		_, err := LoadConfig(write(content))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestManager(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, sampleMetrics)
+	}))
+	defer srv.Close()
+
+	configs := []Config{{Name: "app", Type: PluginTypePrometheus, URL: srv.URL, Allow: []string{"go_goroutines"}, Interval: time.Minute, Timeout: time.Second}}
+	if runtime.GOOS != "windows" {
+		configs = append(configs, Config{Name: "script", Type: PluginTypeExec, Command: "sh", Args: []string{"-c", "echo 'backup_age_seconds 3600'"}, Interval: time.Minute, Timeout: 5 * time.Second})
+	}
+
+	m := NewManager(configs)
+	m.Start(context.Background())
+	defer m.Stop()
+
+	require.Eventually(t, func() bool { return len(m.Snapshot()) == len(configs) }, 5*time.Second, 10*time.Millisecond)
+	metric, ok := findMetric(m.Snapshot(), "go_goroutines", map[string]string{"plugin": "app"})
+	require.True(t, ok)
+	assert.Equal(t, 42.0, metric.Value)
+	if runtime.GOOS != "windows" {
+		metric, ok = findMetric(m.Snapshot(), "backup_age_seconds", map[string]string{"plugin": "script"})
+		require.True(t, ok)
+		assert.Equal(t, 3600.0, metric.Value)
+	}
+
+	// A failing plugin reports nothing rather than stale values
+	_, err := m.collect(context.Background(), &Config{Name: "down", Type: PluginTypeExec, Command: "/nonexistent/plugin", Timeout: time.Second})
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package probe
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
+)
+
+// DNSProbe performs DNS resolution checks
+type DNSProbe struct {
+	timeout time.Duration
+}
+
+// NewDNSProbe creates a new DNS probe executor
+func NewDNSProbe(timeout time.Duration) *DNSProbe {
+	return &DNSProbe{
+		timeout: timeout,
+	}
+}
+
+// Execute resolves the target name and checks the expected answers
+func (p *DNSProbe) Execute(target string, opts healthcheck.DNSOptions) *ProbeResult {
+	result := &ProbeResult{
+		Target:    target,
+		Type:      string(ProbeTypeDNS),
+		Timestamp: time.Now(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	answers, err := healthcheck.CheckDNS(ctx, target, opts)
+	result.Latency = time.Since(start).Milliseconds()
+	result.Answers = answers
+
+	if err != nil {
+		result.Success = false
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Success = true
+	return result
+}
@@ -0,0 +1,46 @@
+package probe
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
+)
+
+// GRPCProbe performs grpc.health.v1 health checks
+type GRPCProbe struct {
+	timeout time.Duration
+}
+
+// NewGRPCProbe creates a new gRPC health probe executor
+func NewGRPCProbe(timeout time.Duration) *GRPCProbe {
+	return &GRPCProbe{
+		timeout: timeout,
+	}
+}
+
+// Execute calls the health check of the target host:port
+func (p *GRPCProbe) Execute(target string, opts healthcheck.GRPCOptions) *ProbeResult {
+	result := &ProbeResult{
+		Target:    target,
+		Type:      string(ProbeTypeGRPC),
+		Timestamp: time.Now(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	status, err := healthcheck.CheckGRPCHealth(ctx, target, opts)
+	result.Latency = time.Since(start).Milliseconds()
+	result.GRPCStatus = status
+
+	if err != nil {
+		result.Success = false
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Success = true
+	return result
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
 )
 
 // HTTPProbe performs HTTP/HTTPS health checks
@@ -137,3 +139,76 @@ func (p *HTTPProbe) ExecuteWithHeaders(target string, headers map[string]string)
 
 	return result
 }
+
+// ExecuteWithJSONPath performs an HTTP probe and asserts a JSONPath
+// expression on the response body. A non-zero expectedStatus replaces the
+// default 2xx/3xx status check.
+func (p *HTTPProbe) ExecuteWithJSONPath(target, method string, headers map[string]string, expectedStatus int, path, expect string) *ProbeResult {
+	result := &ProbeResult{
+		Target:    target,
+		Type:      "HTTP",
+		Timestamp: time.Now(),
+	}
+
+	if method == "" {
+		method = "GET"
+	}
+
+	start := time.Now()
+
+	// Create request
+	req, err := http.NewRequest(method, target, nil)
+	if err != nil {
+		result.Success = false
+		result.Error = fmt.Sprintf("Failed to create request: %v", err)
+		return result
+	}
+
+	req.Header.Set("User-Agent", "Tiga-Agent-Probe/1.0")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Execute request
+	resp, err := p.client.Do(req)
+	if err != nil {
+		result.Success = false
+		result.Error = fmt.Sprintf("Request failed: %v", err)
+		result.Latency = time.Since(start).Milliseconds()
+		return result
+	}
+	defer resp.Body.Close()
+
+	// Read response body (limited to 1MB)
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	result.Latency = time.Since(start).Milliseconds()
+	result.StatusCode = resp.StatusCode
+	if err != nil {
+		result.Success = false
+		result.Error = fmt.Sprintf("Failed to read response: %v", err)
+		return result
+	}
+
+	// Check status code
+	if expectedStatus > 0 {
+		if resp.StatusCode != expectedStatus {
+			result.Success = false
+			result.Error = fmt.Sprintf("Expected status %d, got %d", expectedStatus, resp.StatusCode)
+			return result
+		}
+	} else if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		result.Success = false
+		result.Error = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		return result
+	}
+
+	// Check JSONPath assertion
+	if err := healthcheck.AssertJSONPath(body, path, expect); err != nil {
+		result.Success = false
+		result.Error = fmt.Sprintf("JSON validation failed: %v", err)
+		return result
+	}
+
+	result.Success = true
+	return result
+}
@@ -2,6 +2,8 @@ package probe
 
 import (
 	"time"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
 )
 
 // ProbeType represents the type of probe
@@ -11,17 +13,27 @@ const (
 	ProbeTypeHTTP ProbeType = "HTTP"
 	ProbeTypeTCP  ProbeType = "TCP"
 	ProbeTypeICMP ProbeType = "ICMP"
+	ProbeTypeDNS  ProbeType = "DNS"
+	ProbeTypeGRPC ProbeType = "GRPC"
+	ProbeTypeTLS  ProbeType = "TLS"
+
+	ProbeTypeSynthetic ProbeType = "SYNTHETIC"
 )
 
 // ProbeResult represents the result of a probe execution
 type ProbeResult struct {
 	Target     string    // Target URL/host
-	Type       string    // Probe type (HTTP/TCP/ICMP)
+	Type       string    // Probe type (HTTP/TCP/ICMP/DNS/GRPC/TLS/SYNTHETIC)
 	Timestamp  time.Time // Time of probe execution
 	Success    bool      // Whether probe succeeded
 	Latency    int64     // Response time in milliseconds
 	StatusCode int       // HTTP status code (HTTP only)
 	Error      string    // Error message if failed
+
+	Answers     []string                     // Resolved answers (DNS only)
+	GRPCStatus  string                       // Reported health status (GRPC only)
+	Certificate *healthcheck.CertificateInfo // Leaf certificate (TLS only)
+	Steps       []healthcheck.StepResult     // Executed steps (SYNTHETIC only)
 }
 
 // Executor defines the interface for probe executors
@@ -34,6 +46,9 @@ type ProbeExecutor struct {
 	httpProbe *HTTPProbe
 	tcpProbe  *TCPProbe
 	icmpProbe *ICMPProbe
+	dnsProbe  *DNSProbe
+	grpcProbe *GRPCProbe
+	tlsProbe  *TLSProbe
 }
 
 // NewProbeExecutor creates a new probe executor with default settings
@@ -42,6 +57,9 @@ func NewProbeExecutor() *ProbeExecutor {
 		httpProbe: NewHTTPProbe(10 * time.Second),
 		tcpProbe:  NewTCPProbe(5 * time.Second),
 		icmpProbe: NewICMPProbe(5*time.Second, 1),
+		dnsProbe:  NewDNSProbe(5 * time.Second),
+		grpcProbe: NewGRPCProbe(5 * time.Second),
+		tlsProbe:  NewTLSProbe(5 * time.Second),
 	}
 }
 
@@ -51,6 +69,9 @@ func NewProbeExecutorWithTimeout(timeout time.Duration) *ProbeExecutor {
 		httpProbe: NewHTTPProbe(timeout),
 		tcpProbe:  NewTCPProbe(timeout),
 		icmpProbe: NewICMPProbe(timeout, 1),
+		dnsProbe:  NewDNSProbe(timeout),
+		grpcProbe: NewGRPCProbe(timeout),
+		tlsProbe:  NewTLSProbe(timeout),
 	}
 }
 
@@ -63,6 +84,12 @@ func (e *ProbeExecutor) Execute(probeType ProbeType, target string) *ProbeResult
 		return e.tcpProbe.Execute(target)
 	case ProbeTypeICMP:
 		return e.icmpProbe.Execute(target)
+	case ProbeTypeDNS:
+		return e.dnsProbe.Execute(target, healthcheck.DNSOptions{})
+	case ProbeTypeGRPC:
+		return e.grpcProbe.Execute(target, healthcheck.GRPCOptions{})
+	case ProbeTypeTLS:
+		return e.tlsProbe.Execute(target, healthcheck.TLSOptions{})
 	default:
 		return &ProbeResult{
 			Target:    target,
@@ -0,0 +1,49 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
+)
+
+// SyntheticProbe runs multi-step HTTP transactions
+type SyntheticProbe struct {
+	client *http.Client
+}
+
+// NewSyntheticProbe creates a new synthetic probe executor; timeout applies to each step
+func NewSyntheticProbe(timeout time.Duration) *SyntheticProbe {
+	return &SyntheticProbe{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true, // Allow self-signed certificates
+				},
+			},
+		},
+	}
+}
+
+// Execute runs the steps in order and stops at the first failing step
+func (p *SyntheticProbe) Execute(target string, steps []healthcheck.SyntheticStep) *ProbeResult {
+	result := &ProbeResult{
+		Target:    target,
+		Type:      string(ProbeTypeSynthetic),
+		Timestamp: time.Now(),
+	}
+
+	run := healthcheck.RunSynthetic(context.Background(), p.client, steps)
+	result.Success = run.Success
+	result.Latency = run.Latency
+	result.Error = run.Error
+	result.Steps = run.Steps
+	if n := len(run.Steps); n > 0 {
+		result.StatusCode = run.Steps[n-1].StatusCode
+	}
+
+	return result
+}
@@ -0,0 +1,47 @@
+package probe
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
+)
+
+// TLSProbe performs TLS handshake and certificate checks
+type TLSProbe struct {
+	timeout time.Duration
+}
+
+// NewTLSProbe creates a new TLS probe executor
+func NewTLSProbe(timeout time.Duration) *TLSProbe {
+	return &TLSProbe{
+		timeout: timeout,
+	}
+}
+
+// Execute performs a TLS handshake with the target host:port and validates
+// the certificate chain and expiry
+func (p *TLSProbe) Execute(target string, opts healthcheck.TLSOptions) *ProbeResult {
+	result := &ProbeResult{
+		Target:    target,
+		Type:      string(ProbeTypeTLS),
+		Timestamp: time.Now(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	cert, err := healthcheck.CheckTLS(ctx, target, opts)
+	result.Latency = time.Since(start).Milliseconds()
+	result.Certificate = cert
+
+	if err != nil {
+		result.Success = false
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Success = true
+	return result
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/ysicing/tiga/cmd/tiga-agent/probe"
+	"github.com/ysicing/tiga/pkg/healthcheck"
 	"github.com/ysicing/tiga/proto"
 )
 
@@ -85,6 +86,39 @@ func (h *ProbeTaskHandler) HandleProbeTask(task *proto.AgentTask, config *Config
 		logrus.Debugf("[ProbeTask] 执行ICMP探测 - 主机: %s", target)
 		result = h.executeICMPProbe(target)
 
+	case "dns", "DNS":
+		logrus.Debugf("[ProbeTask] 执行DNS探测 - 域名: %s, 类型: %s", target, task.Params["dns_record_type"])
+		result = probe.NewDNSProbe(taskTimeout(task.Params, 10*time.Second)).Execute(target, healthcheck.DNSOptions{
+			RecordType: task.Params["dns_record_type"],
+			Expect:     task.Params["dns_expect"],
+			Resolver:   task.Params["dns_resolver"],
+		})
+
+	case "grpc", "GRPC":
+		logrus.Debugf("[ProbeTask] 执行gRPC健康检查 - 地址: %s, 服务: %s", target, task.Params["grpc_service"])
+		useTLS, _ := strconv.ParseBool(task.Params["grpc_tls"])
+		result = probe.NewGRPCProbe(taskTimeout(task.Params, 10*time.Second)).Execute(target, healthcheck.GRPCOptions{
+			Service: task.Params["grpc_service"],
+			TLS:     useTLS,
+		})
+
+	case "tls", "TLS":
+		logrus.Debugf("[ProbeTask] 执行TLS探测 - 地址: %s", target)
+		minDays, _ := strconv.Atoi(task.Params["tls_min_days_remaining"])
+		result = probe.NewTLSProbe(taskTimeout(task.Params, 10*time.Second)).Execute(target, healthcheck.TLSOptions{
+			ServerName:       task.Params["tls_server_name"],
+			MinDaysRemaining: minDays,
+		})
+
+	case "synthetic", "SYNTHETIC":
+		var steps []healthcheck.SyntheticStep
+		if err := json.Unmarshal([]byte(task.Params["synthetic_steps"]), &steps); err != nil || len(steps) == 0 {
+			logrus.Errorf("[ProbeTask] Invalid synthetic steps for monitor %s: %v", monitorIDStr, err)
+			return
+		}
+		logrus.Debugf("[ProbeTask] 执行多步骤事务探测 - 步骤数: %d", len(steps))
+		result = probe.NewSyntheticProbe(taskTimeout(task.Params, 30*time.Second)).Execute(target, steps)
+
 	default:
 		logrus.Errorf("[ProbeTask] Unknown probe type: %s", probeType)
 		return
@@ -110,6 +144,16 @@ func (h *ProbeTaskHandler) HandleProbeTask(task *proto.AgentTask, config *Config
 
 // executeHTTPProbe executes HTTP probe with extended configuration
 func (h *ProbeTaskHandler) executeHTTPProbe(target, method string, params map[string]string) *probe.ProbeResult {
+	// JSONPath assertions need the response body along with headers and status
+	if path := params["json_path"]; path != "" {
+		var headers map[string]string
+		if headersJSON := params["headers"]; headersJSON != "" {
+			_ = json.Unmarshal([]byte(headersJSON), &headers)
+		}
+		expectedStatus, _ := strconv.Atoi(params["expected_status"])
+		return h.httpProbe.ExecuteWithJSONPath(target, method, headers, expectedStatus, path, params["json_expect"])
+	}
+
 	// Check if custom headers are provided
 	if headersJSON, ok := params["headers"]; ok && headersJSON != "" {
 		var headers map[string]string
@@ -141,6 +185,14 @@ func (h *ProbeTaskHandler) executeICMPProbe(target string) *probe.ProbeResult {
 	return result
 }
 
+// taskTimeout returns the "timeout" parameter in seconds, or def when unset
+func taskTimeout(params map[string]string, def time.Duration) time.Duration {
+	if seconds, err := strconv.Atoi(params["timeout"]); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return def
+}
+
 // reportProbeResult adds probe result to buffer for batch reporting
 func (h *ProbeTaskHandler) reportProbeResult(uuid, monitorID string, result *probe.ProbeResult) {
 	// Convert probe.ProbeResult to proto.ProbeResult
@@ -160,6 +212,26 @@ func (h *ProbeTaskHandler) reportProbeResult(uuid, monitorID string, result *pro
 		protoResult.HttpStatusCode = int32(result.StatusCode)
 	}
 
+	// Add DNS, gRPC and TLS specific fields
+	protoResult.DnsAnswers = result.Answers
+	protoResult.GrpcStatus = result.GRPCStatus
+	if result.Certificate != nil {
+		protoResult.TlsNotAfter = result.Certificate.NotAfter.UnixMilli()
+		protoResult.TlsDaysToExpiry = int32(result.Certificate.DaysToExpiry)
+	}
+
+	// Add synthetic step results
+	for _, step := range result.Steps {
+		protoResult.Steps = append(protoResult.Steps, &proto.ProbeStepResult{
+			Index:          int32(step.Index),
+			Name:           step.Name,
+			Success:        step.Success,
+			Latency:        int32(step.Latency),
+			HttpStatusCode: int32(step.StatusCode),
+			ErrorMessage:   step.Error,
+		})
+	}
+
 	// Add to buffer (non-blocking, will be sent in batch)
 	h.resultBuffer.Add(monitorID, protoResult)
 
@@ -1,12 +1,16 @@
 package main
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ysicing/tiga/cmd/tiga-agent/probe"
+	"github.com/ysicing/tiga/pkg/healthcheck"
 	"github.com/ysicing/tiga/proto"
 )
 
@@ -136,6 +140,61 @@ func TestExecuteHTTPProbe(t *testing.T) {
 	})
 }
 
+// TestExecuteHTTPProbeJSONPath tests JSONPath assertions against a local server
+func TestExecuteHTTPProbeJSONPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Token"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok","checks":[{"name":"db","up":true}]}`))
+	}))
+	defer server.Close()
+
+	handler := NewProbeTaskHandler("test-uuid-123", nil)
+	params := map[string]string{
+		"headers":   `{"X-Token": "secret"}`,
+		"json_path": "$.status",
+	}
+
+	params["json_expect"] = "ok"
+	result := handler.executeHTTPProbe(server.URL, "GET", params)
+	require.NotNil(t, result)
+	assert.True(t, result.Success, result.Error)
+
+	params["json_path"] = "$.checks[0].up"
+	params["json_expect"] = "false"
+	result = handler.executeHTTPProbe(server.URL, "GET", params)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "JSON validation failed")
+
+	params["expected_status"] = "204"
+	result = handler.executeHTTPProbe(server.URL, "GET", params)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "Expected status 204")
+}
+
+// TestSyntheticProbe tests multi-step transactions dispatched to agents
+func TestSyntheticProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session" {
+			w.Header().Set("X-Session", "s-1")
+			return
+		}
+		if r.Header.Get("X-Session") != "s-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	steps := []healthcheck.SyntheticStep{
+		{Name: "session", URL: server.URL + "/session", Extract: []healthcheck.Extraction{{Name: "sid", From: "header", Path: "X-Session"}}},
+		{Name: "profile", URL: server.URL + "/profile", Headers: map[string]string{"X-Session": "{{sid}}"}},
+	}
+	result := probe.NewSyntheticProbe(5*time.Second).Execute("profile flow", steps)
+	assert.True(t, result.Success, result.Error)
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, 200, result.StatusCode)
+}
+
 // TestExecuteTCPProbe tests TCP probe execution
 func TestExecuteTCPProbe(t *testing.T) {
 	t.Skip("Skipping TCP probe test as it requires network access - use integration tests instead")
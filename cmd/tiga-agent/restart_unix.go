@@ -0,0 +1,14 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// restartAgent replaces the running process with the binary at exe, keeping
+// the PID so that service managers do not notice the restart
+func restartAgent(exe string) error {
+	return syscall.Exec(exe, os.Args, os.Environ())
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/ysicing/tiga/cmd/tiga-agent/collector"
+	"github.com/ysicing/tiga/proto"
+)
+
+const (
+	// stateSegmentSize is the number of states stored per segment file (1h at 10s)
+	stateSegmentSize = 360
+	// stateSegmentExt is the file extension of segment files
+	stateSegmentExt = ".seg"
+	// maxStateRecordSize guards against reading a corrupt length prefix
+	maxStateRecordSize = 1 << 20
+	// maxBackfillBatch is the number of buffered states replayed per request
+	maxBackfillBatch = 500
+)
+
+// StateBuffer is a bounded on-disk FIFO of host states collected while the
+// agent is disconnected. States are appended to numbered segment files as
+// length-prefixed protobuf records; when the buffer is full the oldest
+// segment is dropped.
+type StateBuffer struct {
+	dir         string
+	maxStates   int
+	segmentSize int
+
+	mu       sync.Mutex
+	segments []*stateSegment // Oldest first
+	offset   int             // States of the oldest segment already replayed
+	nextSeq  uint64
+}
+
+type stateSegment struct {
+	seq   uint64
+	path  string
+	count int
+}
+
+// NewStateBuffer opens the buffer in dir, keeping the states left by a
+// previous run. States replayed but not yet deleted are sent again after a
+// restart; the server ignores the duplicates.
+func NewStateBuffer(dir string, maxStates int) (*StateBuffer, error) {
+	if maxStates <= 0 {
+		return nil, fmt.Errorf("invalid state buffer size %d", maxStates)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create state buffer directory: %w", err)
+	}
+
+	b := &StateBuffer{
+		dir:         dir,
+		maxStates:   maxStates,
+		segmentSize: min(stateSegmentSize, maxStates),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state buffer directory: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, stateSegmentExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, stateSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		seg := &stateSegment{seq: seq, path: filepath.Join(dir, name)}
+		if seg.count, err = recoverSegment(seg.path); err != nil {
+			return nil, err
+		}
+		if seg.count == 0 {
+			os.Remove(seg.path)
+			continue
+		}
+		b.segments = append(b.segments, seg)
+	}
+	sort.Slice(b.segments, func(i, j int) bool { return b.segments[i].seq < b.segments[j].seq })
+	if n := len(b.segments); n > 0 {
+		b.nextSeq = b.segments[n-1].seq + 1
+	}
+	b.trim()
+
+	return b, nil
+}
+
+// Add appends a state to the buffer, dropping the oldest states when full
+func (b *StateBuffer) Add(state *proto.HostState) error {
+	data, err := protobuf.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	seg := b.tail()
+	if seg == nil || seg.count >= b.segmentSize {
+		seg = &stateSegment{
+			seq:  b.nextSeq,
+			path: filepath.Join(b.dir, fmt.Sprintf("%016d%s", b.nextSeq, stateSegmentExt)),
+		}
+		b.nextSeq++
+		b.segments = append(b.segments, seg)
+	}
+
+	f, err := os.OpenFile(seg.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open state segment: %w", err)
+	}
+	if _, err := f.Write(record); err != nil {
+		f.Close()
+		// Drop the partial record so the segment stays readable
+		_, _ = recoverSegment(seg.path)
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	seg.count++
+
+	b.trim()
+	return nil
+}
+
+// Peek returns up to n of the oldest states not yet discarded
+func (b *StateBuffer) Peek(n int) ([]*proto.HostState, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var states []*proto.HostState
+	skip := b.offset
+	for _, seg := range b.segments {
+		if len(states) >= n {
+			break
+		}
+		records, err := readSegment(seg.path)
+		if err != nil {
+			return nil, err
+		}
+		if skip > len(records) {
+			skip = len(records)
+		}
+		for _, data := range records[skip:] {
+			if len(states) >= n {
+				break
+			}
+			state := &proto.HostState{}
+			if err := protobuf.Unmarshal(data, state); err != nil {
+				return nil, fmt.Errorf("failed to decode state: %w", err)
+			}
+			states = append(states, state)
+		}
+		skip = 0
+	}
+	return states, nil
+}
+
+// Discard removes the n oldest states, deleting segments fully replayed
+func (b *StateBuffer) Discard(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.offset += n
+	for len(b.segments) > 0 && b.offset >= b.segments[0].count {
+		b.offset -= b.segments[0].count
+		b.dropOldest()
+	}
+	if len(b.segments) == 0 {
+		b.offset = 0
+	}
+}
+
+// Len returns the number of buffered states
+func (b *StateBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.len()
+}
+
+func (b *StateBuffer) len() int {
+	total := -b.offset
+	for _, seg := range b.segments {
+		total += seg.count
+	}
+	return total
+}
+
+func (b *StateBuffer) tail() *stateSegment {
+	if len(b.segments) == 0 {
+		return nil
+	}
+	return b.segments[len(b.segments)-1]
+}
+
+// trim drops the oldest segments until the buffer is within its bound
+func (b *StateBuffer) trim() {
+	for len(b.segments) > 1 && b.len() > b.maxStates {
+		logrus.Warnf("[StateBuffer] Buffer full, dropping %d oldest states", b.segments[0].count-b.offset)
+		b.offset = 0
+		b.dropOldest()
+	}
+}
+
+func (b *StateBuffer) dropOldest() {
+	if err := os.Remove(b.segments[0].path); err != nil && !os.IsNotExist(err) {
+		logrus.Warnf("[StateBuffer] Failed to remove segment %s: %v", b.segments[0].path, err)
+	}
+	b.segments = b.segments[1:]
+}
+
+// readSegment returns the complete records of a segment file
+func readSegment(path string) ([][]byte, error) {
+	records, _, err := scanSegment(path)
+	return records, err
+}
+
+// recoverSegment counts the records of a segment file, truncating a partial
+// record left by an interrupted write
+func recoverSegment(path string) (int, error) {
+	records, valid, err := scanSegment(path)
+	if err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat state segment: %w", err)
+	}
+	if info.Size() > valid {
+		logrus.Warnf("[StateBuffer] Truncating corrupt tail of %s", path)
+		if err := os.Truncate(path, valid); err != nil {
+			return 0, fmt.Errorf("failed to truncate state segment: %w", err)
+		}
+	}
+	return len(records), nil
+}
+
+// scanSegment reads records up to the first incomplete one and returns them
+// with the size of the valid part of the file
+func scanSegment(path string) ([][]byte, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open state segment: %w", err)
+	}
+	defer f.Close()
+
+	var records [][]byte
+	var valid int64
+	r := bufio.NewReader(f)
+	header := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return records, valid, nil
+			}
+			return nil, 0, fmt.Errorf("failed to read state segment: %w", err)
+		}
+		size := binary.BigEndian.Uint32(header)
+		if size > maxStateRecordSize {
+			return records, valid, nil
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return records, valid, nil
+			}
+			return nil, 0, fmt.Errorf("failed to read state segment: %w", err)
+		}
+		records = append(records, data)
+		valid += int64(4 + size)
+	}
+}
+
+// startStateSampler collects host states into the buffer at the report
+// interval until the returned function is called. The returned function
+// waits for the sampler to exit so the collector can be reused right away.
+func startStateSampler(ctx context.Context, col *collector.Collector, buffer *StateBuffer, interval time.Duration) func() {
+	if buffer == nil {
+		return func() {}
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-stop:
+				return
+			case <-ticker.C:
+				state, err := collectProtoState(col)
+				if err != nil {
+					logrus.Warnf("[StateBuffer] %v", err)
+					continue
+				}
+				if err := buffer.Add(state); err != nil {
+					logrus.Warnf("[StateBuffer] Failed to buffer state: %v", err)
+					continue
+				}
+				logrus.Debugf("[StateBuffer] Buffered state while disconnected (%d pending)", buffer.Len())
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stop)
+			<-done
+		})
+	}
+}
+
+// stateReplay sends buffered states to the server one batch at a time and
+// discards them once the server acknowledged the request carrying them
+type stateReplay struct {
+	buffer   *StateBuffer
+	inflight []int // Buffered states carried by each unacknowledged request
+	sending  bool  // A batch is waiting for its acknowledgment
+}
+
+func newStateReplay(buffer *StateBuffer) *stateReplay {
+	return &stateReplay{buffer: buffer}
+}
+
+// next returns the next batch to replay, or nil when there is nothing to
+// send or a batch is still in flight
+func (r *stateReplay) next() []*proto.HostState {
+	if r.buffer == nil || r.sending || r.buffer.Len() == 0 {
+		return nil
+	}
+	states, err := r.buffer.Peek(maxBackfillBatch)
+	if err != nil {
+		logrus.Warnf("[StateBuffer] Failed to read buffered states: %v", err)
+		return nil
+	}
+	return states
+}
+
+// sent records a request sent to the server with n buffered states
+func (r *stateReplay) sent(n int) {
+	r.inflight = append(r.inflight, n)
+	if n > 0 {
+		r.sending = true
+	}
+}
+
+// ack records the server response to the oldest request in flight. It
+// returns true when a batch was stored and more states are pending.
+func (r *stateReplay) ack(success bool) bool {
+	if len(r.inflight) == 0 {
+		return false
+	}
+	n := r.inflight[0]
+	r.inflight = r.inflight[1:]
+	if n == 0 {
+		return false
+	}
+
+	r.sending = false
+	if !success {
+		// Keep the batch, it is sent again on the next report
+		return false
+	}
+	r.buffer.Discard(n)
+	remaining := r.buffer.Len()
+	logrus.Infof("[StateBuffer] Replayed %d buffered states (%d pending)", n, remaining)
+	return remaining > 0
+}
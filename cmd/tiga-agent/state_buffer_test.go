@@ -0,0 +1,157 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/proto"
+)
+
+func addStates(t *testing.T, b *StateBuffer, from, to int) {
+	for i := from; i < to; i++ {
+		require.NoError(t, b.Add(&proto.HostState{Timestamp: int64(i), CpuUsage: float64(i)}))
+	}
+}
+
+func timestamps(states []*proto.HostState) []int64 {
+	var result []int64
+	for _, state := range states {
+		result = append(result, state.Timestamp)
+	}
+	return result
+}
+
+func segmentFiles(t *testing.T, dir string) int {
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+stateSegmentExt))
+	require.NoError(t, err)
+	return len(matches)
+}
+
+func TestStateBuffer_PeekAndDiscard(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewStateBuffer(dir, 1000)
+	require.NoError(t, err)
+	b.segmentSize = 4
+
+	addStates(t, b, 0, 10)
+	assert.Equal(t, 10, b.Len())
+	assert.Equal(t, 3, segmentFiles(t, dir))
+
+	states, err := b.Peek(6)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5}, timestamps(states))
+	assert.Equal(t, 5.0, states[5].CpuUsage)
+
+	// Fully replayed segments are deleted
+	b.Discard(6)
+	assert.Equal(t, 4, b.Len())
+	assert.Equal(t, 2, segmentFiles(t, dir))
+	states, err = b.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6, 7, 8, 9}, timestamps(states))
+
+	b.Discard(4)
+	assert.Zero(t, b.Len())
+	assert.Zero(t, segmentFiles(t, dir))
+	states, err = b.Peek(100)
+	require.NoError(t, err)
+	assert.Empty(t, states)
+
+	addStates(t, b, 10, 12)
+	states, err = b.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{10, 11}, timestamps(states))
+}
+
+func TestStateBuffer_Bounded(t *testing.T) {
+	b, err := NewStateBuffer(t.TempDir(), 8)
+	require.NoError(t, err)
+	b.segmentSize = 4
+
+	// The oldest segment is dropped once the buffer is full
+	addStates(t, b, 0, 9)
+	assert.Equal(t, 5, b.Len())
+	states, err := b.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6, 7, 8}, timestamps(states))
+
+	// Replayed states free their room
+	b.Discard(2)
+	addStates(t, b, 9, 14)
+	states, err = b.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6, 7, 8, 9, 10, 11, 12, 13}, timestamps(states))
+
+	addStates(t, b, 14, 15)
+	states, err = b.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{8, 9, 10, 11, 12, 13, 14}, timestamps(states))
+}
+
+func TestStateBuffer_Reload(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewStateBuffer(dir, 100)
+	require.NoError(t, err)
+	b.segmentSize = 4
+	addStates(t, b, 0, 6)
+	b.Discard(1)
+
+	// Simulate a write interrupted by a crash
+	last := filepath.Join(dir, "0000000000000001"+stateSegmentExt)
+	f, err := os.OpenFile(last, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 9, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// States replayed but not yet deleted come back, the corrupt tail is dropped
+	b, err = NewStateBuffer(dir, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 6, b.Len())
+	addStates(t, b, 6, 7)
+	states, err := b.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6}, timestamps(states))
+}
+
+func TestStateReplay(t *testing.T) {
+	b, err := NewStateBuffer(t.TempDir(), 2000)
+	require.NoError(t, err)
+	addStates(t, b, 0, maxBackfillBatch+10)
+	r := newStateReplay(b)
+
+	// Live reports without buffered states are acknowledged in order
+	r.sent(0)
+	batch := r.next()
+	require.Len(t, batch, maxBackfillBatch)
+	r.sent(len(batch))
+	assert.Nil(t, r.next(), "one batch in flight at a time")
+
+	assert.False(t, r.ack(true))
+	assert.Equal(t, maxBackfillBatch+10, b.Len())
+
+	// A rejected batch is kept and sent again
+	assert.False(t, r.ack(false))
+	batch = r.next()
+	require.Len(t, batch, maxBackfillBatch)
+	assert.Equal(t, int64(0), batch[0].Timestamp)
+	r.sent(len(batch))
+
+	assert.True(t, r.ack(true))
+	batch = r.next()
+	assert.Equal(t, []int64{500, 501, 502, 503, 504, 505, 506, 507, 508, 509}, timestamps(batch))
+	r.sent(len(batch))
+	assert.False(t, r.ack(true))
+	assert.Zero(t, b.Len())
+	assert.Nil(t, r.next())
+
+	// Without a buffer nothing is replayed
+	r = newStateReplay(nil)
+	assert.Nil(t, r.next())
+	r.sent(0)
+	assert.False(t, r.ack(true))
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ysicing/tiga/proto"
+)
+
+// Files kept in --cert-dir after enrolment
+const (
+	clientCertFile = "agent.crt"
+	clientKeyFile  = "agent.key"
+	caCertFile     = "ca.crt"
+)
+
+// defaultCertDir returns the platform default for --cert-dir
+func defaultCertDir() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("ProgramData"), "tiga-agent", "certs")
+	}
+	return "/var/lib/tiga-agent/certs"
+}
+
+// useTLS reports whether the agent connects over TLS. Setting a CA bundle
+// or pin implies TLS; ports 443/8443 keep enabling it for older installs.
+func useTLS(config *Config) bool {
+	return config.TLS || config.CAFile != "" || config.ServerPin != "" || shouldUseTLS(config.ServerAddr)
+}
+
+// buildTLSConfig returns the client TLS configuration. The server is
+// verified against the CA bundle when given, the system roots plus the CA
+// saved at enrolment otherwise, and must additionally match the pin when
+// one is set. With only a pin the pinned certificate is the trust anchor.
+// clientCert may be nil before enrolment.
+func buildTLSConfig(config *Config, clientCert *tls.Certificate) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: config.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if clientCert != nil {
+		tlsConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	if config.InsecureSkipVerify {
+		logrus.Warn("Server certificate verification is disabled (--insecure-skip-verify)")
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // explicitly requested by the operator
+		return tlsConfig, nil
+	}
+
+	if config.CAFile != "" {
+		data, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = roots
+	}
+
+	if config.ServerPin == "" {
+		if config.CAFile == "" {
+			tlsConfig.RootCAs = enrolledRoots(config.CertDir)
+		}
+		return tlsConfig, nil
+	}
+	pin, err := parsePin(config.ServerPin)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.CAFile != "" {
+		tlsConfig.VerifyPeerCertificate = func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+			for _, chain := range verifiedChains {
+				for _, cert := range chain {
+					if matchesPin(cert, pin) {
+						return nil
+					}
+				}
+			}
+			return errors.New("server certificate chain does not match the pinned public key")
+		}
+		return tlsConfig, nil
+	}
+
+	// Chain and name verification are replaced by the pin check
+	tlsConfig.InsecureSkipVerify = true //nolint:gosec // verified by VerifyPeerCertificate
+	tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		return verifyPinnedChain(rawCerts, pin)
+	}
+	return tlsConfig, nil
+}
+
+// enrolledRoots returns the system roots extended with the CA saved at
+// enrolment, which signs the server certificate unless the operator
+// configured their own. It returns nil, meaning the system roots, when the
+// agent has not enrolled yet.
+func enrolledRoots(dir string) *x509.CertPool {
+	if dir == "" {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(dir, caCertFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Warnf("Ignoring enrolled CA certificate: %v", err)
+		}
+		return nil
+	}
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+	if !roots.AppendCertsFromPEM(data) {
+		logrus.Warnf("Ignoring enrolled CA certificate: no certificates found in %s", filepath.Join(dir, caCertFile))
+		return nil
+	}
+	return roots
+}
+
+// parsePin decodes a "sha256:<hex>" public key pin
+func parsePin(pin string) ([]byte, error) {
+	value := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(pin)), "sha256:")
+	sum, err := hex.DecodeString(value)
+	if err != nil || len(sum) != sha256.Size {
+		return nil, fmt.Errorf("invalid server pin %q, expected sha256:<64 hex characters>", pin)
+	}
+	return sum, nil
+}
+
+func matchesPin(cert *x509.Certificate, pin []byte) bool {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return bytes.Equal(sum[:], pin)
+}
+
+// verifyPinnedChain accepts the presented chain when the leaf matches the
+// pin, or when a pinned certificate in the chain signed the leaf for
+// server authentication
+func verifyPinnedChain(rawCerts [][]byte, pin []byte) error {
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse server certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return errors.New("server presented no certificate")
+	}
+
+	for i, cert := range certs {
+		if !matchesPin(cert, pin) {
+			continue
+		}
+		if i == 0 {
+			return nil
+		}
+		roots := x509.NewCertPool()
+		roots.AddCert(cert)
+		intermediates := x509.NewCertPool()
+		for _, c := range certs[1:i] {
+			intermediates.AddCert(c)
+		}
+		if _, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err != nil {
+			return fmt.Errorf("server certificate is not signed by the pinned key: %w", err)
+		}
+		return nil
+	}
+	return errors.New("server certificate chain does not match the pinned public key")
+}
+
+// loadClientCertificate loads the enrolled client certificate from dir. It
+// returns nil without error when the agent has not enrolled yet.
+func loadClientCertificate(dir string) (*tls.Certificate, error) {
+	certPath := filepath.Join(dir, clientCertFile)
+	keyPath := filepath.Join(dir, clientKeyFile)
+	if _, err := os.Stat(certPath); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	if cert.Leaf == nil {
+		if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+	}
+	return &cert, nil
+}
+
+// discardClientCertificate removes the stored client certificate and key so
+// the next connection enrols again. The server rejects certificates it has
+// revoked, e.g. after the host secret key was regenerated.
+func discardClientCertificate(dir string) error {
+	for _, name := range []string{clientCertFile, clientKeyFile} {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// needsEnrollment reports whether a new client certificate should be
+// requested: none exists yet or less than a third of its lifetime is left
+func needsEnrollment(cert *tls.Certificate, now time.Time) bool {
+	if cert == nil || cert.Leaf == nil {
+		return true
+	}
+	lifetime := cert.Leaf.NotAfter.Sub(cert.Leaf.NotBefore)
+	return cert.Leaf.NotAfter.Sub(now) < lifetime/3
+}
+
+// enrollAgent requests a client certificate with the secret key and stores
+// it with a freshly generated key in config.CertDir
+func enrollAgent(ctx context.Context, client proto.HostMonitorClient, config *Config) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: config.UUID},
+	}, key)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	resp, err := client.EnrollAgent(ctx, &proto.EnrollAgentRequest{
+		Uuid:      config.UUID,
+		SecretKey: config.SecretKey,
+		Csr:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("enrolment rejected: %s", resp.Message)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to encode key: %w", err)
+	}
+	if err := os.MkdirAll(config.CertDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create certificate directory: %w", err)
+	}
+	files := []struct {
+		name string
+		data []byte
+		mode os.FileMode
+	}{
+		{clientKeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600},
+		{clientCertFile, resp.Certificate, 0o644},
+		{caCertFile, resp.CaCertificate, 0o644},
+	}
+	for _, f := range files {
+		if err := writeFileAtomic(filepath.Join(config.CertDir, f.name), f.data, f.mode); err != nil {
+			return err
+		}
+	}
+
+	logrus.Infof("Client certificate enrolled, expires at %s", time.Unix(resp.ExpiresAt, 0).Format(time.RFC3339))
+	return nil
+}
+
+// ensureClientCertificate returns the client certificate to connect with,
+// enrolling first when needed. Enrolment failures are logged and the
+// current certificate, possibly none, is kept so servers without agent
+// mTLS keep working.
+func ensureClientCertificate(ctx context.Context, config *Config) *tls.Certificate {
+	cert, err := loadClientCertificate(config.CertDir)
+	if err != nil {
+		logrus.Warnf("Ignoring stored client certificate: %v", err)
+		cert = nil
+	}
+	if !needsEnrollment(cert, time.Now()) {
+		return cert
+	}
+
+	conn, err := dialServer(ctx, config, cert)
+	if err != nil {
+		logrus.Warnf("Failed to connect for certificate enrolment: %v", err)
+		return cert
+	}
+	defer conn.Close()
+
+	if err := enrollAgent(ctx, proto.NewHostMonitorClient(conn), config); err != nil {
+		switch status.Code(err) {
+		case codes.Unimplemented, codes.FailedPrecondition:
+			logrus.Debugf("Server does not issue client certificates: %v", err)
+		case codes.Unauthenticated:
+			logrus.Warnf("Certificate enrolment rejected: %v", err)
+			if cert != nil {
+				// The stored certificate was refused, connect without it
+				resetClientCertificate(config)
+				return nil
+			}
+		default:
+			logrus.Warnf("Certificate enrolment failed: %v", err)
+		}
+		return cert
+	}
+
+	enrolled, err := loadClientCertificate(config.CertDir)
+	if err != nil {
+		logrus.Warnf("Failed to load enrolled client certificate: %v", err)
+		return cert
+	}
+	return enrolled
+}
+
+// resetClientCertificate discards the stored client certificate, if any,
+// after the server refused to authenticate the agent
+func resetClientCertificate(config *Config) {
+	if _, err := os.Stat(filepath.Join(config.CertDir, clientCertFile)); err != nil {
+		return
+	}
+	logrus.Warn("Server rejected the client certificate, discarding it to enrol again")
+	if err := discardClientCertificate(config.CertDir); err != nil {
+		logrus.Warnf("Failed to discard client certificate: %v", err)
+	}
+}
+
+func writeFileAtomic(path string, data []byte, mode os.FileMode) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, mode); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ysicing/tiga/proto"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, template *x509.Certificate, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	if template.NotAfter.IsZero() {
+		template.NotAfter = time.Now().Add(24 * time.Hour)
+	}
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func newTestCA(t *testing.T) *testCert {
+	return newTestCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test CA"},
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil)
+}
+
+func pinOf(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestParsePin(t *testing.T) {
+	ca := newTestCA(t)
+	pin, err := parsePin(pinOf(ca.cert))
+	require.NoError(t, err)
+	assert.True(t, matchesPin(ca.cert, pin))
+
+	_, err = parsePin("SHA256:" + pinOf(ca.cert)[7:])
+	assert.NoError(t, err, "prefix is case insensitive")
+	_, err = parsePin("sha256:abcd")
+	assert.Error(t, err)
+	_, err = parsePin("md5:zz")
+	assert.Error(t, err)
+}
+
+func TestVerifyPinnedChain(t *testing.T) {
+	ca := newTestCA(t)
+	server := newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "server"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	client := newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "agent"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+	chain := [][]byte{server.cert.Raw, ca.cert.Raw}
+
+	caPin, _ := parsePin(pinOf(ca.cert))
+	leafPin, _ := parsePin(pinOf(server.cert))
+	otherPin, _ := parsePin(pinOf(newTestCA(t).cert))
+
+	assert.NoError(t, verifyPinnedChain(chain, caPin))
+	assert.NoError(t, verifyPinnedChain(chain, leafPin))
+	assert.Error(t, verifyPinnedChain(chain, otherPin))
+	assert.Error(t, verifyPinnedChain(nil, caPin))
+
+	// A client certificate of the same CA cannot impersonate the server
+	err := verifyPinnedChain([][]byte{client.cert.Raw, ca.cert.Raw}, caPin)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not signed by the pinned key")
+}
+
+func TestBuildTLSConfigHandshake(t *testing.T) {
+	ca := newTestCA(t)
+	server := newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "tiga"},
+		DNSNames:    []string{"tiga.example.com"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{{
+		Certificate: [][]byte{server.cert.Raw, ca.cert.Raw},
+		PrivateKey:  server.key,
+	}}})
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+	certDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, caCertFile), caPEM, 0o600))
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "ca pin", config: Config{ServerPin: pinOf(ca.cert)}},
+		{name: "leaf pin", config: Config{ServerPin: pinOf(server.cert)}},
+		{name: "wrong pin", config: Config{ServerPin: pinOf(newTestCA(t).cert)}, wantErr: true},
+		{name: "ca bundle", config: Config{CAFile: caFile, ServerName: "tiga.example.com"}},
+		{name: "ca bundle wrong name", config: Config{CAFile: caFile, ServerName: "other.example.com"}, wantErr: true},
+		{name: "ca bundle and pin", config: Config{CAFile: caFile, ServerName: "tiga.example.com", ServerPin: pinOf(ca.cert)}},
+		{name: "ca bundle and wrong pin", config: Config{CAFile: caFile, ServerName: "tiga.example.com", ServerPin: pinOf(newTestCA(t).cert)}, wantErr: true},
+		{name: "system roots", config: Config{ServerName: "tiga.example.com"}, wantErr: true},
+		{name: "enrolled ca", config: Config{CertDir: certDir, ServerName: "tiga.example.com"}},
+		{name: "enrolled ca wrong name", config: Config{CertDir: certDir, ServerName: "other.example.com"}, wantErr: true},
+		{name: "insecure", config: Config{InsecureSkipVerify: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := buildTLSConfig(&tt.config, nil)
+			require.NoError(t, err)
+			conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 5 * time.Second}, "tcp", lis.Addr().String(), tlsConfig)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			conn.Close()
+		})
+	}
+}
+
+type enrollTestClient struct {
+	proto.HostMonitorClient
+	ca  *testCert
+	req *proto.EnrollAgentRequest
+}
+
+func (c *enrollTestClient) EnrollAgent(_ context.Context, req *proto.EnrollAgentRequest, _ ...grpc.CallOption) (*proto.EnrollAgentResponse, error) {
+	c.req = req
+	block, _ := pem.Decode(req.Csr)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      csr.Subject,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.ca.cert, csr.PublicKey, c.ca.key)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.EnrollAgentResponse{
+		Success:       true,
+		Certificate:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		CaCertificate: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.ca.cert.Raw}),
+		ExpiresAt:     template.NotAfter.Unix(),
+	}, nil
+}
+
+func TestEnrollAgent(t *testing.T) {
+	config := &Config{UUID: "host-1", SecretKey: "secret", CertDir: filepath.Join(t.TempDir(), "certs")}
+
+	cert, err := loadClientCertificate(config.CertDir)
+	require.NoError(t, err)
+	assert.Nil(t, cert)
+	assert.True(t, needsEnrollment(cert, time.Now()))
+
+	client := &enrollTestClient{ca: newTestCA(t)}
+	require.NoError(t, enrollAgent(context.Background(), client, config))
+	assert.Equal(t, "secret", client.req.SecretKey)
+
+	cert, err = loadClientCertificate(config.CertDir)
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+	assert.Equal(t, "host-1", cert.Leaf.Subject.CommonName)
+	assert.False(t, needsEnrollment(cert, time.Now()))
+	assert.True(t, needsEnrollment(cert, time.Now().Add(70*24*time.Hour)), "renews in the last third of the lifetime")
+
+	info, err := os.Stat(filepath.Join(config.CertDir, clientKeyFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	_, err = os.Stat(filepath.Join(config.CertDir, caCertFile))
+	assert.NoError(t, err)
+}
+
+func TestResetClientCertificate(t *testing.T) {
+	config := &Config{UUID: "host-1", SecretKey: "secret", CertDir: filepath.Join(t.TempDir(), "certs")}
+	client := &enrollTestClient{ca: newTestCA(t)}
+	require.NoError(t, enrollAgent(context.Background(), client, config))
+
+	// The server revoked the certificate, the agent drops it and enrols again
+	resetClientCertificate(config)
+	cert, err := loadClientCertificate(config.CertDir)
+	require.NoError(t, err)
+	assert.Nil(t, cert)
+	assert.True(t, needsEnrollment(cert, time.Now()))
+	_, err = os.Stat(filepath.Join(config.CertDir, clientKeyFile))
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, enrollAgent(context.Background(), client, config))
+	cert, err = loadClientCertificate(config.CertDir)
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+	assert.False(t, needsEnrollment(cert, time.Now()))
+
+	// Nothing to discard is not an error
+	require.NoError(t, discardClientCertificate(t.TempDir()))
+}
@@ -0,0 +1,456 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sync"
+	"time"
+
+	semver "github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/version"
+	"github.com/ysicing/tiga/proto"
+)
+
+// Self-update: the server sends an "upgrade" task, the agent downloads the
+// new binary over gRPC, verifies it and swaps it in place, then restarts.
+// A marker file next to the binary records the pending upgrade; the new
+// binary removes it once it registered with the server, otherwise the
+// previous binary is restored.
+const (
+	upgradeMarkerSuffix = ".upgrade.json"
+	upgradeBackupSuffix = ".old"
+
+	upgradeDownloadTimeout = 10 * time.Minute
+	upgradeConfirmTimeout  = 3 * time.Minute // New binary must register within this
+	maxUpgradeAttempts     = 3               // Starts of the new binary before rolling back
+	maxUpgradeBinarySize   = 256 << 20
+)
+
+// Versions from scripts/version.sh: "<tag>-<commit>" or "<yyyymmdd>-<commit>"
+var (
+	commitSuffixPattern = regexp.MustCompile(`-[0-9a-f]{7}$`)
+	dateVersionPattern  = regexp.MustCompile(`^[0-9]{8}$`)
+)
+
+// upgradeMarker records an upgrade that is not confirmed yet
+type upgradeMarker struct {
+	FromVersion string    `json:"from_version"`
+	ToVersion   string    `json:"to_version"`
+	Backup      string    `json:"backup"`
+	Attempts    int       `json:"attempts"`
+	StartedAt   time.Time `json:"started_at"`
+}
+
+// Updater replaces the running agent binary
+type Updater struct {
+	exe            string            // Path of the running binary
+	currentVersion string            // Version of the running binary
+	publicKey      ed25519.PublicKey // Verifies binary signatures, nil when not configured
+	allowUnsigned  bool              // Accept binaries without a verifiable signature
+	restart        func(exe string) error
+
+	mu        sync.Mutex
+	upgrading bool
+	confirm   chan struct{} // Closed when the pending upgrade is confirmed
+}
+
+// newUpdater creates an Updater for the running binary
+func newUpdater(config *Config) (*Updater, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate agent binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+
+	u := &Updater{
+		exe:            exe,
+		currentVersion: version.Version,
+		allowUnsigned:  config.AllowUnsignedUpdate,
+		restart:        restartAgent,
+	}
+
+	key := config.UpdatePublicKey
+	if key == "" {
+		key = version.AgentUpdatePublicKey
+	}
+	if key != "" {
+		raw, err := base64.StdEncoding.DecodeString(key)
+		if err != nil || len(raw) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("update public key must be a base64 Ed25519 public key")
+		}
+		u.publicKey = ed25519.PublicKey(raw)
+	}
+	return u, nil
+}
+
+func (u *Updater) markerPath() string {
+	return u.exe + upgradeMarkerSuffix
+}
+
+// Upgrade downloads, verifies and installs a version, then restarts the
+// agent. It does not return once the restart succeeded.
+func (u *Updater) Upgrade(client proto.HostMonitorClient, uuid, targetVersion string) error {
+	if targetVersion == "" {
+		return errors.New("upgrade task has no version")
+	}
+	if err := u.checkNewer(targetVersion); err != nil {
+		return err
+	}
+
+	u.mu.Lock()
+	if u.upgrading {
+		u.mu.Unlock()
+		return errors.New("another upgrade is in progress")
+	}
+	u.upgrading = true
+	u.mu.Unlock()
+	defer func() {
+		u.mu.Lock()
+		u.upgrading = false
+		u.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), upgradeDownloadTimeout)
+	defer cancel()
+
+	tmp, err := u.download(ctx, client, uuid, targetVersion)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if err := u.install(tmp, targetVersion); err != nil {
+		return err
+	}
+
+	logrus.Infof("[Upgrade] Installed agent %s, restarting", targetVersion)
+	if err := u.restart(u.exe); err != nil {
+		u.rollbackFiles()
+		return fmt.Errorf("failed to restart agent: %w", err)
+	}
+	return nil
+}
+
+// download streams the binary into a temporary file next to the running
+// binary and verifies its checksum and signature
+func (u *Updater) download(ctx context.Context, client proto.HostMonitorClient, uuid, targetVersion string) (string, error) {
+	stream, err := client.DownloadAgentBinary(ctx, &proto.DownloadAgentBinaryRequest{
+		Uuid:    uuid,
+		Version: targetVersion,
+		Os:      runtime.GOOS,
+		Arch:    runtime.GOARCH,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to request agent binary: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(u.exe), ".tiga-agent-update-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create update file: %w", err)
+	}
+	path := f.Name()
+	fail := func(err error) (string, error) {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+
+	var (
+		size      int64
+		sum       string
+		signature string
+		written   int64
+		first     = true
+	)
+	h := sha256.New()
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fail(fmt.Errorf("failed to download agent binary: %w", err))
+		}
+		if first {
+			size, sum, signature = chunk.Size, chunk.Sha256, chunk.Signature
+			if size <= 0 || size > maxUpgradeBinarySize {
+				return fail(fmt.Errorf("invalid agent binary size %d", size))
+			}
+			first = false
+		}
+		written += int64(len(chunk.Data))
+		if written > size {
+			return fail(errors.New("agent binary is larger than announced"))
+		}
+		if _, err := f.Write(chunk.Data); err != nil {
+			return fail(fmt.Errorf("failed to write update file: %w", err))
+		}
+		h.Write(chunk.Data)
+	}
+	if first || written != size {
+		return fail(fmt.Errorf("agent binary is incomplete: got %d of %d bytes", written, size))
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return "", fmt.Errorf("failed to write update file: %w", err)
+	}
+
+	digest := h.Sum(nil)
+	if hex.EncodeToString(digest) != sum {
+		os.Remove(path)
+		return "", errors.New("agent binary checksum mismatch")
+	}
+	if err := u.verifySignature(signedUpdateMessage(targetVersion, runtime.GOOS, runtime.GOARCH, digest), signature); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	if err := os.Chmod(path, 0o755); err != nil {
+		os.Remove(path)
+		return "", fmt.Errorf("failed to make update executable: %w", err)
+	}
+	return path, nil
+}
+
+// checkNewer rejects versions that are not newer than the running one, so a
+// validly signed older release cannot be replayed as a downgrade
+func (u *Updater) checkNewer(targetVersion string) error {
+	if u.currentVersion == "dev" {
+		logrus.Warnf("[Upgrade] Development build, skipping the version check for %s", targetVersion)
+		return nil
+	}
+	newer, err := isNewerVersion(targetVersion, u.currentVersion)
+	if err != nil {
+		return err
+	}
+	if !newer {
+		return fmt.Errorf("refusing to install %s, it is not newer than the running version %s", targetVersion, u.currentVersion)
+	}
+	return nil
+}
+
+// isNewerVersion reports whether target is newer than current. Both must
+// be release ("v1.2.3") or date ("20251026") builds; the commit suffix is
+// ignored, so two builds of the same release are not ordered.
+func isNewerVersion(target, current string) (bool, error) {
+	t, tDate, err := parseAgentVersion(target)
+	if err != nil {
+		return false, err
+	}
+	c, cDate, err := parseAgentVersion(current)
+	if err != nil {
+		return false, err
+	}
+	if tDate != cDate {
+		return false, fmt.Errorf("cannot order versions %s and %s", target, current)
+	}
+	return t.GT(c), nil
+}
+
+func parseAgentVersion(v string) (semver.Version, bool, error) {
+	core := commitSuffixPattern.ReplaceAllString(v, "")
+	parsed, err := semver.ParseTolerant(core)
+	if err != nil {
+		return semver.Version{}, false, fmt.Errorf("invalid agent version %q: %w", v, err)
+	}
+	return parsed, dateVersionPattern.MatchString(core), nil
+}
+
+// signedUpdateMessage returns the bytes an update signature covers: the
+// version, the platform and the hex SHA-256 of the binary on separate lines.
+// Binding version and platform keeps a signature from being reused for
+// another release or build. The agent-sign task produces the same message.
+func signedUpdateMessage(version, goos, goarch string, digest []byte) []byte {
+	return []byte(fmt.Sprintf("%s\n%s/%s\n%s", version, goos, goarch, hex.EncodeToString(digest)))
+}
+
+// verifySignature checks the Ed25519 signature of the signed update message
+func (u *Updater) verifySignature(message []byte, signature string) error {
+	if u.publicKey == nil {
+		if u.allowUnsigned {
+			logrus.Warn("[Upgrade] No update public key configured, installing unverified binary")
+			return nil
+		}
+		return errors.New("no update public key configured (use --update-public-key or --allow-unsigned-update)")
+	}
+	if signature == "" {
+		if u.allowUnsigned {
+			logrus.Warn("[Upgrade] Agent binary is not signed, installing unverified binary")
+			return nil
+		}
+		return errors.New("agent binary is not signed")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !ed25519.Verify(u.publicKey, message, sig) {
+		return errors.New("agent binary signature is invalid")
+	}
+	return nil
+}
+
+// install records the upgrade and swaps the new binary in. The running
+// binary is kept as backup until the upgrade is confirmed.
+func (u *Updater) install(newBinary, targetVersion string) error {
+	backup := u.exe + upgradeBackupSuffix
+	marker := &upgradeMarker{
+		FromVersion: u.currentVersion,
+		ToVersion:   targetVersion,
+		Backup:      backup,
+		StartedAt:   time.Now(),
+	}
+	if err := writeUpgradeMarker(u.markerPath(), marker); err != nil {
+		return err
+	}
+
+	if err := os.Rename(u.exe, backup); err != nil {
+		os.Remove(u.markerPath())
+		return fmt.Errorf("failed to back up agent binary: %w", err)
+	}
+	if err := os.Rename(newBinary, u.exe); err != nil {
+		if restoreErr := os.Rename(backup, u.exe); restoreErr != nil {
+			logrus.Errorf("[Upgrade] Failed to restore agent binary: %v", restoreErr)
+		}
+		os.Remove(u.markerPath())
+		return fmt.Errorf("failed to install agent binary: %w", err)
+	}
+	return nil
+}
+
+// CheckPendingUpgrade runs at startup. After an upgrade it counts the start
+// and arms the rollback watchdog, or rolls back right away when the new
+// binary keeps failing to start.
+func (u *Updater) CheckPendingUpgrade() {
+	marker, err := readUpgradeMarker(u.markerPath())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Warnf("[Upgrade] Ignoring unreadable upgrade marker: %v", err)
+			os.Remove(u.markerPath())
+		}
+		return
+	}
+
+	if u.currentVersion != marker.ToVersion {
+		// Rolled back, or replaced by other means
+		if u.currentVersion == marker.FromVersion {
+			logrus.Warnf("[Upgrade] Upgrade to %s was rolled back, running %s", marker.ToVersion, u.currentVersion)
+		}
+		os.Remove(u.markerPath())
+		return
+	}
+
+	marker.Attempts++
+	if marker.Attempts > maxUpgradeAttempts {
+		logrus.Errorf("[Upgrade] Agent %s failed to start %d times, rolling back to %s", marker.ToVersion, maxUpgradeAttempts, marker.FromVersion)
+		u.rollback()
+		return
+	}
+	if err := writeUpgradeMarker(u.markerPath(), marker); err != nil {
+		logrus.Warnf("[Upgrade] %v", err)
+	}
+
+	logrus.Infof("[Upgrade] Upgraded from %s, waiting for the server to confirm", marker.FromVersion)
+	u.mu.Lock()
+	u.confirm = make(chan struct{})
+	confirm := u.confirm
+	u.mu.Unlock()
+
+	go func() {
+		select {
+		case <-confirm:
+		case <-time.After(upgradeConfirmTimeout):
+			logrus.Errorf("[Upgrade] Agent %s did not register within %s, rolling back to %s", marker.ToVersion, upgradeConfirmTimeout, marker.FromVersion)
+			u.rollback()
+		}
+	}()
+}
+
+// ConfirmUpgrade is called after registering with the server and makes a
+// pending upgrade permanent
+func (u *Updater) ConfirmUpgrade() {
+	u.mu.Lock()
+	confirm := u.confirm
+	u.confirm = nil
+	u.mu.Unlock()
+	if confirm == nil {
+		return
+	}
+	close(confirm)
+
+	if marker, err := readUpgradeMarker(u.markerPath()); err == nil && marker.Backup != "" {
+		os.Remove(marker.Backup)
+	}
+	os.Remove(u.markerPath())
+	logrus.Infof("[Upgrade] Upgrade to %s confirmed", u.currentVersion)
+}
+
+// rollback restores the previous binary and restarts it
+func (u *Updater) rollback() {
+	if !u.rollbackFiles() {
+		return
+	}
+	if err := u.restart(u.exe); err != nil {
+		logrus.Errorf("[Upgrade] Failed to restart previous agent: %v", err)
+	}
+}
+
+// rollbackFiles puts the backup binary back in place. The marker is kept so
+// that the restored binary recognises the rollback.
+func (u *Updater) rollbackFiles() bool {
+	marker, err := readUpgradeMarker(u.markerPath())
+	if err != nil {
+		logrus.Errorf("[Upgrade] Cannot roll back: %v", err)
+		return false
+	}
+	if _, err := os.Stat(marker.Backup); err != nil {
+		logrus.Errorf("[Upgrade] Cannot roll back, backup binary is missing: %v", err)
+		os.Remove(u.markerPath())
+		return false
+	}
+
+	// A running binary can be renamed but not overwritten on every platform
+	failed := u.exe + ".failed"
+	if err := os.Rename(u.exe, failed); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logrus.Errorf("[Upgrade] Cannot roll back: %v", err)
+		return false
+	}
+	if err := os.Rename(marker.Backup, u.exe); err != nil {
+		logrus.Errorf("[Upgrade] Cannot roll back: %v", err)
+		os.Rename(failed, u.exe)
+		return false
+	}
+	os.Remove(failed)
+	return true
+}
+
+func readUpgradeMarker(path string) (*upgradeMarker, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var marker upgradeMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, err
+	}
+	return &marker, nil
+}
+
+func writeUpgradeMarker(path string, marker *upgradeMarker) error {
+	data, err := json.Marshal(marker)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, 0o600)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ysicing/tiga/proto"
+)
+
+type binaryStream struct {
+	grpc.ClientStream
+	chunks []*proto.AgentBinaryChunk
+}
+
+func (s *binaryStream) Recv() (*proto.AgentBinaryChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+type upgradeTestClient struct {
+	proto.HostMonitorClient
+	content   []byte
+	signature string
+	sha256    string
+	req       *proto.DownloadAgentBinaryRequest
+}
+
+func (c *upgradeTestClient) DownloadAgentBinary(_ context.Context, req *proto.DownloadAgentBinaryRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[proto.AgentBinaryChunk], error) {
+	c.req = req
+	half := len(c.content) / 2
+	return &binaryStream{chunks: []*proto.AgentBinaryChunk{
+		{Size: int64(len(c.content)), Sha256: c.sha256, Signature: c.signature, Data: c.content[:half]},
+		{Data: c.content[half:]},
+	}}, nil
+}
+
+// newUpgradeTestClient serves content signed by key for version v2 on the
+// running platform
+func newUpgradeTestClient(content string, key ed25519.PrivateKey) *upgradeTestClient {
+	return newSignedUpgradeTestClient(content, key, "v2", runtime.GOOS, runtime.GOARCH)
+}
+
+func newSignedUpgradeTestClient(content string, key ed25519.PrivateKey, version, goos, goarch string) *upgradeTestClient {
+	sum := sha256.Sum256([]byte(content))
+	c := &upgradeTestClient{content: []byte(content), sha256: hex.EncodeToString(sum[:])}
+	if key != nil {
+		message := signedUpdateMessage(version, goos, goarch, sum[:])
+		c.signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, message))
+	}
+	return c
+}
+
+// newTestUpdater installs a fake agent binary of version v1 in a temp dir
+func newTestUpdater(t *testing.T, publicKey ed25519.PublicKey) (*Updater, *[]string) {
+	exe := filepath.Join(t.TempDir(), "tiga-agent")
+	require.NoError(t, os.WriteFile(exe, []byte("agent v1"), 0o755))
+	restarts := &[]string{}
+	return &Updater{
+		exe:            exe,
+		currentVersion: "v1",
+		publicKey:      publicKey,
+		restart: func(exe string) error {
+			data, err := os.ReadFile(exe)
+			*restarts = append(*restarts, string(data))
+			return err
+		},
+	}, restarts
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestUpdater_Upgrade(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	u, restarts := newTestUpdater(t, pub)
+
+	client := newUpgradeTestClient("agent v2", key)
+	require.NoError(t, u.Upgrade(client, "host-1", "v2"))
+	assert.Equal(t, "host-1", client.req.Uuid)
+	assert.Equal(t, "v2", client.req.Version)
+
+	assert.Equal(t, []string{"agent v2"}, *restarts)
+	assert.Equal(t, "agent v2", readFile(t, u.exe))
+	assert.Equal(t, "agent v1", readFile(t, u.exe+upgradeBackupSuffix))
+	info, err := os.Stat(u.exe)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	marker, err := readUpgradeMarker(u.markerPath())
+	require.NoError(t, err)
+	assert.Equal(t, "v1", marker.FromVersion)
+	assert.Equal(t, "v2", marker.ToVersion)
+
+	// Only the agent binary, its backup and the marker are left
+	entries, err := os.ReadDir(filepath.Dir(u.exe))
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestUpdater_RejectsBadBinaries(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tampered := newUpgradeTestClient("agent v2", key)
+	tampered.content = []byte("agent v2 with a backdoor")
+
+	tests := []struct {
+		name    string
+		client  *upgradeTestClient
+		key     ed25519.PublicKey
+		unsafe  bool
+		wantErr string
+	}{
+		{name: "tampered", client: tampered, key: pub, wantErr: "checksum mismatch"},
+		{name: "wrong key", client: newUpgradeTestClient("agent v2", otherKey), key: pub, wantErr: "signature is invalid"},
+		{name: "signed for another version", client: newSignedUpgradeTestClient("agent v2", key, "v3", runtime.GOOS, runtime.GOARCH), key: pub, wantErr: "signature is invalid"},
+		{name: "signed for another platform", client: newSignedUpgradeTestClient("agent v2", key, "v2", "plan9", "mips"), key: pub, wantErr: "signature is invalid"},
+		{name: "unsigned", client: newUpgradeTestClient("agent v2", nil), key: pub, wantErr: "not signed"},
+		{name: "no public key", client: newUpgradeTestClient("agent v2", key), wantErr: "no update public key"},
+		{name: "unsigned allowed", client: newUpgradeTestClient("agent v2", nil), key: pub, unsafe: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, restarts := newTestUpdater(t, tt.key)
+			u.allowUnsigned = tt.unsafe
+			err := u.Upgrade(tt.client, "host-1", "v2")
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				assert.Len(t, *restarts, 1)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, *restarts)
+			assert.Equal(t, "agent v1", readFile(t, u.exe))
+			entries, err := os.ReadDir(filepath.Dir(u.exe))
+			require.NoError(t, err)
+			assert.Len(t, entries, 1, "no leftovers")
+		})
+	}
+
+	// A validly signed older or equal release is refused before downloading
+	for _, target := range []string{"v1", "v0.9.0"} {
+		u, _ := newTestUpdater(t, pub)
+		client := newSignedUpgradeTestClient("agent old", key, target, runtime.GOOS, runtime.GOARCH)
+		err := u.Upgrade(client, "host-1", target)
+		require.Error(t, err, target)
+		assert.Contains(t, err.Error(), "not newer")
+		assert.Nil(t, client.req)
+	}
+}
+
+func TestIsNewerVersion(t *testing.T) {
+	tests := []struct {
+		target, current string
+		want            bool
+		wantErr         bool
+	}{
+		{target: "v1.2.4-a1b2c3d", current: "v1.2.3-a1b2c3d", want: true},
+		{target: "v1.10.0", current: "v1.9.9", want: true},
+		{target: "v1.2.3-0000000", current: "v1.2.3-a1b2c3d", want: false},
+		{target: "v1.2.2-a1b2c3d", current: "v1.2.3-a1b2c3d", want: false},
+		{target: "v1.3.0-rc.1-a1b2c3d", current: "v1.2.3-a1b2c3d", want: true},
+		{target: "v1.3.0-a1b2c3d", current: "v1.3.0-rc.1-a1b2c3d", want: true},
+		{target: "20251027-a1b2c3d", current: "20251026-b2c3d4e", want: true},
+		{target: "20251025-a1b2c3d", current: "20251026-b2c3d4e", want: false},
+		{target: "20251027-a1b2c3d", current: "v1.2.3-a1b2c3d", wantErr: true},
+		{target: "latest", current: "v1.2.3", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := isNewerVersion(tt.target, tt.current)
+		if tt.wantErr {
+			assert.Error(t, err, "%s over %s", tt.target, tt.current)
+			continue
+		}
+		require.NoError(t, err, "%s over %s", tt.target, tt.current)
+		assert.Equal(t, tt.want, got, "%s over %s", tt.target, tt.current)
+	}
+}
+
+func TestUpdater_ConfirmAndRollback(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	// The new binary registered: the upgrade becomes permanent
+	u, _ := newTestUpdater(t, pub)
+	require.NoError(t, u.Upgrade(newUpgradeTestClient("agent v2", key), "host-1", "v2"))
+	u.currentVersion = "v2"
+	u.CheckPendingUpgrade()
+	marker, err := readUpgradeMarker(u.markerPath())
+	require.NoError(t, err)
+	assert.Equal(t, 1, marker.Attempts)
+	u.ConfirmUpgrade()
+	assert.NoFileExists(t, u.markerPath())
+	assert.NoFileExists(t, u.exe+upgradeBackupSuffix)
+	assert.Equal(t, "agent v2", readFile(t, u.exe))
+
+	// The new binary keeps failing to start: the previous one is restored
+	u, restarts := newTestUpdater(t, pub)
+	require.NoError(t, u.Upgrade(newUpgradeTestClient("agent v2", key), "host-1", "v2"))
+	u.currentVersion = "v2"
+	for i := 0; i <= maxUpgradeAttempts; i++ {
+		u.CheckPendingUpgrade()
+	}
+	assert.Equal(t, []string{"agent v2", "agent v1"}, *restarts)
+	assert.Equal(t, "agent v1", readFile(t, u.exe))
+	assert.NoFileExists(t, u.exe+upgradeBackupSuffix)
+
+	// The restored binary clears the marker
+	u.currentVersion = "v1"
+	u.CheckPendingUpgrade()
+	assert.NoFileExists(t, u.markerPath())
+}
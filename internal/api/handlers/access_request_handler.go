@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/auth"
+)
+
+// AccessRequestHandler handles just-in-time access request endpoints
+type AccessRequestHandler struct {
+	accessService *auth.AccessRequestService
+}
+
+// NewAccessRequestHandler creates a new access request handler
+func NewAccessRequestHandler(accessService *auth.AccessRequestService) *AccessRequestHandler {
+	return &AccessRequestHandler{accessService: accessService}
+}
+
+// ListAccessRequestsRequest represents a request to list access requests
+type ListAccessRequestsRequest struct {
+	Status   string `form:"status"`
+	All      bool   `form:"all"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"page_size" binding:"max=100"`
+}
+
+// AccessDecisionRequest carries the approver's note
+type AccessDecisionRequest struct {
+	Note string `json:"note"`
+}
+
+// CreateRequest submits a new access request
+// @Summary Request privileged access
+// @Description Request time-bound exec or WebSSH access that an admin must approve
+// @Tags access-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body auth.CreateAccessRequest true "Access request"
+// @Success 201 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/access-requests [post]
+func (h *AccessRequestHandler) CreateRequest(c *gin.Context) {
+	var req auth.CreateAccessRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	user := c.MustGet("user").(models.User)
+	accessReq, err := h.accessService.Create(c.Request.Context(), &user, &req)
+	if err != nil {
+		RespondBadRequest(c, err)
+		return
+	}
+
+	RespondCreated(c, accessReq)
+}
+
+// ListRequests lists access requests
+// @Summary List access requests
+// @Description Regular users see their own requests; admins may pass all=true to see everyone's
+// @Tags access-requests
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status"
+// @Param all query bool false "List requests of all users (admin only)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /api/v1/access-requests [get]
+func (h *AccessRequestHandler) ListRequests(c *gin.Context) {
+	var req ListAccessRequestsRequest
+	if !BindQuery(c, &req) {
+		return
+	}
+
+	req.Page = defaultInt(req.Page, 1)
+	req.PageSize = clamp(defaultInt(req.PageSize, 20), 1, 100)
+
+	user := c.MustGet("user").(models.User)
+	filter := auth.AccessRequestFilter{
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	if !req.All || !user.IsAdmin {
+		filter.RequesterID = &user.ID
+	}
+
+	requests, total, err := h.accessService.List(c.Request.Context(), filter)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+
+	RespondPaginated(c, requests, req.Page, req.PageSize, total)
+}
+
+// GetRequest returns a single access request
+// @Summary Get access request
+// @Tags access-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Access request ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/access-requests/{id} [get]
+func (h *AccessRequestHandler) GetRequest(c *gin.Context) {
+	id, ok := h.parseID(c)
+	if !ok {
+		return
+	}
+
+	accessReq, err := h.accessService.Get(c.Request.Context(), id)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	user := c.MustGet("user").(models.User)
+	if !user.IsAdmin && accessReq.RequesterID != user.ID {
+		RespondNotFound(c, auth.ErrAccessRequestNotFound)
+		return
+	}
+
+	RespondSuccess(c, accessReq)
+}
+
+// CancelRequest withdraws the caller's pending request
+// @Summary Cancel access request
+// @Tags access-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Access request ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/access-requests/{id}/cancel [post]
+func (h *AccessRequestHandler) CancelRequest(c *gin.Context) {
+	id, ok := h.parseID(c)
+	if !ok {
+		return
+	}
+
+	user := c.MustGet("user").(models.User)
+	accessReq, err := h.accessService.Cancel(c.Request.Context(), id, user.ID)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	RespondSuccess(c, accessReq)
+}
+
+// ApproveRequest approves a pending request (admin only)
+// @Summary Approve access request
+// @Tags access-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Access request ID"
+// @Param request body AccessDecisionRequest false "Decision note"
+// @Success 200 {object} SuccessResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/access-requests/{id}/approve [post]
+func (h *AccessRequestHandler) ApproveRequest(c *gin.Context) {
+	h.decide(c, h.accessService.Approve)
+}
+
+// RejectRequest rejects a pending request (admin only)
+// @Summary Reject access request
+// @Tags access-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Access request ID"
+// @Param request body AccessDecisionRequest false "Decision note"
+// @Success 200 {object} SuccessResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/access-requests/{id}/reject [post]
+func (h *AccessRequestHandler) RejectRequest(c *gin.Context) {
+	h.decide(c, h.accessService.Reject)
+}
+
+// RevokeRequest revokes an approved grant before it expires (admin only)
+// @Summary Revoke access grant
+// @Tags access-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Access request ID"
+// @Param request body AccessDecisionRequest false "Revocation note"
+// @Success 200 {object} SuccessResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/access-requests/{id}/revoke [post]
+func (h *AccessRequestHandler) RevokeRequest(c *gin.Context) {
+	h.decide(c, h.accessService.Revoke)
+}
+
+// accessDecisionFunc is the shape shared by Approve, Reject and Revoke
+type accessDecisionFunc func(ctx context.Context, id uuid.UUID, actor *models.User, note string) (*models.AccessRequest, error)
+
+func (h *AccessRequestHandler) decide(c *gin.Context, fn accessDecisionFunc) {
+	id, ok := h.parseID(c)
+	if !ok {
+		return
+	}
+
+	var req AccessDecisionRequest
+	if c.Request.ContentLength > 0 && !BindJSON(c, &req) {
+		return
+	}
+
+	user := c.MustGet("user").(models.User)
+	accessReq, err := fn(c.Request.Context(), id, &user, req.Note)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	RespondSuccess(c, accessReq)
+}
+
+func (h *AccessRequestHandler) parseID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		RespondBadRequest(c, fmt.Errorf("invalid access request ID"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *AccessRequestHandler) respondServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, auth.ErrAccessRequestNotFound):
+		RespondNotFound(c, err)
+	case errors.Is(err, auth.ErrAccessRequestNotPending), errors.Is(err, auth.ErrAccessRequestNotActive):
+		RespondConflict(c, err)
+	case errors.Is(err, auth.ErrAccessRequestSelfReview):
+		RespondForbidden(c, err)
+	default:
+		RespondInternalError(c, err)
+	}
+}
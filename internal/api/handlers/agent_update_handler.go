@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/api/middleware"
+	"github.com/ysicing/tiga/internal/services/host"
+)
+
+// AgentUpdateHandler handles agent binary uploads and upgrade rollouts
+type AgentUpdateHandler struct {
+	binaries *host.AgentBinaryStore
+	rollouts *host.AgentRolloutService
+}
+
+// NewAgentUpdateHandler creates a new agent update handler
+func NewAgentUpdateHandler(binaries *host.AgentBinaryStore, rollouts *host.AgentRolloutService) *AgentUpdateHandler {
+	return &AgentUpdateHandler{
+		binaries: binaries,
+		rollouts: rollouts,
+	}
+}
+
+// ListBinaries godoc
+// @Summary List agent binaries available for self-update
+// @Tags VMs
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/agent-binaries [get]
+func (h *AgentUpdateHandler) ListBinaries(c *gin.Context) {
+	binaries, err := h.binaries.List()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to list agent binaries",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "success",
+		"data": gin.H{
+			"items": binaries,
+			"total": len(binaries),
+		},
+	})
+}
+
+// UploadBinary godoc
+// @Summary Upload an agent binary
+// @Description Multipart upload of a tiga-agent build. signature is the base64 Ed25519 signature of the version, "<os>/<arch>" and hex SHA-256 joined by newlines; agents refuse versions that are not newer than their own.
+// @Tags VMs
+// @Accept multipart/form-data
+// @Produce json
+// @Param version formData string true "Agent version"
+// @Param os formData string true "GOOS"
+// @Param arch formData string true "GOARCH"
+// @Param signature formData string false "Signature"
+// @Param file formData file true "Agent binary"
+// @Success 201 {object} map[string]interface{}
+// @Router /api/v1/vms/agent-binaries [post]
+func (h *AgentUpdateHandler) UploadBinary(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Agent binary file is required",
+		})
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to read upload",
+		})
+		return
+	}
+	defer f.Close()
+
+	binary, err := h.binaries.Save(c.PostForm("version"), c.PostForm("os"), c.PostForm("arch"), f, c.PostForm("signature"))
+	if errors.Is(err, host.ErrInvalidAgentBinary) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to store agent binary",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"code":    0,
+		"message": "Agent binary uploaded",
+		"data":    binary,
+	})
+}
+
+// CreateRollout godoc
+// @Summary Start an agent upgrade rollout
+// @Tags VMs
+// @Accept json
+// @Produce json
+// @Param rollout body host.CreateRolloutRequest true "Rollout target"
+// @Success 201 {object} map[string]interface{}
+// @Router /api/v1/vms/agent-rollouts [post]
+func (h *AgentUpdateHandler) CreateRollout(c *gin.Context) {
+	var req host.CreateRolloutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Invalid request parameters",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	username, _ := middleware.GetUsername(c)
+	rollout, err := h.rollouts.CreateRollout(c.Request.Context(), &req, username)
+	if err != nil {
+		switch {
+		case errors.Is(err, host.ErrInvalidRollout),
+			errors.Is(err, host.ErrRolloutNoHosts),
+			errors.Is(err, host.ErrRolloutVersionUnavailable):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    40001,
+				"message": err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"code":    50001,
+				"message": "Failed to create rollout",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"code":    0,
+		"message": "Rollout started",
+		"data":    rollout,
+	})
+}
+
+// ListRollouts godoc
+// @Summary List agent upgrade rollouts
+// @Tags VMs
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/agent-rollouts [get]
+func (h *AgentUpdateHandler) ListRollouts(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	rollouts, total, err := h.rollouts.ListRollouts(c.Request.Context(), page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to list rollouts",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "success",
+		"data": gin.H{
+			"items": rollouts,
+			"total": total,
+		},
+	})
+}
+
+// GetRollout godoc
+// @Summary Get an agent upgrade rollout with per-host status
+// @Tags VMs
+// @Produce json
+// @Param id path string true "Rollout ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/agent-rollouts/{id} [get]
+func (h *AgentUpdateHandler) GetRollout(c *gin.Context) {
+	id, ok := parseRolloutID(c)
+	if !ok {
+		return
+	}
+
+	rollout, err := h.rollouts.GetRollout(c.Request.Context(), id)
+	if err != nil {
+		respondRolloutError(c, err, "Failed to get rollout")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "success",
+		"data":    rollout,
+	})
+}
+
+// CancelRollout godoc
+// @Summary Cancel an agent upgrade rollout
+// @Tags VMs
+// @Produce json
+// @Param id path string true "Rollout ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/agent-rollouts/{id}/cancel [post]
+func (h *AgentUpdateHandler) CancelRollout(c *gin.Context) {
+	id, ok := parseRolloutID(c)
+	if !ok {
+		return
+	}
+
+	rollout, err := h.rollouts.CancelRollout(c.Request.Context(), id)
+	if err != nil {
+		respondRolloutError(c, err, "Failed to cancel rollout")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "Rollout cancelled",
+		"data":    rollout,
+	})
+}
+
+func parseRolloutID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Invalid rollout ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func respondRolloutError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, host.ErrRolloutNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    40404,
+			"message": "Rollout not found",
+		})
+	case errors.Is(err, host.ErrRolloutNotRunning):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": message,
+		})
+	}
+}
@@ -3,7 +3,9 @@ package audit
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -11,6 +13,7 @@ import (
 	"github.com/ysicing/tiga/internal/api/handlers"
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/repository"
+	auditservice "github.com/ysicing/tiga/internal/services/audit"
 )
 
 // EventHandler handles audit event query endpoints
@@ -20,13 +23,15 @@ import (
 //
 //	.claude/specs/006-gitness-tiga/contracts/audit_api.yaml
 type EventHandler struct {
-	eventRepo repository.AuditEventRepository
+	eventRepo  repository.AuditEventRepository
+	signingKey []byte // HMAC key for signed export bundles
 }
 
 // NewEventHandler creates a new audit event handler
-func NewEventHandler(eventRepo repository.AuditEventRepository) *EventHandler {
+func NewEventHandler(eventRepo repository.AuditEventRepository, signingKey string) *EventHandler {
 	return &EventHandler{
-		eventRepo: eventRepo,
+		eventRepo:  eventRepo,
+		signingKey: []byte(signingKey),
 	}
 }
 
@@ -237,3 +242,93 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 		"data": event,
 	})
 }
+
+// VerifyChain godoc
+// @Summary Verify audit hash chain
+// @Description Walk audit events in sequence order and report gaps, edited events and broken links
+// @Tags audit
+// @Produce json
+// @Param after_sequence query int false "Only verify events after this sequence"
+// @Success 200 {object} object{data=auditservice.ChainVerification}
+// @Failure 401 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /audit/events/verify [get]
+// @Security BearerAuth
+func (h *EventHandler) VerifyChain(c *gin.Context) {
+	afterSequence, err := strconv.ParseInt(c.DefaultQuery("after_sequence", "0"), 10, 64)
+	if err != nil || afterSequence < 0 {
+		handlers.RespondErrorWithMessage(c, http.StatusBadRequest, fmt.Errorf("invalid after_sequence"), "Invalid after_sequence value")
+		return
+	}
+
+	result, err := auditservice.VerifyChain(c.Request.Context(), h.eventRepo, afterSequence)
+	if err != nil {
+		logrus.Errorf("Failed to verify audit chain: %v", err)
+		handlers.RespondErrorWithMessage(c, http.StatusInternalServerError, err, "Failed to verify audit chain")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// ExportEvents godoc
+// @Summary Export audit events
+// @Description Export audit events as JSON, CSV or a signed bundle (zip with manifest and HMAC signature)
+// @Tags audit
+// @Produce application/json
+// @Produce text/csv
+// @Produce application/zip
+// @Param format query string false "Export format" Enums(json, csv, bundle)
+// @Param subsystem query string false "Filter by subsystem"
+// @Param start_time query int false "Start time (Unix milliseconds)"
+// @Param end_time query int false "End time (Unix milliseconds)"
+// @Success 200 {file} file
+// @Failure 400 {object} handlers.ErrorResponse
+// @Router /audit/events/export [get]
+// @Security BearerAuth
+func (h *EventHandler) ExportEvents(c *gin.Context) {
+	format := c.DefaultQuery("format", auditservice.ExportFormatJSON)
+
+	filters := make(map[string]interface{})
+	if subsystem := c.Query("subsystem"); subsystem != "" {
+		filters["subsystem"] = subsystem
+	}
+	for _, key := range []string{"start_time", "end_time"} {
+		if value := c.Query(key); value != "" {
+			ms, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				handlers.RespondErrorWithMessage(c, http.StatusBadRequest, err, "Invalid "+key+" format")
+				return
+			}
+			filters[key] = ms
+		}
+	}
+
+	events, err := h.eventRepo.List(c.Request.Context(), filters)
+	if err != nil {
+		logrus.Errorf("Failed to list audit events for export: %v", err)
+		handlers.RespondErrorWithMessage(c, http.StatusInternalServerError, err, "Failed to list audit events")
+		return
+	}
+
+	// Export in chain order
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Sequence != events[j].Sequence {
+			return events[i].Sequence < events[j].Sequence
+		}
+		return events[i].Timestamp < events[j].Timestamp
+	})
+
+	data, err := auditservice.ExportEvents(events, format, h.signingKey)
+	if err != nil {
+		handlers.RespondErrorWithMessage(c, http.StatusBadRequest, err, "Failed to export audit events")
+		return
+	}
+
+	contentType, ext := auditservice.ExportContentType(format)
+	filename := fmt.Sprintf("audit-events-%s.%s", time.Now().UTC().Format("20060102-150405"), ext)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, contentType, data)
+}
@@ -0,0 +1,337 @@
+package cluster
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/api/middleware"
+	"github.com/ysicing/tiga/internal/repository"
+	k8sservices "github.com/ysicing/tiga/internal/services/k8s"
+)
+
+// CompareHandler serves multi-cluster comparisons and saved baselines
+type CompareHandler struct {
+	comparator   *k8sservices.ClusterComparator
+	baselineRepo *repository.ClusterBaselineRepository
+	baselines    *k8sservices.BaselineService
+}
+
+// NewCompareHandler creates a new CompareHandler instance
+func NewCompareHandler(
+	comparator *k8sservices.ClusterComparator,
+	baselineRepo *repository.ClusterBaselineRepository,
+	baselines *k8sservices.BaselineService,
+) *CompareHandler {
+	return &CompareHandler{
+		comparator:   comparator,
+		baselineRepo: baselineRepo,
+		baselines:    baselines,
+	}
+}
+
+// Compare godoc
+// @Summary Compare resources between two clusters
+// @Description Normalized diff of a namespace (or selected objects) between two clusters. Status, managedFields and server-generated fields are ignored; Secret values are compared by hash.
+// @Tags k8s-compare
+// @Accept json
+// @Produce json
+// @Param request body k8s.CompareRequest true "Comparison scope"
+// @Success 200 {object} map[string]interface{} "code=200, data={summary, items:[]}"
+// @Failure 400 {object} map[string]interface{} "code=400, message=Invalid parameters"
+// @Router /api/v1/k8s/compare [post]
+// @Security Bearer
+func (h *CompareHandler) Compare(c *gin.Context) {
+	var req k8sservices.CompareRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	result, err := h.comparator.Compare(c.Request.Context(), &req)
+	if err != nil {
+		logrus.Warnf("Cluster comparison failed: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Comparison completed",
+		"data":    result,
+	})
+}
+
+// ListBaselines godoc
+// @Summary List baselines
+// @Tags k8s-compare
+// @Produce json
+// @Param cluster_id query string false "Only baselines captured from or checked against this cluster"
+// @Success 200 {object} map[string]interface{} "code=200, data={items:[], total:int}"
+// @Router /api/v1/k8s/baselines [get]
+// @Security Bearer
+func (h *CompareHandler) ListBaselines(c *gin.Context) {
+	var clusterID *uuid.UUID
+	if idStr := c.Query("cluster_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    http.StatusBadRequest,
+				"message": "Invalid cluster ID format",
+			})
+			return
+		}
+		clusterID = &id
+	}
+
+	baselines, err := h.baselineRepo.List(c.Request.Context(), clusterID)
+	if err != nil {
+		logrus.Errorf("Failed to list baselines: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    http.StatusInternalServerError,
+			"message": "Failed to list baselines",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Baselines retrieved successfully",
+		"data": gin.H{
+			"items": baselines,
+			"total": len(baselines),
+		},
+	})
+}
+
+// CreateBaseline godoc
+// @Summary Create a baseline
+// @Description Capture a normalized snapshot of a namespace and save it as a baseline for drift checks
+// @Tags k8s-compare
+// @Accept json
+// @Produce json
+// @Param request body k8s.CreateBaselineRequest true "Baseline"
+// @Success 201 {object} map[string]interface{} "code=201, data={baseline}"
+// @Router /api/v1/k8s/baselines [post]
+// @Security Bearer
+func (h *CompareHandler) CreateBaseline(c *gin.Context) {
+	var req k8sservices.CreateBaselineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	baseline, err := h.baselines.Create(c.Request.Context(), &req, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"code":    http.StatusCreated,
+		"message": "Baseline created successfully",
+		"data":    baseline,
+	})
+}
+
+// GetBaseline godoc
+// @Summary Get a baseline
+// @Description Get a baseline together with its stored snapshot
+// @Tags k8s-compare
+// @Produce json
+// @Param baseline_id path string true "Baseline ID (UUID)"
+// @Success 200 {object} map[string]interface{} "code=200, data={baseline, snapshot:[]}"
+// @Router /api/v1/k8s/baselines/{baseline_id} [get]
+// @Security Bearer
+func (h *CompareHandler) GetBaseline(c *gin.Context) {
+	id, ok := parseBaselineID(c)
+	if !ok {
+		return
+	}
+
+	baseline, err := h.baselineRepo.GetByID(c.Request.Context(), id)
+	if err != nil {
+		respondBaselineError(c, err)
+		return
+	}
+
+	snapshot, err := h.baselines.Snapshot(baseline)
+	if err != nil {
+		respondBaselineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Baseline retrieved successfully",
+		"data": gin.H{
+			"baseline": baseline,
+			"snapshot": snapshot,
+		},
+	})
+}
+
+// UpdateBaseline godoc
+// @Summary Update baseline drift check settings
+// @Tags k8s-compare
+// @Accept json
+// @Produce json
+// @Param baseline_id path string true "Baseline ID (UUID)"
+// @Param request body k8s.UpdateBaselineRequest true "Settings"
+// @Success 200 {object} map[string]interface{} "code=200, data={baseline}"
+// @Router /api/v1/k8s/baselines/{baseline_id} [put]
+// @Security Bearer
+func (h *CompareHandler) UpdateBaseline(c *gin.Context) {
+	id, ok := parseBaselineID(c)
+	if !ok {
+		return
+	}
+
+	var req k8sservices.UpdateBaselineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	baseline, err := h.baselines.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		respondBaselineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Baseline updated successfully",
+		"data":    baseline,
+	})
+}
+
+// DeleteBaseline godoc
+// @Summary Delete a baseline
+// @Tags k8s-compare
+// @Produce json
+// @Param baseline_id path string true "Baseline ID (UUID)"
+// @Success 200 {object} map[string]interface{} "code=200, message=Baseline deleted"
+// @Router /api/v1/k8s/baselines/{baseline_id} [delete]
+// @Security Bearer
+func (h *CompareHandler) DeleteBaseline(c *gin.Context) {
+	id, ok := parseBaselineID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.baselineRepo.Delete(c.Request.Context(), id); err != nil {
+		respondBaselineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Baseline deleted successfully",
+	})
+}
+
+// RecaptureBaseline godoc
+// @Summary Recapture a baseline
+// @Description Replace the stored snapshot with the current state of the source cluster
+// @Tags k8s-compare
+// @Produce json
+// @Param baseline_id path string true "Baseline ID (UUID)"
+// @Success 200 {object} map[string]interface{} "code=200, data={baseline}"
+// @Router /api/v1/k8s/baselines/{baseline_id}/recapture [post]
+// @Security Bearer
+func (h *CompareHandler) RecaptureBaseline(c *gin.Context) {
+	id, ok := parseBaselineID(c)
+	if !ok {
+		return
+	}
+
+	baseline, err := h.baselines.Recapture(c.Request.Context(), id)
+	if err != nil {
+		respondBaselineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Baseline recaptured successfully",
+		"data":    baseline,
+	})
+}
+
+// CheckBaseline godoc
+// @Summary Run a drift check now
+// @Description Compare the live target against the baseline snapshot and return the diff
+// @Tags k8s-compare
+// @Produce json
+// @Param baseline_id path string true "Baseline ID (UUID)"
+// @Success 200 {object} map[string]interface{} "code=200, data={baseline, result}"
+// @Router /api/v1/k8s/baselines/{baseline_id}/check [post]
+// @Security Bearer
+func (h *CompareHandler) CheckBaseline(c *gin.Context) {
+	id, ok := parseBaselineID(c)
+	if !ok {
+		return
+	}
+
+	baseline, result, err := h.baselines.Check(c.Request.Context(), id)
+	if err != nil {
+		respondBaselineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Drift check completed",
+		"data": gin.H{
+			"baseline": baseline,
+			"result":   result,
+		},
+	})
+}
+
+func parseBaselineID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("baseline_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "Invalid baseline ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func respondBaselineError(c *gin.Context, err error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    http.StatusNotFound,
+			"message": "Baseline not found",
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    http.StatusInternalServerError,
+		"message": err.Error(),
+	})
+}
@@ -0,0 +1,335 @@
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/api/middleware"
+	"github.com/ysicing/tiga/internal/repository"
+	k8sservices "github.com/ysicing/tiga/internal/services/k8s"
+)
+
+// maxAuditWebhookBody caps a single audit webhook batch
+const maxAuditWebhookBody = 16 << 20
+
+// EventHandler serves collected cluster events, audit entries and resource timelines
+type EventHandler struct {
+	clusterRepo repository.ClusterRepositoryInterface
+	eventRepo   *repository.ClusterEventRepository
+	timeline    *k8sservices.ClusterTimelineService
+	jwtSecret   string
+}
+
+// NewEventHandler creates a new EventHandler instance
+func NewEventHandler(
+	clusterRepo repository.ClusterRepositoryInterface,
+	eventRepo *repository.ClusterEventRepository,
+	timeline *k8sservices.ClusterTimelineService,
+	jwtSecret string,
+) *EventHandler {
+	return &EventHandler{
+		clusterRepo: clusterRepo,
+		eventRepo:   eventRepo,
+		timeline:    timeline,
+		jwtSecret:   jwtSecret,
+	}
+}
+
+// ListEvents godoc
+// @Summary Search cluster events
+// @Description Search collected Warning events and API server audit entries of a cluster
+// @Tags k8s-events
+// @Produce json
+// @Param id path string true "Cluster ID (UUID)"
+// @Param source query string false "event or audit"
+// @Param kind query string false "Involved object kind (e.g., Deployment)"
+// @Param namespace query string false "Namespace"
+// @Param name query string false "Involved object name"
+// @Param related query bool false "Also match objects named <name>-*"
+// @Param username query string false "Audit username"
+// @Param q query string false "Substring of reason or message"
+// @Param since query string false "Look-back window (e.g., 1h, 30m; default 24h)"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 50, max: 200)"
+// @Success 200 {object} map[string]interface{} "code=200, data={items:[], total:int, page:int, page_size:int}"
+// @Failure 400 {object} map[string]interface{} "code=400, message=Invalid parameters"
+// @Router /api/v1/k8s/clusters/{id}/events [get]
+// @Security Bearer
+func (h *EventHandler) ListEvents(c *gin.Context) {
+	cluster, ok := middleware.GetClusterFromContext(c)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    http.StatusInternalServerError,
+			"message": "Failed to get cluster from context",
+		})
+		return
+	}
+
+	since, err := parseSince(c.Query("since"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	filter := &repository.ClusterEventFilter{
+		Source:         c.Query("source"),
+		Kinds:          k8sservices.KindAliases(c.Query("kind")),
+		Namespace:      c.Query("namespace"),
+		Name:           c.Query("name"),
+		IncludeRelated: c.Query("related") == "true",
+		Type:           c.Query("type"),
+		Username:       c.Query("username"),
+		Query:          c.Query("q"),
+		Since:          &since,
+		Page:           1,
+		PageSize:       50,
+	}
+	if page, err := strconv.Atoi(c.Query("page")); err == nil && page > 0 {
+		filter.Page = page
+	}
+	if pageSize, err := strconv.Atoi(c.Query("page_size")); err == nil && pageSize > 0 {
+		filter.PageSize = pageSize
+		if filter.PageSize > 200 {
+			filter.PageSize = 200
+		}
+	}
+
+	events, total, err := h.eventRepo.Search(c.Request.Context(), cluster.ID, filter)
+	if err != nil {
+		logrus.Errorf("Failed to search cluster events: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    http.StatusInternalServerError,
+			"message": "Failed to search cluster events",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Cluster events retrieved successfully",
+		"data": gin.H{
+			"items":     events,
+			"total":     total,
+			"page":      filter.Page,
+			"page_size": filter.PageSize,
+		},
+	})
+}
+
+// Timeline godoc
+// @Summary Resource timeline
+// @Description Merge Warning events, audit entries and tiga resource history for one resource, newest first
+// @Tags k8s-events
+// @Produce json
+// @Param id path string true "Cluster ID (UUID)"
+// @Param kind query string true "Resource kind (e.g., Deployment)"
+// @Param name query string true "Resource name"
+// @Param namespace query string false "Namespace"
+// @Param related query bool false "Include objects named <name>-* (ReplicaSets, Pods); default true"
+// @Param q query string false "Substring of reason or message"
+// @Param since query string false "Look-back window (e.g., 1h, 30m; default 24h)"
+// @Param limit query int false "Max entries (default: 200, max: 1000)"
+// @Success 200 {object} map[string]interface{} "code=200, data={items:[], total:int}"
+// @Failure 400 {object} map[string]interface{} "code=400, message=Invalid parameters"
+// @Router /api/v1/k8s/clusters/{id}/timeline [get]
+// @Security Bearer
+func (h *EventHandler) Timeline(c *gin.Context) {
+	cluster, ok := middleware.GetClusterFromContext(c)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    http.StatusInternalServerError,
+			"message": "Failed to get cluster from context",
+		})
+		return
+	}
+
+	query := &k8sservices.TimelineQuery{
+		Kind:           c.Query("kind"),
+		Namespace:      c.Query("namespace"),
+		Name:           c.Query("name"),
+		IncludeRelated: c.DefaultQuery("related", "true") == "true",
+		Query:          c.Query("q"),
+		Limit:          200,
+	}
+	if query.Kind == "" || query.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "kind and name are required",
+		})
+		return
+	}
+
+	since, err := parseSince(c.Query("since"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": err.Error(),
+		})
+		return
+	}
+	query.Since = since
+
+	if limit, err := strconv.Atoi(c.Query("limit")); err == nil && limit > 0 {
+		query.Limit = limit
+		if query.Limit > 1000 {
+			query.Limit = 1000
+		}
+	}
+
+	entries, err := h.timeline.ResourceTimeline(c.Request.Context(), cluster.ID, query)
+	if err != nil {
+		logrus.Errorf("Failed to build resource timeline: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    http.StatusInternalServerError,
+			"message": "Failed to build resource timeline",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Resource timeline retrieved successfully",
+		"data": gin.H{
+			"items": entries,
+			"total": len(entries),
+		},
+	})
+}
+
+// GetAuditWebhookConfig godoc
+// @Summary Get audit webhook configuration
+// @Description Return the URL, bearer token and kubeconfig for kube-apiserver --audit-webhook-config-file
+// @Tags k8s-events
+// @Produce json
+// @Param id path string true "Cluster ID (UUID)"
+// @Success 200 {object} map[string]interface{} "code=200, data={url, token, kubeconfig}"
+// @Router /api/v1/k8s/clusters/{id}/audit-webhook [get]
+// @Security Bearer
+func (h *EventHandler) GetAuditWebhookConfig(c *gin.Context) {
+	cluster, ok := middleware.GetClusterFromContext(c)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    http.StatusInternalServerError,
+			"message": "Failed to get cluster from context",
+		})
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	url := fmt.Sprintf("%s://%s/api/v1/k8s/audit-webhook/%s", scheme, c.Request.Host, cluster.ID)
+	token := k8sservices.AuditWebhookToken(h.jwtSecret, cluster.ID)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Audit webhook configuration retrieved successfully",
+		"data": gin.H{
+			"url":        url,
+			"token":      token,
+			"kubeconfig": k8sservices.AuditWebhookKubeconfig(url, token),
+		},
+	})
+}
+
+// ReceiveAuditEvents godoc
+// @Summary Receive Kubernetes audit events
+// @Description Audit webhook backend for kube-apiserver; authenticated with the per-cluster bearer token
+// @Tags k8s-events
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID (UUID)"
+// @Success 200 {object} map[string]interface{} "code=200, data={received:int, stored:int}"
+// @Failure 401 {object} map[string]interface{} "code=401, message=Invalid token"
+// @Router /api/v1/k8s/audit-webhook/{id} [post]
+func (h *EventHandler) ReceiveAuditEvents(c *gin.Context) {
+	clusterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "Invalid cluster ID format",
+		})
+		return
+	}
+
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" || !k8sservices.VerifyAuditWebhookToken(h.jwtSecret, clusterID, token) {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    http.StatusUnauthorized,
+			"message": "Invalid token",
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := h.clusterRepo.GetByID(ctx, clusterID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    http.StatusNotFound,
+			"message": "Cluster not found",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxAuditWebhookBody))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "Failed to read request body",
+		})
+		return
+	}
+
+	events, err := k8sservices.ParseAuditEvents(clusterID, body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	stored := 0
+	for _, event := range events {
+		if err := h.eventRepo.Upsert(ctx, event); err != nil {
+			logrus.Warnf("Failed to store audit event %s for cluster %s: %v", event.UID, clusterID, err)
+			continue
+		}
+		stored++
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    http.StatusOK,
+		"message": "Audit events received",
+		"data": gin.H{
+			"received": len(events),
+			"stored":   stored,
+		},
+	})
+}
+
+// parseSince converts a look-back duration such as "1h" into a start time
+func parseSince(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now().Add(-24 * time.Hour), nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return time.Time{}, fmt.Errorf("invalid since duration: %s", value)
+	}
+	return time.Now().Add(-d), nil
+}
@@ -0,0 +1,325 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/docker"
+
+	basehandlers "github.com/ysicing/tiga/internal/api/handlers"
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// CleanupHandler handles prune operations and cleanup policies
+type CleanupHandler struct {
+	cleanupService *docker.CleanupService
+	agentForwarder *docker.AgentForwarderV2
+	auditHelper    *docker.AuditHelper
+}
+
+// NewCleanupHandler creates a new CleanupHandler
+func NewCleanupHandler(cleanupService *docker.CleanupService, agentForwarder *docker.AgentForwarderV2, auditHelper *docker.AuditHelper) *CleanupHandler {
+	return &CleanupHandler{
+		cleanupService: cleanupService,
+		agentForwarder: agentForwarder,
+		auditHelper:    auditHelper,
+	}
+}
+
+// PruneRequest represents the request body for image, container and build
+// cache pruning
+type PruneRequest struct {
+	All           bool  `json:"all"`             // Images: all unused images; build cache: all unused cache
+	OlderThanDays int32 `json:"older_than_days"` // Only prune objects older than N days, 0 for any age
+	DryRun        bool  `json:"dry_run"`         // Only report reclaimable space
+}
+
+// CleanupPolicyRequest represents the request body for saving a cleanup policy
+type CleanupPolicyRequest struct {
+	Enabled         bool `json:"enabled"`
+	PruneContainers bool `json:"prune_containers"`
+	PruneImages     bool `json:"prune_images"`
+	PruneAllImages  bool `json:"prune_all_images"`
+	PruneBuildCache bool `json:"prune_build_cache"`
+	PruneVolumes    bool `json:"prune_volumes"`
+	OlderThanDays   int  `json:"older_than_days"`
+	DryRun          bool `json:"dry_run"`
+}
+
+// parsePruneRequest parses the instance ID and the optional prune options
+func parsePruneRequest(c *gin.Context) (uuid.UUID, *PruneRequest, bool) {
+	instanceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondError(c, http.StatusBadRequest, fmt.Errorf("invalid instance ID format"))
+		return uuid.Nil, nil, false
+	}
+
+	var req PruneRequest
+	if c.Request.ContentLength > 0 && !basehandlers.BindJSON(c, &req) {
+		return uuid.Nil, nil, false
+	}
+	if req.OlderThanDays < 0 {
+		basehandlers.RespondBadRequest(c, fmt.Errorf("older_than_days must not be negative"))
+		return uuid.Nil, nil, false
+	}
+	return instanceID, &req, true
+}
+
+// logPrune records a prune in the audit log; dry runs change nothing and
+// are not recorded
+func (h *CleanupHandler) logPrune(c *gin.Context, action, resourceType string, instanceID uuid.UUID, req *PruneRequest, deleted int, reclaimed uint64, err error) {
+	if req.DryRun {
+		return
+	}
+	h.auditHelper.LogDockerOperation(c, docker.AuditParams{
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   instanceID,
+		ResourceName: action,
+		InstanceID:   instanceID,
+		ExtraData: map[string]interface{}{
+			"all":             req.All,
+			"older_than_days": req.OlderThanDays,
+			"deleted":         deleted,
+			"space_reclaimed": reclaimed,
+		},
+		Error: err,
+	})
+}
+
+// PruneImages removes dangling or unused images
+// @Summary Prune Docker images
+// @Description Remove dangling images, or all unused images with all. dry_run reports what would be removed and the reclaimable space.
+// @Tags Docker Images
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body PruneRequest false "Prune options"
+// @Success 200 {object} map[string]interface{} "Prune result with space reclaimed"
+// @Failure 400 {object} map[string]interface{} "Invalid request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/docker/instances/{id}/images/prune [post]
+func (h *CleanupHandler) PruneImages(c *gin.Context) {
+	instanceID, req, ok := parsePruneRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.agentForwarder.PruneImages(instanceID, &pb.PruneImagesRequest{
+		All:           req.All,
+		OlderThanDays: req.OlderThanDays,
+		DryRun:        req.DryRun,
+	})
+	h.logPrune(c, models.DockerActionPruneImages, models.DockerResourceTypeImage, instanceID, req, len(resp.GetImagesDeleted()), resp.GetSpaceReclaimed(), err)
+	if err != nil {
+		basehandlers.RespondError(c, http.StatusInternalServerError, fmt.Errorf("failed to prune images: %w", err))
+		return
+	}
+
+	basehandlers.RespondSuccess(c, resp)
+}
+
+// PruneContainers removes stopped containers
+// @Summary Prune stopped Docker containers
+// @Description Remove stopped containers. dry_run reports what would be removed and the reclaimable space.
+// @Tags Docker Containers
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body PruneRequest false "Prune options"
+// @Success 200 {object} map[string]interface{} "Prune result with space reclaimed"
+// @Failure 400 {object} map[string]interface{} "Invalid request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/docker/instances/{id}/containers/prune [post]
+func (h *CleanupHandler) PruneContainers(c *gin.Context) {
+	instanceID, req, ok := parsePruneRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.agentForwarder.PruneContainers(instanceID, &pb.PruneContainersRequest{
+		OlderThanDays: req.OlderThanDays,
+		DryRun:        req.DryRun,
+	})
+	h.logPrune(c, models.DockerActionPruneContainers, models.DockerResourceTypeContainer, instanceID, req, len(resp.GetContainersDeleted()), resp.GetSpaceReclaimed(), err)
+	if err != nil {
+		basehandlers.RespondError(c, http.StatusInternalServerError, fmt.Errorf("failed to prune containers: %w", err))
+		return
+	}
+
+	basehandlers.RespondSuccess(c, resp)
+}
+
+// PruneBuildCache removes unused build cache
+// @Summary Prune Docker build cache
+// @Description Remove build cache not in use. dry_run reports what would be removed and the reclaimable space.
+// @Tags Docker System
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body PruneRequest false "Prune options"
+// @Success 200 {object} map[string]interface{} "Prune result with space reclaimed"
+// @Failure 400 {object} map[string]interface{} "Invalid request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/docker/instances/{id}/system/build-cache/prune [post]
+func (h *CleanupHandler) PruneBuildCache(c *gin.Context) {
+	instanceID, req, ok := parsePruneRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.agentForwarder.PruneBuildCache(instanceID, &pb.PruneBuildCacheRequest{
+		All:           req.All,
+		OlderThanDays: req.OlderThanDays,
+		DryRun:        req.DryRun,
+	})
+	h.logPrune(c, models.DockerActionPruneBuildCache, models.DockerResourceTypeSystem, instanceID, req, len(resp.GetCachesDeleted()), resp.GetSpaceReclaimed(), err)
+	if err != nil {
+		basehandlers.RespondError(c, http.StatusInternalServerError, fmt.Errorf("failed to prune build cache: %w", err))
+		return
+	}
+
+	basehandlers.RespondSuccess(c, resp)
+}
+
+// ListPolicies lists the cleanup policies of all instances
+// @Summary List Docker cleanup policies
+// @Tags Docker Cleanup
+// @Produce json
+// @Success 200 {object} basehandlers.SuccessResponse{data=[]models.DockerCleanupPolicy}
+// @Failure 500 {object} basehandlers.ErrorResponse
+// @Router /api/v1/docker/cleanup-policies [get]
+func (h *CleanupHandler) ListPolicies(c *gin.Context) {
+	policies, err := h.cleanupService.ListPolicies(c.Request.Context())
+	if err != nil {
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, policies)
+}
+
+// GetPolicy returns the cleanup policy of an instance
+// @Summary Get the cleanup policy of a Docker instance
+// @Tags Docker Cleanup
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 200 {object} basehandlers.SuccessResponse{data=models.DockerCleanupPolicy}
+// @Failure 404 {object} basehandlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/cleanup-policy [get]
+func (h *CleanupHandler) GetPolicy(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	policy, err := h.cleanupService.GetPolicy(c.Request.Context(), instanceID)
+	if err != nil {
+		respondCleanupError(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, policy)
+}
+
+// SavePolicy creates or replaces the cleanup policy of an instance
+// @Summary Save the cleanup policy of a Docker instance
+// @Description Enabled policies run on every online instance by the docker_cleanup scheduled task
+// @Tags Docker Cleanup
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body CleanupPolicyRequest true "Cleanup policy"
+// @Success 200 {object} basehandlers.SuccessResponse{data=models.DockerCleanupPolicy}
+// @Failure 400 {object} basehandlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/cleanup-policy [put]
+func (h *CleanupHandler) SavePolicy(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req CleanupPolicyRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	policy, err := h.cleanupService.SavePolicy(c.Request.Context(), instanceID, &models.DockerCleanupPolicy{
+		Enabled:         req.Enabled,
+		PruneContainers: req.PruneContainers,
+		PruneImages:     req.PruneImages,
+		PruneAllImages:  req.PruneAllImages,
+		PruneBuildCache: req.PruneBuildCache,
+		PruneVolumes:    req.PruneVolumes,
+		OlderThanDays:   req.OlderThanDays,
+		DryRun:          req.DryRun,
+	})
+	if err != nil {
+		respondCleanupError(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, policy)
+}
+
+// DeletePolicy deletes the cleanup policy of an instance
+// @Summary Delete the cleanup policy of a Docker instance
+// @Tags Docker Cleanup
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 204
+// @Failure 404 {object} basehandlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/cleanup-policy [delete]
+func (h *CleanupHandler) DeletePolicy(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	if err := h.cleanupService.DeletePolicy(c.Request.Context(), instanceID); err != nil {
+		respondCleanupError(c, err)
+		return
+	}
+	basehandlers.RespondNoContent(c)
+}
+
+// RunPolicy runs the cleanup policy of an instance now
+// @Summary Run the cleanup policy of a Docker instance
+// @Tags Docker Cleanup
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param dry_run query bool false "Only report reclaimable space"
+// @Success 200 {object} basehandlers.SuccessResponse{data=docker.CleanupResult}
+// @Failure 404 {object} basehandlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/cleanup-policy/run [post]
+func (h *CleanupHandler) RunPolicy(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+
+	result, err := h.cleanupService.RunPolicy(c.Request.Context(), instanceID, dryRun)
+	if err != nil {
+		respondCleanupError(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, result)
+}
+
+func respondCleanupError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, docker.ErrCleanupPolicyNotFound):
+		basehandlers.RespondNotFound(c, err)
+	case errors.Is(err, docker.ErrInvalidCleanupPolicy):
+		basehandlers.RespondBadRequest(c, err)
+	default:
+		basehandlers.RespondInternalError(c, err)
+	}
+}
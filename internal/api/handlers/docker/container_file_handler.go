@@ -0,0 +1,192 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/services/docker"
+
+	basehandlers "github.com/ysicing/tiga/internal/api/handlers"
+)
+
+// ContainerFileHandler handles Docker container file API requests
+type ContainerFileHandler struct {
+	fileService *docker.ContainerFileService
+}
+
+// NewContainerFileHandler creates a new ContainerFileHandler
+func NewContainerFileHandler(fileService *docker.ContainerFileService) *ContainerFileHandler {
+	return &ContainerFileHandler{
+		fileService: fileService,
+	}
+}
+
+// ListFiles godoc
+// @Summary List container files
+// @Description List a directory inside a Docker container. Directories come first, and at most 1000 entries are returned.
+// @Tags docker-containers
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param container_id path string true "Container ID or name"
+// @Param path query string false "Absolute directory path (default: /)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/containers/{container_id}/files [get]
+// @Security BearerAuth
+func (h *ContainerFileHandler) ListFiles(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+	containerID := c.Param("container_id")
+
+	resp, err := h.fileService.ListFiles(c, instanceID, containerID, c.DefaultQuery("path", "/"))
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id":  instanceID,
+			"container_id": containerID,
+		}).Error("Failed to list container files")
+		respondContainerFileError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"path":      resp.Path,
+		"files":     resp.Files,
+		"truncated": resp.Truncated,
+	})
+}
+
+// DownloadFiles godoc
+// @Summary Download container files
+// @Description Download a file or directory from a Docker container as a tar.gz archive
+// @Tags docker-containers
+// @Produce application/gzip
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param container_id path string true "Container ID or name"
+// @Param path query string true "Absolute path of the file or directory"
+// @Success 200 {file} file
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/containers/{container_id}/files/download [get]
+// @Security BearerAuth
+func (h *ContainerFileHandler) DownloadFiles(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+	containerID := c.Param("container_id")
+	srcPath := c.Query("path")
+	if srcPath == "" {
+		basehandlers.RespondBadRequest(c, fmt.Errorf("path is required"))
+		return
+	}
+
+	name := path.Base(srcPath)
+	if name == "/" {
+		name = "root"
+	}
+	c.Header("Content-Type", "application/gzip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".tar.gz"))
+
+	if _, err := h.fileService.Download(c, instanceID, containerID, srcPath, c.Writer); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id":  instanceID,
+			"container_id": containerID,
+			"path":         srcPath,
+		}).Error("Failed to download container files")
+		// Once the archive has started the status can no longer change
+		if c.Writer.Written() {
+			c.Abort()
+			return
+		}
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		respondContainerFileError(c, err)
+	}
+}
+
+// UploadFile godoc
+// @Summary Upload a file into a container
+// @Description Upload a file into a directory of a Docker container, replacing a file with the same name. Files are limited to 100 MiB.
+// @Tags docker-containers
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param container_id path string true "Container ID or name"
+// @Param path formData string true "Absolute path of the target directory"
+// @Param file formData file true "File to upload"
+// @Param name formData string false "File name in the container (default: the uploaded file name)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/containers/{container_id}/files/upload [post]
+// @Security BearerAuth
+func (h *ContainerFileHandler) UploadFile(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+	containerID := c.Param("container_id")
+
+	// Leave room for the multipart framing around the file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, docker.MaxContainerUploadSize+1<<20)
+
+	dstDir := c.PostForm("path")
+	if dstDir == "" {
+		basehandlers.RespondBadRequest(c, fmt.Errorf("path is required"))
+		return
+	}
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		basehandlers.RespondBadRequest(c, fmt.Errorf("file is required: %w", err))
+		return
+	}
+	name := c.PostForm("name")
+	if name == "" {
+		name = fileHeader.Filename
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+	defer file.Close()
+
+	if err := h.fileService.Upload(c, instanceID, containerID, dstDir, name, fileHeader.Size, file); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id":  instanceID,
+			"container_id": containerID,
+			"path":         dstDir,
+			"name":         name,
+		}).Error("Failed to upload file into container")
+		respondContainerFileError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message": "File uploaded successfully",
+		"path":    path.Join(dstDir, name),
+		"size":    fileHeader.Size,
+	})
+}
+
+// respondContainerFileError maps container file errors to HTTP status codes
+func respondContainerFileError(c *gin.Context, err error) {
+	if errors.Is(err, docker.ErrInvalidContainerPath) {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+	basehandlers.RespondInternalError(c, err)
+}
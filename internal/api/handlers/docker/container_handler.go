@@ -1,6 +1,7 @@
 package docker
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -480,3 +481,92 @@ func (h *ContainerHandler) DeleteContainer(c *gin.Context) {
 		"container_id": req.ContainerID,
 	})
 }
+
+// UpdateContainerRequest represents the request body for updating a container
+type UpdateContainerRequest struct {
+	ContainerID   string  `json:"container_id" binding:"required"`
+	Image         string  `json:"image"`          // New image, empty to keep the current one
+	SkipPull      bool    `json:"skip_pull"`      // Use the local image instead of pulling it first
+	Memory        int64   `json:"memory"`         // Memory limit in bytes (0 = keep, -1 = unlimited)
+	MemorySwap    int64   `json:"memory_swap"`    // Memory + swap limit in bytes (0 = keep, -1 = unlimited swap)
+	CPUs          float64 `json:"cpus"`           // Number of CPUs, e.g. 1.5 (0 = keep, -1 = unlimited)
+	CPUShares     int64   `json:"cpu_shares"`     // Relative CPU weight (0 = keep, -1 = default)
+	StopTimeout   int32   `json:"stop_timeout"`   // Seconds to wait for the old container to stop
+	HealthTimeout int32   `json:"health_timeout"` // Seconds to wait for the new container to become healthy
+}
+
+// resources returns the requested resource limits, nil when none are set
+func (r *UpdateContainerRequest) resources() *pb.ContainerResources {
+	if r.Memory == 0 && r.MemorySwap == 0 && r.CPUs == 0 && r.CPUShares == 0 {
+		return nil
+	}
+	resources := &pb.ContainerResources{
+		Memory:     r.Memory,
+		MemorySwap: r.MemorySwap,
+		CpuShares:  r.CPUShares,
+	}
+	switch {
+	case r.CPUs < 0:
+		resources.NanoCpus = -1
+	case r.CPUs > 0:
+		resources.NanoCpus = int64(r.CPUs * 1e9)
+	}
+	return resources
+}
+
+// UpdateContainer godoc
+// @Summary Update container
+// @Description Recreate a Docker container with a new image or resource limits. The image is pulled first, the old container is stopped and kept until the new one is healthy, and restored if the new one fails.
+// @Tags docker-containers
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body UpdateContainerRequest true "Update container request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 404 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/containers/update [post]
+// @Security BearerAuth
+func (h *ContainerHandler) UpdateContainer(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req UpdateContainerRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	resp, err := h.containerService.UpdateContainer(c, instanceID, req.ContainerID, docker.UpdateContainerOptions{
+		Image:         req.Image,
+		SkipPull:      req.SkipPull,
+		Resources:     req.resources(),
+		StopTimeout:   req.StopTimeout,
+		HealthTimeout: req.HealthTimeout,
+	})
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id":  instanceID,
+			"container_id": req.ContainerID,
+			"image":        req.Image,
+			"rolled_back":  resp.GetRolledBack(),
+		}).Error("Failed to update container")
+		if errors.Is(err, docker.ErrInvalidContainerUpdate) {
+			basehandlers.RespondBadRequest(c, err)
+			return
+		}
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message":          resp.Message,
+		"container_id":     resp.ContainerId,
+		"old_container_id": resp.OldContainerId,
+		"old_image":        resp.OldImage,
+		"image":            resp.Image,
+	})
+}
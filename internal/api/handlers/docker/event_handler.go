@@ -0,0 +1,161 @@
+package docker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/docker"
+
+	basehandlers "github.com/ysicing/tiga/internal/api/handlers"
+)
+
+// EventHandler serves the collected container event history of Docker instances
+type EventHandler struct {
+	eventService *docker.ContainerEventService
+}
+
+// NewEventHandler creates a new EventHandler
+func NewEventHandler(eventService *docker.ContainerEventService) *EventHandler {
+	return &EventHandler{
+		eventService: eventService,
+	}
+}
+
+// GetInstanceEvents godoc
+// @Summary Get Docker instance event timeline
+// @Description Get the collected container die, oom, restart and health_status events of a Docker instance, newest first
+// @Tags docker-containers
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param container query string false "Container ID, ID prefix (12+ characters) or name"
+// @Param action query string false "Comma-separated actions (die, oom, restart, health_status)"
+// @Param since query string false "Look-back window (e.g., 1h, 30m) or RFC3339 time (default: 24h)"
+// @Param until query string false "RFC3339 end time"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 50, max: 200)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/events [get]
+// @Security BearerAuth
+func (h *EventHandler) GetInstanceEvents(c *gin.Context) {
+	h.listEvents(c, c.Query("container"))
+}
+
+// GetContainerEvents godoc
+// @Summary Get container event timeline
+// @Description Get the collected die, oom, restart and health_status events of a container, newest first
+// @Tags docker-containers
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param container_id path string true "Container ID, ID prefix (12+ characters) or name"
+// @Param action query string false "Comma-separated actions (die, oom, restart, health_status)"
+// @Param since query string false "Look-back window (e.g., 1h, 30m) or RFC3339 time (default: 24h)"
+// @Param until query string false "RFC3339 end time"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 50, max: 200)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/containers/{container_id}/events [get]
+// @Security BearerAuth
+func (h *EventHandler) GetContainerEvents(c *gin.Context) {
+	h.listEvents(c, c.Param("container_id"))
+}
+
+// listEvents responds with the events of the instance in the path matching
+// the query parameters
+func (h *EventHandler) listEvents(c *gin.Context, container string) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	filter, err := parseEventFilter(c, container)
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	events, total, err := h.eventService.Timeline(c.Request.Context(), instanceID, filter)
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to get container events")
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"items":     events,
+		"total":     total,
+		"page":      filter.Page,
+		"page_size": filter.PageSize,
+	})
+}
+
+// parseEventFilter builds an event filter from the query parameters
+func parseEventFilter(c *gin.Context, container string) (*repository.DockerContainerEventFilter, error) {
+	filter := &repository.DockerContainerEventFilter{
+		Container: container,
+		Page:      1,
+		PageSize:  50,
+	}
+
+	since, err := parseEventTime(c.DefaultQuery("since", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid since: %w", err)
+	}
+	filter.Since = &since
+	if value := c.Query("until"); value != "" {
+		until, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid until: %w", err)
+		}
+		filter.Until = &until
+	}
+
+	if value := c.Query("action"); value != "" {
+		for _, action := range strings.Split(value, ",") {
+			action = strings.TrimSpace(action)
+			if !isContainerEventAction(action) {
+				return nil, fmt.Errorf("invalid action %q", action)
+			}
+			filter.Actions = append(filter.Actions, action)
+		}
+	}
+
+	if page, err := strconv.Atoi(c.Query("page")); err == nil && page > 0 {
+		filter.Page = page
+	}
+	if pageSize, err := strconv.Atoi(c.Query("page_size")); err == nil && pageSize > 0 {
+		filter.PageSize = min(pageSize, 200)
+	}
+	return filter, nil
+}
+
+// parseEventTime parses a look-back window such as 1h or an RFC3339 time
+func parseEventTime(value string) (time.Time, error) {
+	if window, err := time.ParseDuration(value); err == nil {
+		if window <= 0 {
+			return time.Time{}, fmt.Errorf("window must be positive")
+		}
+		return time.Now().Add(-window), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func isContainerEventAction(action string) bool {
+	for _, a := range models.DockerContainerEvents {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
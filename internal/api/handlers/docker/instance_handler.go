@@ -1,6 +1,7 @@
 package docker
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/ysicing/tiga/internal/services/docker"
 
 	basehandlers "github.com/ysicing/tiga/internal/api/handlers"
+	engine "github.com/ysicing/tiga/internal/docker"
 )
 
 // InstanceHandler handles Docker instance API requests
@@ -47,6 +49,11 @@ type DockerInstanceResponse struct {
 	Description string `json:"description"`
 	Status      string `json:"status"` // online, offline, unknown, archived
 
+	// Direct connection
+	ConnectionType string `json:"connection_type"` // agent, direct
+	Endpoint       string `json:"endpoint,omitempty"`
+	SSHHostKey     string `json:"ssh_host_key,omitempty"`
+
 	// Docker daemon info
 	Version         string `json:"version,omitempty"`
 	APIVersion      string `json:"api_version,omitempty"`
@@ -231,6 +238,16 @@ func (h *InstanceHandler) toResponse(c *gin.Context, instance *models.DockerInst
 		response.LastSeenAt = instance.LastConnectedAt.Format(time.RFC3339)
 	}
 
+	if instance.IsDirect() {
+		response.ConnectionType = models.DockerConnectionDirect
+		response.Endpoint = instance.Endpoint
+		response.SSHHostKey = instance.SSHHostKey
+		response.Host = instance.Endpoint
+		response.Port = 0
+		return response
+	}
+	response.ConnectionType = models.DockerConnectionAgent
+
 	// Query Agent information from agent_connections table
 	var agentConn models.AgentConnection
 	if err := db.Where("id = ?", instance.AgentID).First(&agentConn).Error; err == nil {
@@ -254,13 +271,23 @@ func (h *InstanceHandler) toResponse(c *gin.Context, instance *models.DockerInst
 type CreateInstanceRequest struct {
 	Name        string   `json:"name" binding:"required"`
 	Description string   `json:"description"`
-	AgentID     string   `json:"agent_id" binding:"required,uuid"`
+	AgentID     string   `json:"agent_id" binding:"required_unless=ConnectionType direct"`
 	Tags        []string `json:"tags"`
+
+	// Direct connections reach the engine without an agent
+	ConnectionType string `json:"connection_type" binding:"omitempty,oneof=agent direct"`
+	Endpoint       string `json:"endpoint" binding:"required_if=ConnectionType direct"` // tcp://host:port or ssh://user@host[:port][/socket]
+	TLSCACert      string `json:"tls_ca_cert"`
+	TLSCert        string `json:"tls_cert"`
+	TLSKey         string `json:"tls_key"`
+	SSHKey         string `json:"ssh_key"`
+	SSHHostKey     string `json:"ssh_host_key"` // Trusted on first connection when empty
 }
 
 // CreateInstance godoc
 // @Summary Create Docker instance
-// @Description Manually register a Docker instance (Note: Auto-discovery is preferred via Agent)
+// @Description Manually register a Docker instance (Note: Auto-discovery is preferred via Agent).
+// @Description Direct instances are reached over TCP with TLS client certificates or over SSH with a private key; the keys are stored encrypted.
 // @Tags docker-instances
 // @Accept json
 // @Produce json
@@ -276,6 +303,11 @@ func (h *InstanceHandler) CreateInstance(c *gin.Context) {
 		return
 	}
 
+	if req.ConnectionType == models.DockerConnectionDirect {
+		h.createDirectInstance(c, &req)
+		return
+	}
+
 	agentID, err := uuid.Parse(req.AgentID)
 	if err != nil {
 		basehandlers.RespondBadRequest(c, err)
@@ -304,6 +336,41 @@ func (h *InstanceHandler) CreateInstance(c *gin.Context) {
 	basehandlers.RespondCreated(c, instance)
 }
 
+// createDirectInstance registers a Docker instance reached without an agent
+func (h *InstanceHandler) createDirectInstance(c *gin.Context, req *CreateInstanceRequest) {
+	instance, err := h.instanceService.CreateDirectInstance(
+		c.Request.Context(),
+		req.Name,
+		req.Description,
+		engine.RemoteEndpoint{
+			Host:       req.Endpoint,
+			TLSCACert:  req.TLSCACert,
+			TLSCert:    req.TLSCert,
+			TLSKey:     req.TLSKey,
+			SSHKey:     req.SSHKey,
+			SSHHostKey: req.SSHHostKey,
+		},
+		req.Tags,
+	)
+	if err != nil {
+		if errors.Is(err, docker.ErrInvalidDirectEndpoint) {
+			basehandlers.RespondBadRequest(c, err)
+			return
+		}
+		logrus.WithError(err).WithField("endpoint", req.Endpoint).Error("Failed to create direct Docker instance")
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"instance_id":   instance.ID,
+		"instance_name": instance.Name,
+		"endpoint":      instance.Endpoint,
+	}).Info("Direct Docker instance created")
+
+	basehandlers.RespondCreated(c, instance)
+}
+
 // UpdateInstanceRequest represents the request body for updating a Docker instance
 type UpdateInstanceRequest struct {
 	Name        *string  `json:"name"`
@@ -407,14 +474,18 @@ func (h *InstanceHandler) DeleteInstance(c *gin.Context) {
 		return
 	}
 
+	h.agentForwarder.EvictDirectConnection(id)
+
 	logrus.WithField("instance_id", id).Info("Docker instance archived")
 
 	basehandlers.RespondNoContent(c)
 }
 
-// TestConnectionRequest represents the request body for testing connection
+// TestConnectionRequest represents the request body for testing connection.
+// An instance ID tests a registered instance, including direct ones.
 type TestConnectionRequest struct {
-	AgentID string `json:"agent_id" binding:"required,uuid"`
+	AgentID    string `json:"agent_id" binding:"required_without=InstanceID"`
+	InstanceID string `json:"instance_id"`
 }
 
 // TestConnectionResponse represents the response for connection test
@@ -425,9 +496,27 @@ type TestConnectionResponse struct {
 	ErrorMessage string                 `json:"error_message,omitempty"`
 }
 
+// testConnectionTarget resolves the instance to test from the instance ID
+// or the agent ID of the request
+func (h *InstanceHandler) testConnectionTarget(c *gin.Context, req *TestConnectionRequest) (uuid.UUID, error) {
+	if req.InstanceID != "" {
+		return uuid.Parse(req.InstanceID)
+	}
+
+	agentID, err := uuid.Parse(req.AgentID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	instance, err := h.instanceService.GetByAgentID(c.Request.Context(), agentID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return instance.ID, nil
+}
+
 // TestConnection godoc
 // @Summary Test Docker connection
-// @Description Test connection to Docker daemon via Agent
+// @Description Test connection to Docker daemon via Agent, or directly for direct instances
 // @Tags docker-instances
 // @Accept json
 // @Produce json
@@ -443,16 +532,16 @@ func (h *InstanceHandler) TestConnection(c *gin.Context) {
 		return
 	}
 
-	agentID, err := uuid.Parse(req.AgentID)
+	instanceID, err := h.testConnectionTarget(c, &req)
 	if err != nil {
 		basehandlers.RespondBadRequest(c, err)
 		return
 	}
 
-	// Try to get Docker info from agent
-	dockerInfo, err := h.agentForwarder.GetDockerInfo(agentID)
+	// Try to get Docker info from agent or, for direct instances, the engine
+	dockerInfo, err := h.agentForwarder.GetDockerInfo(instanceID)
 	if err != nil {
-		logrus.WithError(err).WithField("agent_id", agentID).Warn("Docker connection test failed")
+		logrus.WithError(err).WithField("instance_id", instanceID).Warn("Docker connection test failed")
 
 		response := TestConnectionResponse{
 			Success:      false,
@@ -488,7 +577,7 @@ func (h *InstanceHandler) TestConnection(c *gin.Context) {
 	}
 
 	logrus.WithFields(logrus.Fields{
-		"agent_id":       agentID,
+		"instance_id":    instanceID,
 		"docker_version": dockerInfo.Info.Driver, // Using driver as version
 	}).Info("Docker connection test successful")
 
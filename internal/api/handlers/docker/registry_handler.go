@@ -0,0 +1,217 @@
+package docker
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/docker"
+
+	basehandlers "github.com/ysicing/tiga/internal/api/handlers"
+)
+
+// RegistryHandler handles registry credential API requests
+type RegistryHandler struct {
+	credentialService *docker.RegistryCredentialService
+}
+
+// NewRegistryHandler creates a new RegistryHandler
+func NewRegistryHandler(credentialService *docker.RegistryCredentialService) *RegistryHandler {
+	return &RegistryHandler{credentialService: credentialService}
+}
+
+// RegistryCredentialRequest represents the request body for creating or
+// updating a registry credential
+type RegistryCredentialRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	Registry    string   `json:"registry"` // Registry host, defaults to docker.io
+	Username    string   `json:"username" binding:"required"`
+	Password    string   `json:"password"` // Password or access token, kept when empty on update
+	Description string   `json:"description"`
+	InstanceIDs []string `json:"instance_ids"` // Docker instances the credential applies to
+	Team        string   `json:"team"`         // Docker instances tagged with the team
+}
+
+func (r *RegistryCredentialRequest) toModel() *models.RegistryCredential {
+	return &models.RegistryCredential{
+		Name:        strings.TrimSpace(r.Name),
+		Registry:    r.Registry,
+		Username:    r.Username,
+		Description: r.Description,
+		InstanceIDs: r.InstanceIDs,
+		Team:        strings.TrimSpace(r.Team),
+	}
+}
+
+// RegistryLoginTestResponse represents the result of a login test
+type RegistryLoginTestResponse struct {
+	Success      bool   `json:"success"`
+	Message      string `json:"message"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// ListCredentials godoc
+// @Summary List registry credentials
+// @Description List the stored container registry credentials. Passwords are never returned.
+// @Tags docker-registries
+// @Produce json
+// @Param registry query string false "Filter by registry host"
+// @Success 200 {object} handlers.SuccessResponse{data=[]models.RegistryCredential}
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/registries [get]
+// @Security BearerAuth
+func (h *RegistryHandler) ListCredentials(c *gin.Context) {
+	credentials, err := h.credentialService.List(c.Request.Context(), c.Query("registry"))
+	if err != nil {
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, credentials)
+}
+
+// GetCredential godoc
+// @Summary Get registry credential
+// @Tags docker-registries
+// @Produce json
+// @Param id path string true "Credential ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=models.RegistryCredential}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 404 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/registries/{id} [get]
+// @Security BearerAuth
+func (h *RegistryHandler) GetCredential(c *gin.Context) {
+	id, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	credential, err := h.credentialService.Get(c.Request.Context(), id)
+	if err != nil {
+		basehandlers.RespondNotFound(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, credential)
+}
+
+// CreateCredential godoc
+// @Summary Create registry credential
+// @Description Store a registry login, encrypted at rest. Without instance_ids and team it applies to every Docker instance.
+// @Tags docker-registries
+// @Accept json
+// @Produce json
+// @Param request body RegistryCredentialRequest true "Registry credential"
+// @Success 201 {object} handlers.SuccessResponse{data=models.RegistryCredential}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/registries [post]
+// @Security BearerAuth
+func (h *RegistryHandler) CreateCredential(c *gin.Context) {
+	var req RegistryCredentialRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	credential := req.toModel()
+	if err := h.credentialService.Create(c.Request.Context(), credential, req.Password); err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	basehandlers.RespondCreated(c, credential)
+}
+
+// UpdateCredential godoc
+// @Summary Update registry credential
+// @Description Update a registry credential. An empty password keeps the stored one.
+// @Tags docker-registries
+// @Accept json
+// @Produce json
+// @Param id path string true "Credential ID (UUID)"
+// @Param request body RegistryCredentialRequest true "Registry credential"
+// @Success 200 {object} handlers.SuccessResponse{data=models.RegistryCredential}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/registries/{id} [put]
+// @Security BearerAuth
+func (h *RegistryHandler) UpdateCredential(c *gin.Context) {
+	id, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req RegistryCredentialRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	credential, err := h.credentialService.Update(c.Request.Context(), id, req.toModel(), req.Password)
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+	basehandlers.RespondSuccess(c, credential)
+}
+
+// DeleteCredential godoc
+// @Summary Delete registry credential
+// @Tags docker-registries
+// @Param id path string true "Credential ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 404 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/registries/{id} [delete]
+// @Security BearerAuth
+func (h *RegistryHandler) DeleteCredential(c *gin.Context) {
+	id, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	if err := h.credentialService.Delete(c.Request.Context(), id); err != nil {
+		basehandlers.RespondNotFound(c, err)
+		return
+	}
+
+	logrus.WithField("credential_id", id).Info("Registry credential deleted")
+	basehandlers.RespondNoContent(c)
+}
+
+// TestLogin godoc
+// @Summary Test registry login
+// @Description Log in to the registry with a stored credential from the server
+// @Tags docker-registries
+// @Produce json
+// @Param id path string true "Credential ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=RegistryLoginTestResponse}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/registries/{id}/test [post]
+// @Security BearerAuth
+func (h *RegistryHandler) TestLogin(c *gin.Context) {
+	id, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	if _, err := h.credentialService.Get(c.Request.Context(), id); err != nil {
+		basehandlers.RespondNotFound(c, err)
+		return
+	}
+
+	if err := h.credentialService.TestLogin(c.Request.Context(), id); err != nil {
+		basehandlers.RespondSuccess(c, RegistryLoginTestResponse{
+			Success:      false,
+			Message:      "Login failed",
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	basehandlers.RespondSuccess(c, RegistryLoginTestResponse{
+		Success: true,
+		Message: "Login succeeded",
+	})
+}
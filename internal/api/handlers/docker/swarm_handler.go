@@ -0,0 +1,791 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/services/docker"
+
+	basehandlers "github.com/ysicing/tiga/internal/api/handlers"
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// SwarmHandler handles Docker Swarm nodes, services, tasks, secrets,
+// configs and stacks of an instance that is a swarm manager
+type SwarmHandler struct {
+	swarmService *docker.SwarmService
+}
+
+// NewSwarmHandler creates a new SwarmHandler
+func NewSwarmHandler(swarmService *docker.SwarmService) *SwarmHandler {
+	return &SwarmHandler{
+		swarmService: swarmService,
+	}
+}
+
+// respondSwarmError responds with the status matching a swarm service error
+func respondSwarmError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, docker.ErrInvalidSwarmRequest):
+		basehandlers.RespondBadRequest(c, err)
+	case errors.Is(err, docker.ErrNotSwarmManager):
+		basehandlers.RespondConflict(c, err)
+	default:
+		basehandlers.RespondInternalError(c, err)
+	}
+}
+
+// GetSwarmInfo godoc
+// @Summary Get swarm info
+// @Description Detect whether the Docker daemon of an instance is part of a swarm and whether it is a manager. The detected state is stored on the instance.
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/info [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetSwarmInfo(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	info, err := h.swarmService.GetSwarmInfo(c.Request.Context(), instanceID)
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to get swarm info")
+		basehandlers.RespondInternalError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"swarm":   info,
+		"manager": info.LocalNodeState == "active" && info.ControlAvailable,
+	})
+}
+
+// GetNodes godoc
+// @Summary List swarm nodes
+// @Description List the nodes of the swarm managed by a Docker instance
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/nodes [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetNodes(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	nodes, err := h.swarmService.ListNodes(c.Request.Context(), instanceID)
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to list swarm nodes")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"nodes": nodes,
+		"total": len(nodes),
+	})
+}
+
+// UpdateSwarmNodeRequest represents the request body for updating a swarm node
+type UpdateSwarmNodeRequest struct {
+	Availability string `json:"availability"` // active, pause or drain
+	Role         string `json:"role"`         // worker or manager
+}
+
+// UpdateNode godoc
+// @Summary Update swarm node
+// @Description Change the availability (active, pause, drain) or the role (worker, manager) of a swarm node
+// @Tags docker-swarm
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param node_id path string true "Node ID"
+// @Param request body UpdateSwarmNodeRequest true "Update node request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/nodes/{node_id} [put]
+// @Security BearerAuth
+func (h *SwarmHandler) UpdateNode(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req UpdateSwarmNodeRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	nodeID := c.Param("node_id")
+	resp, err := h.swarmService.UpdateNode(c, instanceID, nodeID, req.Availability, req.Role)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"node_id":     nodeID,
+		}).Error("Failed to update swarm node")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message": resp.Message,
+		"node_id": nodeID,
+	})
+}
+
+// GetServices godoc
+// @Summary List swarm services
+// @Description List the swarm services with their running and desired task counts
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param stack query string false "Only list the services of this stack"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/services [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetServices(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	services, err := h.swarmService.ListServices(c.Request.Context(), instanceID, c.Query("stack"))
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to list swarm services")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"services": services,
+		"total":    len(services),
+	})
+}
+
+// GetService godoc
+// @Summary Get swarm service
+// @Description Get a swarm service and its spec
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param service_id path string true "Service ID or name"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/services/{service_id} [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetService(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	serviceID := c.Param("service_id")
+	resp, err := h.swarmService.GetService(c.Request.Context(), instanceID, serviceID)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"service_id":  serviceID,
+		}).Error("Failed to get swarm service")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"service": resp.Service,
+		"spec":    resp.SpecJson,
+	})
+}
+
+// ScaleSwarmServiceRequest represents the request body for scaling a swarm service
+type ScaleSwarmServiceRequest struct {
+	Replicas *uint64 `json:"replicas" binding:"required"`
+}
+
+// ScaleService godoc
+// @Summary Scale swarm service
+// @Description Set the number of replicas of a replicated swarm service
+// @Tags docker-swarm
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param service_id path string true "Service ID or name"
+// @Param request body ScaleSwarmServiceRequest true "Scale service request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/services/{service_id}/scale [post]
+// @Security BearerAuth
+func (h *SwarmHandler) ScaleService(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req ScaleSwarmServiceRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	serviceID := c.Param("service_id")
+	resp, err := h.swarmService.ScaleService(c, instanceID, serviceID, *req.Replicas)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"service_id":  serviceID,
+			"replicas":    *req.Replicas,
+		}).Error("Failed to scale swarm service")
+		respondSwarmError(c, err)
+		return
+	}
+
+	respondServiceUpdate(c, serviceID, resp)
+}
+
+// UpdateSwarmServiceImageRequest represents the request body for updating the image of a swarm service
+type UpdateSwarmServiceImageRequest struct {
+	Image string `json:"image" binding:"required"`
+}
+
+// UpdateServiceImage godoc
+// @Summary Update swarm service image
+// @Description Start a rolling update of a swarm service to a new image, using the stored registry credentials of the image
+// @Tags docker-swarm
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param service_id path string true "Service ID or name"
+// @Param request body UpdateSwarmServiceImageRequest true "Update image request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/services/{service_id}/image [post]
+// @Security BearerAuth
+func (h *SwarmHandler) UpdateServiceImage(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req UpdateSwarmServiceImageRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	serviceID := c.Param("service_id")
+	resp, err := h.swarmService.UpdateServiceImage(c, instanceID, serviceID, req.Image)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"service_id":  serviceID,
+			"image":       req.Image,
+		}).Error("Failed to update swarm service image")
+		respondSwarmError(c, err)
+		return
+	}
+
+	respondServiceUpdate(c, serviceID, resp)
+}
+
+// RollbackService godoc
+// @Summary Roll back swarm service
+// @Description Revert a swarm service to its previous spec
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param service_id path string true "Service ID or name"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/services/{service_id}/rollback [post]
+// @Security BearerAuth
+func (h *SwarmHandler) RollbackService(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	serviceID := c.Param("service_id")
+	resp, err := h.swarmService.RollbackService(c, instanceID, serviceID)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"service_id":  serviceID,
+		}).Error("Failed to roll back swarm service")
+		respondSwarmError(c, err)
+		return
+	}
+
+	respondServiceUpdate(c, serviceID, resp)
+}
+
+// respondServiceUpdate responds with the result of a service update
+func respondServiceUpdate(c *gin.Context, serviceID string, resp *pb.UpdateSwarmServiceResponse) {
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message":    resp.Message,
+		"service_id": serviceID,
+		"warnings":   resp.Warnings,
+	})
+}
+
+// DeleteService godoc
+// @Summary Delete swarm service
+// @Description Remove a swarm service and its tasks
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param service_id path string true "Service ID or name"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/services/{service_id} [delete]
+// @Security BearerAuth
+func (h *SwarmHandler) DeleteService(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	serviceID := c.Param("service_id")
+	if err := h.swarmService.DeleteService(c, instanceID, serviceID); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"service_id":  serviceID,
+		}).Error("Failed to delete swarm service")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message":    "Service deleted successfully",
+		"service_id": serviceID,
+	})
+}
+
+// GetTasks godoc
+// @Summary List swarm tasks
+// @Description List the swarm tasks, optionally of one service or node
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param service_id query string false "Service ID or name"
+// @Param node_id query string false "Node ID"
+// @Param desired_state query string false "Desired state (running, shutdown, accepted)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/tasks [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetTasks(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	tasks, err := h.swarmService.ListTasks(c.Request.Context(), instanceID, &pb.ListSwarmTasksRequest{
+		ServiceId:    c.Query("service_id"),
+		NodeId:       c.Query("node_id"),
+		DesiredState: c.Query("desired_state"),
+	})
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to list swarm tasks")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"tasks": tasks,
+		"total": len(tasks),
+	})
+}
+
+// CreateSwarmFileRequest represents the request body for creating a swarm secret or config
+type CreateSwarmFileRequest struct {
+	Name   string            `json:"name" binding:"required"`
+	Data   string            `json:"data" binding:"required"`
+	Labels map[string]string `json:"labels"`
+}
+
+// GetSecrets godoc
+// @Summary List swarm secrets
+// @Description List the swarm secrets. Secret data is never returned.
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/secrets [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetSecrets(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	secrets, err := h.swarmService.ListSecrets(c.Request.Context(), instanceID)
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to list swarm secrets")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"secrets": secrets,
+		"total":   len(secrets),
+	})
+}
+
+// CreateSecret godoc
+// @Summary Create swarm secret
+// @Description Create a swarm secret
+// @Tags docker-swarm
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body CreateSwarmFileRequest true "Create secret request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/secrets [post]
+// @Security BearerAuth
+func (h *SwarmHandler) CreateSecret(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req CreateSwarmFileRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	id, err := h.swarmService.CreateSecret(c, instanceID, &pb.CreateSwarmSecretRequest{
+		Name:   req.Name,
+		Data:   []byte(req.Data),
+		Labels: req.Labels,
+	})
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"name":        req.Name,
+		}).Error("Failed to create swarm secret")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message": "Secret created successfully",
+		"id":      id,
+	})
+}
+
+// DeleteSecret godoc
+// @Summary Delete swarm secret
+// @Description Remove a swarm secret that no service uses
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param secret_id path string true "Secret ID or name"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/secrets/{secret_id} [delete]
+// @Security BearerAuth
+func (h *SwarmHandler) DeleteSecret(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	secretID := c.Param("secret_id")
+	if err := h.swarmService.DeleteSecret(c, instanceID, secretID); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"secret_id":   secretID,
+		}).Error("Failed to delete swarm secret")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message": "Secret deleted successfully",
+		"id":      secretID,
+	})
+}
+
+// GetConfigs godoc
+// @Summary List swarm configs
+// @Description List the swarm configs with their data
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/configs [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetConfigs(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	configs, err := h.swarmService.ListConfigs(c.Request.Context(), instanceID)
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to list swarm configs")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"configs": configs,
+		"total":   len(configs),
+	})
+}
+
+// CreateConfig godoc
+// @Summary Create swarm config
+// @Description Create a swarm config
+// @Tags docker-swarm
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body CreateSwarmFileRequest true "Create config request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/configs [post]
+// @Security BearerAuth
+func (h *SwarmHandler) CreateConfig(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req CreateSwarmFileRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	id, err := h.swarmService.CreateConfig(c, instanceID, &pb.CreateSwarmConfigRequest{
+		Name:   req.Name,
+		Data:   []byte(req.Data),
+		Labels: req.Labels,
+	})
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"name":        req.Name,
+		}).Error("Failed to create swarm config")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message": "Config created successfully",
+		"id":      id,
+	})
+}
+
+// DeleteConfig godoc
+// @Summary Delete swarm config
+// @Description Remove a swarm config that no service uses
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param config_id path string true "Config ID or name"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/configs/{config_id} [delete]
+// @Security BearerAuth
+func (h *SwarmHandler) DeleteConfig(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	configID := c.Param("config_id")
+	if err := h.swarmService.DeleteConfig(c, instanceID, configID); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"config_id":   configID,
+		}).Error("Failed to delete swarm config")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message": "Config deleted successfully",
+		"id":      configID,
+	})
+}
+
+// GetStacks godoc
+// @Summary List stacks
+// @Description List the stacks deployed on the swarm with their number of services
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/stacks [get]
+// @Security BearerAuth
+func (h *SwarmHandler) GetStacks(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	stacks, err := h.swarmService.ListStacks(c.Request.Context(), instanceID)
+	if err != nil {
+		logrus.WithError(err).WithField("instance_id", instanceID).Error("Failed to list stacks")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"stacks": stacks,
+		"total":  len(stacks),
+	})
+}
+
+// DeployStackRequest represents the request body for deploying a stack
+type DeployStackRequest struct {
+	Name    string `json:"name" binding:"required"`
+	Compose string `json:"compose" binding:"required"` // Compose file (version 3) content
+	Prune   bool   `json:"prune"`                      // Remove services of the stack that are no longer in the compose file
+}
+
+// DeployStack godoc
+// @Summary Deploy stack
+// @Description Create or update a stack from a compose file, like docker stack deploy. Secrets and configs must be external or have inline content.
+// @Tags docker-swarm
+// @Accept json
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param request body DeployStackRequest true "Deploy stack request"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/stacks [post]
+// @Security BearerAuth
+func (h *SwarmHandler) DeployStack(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	var req DeployStackRequest
+	if !basehandlers.BindJSON(c, &req) {
+		return
+	}
+
+	resp, err := h.swarmService.DeployStack(c, instanceID, req.Name, req.Compose, req.Prune)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"stack":       req.Name,
+		}).Error("Failed to deploy stack")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message":  fmt.Sprintf("Stack %s deployed successfully", req.Name),
+		"created":  resp.Created,
+		"updated":  resp.Updated,
+		"removed":  resp.Removed,
+		"warnings": resp.Warnings,
+	})
+}
+
+// RemoveStack godoc
+// @Summary Remove stack
+// @Description Remove the services, secrets, configs and networks of a stack
+// @Tags docker-swarm
+// @Produce json
+// @Param id path string true "Docker Instance ID (UUID)"
+// @Param name path string true "Stack name"
+// @Success 200 {object} handlers.SuccessResponse{data=map[string]interface{}}
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 409 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/docker/instances/{id}/swarm/stacks/{name} [delete]
+// @Security BearerAuth
+func (h *SwarmHandler) RemoveStack(c *gin.Context) {
+	instanceID, err := basehandlers.ParseUUID(c.Param("id"))
+	if err != nil {
+		basehandlers.RespondBadRequest(c, err)
+		return
+	}
+
+	name := c.Param("name")
+	resp, err := h.swarmService.RemoveStack(c, instanceID, name)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instanceID,
+			"stack":       name,
+		}).Error("Failed to remove stack")
+		respondSwarmError(c, err)
+		return
+	}
+
+	basehandlers.RespondSuccess(c, map[string]interface{}{
+		"message":  fmt.Sprintf("Stack %s removed successfully", name),
+		"removed":  resp.Removed,
+		"warnings": resp.Warnings,
+	})
+}
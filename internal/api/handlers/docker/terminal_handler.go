@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -20,6 +21,7 @@ import (
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/repository"
 	"github.com/ysicing/tiga/internal/services/host"
+	"github.com/ysicing/tiga/internal/services/terminal"
 	"github.com/ysicing/tiga/proto"
 
 	authservices "github.com/ysicing/tiga/internal/services/auth"
@@ -35,12 +37,14 @@ type TerminalHandler struct {
 	jwtManager          *authservices.JWTManager
 	recordingRepo       repository.TerminalRecordingRepositoryInterface
 	recordingDir        string // Directory to store recording files
+	terminals           *terminal.Registry
 
 	// Session management
 	sessions sync.Map // session_id -> *TerminalSession
 }
 
-// NewTerminalHandler creates a new TerminalHandler
+// NewTerminalHandler creates a new TerminalHandler. Connected sessions are
+// registered in terminals for live viewing and termination by admins.
 func NewTerminalHandler(
 	db *gorm.DB,
 	dockerStreamManager *host.DockerStreamManager,
@@ -48,6 +52,7 @@ func NewTerminalHandler(
 	instanceService *dockerservices.DockerInstanceService,
 	jwtManager *authservices.JWTManager,
 	recordingRepo repository.TerminalRecordingRepositoryInterface,
+	terminals *terminal.Registry,
 ) *TerminalHandler {
 	// Default recording directory
 	recordingDir := os.Getenv("TERMINAL_RECORDING_DIR")
@@ -68,6 +73,7 @@ func NewTerminalHandler(
 		jwtManager:          jwtManager,
 		recordingRepo:       recordingRepo,
 		recordingDir:        recordingDir,
+		terminals:           terminals,
 	}
 
 	// Cleanup expired sessions every 5 minutes
@@ -96,6 +102,9 @@ type TerminalSession struct {
 	RecordingBuffer *bytes.Buffer
 	RecordingMutex  sync.Mutex
 	StartTime       time.Time
+
+	// WriteMutex serializes writes to the WebSocket connection
+	WriteMutex sync.Mutex
 }
 
 // TerminalMessage represents WebSocket messages
@@ -350,7 +359,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 	// Get Docker instance to find associated agent
 	var instance models.DockerInstance
 	if err := h.db.Where("id = ?", session.InstanceID).First(&instance).Error; err != nil {
-		h.sendError(conn, "INSTANCE_NOT_FOUND", fmt.Sprintf("Failed to find Docker instance: %v", err))
+		h.sendError(conn, session, "INSTANCE_NOT_FOUND", fmt.Sprintf("Failed to find Docker instance: %v", err))
 		return
 	}
 
@@ -370,7 +379,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 		params,
 	)
 	if err != nil {
-		h.sendError(conn, "SESSION_CREATE_FAILED", fmt.Sprintf("Failed to create stream session: %v", err))
+		h.sendError(conn, session, "SESSION_CREATE_FAILED", fmt.Sprintf("Failed to create stream session: %v", err))
 		return
 	}
 
@@ -378,7 +387,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 	dockerSession, ok := dockerSessionInterface.(*host.DockerStreamSession)
 	if !ok {
 		logrus.Error("Failed to cast session to *host.DockerStreamSession")
-		h.sendError(conn, "SESSION_TYPE_ERROR", "Internal error: invalid session type")
+		h.sendError(conn, session, "SESSION_TYPE_ERROR", "Internal error: invalid session type")
 		return
 	}
 	defer h.dockerStreamManager.CloseSession(dockerSession.SessionID)
@@ -386,7 +395,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 	// Wait for Agent to be ready
 	if err := dockerSession.WaitForReady(10 * time.Second); err != nil {
 		logrus.WithError(err).Error("Agent failed to become ready")
-		h.sendError(conn, "AGENT_NOT_READY", fmt.Sprintf("Agent not ready: %v", err))
+		h.sendError(conn, session, "AGENT_NOT_READY", fmt.Sprintf("Agent not ready: %v", err))
 		return
 	}
 
@@ -397,6 +406,33 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 	ctx, cancel := context.WithCancel(c.Request.Context())
 	defer cancel()
 
+	// Register the session for admins to watch and terminate
+	var terminated atomic.Bool
+	var live *terminal.Session
+	if h.terminals != nil {
+		live = h.terminals.Register(terminal.SessionInfo{
+			ID:       session.ID.String(),
+			Kind:     terminal.KindDocker,
+			UserID:   session.UserID,
+			Username: session.Username,
+			Target:   session.ContainerID,
+			ClientIP: session.ClientIP,
+			Cols:     uint16(session.Cols),
+			Rows:     uint16(session.Rows),
+		}, terminal.Controls{
+			Notify: func(message string) {
+				h.writeJSON(conn, session, TerminalMessage{Type: "output", Data: message})
+			},
+			Terminate: func(reason string) {
+				terminated.Store(true)
+				h.sendError(conn, session, "SESSION_TERMINATED", "Session terminated by administrator: "+reason)
+				cancel()
+				conn.Close()
+			},
+		})
+		defer h.terminals.Unregister(live)
+	}
+
 	// Goroutine: Read from Docker stream and write to WebSocket
 	go func() {
 		defer func() {
@@ -408,16 +444,19 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 			select {
 			case data, ok := <-dockerSession.DataChan:
 				if !ok {
-					h.sendExit(conn, 0)
+					h.sendExit(conn, session, 0)
 					cancel()
 					return
 				}
 				// Send output to WebSocket and record it
 				h.sendOutput(conn, session, string(data.Data))
+				if live != nil {
+					live.Output(data.Data)
+				}
 
 			case streamErr, ok := <-dockerSession.ErrorChan:
 				if ok {
-					h.sendError(conn, "EXEC_ERROR", streamErr.Error)
+					h.sendError(conn, session, "EXEC_ERROR", streamErr.Error)
 				}
 				cancel()
 				return
@@ -426,7 +465,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 				if ok {
 					logrus.WithField("reason", closeMsg.Reason).Info("Docker stream closed")
 				}
-				h.sendExit(conn, 0)
+				h.sendExit(conn, session, 0)
 				cancel()
 				return
 
@@ -453,7 +492,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 				heartbeatMu.Lock()
 				if time.Since(lastHeartbeat) > 2*time.Minute {
 					heartbeatMu.Unlock()
-					h.sendError(conn, "SESSION_TIMEOUT", "Session timeout due to inactivity")
+					h.sendError(conn, session, "SESSION_TIMEOUT", "Session timeout due to inactivity")
 					cancel()
 					return
 				}
@@ -470,7 +509,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 		default:
 			var msg TerminalMessage
 			if err := conn.ReadJSON(&msg); err != nil {
-				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				if !terminated.Load() && websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 					logrus.WithError(err).Warn("WebSocket unexpected close")
 				}
 				cancel()
@@ -506,6 +545,10 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 				heartbeatMu.Unlock()
 
 			case "resize":
+				if live != nil {
+					live.Resize(uint16(msg.Cols), uint16(msg.Rows))
+				}
+
 				// Forward resize to Agent via InputChan
 				resizeMsg := &proto.DockerStreamMessage{
 					Message: &proto.DockerStreamMessage_Resize{
@@ -526,7 +569,7 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 
 			case "ping":
 				// Respond with pong
-				h.sendPong(conn)
+				h.sendPong(conn, session)
 
 				// Update heartbeat
 				heartbeatMu.Lock()
@@ -537,13 +580,20 @@ func (h *TerminalHandler) HandleWebSocketTerminal(c *gin.Context) {
 	}
 }
 
+// writeJSON writes a message to the WebSocket client of the session
+func (h *TerminalHandler) writeJSON(conn *websocket.Conn, session *TerminalSession, msg TerminalMessage) error {
+	session.WriteMutex.Lock()
+	defer session.WriteMutex.Unlock()
+	return conn.WriteJSON(msg)
+}
+
 // sendOutput sends terminal output to WebSocket client and records it
 func (h *TerminalHandler) sendOutput(conn *websocket.Conn, session *TerminalSession, data string) {
 	msg := TerminalMessage{
 		Type: "output",
 		Data: data,
 	}
-	if err := conn.WriteJSON(msg); err != nil {
+	if err := h.writeJSON(conn, session, msg); err != nil {
 		logrus.WithError(err).Warn("Failed to send output to WebSocket")
 	}
 
@@ -552,12 +602,14 @@ func (h *TerminalHandler) sendOutput(conn *websocket.Conn, session *TerminalSess
 }
 
 // sendError sends error message to WebSocket client
-func (h *TerminalHandler) sendError(conn *websocket.Conn, code, message string) {
+func (h *TerminalHandler) sendError(conn *websocket.Conn, session *TerminalSession, code, message string) {
 	msg := TerminalMessage{
 		Type:    "error",
 		Code:    code,
 		Message: message,
 	}
+	session.WriteMutex.Lock()
+	defer session.WriteMutex.Unlock()
 	conn.WriteJSON(msg)
 
 	// Close connection after sending error
@@ -565,11 +617,13 @@ func (h *TerminalHandler) sendError(conn *websocket.Conn, code, message string)
 }
 
 // sendExit sends exit message to WebSocket client
-func (h *TerminalHandler) sendExit(conn *websocket.Conn, exitCode int32) {
+func (h *TerminalHandler) sendExit(conn *websocket.Conn, session *TerminalSession, exitCode int32) {
 	msg := TerminalMessage{
 		Type:     "exit",
 		ExitCode: exitCode,
 	}
+	session.WriteMutex.Lock()
+	defer session.WriteMutex.Unlock()
 	conn.WriteJSON(msg)
 
 	// Close connection after sending exit
@@ -577,11 +631,11 @@ func (h *TerminalHandler) sendExit(conn *websocket.Conn, exitCode int32) {
 }
 
 // sendPong sends pong response to WebSocket client
-func (h *TerminalHandler) sendPong(conn *websocket.Conn) {
+func (h *TerminalHandler) sendPong(conn *websocket.Conn, session *TerminalSession) {
 	msg := TerminalMessage{
 		Type: "pong",
 	}
-	if err := conn.WriteJSON(msg); err != nil {
+	if err := h.writeJSON(conn, session, msg); err != nil {
 		logrus.WithError(err).Warn("Failed to send pong to WebSocket")
 	}
 }
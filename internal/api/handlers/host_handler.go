@@ -476,6 +476,7 @@ func (h *HostHandler) GetCurrentState(c *gin.Context) {
 // @Param start query string true "Start time (RFC3339)"
 // @Param end query string true "End time (RFC3339)"
 // @Param interval query string false "Interval (auto/1m/5m/1h/1d)"
+// @Param aggregate query string false "Value of gauges over each interval (avg/max/min)"
 // @Param metrics query string false "Metrics (comma-separated)"
 // @Success 200 {object} map[string]interface{}
 // @Router /api/v1/hosts/{id}/state/history [get]
@@ -492,13 +493,21 @@ func (h *HostHandler) GetHistoryState(c *gin.Context) {
 	start := c.Query("start")
 	end := c.Query("end")
 	interval := c.DefaultQuery("interval", "auto")
+	aggregate := c.DefaultQuery("aggregate", host.HistoryStatAvg)
+	if aggregate != host.HistoryStatAvg && aggregate != host.HistoryStatMax && aggregate != host.HistoryStatMin {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40002,
+			"message": "Invalid aggregate, expected avg, max or min",
+		})
+		return
+	}
 
 	// Parse times
 	startTime, _ := time.Parse(time.RFC3339, start)
 	endTime, _ := time.Parse(time.RFC3339, end)
 
 	// Get historical states
-	states, err := h.hostService.GetHostStateHistory(c.Request.Context(), id, start, end, interval)
+	states, err := h.hostService.GetHostStateHistory(c.Request.Context(), id, start, end, interval, aggregate)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"code":    50001,
@@ -507,6 +516,54 @@ func (h *HostHandler) GetHistoryState(c *gin.Context) {
 		return
 	}
 
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "success",
+		"data": gin.H{
+			"start":     startTime,
+			"end":       endTime,
+			"interval":  interval,
+			"aggregate": aggregate,
+			"points":    states,
+		},
+	})
+}
+
+// GetStateDetails godoc
+// @Summary Get per-partition, per-interface and top process history
+// @Tags hosts
+// @Produce json
+// @Param id path string true "Host ID (UUID)"
+// @Param start query string true "Start time (RFC3339)"
+// @Param end query string true "End time (RFC3339)"
+// @Param interval query string false "Interval (auto/1m/5m/1h/1d)"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/hosts/{id}/state/details [get]
+func (h *HostHandler) GetStateDetails(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Invalid host ID",
+		})
+		return
+	}
+
+	start := c.Query("start")
+	end := c.Query("end")
+	interval := c.DefaultQuery("interval", "auto")
+
+	states, err := h.hostService.GetHostStateDetails(c.Request.Context(), id, start, end, interval)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to get state details",
+		})
+		return
+	}
+
+	startTime, _ := time.Parse(time.RFC3339, start)
+	endTime, _ := time.Parse(time.RFC3339, end)
 	c.JSON(http.StatusOK, gin.H{
 		"code":    0,
 		"message": "success",
@@ -519,6 +576,97 @@ func (h *HostHandler) GetHistoryState(c *gin.Context) {
 	})
 }
 
+// ListCustomMetrics godoc
+// @Summary List plugin metrics reported by a host
+// @Tags hosts
+// @Produce json
+// @Param id path string true "Host ID (UUID)"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/hosts/{id}/state/custom-metrics [get]
+func (h *HostHandler) ListCustomMetrics(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Invalid host ID",
+		})
+		return
+	}
+
+	names, err := h.hostService.GetCustomMetricNames(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to list custom metrics",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "success",
+		"data":    names,
+	})
+}
+
+// GetCustomMetricHistory godoc
+// @Summary Get the history of a plugin metric
+// @Tags hosts
+// @Produce json
+// @Param id path string true "Host ID (UUID)"
+// @Param name query string true "Metric name"
+// @Param start query string true "Start time (RFC3339)"
+// @Param end query string true "End time (RFC3339)"
+// @Param interval query string false "Interval (auto/1m/5m/1h/1d)"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/vms/hosts/{id}/state/custom-metrics/history [get]
+func (h *HostHandler) GetCustomMetricHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Invalid host ID",
+		})
+		return
+	}
+
+	name := c.Query("name")
+	if name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    40001,
+			"message": "Metric name is required",
+		})
+		return
+	}
+
+	start := c.Query("start")
+	end := c.Query("end")
+	interval := c.DefaultQuery("interval", "auto")
+
+	series, err := h.hostService.GetCustomMetricHistory(c.Request.Context(), id, name, start, end, interval)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    50001,
+			"message": "Failed to get custom metric history",
+		})
+		return
+	}
+
+	startTime, _ := time.Parse(time.RFC3339, start)
+	endTime, _ := time.Parse(time.RFC3339, end)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    0,
+		"message": "success",
+		"data": gin.H{
+			"name":     name,
+			"start":    startTime,
+			"end":      endTime,
+			"interval": interval,
+			"series":   series,
+		},
+	})
+}
+
 // RegenerateSecretKey godoc
 // @Summary Regenerate host secret key
 // @Description Regenerates the secret key for a host and disconnects current agent
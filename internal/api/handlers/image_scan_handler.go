@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/docker"
+	"github.com/ysicing/tiga/internal/services/imagescan"
+	"github.com/ysicing/tiga/internal/services/k8s"
+)
+
+// ImageScanHandler handles image vulnerability scans and their per
+// container and per workload summaries
+type ImageScanHandler struct {
+	scans           *imagescan.Service
+	instanceService *docker.DockerInstanceService
+	inventory       *docker.ImageInventory
+	workloads       *k8s.WorkloadImageLister
+}
+
+// NewImageScanHandler creates a new image scan handler
+func NewImageScanHandler(
+	scans *imagescan.Service,
+	instanceService *docker.DockerInstanceService,
+	inventory *docker.ImageInventory,
+	workloads *k8s.WorkloadImageLister,
+) *ImageScanHandler {
+	return &ImageScanHandler{
+		scans:           scans,
+		instanceService: instanceService,
+		inventory:       inventory,
+		workloads:       workloads,
+	}
+}
+
+// ScanImageRequest requests a scan of one image
+type ScanImageRequest struct {
+	Image  string `json:"image" binding:"required"`
+	Digest string `json:"digest"`
+	Force  bool   `json:"force"` // Rescan even when a fresh cached result exists
+}
+
+// ContainerVulnerabilities is a container image with its scan summary
+type ContainerVulnerabilities struct {
+	docker.ContainerImage
+	Scan *models.ImageScan `json:"scan"` // Nil when the image was not scanned yet
+}
+
+// WorkloadContainerVulnerabilities is a workload container image with its
+// scan summary
+type WorkloadContainerVulnerabilities struct {
+	Container string            `json:"container"`
+	Image     string            `json:"image"`
+	ImageRef  string            `json:"image_ref"`
+	Digest    string            `json:"digest"`
+	Scan      *models.ImageScan `json:"scan"` // Nil when the image was not scanned yet
+}
+
+// WorkloadVulnerabilities sums the vulnerabilities of a workload's
+// container images
+type WorkloadVulnerabilities struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	models.VulnerabilityCounts
+	Containers []WorkloadContainerVulnerabilities `json:"containers"`
+}
+
+// ListScans godoc
+// @Summary List image scan results
+// @Description Scan summaries without vulnerability and package lists, most vulnerable first
+// @Tags ImageScans
+// @Produce json
+// @Param status query string false "Filter by status (completed, failed)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /api/v1/image-scans [get]
+func (h *ImageScanHandler) ListScans(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	page = max(page, 1)
+	pageSize = clamp(pageSize, 1, 100)
+
+	scans, total, err := h.scans.List(c.Request.Context(), c.Query("status"), page, pageSize)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+	RespondPaginated(c, scans, page, pageSize, total)
+}
+
+// GetScan godoc
+// @Summary Get an image scan result
+// @Description Includes the vulnerabilities and, for scanners that report it, the package inventory (SBOM)
+// @Tags ImageScans
+// @Produce json
+// @Param id path string true "Scan ID"
+// @Success 200 {object} SuccessResponse{data=models.ImageScan}
+// @Router /api/v1/image-scans/{id} [get]
+func (h *ImageScanHandler) GetScan(c *gin.Context) {
+	id, err := ParseUUID(c.Param("id"))
+	if err != nil {
+		RespondBadRequest(c, err)
+		return
+	}
+
+	scan, err := h.scans.Get(c.Request.Context(), id)
+	if err != nil {
+		RespondNotFound(c, err)
+		return
+	}
+	RespondSuccess(c, scan)
+}
+
+// ScanImage godoc
+// @Summary Scan an image
+// @Description Returns the cached result of the digest unless force is set or the result expired
+// @Tags ImageScans
+// @Accept json
+// @Produce json
+// @Param request body ScanImageRequest true "Image to scan"
+// @Success 200 {object} SuccessResponse{data=models.ImageScan}
+// @Router /api/v1/image-scans [post]
+func (h *ImageScanHandler) ScanImage(c *gin.Context) {
+	var req ScanImageRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	scan, err := h.scans.ScanImage(c.Request.Context(), imagescan.ImageRef{Image: req.Image, Digest: req.Digest}, req.Force)
+	if err != nil {
+		if errors.Is(err, imagescan.ErrScannerDisabled) {
+			RespondBadRequest(c, err)
+			return
+		}
+		RespondInternalError(c, err)
+		return
+	}
+	RespondSuccess(c, scan)
+}
+
+// GetInstanceVulnerabilities godoc
+// @Summary List the vulnerability counts of the containers on a Docker instance
+// @Tags ImageScans
+// @Produce json
+// @Param id path string true "Instance ID"
+// @Success 200 {object} SuccessResponse{data=[]ContainerVulnerabilities}
+// @Router /api/v1/docker/instances/{id}/vulnerabilities [get]
+func (h *ImageScanHandler) GetInstanceVulnerabilities(c *gin.Context) {
+	id, err := ParseUUID(c.Param("id"))
+	if err != nil {
+		RespondBadRequest(c, err)
+		return
+	}
+
+	instance, err := h.instanceService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		RespondNotFound(c, err)
+		return
+	}
+
+	containers, err := h.inventory.ListContainerImages(c.Request.Context(), instance)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+
+	digests := make([]string, 0, len(containers))
+	for _, container := range containers {
+		digests = append(digests, container.Digest)
+	}
+	summaries, err := h.scans.Summaries(c.Request.Context(), digests)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+
+	result := make([]ContainerVulnerabilities, 0, len(containers))
+	for _, container := range containers {
+		result = append(result, ContainerVulnerabilities{
+			ContainerImage: container,
+			Scan:           summaries[container.Digest],
+		})
+	}
+	RespondSuccess(c, result)
+}
+
+// GetClusterVulnerabilities godoc
+// @Summary List the vulnerability counts of the workloads in a cluster
+// @Tags ImageScans
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param namespace query string false "Namespace, all namespaces when empty"
+// @Success 200 {object} SuccessResponse{data=[]WorkloadVulnerabilities}
+// @Router /api/v1/k8s/clusters/{id}/vulnerabilities [get]
+func (h *ImageScanHandler) GetClusterVulnerabilities(c *gin.Context) {
+	id, err := ParseUUID(c.Param("id"))
+	if err != nil {
+		RespondBadRequest(c, err)
+		return
+	}
+
+	images, err := h.workloads.ListWorkloadImages(c.Request.Context(), id, c.Query("namespace"))
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+
+	digests := make([]string, 0, len(images))
+	for _, image := range images {
+		if image.Digest != "" {
+			digests = append(digests, image.Digest)
+		}
+	}
+	summaries, err := h.scans.Summaries(c.Request.Context(), digests)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+
+	// Images are sorted by workload, so containers of a workload are adjacent
+	result := make([]WorkloadVulnerabilities, 0)
+	index := make(map[string]int)
+	for _, image := range images {
+		key := fmt.Sprintf("%s/%s/%s", image.Namespace, image.Kind, image.Name)
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			result = append(result, WorkloadVulnerabilities{
+				Namespace: image.Namespace,
+				Kind:      image.Kind,
+				Name:      image.Name,
+			})
+		}
+
+		scan := summaries[image.Digest]
+		if scan != nil && scan.Status == models.ImageScanStatusCompleted {
+			counts := &result[i].VulnerabilityCounts
+			counts.Critical += scan.Critical
+			counts.High += scan.High
+			counts.Medium += scan.Medium
+			counts.Low += scan.Low
+			counts.Unknown += scan.Unknown
+		}
+		result[i].Containers = append(result[i].Containers, WorkloadContainerVulnerabilities{
+			Container: image.Container,
+			Image:     image.Image,
+			ImageRef:  image.ImageRef,
+			Digest:    image.Digest,
+			Scan:      scan,
+		})
+	}
+	RespondSuccess(c, result)
+}
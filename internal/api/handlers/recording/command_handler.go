@@ -0,0 +1,132 @@
+package recording
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/api/handlers"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/recording"
+)
+
+// CommandHandler handles the commands indexed from terminal recordings
+type CommandHandler struct {
+	indexService *recording.CommandIndexService
+}
+
+// NewCommandHandler creates a new command handler instance
+func NewCommandHandler(indexService *recording.CommandIndexService) *CommandHandler {
+	return &CommandHandler{
+		indexService: indexService,
+	}
+}
+
+// SearchCommandsRequest defines query parameters for searching commands
+type SearchCommandsRequest struct {
+	Query         string     `form:"q"`
+	Page          int        `form:"page" binding:"omitempty,min=1"`
+	Limit         int        `form:"limit" binding:"omitempty,min=1,max=100"`
+	UserID        *uuid.UUID `form:"user_id" binding:"omitempty,uuid"`
+	RecordingType string     `form:"recording_type" binding:"omitempty,oneof=docker webssh k8s_node k8s_pod"`
+	StartTime     *time.Time `form:"start_time" binding:"omitempty" time_format:"2006-01-02T15:04:05Z07:00"`
+	EndTime       *time.Time `form:"end_time" binding:"omitempty" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// SearchCommands searches the commands submitted in all terminal recordings
+// @Summary Search terminal commands
+// @Description Search the command lines submitted in Docker, WebSSH and Kubernetes terminal sessions. Each result carries the recording ID and the offset in seconds for playback from that moment.
+// @Tags recordings
+// @Accept json
+// @Produce json
+// @Param q query string false "Substring of the command line"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param user_id query string false "Filter by user ID (UUID)"
+// @Param recording_type query string false "Filter by recording type (docker, webssh, k8s_node, k8s_pod)"
+// @Param start_time query string false "Executed at or after (RFC3339 format)"
+// @Param end_time query string false "Executed at or before (RFC3339 format)"
+// @Success 200 {object} ListRecordingsResponse
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/recordings/commands [get]
+// @Security BearerAuth
+func (h *CommandHandler) SearchCommands(c *gin.Context) {
+	var req SearchCommandsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		handlers.RespondBadRequest(c, err)
+		return
+	}
+
+	// Set defaults
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	commands, total, err := h.indexService.SearchCommands(c.Request.Context(), &repository.TerminalCommandFilter{
+		Query:         req.Query,
+		UserID:        req.UserID,
+		RecordingType: req.RecordingType,
+		Since:         req.StartTime,
+		Until:         req.EndTime,
+		Page:          req.Page,
+		PageSize:      req.Limit,
+	})
+	if err != nil {
+		handlers.RespondInternalError(c, err)
+		return
+	}
+
+	// Calculate total pages
+	totalPages := int(total) / req.Limit
+	if int(total)%req.Limit > 0 {
+		totalPages++
+	}
+
+	handlers.RespondSuccess(c, ListRecordingsResponse{
+		Items:      commands,
+		Total:      total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalPages: totalPages,
+	})
+}
+
+// ListRecordingCommands lists the commands submitted in a terminal recording
+// @Summary List recording commands
+// @Description List the command lines submitted in a terminal recording in order, with their offsets in seconds for playback
+// @Tags recordings
+// @Accept json
+// @Produce json
+// @Param id path string true "Recording ID (UUID)"
+// @Success 200 {array} models.TerminalCommand
+// @Failure 400 {object} handlers.ErrorResponse
+// @Failure 404 {object} handlers.ErrorResponse
+// @Failure 500 {object} handlers.ErrorResponse
+// @Router /api/v1/recordings/{id}/commands [get]
+// @Security BearerAuth
+func (h *CommandHandler) ListRecordingCommands(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		handlers.RespondBadRequest(c, err)
+		return
+	}
+
+	commands, err := h.indexService.ListRecordingCommands(c.Request.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		handlers.RespondNotFound(c, err)
+		return
+	}
+	if err != nil {
+		handlers.RespondInternalError(c, err)
+		return
+	}
+
+	handlers.RespondSuccess(c, commands)
+}
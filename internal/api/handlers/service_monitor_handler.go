@@ -15,6 +15,7 @@ import (
 
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/services/monitor"
+	"github.com/ysicing/tiga/pkg/healthcheck"
 )
 
 // ServiceMonitorHandler handles service monitoring operations
@@ -40,6 +41,18 @@ func (h *ServiceMonitorHandler) CreateMonitor(c *gin.Context) {
 		ProbeGroupName  string `json:"probe_group_name"` // Node group name
 		Enabled         bool   `json:"enabled"`
 		NotifyOnFailure bool   `json:"notify_on_failure"`
+
+		// Type-specific configuration
+		JSONPath            string                      `json:"json_path"`
+		JSONExpect          string                      `json:"json_expect"`
+		DNSRecordType       string                      `json:"dns_record_type"`
+		DNSExpect           string                      `json:"dns_expect"`
+		DNSResolver         string                      `json:"dns_resolver"`
+		GRPCService         string                      `json:"grpc_service"`
+		GRPCTLS             bool                        `json:"grpc_tls"`
+		TLSServerName       string                      `json:"tls_server_name"`
+		TLSMinDaysRemaining int                         `json:"tls_min_days_remaining"`
+		SyntheticSteps      []healthcheck.SyntheticStep `json:"synthetic_steps"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -65,6 +78,21 @@ func (h *ServiceMonitorHandler) CreateMonitor(c *gin.Context) {
 		ProbeGroupName:  req.ProbeGroupName,
 		Enabled:         req.Enabled,
 		NotifyOnFailure: req.NotifyOnFailure,
+
+		JSONPath:            req.JSONPath,
+		JSONExpect:          req.JSONExpect,
+		DNSRecordType:       req.DNSRecordType,
+		DNSExpect:           req.DNSExpect,
+		DNSResolver:         req.DNSResolver,
+		GRPCService:         req.GRPCService,
+		GRPCTLS:             req.GRPCTLS,
+		TLSServerName:       req.TLSServerName,
+		TLSMinDaysRemaining: req.TLSMinDaysRemaining,
+		SyntheticSteps:      req.SyntheticSteps,
+	}
+	if err := validateProbeConfig(mon); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"code": 40002, "message": fmt.Sprintf("Invalid probe configuration: %v", err)})
+		return
 	}
 
 	// Default to server strategy if not specified
@@ -116,6 +144,18 @@ func (h *ServiceMonitorHandler) UpdateMonitor(c *gin.Context) {
 		ProbeGroupName  *string `json:"probe_group_name"`
 		Enabled         *bool   `json:"enabled"`
 		NotifyOnFailure *bool   `json:"notify_on_failure"`
+
+		// Type-specific configuration
+		JSONPath            *string                     `json:"json_path"`
+		JSONExpect          *string                     `json:"json_expect"`
+		DNSRecordType       *string                     `json:"dns_record_type"`
+		DNSExpect           *string                     `json:"dns_expect"`
+		DNSResolver         *string                     `json:"dns_resolver"`
+		GRPCService         *string                     `json:"grpc_service"`
+		GRPCTLS             *bool                       `json:"grpc_tls"`
+		TLSServerName       *string                     `json:"tls_server_name"`
+		TLSMinDaysRemaining *int                        `json:"tls_min_days_remaining"`
+		SyntheticSteps      []healthcheck.SyntheticStep `json:"synthetic_steps"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -166,6 +206,40 @@ func (h *ServiceMonitorHandler) UpdateMonitor(c *gin.Context) {
 	if req.NotifyOnFailure != nil {
 		mon.NotifyOnFailure = *req.NotifyOnFailure
 	}
+	if req.JSONPath != nil {
+		mon.JSONPath = *req.JSONPath
+	}
+	if req.JSONExpect != nil {
+		mon.JSONExpect = *req.JSONExpect
+	}
+	if req.DNSRecordType != nil {
+		mon.DNSRecordType = *req.DNSRecordType
+	}
+	if req.DNSExpect != nil {
+		mon.DNSExpect = *req.DNSExpect
+	}
+	if req.DNSResolver != nil {
+		mon.DNSResolver = *req.DNSResolver
+	}
+	if req.GRPCService != nil {
+		mon.GRPCService = *req.GRPCService
+	}
+	if req.GRPCTLS != nil {
+		mon.GRPCTLS = *req.GRPCTLS
+	}
+	if req.TLSServerName != nil {
+		mon.TLSServerName = *req.TLSServerName
+	}
+	if req.TLSMinDaysRemaining != nil {
+		mon.TLSMinDaysRemaining = *req.TLSMinDaysRemaining
+	}
+	if req.SyntheticSteps != nil {
+		mon.SyntheticSteps = req.SyntheticSteps
+	}
+	if err := validateProbeConfig(mon); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"code": 40002, "message": fmt.Sprintf("Invalid probe configuration: %v", err)})
+		return
+	}
 
 	if err := h.probeService.UpdateMonitor(c.Request.Context(), mon); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"code": 50001, "message": "Failed to update"})
@@ -382,6 +456,15 @@ func validateAndCleanTarget(target string, probeType models.ProbeType) (string,
 		return validateICMPTarget(cleaned)
 	case models.ProbeTypeTCP:
 		return validateTCPTarget(cleaned)
+	case models.ProbeTypeDNS:
+		return validateDNSTarget(cleaned)
+	case models.ProbeTypeGRPC:
+		return validateHostPortTarget(cleaned, "")
+	case models.ProbeTypeTLS:
+		return validateHostPortTarget(cleaned, "443")
+	case models.ProbeTypeSynthetic:
+		// The steps carry the URLs; the target only labels the transaction
+		return cleaned, nil
 	default:
 		return "", fmt.Errorf("unsupported probe type: %s", probeType)
 	}
@@ -482,3 +565,59 @@ func validateHost(host string) bool {
 	hostnameRegex := regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?$`)
 	return hostnameRegex.MatchString(host)
 }
+
+// validateDNSTarget validates the name to resolve; IP addresses are allowed for PTR lookups
+func validateDNSTarget(target string) (string, error) {
+	target = strings.TrimSuffix(target, ".")
+	if !validateHost(target) {
+		return "", fmt.Errorf("target must be a valid domain name")
+	}
+	return target, nil
+}
+
+// validateHostPortTarget validates a "host:port" target; when defaultPort is
+// set a bare host is accepted and the port appended
+func validateHostPortTarget(target, defaultPort string) (string, error) {
+	host, port, err := net.SplitHostPort(target)
+	if err != nil {
+		if defaultPort == "" {
+			return "", fmt.Errorf("invalid target format (expected host:port)")
+		}
+		host, port = target, defaultPort
+	}
+
+	if !validateHost(host) {
+		return "", fmt.Errorf("invalid host in target")
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil || portNum < 1 || portNum > 65535 {
+		return "", fmt.Errorf("port must be between 1 and 65535")
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+// validateProbeConfig validates the type-specific probe configuration
+func validateProbeConfig(mon *models.ServiceMonitor) error {
+	if mon.JSONPath != "" {
+		if mon.Type != models.ProbeTypeHTTP {
+			return fmt.Errorf("json_path is only supported for HTTP monitors")
+		}
+		if _, err := healthcheck.CompileJSONPath(mon.JSONPath); err != nil {
+			return err
+		}
+	}
+	if mon.Type == models.ProbeTypeDNS && !healthcheck.ValidDNSRecordType(mon.DNSRecordType) {
+		return fmt.Errorf("unsupported DNS record type: %s", mon.DNSRecordType)
+	}
+	mon.DNSRecordType = strings.ToUpper(mon.DNSRecordType)
+	if mon.TLSMinDaysRemaining < 0 {
+		return fmt.Errorf("tls_min_days_remaining must not be negative")
+	}
+	if mon.Type == models.ProbeTypeSynthetic {
+		if err := healthcheck.ValidateSyntheticSteps(mon.SyntheticSteps); err != nil {
+			return fmt.Errorf("invalid synthetic steps: %w", err)
+		}
+	}
+	return nil
+}
@@ -132,6 +132,50 @@ func TestValidateAndCleanTarget(t *testing.T) {
 			wantErr:   true,
 		},
 
+		// DNS, gRPC and TLS tests
+		{
+			name:      "DNS domain with trailing dot",
+			target:    "example.com.",
+			probeType: models.ProbeTypeDNS,
+			want:      "example.com",
+			wantErr:   false,
+		},
+		{
+			name:      "DNS invalid name",
+			target:    "exa mple.com",
+			probeType: models.ProbeTypeDNS,
+			want:      "",
+			wantErr:   true,
+		},
+		{
+			name:      "gRPC with host and port",
+			target:    "grpc.example.com:50051",
+			probeType: models.ProbeTypeGRPC,
+			want:      "grpc.example.com:50051",
+			wantErr:   false,
+		},
+		{
+			name:      "gRPC without port",
+			target:    "grpc.example.com",
+			probeType: models.ProbeTypeGRPC,
+			want:      "",
+			wantErr:   true,
+		},
+		{
+			name:      "TLS defaults to port 443",
+			target:    "example.com",
+			probeType: models.ProbeTypeTLS,
+			want:      "example.com:443",
+			wantErr:   false,
+		},
+		{
+			name:      "TLS invalid port",
+			target:    "example.com:0",
+			probeType: models.ProbeTypeTLS,
+			want:      "",
+			wantErr:   true,
+		},
+
 		// Edge cases
 		{
 			name:      "Empty target",
@@ -162,3 +206,27 @@ func TestValidateAndCleanTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProbeConfig(t *testing.T) {
+	mon := &models.ServiceMonitor{Type: models.ProbeTypeDNS, DNSRecordType: "mx"}
+	if err := validateProbeConfig(mon); err != nil {
+		t.Fatalf("validateProbeConfig() error = %v", err)
+	}
+	if mon.DNSRecordType != "MX" {
+		t.Errorf("DNSRecordType = %q, want MX", mon.DNSRecordType)
+	}
+
+	invalid := []*models.ServiceMonitor{
+		{Type: models.ProbeTypeDNS, DNSRecordType: "HINFO"},
+		{Type: models.ProbeTypeHTTP, JSONPath: "$.items[x]"},
+		{Type: models.ProbeTypeTCP, JSONPath: "$.status"},
+		{Type: models.ProbeTypeTLS, TLSMinDaysRemaining: -1},
+		{Type: models.ProbeTypeSynthetic},
+		{Type: models.ProbeTypeSynthetic, SyntheticSteps: models.SyntheticSteps{{URL: "http://example.com/{{id}}"}}},
+	}
+	for _, mon := range invalid {
+		if err := validateProbeConfig(mon); err == nil {
+			t.Errorf("validateProbeConfig(%+v) expected error", mon)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/api/middleware"
+	"github.com/ysicing/tiga/internal/services/statuspage"
+)
+
+// StatusPageHandler handles status page management and the public status endpoints
+type StatusPageHandler struct {
+	statusPages *statuspage.StatusPageService
+}
+
+// NewStatusPageHandler creates a new status page handler
+func NewStatusPageHandler(statusPages *statuspage.StatusPageService) *StatusPageHandler {
+	return &StatusPageHandler{statusPages: statusPages}
+}
+
+// ListPages lists all status pages
+// @Summary List status pages
+// @Tags status-pages
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /api/v1/vms/status-pages [get]
+func (h *StatusPageHandler) ListPages(c *gin.Context) {
+	pages, err := h.statusPages.List(c.Request.Context())
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+	RespondSuccess(c, pages)
+}
+
+// GetPage returns a status page configuration
+// @Summary Get status page
+// @Tags status-pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Status page ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages/{id} [get]
+func (h *StatusPageHandler) GetPage(c *gin.Context) {
+	id, ok := h.parseID(c, "id")
+	if !ok {
+		return
+	}
+
+	page, err := h.statusPages.Get(c.Request.Context(), id)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondSuccess(c, page)
+}
+
+// CreatePage creates a status page
+// @Summary Create status page
+// @Description Sections select which service monitors and hosts appear on the page
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body statuspage.StatusPageRequest true "Status page"
+// @Success 201 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages [post]
+func (h *StatusPageHandler) CreatePage(c *gin.Context) {
+	var req statuspage.StatusPageRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	page, err := h.statusPages.Create(c.Request.Context(), &req)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondCreated(c, page)
+}
+
+// UpdatePage replaces a status page configuration
+// @Summary Update status page
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Status page ID"
+// @Param request body statuspage.StatusPageRequest true "Status page"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages/{id} [put]
+func (h *StatusPageHandler) UpdatePage(c *gin.Context) {
+	id, ok := h.parseID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req statuspage.StatusPageRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	page, err := h.statusPages.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondSuccess(c, page)
+}
+
+// DeletePage deletes a status page and its incidents
+// @Summary Delete status page
+// @Tags status-pages
+// @Security BearerAuth
+// @Param id path string true "Status page ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages/{id} [delete]
+func (h *StatusPageHandler) DeletePage(c *gin.Context) {
+	id, ok := h.parseID(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.statusPages.Delete(c.Request.Context(), id); err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondNoContent(c)
+}
+
+// ListIncidents lists the incidents of a status page
+// @Summary List incidents
+// @Tags status-pages
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Status page ID"
+// @Success 200 {object} SuccessResponse
+// @Router /api/v1/vms/status-pages/{id}/incidents [get]
+func (h *StatusPageHandler) ListIncidents(c *gin.Context) {
+	id, ok := h.parseID(c, "id")
+	if !ok {
+		return
+	}
+
+	incidents, err := h.statusPages.ListIncidents(c.Request.Context(), id, time.Time{}, 0)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+	RespondSuccess(c, incidents)
+}
+
+// CreateIncident opens an incident on a status page
+// @Summary Create incident
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Status page ID"
+// @Param request body statuspage.CreateIncidentRequest true "Incident"
+// @Success 201 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages/{id}/incidents [post]
+func (h *StatusPageHandler) CreateIncident(c *gin.Context) {
+	id, ok := h.parseID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req statuspage.CreateIncidentRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	incident, err := h.statusPages.CreateIncident(c.Request.Context(), id, &req, userID)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondCreated(c, incident)
+}
+
+// AddIncidentUpdate posts a progress update to an incident
+// @Summary Post incident update
+// @Description Adds an update and moves the incident to the given status; "resolved" closes it
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param incident_id path string true "Incident ID"
+// @Param request body statuspage.IncidentUpdateRequest true "Update"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages/incidents/{incident_id}/updates [post]
+func (h *StatusPageHandler) AddIncidentUpdate(c *gin.Context) {
+	id, ok := h.parseID(c, "incident_id")
+	if !ok {
+		return
+	}
+
+	var req statuspage.IncidentUpdateRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	incident, err := h.statusPages.AddIncidentUpdate(c.Request.Context(), id, &req, userID)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondSuccess(c, incident)
+}
+
+// DeleteIncident deletes an incident
+// @Summary Delete incident
+// @Tags status-pages
+// @Security BearerAuth
+// @Param incident_id path string true "Incident ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/vms/status-pages/incidents/{incident_id} [delete]
+func (h *StatusPageHandler) DeleteIncident(c *gin.Context) {
+	id, ok := h.parseID(c, "incident_id")
+	if !ok {
+		return
+	}
+
+	if err := h.statusPages.DeleteIncident(c.Request.Context(), id); err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+	RespondNoContent(c)
+}
+
+// GetPublicStatus returns the public view of a published status page
+// @Summary Public status page
+// @Description Unauthenticated status of the page's monitors and hosts with 90-day uptime bars and incidents
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status page slug"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/status/{slug} [get]
+func (h *StatusPageHandler) GetPublicStatus(c *gin.Context) {
+	view, err := h.statusPages.Render(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=30")
+	RespondSuccess(c, view)
+}
+
+// GetRSSFeed returns the incidents of a published status page as RSS
+// @Summary Status page RSS feed
+// @Tags status-pages
+// @Produce xml
+// @Param slug path string true "Status page slug"
+// @Success 200 {string} string "RSS 2.0 feed"
+// @Router /api/v1/status/{slug}/rss [get]
+func (h *StatusPageHandler) GetRSSFeed(c *gin.Context) {
+	h.serveFeed(c, "application/rss+xml; charset=utf-8", statuspage.RSSFeed)
+}
+
+// GetAtomFeed returns the incidents of a published status page as Atom
+// @Summary Status page Atom feed
+// @Tags status-pages
+// @Produce xml
+// @Param slug path string true "Status page slug"
+// @Success 200 {string} string "Atom feed"
+// @Router /api/v1/status/{slug}/atom [get]
+func (h *StatusPageHandler) GetAtomFeed(c *gin.Context) {
+	h.serveFeed(c, "application/atom+xml; charset=utf-8", statuspage.AtomFeed)
+}
+
+func (h *StatusPageHandler) serveFeed(c *gin.Context, contentType string, render func(*statuspage.PublicStatusPage, string) ([]byte, error)) {
+	view, err := h.statusPages.Render(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	link := fmt.Sprintf("%s://%s/status/%s", scheme, c.Request.Host, view.Slug)
+
+	data, err := render(view, link)
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=60")
+	c.Data(http.StatusOK, contentType, data)
+}
+
+func (h *StatusPageHandler) parseID(c *gin.Context, param string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		RespondBadRequest(c, fmt.Errorf("invalid %s", param))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *StatusPageHandler) respondServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, statuspage.ErrStatusPageNotFound), errors.Is(err, statuspage.ErrIncidentNotFound):
+		RespondNotFound(c, err)
+	case errors.Is(err, statuspage.ErrSlugTaken):
+		RespondConflict(c, err)
+	case errors.Is(err, statuspage.ErrInvalidInput):
+		RespondBadRequest(c, err)
+	default:
+		RespondInternalError(c, err)
+	}
+}
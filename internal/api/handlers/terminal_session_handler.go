@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/terminal"
+)
+
+// TerminalSessionHandler lets admins watch and terminate live WebSSH, Docker
+// and Kubernetes terminal sessions
+type TerminalSessionHandler struct {
+	terminals *terminal.Registry
+}
+
+// NewTerminalSessionHandler creates a new terminal session handler
+func NewTerminalSessionHandler(terminals *terminal.Registry) *TerminalSessionHandler {
+	return &TerminalSessionHandler{terminals: terminals}
+}
+
+// TerminateTerminalSessionRequest carries the reason shown to the session owner
+type TerminateTerminalSessionRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// TerminalViewerMessage is a message sent to an admin watching a session
+type TerminalViewerMessage struct {
+	Type    string                `json:"type"`              // "session", "output", "resize", "closed"
+	Session *terminal.SessionInfo `json:"session,omitempty"` // Session description (session)
+	Data    string                `json:"data,omitempty"`    // Base64 encoded output (output)
+	Cols    uint16                `json:"cols,omitempty"`    // Terminal columns (resize)
+	Rows    uint16                `json:"rows,omitempty"`    // Terminal rows (resize)
+	Reason  string                `json:"reason,omitempty"`  // Why the stream ended (closed)
+}
+
+// ListSessions lists the active terminal sessions
+// @Summary List live terminal sessions
+// @Description List the active WebSSH, Docker exec and Kubernetes terminal sessions with the admins viewing them
+// @Tags terminal-sessions
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=[]terminal.SessionInfo}
+// @Failure 403 {object} ErrorResponse
+// @Router /api/v1/terminal-sessions [get]
+func (h *TerminalSessionHandler) ListSessions(c *gin.Context) {
+	RespondSuccess(c, h.terminals.List())
+}
+
+// JoinSession streams the output of a live terminal session
+// @Summary Watch a live terminal session
+// @Description Read-only WebSocket mirroring the output of an active terminal session. The owner is told who is watching, and joining is audited. Output is sent base64 encoded; input from the viewer is ignored.
+// @Tags terminal-sessions
+// @Param id path string true "Terminal session ID"
+// @Security BearerAuth
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/terminal-sessions/{id}/join [get]
+func (h *TerminalSessionHandler) JoinSession(c *gin.Context) {
+	session, err := h.terminals.Get(c.Param("id"))
+	if err != nil {
+		RespondNotFound(c, err)
+		return
+	}
+
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		logrus.Errorf("WebSocket upgrade failed: %v", err)
+		return
+	}
+	defer ws.Close()
+
+	viewer, err := h.terminals.Join(session.ID(), terminalActor(c))
+	if err != nil {
+		ws.WriteJSON(TerminalViewerMessage{Type: "closed", Reason: err.Error()})
+		return
+	}
+	defer viewer.Leave()
+
+	info := session.Info()
+	if err := ws.WriteJSON(TerminalViewerMessage{Type: "session", Session: &info}); err != nil {
+		return
+	}
+
+	// Discard anything the viewer sends; stop when it disconnects
+	gone := make(chan struct{})
+	go func() {
+		defer close(gone)
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case event, ok := <-viewer.Events():
+			if !ok {
+				ws.WriteJSON(TerminalViewerMessage{Type: "closed", Reason: viewer.Reason()})
+				ws.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+				return
+			}
+
+			msg := TerminalViewerMessage{Type: string(event.Type)}
+			switch event.Type {
+			case terminal.EventOutput:
+				msg.Data = base64.StdEncoding.EncodeToString(event.Data)
+			case terminal.EventResize:
+				msg.Cols = event.Cols
+				msg.Rows = event.Rows
+			}
+			if err := ws.WriteJSON(msg); err != nil {
+				return
+			}
+
+		case <-gone:
+			return
+		}
+	}
+}
+
+// TerminateSession closes a live terminal session
+// @Summary Terminate a live terminal session
+// @Description Close the underlying stream of an active terminal session. The reason is shown to the owner and recorded in the audit log.
+// @Tags terminal-sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Terminal session ID"
+// @Param request body TerminateTerminalSessionRequest true "Termination reason"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/terminal-sessions/{id}/terminate [post]
+func (h *TerminalSessionHandler) TerminateSession(c *gin.Context) {
+	var req TerminateTerminalSessionRequest
+	if !BindJSON(c, &req) {
+		return
+	}
+
+	err := h.terminals.Terminate(c.Param("id"), terminalActor(c), req.Reason)
+	if errors.Is(err, terminal.ErrSessionNotFound) {
+		RespondNotFound(c, err)
+		return
+	}
+	if err != nil {
+		RespondInternalError(c, err)
+		return
+	}
+
+	RespondSuccessWithMessage(c, nil, "Terminal session terminated")
+}
+
+// terminalActor identifies the admin making the request
+func terminalActor(c *gin.Context) terminal.Actor {
+	user := c.MustGet("user").(models.User)
+	return terminal.Actor{
+		UserID:    user.ID,
+		Username:  user.Username,
+		ClientIP:  c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
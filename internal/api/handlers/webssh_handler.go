@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -16,7 +18,9 @@ import (
 	"github.com/ysicing/tiga/internal/api/middleware"
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/services/host"
+	"github.com/ysicing/tiga/internal/services/terminal"
 	"github.com/ysicing/tiga/internal/services/webssh"
+	"github.com/ysicing/tiga/pkg/rbac"
 	"github.com/ysicing/tiga/proto"
 )
 
@@ -35,16 +39,19 @@ type WebSSHHandler struct {
 	agentManager *host.AgentManager
 	db           *gorm.DB
 	auditLogger  *host.AuditLogger // T038: 统一审计
+	terminals    *terminal.Registry
 }
 
-// NewWebSSHHandler creates a new WebSSH handler
-func NewWebSSHHandler(sessionMgr *webssh.SessionManager, terminalMgr *host.TerminalManager, agentMgr *host.AgentManager, db *gorm.DB, auditLogger *host.AuditLogger) *WebSSHHandler {
+// NewWebSSHHandler creates a new WebSSH handler. Connected sessions are
+// registered for live viewing and termination by admins.
+func NewWebSSHHandler(sessionMgr *webssh.SessionManager, terminalMgr *host.TerminalManager, agentMgr *host.AgentManager, db *gorm.DB, auditLogger *host.AuditLogger, terminals *terminal.Registry) *WebSSHHandler {
 	return &WebSSHHandler{
 		sessionMgr:   sessionMgr,
 		terminalMgr:  terminalMgr,
 		agentManager: agentMgr,
 		db:           db,
 		auditLogger:  auditLogger,
+		terminals:    terminals,
 	}
 }
 
@@ -82,6 +89,18 @@ func (h *WebSSHHandler) CreateSession(c *gin.Context) {
 		return
 	}
 
+	// WebSSH requires admin or an active just-in-time grant for the host's group
+	user := c.MustGet("user").(models.User)
+	var hostNode models.HostNode
+	if err := h.db.Select("id", "group_name").Where("id = ?", hostUUID).First(&hostNode).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"code": 40404, "message": "Host not found"})
+		return
+	}
+	if !rbac.CanAccessHostGroup(user, hostNode.GroupName, "webssh") {
+		c.JSON(http.StatusForbidden, gin.H{"code": 40300, "message": "WebSSH access to this host group requires an approved access request"})
+		return
+	}
+
 	// Get host info from agent manager
 	conn := h.agentManager.GetConnectionByHostID(hostUUID)
 	if conn == nil {
@@ -228,6 +247,14 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 
 	logrus.Infof("WebSocket connected for session: %s", streamID)
 
+	// Serialize writes from the ping, reader, output and admin goroutines
+	var writeMu sync.Mutex
+	writeMessage := func(msgBytes []byte) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return ws.WriteMessage(websocket.TextMessage, msgBytes)
+	}
+
 	// Send connected message using new protocol
 	connMsg, _ := webssh.NewMessage(webssh.MessageTypeConnected, &webssh.ConnectedMessage{
 		SessionID: streamID,
@@ -237,7 +264,7 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 		Rows:      wsSession.Rows,
 	})
 	if msgBytes, err := json.Marshal(connMsg); err == nil {
-		ws.WriteMessage(websocket.TextMessage, msgBytes)
+		writeMessage(msgBytes)
 	}
 
 	// Set WebSocket timeouts
@@ -260,7 +287,7 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 				h.sessionMgr.UpdateActivity(wsSession.SessionID)
 				pingMsg, _ := webssh.NewMessage(webssh.MessageTypePing, nil)
 				if msgBytes, err := json.Marshal(pingMsg); err == nil {
-					if err := ws.WriteMessage(websocket.TextMessage, msgBytes); err != nil {
+					if err := writeMessage(msgBytes); err != nil {
 						return
 					}
 				}
@@ -277,10 +304,45 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 			Message: message,
 		})
 		if msgBytes, err := json.Marshal(errMsg); err == nil {
-			ws.WriteMessage(websocket.TextMessage, msgBytes)
+			writeMessage(msgBytes)
 		}
 	}
 
+	// Register the session for admins to watch and terminate
+	var terminated atomic.Bool
+	var live *terminal.Session
+	if h.terminals != nil {
+		username, _ := middleware.GetUsername(c)
+		live = h.terminals.Register(terminal.SessionInfo{
+			ID:        streamID,
+			Kind:      terminal.KindWebSSH,
+			UserID:    wsSession.UserID,
+			Username:  username,
+			Target:    wsSession.HostNodeID.String(),
+			ClientIP:  wsSession.ClientIP,
+			StartedAt: wsSession.StartTime,
+			Cols:      uint16(wsSession.Cols),
+			Rows:      uint16(wsSession.Rows),
+		}, terminal.Controls{
+			Notify: func(message string) {
+				outputMsg, _ := webssh.NewMessage(webssh.MessageTypeOutput, &webssh.OutputMessage{
+					Output: base64.StdEncoding.EncodeToString([]byte(message)),
+				})
+				if msgBytes, err := json.Marshal(outputMsg); err == nil {
+					writeMessage(msgBytes)
+				}
+			},
+			Terminate: func(reason string) {
+				terminated.Store(true)
+				sendError(webssh.ErrCodeSessionTerminated, "Session terminated by administrator: "+reason)
+				if err := h.terminalMgr.CloseSession(streamID); err != nil {
+					logrus.Debugf("terminal session close error: %v", err)
+				}
+			},
+		})
+		defer h.terminals.Unregister(live)
+	}
+
 	// Read from WebSocket and send to Agent
 	go func() {
 		defer session.SendToAgent([]byte{0xff}) // Signal end
@@ -325,6 +387,9 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 					}
 					// Update recorder size
 					h.sessionMgr.ResizeRecorder(wsSession.SessionID, resizeMsg.Cols, resizeMsg.Rows)
+					if live != nil {
+						live.Resize(uint16(resizeMsg.Cols), uint16(resizeMsg.Rows))
+					}
 					resizeData, _ := json.Marshal(map[string]int{"cols": resizeMsg.Cols, "rows": resizeMsg.Rows})
 					session.SendToAgent(append([]byte{0x01}, resizeData...))
 
@@ -342,7 +407,7 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 					// Send pong response
 					pongMsg, _ := webssh.NewMessage(webssh.MessageTypePong, nil)
 					if msgBytes, err := json.Marshal(pongMsg); err == nil {
-						ws.WriteMessage(websocket.TextMessage, msgBytes)
+						writeMessage(msgBytes)
 					}
 				}
 			}
@@ -367,12 +432,17 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 			}
 
 			logrus.Errorf("Failed to receive from agent: %v", err)
-			sendError(webssh.ErrCodeConnectionClosed, errorMessage)
+			if !terminated.Load() {
+				sendError(webssh.ErrCodeConnectionClosed, errorMessage)
+			}
 			break
 		}
 
 		// Record output
 		h.sessionMgr.RecordOutput(wsSession.SessionID, data)
+		if live != nil {
+			live.Output(data)
+		}
 
 		// Encode output as base64 for binary safety
 		h.sessionMgr.UpdateActivity(wsSession.SessionID)
@@ -380,7 +450,7 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 			Output: base64.StdEncoding.EncodeToString(data),
 		})
 		if msgBytes, err := json.Marshal(outputMsg); err == nil {
-			if err := ws.WriteMessage(websocket.TextMessage, msgBytes); err != nil {
+			if err := writeMessage(msgBytes); err != nil {
 				logrus.Errorf("WebSocket write error: %v", err)
 				break
 			}
@@ -390,7 +460,7 @@ func (h *WebSSHHandler) HandleWebSocket(c *gin.Context) {
 	// Send disconnected message
 	disconnMsg, _ := webssh.NewMessage(webssh.MessageTypeDisconnected, nil)
 	if msgBytes, err := json.Marshal(disconnMsg); err == nil {
-		ws.WriteMessage(websocket.TextMessage, msgBytes)
+		writeMessage(msgBytes)
 	}
 
 	logrus.Infof("WebSocket closed for session: %s", streamID)
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"strings"
@@ -122,6 +123,22 @@ func AuthRequired() gin.HandlerFunc {
 	return globalJWTMiddleware.AuthRequired()
 }
 
+// RequireBearerToken requires the Authorization header to carry the given
+// static bearer token. Every request is rejected when the token is empty.
+func RequireBearerToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		presented, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "authentication required",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
 // GetUserID retrieves user ID from context
 func GetUserID(c *gin.Context) (uuid.UUID, error) {
 	userIDStr, exists := c.Get(string(UserIDKey))
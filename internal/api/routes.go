@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
@@ -33,9 +34,13 @@ import (
 	dbservices "github.com/ysicing/tiga/internal/services/database"
 	dockerservices "github.com/ysicing/tiga/internal/services/docker"
 	hostservices "github.com/ysicing/tiga/internal/services/host"
+	imagescanservices "github.com/ysicing/tiga/internal/services/imagescan"
+	k8sservices "github.com/ysicing/tiga/internal/services/k8s"
 	monitorservices "github.com/ysicing/tiga/internal/services/monitor"
 	recordingservices "github.com/ysicing/tiga/internal/services/recording"
 	schedulerservices "github.com/ysicing/tiga/internal/services/scheduler"
+	statuspageservices "github.com/ysicing/tiga/internal/services/statuspage"
+	terminalservices "github.com/ysicing/tiga/internal/services/terminal"
 	websshservices "github.com/ysicing/tiga/internal/services/webssh"
 	pkghandlers "github.com/ysicing/tiga/pkg/handlers"
 	pkgmiddleware "github.com/ysicing/tiga/pkg/middleware"
@@ -55,6 +60,7 @@ func SetupRoutes(
 	dockerStreamManager *hostservices.DockerStreamManager,
 	probeScheduler *monitorservices.ServiceProbeScheduler,
 	agentManager *hostservices.AgentManager,
+	accessRequestService *authservices.AccessRequestService,
 	cfg *config.Config,
 ) {
 	// Initialize global JWT auth middleware
@@ -101,6 +107,8 @@ func SetupRoutes(
 	// K8s repositories (Phase 0-3)
 	clusterRepo := repository.NewClusterRepository(db)
 	resourceHistoryRepo := repository.NewResourceHistoryRepository(db)
+	clusterEventRepo := repository.NewClusterEventRepository(db)
+	clusterBaselineRepo := repository.NewClusterBaselineRepository(db)
 
 	// Host monitoring repositories
 	hostRepo := repository.NewHostRepository(db)
@@ -128,6 +136,9 @@ func SetupRoutes(
 	// Audit repositories (T012, T020)
 	auditEventRepo := repository.NewAuditEventRepository(db)
 
+	// Live terminal sessions that admins can watch and terminate
+	terminalRegistry := terminalservices.NewRegistry(auditEventRepo, nil)
+
 	// Initialize session and login services using the shared jwtManager
 	sessionService := authservices.NewSessionService(db)
 	loginService := authservices.NewLoginService(db, jwtManager, sessionService)
@@ -159,17 +170,49 @@ func SetupRoutes(
 	dockerAgentForwarder := dockerservices.NewAgentForwarderV2(agentManager, db) // Use task-queue based forwarder
 	dockerInstanceService := dockerservices.NewDockerInstanceService(db)
 	dockerAuditHelper := dockerservices.NewAuditHelper(auditEventRepo) // T036-T037: Use unified audit system
-	dockerContainerService := dockerservices.NewContainerService(dockerInstanceService, dockerAgentForwarder, dockerAuditHelper)
-	dockerImageService := dockerservices.NewImageService(dockerInstanceService, dockerAgentForwarder, dockerStreamManager, dockerAuditHelper)
+	dockerRegistryCredentialService := dockerservices.NewRegistryCredentialService(db)
+	dockerImageService := dockerservices.NewImageService(dockerInstanceService, dockerAgentForwarder, dockerStreamManager, dockerAuditHelper, dockerRegistryCredentialService)
+	dockerContainerService := dockerservices.NewContainerService(dockerInstanceService, dockerAgentForwarder, dockerAuditHelper, dockerImageService)
+	dockerContainerFileService := dockerservices.NewContainerFileService(dockerInstanceService, dockerAgentForwarder, dockerStreamManager, dockerAuditHelper)
 	// Docker health check service
 	dockerHealthService := dockerservices.NewDockerHealthService(dockerInstanceRepo, dockerAgentForwarder, db)
+	// Docker cleanup policies, run across online instances by the scheduler
+	dockerCleanupService := dockerservices.NewCleanupService(db, dockerInstanceService, dockerAgentForwarder)
+	// Docker container event history, collected by the event collector started in app
+	dockerContainerEventRepo := repository.NewDockerContainerEventRepository(db)
+	dockerContainerEventService := dockerservices.NewContainerEventService(dockerInstanceService, dockerContainerEventRepo)
+	dockerSwarmService := dockerservices.NewSwarmService(dockerInstanceService, dockerAgentForwarder, dockerAuditHelper, dockerRegistryCredentialService)
 	// Unused services for future phases
 	_ = dockerservices.NewDockerCacheService()
 
+	// K8s multi-cluster comparison and baseline drift services
+	clusterComparator := k8sservices.NewClusterComparator(clusterRepo)
+	clusterComparator.SetSecretKey([]byte(cfg.JWT.Secret))
+	baselineService := k8sservices.NewBaselineService(db, clusterBaselineRepo, clusterComparator)
+
+	// Image vulnerability scanning of Docker containers and cluster workloads
+	imageScanner, err := imagescanservices.NewScanner(cfg.ImageScan)
+	if err != nil {
+		logrus.Errorf("Image scanning disabled: %v", err)
+	}
+	imageScanService := imagescanservices.NewService(db, imageScanner, cfg.ImageScan)
+	imageScanService.SetCredentialLookup(func(ctx context.Context, image string) (*imagescanservices.Credentials, error) {
+		_, username, password, found, err := dockerRegistryCredentialService.GlobalLogin(ctx, image)
+		if err != nil || !found {
+			return nil, err
+		}
+		return &imagescanservices.Credentials{Username: username, Password: password}, nil
+	})
+	dockerImageInventory := dockerservices.NewImageInventory(dockerInstanceService, dockerAgentForwarder)
+	workloadImageLister := k8sservices.NewWorkloadImageLister(clusterRepo)
+	imageScanService.AddSource(dockerImageInventory)
+	imageScanService.AddSource(workloadImageLister)
+
 	// Terminal recording services (unified system)
 	recordingStorageService := recordingservices.NewLocalStorageService(cfg)
 	recordingCleanupService := recordingservices.NewCleanupService(recordingRepo, recordingStorageService, cfg)
 	recordingManagerService := recordingservices.NewManagerService(recordingRepo, recordingStorageService)
+	recordingCommandIndexService := recordingservices.NewCommandIndexService(recordingRepo, repository.NewTerminalCommandRepository(db), recordingStorageService)
 
 	// Host monitoring services - use shared instances from app.go to avoid duplicate creation
 	// stateCollector, hostService, terminalManager, and probeScheduler are passed as parameters
@@ -243,6 +286,117 @@ func SetupRoutes(
 		logrus.Info("docker_health_check task registered successfully")
 	}
 
+	// 5. Cluster event cleanup task (daily at 4 AM)
+	// Deletes collected Kubernetes warning events and audit entries older than 30 days
+	clusterEventCleanupTask := schedulerservices.NewClusterEventCleanupTask(clusterEventRepo, 30)
+	if err := schedulerService.AddCron(
+		"cluster_event_cleanup",
+		"0 4 * * *", // Daily at 4:00 AM
+		clusterEventCleanupTask,
+	); err != nil {
+		logrus.Errorf("Failed to register cluster_event_cleanup task: %v", err)
+	} else {
+		logrus.Info("cluster_event_cleanup task registered successfully")
+	}
+
+	// 6. Cluster drift check task (every 15 minutes)
+	// Compares saved baselines against their live clusters and notifies on drift
+	clusterDriftCheckTask := schedulerservices.NewClusterDriftCheckTask(baselineService)
+	if err := schedulerService.AddCron(
+		"cluster_drift_check",
+		"*/15 * * * *", // Every 15 minutes
+		clusterDriftCheckTask,
+	); err != nil {
+		logrus.Errorf("Failed to register cluster_drift_check task: %v", err)
+	} else {
+		logrus.Info("cluster_drift_check task registered successfully")
+	}
+
+	// 7. Service availability rollup task (daily at 00:10)
+	// Aggregates service histories into daily availability rows for status pages
+	statusPageService := statuspageservices.NewStatusPageService(db, func(hostID uuid.UUID) bool {
+		return agentManager != nil && agentManager.GetConnectionByHostID(hostID) != nil
+	})
+	availabilityRollupTask := schedulerservices.NewServiceAvailabilityRollupTask(statusPageService)
+	if err := schedulerService.AddCron(
+		"service_availability_rollup",
+		"10 0 * * *", // Daily at 00:10
+		availabilityRollupTask,
+	); err != nil {
+		logrus.Errorf("Failed to register service_availability_rollup task: %v", err)
+	} else {
+		logrus.Info("service_availability_rollup task registered successfully")
+	}
+
+	// 8. Agent rollout reconcile task (every minute)
+	// Dispatches agent upgrades batch by batch and tracks their outcome
+	agentBinaryStore := hostservices.NewAgentBinaryStore(cfg.AgentUpdate.BinaryDir, cfg.AgentUpdate.ReleaseURL)
+	agentRolloutService := hostservices.NewAgentRolloutService(db, agentManager, agentBinaryStore)
+	agentRolloutReconcileTask := schedulerservices.NewAgentRolloutReconcileTask(agentRolloutService)
+	if err := schedulerService.AddCron(
+		"agent_rollout_reconcile",
+		"* * * * *", // Every minute
+		agentRolloutReconcileTask,
+	); err != nil {
+		logrus.Errorf("Failed to register agent_rollout_reconcile task: %v", err)
+	} else {
+		logrus.Info("agent_rollout_reconcile task registered successfully")
+	}
+
+	// 9. Image scan task (daily at 2 AM by default)
+	// Rescans the images in use and notifies about new critical vulnerabilities
+	if imageScanService.Enabled() {
+		imageScanTask := schedulerservices.NewImageScanTask(imageScanService)
+		if err := schedulerService.AddCron(
+			"image_scan",
+			cfg.ImageScan.Cron,
+			imageScanTask,
+		); err != nil {
+			logrus.Errorf("Failed to register image_scan task: %v", err)
+		} else {
+			logrus.Info("image_scan task registered successfully")
+		}
+	}
+
+	// 10. Docker cleanup task (daily at 3:30 AM)
+	// Runs the enabled cleanup policies on all online Docker instances
+	dockerCleanupTask := schedulerservices.NewDockerCleanupTask(dockerCleanupService)
+	if err := schedulerService.AddCron(
+		"docker_cleanup",
+		"30 3 * * *", // Daily at 3:30 AM
+		dockerCleanupTask,
+	); err != nil {
+		logrus.Errorf("Failed to register docker_cleanup task: %v", err)
+	} else {
+		logrus.Info("docker_cleanup task registered successfully")
+	}
+
+	// 11. Docker container event cleanup task (daily at 4:30 AM)
+	// Deletes collected container die, oom, restart and health events older than 30 days
+	dockerContainerEventCleanupTask := schedulerservices.NewDockerContainerEventCleanupTask(dockerContainerEventRepo, 30)
+	if err := schedulerService.AddCron(
+		"docker_container_event_cleanup",
+		"30 4 * * *", // Daily at 4:30 AM
+		dockerContainerEventCleanupTask,
+	); err != nil {
+		logrus.Errorf("Failed to register docker_container_event_cleanup task: %v", err)
+	} else {
+		logrus.Info("docker_container_event_cleanup task registered successfully")
+	}
+
+	// 12. Terminal command index task (every 5 minutes)
+	// Extracts the commands of finished Docker and WebSSH recordings for search
+	terminalCommandIndexTask := schedulerservices.NewTerminalCommandIndexTask(recordingCommandIndexService)
+	if err := schedulerService.AddCron(
+		"terminal_command_index",
+		"*/5 * * * *", // Every 5 minutes
+		terminalCommandIndexTask,
+	); err != nil {
+		logrus.Errorf("Failed to register terminal_command_index task: %v", err)
+	} else {
+		logrus.Info("terminal_command_index task registered successfully")
+	}
+
 	// Initialize handlers
 	instanceHandler := handlers.NewInstanceHandler(instanceRepo)
 	healthHandler := instances.NewHealthHandler(instanceService)
@@ -257,11 +411,18 @@ func SetupRoutes(
 	schedulerStatsHandler := schedulerhandlers.NewStatsHandler(schedulerStatsCalculator)
 
 	// Audit handlers (T023)
-	auditEventHandler := audithandlers.NewEventHandler(auditEventRepo)
+	auditEventHandler := audithandlers.NewEventHandler(auditEventRepo, cfg.Audit.SigningKey)
 	auditConfigHandler := audithandlers.NewConfigHandler()
 
 	// K8s handlers (Phase 0-4)
 	k8sClusterHandler := clusterhandlers.NewClusterHandler(clusterRepo, resourceHistoryRepo, cfg)
+	k8sEventHandler := clusterhandlers.NewEventHandler(
+		clusterRepo,
+		clusterEventRepo,
+		k8sservices.NewClusterTimelineService(clusterEventRepo, resourceHistoryRepo),
+		jwtSecret,
+	)
+	k8sCompareHandler := clusterhandlers.NewCompareHandler(clusterComparator, clusterBaselineRepo, baselineService)
 
 	// Database management handlers
 	dbInstanceHandler := databasehandlers.NewInstanceHandler(dbManager, dbAuditLogger)
@@ -276,31 +437,41 @@ func SetupRoutes(
 	dockerContainerHandler := dockerhandlers.NewContainerHandler(dockerContainerService, dockerAgentForwarder, dockerAuditHelper)
 	dockerStatsHandler := dockerhandlers.NewContainerStatsHandler(dockerStreamManager, agentManager, db)
 	dockerLogsHandler := dockerhandlers.NewContainerLogsHandler(dockerStreamManager, agentManager, db)
+	dockerContainerFileHandler := dockerhandlers.NewContainerFileHandler(dockerContainerFileService)
+	dockerEventHandler := dockerhandlers.NewEventHandler(dockerContainerEventService)
+	dockerSwarmHandler := dockerhandlers.NewSwarmHandler(dockerSwarmService)
 	dockerImageHandler := dockerhandlers.NewImageHandler(dockerImageService, dockerAgentForwarder, dockerAuditHelper)
+	dockerRegistryHandler := dockerhandlers.NewRegistryHandler(dockerRegistryCredentialService)
+	imageScanHandler := handlers.NewImageScanHandler(imageScanService, dockerInstanceService, dockerImageInventory, workloadImageLister)
 	// T036-T037: dockerAuditHandler 已移除，使用统一审计 API: /api/v1/audit/events?subsystem=docker
 	dockerVolumeHandler := dockerhandlers.NewVolumeHandler(dockerAgentForwarder, dockerAuditHelper)
 	dockerNetworkHandler := dockerhandlers.NewNetworkHandler(dockerAgentForwarder, dockerAuditHelper)
 	dockerSystemHandler := dockerhandlers.NewSystemHandler(dockerAgentForwarder)
+	dockerCleanupHandler := dockerhandlers.NewCleanupHandler(dockerCleanupService, dockerAgentForwarder, dockerAuditHelper)
 
 	// Terminal handlers (using terminalRecordingRepo created earlier)
-	dockerTerminalHandler := dockerhandlers.NewTerminalHandler(db, dockerStreamManager, agentManager, dockerInstanceService, jwtManager, terminalRecordingRepo)
+	dockerTerminalHandler := dockerhandlers.NewTerminalHandler(db, dockerStreamManager, agentManager, dockerInstanceService, jwtManager, terminalRecordingRepo, terminalRegistry)
 	dockerRecordingHandler := dockerhandlers.NewRecordingHandler(db, terminalRecordingRepo)
 
 	// Unified terminal recording handlers
 	recordingHandler := recordinghandlers.NewRecordingHandler(recordingManagerService)
 	playbackHandler := recordinghandlers.NewPlaybackHandler(recordingManagerService)
 	cleanupHandler := recordinghandlers.NewCleanupHandler(recordingCleanupService)
+	commandHandler := recordinghandlers.NewCommandHandler(recordingCommandIndexService)
 
 	// Host monitoring handlers
 	hostHandler := handlers.NewHostHandler(hostService)
 	hostGroupHandler := handlers.NewHostGroupHandler(db)
+	agentUpdateHandler := handlers.NewAgentUpdateHandler(agentBinaryStore, agentRolloutService)
 	serviceMonitorHandler := handlers.NewServiceMonitorHandler(probeService)
 	// T038: hostActivityHandler 已移除，使用统一审计 API: /api/v1/audit/events?subsystem=host
 	monitorAlertHandler := handlers.NewMonitorAlertRuleHandler(monitorAlertRepo)
+	statusPageHandler := handlers.NewStatusPageHandler(statusPageService)
 
 	// T038: Create host audit logger for handlers
 	hostAuditLogger := hostservices.NewAuditLogger(auditEventRepo, nil)
-	websshHandler := handlers.NewWebSSHHandler(sessionManager, terminalManager, agentManager, db, hostAuditLogger)
+	websshHandler := handlers.NewWebSSHHandler(sessionManager, terminalManager, agentManager, db, hostAuditLogger, terminalRegistry)
+	terminalSessionHandler := handlers.NewTerminalSessionHandler(terminalRegistry)
 	websocketHandler := handlers.NewWebSocketHandler(stateCollector)
 
 	// MinIO handlers
@@ -313,6 +484,9 @@ func SetupRoutes(
 	// System user management handler
 	userHandler := handlers.NewUserHandler(userRepo, rbacService)
 
+	// Just-in-time access requests
+	accessRequestHandler := handlers.NewAccessRequestHandler(accessRequestService)
+
 	// ==================== Auth Routes (No Auth Required /api/auth) ====================
 	// Note: These are at /api/auth (not /api/v1/auth) for compatibility with frontend
 	authGroup := router.Group("/api/auth")
@@ -412,6 +586,14 @@ func SetupRoutes(
 			systemAdminAPI.PUT("/config", systemHandler.UpdateSystemConfig)
 		}
 
+		// Kubernetes audit webhook backend (authenticated by per-cluster token)
+		v1.POST("/k8s/audit-webhook/:id", k8sEventHandler.ReceiveAuditEvents)
+
+		// Public status pages (no auth required)
+		v1.GET("/status/:slug", statusPageHandler.GetPublicStatus)
+		v1.GET("/status/:slug/rss", statusPageHandler.GetRSSFeed)
+		v1.GET("/status/:slug/atom", statusPageHandler.GetAtomFeed)
+
 		// ==================== Protected Endpoints (Require Auth) ====================
 		protected := v1.Group("")
 		protected.Use(middleware.AuthRequired(), middleware.ReadonlyMode(cfg))
@@ -460,6 +642,15 @@ func SetupRoutes(
 					clustersGroup.GET("/:id/resource-history/:history_id", k8sClusterHandler.GetResourceHistory)
 					clustersGroup.DELETE("/:id/resource-history/:history_id", k8sClusterHandler.DeleteResourceHistory)
 
+					// Collected warning events, audit entries and merged resource timeline
+					clusterContext := middleware.ClusterContext(clusterRepo)
+					clustersGroup.GET("/:id/events", clusterContext, k8sEventHandler.ListEvents)
+					clustersGroup.GET("/:id/timeline", clusterContext, k8sEventHandler.Timeline)
+					clustersGroup.GET("/:id/audit-webhook", middleware.RequireAdmin(), clusterContext, k8sEventHandler.GetAuditWebhookConfig)
+
+					// Vulnerability counts of workload images
+					clustersGroup.GET("/:id/vulnerabilities", imageScanHandler.GetClusterVulnerabilities)
+
 					// Generic CRD CRUD (Phase 3)
 					clustersGroup.GET("/:id/crd-resources", k8sClusterHandler.ListCRDResources)
 					clustersGroup.GET("/:id/crd-resources/:name", k8sClusterHandler.GetCRDResource)
@@ -471,6 +662,19 @@ func SetupRoutes(
 					// WebSocket terminal (Phase 4)
 					clustersGroup.GET("/:id/terminal", k8sClusterHandler.PodTerminal)
 				}
+
+				// Multi-cluster comparison and baseline drift checks
+				k8sGroup.POST("/compare", k8sCompareHandler.Compare)
+				baselinesGroup := k8sGroup.Group("/baselines")
+				{
+					baselinesGroup.GET("", k8sCompareHandler.ListBaselines)
+					baselinesGroup.GET("/:baseline_id", k8sCompareHandler.GetBaseline)
+					baselinesGroup.POST("/:baseline_id/check", middleware.RequireAdmin(), k8sCompareHandler.CheckBaseline)
+					baselinesGroup.POST("", middleware.RequireAdmin(), k8sCompareHandler.CreateBaseline)
+					baselinesGroup.PUT("/:baseline_id", middleware.RequireAdmin(), k8sCompareHandler.UpdateBaseline)
+					baselinesGroup.DELETE("/:baseline_id", middleware.RequireAdmin(), k8sCompareHandler.DeleteBaseline)
+					baselinesGroup.POST("/:baseline_id/recapture", middleware.RequireAdmin(), k8sCompareHandler.RecaptureBaseline)
+				}
 			}
 
 			// ==================== Kubernetes Resources & Operations ====================
@@ -490,11 +694,12 @@ func SetupRoutes(
 				// WebSocket endpoints for logs and terminals
 				logsHandler := pkghandlers.NewLogsHandler()
 				clusterGroup.GET("/logs/:namespace/:podName/ws", logsHandler.HandleLogsWebSocket)
+				clusterGroup.GET("/logs/:namespace/download", logsHandler.DownloadLogs)
 
-				terminalHandler := pkghandlers.NewTerminalHandler()
+				terminalHandler := pkghandlers.NewTerminalHandler(recordingManagerService, recordingCommandIndexService, terminalRegistry)
 				clusterGroup.GET("/terminal/:namespace/:podName/ws", terminalHandler.HandleTerminalWebSocket)
 
-				nodeTerminalHandler := pkghandlers.NewNodeTerminalHandler()
+				nodeTerminalHandler := pkghandlers.NewNodeTerminalHandler(recordingManagerService, recordingCommandIndexService, terminalRegistry)
 				clusterGroup.GET("/node-terminal/:nodeName/ws", nodeTerminalHandler.HandleNodeTerminalWebSocket)
 
 				// Search and resource operations
@@ -577,6 +782,14 @@ func SetupRoutes(
 				// T036-T037: 审计查询已迁移到 /api/v1/audit?subsystem=database
 			}
 
+			// ==================== Image Vulnerability Scans ====================
+			imageScansGroup := protected.Group("/image-scans")
+			{
+				imageScansGroup.GET("", imageScanHandler.ListScans)
+				imageScansGroup.GET("/:id", imageScanHandler.GetScan)
+				imageScansGroup.POST("", middleware.RequireAdmin(), imageScanHandler.ScanImage)
+			}
+
 			// ==================== Docker Management Subsystem ====================
 			dockerGroup := protected.Group("/docker")
 			dockerGroup.Use(middleware.RequireAdmin())
@@ -590,6 +803,8 @@ func SetupRoutes(
 					instancesGroup.PUT("/:id", dockerInstanceHandler.UpdateInstance)
 					instancesGroup.DELETE("/:id", dockerInstanceHandler.DeleteInstance)
 					instancesGroup.POST("/:id/test-connection", dockerInstanceHandler.TestConnection)
+					instancesGroup.GET("/:id/vulnerabilities", imageScanHandler.GetInstanceVulnerabilities)
+					instancesGroup.GET("/:id/events", dockerEventHandler.GetInstanceEvents)
 				}
 
 				// Container operations
@@ -603,6 +818,8 @@ func SetupRoutes(
 					containersGroup.POST("/pause", dockerContainerHandler.PauseContainer)
 					containersGroup.POST("/unpause", dockerContainerHandler.UnpauseContainer)
 					containersGroup.POST("/delete", dockerContainerHandler.DeleteContainer)
+					containersGroup.POST("/prune", dockerCleanupHandler.PruneContainers)
+					containersGroup.POST("/update", dockerContainerHandler.UpdateContainer)
 
 					// Container stats
 					containersGroup.GET("/:container_id/stats", dockerStatsHandler.GetContainerStats)
@@ -612,6 +829,14 @@ func SetupRoutes(
 					containersGroup.GET("/:container_id/logs", dockerLogsHandler.GetContainerLogs)
 					containersGroup.GET("/:container_id/logs/stream", dockerLogsHandler.GetContainerLogsStream)
 
+					// Container files
+					containersGroup.GET("/:container_id/files", dockerContainerFileHandler.ListFiles)
+					containersGroup.GET("/:container_id/files/download", dockerContainerFileHandler.DownloadFiles)
+					containersGroup.POST("/:container_id/files/upload", dockerContainerFileHandler.UploadFile)
+
+					// Container events
+					containersGroup.GET("/:container_id/events", dockerEventHandler.GetContainerEvents)
+
 					// Container terminal (T040)
 					containersGroup.POST("/:container_id/terminal", dockerTerminalHandler.CreateTerminalSession)
 				}
@@ -624,10 +849,22 @@ func SetupRoutes(
 					imagesGroup.POST("/delete", dockerImageHandler.DeleteImage)
 					imagesGroup.POST("/tag", dockerImageHandler.TagImage)
 					imagesGroup.POST("/pull", dockerImageHandler.PullImage)
+					imagesGroup.POST("/prune", dockerCleanupHandler.PruneImages)
 				}
 
 				// T036-T037: 审计 API 已统一到 /api/v1/audit/events?subsystem=docker，移除旧的 /audit-logs 路由
 
+				// Registry credentials
+				registriesGroup := dockerGroup.Group("/registries")
+				{
+					registriesGroup.GET("", dockerRegistryHandler.ListCredentials)
+					registriesGroup.POST("", dockerRegistryHandler.CreateCredential)
+					registriesGroup.GET("/:id", dockerRegistryHandler.GetCredential)
+					registriesGroup.PUT("/:id", dockerRegistryHandler.UpdateCredential)
+					registriesGroup.DELETE("/:id", dockerRegistryHandler.DeleteCredential)
+					registriesGroup.POST("/:id/test", dockerRegistryHandler.TestLogin)
+				}
+
 				// Volume operations
 				volumesGroup := dockerGroup.Group("/instances/:id/volumes")
 				{
@@ -657,6 +894,41 @@ func SetupRoutes(
 					systemGroup.GET("/disk-usage", dockerSystemHandler.GetDiskUsage)
 					systemGroup.GET("/ping", dockerSystemHandler.Ping)
 					systemGroup.GET("/events/stream", dockerSystemHandler.GetEventsStream)
+					systemGroup.POST("/build-cache/prune", dockerCleanupHandler.PruneBuildCache)
+				}
+
+				// Swarm operations (the instance must be a swarm manager)
+				swarmGroup := dockerGroup.Group("/instances/:id/swarm")
+				{
+					swarmGroup.GET("/info", dockerSwarmHandler.GetSwarmInfo)
+					swarmGroup.GET("/nodes", dockerSwarmHandler.GetNodes)
+					swarmGroup.PUT("/nodes/:node_id", dockerSwarmHandler.UpdateNode)
+					swarmGroup.GET("/services", dockerSwarmHandler.GetServices)
+					swarmGroup.GET("/services/:service_id", dockerSwarmHandler.GetService)
+					swarmGroup.DELETE("/services/:service_id", dockerSwarmHandler.DeleteService)
+					swarmGroup.POST("/services/:service_id/scale", dockerSwarmHandler.ScaleService)
+					swarmGroup.POST("/services/:service_id/image", dockerSwarmHandler.UpdateServiceImage)
+					swarmGroup.POST("/services/:service_id/rollback", dockerSwarmHandler.RollbackService)
+					swarmGroup.GET("/tasks", dockerSwarmHandler.GetTasks)
+					swarmGroup.GET("/secrets", dockerSwarmHandler.GetSecrets)
+					swarmGroup.POST("/secrets", dockerSwarmHandler.CreateSecret)
+					swarmGroup.DELETE("/secrets/:secret_id", dockerSwarmHandler.DeleteSecret)
+					swarmGroup.GET("/configs", dockerSwarmHandler.GetConfigs)
+					swarmGroup.POST("/configs", dockerSwarmHandler.CreateConfig)
+					swarmGroup.DELETE("/configs/:config_id", dockerSwarmHandler.DeleteConfig)
+					swarmGroup.GET("/stacks", dockerSwarmHandler.GetStacks)
+					swarmGroup.POST("/stacks", dockerSwarmHandler.DeployStack)
+					swarmGroup.DELETE("/stacks/:name", dockerSwarmHandler.RemoveStack)
+				}
+
+				// Cleanup policies
+				dockerGroup.GET("/cleanup-policies", dockerCleanupHandler.ListPolicies)
+				cleanupPolicyGroup := dockerGroup.Group("/instances/:id/cleanup-policy")
+				{
+					cleanupPolicyGroup.GET("", dockerCleanupHandler.GetPolicy)
+					cleanupPolicyGroup.PUT("", dockerCleanupHandler.SavePolicy)
+					cleanupPolicyGroup.DELETE("", dockerCleanupHandler.DeletePolicy)
+					cleanupPolicyGroup.POST("/run", dockerCleanupHandler.RunPolicy)
 				}
 
 				// Terminal recordings
@@ -767,6 +1039,8 @@ func SetupRoutes(
 				// ==================== New Unified Audit API (T023) ====================
 				// Unified audit events
 				auditGroup.GET("/events", auditEventHandler.ListEvents)
+				auditGroup.GET("/events/verify", middleware.RequireAdmin(), auditEventHandler.VerifyChain)
+				auditGroup.GET("/events/export", middleware.RequireAdmin(), auditEventHandler.ExportEvents)
 				auditGroup.GET("/events/:id", auditEventHandler.GetEvent)
 
 				// Audit configuration
@@ -781,12 +1055,14 @@ func SetupRoutes(
 				recordingsGroup.GET("", recordingHandler.ListRecordings)
 				recordingsGroup.GET("/search", recordingHandler.SearchRecordings)
 				recordingsGroup.GET("/statistics", recordingHandler.GetStatistics)
+				recordingsGroup.GET("/commands", middleware.RequireAdmin(), commandHandler.SearchCommands)
 				recordingsGroup.GET("/:id", recordingHandler.GetRecording)
 				recordingsGroup.DELETE("/:id", recordingHandler.DeleteRecording)
 
 				// Playback and download
 				recordingsGroup.GET("/:id/playback", playbackHandler.GetPlaybackContent)
 				recordingsGroup.GET("/:id/download", playbackHandler.DownloadRecording)
+				recordingsGroup.GET("/:id/commands", commandHandler.ListRecordingCommands)
 
 				// Cleanup management
 				cleanupGroup := recordingsGroup.Group("/cleanup")
@@ -796,6 +1072,14 @@ func SetupRoutes(
 				}
 			}
 
+			// Live terminal sessions (WebSSH, Docker exec, Kubernetes exec)
+			terminalSessionsGroup := protected.Group("/terminal-sessions", middleware.RequireAdmin())
+			{
+				terminalSessionsGroup.GET("", terminalSessionHandler.ListSessions)
+				terminalSessionsGroup.GET("/:id/join", terminalSessionHandler.JoinSession)
+				terminalSessionsGroup.POST("/:id/terminate", terminalSessionHandler.TerminateSession)
+			}
+
 			// ==================== User Management Subsystem ====================
 			usersGroup := protected.Group("/users")
 			{
@@ -814,6 +1098,18 @@ func SetupRoutes(
 			// User-specific audit logs
 			protected.GET("/users/:user_id/audit", auditHandler.ListUserAuditLogs)
 
+			// ==================== Just-in-time Access Requests ====================
+			accessGroup := protected.Group("/access-requests")
+			{
+				accessGroup.GET("", accessRequestHandler.ListRequests)
+				accessGroup.POST("", accessRequestHandler.CreateRequest)
+				accessGroup.GET("/:id", accessRequestHandler.GetRequest)
+				accessGroup.POST("/:id/cancel", accessRequestHandler.CancelRequest)
+				accessGroup.POST("/:id/approve", middleware.RequireAdmin(), accessRequestHandler.ApproveRequest)
+				accessGroup.POST("/:id/reject", middleware.RequireAdmin(), accessRequestHandler.RejectRequest)
+				accessGroup.POST("/:id/revoke", middleware.RequireAdmin(), accessRequestHandler.RevokeRequest)
+			}
+
 			// ==================== VMs (Host Monitoring) Subsystem ====================
 			vmsGroup := protected.Group("/vms")
 			{
@@ -833,6 +1129,9 @@ func SetupRoutes(
 					// Host state queries
 					hostsGroup.GET("/:id/state/current", hostHandler.GetCurrentState)
 					hostsGroup.GET("/:id/state/history", hostHandler.GetHistoryState)
+					hostsGroup.GET("/:id/state/details", hostHandler.GetStateDetails)
+					hostsGroup.GET("/:id/state/custom-metrics", hostHandler.ListCustomMetrics)
+					hostsGroup.GET("/:id/state/custom-metrics/history", hostHandler.GetCustomMetricHistory)
 
 					// T038: Host activities API 已移除，使用统一审计 API
 					// GET /api/v1/audit/events?subsystem=host&resource.identifier=<host_id>
@@ -866,6 +1165,20 @@ func SetupRoutes(
 					minioAPI.DELETE("/shares/:id", minioShareHandler.RevokeShare)
 				}
 
+				// Agent self-update: binaries and upgrade rollouts
+				agentBinariesGroup := vmsGroup.Group("/agent-binaries", middleware.RequireAdmin())
+				{
+					agentBinariesGroup.GET("", agentUpdateHandler.ListBinaries)
+					agentBinariesGroup.POST("", agentUpdateHandler.UploadBinary)
+				}
+				agentRolloutsGroup := vmsGroup.Group("/agent-rollouts", middleware.RequireAdmin())
+				{
+					agentRolloutsGroup.POST("", agentUpdateHandler.CreateRollout)
+					agentRolloutsGroup.GET("", agentUpdateHandler.ListRollouts)
+					agentRolloutsGroup.GET("/:id", agentUpdateHandler.GetRollout)
+					agentRolloutsGroup.POST("/:id/cancel", agentUpdateHandler.CancelRollout)
+				}
+
 				// Host groups (simplified - just list unique group names)
 				hostGroupsGroup := vmsGroup.Group("/host-groups")
 				{
@@ -909,6 +1222,20 @@ func SetupRoutes(
 					alertRulesGroup.DELETE("/:id", monitorAlertHandler.DeleteRule)
 				}
 
+				// Status page configuration and incidents
+				statusPagesGroup := vmsGroup.Group("/status-pages")
+				{
+					statusPagesGroup.GET("", statusPageHandler.ListPages)
+					statusPagesGroup.POST("", middleware.RequireAdmin(), statusPageHandler.CreatePage)
+					statusPagesGroup.GET("/:id", statusPageHandler.GetPage)
+					statusPagesGroup.PUT("/:id", middleware.RequireAdmin(), statusPageHandler.UpdatePage)
+					statusPagesGroup.DELETE("/:id", middleware.RequireAdmin(), statusPageHandler.DeletePage)
+					statusPagesGroup.GET("/:id/incidents", statusPageHandler.ListIncidents)
+					statusPagesGroup.POST("/:id/incidents", middleware.RequireAdmin(), statusPageHandler.CreateIncident)
+					statusPagesGroup.POST("/incidents/:incident_id/updates", middleware.RequireAdmin(), statusPageHandler.AddIncidentUpdate)
+					statusPagesGroup.DELETE("/incidents/:incident_id", middleware.RequireAdmin(), statusPageHandler.DeleteIncident)
+				}
+
 				alertEventsGroup := vmsGroup.Group("/alert-events")
 				{
 					alertEventsGroup.GET("", monitorAlertHandler.ListEvents)
@@ -15,8 +15,11 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	clientprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"gorm.io/gorm"
 
 	"github.com/ysicing/tiga/internal/api"
@@ -31,14 +34,17 @@ import (
 	"github.com/ysicing/tiga/internal/services/host"
 	"github.com/ysicing/tiga/internal/services/k8s"
 	"github.com/ysicing/tiga/internal/services/managers"
+	"github.com/ysicing/tiga/internal/services/metrics"
 	"github.com/ysicing/tiga/internal/services/monitor"
 	"github.com/ysicing/tiga/internal/services/notification"
 	"github.com/ysicing/tiga/internal/services/prometheus"
 	"github.com/ysicing/tiga/internal/services/scheduler"
 	"github.com/ysicing/tiga/pkg/crypto"
+	"github.com/ysicing/tiga/pkg/rbac"
 	"github.com/ysicing/tiga/proto"
 
 	installhandlers "github.com/ysicing/tiga/internal/install/handlers"
+	auditservice "github.com/ysicing/tiga/internal/services/audit"
 	dockerservices "github.com/ysicing/tiga/internal/services/docker"
 )
 
@@ -72,13 +78,26 @@ type Application struct {
 	k8sService *services.K8sService
 
 	// K8s cluster health and Prometheus discovery
-	clusterHealthService *k8s.ClusterHealthService
-	prometheusDiscovery  *prometheus.AutoDiscoveryService
+	clusterHealthService  *k8s.ClusterHealthService
+	clusterEventCollector *k8s.ClusterEventCollector
+	prometheusDiscovery   *prometheus.AutoDiscoveryService
+
+	// Docker container event history and crash alerts
+	containerEventCollector *dockerservices.ContainerEventCollector
 
 	// Phase 3 services: resource relations, caching, and search
 	cacheService     *k8s.CacheService
 	relationsService *k8s.RelationsService
 	searchService    *k8s.SearchService
+
+	// External audit event sinks (syslog, webhook, MinIO)
+	auditSinks *auditservice.SinkDispatcher
+
+	// Just-in-time access requests, shared by the API and the expiry task
+	accessRequestService *auth.AccessRequestService
+
+	// Stops the Prometheus remote-write sender
+	stopRemoteWrite context.CancelFunc
 }
 
 // NewApplication creates a new application instance using Wire dependency injection
@@ -130,6 +149,28 @@ func (a *Application) Initialize(ctx context.Context) error {
 	models.DB = a.db.DB
 	models.InitRepositories(a.db.DB)
 
+	// Load just-in-time access grants into the RBAC config and keep them in sync
+	rbac.InitRBAC(a.db.DB)
+
+	// Key the audit hash chain with a secret kept outside the database, so rows
+	// rewritten in the database cannot be re-hashed into a valid chain
+	chainKey := a.config.Audit.SigningKey
+	if chainKey == "" {
+		chainKey = a.config.JWT.Secret
+	}
+	models.SetAuditChainKey([]byte(chainKey))
+
+	// Stream committed audit events to the configured external sinks
+	sinks, err := auditservice.NewSinksFromConfig(a.config.Audit.Sinks)
+	if err != nil {
+		return fmt.Errorf("failed to configure audit sinks: %w", err)
+	}
+	if len(sinks) > 0 {
+		a.auditSinks = auditservice.NewSinkDispatcher(sinks, 0)
+		repository.RegisterAuditEventHook(a.auditSinks.Dispatch)
+		logrus.Infof("Audit events will be streamed to %d external sinks", len(sinks))
+	}
+
 	// Ensure and initialize encryption keys
 	appEncryptionKey, err := a.ensureApplicationEncryptionKey(ctx)
 	if err != nil {
@@ -174,6 +215,17 @@ func (a *Application) Initialize(ctx context.Context) error {
 		a.clusterHealthService.Start(ctx)
 	}
 
+	// Collect warning events from every enabled cluster
+	a.clusterEventCollector = k8s.NewClusterEventCollector(
+		repository.NewClusterRepository(a.db.DB),
+		repository.NewClusterEventRepository(a.db.DB),
+	)
+	a.clusterEventCollector.Start(ctx)
+
+	// Collect container exits, OOM kills and health changes from every online Docker instance
+	a.containerEventCollector = dockerservices.NewContainerEventCollector(a.db.DB, a.dockerStreamManager)
+	a.containerEventCollector.Start(ctx)
+
 	// Start monitoring (coordinator was created by wire)
 	a.coordinator.StartMonitoring(ctx)
 
@@ -182,6 +234,16 @@ func (a *Application) Initialize(ctx context.Context) error {
 	// Initialize JWT auth middleware (jwtManager was created by wire)
 	middleware.InitJWTAuthMiddleware(a.jwtManager, a.db.DB)
 
+	// Just-in-time access requests
+	accessNotificationSvc, accessChannels := auth.NewAccessNotificationService(a.config.Access.NotifyWebhookURL)
+	a.accessRequestService = auth.NewAccessRequestService(
+		a.db.DB,
+		auth.NewRBACService(a.db.DB),
+		accessNotificationSvc,
+		accessChannels,
+		time.Duration(a.config.Access.MaxDurationMinutes)*time.Minute,
+	)
+
 	// Setup HTTP router
 	routerConfig := &middleware.RouterConfig{
 		DebugMode:     a.config.Server.Debug,
@@ -190,6 +252,9 @@ func (a *Application) Initialize(ctx context.Context) error {
 
 	router := middleware.NewRouter(routerConfig)
 
+	// Host and service metrics for scrapers and remote-write
+	a.setupMetricsExport(ctx, router)
+
 	// Register all API handlers
 	api.SetupRoutes(
 		router,
@@ -204,6 +269,7 @@ func (a *Application) Initialize(ctx context.Context) error {
 		a.dockerStreamManager,
 		a.probeScheduler,
 		a.agentManager,
+		a.accessRequestService,
 		a.config,
 	)
 
@@ -219,9 +285,14 @@ func (a *Application) Initialize(ctx context.Context) error {
 	}
 
 	// Initialize gRPC server for Agent communication
-	grpcService := host.NewGRPCServer(a.agentManager, a.terminalManager, a.dockerStreamManager, a.probeScheduler)
+	agentBinaries := host.NewAgentBinaryStore(a.config.AgentUpdate.BinaryDir, a.config.AgentUpdate.ReleaseURL)
+	grpcService := host.NewGRPCServer(a.agentManager, a.terminalManager, a.dockerStreamManager, a.probeScheduler, agentBinaries)
 
-	a.grpcServer = grpc.NewServer()
+	grpcOpts, err := a.agentTLSServerOptions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to configure agent TLS: %w", err)
+	}
+	a.grpcServer = grpc.NewServer(grpcOpts...)
 	proto.RegisterHostMonitorServer(a.grpcServer, grpcService)
 
 	logrus.Info("gRPC server initialized for Agent monitoring")
@@ -234,6 +305,76 @@ func (a *Application) Initialize(ctx context.Context) error {
 	return nil
 }
 
+// setupMetricsExport exports the latest host states and service probe
+// results on a dedicated registry. The registry is served on
+// /metrics/monitoring behind a bearer token when enabled, and pushed by the
+// remote-write sender when configured.
+func (a *Application) setupMetricsExport(ctx context.Context, router *gin.Engine) {
+	exportCfg := a.config.MetricsExport
+	exporter := metrics.NewMonitoringExporter(
+		repository.NewServiceRepository(a.db.DB),
+		a.stateCollector,
+		a.agentManager,
+		time.Duration(exportCfg.CacheSeconds)*time.Second,
+	)
+	registry := clientprometheus.NewRegistry()
+	registry.MustRegister(exporter)
+
+	if exportCfg.Enabled {
+		if exportCfg.BearerToken == "" {
+			logrus.Warn("Metrics export is enabled without a bearer token, /metrics/monitoring is not served")
+		} else {
+			router.GET("/metrics/monitoring",
+				middleware.RequireBearerToken(exportCfg.BearerToken),
+				gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{})),
+			)
+		}
+	}
+
+	cfg := a.config.RemoteWrite
+	if cfg.URL == "" {
+		return
+	}
+
+	ctx, a.stopRemoteWrite = context.WithCancel(ctx)
+	go metrics.NewRemoteWriter(cfg, registry).Run(ctx)
+}
+
+// agentTLSServerOptions loads the built-in agent CA and returns the gRPC
+// server options for TLS and client certificate checks. No options are
+// returned when agent TLS is disabled.
+func (a *Application) agentTLSServerOptions(ctx context.Context) ([]grpc.ServerOption, error) {
+	cfg := a.config.AgentTLS
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	ca, err := host.LoadAgentCA(ctx, a.db.DB, time.Duration(cfg.CertValidityDays)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if a.config.Server.GRPCDomain != "" {
+		hosts = append([]string{a.config.Server.GRPCDomain}, hosts...)
+	}
+	tlsConfig, err := ca.ServerTLSConfig(cfg.CertFile, cfg.KeyFile, hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Agents can only pin the CA when it signed the server certificate
+	serverPin := ""
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		serverPin = ca.Pin()
+	}
+	a.agentManager.EnableAgentTLS(ca, serverPin)
+
+	logrus.Infof("Agent gRPC TLS enabled (client certificates required: %v, CA pin: %s)", cfg.RequireClientCert, ca.Pin())
+
+	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}
+	return append(opts, host.NewAgentAuthInterceptor(ca, cfg.RequireClientCert).ServerOptions()...), nil
+}
+
 // initializeInstallMode initializes minimal components for installation mode
 func (a *Application) initializeInstallMode(_ context.Context) error {
 	logrus.Info("Initializing installation mode...")
@@ -395,6 +536,21 @@ func (a *Application) Run(ctx context.Context) error {
 		logrus.Infof("[4/7] ✓ Service probe scheduler stopped (%v)", time.Since(stepStart))
 	}
 
+	// Stop cluster event watchers
+	if a.clusterEventCollector != nil {
+		a.clusterEventCollector.Stop()
+	}
+
+	// Stop Docker container event streams
+	if a.containerEventCollector != nil {
+		a.containerEventCollector.Stop()
+	}
+
+	// Stop pushing metrics
+	if a.stopRemoteWrite != nil {
+		a.stopRemoteWrite()
+	}
+
 	// Stop monitoring
 	stepStart = time.Now()
 	a.coordinator.StopMonitoring()
@@ -405,6 +561,12 @@ func (a *Application) Run(ctx context.Context) error {
 	a.coordinator.DisconnectAll(ctx)
 	logrus.Infof("[6/7] ✓ All managers disconnected (%v)", time.Since(stepStart))
 
+	// Flush external audit sinks before the database goes away
+	if a.auditSinks != nil {
+		_ = a.auditSinks.Close()
+		logrus.Info("✓ Audit sinks flushed")
+	}
+
 	// Close database
 	stepStart = time.Now()
 	if err := a.db.Close(); err != nil {
@@ -710,9 +872,21 @@ func (a *Application) registerScheduledTasks(ctx context.Context) error {
 	}
 	logrus.Info("docker_audit_cleanup task registered successfully")
 
+	// Task 7: Access Grant Expiry (runs every minute)
+	// Revoke just-in-time access grants once their expiry has passed
+	accessExpiryTask := scheduler.NewAccessGrantExpiryTask(a.accessRequestService)
+	if err := a.scheduler.AddCron(
+		accessExpiryTask.Name(),
+		"*/1 * * * *", // Every minute
+		accessExpiryTask,
+	); err != nil {
+		return fmt.Errorf("failed to register access_grant_expiry task: %w", err)
+	}
+	logrus.Info("access_grant_expiry task registered successfully")
+
 	// Note: Terminal Recording Cleanup task is now registered in routes.go
 	// It handles both expired and invalid recordings in a unified manner
 
-	logrus.Infof("Successfully registered %d scheduled tasks", 6)
+	logrus.Infof("Successfully registered %d scheduled tasks", 7)
 	return nil
 }
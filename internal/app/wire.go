@@ -169,9 +169,13 @@ func provideHostMonitoringComponents(
 	db *gorm.DB,
 	auditEventRepo repository.AuditEventRepository,
 	dockerInstanceService *dockerservices.DockerInstanceService,
+	alertEngine *alert.AlertEngine,
+	cfg *config.Config,
 ) *HostMonitoringComponents {
 	// Create StateCollector first
 	stateCollector := host.NewStateCollector(hostRepo)
+	stateCollector.SetRuleEvaluator(alertEngine)
+	stateCollector.SetRetention(cfg.HostStateRetention)
 
 	// T038: Create AuditLogger for host subsystem
 	auditLogger := host.NewAuditLogger(auditEventRepo, nil)
@@ -57,15 +57,15 @@ func InitializeApplication(ctx context.Context, cfg *config.Config, configPath s
 	hostRepository := repository.NewHostRepository(gormDB)
 	auditEventRepository := repository.NewAuditEventRepository(gormDB)
 	dockerInstanceService := docker.NewDockerInstanceService(gormDB)
-	hostMonitoringComponents := provideHostMonitoringComponents(hostRepository, gormDB, auditEventRepository, dockerInstanceService)
+	monitorAlertRepository := repository.NewMonitorAlertRepository(gormDB)
+	serviceRepository := repository.NewServiceRepository(gormDB)
+	alertEngine := provideAlertEngine(monitorAlertRepository, hostRepository, serviceRepository)
+	hostMonitoringComponents := provideHostMonitoringComponents(hostRepository, gormDB, auditEventRepository, dockerInstanceService, alertEngine, cfg)
 	stateCollector := provideStateCollector(hostMonitoringComponents)
 	agentManager := provideAgentManager(hostMonitoringComponents)
 	terminalManager := host.NewTerminalManager()
 	dockerStreamManager := host.NewDockerStreamManager(agentManager, gormDB)
 	hostService := provideHostService(hostRepository, agentManager, stateCollector, cfg)
-	serviceRepository := repository.NewServiceRepository(gormDB)
-	monitorAlertRepository := repository.NewMonitorAlertRepository(gormDB)
-	alertEngine := provideAlertEngine(monitorAlertRepository, hostRepository, serviceRepository)
 	serviceProbeScheduler := provideServiceProbeScheduler(serviceRepository, alertEngine)
 	clusterRepository := repository.NewClusterRepository(gormDB)
 	resourceHistoryRepository := repository.NewResourceHistoryRepository(gormDB)
@@ -168,9 +168,13 @@ func provideHostMonitoringComponents(
 	hostRepo repository.HostRepository, db2 *gorm.DB,
 	auditEventRepo repository.AuditEventRepository,
 	dockerInstanceService *docker.DockerInstanceService,
+	alertEngine *alert.AlertEngine,
+	cfg *config.Config,
 ) *HostMonitoringComponents {
 
 	stateCollector := host.NewStateCollector(hostRepo)
+	stateCollector.SetRuleEvaluator(alertEngine)
+	stateCollector.SetRetention(cfg.HostStateRetention)
 
 	auditLogger := host.NewAuditLogger(auditEventRepo, nil)
 
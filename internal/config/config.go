@@ -24,9 +24,16 @@ type Config struct {
 	Webhook            WebhookConfig
 	Features           FeaturesConfig
 	Log                LogConfig
-	Scheduler          SchedulerConfig // T028: Scheduler configuration
-	Audit              AuditConfig     // T028: Audit configuration
-	Recording          RecordingConfig // T002: Terminal recording configuration
+	Scheduler          SchedulerConfig          // T028: Scheduler configuration
+	Audit              AuditConfig              // T028: Audit configuration
+	Recording          RecordingConfig          // T002: Terminal recording configuration
+	Access             AccessConfig             // Just-in-time access requests
+	AgentTLS           AgentTLSConfig           // Agent gRPC transport security
+	AgentUpdate        AgentUpdateConfig        // Agent self-update binaries
+	MetricsExport      MetricsExportConfig      // Scrape endpoint of host and service metrics
+	RemoteWrite        RemoteWriteConfig        // Push host and service metrics to Prometheus
+	HostStateRetention HostStateRetentionConfig // Host state history downsampling
+	ImageScan          ImageScanConfig          // Image vulnerability scanning
 }
 
 // ServerConfig holds HTTP server configuration
@@ -140,8 +147,103 @@ type SchedulerConfig struct {
 
 // AuditConfig holds audit logging configuration (T028)
 type AuditConfig struct {
-	RetentionDays  int // Audit log retention in days (default: 90)
-	MaxObjectBytes int // Maximum object size in bytes (default: 64KB)
+	RetentionDays  int    // Audit log retention in days (default: 90)
+	MaxObjectBytes int    // Maximum object size in bytes (default: 64KB)
+	SigningKey     string // HMAC key for signed export bundles and the audit hash chain (optional, falls back to the JWT secret for the chain)
+	Sinks          AuditSinksConfig
+}
+
+// AuditSinksConfig holds external audit event sinks
+// Each sink is enabled by setting its address, URL or endpoint
+type AuditSinksConfig struct {
+	Syslog  AuditSyslogConfig
+	Webhook AuditWebhookConfig
+	MinIO   AuditMinIOConfig
+}
+
+// AuditSyslogConfig configures the RFC 5424 syslog sink
+type AuditSyslogConfig struct {
+	Network string // udp or tcp (default: udp)
+	Address string // host:port
+	AppName string // default: tiga
+}
+
+// AuditWebhookConfig configures the webhook sink
+type AuditWebhookConfig struct {
+	URL    string
+	Secret string // HMAC secret for the X-Tiga-Signature header (optional)
+}
+
+// AuditMinIOConfig configures the append-only MinIO sink
+type AuditMinIOConfig struct {
+	Endpoint      string
+	AccessKey     string
+	SecretKey     string
+	Bucket        string
+	Prefix        string
+	UseSSL        bool
+	RetentionDays int // Object lock retention in days, 0 disables (default: 0)
+}
+
+// AccessConfig holds just-in-time access request configuration
+type AccessConfig struct {
+	MaxDurationMinutes int    // Longest grant a user may request (default: 480)
+	NotifyWebhookURL   string // Webhook notified about new and decided requests (optional)
+}
+
+// AgentTLSConfig holds agent gRPC transport security configuration
+type AgentTLSConfig struct {
+	Enabled           bool   // Serve agent gRPC over TLS with the built-in CA
+	CertFile          string // Server certificate, issued by the built-in CA when empty
+	KeyFile           string // Server certificate key
+	RequireClientCert bool   // Reject agents without an enrolled client certificate (mTLS)
+	CertValidityDays  int    // Lifetime of issued agent certificates (default: 90)
+}
+
+// AgentUpdateConfig holds agent self-update configuration
+type AgentUpdateConfig struct {
+	BinaryDir  string // Directory of agent binaries per version (default: ./data/agent-binaries)
+	ReleaseURL string // Fetch missing binaries from here; {version} and {name} are substituted (optional)
+}
+
+// MetricsExportConfig holds the Prometheus scrape endpoint of host and
+// service metrics. The endpoint is only served when enabled with a token.
+type MetricsExportConfig struct {
+	Enabled      bool   // Serve /metrics/monitoring (default: false)
+	BearerToken  string // Token scrapers must send in the Authorization header
+	CacheSeconds int    // Reuse probe summaries between collections (default: 30)
+}
+
+// RemoteWriteConfig holds the Prometheus remote-write sender configuration
+type RemoteWriteConfig struct {
+	URL             string            // Remote-write endpoint, the sender is disabled when empty
+	IntervalSeconds int               // Push interval (default: 60)
+	TimeoutSeconds  int               // Request timeout (default: 30)
+	Username        string            // Basic auth username (optional)
+	Password        string            // Basic auth password (optional)
+	BearerToken     string            // Bearer token, takes precedence over basic auth (optional)
+	ExternalLabels  map[string]string // Labels added to every series (optional)
+}
+
+// HostStateRetentionConfig holds the retention of raw host states and of
+// their 1-minute, 1-hour and 1-day rollups. A negative value keeps the data
+// forever.
+type HostStateRetentionConfig struct {
+	RawHours   int // Raw states (default: 48)
+	MinuteDays int // 1-minute rollups (default: 14)
+	HourDays   int // 1-hour rollups (default: 90)
+	DayDays    int // 1-day rollups (default: 730)
+}
+
+// ImageScanConfig holds the image vulnerability scanner configuration
+type ImageScanConfig struct {
+	Scanner          string // "trivy" or "grype", scanning is disabled when empty
+	BinaryPath       string // Scanner binary (default: the scanner name, looked up in PATH)
+	ServerURL        string // Trivy server to scan with in client/server mode (optional)
+	Cron             string // Rescan schedule of running images (default: "0 2 * * *")
+	TimeoutSeconds   int    // Timeout of one image scan (default: 600)
+	CacheHours       int    // Results younger than this are reused on demand (default: 24)
+	NotifyWebhookURL string // Webhook notified of new critical vulnerabilities (optional)
 }
 
 // RecordingConfig holds terminal recording system configuration (T002)
@@ -286,6 +388,27 @@ func LoadFromFile(filename string) (*Config, error) {
 		Audit: AuditConfig{
 			RetentionDays:  getIntOrDefault(configFile.Audit.RetentionDays, getEnvAsInt("AUDIT_RETENTION_DAYS", 90)),
 			MaxObjectBytes: getIntOrDefault(configFile.Audit.MaxObjectBytes, getEnvAsInt("AUDIT_MAX_OBJECT_BYTES", 64*1024)),
+			SigningKey:     getOrDefault(configFile.Audit.SigningKey, getEnv("AUDIT_SIGNING_KEY", "")),
+			Sinks: AuditSinksConfig{
+				Syslog: AuditSyslogConfig{
+					Network: getOrDefault(configFile.Audit.Sinks.Syslog.Network, getEnv("AUDIT_SYSLOG_NETWORK", "udp")),
+					Address: getOrDefault(configFile.Audit.Sinks.Syslog.Address, getEnv("AUDIT_SYSLOG_ADDRESS", "")),
+					AppName: getOrDefault(configFile.Audit.Sinks.Syslog.AppName, getEnv("AUDIT_SYSLOG_APP_NAME", "tiga")),
+				},
+				Webhook: AuditWebhookConfig{
+					URL:    getOrDefault(configFile.Audit.Sinks.Webhook.URL, getEnv("AUDIT_WEBHOOK_URL", "")),
+					Secret: getOrDefault(configFile.Audit.Sinks.Webhook.Secret, getEnv("AUDIT_WEBHOOK_SECRET", "")),
+				},
+				MinIO: AuditMinIOConfig{
+					Endpoint:      getOrDefault(configFile.Audit.Sinks.MinIO.Endpoint, getEnv("AUDIT_MINIO_ENDPOINT", "")),
+					AccessKey:     getOrDefault(configFile.Audit.Sinks.MinIO.AccessKey, getEnv("AUDIT_MINIO_ACCESS_KEY", "")),
+					SecretKey:     getOrDefault(configFile.Audit.Sinks.MinIO.SecretKey, getEnv("AUDIT_MINIO_SECRET_KEY", "")),
+					Bucket:        getOrDefault(configFile.Audit.Sinks.MinIO.Bucket, getEnv("AUDIT_MINIO_BUCKET", "")),
+					Prefix:        getOrDefault(configFile.Audit.Sinks.MinIO.Prefix, getEnv("AUDIT_MINIO_PREFIX", "audit")),
+					UseSSL:        getBoolOrDefault(configFile.Audit.Sinks.MinIO.UseSSL, getEnvAsBool("AUDIT_MINIO_USE_SSL", false)),
+					RetentionDays: getIntOrDefault(configFile.Audit.Sinks.MinIO.RetentionDays, getEnvAsInt("AUDIT_MINIO_RETENTION_DAYS", 0)),
+				},
+			},
 		},
 		// T002: Terminal recording configuration
 		Recording: RecordingConfig{
@@ -303,6 +426,50 @@ func LoadFromFile(filename string) (*Config, error) {
 				UseSSL:    getBoolOrDefault(configFile.Recording.MinIO.UseSSL, getEnvAsBool("RECORDING_MINIO_USE_SSL", true)),
 			},
 		},
+		Access: AccessConfig{
+			MaxDurationMinutes: getIntOrDefault(configFile.Access.MaxDurationMinutes, getEnvAsInt("ACCESS_MAX_DURATION_MINUTES", 480)),
+			NotifyWebhookURL:   getOrDefault(configFile.Access.NotifyWebhookURL, getEnv("ACCESS_NOTIFY_WEBHOOK_URL", "")),
+		},
+		AgentTLS: AgentTLSConfig{
+			Enabled:           getBoolOrDefault(configFile.AgentTLS.Enabled, getEnvAsBool("AGENT_TLS_ENABLED", false)),
+			CertFile:          getOrDefault(configFile.AgentTLS.CertFile, getEnv("AGENT_TLS_CERT_FILE", "")),
+			KeyFile:           getOrDefault(configFile.AgentTLS.KeyFile, getEnv("AGENT_TLS_KEY_FILE", "")),
+			RequireClientCert: getBoolOrDefault(configFile.AgentTLS.RequireClientCert, getEnvAsBool("AGENT_TLS_REQUIRE_CLIENT_CERT", false)),
+			CertValidityDays:  getIntOrDefault(configFile.AgentTLS.CertValidityDays, getEnvAsInt("AGENT_TLS_CERT_VALIDITY_DAYS", 90)),
+		},
+		AgentUpdate: AgentUpdateConfig{
+			BinaryDir:  getOrDefault(configFile.AgentUpdate.BinaryDir, getEnv("AGENT_UPDATE_BINARY_DIR", "./data/agent-binaries")),
+			ReleaseURL: getOrDefault(configFile.AgentUpdate.ReleaseURL, getEnv("AGENT_UPDATE_RELEASE_URL", "")),
+		},
+		MetricsExport: MetricsExportConfig{
+			Enabled:      getBoolOrDefault(configFile.MetricsExport.Enabled, getEnvAsBool("METRICS_EXPORT_ENABLED", false)),
+			BearerToken:  getOrDefault(configFile.MetricsExport.BearerToken, getEnv("METRICS_EXPORT_BEARER_TOKEN", "")),
+			CacheSeconds: getIntOrDefault(configFile.MetricsExport.CacheSeconds, getEnvAsInt("METRICS_EXPORT_CACHE_SECONDS", 30)),
+		},
+		RemoteWrite: RemoteWriteConfig{
+			URL:             getOrDefault(configFile.RemoteWrite.URL, getEnv("REMOTE_WRITE_URL", "")),
+			IntervalSeconds: getIntOrDefault(configFile.RemoteWrite.IntervalSeconds, getEnvAsInt("REMOTE_WRITE_INTERVAL_SECONDS", 60)),
+			TimeoutSeconds:  getIntOrDefault(configFile.RemoteWrite.TimeoutSeconds, getEnvAsInt("REMOTE_WRITE_TIMEOUT_SECONDS", 30)),
+			Username:        getOrDefault(configFile.RemoteWrite.Username, getEnv("REMOTE_WRITE_USERNAME", "")),
+			Password:        getOrDefault(configFile.RemoteWrite.Password, getEnv("REMOTE_WRITE_PASSWORD", "")),
+			BearerToken:     getOrDefault(configFile.RemoteWrite.BearerToken, getEnv("REMOTE_WRITE_BEARER_TOKEN", "")),
+			ExternalLabels:  configFile.RemoteWrite.ExternalLabels,
+		},
+		HostStateRetention: HostStateRetentionConfig{
+			RawHours:   getIntOrDefault(configFile.HostStateRetention.RawHours, getEnvAsInt("HOST_STATE_RETENTION_RAW_HOURS", 48)),
+			MinuteDays: getIntOrDefault(configFile.HostStateRetention.MinuteDays, getEnvAsInt("HOST_STATE_RETENTION_MINUTE_DAYS", 14)),
+			HourDays:   getIntOrDefault(configFile.HostStateRetention.HourDays, getEnvAsInt("HOST_STATE_RETENTION_HOUR_DAYS", 90)),
+			DayDays:    getIntOrDefault(configFile.HostStateRetention.DayDays, getEnvAsInt("HOST_STATE_RETENTION_DAY_DAYS", 730)),
+		},
+		ImageScan: ImageScanConfig{
+			Scanner:          getOrDefault(configFile.ImageScan.Scanner, getEnv("IMAGE_SCAN_SCANNER", "")),
+			BinaryPath:       getOrDefault(configFile.ImageScan.BinaryPath, getEnv("IMAGE_SCAN_BINARY_PATH", "")),
+			ServerURL:        getOrDefault(configFile.ImageScan.ServerURL, getEnv("IMAGE_SCAN_SERVER_URL", "")),
+			Cron:             getOrDefault(configFile.ImageScan.Cron, getEnv("IMAGE_SCAN_CRON", "0 2 * * *")),
+			TimeoutSeconds:   getIntOrDefault(configFile.ImageScan.TimeoutSeconds, getEnvAsInt("IMAGE_SCAN_TIMEOUT_SECONDS", 600)),
+			CacheHours:       getIntOrDefault(configFile.ImageScan.CacheHours, getEnvAsInt("IMAGE_SCAN_CACHE_HOURS", 24)),
+			NotifyWebhookURL: getOrDefault(configFile.ImageScan.NotifyWebhookURL, getEnv("IMAGE_SCAN_NOTIFY_WEBHOOK_URL", "")),
+		},
 	}
 
 	return config, nil
@@ -371,8 +538,29 @@ type ConfigFile struct {
 
 	// T028: Audit configuration
 	Audit struct {
-		RetentionDays  int `yaml:"retention_days"`
-		MaxObjectBytes int `yaml:"max_object_bytes"`
+		RetentionDays  int    `yaml:"retention_days"`
+		MaxObjectBytes int    `yaml:"max_object_bytes"`
+		SigningKey     string `yaml:"signing_key"`
+		Sinks          struct {
+			Syslog struct {
+				Network string `yaml:"network"`
+				Address string `yaml:"address"`
+				AppName string `yaml:"app_name"`
+			} `yaml:"syslog"`
+			Webhook struct {
+				URL    string `yaml:"url"`
+				Secret string `yaml:"secret"`
+			} `yaml:"webhook"`
+			MinIO struct {
+				Endpoint      string `yaml:"endpoint"`
+				AccessKey     string `yaml:"access_key"`
+				SecretKey     string `yaml:"secret_key"`
+				Bucket        string `yaml:"bucket"`
+				Prefix        string `yaml:"prefix"`
+				UseSSL        bool   `yaml:"use_ssl"`
+				RetentionDays int    `yaml:"retention_days"`
+			} `yaml:"minio"`
+		} `yaml:"sinks"`
 	} `yaml:"audit"`
 
 	// T002: Terminal recording configuration
@@ -391,6 +579,64 @@ type ConfigFile struct {
 			UseSSL    bool   `yaml:"use_ssl"`
 		} `yaml:"minio"`
 	} `yaml:"recording"`
+
+	// Just-in-time access requests
+	Access struct {
+		MaxDurationMinutes int    `yaml:"max_duration_minutes"`
+		NotifyWebhookURL   string `yaml:"notify_webhook_url"`
+	} `yaml:"access"`
+
+	// Agent gRPC transport security
+	AgentTLS struct {
+		Enabled           bool   `yaml:"enabled"`
+		CertFile          string `yaml:"cert_file"`
+		KeyFile           string `yaml:"key_file"`
+		RequireClientCert bool   `yaml:"require_client_cert"`
+		CertValidityDays  int    `yaml:"cert_validity_days"`
+	} `yaml:"agent_tls"`
+
+	// Agent self-update
+	AgentUpdate struct {
+		BinaryDir  string `yaml:"binary_dir"`
+		ReleaseURL string `yaml:"release_url"`
+	} `yaml:"agent_update"`
+
+	// Prometheus scrape endpoint of host and service metrics
+	MetricsExport struct {
+		Enabled      bool   `yaml:"enabled"`
+		BearerToken  string `yaml:"bearer_token"`
+		CacheSeconds int    `yaml:"cache_seconds"`
+	} `yaml:"metrics_export"`
+
+	// Prometheus remote-write of host and service metrics
+	RemoteWrite struct {
+		URL             string            `yaml:"url"`
+		IntervalSeconds int               `yaml:"interval_seconds"`
+		TimeoutSeconds  int               `yaml:"timeout_seconds"`
+		Username        string            `yaml:"username"`
+		Password        string            `yaml:"password"`
+		BearerToken     string            `yaml:"bearer_token"`
+		ExternalLabels  map[string]string `yaml:"external_labels"`
+	} `yaml:"remote_write"`
+
+	// Host state history downsampling and retention
+	HostStateRetention struct {
+		RawHours   int `yaml:"raw_hours"`
+		MinuteDays int `yaml:"minute_days"`
+		HourDays   int `yaml:"hour_days"`
+		DayDays    int `yaml:"day_days"`
+	} `yaml:"host_state_retention"`
+
+	// Image vulnerability scanning
+	ImageScan struct {
+		Scanner          string `yaml:"scanner"`
+		BinaryPath       string `yaml:"binary_path"`
+		ServerURL        string `yaml:"server_url"`
+		Cron             string `yaml:"cron"`
+		TimeoutSeconds   int    `yaml:"timeout_seconds"`
+		CacheHours       int    `yaml:"cache_hours"`
+		NotifyWebhookURL string `yaml:"notify_webhook_url"`
+	} `yaml:"image_scan"`
 }
 
 // LoadFromEnv loads configuration from environment variables
@@ -474,6 +720,31 @@ func LoadFromEnv() *Config {
 			Level:  getEnv("LOG_LEVEL", "debug"),
 			Format: getEnv("LOG_FORMAT", "json"),
 		},
+		Audit: AuditConfig{
+			RetentionDays:  getEnvAsInt("AUDIT_RETENTION_DAYS", 90),
+			MaxObjectBytes: getEnvAsInt("AUDIT_MAX_OBJECT_BYTES", 64*1024),
+			SigningKey:     getEnv("AUDIT_SIGNING_KEY", ""),
+			Sinks: AuditSinksConfig{
+				Syslog: AuditSyslogConfig{
+					Network: getEnv("AUDIT_SYSLOG_NETWORK", "udp"),
+					Address: getEnv("AUDIT_SYSLOG_ADDRESS", ""),
+					AppName: getEnv("AUDIT_SYSLOG_APP_NAME", "tiga"),
+				},
+				Webhook: AuditWebhookConfig{
+					URL:    getEnv("AUDIT_WEBHOOK_URL", ""),
+					Secret: getEnv("AUDIT_WEBHOOK_SECRET", ""),
+				},
+				MinIO: AuditMinIOConfig{
+					Endpoint:      getEnv("AUDIT_MINIO_ENDPOINT", ""),
+					AccessKey:     getEnv("AUDIT_MINIO_ACCESS_KEY", ""),
+					SecretKey:     getEnv("AUDIT_MINIO_SECRET_KEY", ""),
+					Bucket:        getEnv("AUDIT_MINIO_BUCKET", ""),
+					Prefix:        getEnv("AUDIT_MINIO_PREFIX", "audit"),
+					UseSSL:        getEnvAsBool("AUDIT_MINIO_USE_SSL", false),
+					RetentionDays: getEnvAsInt("AUDIT_MINIO_RETENTION_DAYS", 0),
+				},
+			},
+		},
 		// T002: Terminal recording configuration
 		Recording: RecordingConfig{
 			StorageType:      getEnv("RECORDING_STORAGE_TYPE", "local"),
@@ -490,6 +761,49 @@ func LoadFromEnv() *Config {
 				UseSSL:    getEnvAsBool("RECORDING_MINIO_USE_SSL", true),
 			},
 		},
+		Access: AccessConfig{
+			MaxDurationMinutes: getEnvAsInt("ACCESS_MAX_DURATION_MINUTES", 480),
+			NotifyWebhookURL:   getEnv("ACCESS_NOTIFY_WEBHOOK_URL", ""),
+		},
+		AgentTLS: AgentTLSConfig{
+			Enabled:           getEnvAsBool("AGENT_TLS_ENABLED", false),
+			CertFile:          getEnv("AGENT_TLS_CERT_FILE", ""),
+			KeyFile:           getEnv("AGENT_TLS_KEY_FILE", ""),
+			RequireClientCert: getEnvAsBool("AGENT_TLS_REQUIRE_CLIENT_CERT", false),
+			CertValidityDays:  getEnvAsInt("AGENT_TLS_CERT_VALIDITY_DAYS", 90),
+		},
+		AgentUpdate: AgentUpdateConfig{
+			BinaryDir:  getEnv("AGENT_UPDATE_BINARY_DIR", "./data/agent-binaries"),
+			ReleaseURL: getEnv("AGENT_UPDATE_RELEASE_URL", ""),
+		},
+		MetricsExport: MetricsExportConfig{
+			Enabled:      getEnvAsBool("METRICS_EXPORT_ENABLED", false),
+			BearerToken:  getEnv("METRICS_EXPORT_BEARER_TOKEN", ""),
+			CacheSeconds: getEnvAsInt("METRICS_EXPORT_CACHE_SECONDS", 30),
+		},
+		RemoteWrite: RemoteWriteConfig{
+			URL:             getEnv("REMOTE_WRITE_URL", ""),
+			IntervalSeconds: getEnvAsInt("REMOTE_WRITE_INTERVAL_SECONDS", 60),
+			TimeoutSeconds:  getEnvAsInt("REMOTE_WRITE_TIMEOUT_SECONDS", 30),
+			Username:        getEnv("REMOTE_WRITE_USERNAME", ""),
+			Password:        getEnv("REMOTE_WRITE_PASSWORD", ""),
+			BearerToken:     getEnv("REMOTE_WRITE_BEARER_TOKEN", ""),
+		},
+		HostStateRetention: HostStateRetentionConfig{
+			RawHours:   getEnvAsInt("HOST_STATE_RETENTION_RAW_HOURS", 48),
+			MinuteDays: getEnvAsInt("HOST_STATE_RETENTION_MINUTE_DAYS", 14),
+			HourDays:   getEnvAsInt("HOST_STATE_RETENTION_HOUR_DAYS", 90),
+			DayDays:    getEnvAsInt("HOST_STATE_RETENTION_DAY_DAYS", 730),
+		},
+		ImageScan: ImageScanConfig{
+			Scanner:          getEnv("IMAGE_SCAN_SCANNER", ""),
+			BinaryPath:       getEnv("IMAGE_SCAN_BINARY_PATH", ""),
+			ServerURL:        getEnv("IMAGE_SCAN_SERVER_URL", ""),
+			Cron:             getEnv("IMAGE_SCAN_CRON", "0 2 * * *"),
+			TimeoutSeconds:   getEnvAsInt("IMAGE_SCAN_TIMEOUT_SECONDS", 600),
+			CacheHours:       getEnvAsInt("IMAGE_SCAN_CACHE_HOURS", 24),
+			NotifyWebhookURL: getEnv("IMAGE_SCAN_NOTIFY_WEBHOOK_URL", ""),
+		},
 	}
 
 	return config
@@ -134,6 +134,7 @@ func (d *Database) AutoMigrate() error {
 		&models.User{},
 		&models.Role{},
 		&models.UserRole{},
+		&models.AccessRequest{},
 		&models.Session{},
 		&models.OAuthProvider{},
 
@@ -156,20 +157,34 @@ func (d *Database) AutoMigrate() error {
 		// Kubernetes management
 		&models.Cluster{},
 		&models.ResourceHistory{},
+		&models.ClusterEvent{},
+		&models.ClusterBaseline{},
 
 		// Host monitoring subsystem (Nezha-inspired)
 		&models.HostNode{},
 		&models.HostInfo{},
 		&models.HostState{},
+		&models.HostStateMinute{},
+		&models.HostStateHour{},
+		&models.HostStateDay{},
+		&models.HostCustomMetric{},
 		&models.ServiceMonitor{},
 		&models.ServiceProbeResult{},
+		&models.ServiceProbeStepResult{},
 		&models.ServiceAvailability{},
 		&models.ServiceHistory{}, // 30-day aggregated service history
+		&models.StatusPage{},
+		&models.StatusIncident{},
+		&models.StatusIncidentUpdate{},
 		&models.WebSSHSession{},
 		// T038: HostActivityLog 已迁移到统一的 AuditEvent 模型（subsystem='host'）
 		&models.MonitorAlertRule{},
 		&models.MonitorAlertEvent{},
 		&models.AgentConnection{},
+		&models.AgentCertificateAuthority{},
+		&models.AgentCertificate{},
+		&models.AgentRollout{},
+		&models.AgentRolloutHost{},
 
 		// MinIO subsystem
 		&models.MinIOInstance{},
@@ -186,7 +201,12 @@ func (d *Database) AutoMigrate() error {
 
 		// Docker instance management (007-docker-docker-agent)
 		&models.DockerInstance{},
+		&models.RegistryCredential{},
+		&models.ImageScan{},
+		&models.DockerCleanupPolicy{},
+		&models.DockerContainerEvent{},
 		&models.TerminalRecording{},
+		&models.TerminalCommand{},
 
 		// Scheduler and unified audit (T001-T037)
 		&models.ScheduledTask{},
@@ -203,6 +223,10 @@ func (d *Database) AutoMigrate() error {
 		logrus.Warnf("Failed to create resource history indexes: %v", err)
 	}
 
+	if err := (&models.ClusterEvent{}).AfterMigrate(d.DB); err != nil {
+		logrus.Warnf("Failed to create cluster event indexes: %v", err)
+	}
+
 	if err := (&models.TerminalRecording{}).AfterMigrate(d.DB); err != nil {
 		logrus.Warnf("Failed to create terminal recording indexes: %v", err)
 	}
@@ -0,0 +1,372 @@
+package docker
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+const (
+	// ArchiveChunkSize is the largest piece of archive data sent in one message
+	ArchiveChunkSize = 32 * 1024
+
+	// maxListedFiles caps the entries returned for one directory
+	maxListedFiles = 1000
+	// maxArchiveScanEntries caps the archive headers read when a directory
+	// is listed from its archive, which includes every nested entry
+	maxArchiveScanEntries = 20000
+)
+
+// ChunkWriter is an io.Writer that hands data to the wrapped function in
+// pieces of at most ArchiveChunkSize bytes. Each piece is a fresh copy, so
+// the function may keep it.
+type ChunkWriter func(data []byte) error
+
+// Write implements io.Writer
+func (w ChunkWriter) Write(p []byte) (int, error) {
+	for written := 0; written < len(p); {
+		n := min(len(p)-written, ArchiveChunkSize)
+		chunk := make([]byte, n)
+		copy(chunk, p[written:written+n])
+		if err := w(chunk); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return len(p), nil
+}
+
+// CopyArchiveFrom writes a tar archive of a file or directory in a container
+// to w, gzip-compressed when compress is set
+func (dc *DockerClient) CopyArchiveFrom(ctx context.Context, containerID, srcPath string, compress bool, w io.Writer) error {
+	reader, _, err := dc.client.CopyFromContainer(ctx, containerID, srcPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	// Coalesce the small writes of the gzip writer into full chunks
+	buf := bufio.NewWriterSize(w, ArchiveChunkSize)
+	if compress {
+		gz := gzip.NewWriter(buf)
+		if _, err := io.Copy(gz, reader); err != nil {
+			return err
+		}
+		if err := gz.Close(); err != nil {
+			return err
+		}
+	} else if _, err := io.Copy(buf, reader); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+// CopyArchiveTo extracts the tar archive read from r into a directory of a
+// container. The directory has to exist.
+func (dc *DockerClient) CopyArchiveTo(ctx context.Context, containerID, dstPath string, r io.Reader) error {
+	return dc.client.CopyToContainer(ctx, containerID, dstPath, r, container.CopyToContainerOptions{})
+}
+
+// ListContainerFiles implements the ListContainerFiles RPC method
+// It lists the directory with find and stat inside the container, and reads
+// the entry headers of the directory archive instead when the container is
+// stopped or its image lacks these tools
+func (s *DockerService) ListContainerFiles(ctx context.Context, req *pb.ListContainerFilesRequest) (*pb.ListContainerFilesResponse, error) {
+	cli := s.dockerClient.Client()
+	dir := cleanContainerPath(req.Path)
+
+	stat, err := cli.ContainerStatPath(ctx, req.ContainerId, dir)
+	if err != nil {
+		return nil, err
+	}
+	if stat.Mode&os.ModeSymlink != 0 && stat.LinkTarget != "" {
+		dir = cleanContainerPath(stat.LinkTarget)
+		if stat, err = cli.ContainerStatPath(ctx, req.ContainerId, dir); err != nil {
+			return nil, err
+		}
+	}
+	if !stat.Mode.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	files, truncated, err := s.listFilesWithExec(ctx, req.ContainerId, dir)
+	if err != nil {
+		logrus.WithError(err).WithField("container_id", req.ContainerId).Debug("Listing with find failed, reading the directory archive")
+		files, truncated, err = s.listFilesFromArchive(ctx, req.ContainerId, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sortContainerFiles(files)
+	if len(files) > maxListedFiles {
+		files = files[:maxListedFiles]
+		truncated = true
+	}
+
+	return &pb.ListContainerFilesResponse{
+		Path:      dir,
+		Files:     files,
+		Truncated: truncated,
+	}, nil
+}
+
+// listFilesWithExec lists a directory by running find and stat in the container
+func (s *DockerService) listFilesWithExec(ctx context.Context, containerID, dir string) ([]*pb.ContainerFile, bool, error) {
+	cli := s.dockerClient.Client()
+
+	execID, err := cli.ContainerExecCreate(ctx, containerID, types.ExecConfig{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          []string{"find", dir, "-mindepth", "1", "-maxdepth", "1", "-exec", "stat", "-c", "%f|%s|%Y|%n", "{}", "+"},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	attachResp, err := cli.ContainerExecAttach(ctx, execID.ID, types.ExecStartCheck{})
+	if err != nil {
+		return nil, false, err
+	}
+	defer attachResp.Close()
+
+	var stdout, stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, &stderr, attachResp.Reader); err != nil {
+		return nil, false, err
+	}
+
+	inspect, err := cli.ContainerExecInspect(ctx, execID.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	// find exits non-zero on unreadable entries but still lists the rest
+	if inspect.ExitCode != 0 && stdout.Len() == 0 {
+		return nil, false, fmt.Errorf("find exited with code %d: %s", inspect.ExitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	files := parseStatListing(dir, stdout.String())
+	return files, false, nil
+}
+
+// listFilesFromArchive lists a directory from the headers of its archive
+func (s *DockerService) listFilesFromArchive(ctx context.Context, containerID, dir string) ([]*pb.ContainerFile, bool, error) {
+	reader, _, err := s.dockerClient.Client().CopyFromContainer(ctx, containerID, dir)
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+
+	return readArchiveListing(dir, reader)
+}
+
+// parseStatListing parses the "%f|%s|%Y|%n" lines printed by stat, which
+// are the raw mode in hex, the size, the modification time and the path
+func parseStatListing(dir, output string) []*pb.ContainerFile {
+	var files []*pb.ContainerFile
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(line, "|", 4)
+		if len(parts) != 4 {
+			continue
+		}
+		rawMode, err := strconv.ParseUint(parts[0], 16, 32)
+		if err != nil {
+			continue
+		}
+		size, _ := strconv.ParseInt(parts[1], 10, 64)
+		modifiedAt, _ := strconv.ParseInt(parts[2], 10, 64)
+
+		name := path.Base(parts[3])
+		mode := unixFileMode(uint32(rawMode))
+		files = append(files, &pb.ContainerFile{
+			Name:       name,
+			Path:       path.Join(dir, name),
+			IsDir:      mode.IsDir(),
+			IsSymlink:  mode&os.ModeSymlink != 0,
+			Size:       size,
+			Mode:       formatFileMode(mode),
+			ModifiedAt: modifiedAt,
+		})
+	}
+	return files
+}
+
+// readArchiveListing returns the direct children of dir from a tar archive
+// of it. The first entry of the archive is the directory itself.
+func readArchiveListing(dir string, r io.Reader) ([]*pb.ContainerFile, bool, error) {
+	tr := tar.NewReader(r)
+	var files []*pb.ContainerFile
+	prefix := ""
+
+	for scanned := 0; ; scanned++ {
+		if scanned >= maxArchiveScanEntries {
+			return files, true, nil
+		}
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		name := strings.TrimSuffix(hdr.Name, "/")
+		if scanned == 0 {
+			if name != "" {
+				prefix = name + "/"
+			}
+			continue
+		}
+		rel, ok := strings.CutPrefix(name, prefix)
+		if !ok || rel == "" || strings.Contains(rel, "/") {
+			continue
+		}
+
+		mode := hdr.FileInfo().Mode()
+		files = append(files, &pb.ContainerFile{
+			Name:       rel,
+			Path:       path.Join(dir, rel),
+			IsDir:      mode.IsDir(),
+			IsSymlink:  mode&os.ModeSymlink != 0,
+			Size:       hdr.Size,
+			Mode:       formatFileMode(mode),
+			ModifiedAt: hdr.ModTime.Unix(),
+			LinkTarget: hdr.Linkname,
+		})
+	}
+}
+
+// cleanContainerPath returns the absolute, cleaned form of a container path
+func cleanContainerPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// sortContainerFiles sorts directories first, then by name
+func sortContainerFiles(files []*pb.ContainerFile) {
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].IsDir != files[j].IsDir {
+			return files[i].IsDir
+		}
+		return files[i].Name < files[j].Name
+	})
+}
+
+// unixFileMode converts a raw st_mode value to an os.FileMode
+func unixFileMode(raw uint32) os.FileMode {
+	mode := os.FileMode(raw & 0o777)
+	switch raw & 0o170000 {
+	case 0o040000:
+		mode |= os.ModeDir
+	case 0o120000:
+		mode |= os.ModeSymlink
+	case 0o020000:
+		mode |= os.ModeDevice | os.ModeCharDevice
+	case 0o060000:
+		mode |= os.ModeDevice
+	case 0o010000:
+		mode |= os.ModeNamedPipe
+	case 0o140000:
+		mode |= os.ModeSocket
+	}
+	if raw&0o4000 != 0 {
+		mode |= os.ModeSetuid
+	}
+	if raw&0o2000 != 0 {
+		mode |= os.ModeSetgid
+	}
+	if raw&0o1000 != 0 {
+		mode |= os.ModeSticky
+	}
+	return mode
+}
+
+// formatFileMode formats a mode the way ls does, e.g. drwxr-xr-x
+func formatFileMode(mode os.FileMode) string {
+	kind := "-"
+	switch {
+	case mode.IsDir():
+		kind = "d"
+	case mode&os.ModeSymlink != 0:
+		kind = "l"
+	case mode&os.ModeCharDevice != 0:
+		kind = "c"
+	case mode&os.ModeDevice != 0:
+		kind = "b"
+	case mode&os.ModeNamedPipe != 0:
+		kind = "p"
+	case mode&os.ModeSocket != 0:
+		kind = "s"
+	}
+	return kind + mode.Perm().String()[1:]
+}
+
+// CopyFromContainer implements the CopyFromContainer streaming RPC method
+// It streams a tar archive of a file or directory in the container
+func (s *DockerService) CopyFromContainer(req *pb.CopyFromContainerRequest, stream pb.DockerService_CopyFromContainerServer) error {
+	send := ChunkWriter(func(data []byte) error {
+		return stream.Send(&pb.ArchiveChunk{Data: data})
+	})
+	return s.dockerClient.CopyArchiveFrom(stream.Context(), req.ContainerId, req.Path, req.Compress, send)
+}
+
+// CopyToContainer implements the CopyToContainer client streaming RPC method
+// It extracts the streamed tar archive into a directory of the container
+func (s *DockerService) CopyToContainer(stream pb.DockerService_CopyToContainerServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.ContainerId == "" || first.Path == "" {
+		return fmt.Errorf("first message must carry container_id and path")
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		data := first.Data
+		for {
+			if len(data) > 0 {
+				if _, err := pw.Write(data); err != nil {
+					return
+				}
+			}
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				pw.Close()
+				return
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			data = msg.Data
+		}
+	}()
+
+	err = s.dockerClient.CopyArchiveTo(stream.Context(), first.ContainerId, first.Path, pr)
+	// Unblock the receiver if Docker stopped reading early
+	pr.Close()
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.CopyToContainerResponse{
+		Success: true,
+		Message: "Archive extracted successfully",
+	})
+}
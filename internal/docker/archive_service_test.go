@@ -0,0 +1,100 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatListing(t *testing.T) {
+	output := "41ed|4096|1700000000|/etc/ssl\n" +
+		"81a4|220|1700000100|/etc/hosts\n" +
+		"a1ff|12|1700000200|/etc/mtab\n" +
+		"81a4|5|1700000300|/etc/a|b\n" +
+		"not a stat line\n"
+
+	files := parseStatListing("/etc", output)
+	require.Len(t, files, 4)
+
+	assert.Equal(t, "ssl", files[0].Name)
+	assert.Equal(t, "/etc/ssl", files[0].Path)
+	assert.True(t, files[0].IsDir)
+	assert.Equal(t, "drwxr-xr-x", files[0].Mode)
+
+	assert.Equal(t, int64(220), files[1].Size)
+	assert.Equal(t, int64(1700000100), files[1].ModifiedAt)
+	assert.Equal(t, "-rw-r--r--", files[1].Mode)
+
+	assert.True(t, files[2].IsSymlink)
+	assert.Equal(t, "lrwxrwxrwx", files[2].Mode)
+
+	// The path is the last field, so names may contain the separator
+	assert.Equal(t, "a|b", files[3].Name)
+}
+
+func TestReadArchiveListing(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	modTime := time.Unix(1700000000, 0)
+	for _, hdr := range []*tar.Header{
+		{Name: "etc/", Typeflag: tar.TypeDir, Mode: 0o755, ModTime: modTime},
+		{Name: "etc/hosts", Typeflag: tar.TypeReg, Mode: 0o644, Size: 5, ModTime: modTime},
+		{Name: "etc/ssl/", Typeflag: tar.TypeDir, Mode: 0o755, ModTime: modTime},
+		{Name: "etc/ssl/cert.pem", Typeflag: tar.TypeReg, Mode: 0o644, ModTime: modTime},
+		{Name: "etc/mtab", Typeflag: tar.TypeSymlink, Linkname: "/proc/mounts", Mode: 0o777, ModTime: modTime},
+	} {
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Size > 0 {
+			_, err := tw.Write([]byte("hello"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+
+	files, truncated, err := readArchiveListing("/etc", &buf)
+	require.NoError(t, err)
+	assert.False(t, truncated)
+
+	// Nested entries are skipped
+	require.Len(t, files, 3)
+	sortContainerFiles(files)
+	assert.Equal(t, "ssl", files[0].Name)
+	assert.Equal(t, "hosts", files[1].Name)
+	assert.Equal(t, int64(5), files[1].Size)
+	assert.Equal(t, "/etc/mtab", files[2].Path)
+	assert.True(t, files[2].IsSymlink)
+	assert.Equal(t, "/proc/mounts", files[2].LinkTarget)
+}
+
+func TestUnixFileMode(t *testing.T) {
+	assert.Equal(t, os.ModeDir|os.ModeSticky|0o777, unixFileMode(0o41777))
+	assert.Equal(t, os.ModeSetuid|0o755, unixFileMode(0o104755))
+	assert.Equal(t, "crw-rw-rw-", formatFileMode(unixFileMode(0o20666)))
+	assert.Equal(t, "/", cleanContainerPath(""))
+	assert.Equal(t, "/var/log", cleanContainerPath("var/log/../log/"))
+}
+
+func TestChunkWriter(t *testing.T) {
+	var chunks [][]byte
+	w := ChunkWriter(func(data []byte) error {
+		chunks = append(chunks, data)
+		return nil
+	})
+
+	data := bytes.Repeat([]byte("x"), ArchiveChunkSize+10)
+	n, err := w.Write(data)
+	require.NoError(t, err)
+	assert.Equal(t, len(data), n)
+	require.Len(t, chunks, 2)
+	assert.Len(t, chunks[0], ArchiveChunkSize)
+	assert.Len(t, chunks[1], 10)
+
+	// Chunks do not alias the written buffer
+	data[0] = 'y'
+	assert.Equal(t, byte('x'), chunks[0][0])
+}
@@ -16,15 +16,23 @@ type DockerClient struct {
 	client        *client.Client
 	apiVersion    string
 	dockerVersion string
+
+	// Set for engines reached over SSH
+	ssh *sshDialer
 }
 
 // NewDockerClient creates a new Docker client with API version negotiation and validation
 func NewDockerClient() (*DockerClient, error) {
-	// Create client with automatic API version negotiation
-	cli, err := client.NewClientWithOpts(
+	return newDockerClient(
 		client.FromEnv,                     // Read from DOCKER_HOST, DOCKER_TLS_VERIFY, DOCKER_CERT_PATH
 		client.WithAPIVersionNegotiation(), // Automatically negotiate API version
 	)
+}
+
+// newDockerClient creates a Docker client with the given options and checks
+// that the daemon supports the minimum API version
+func newDockerClient(opts ...client.Opt) (*DockerClient, error) {
+	cli, err := client.NewClientWithOpts(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Docker client: %w", err)
 	}
@@ -120,6 +128,9 @@ func (dc *DockerClient) DockerVersion() string {
 
 // Close closes the Docker client connection
 func (dc *DockerClient) Close() error {
+	if dc.ssh != nil {
+		dc.ssh.Close()
+	}
 	if dc.client != nil {
 		return dc.client.Close()
 	}
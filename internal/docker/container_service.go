@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -153,6 +154,127 @@ func convertMountsToProto(mounts []types.MountPoint) []*pb.Mount {
 	return pbMounts
 }
 
+// GetContainer implements the GetContainer RPC method
+// It returns the inspected configuration and state of a container
+func (s *DockerService) GetContainer(ctx context.Context, req *pb.GetContainerRequest) (*pb.GetContainerResponse, error) {
+	inspect, err := s.dockerClient.Client().ContainerInspect(ctx, req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetContainerResponse{Container: convertContainerDetailToProto(&inspect)}, nil
+}
+
+// convertContainerDetailToProto converts an inspected container to protobuf ContainerDetail
+func convertContainerDetailToProto(inspect *types.ContainerJSON) *pb.ContainerDetail {
+	detail := &pb.ContainerDetail{
+		Mounts:          convertMountsToProto(inspect.Mounts),
+		NetworkSettings: make(map[string]*pb.NetworkSettings),
+	}
+
+	if inspect.ContainerJSONBase != nil {
+		detail.Id = inspect.ID
+		detail.Path = inspect.Path
+		detail.Args = inspect.Args
+		detail.Image = inspect.Image
+		detail.Name = strings.TrimPrefix(inspect.Name, "/")
+		detail.RestartCount = int32(inspect.RestartCount)
+		if created, err := time.Parse(time.RFC3339Nano, inspect.Created); err == nil {
+			detail.Created = created.Unix()
+		}
+
+		if st := inspect.State; st != nil {
+			detail.State = &pb.ContainerState{
+				Status:     st.Status,
+				Running:    st.Running,
+				Paused:     st.Paused,
+				Restarting: st.Restarting,
+				OomKilled:  st.OOMKilled,
+				Dead:       st.Dead,
+				Pid:        int32(st.Pid),
+				ExitCode:   int32(st.ExitCode),
+				Error:      st.Error,
+				StartedAt:  st.StartedAt,
+				FinishedAt: st.FinishedAt,
+			}
+		}
+
+		if hc := inspect.HostConfig; hc != nil {
+			hostConfig := &pb.HostConfig{
+				CpuShares:     hc.CPUShares,
+				Memory:        hc.Memory,
+				CgroupParent:  hc.CgroupParent,
+				BlkioWeight:   int32(hc.BlkioWeight),
+				RestartPolicy: string(hc.RestartPolicy.Name),
+				NetworkMode:   string(hc.NetworkMode),
+				Privileged:    hc.Privileged,
+				Binds:         hc.Binds,
+				Dns:           hc.DNS,
+			}
+			for _, bindings := range hc.PortBindings {
+				for _, binding := range bindings {
+					hostConfig.PortBindings = append(hostConfig.PortBindings, &pb.PortBinding{
+						HostIp:   binding.HostIP,
+						HostPort: binding.HostPort,
+					})
+				}
+			}
+			detail.HostConfig = hostConfig
+		}
+	}
+
+	if cfg := inspect.Config; cfg != nil {
+		config := &pb.ContainerConfig{
+			Hostname:     cfg.Hostname,
+			Domainname:   cfg.Domainname,
+			User:         cfg.User,
+			AttachStdin:  cfg.AttachStdin,
+			AttachStdout: cfg.AttachStdout,
+			AttachStderr: cfg.AttachStderr,
+			ExposedPorts: make(map[string]bool),
+			Tty:          cfg.Tty,
+			OpenStdin:    cfg.OpenStdin,
+			StdinOnce:    cfg.StdinOnce,
+			Env:          cfg.Env,
+			Cmd:          cfg.Cmd,
+			Image:        cfg.Image,
+			Volumes:      make(map[string]bool),
+			WorkingDir:   cfg.WorkingDir,
+			Entrypoint:   cfg.Entrypoint,
+			Labels:       cfg.Labels,
+		}
+		for port := range cfg.ExposedPorts {
+			config.ExposedPorts[string(port)] = true
+		}
+		for volume := range cfg.Volumes {
+			config.Volumes[volume] = true
+		}
+		detail.Config = config
+	}
+
+	if inspect.NetworkSettings != nil {
+		for name, network := range inspect.NetworkSettings.Networks {
+			if network == nil {
+				continue
+			}
+			detail.NetworkSettings[name] = &pb.NetworkSettings{
+				Bridge:              inspect.NetworkSettings.Bridge,
+				SandboxId:           inspect.NetworkSettings.SandboxID,
+				SandboxKey:          inspect.NetworkSettings.SandboxKey,
+				EndpointId:          network.EndpointID,
+				Gateway:             network.Gateway,
+				GlobalIpv6Address:   network.GlobalIPv6Address,
+				GlobalIpv6PrefixLen: int32(network.GlobalIPv6PrefixLen),
+				IpAddress:           network.IPAddress,
+				IpPrefixLen:         int32(network.IPPrefixLen),
+				Ipv6Gateway:         network.IPv6Gateway,
+				MacAddress:          network.MacAddress,
+			}
+		}
+	}
+
+	return detail
+}
+
 // StartContainer implements the StartContainer RPC method
 func (s *DockerService) StartContainer(ctx context.Context, req *pb.StartContainerRequest) (*pb.StartContainerResponse, error) {
 	err := s.dockerClient.Client().ContainerStart(ctx, req.ContainerId, container.StartOptions{})
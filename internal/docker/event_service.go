@@ -0,0 +1,67 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// StreamEvents follows the engine events matching the request and hands each
+// one to send. It returns when ctx is done, the until time is reached or
+// send fails.
+func (dc *DockerClient) StreamEvents(ctx context.Context, req *pb.GetEventsRequest, send func(*pb.DockerEvent) error) error {
+	opts := events.ListOptions{
+		Since: req.Since,
+		Until: req.Until,
+	}
+	if req.Filters != "" {
+		args, err := filters.FromJSON(req.Filters)
+		if err != nil {
+			return fmt.Errorf("invalid event filters: %w", err)
+		}
+		opts.Filters = args
+	}
+
+	messages, errs := dc.client.Events(ctx, opts)
+	for {
+		select {
+		case msg := <-messages:
+			if err := send(convertEventToProto(&msg)); err != nil {
+				return err
+			}
+		case err := <-errs:
+			// The engine ends the stream once the until time is reached
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// GetEvents implements the GetEvents streaming RPC method
+// It streams Docker engine events to the client
+func (s *DockerService) GetEvents(req *pb.GetEventsRequest, stream pb.DockerService_GetEventsServer) error {
+	return s.dockerClient.StreamEvents(stream.Context(), req, stream.Send)
+}
+
+// convertEventToProto converts a Docker events.Message to protobuf DockerEvent
+func convertEventToProto(msg *events.Message) *pb.DockerEvent {
+	return &pb.DockerEvent{
+		Type:   string(msg.Type),
+		Action: string(msg.Action),
+		Actor: &pb.Actor{
+			Id:         msg.Actor.ID,
+			Attributes: msg.Actor.Attributes,
+		},
+		Time:     msg.Time,
+		TimeNano: msg.TimeNano,
+		Scope:    msg.Scope,
+	}
+}
@@ -2,6 +2,9 @@ package docker
 
 import (
 	"context"
+	"sort"
+
+	"github.com/docker/docker/api/types/system"
 
 	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
 )
@@ -44,3 +47,106 @@ func (s *DockerService) GetDockerInfo(ctx context.Context, req *pb.GetDockerInfo
 
 	return response, nil
 }
+
+// GetSystemInfo implements the GetSystemInfo RPC method
+// It returns the daemon information, including the swarm state of the node
+func (s *DockerService) GetSystemInfo(ctx context.Context, req *pb.GetSystemInfoRequest) (*pb.GetSystemInfoResponse, error) {
+	info, err := s.dockerClient.Client().Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSystemInfoResponse{Info: convertSystemInfo(&info)}, nil
+}
+
+// convertSystemInfo converts the daemon information to protobuf format
+func convertSystemInfo(info *system.Info) *pb.SystemInfo {
+	pbInfo := &pb.SystemInfo{
+		Id:                 info.ID,
+		Containers:         int32(info.Containers),
+		ContainersRunning:  int32(info.ContainersRunning),
+		ContainersPaused:   int32(info.ContainersPaused),
+		ContainersStopped:  int32(info.ContainersStopped),
+		Images:             int32(info.Images),
+		Driver:             info.Driver,
+		DockerRootDir:      info.DockerRootDir,
+		MemoryLimit:        info.MemoryLimit,
+		SwapLimit:          info.SwapLimit,
+		KernelMemory:       info.KernelMemory,
+		CpuCfsPeriod:       info.CPUCfsPeriod,
+		CpuCfsQuota:        info.CPUCfsQuota,
+		CpuShares:          info.CPUShares,
+		CpuSet:             info.CPUSet,
+		PidsLimit:          info.PidsLimit,
+		Ipv4Forwarding:     info.IPv4Forwarding,
+		BridgeNfIptables:   info.BridgeNfIptables,
+		BridgeNfIp6Tables:  info.BridgeNfIP6tables,
+		Debug:              info.Debug,
+		NFd:                int32(info.NFd),
+		OomKillDisable:     info.OomKillDisable,
+		NGoroutines:        int32(info.NGoroutines),
+		SystemTime:         info.SystemTime,
+		LoggingDriver:      info.LoggingDriver,
+		CgroupDriver:       info.CgroupDriver,
+		NEventsListener:    int32(info.NEventsListener),
+		KernelVersion:      info.KernelVersion,
+		OperatingSystem:    info.OperatingSystem,
+		OsType:             info.OSType,
+		Architecture:       info.Architecture,
+		Ncpu:               int32(info.NCPU),
+		MemTotal:           info.MemTotal,
+		IndexServerAddress: info.IndexServerAddress,
+		Warnings:           info.Warnings,
+		Swarm: &pb.SwarmInfo{
+			NodeId:           info.Swarm.NodeID,
+			NodeAddr:         info.Swarm.NodeAddr,
+			LocalNodeState:   string(info.Swarm.LocalNodeState),
+			ControlAvailable: info.Swarm.ControlAvailable,
+			Nodes:            int32(info.Swarm.Nodes),
+			Managers:         int32(info.Swarm.Managers),
+			Error:            info.Swarm.Error,
+		},
+	}
+	if info.Swarm.Cluster != nil {
+		pbInfo.Swarm.ClusterId = info.Swarm.Cluster.ID
+	}
+
+	for _, status := range info.DriverStatus {
+		pbInfo.DriverStatus = append(pbInfo.DriverStatus, &pb.DriverStatus{Name: status[0], Value: status[1]})
+	}
+	if len(info.SystemStatus) > 0 {
+		pbInfo.SystemStatus = make(map[string]string, len(info.SystemStatus))
+		for _, status := range info.SystemStatus {
+			pbInfo.SystemStatus[status[0]] = status[1]
+		}
+	}
+	for pluginType, names := range map[string][]string{
+		"Volume":        info.Plugins.Volume,
+		"Network":       info.Plugins.Network,
+		"Authorization": info.Plugins.Authorization,
+		"Log":           info.Plugins.Log,
+	} {
+		for _, name := range names {
+			pbInfo.Plugins = append(pbInfo.Plugins, &pb.Plugin{Type: pluginType, Name: name})
+		}
+	}
+	sort.Slice(pbInfo.Plugins, func(i, j int) bool {
+		if pbInfo.Plugins[i].Type != pbInfo.Plugins[j].Type {
+			return pbInfo.Plugins[i].Type < pbInfo.Plugins[j].Type
+		}
+		return pbInfo.Plugins[i].Name < pbInfo.Plugins[j].Name
+	})
+
+	if rc := info.RegistryConfig; rc != nil {
+		registryConfig := &pb.RegistryConfig{Mirrors: rc.Mirrors}
+		for _, cidr := range rc.InsecureRegistryCIDRs {
+			registryConfig.InsecureRegistryCidrs = append(registryConfig.InsecureRegistryCidrs, cidr.String())
+		}
+		for name := range rc.IndexConfigs {
+			registryConfig.IndexConfigs = append(registryConfig.IndexConfigs, name)
+		}
+		sort.Strings(registryConfig.IndexConfigs)
+		pbInfo.RegistryConfig = registryConfig
+	}
+
+	return pbInfo
+}
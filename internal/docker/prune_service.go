@@ -0,0 +1,260 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// GetDiskUsage implements the GetDiskUsage RPC method
+// It reports the disk space used by images, containers, volumes and build cache
+func (s *DockerService) GetDiskUsage(ctx context.Context, req *pb.GetDiskUsageRequest) (*pb.GetDiskUsageResponse, error) {
+	du, err := s.dockerClient.Client().DiskUsage(ctx, types.DiskUsageOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDiskUsageResponse{Usage: convertDiskUsageToProto(&du)}, nil
+}
+
+// PruneImages implements the PruneImages RPC method
+// It removes dangling images, or all unused images when All is set
+func (s *DockerService) PruneImages(ctx context.Context, req *pb.PruneImagesRequest) (*pb.PruneImagesResponse, error) {
+	if req.DryRun {
+		du, err := s.dockerClient.Client().DiskUsage(ctx, types.DiskUsageOptions{Types: []types.DiskUsageObject{types.ImageObject}})
+		if err != nil {
+			return nil, err
+		}
+		ids, reclaimable := planImagePrune(du.Images, req.All, pruneCutoff(req.OlderThanDays))
+		return &pb.PruneImagesResponse{ImagesDeleted: ids, SpaceReclaimed: reclaimable, DryRun: true}, nil
+	}
+
+	filterArgs := pruneFilters(req.OlderThanDays)
+	if req.All {
+		filterArgs.Add("dangling", "false")
+	} else {
+		filterArgs.Add("dangling", "true")
+	}
+
+	report, err := s.dockerClient.Client().ImagesPrune(ctx, filterArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+	for _, item := range report.ImagesDeleted {
+		if item.Deleted != "" {
+			deleted = append(deleted, item.Deleted)
+		}
+	}
+	return &pb.PruneImagesResponse{ImagesDeleted: deleted, SpaceReclaimed: report.SpaceReclaimed}, nil
+}
+
+// PruneContainers implements the PruneContainers RPC method
+// It removes stopped containers
+func (s *DockerService) PruneContainers(ctx context.Context, req *pb.PruneContainersRequest) (*pb.PruneContainersResponse, error) {
+	if req.DryRun {
+		du, err := s.dockerClient.Client().DiskUsage(ctx, types.DiskUsageOptions{Types: []types.DiskUsageObject{types.ContainerObject}})
+		if err != nil {
+			return nil, err
+		}
+		ids, reclaimable := planContainerPrune(du.Containers, pruneCutoff(req.OlderThanDays))
+		return &pb.PruneContainersResponse{ContainersDeleted: ids, SpaceReclaimed: reclaimable, DryRun: true}, nil
+	}
+
+	report, err := s.dockerClient.Client().ContainersPrune(ctx, pruneFilters(req.OlderThanDays))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PruneContainersResponse{ContainersDeleted: report.ContainersDeleted, SpaceReclaimed: report.SpaceReclaimed}, nil
+}
+
+// PruneBuildCache implements the PruneBuildCache RPC method
+// It removes build cache that is not in use
+func (s *DockerService) PruneBuildCache(ctx context.Context, req *pb.PruneBuildCacheRequest) (*pb.PruneBuildCacheResponse, error) {
+	if req.DryRun {
+		du, err := s.dockerClient.Client().DiskUsage(ctx, types.DiskUsageOptions{Types: []types.DiskUsageObject{types.BuildCacheObject}})
+		if err != nil {
+			return nil, err
+		}
+		ids, reclaimable := planBuildCachePrune(du.BuildCache, req.All, pruneCutoff(req.OlderThanDays))
+		return &pb.PruneBuildCacheResponse{CachesDeleted: ids, SpaceReclaimed: reclaimable, DryRun: true}, nil
+	}
+
+	report, err := s.dockerClient.Client().BuildCachePrune(ctx, types.BuildCachePruneOptions{
+		All:     req.All,
+		Filters: pruneFilters(req.OlderThanDays),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PruneBuildCacheResponse{CachesDeleted: report.CachesDeleted, SpaceReclaimed: report.SpaceReclaimed}, nil
+}
+
+// pruneFilters returns the Docker prune filters for an age limit in days
+func pruneFilters(olderThanDays int32) filters.Args {
+	filterArgs := filters.NewArgs()
+	if olderThanDays > 0 {
+		filterArgs.Add("until", fmt.Sprintf("%dh", olderThanDays*24))
+	}
+	return filterArgs
+}
+
+// pruneCutoff returns the time before which objects may be pruned, zero
+// when there is no age limit
+func pruneCutoff(olderThanDays int32) time.Time {
+	if olderThanDays <= 0 {
+		return time.Time{}
+	}
+	return time.Now().AddDate(0, 0, -int(olderThanDays))
+}
+
+// planImagePrune selects the images ImagesPrune would remove: unused images
+// that are dangling (untagged) unless all is set. Reclaimable space excludes
+// layers shared with other images.
+func planImagePrune(images []*image.Summary, all bool, cutoff time.Time) ([]string, uint64) {
+	var ids []string
+	var reclaimable uint64
+	for _, img := range images {
+		if img.Containers > 0 {
+			continue
+		}
+		if !all && !isDanglingImage(img) {
+			continue
+		}
+		if !cutoff.IsZero() && !time.Unix(img.Created, 0).Before(cutoff) {
+			continue
+		}
+		ids = append(ids, img.ID)
+		size := img.Size
+		if img.SharedSize > 0 {
+			size -= img.SharedSize
+		}
+		if size > 0 {
+			reclaimable += uint64(size)
+		}
+	}
+	return ids, reclaimable
+}
+
+// planContainerPrune selects the containers ContainersPrune would remove:
+// containers that are not running, paused or restarting
+func planContainerPrune(containers []*types.Container, cutoff time.Time) ([]string, uint64) {
+	var ids []string
+	var reclaimable uint64
+	for _, c := range containers {
+		switch c.State {
+		case "running", "paused", "restarting":
+			continue
+		}
+		if !cutoff.IsZero() && !time.Unix(c.Created, 0).Before(cutoff) {
+			continue
+		}
+		ids = append(ids, c.ID)
+		if c.SizeRw > 0 {
+			reclaimable += uint64(c.SizeRw)
+		}
+	}
+	return ids, reclaimable
+}
+
+// planBuildCachePrune selects the cache records BuildCachePrune would
+// remove: records not in use, and not shared unless all is set. This is an
+// estimate, BuildKit applies its own rules to shared records.
+func planBuildCachePrune(records []*types.BuildCache, all bool, cutoff time.Time) ([]string, uint64) {
+	var ids []string
+	var reclaimable uint64
+	for _, bc := range records {
+		if bc.InUse || (bc.Shared && !all) {
+			continue
+		}
+		lastUsed := bc.CreatedAt
+		if bc.LastUsedAt != nil {
+			lastUsed = *bc.LastUsedAt
+		}
+		if !cutoff.IsZero() && !lastUsed.Before(cutoff) {
+			continue
+		}
+		ids = append(ids, bc.ID)
+		if bc.Size > 0 {
+			reclaimable += uint64(bc.Size)
+		}
+	}
+	return ids, reclaimable
+}
+
+// isDanglingImage reports whether an image has no tags
+func isDanglingImage(img *image.Summary) bool {
+	for _, tag := range img.RepoTags {
+		if tag != "<none>:<none>" {
+			return false
+		}
+	}
+	return true
+}
+
+func convertDiskUsageToProto(du *types.DiskUsage) *pb.DiskUsage {
+	usage := &pb.DiskUsage{LayersSize: du.LayersSize}
+	for _, img := range du.Images {
+		usage.Images = append(usage.Images, &pb.ImageSummary{
+			Id:          img.ID,
+			RepoTags:    img.RepoTags,
+			Created:     img.Created,
+			Size:        img.Size,
+			SharedSize:  img.SharedSize,
+			VirtualSize: img.Size,
+			Containers:  int32(img.Containers),
+		})
+	}
+	for _, c := range du.Containers {
+		usage.Containers = append(usage.Containers, &pb.ContainerSummary{
+			Id:         c.ID,
+			Names:      c.Names,
+			Image:      c.Image,
+			ImageId:    c.ImageID,
+			Command:    c.Command,
+			Created:    c.Created,
+			State:      c.State,
+			Status:     c.Status,
+			SizeRw:     c.SizeRw,
+			SizeRootFs: c.SizeRootFs,
+		})
+	}
+	for _, v := range du.Volumes {
+		summary := &pb.VolumeSummary{
+			Name:       v.Name,
+			Driver:     v.Driver,
+			Mountpoint: v.Mountpoint,
+		}
+		if v.UsageData != nil {
+			summary.UsageRefCount = v.UsageData.RefCount
+			summary.UsageSize = v.UsageData.Size
+		}
+		usage.Volumes = append(usage.Volumes, summary)
+	}
+	for _, bc := range du.BuildCache {
+		summary := &pb.BuildCacheSummary{
+			Id:          bc.ID,
+			Type:        bc.Type,
+			Description: bc.Description,
+			InUse:       bc.InUse,
+			Shared:      bc.Shared,
+			Size:        bc.Size,
+			CreatedAt:   bc.CreatedAt.Unix(),
+			UsageCount:  int64(bc.UsageCount),
+		}
+		if len(bc.Parents) > 0 {
+			summary.Parent = bc.Parents[0]
+		}
+		if bc.LastUsedAt != nil {
+			summary.LastUsedAt = bc.LastUsedAt.Unix()
+		}
+		usage.BuildCache = append(usage.BuildCache, summary)
+	}
+	return usage
+}
@@ -0,0 +1,64 @@
+package docker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/image"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanImagePrune(t *testing.T) {
+	old := time.Now().AddDate(0, 0, -10).Unix()
+	recent := time.Now().Unix()
+	images := []*image.Summary{
+		{ID: "dangling-old", RepoTags: []string{"<none>:<none>"}, Created: old, Size: 100},
+		{ID: "dangling-recent", Created: recent, Size: 200},
+		{ID: "tagged-old", RepoTags: []string{"nginx:1.27"}, Created: old, Size: 300, SharedSize: 100},
+		{ID: "in-use", Created: old, Size: 400, Containers: 1},
+	}
+
+	ids, reclaimable := planImagePrune(images, false, time.Time{})
+	assert.Equal(t, []string{"dangling-old", "dangling-recent"}, ids)
+	assert.Equal(t, uint64(300), reclaimable)
+
+	ids, reclaimable = planImagePrune(images, true, pruneCutoff(7))
+	assert.Equal(t, []string{"dangling-old", "tagged-old"}, ids)
+	assert.Equal(t, uint64(300), reclaimable)
+}
+
+func TestPlanContainerPrune(t *testing.T) {
+	containers := []*types.Container{
+		{ID: "exited", State: "exited", SizeRw: 10},
+		{ID: "running", State: "running", SizeRw: 20},
+		{ID: "created", State: "created"},
+	}
+
+	ids, reclaimable := planContainerPrune(containers, time.Time{})
+	assert.Equal(t, []string{"exited", "created"}, ids)
+	assert.Equal(t, uint64(10), reclaimable)
+}
+
+func TestPlanBuildCachePrune(t *testing.T) {
+	lastUsed := time.Now().AddDate(0, 0, -1)
+	records := []*types.BuildCache{
+		{ID: "old", CreatedAt: time.Now().AddDate(0, 0, -30), Size: 10},
+		{ID: "recently-used", CreatedAt: time.Now().AddDate(0, 0, -30), LastUsedAt: &lastUsed, Size: 20},
+		{ID: "shared", Shared: true, Size: 40},
+		{ID: "in-use", InUse: true, Size: 80},
+	}
+
+	ids, reclaimable := planBuildCachePrune(records, false, pruneCutoff(7))
+	assert.Equal(t, []string{"old"}, ids)
+	assert.Equal(t, uint64(10), reclaimable)
+
+	ids, reclaimable = planBuildCachePrune(records, true, time.Time{})
+	assert.Equal(t, []string{"old", "recently-used", "shared"}, ids)
+	assert.Equal(t, uint64(70), reclaimable)
+}
+
+func TestPruneFilters(t *testing.T) {
+	assert.Equal(t, 0, pruneFilters(0).Len())
+	assert.Equal(t, []string{"72h"}, pruneFilters(3).Get("until"))
+}
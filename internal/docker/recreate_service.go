@@ -0,0 +1,389 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+const (
+	defaultRecreateStopTimeout   = 10 // seconds
+	defaultRecreateHealthTimeout = 60 // seconds
+
+	// recreateStableWait is how long a container without a health check
+	// has to keep running before it counts as healthy
+	recreateStableWait = 5 * time.Second
+)
+
+// RecreateContainer implements the RecreateContainer RPC method
+// It stops the container, renames it out of the way and creates a new one
+// with the same settings plus the new image or resource limits. The old
+// container is removed once the new one is healthy, and restored if the new
+// one fails to create, start or become healthy.
+func (s *DockerService) RecreateContainer(ctx context.Context, req *pb.RecreateContainerRequest) (*pb.RecreateContainerResponse, error) {
+	cli := s.dockerClient.Client()
+
+	old, err := cli.ContainerInspect(ctx, req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+	if old.ContainerJSONBase == nil || old.Config == nil || old.HostConfig == nil {
+		return nil, fmt.Errorf("incomplete inspect data for container %s", req.ContainerId)
+	}
+
+	name := strings.TrimPrefix(old.Name, "/")
+	image := req.Image
+	if image == "" {
+		image = old.Config.Image
+	}
+	resp := &pb.RecreateContainerResponse{
+		OldContainerId: old.ID,
+		OldImage:       old.Config.Image,
+		Image:          image,
+	}
+
+	if _, _, err := cli.ImageInspectWithRaw(ctx, image); err != nil {
+		resp.Message = fmt.Sprintf("image %s is not available locally: %v", image, err)
+		return resp, nil
+	}
+	// The old image may be gone; its defaults are then kept as they are
+	var oldImageConfig *container.Config
+	if oldImage, _, err := cli.ImageInspectWithRaw(ctx, old.Image); err == nil {
+		oldImageConfig = oldImage.Config
+	}
+	config, hostConfig, networking, extraNetworks := buildRecreateSpec(&old, image, oldImageConfig, req.Resources)
+
+	stopTimeout := int(req.StopTimeout)
+	if stopTimeout <= 0 {
+		stopTimeout = defaultRecreateStopTimeout
+	}
+	healthTimeout := time.Duration(req.HealthTimeout) * time.Second
+	if healthTimeout <= 0 {
+		healthTimeout = defaultRecreateHealthTimeout * time.Second
+	}
+	wasRunning := old.State != nil && old.State.Running
+
+	if wasRunning {
+		if err := cli.ContainerStop(ctx, old.ID, container.StopOptions{Timeout: &stopTimeout}); err != nil {
+			resp.Message = fmt.Sprintf("failed to stop container: %v", err)
+			return resp, nil
+		}
+	}
+
+	backupName := fmt.Sprintf("%s-old-%d", name, time.Now().Unix())
+	if err := cli.ContainerRename(ctx, old.ID, backupName); err != nil {
+		resp.Message = fmt.Sprintf("failed to rename container: %v", err)
+		if wasRunning {
+			if err := cli.ContainerStart(ctx, old.ID, container.StartOptions{}); err != nil {
+				return nil, fmt.Errorf("%s; failed to restart old container: %w", resp.Message, err)
+			}
+		}
+		return resp, nil
+	}
+
+	created, err := cli.ContainerCreate(ctx, config, hostConfig, networking, nil, name)
+	if err != nil {
+		return s.rollbackRecreate(ctx, resp, old.ID, name, "", wasRunning, fmt.Errorf("failed to create container: %w", err))
+	}
+	for networkName, endpoint := range extraNetworks {
+		if err := cli.NetworkConnect(ctx, networkName, created.ID, endpoint); err != nil {
+			return s.rollbackRecreate(ctx, resp, old.ID, name, created.ID, wasRunning, fmt.Errorf("failed to connect network %s: %w", networkName, err))
+		}
+	}
+
+	if wasRunning {
+		if err := cli.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+			return s.rollbackRecreate(ctx, resp, old.ID, name, created.ID, wasRunning, fmt.Errorf("failed to start container: %w", err))
+		}
+		if err := s.waitHealthy(ctx, created.ID, healthTimeout); err != nil {
+			return s.rollbackRecreate(ctx, resp, old.ID, name, created.ID, wasRunning, err)
+		}
+	}
+
+	resp.Success = true
+	resp.ContainerId = created.ID
+	resp.Message = "Container recreated successfully"
+
+	// Anonymous volumes of the old container are mounted by the new one and
+	// must be kept
+	if err := cli.ContainerRemove(ctx, old.ID, container.RemoveOptions{}); err != nil {
+		logrus.WithError(err).WithField("container", backupName).Warn("Failed to remove replaced container")
+		resp.Message = fmt.Sprintf("Container recreated, but the old container %s could not be removed: %v", backupName, err)
+	}
+	return resp, nil
+}
+
+// rollbackRecreate removes the new container and restores the old one under
+// its original name. It runs on a fresh context so that a health wait that
+// used up the deadline can still be rolled back.
+func (s *DockerService) rollbackRecreate(ctx context.Context, resp *pb.RecreateContainerResponse, oldID, name, newID string, wasRunning bool, cause error) (*pb.RecreateContainerResponse, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+	cli := s.dockerClient.Client()
+
+	logrus.WithError(cause).WithField("container", name).Warn("Recreated container failed, restoring the old container")
+
+	if newID != "" {
+		if err := cli.ContainerRemove(ctx, newID, container.RemoveOptions{Force: true}); err != nil {
+			return nil, fmt.Errorf("%v; failed to remove new container: %w", cause, err)
+		}
+	}
+	if err := cli.ContainerRename(ctx, oldID, name); err != nil {
+		return nil, fmt.Errorf("%v; failed to restore name of old container: %w", cause, err)
+	}
+	if wasRunning {
+		if err := cli.ContainerStart(ctx, oldID, container.StartOptions{}); err != nil {
+			return nil, fmt.Errorf("%v; failed to restart old container: %w", cause, err)
+		}
+	}
+
+	resp.RolledBack = true
+	resp.ContainerId = oldID
+	resp.Message = fmt.Sprintf("%v; restored the old container", cause)
+	return resp, nil
+}
+
+// waitHealthy polls the container until it is healthy, fails or the
+// timeout passes
+func (s *DockerService) waitHealthy(ctx context.Context, containerID string, timeout time.Duration) error {
+	startedAt := time.Now()
+	deadline := startedAt.Add(timeout)
+
+	for {
+		inspect, err := s.dockerClient.Client().ContainerInspect(ctx, containerID)
+		if err != nil {
+			return fmt.Errorf("failed to inspect new container: %w", err)
+		}
+		healthy, err := evaluateRecreateHealth(&inspect, startedAt, time.Now())
+		if err != nil || healthy {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("container did not become healthy within %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// evaluateRecreateHealth reports whether a recreated container is healthy,
+// or an error once it has failed. A container with a health check has to
+// report healthy; one without has to keep running for recreateStableWait.
+func evaluateRecreateHealth(inspect *types.ContainerJSON, startedAt, now time.Time) (bool, error) {
+	if inspect.ContainerJSONBase == nil || inspect.State == nil {
+		return false, nil
+	}
+	state := inspect.State
+	if !state.Running || state.Restarting || inspect.RestartCount > 0 {
+		msg := fmt.Sprintf("container exited with code %d", state.ExitCode)
+		if state.Error != "" {
+			msg += ": " + state.Error
+		}
+		return false, errors.New(msg)
+	}
+
+	if state.Health != nil {
+		switch state.Health.Status {
+		case types.Healthy:
+			return true, nil
+		case types.Unhealthy:
+			return false, fmt.Errorf("container is unhealthy")
+		}
+		return false, nil
+	}
+	return now.Sub(startedAt) >= recreateStableWait, nil
+}
+
+// buildRecreateSpec derives the settings of the new container from the
+// inspected old one. Values the old image provided are dropped so that the
+// new image supplies its own, and anonymous volumes are mounted again by
+// name so their data carries over. The primary network is set at create
+// time, the others are returned to be connected afterwards.
+func buildRecreateSpec(old *types.ContainerJSON, image string, oldImageConfig *container.Config, resources *pb.ContainerResources) (*container.Config, *container.HostConfig, *network.NetworkingConfig, map[string]*network.EndpointSettings) {
+	config := *old.Config
+	config.Image = image
+	if len(old.ID) >= 12 && config.Hostname == old.ID[:12] {
+		config.Hostname = "" // Generated from the container ID
+	}
+	if oldImageConfig != nil {
+		stripImageDefaults(&config, oldImageConfig)
+	}
+
+	hostConfig := *old.HostConfig
+	hostConfig.Mounts = append([]mount.Mount(nil), old.HostConfig.Mounts...)
+	for _, m := range old.Mounts {
+		if m.Type != mount.TypeVolume || m.Name == "" || hasMountTarget(&hostConfig, m.Destination) {
+			continue
+		}
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:     mount.TypeVolume,
+			Source:   m.Name,
+			Target:   m.Destination,
+			ReadOnly: !m.RW,
+		})
+	}
+	applyResources(&hostConfig, resources)
+
+	mode := hostConfig.NetworkMode
+	if mode.IsHost() || mode.IsNone() || mode.IsContainer() || old.NetworkSettings == nil {
+		return &config, &hostConfig, nil, nil
+	}
+
+	primary := mode.NetworkName()
+	if mode.IsDefault() {
+		primary = network.NetworkBridge
+	}
+	networking := &network.NetworkingConfig{EndpointsConfig: map[string]*network.EndpointSettings{}}
+	extra := map[string]*network.EndpointSettings{}
+	for name, endpoint := range old.NetworkSettings.Networks {
+		if endpoint == nil {
+			continue
+		}
+		settings := recreateEndpoint(endpoint, old.ID)
+		if name == primary {
+			networking.EndpointsConfig[name] = settings
+		} else {
+			extra[name] = settings
+		}
+	}
+	return &config, &hostConfig, networking, extra
+}
+
+// stripImageDefaults drops the container settings that equal the defaults
+// of the old image
+func stripImageDefaults(config, imageConfig *container.Config) {
+	var env []string
+	for _, e := range config.Env {
+		if !slices.Contains(imageConfig.Env, e) {
+			env = append(env, e)
+		}
+	}
+	config.Env = env
+
+	if len(config.Labels) > 0 {
+		labels := make(map[string]string, len(config.Labels))
+		for k, v := range config.Labels {
+			if iv, ok := imageConfig.Labels[k]; !ok || iv != v {
+				labels[k] = v
+			}
+		}
+		config.Labels = labels
+	}
+
+	if slices.Equal(config.Cmd, imageConfig.Cmd) {
+		config.Cmd = nil
+	}
+	if slices.Equal(config.Entrypoint, imageConfig.Entrypoint) {
+		config.Entrypoint = nil
+	}
+	if config.WorkingDir == imageConfig.WorkingDir {
+		config.WorkingDir = ""
+	}
+	if config.User == imageConfig.User {
+		config.User = ""
+	}
+	if config.StopSignal == imageConfig.StopSignal {
+		config.StopSignal = ""
+	}
+	if config.Healthcheck != nil && imageConfig.Healthcheck != nil && slices.Equal(config.Healthcheck.Test, imageConfig.Healthcheck.Test) {
+		config.Healthcheck = nil
+	}
+
+	if len(config.ExposedPorts) > 0 {
+		ports := make(nat.PortSet, len(config.ExposedPorts))
+		for port := range config.ExposedPorts {
+			if _, ok := imageConfig.ExposedPorts[port]; !ok {
+				ports[port] = struct{}{}
+			}
+		}
+		config.ExposedPorts = ports
+	}
+	if len(config.Volumes) > 0 {
+		volumes := make(map[string]struct{}, len(config.Volumes))
+		for v := range config.Volumes {
+			if _, ok := imageConfig.Volumes[v]; !ok {
+				volumes[v] = struct{}{}
+			}
+		}
+		config.Volumes = volumes
+	}
+}
+
+// hasMountTarget reports whether a bind or mount already covers a path
+func hasMountTarget(hostConfig *container.HostConfig, target string) bool {
+	for _, bind := range hostConfig.Binds {
+		parts := strings.Split(bind, ":")
+		if len(parts) >= 2 && parts[1] == target {
+			return true
+		}
+	}
+	for _, m := range hostConfig.Mounts {
+		if m.Target == target {
+			return true
+		}
+	}
+	return false
+}
+
+// applyResources applies the requested limits: 0 keeps the current value
+// and -1 removes the limit. A changed memory limit resets the swap limit
+// unless one is requested, as the old one may now be below the memory limit.
+func applyResources(hostConfig *container.HostConfig, resources *pb.ContainerResources) {
+	if resources == nil {
+		return
+	}
+
+	if resources.Memory != 0 {
+		hostConfig.Memory = max(resources.Memory, 0)
+		if resources.MemorySwap == 0 && hostConfig.MemorySwap > 0 {
+			hostConfig.MemorySwap = 0
+		}
+	}
+	if resources.MemorySwap != 0 {
+		hostConfig.MemorySwap = max(resources.MemorySwap, -1)
+	}
+	if resources.NanoCpus != 0 {
+		hostConfig.NanoCPUs = max(resources.NanoCpus, 0)
+		// NanoCPUs conflicts with a CPU period and quota
+		hostConfig.CPUPeriod = 0
+		hostConfig.CPUQuota = 0
+	}
+	if resources.CpuShares != 0 {
+		hostConfig.CPUShares = max(resources.CpuShares, 0)
+	}
+}
+
+// recreateEndpoint copies the user settings of a network endpoint, dropping
+// the runtime state and the alias Docker adds for the old container ID
+func recreateEndpoint(endpoint *network.EndpointSettings, oldID string) *network.EndpointSettings {
+	settings := &network.EndpointSettings{
+		Links:      endpoint.Links,
+		DriverOpts: endpoint.DriverOpts,
+	}
+	if endpoint.IPAMConfig != nil {
+		settings.IPAMConfig = endpoint.IPAMConfig.Copy()
+	}
+	for _, alias := range endpoint.Aliases {
+		if len(alias) >= 12 && strings.HasPrefix(oldID, alias) {
+			continue
+		}
+		settings.Aliases = append(settings.Aliases, alias)
+	}
+	return settings
+}
@@ -0,0 +1,158 @@
+package docker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+func newRecreateTestContainer() *types.ContainerJSON {
+	return &types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID:   "0123456789abcdef0123",
+			Name: "/web",
+			HostConfig: &container.HostConfig{
+				NetworkMode: "app",
+				Binds:       []string{"/srv/data:/data"},
+				Resources: container.Resources{
+					Memory:     256 << 20,
+					MemorySwap: 512 << 20,
+					CPUPeriod:  100000,
+					CPUQuota:   50000,
+				},
+			},
+		},
+		Config: &container.Config{
+			Hostname:     "0123456789ab",
+			Image:        "nginx:1.26",
+			Env:          []string{"PATH=/usr/bin", "NGINX_VERSION=1.26", "APP_ENV=prod"},
+			Cmd:          []string{"nginx", "-g", "daemon off;"},
+			Labels:       map[string]string{"maintainer": "nginx", "app": "web"},
+			ExposedPorts: nat.PortSet{"80/tcp": {}, "9000/tcp": {}},
+		},
+		Mounts: []types.MountPoint{
+			{Type: mount.TypeBind, Source: "/srv/data", Destination: "/data", RW: true},
+			{Type: mount.TypeVolume, Name: "3f9c0anonymous", Destination: "/var/cache/nginx", RW: true},
+		},
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"app": {
+					Aliases:    []string{"web", "0123456789ab"},
+					NetworkID:  "n1",
+					EndpointID: "e1",
+					IPAddress:  "172.18.0.2",
+				},
+				"monitoring": {NetworkID: "n2", IPAddress: "172.19.0.2"},
+			},
+		},
+	}
+}
+
+func TestBuildRecreateSpec(t *testing.T) {
+	old := newRecreateTestContainer()
+	imageConfig := &container.Config{
+		Env:          []string{"PATH=/usr/bin", "NGINX_VERSION=1.26"},
+		Cmd:          []string{"nginx", "-g", "daemon off;"},
+		Labels:       map[string]string{"maintainer": "nginx"},
+		ExposedPorts: nat.PortSet{"80/tcp": {}},
+	}
+
+	config, hostConfig, networking, extra := buildRecreateSpec(old, "nginx:1.27", imageConfig, &pb.ContainerResources{Memory: 1 << 30, NanoCpus: 2e9})
+
+	// Image defaults are left to the new image
+	assert.Equal(t, "nginx:1.27", config.Image)
+	assert.Empty(t, config.Hostname)
+	assert.Equal(t, []string{"APP_ENV=prod"}, config.Env)
+	assert.Nil(t, config.Cmd)
+	assert.Equal(t, map[string]string{"app": "web"}, config.Labels)
+	assert.Equal(t, nat.PortSet{"9000/tcp": {}}, config.ExposedPorts)
+	// The old container is not modified
+	assert.Equal(t, "nginx:1.26", old.Config.Image)
+	assert.Len(t, old.Config.Env, 3)
+
+	// Anonymous volumes are mounted again, binds are kept
+	assert.Equal(t, []string{"/srv/data:/data"}, hostConfig.Binds)
+	require.Len(t, hostConfig.Mounts, 1)
+	assert.Equal(t, mount.Mount{Type: mount.TypeVolume, Source: "3f9c0anonymous", Target: "/var/cache/nginx"}, hostConfig.Mounts[0])
+	assert.Empty(t, old.HostConfig.Mounts)
+
+	assert.Equal(t, int64(1<<30), hostConfig.Memory)
+	assert.Zero(t, hostConfig.MemorySwap)
+	assert.Equal(t, int64(2e9), hostConfig.NanoCPUs)
+	assert.Zero(t, hostConfig.CPUQuota)
+
+	// The primary network is set at create time without runtime state
+	require.NotNil(t, networking)
+	assert.Equal(t, &network.EndpointSettings{Aliases: []string{"web"}}, networking.EndpointsConfig["app"])
+	assert.Equal(t, map[string]*network.EndpointSettings{"monitoring": {}}, extra)
+}
+
+func TestBuildRecreateSpecHostNetwork(t *testing.T) {
+	old := newRecreateTestContainer()
+	old.HostConfig.NetworkMode = "host"
+
+	config, _, networking, extra := buildRecreateSpec(old, "nginx:1.26", nil, nil)
+	assert.Nil(t, networking)
+	assert.Nil(t, extra)
+	// Without the old image config nothing is stripped
+	assert.Len(t, config.Env, 3)
+}
+
+func TestApplyResources(t *testing.T) {
+	hostConfig := &container.HostConfig{Resources: container.Resources{Memory: 100, MemorySwap: -1, NanoCPUs: 1e9, CPUShares: 512}}
+
+	applyResources(hostConfig, &pb.ContainerResources{Memory: 200})
+	assert.Equal(t, int64(200), hostConfig.Memory)
+	assert.Equal(t, int64(-1), hostConfig.MemorySwap) // Unlimited swap is kept
+	assert.Equal(t, int64(1e9), hostConfig.NanoCPUs)
+
+	applyResources(hostConfig, &pb.ContainerResources{Memory: -1, MemorySwap: 400, NanoCpus: -1, CpuShares: -1})
+	assert.Zero(t, hostConfig.Memory)
+	assert.Equal(t, int64(400), hostConfig.MemorySwap)
+	assert.Zero(t, hostConfig.NanoCPUs)
+	assert.Zero(t, hostConfig.CPUShares)
+}
+
+func TestEvaluateRecreateHealth(t *testing.T) {
+	startedAt := time.Now()
+	inspect := func(state *types.ContainerState, restarts int) *types.ContainerJSON {
+		return &types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{State: state, RestartCount: restarts}}
+	}
+
+	healthy, err := evaluateRecreateHealth(inspect(&types.ContainerState{Running: true}, 0), startedAt, startedAt.Add(time.Second))
+	assert.NoError(t, err)
+	assert.False(t, healthy)
+
+	healthy, err = evaluateRecreateHealth(inspect(&types.ContainerState{Running: true}, 0), startedAt, startedAt.Add(recreateStableWait))
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+
+	_, err = evaluateRecreateHealth(inspect(&types.ContainerState{ExitCode: 1}, 0), startedAt, startedAt)
+	assert.EqualError(t, err, "container exited with code 1")
+
+	_, err = evaluateRecreateHealth(inspect(&types.ContainerState{Running: true}, 1), startedAt, startedAt)
+	assert.Error(t, err)
+
+	withHealth := func(status string) *types.ContainerJSON {
+		return inspect(&types.ContainerState{Running: true, Health: &types.Health{Status: status}}, 0)
+	}
+	healthy, err = evaluateRecreateHealth(withHealth(types.Starting), startedAt, startedAt.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.False(t, healthy)
+
+	healthy, err = evaluateRecreateHealth(withHealth(types.Healthy), startedAt, startedAt)
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+
+	_, err = evaluateRecreateHealth(withHealth(types.Unhealthy), startedAt, startedAt)
+	assert.EqualError(t, err, "container is unhealthy")
+}
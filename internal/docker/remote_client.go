@@ -0,0 +1,291 @@
+package docker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/client"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	defaultSSHPort      = "22"
+	defaultSSHUser      = "root"
+	defaultDockerSocket = "/var/run/docker.sock"
+	sshDialTimeout      = 10 * time.Second
+)
+
+// RemoteEndpoint describes a Docker engine reached directly instead of
+// through an agent
+type RemoteEndpoint struct {
+	// Host is tcp://host:port for TLS or ssh://user@host[:port][/socket]
+	Host string
+
+	// PEM encoded TLS material for tcp endpoints. The CA is optional and
+	// defaults to the system roots.
+	TLSCACert string
+	TLSCert   string
+	TLSKey    string
+
+	// PEM encoded private key for ssh endpoints
+	SSHKey string
+	// SSHHostKey is the expected host key in authorized_keys format. When
+	// empty the first key presented is trusted and reported by HostKey.
+	SSHHostKey string
+}
+
+// NewRemoteDockerClient creates a Docker client for a remote engine
+func NewRemoteDockerClient(endpoint RemoteEndpoint) (*DockerClient, error) {
+	switch {
+	case strings.HasPrefix(endpoint.Host, "tcp://"):
+		tlsConfig, err := endpoint.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		return newDockerClient(
+			client.WithHTTPClient(&http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}),
+			client.WithHost(endpoint.Host),
+			client.WithAPIVersionNegotiation(),
+		)
+	case strings.HasPrefix(endpoint.Host, "ssh://"):
+		dialer, err := newSSHDialer(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		// The host only sets the request URL; every connection is a stream
+		// to the engine socket over SSH. The HTTP client is set after the
+		// host so that no proxy settings are applied to it.
+		dc, err := newDockerClient(
+			client.WithHost("http://docker.example.com"),
+			client.WithHTTPClient(&http.Client{Transport: &http.Transport{DialContext: dialer.DialContext}}),
+			client.WithAPIVersionNegotiation(),
+		)
+		if err != nil {
+			dialer.Close()
+			return nil, err
+		}
+		dc.ssh = dialer
+		return dc, nil
+	default:
+		return nil, fmt.Errorf("unsupported Docker endpoint %q, expected tcp:// or ssh://", endpoint.Host)
+	}
+}
+
+// Validate checks the endpoint settings without connecting
+func (e RemoteEndpoint) Validate() error {
+	switch {
+	case strings.HasPrefix(e.Host, "tcp://"):
+		_, err := e.tlsConfig()
+		return err
+	case strings.HasPrefix(e.Host, "ssh://"):
+		_, err := newSSHDialer(e)
+		return err
+	default:
+		return fmt.Errorf("unsupported Docker endpoint %q, expected tcp:// or ssh://", e.Host)
+	}
+}
+
+// HostKey returns the SSH host key of the engine in authorized_keys format,
+// or an empty string when the engine is not reached over SSH
+func (dc *DockerClient) HostKey() string {
+	if dc.ssh == nil {
+		return ""
+	}
+	return dc.ssh.HostKey()
+}
+
+// tlsConfig builds the mutual TLS configuration of a tcp endpoint
+func (e RemoteEndpoint) tlsConfig() (*tls.Config, error) {
+	u, err := url.Parse(e.Host)
+	if err != nil || u.Hostname() == "" || u.Port() == "" {
+		return nil, fmt.Errorf("invalid Docker endpoint %q, expected tcp://host:port", e.Host)
+	}
+	if e.TLSCert == "" || e.TLSKey == "" {
+		return nil, errors.New("a TLS client certificate and key are required for tcp endpoints")
+	}
+
+	cert, err := tls.X509KeyPair([]byte(e.TLSCert), []byte(e.TLSKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS client certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if e.TLSCACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(e.TLSCACert)) {
+			return nil, errors.New("invalid TLS CA certificate")
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+// sshDialer opens streams to the engine socket through a shared SSH
+// connection, reconnecting when it has been closed
+type sshDialer struct {
+	addr   string
+	socket string
+	config *ssh.ClientConfig
+
+	mu      sync.Mutex
+	client  *ssh.Client
+	hostKey string
+}
+
+func newSSHDialer(e RemoteEndpoint) (*sshDialer, error) {
+	u, err := url.Parse(e.Host)
+	if err != nil || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid Docker endpoint %q, expected ssh://user@host[:port][/socket]", e.Host)
+	}
+	if _, ok := u.User.Password(); ok {
+		return nil, errors.New("passwords are not supported in ssh endpoints, use a private key")
+	}
+	if e.SSHKey == "" {
+		return nil, errors.New("an SSH private key is required for ssh endpoints")
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(e.SSHKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH private key: %w", err)
+	}
+
+	d := &sshDialer{
+		addr:   net.JoinHostPort(u.Hostname(), defaultSSHPort),
+		socket: defaultDockerSocket,
+	}
+	if port := u.Port(); port != "" {
+		d.addr = net.JoinHostPort(u.Hostname(), port)
+	}
+	if u.Path != "" && u.Path != "/" {
+		d.socket = u.Path
+	}
+	user := defaultSSHUser
+	if u.User != nil && u.User.Username() != "" {
+		user = u.User.Username()
+	}
+
+	hostKeyCallback := d.trustFirstHostKey
+	if e.SSHHostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(e.SSHHostKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SSH host key: %w", err)
+		}
+		d.hostKey = marshalHostKey(key)
+		hostKeyCallback = ssh.FixedHostKey(key)
+	}
+
+	d.config = &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshDialTimeout,
+	}
+	return d, nil
+}
+
+// trustFirstHostKey accepts the first host key presented and requires the
+// same key on every later connection
+func (d *sshDialer) trustFirstHostKey(_ string, _ net.Addr, key ssh.PublicKey) error {
+	presented := marshalHostKey(key)
+	if d.hostKey == "" {
+		d.hostKey = presented
+		return nil
+	}
+	if d.hostKey != presented {
+		return errors.New("SSH host key mismatch")
+	}
+	return nil
+}
+
+// HostKey returns the trusted host key in authorized_keys format
+func (d *sshDialer) HostKey() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.hostKey
+}
+
+// DialContext opens a stream to the engine socket. The network and address
+// requested by the HTTP transport are ignored.
+func (d *sshDialer) DialContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	cli, err := d.sshClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := cli.Dial("unix", d.socket)
+	if err == nil {
+		return conn, nil
+	}
+
+	// The SSH connection may have been dropped, retry once on a new one
+	d.reset(cli)
+	if cli, err = d.sshClient(ctx); err != nil {
+		return nil, err
+	}
+	conn, err = cli.Dial("unix", d.socket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s over SSH: %w", d.socket, err)
+	}
+	return conn, nil
+}
+
+// sshClient returns the shared SSH connection, dialing it when needed
+func (d *sshDialer) sshClient(ctx context.Context) (*ssh.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.client != nil {
+		return d.client, nil
+	}
+
+	conn, err := (&net.Dialer{Timeout: sshDialTimeout}).DialContext(ctx, "tcp", d.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", d.addr, err)
+	}
+	deadline := time.Now().Add(sshDialTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, d.addr, d.config)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("SSH handshake with %s failed: %w", d.addr, err)
+	}
+	conn.SetDeadline(time.Time{})
+
+	d.client = ssh.NewClient(sshConn, chans, reqs)
+	return d.client, nil
+}
+
+// reset drops the shared SSH connection if it is still the given one
+func (d *sshDialer) reset(cli *ssh.Client) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.client == cli {
+		d.client.Close()
+		d.client = nil
+	}
+}
+
+// Close closes the shared SSH connection
+func (d *sshDialer) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.client != nil {
+		d.client.Close()
+		d.client = nil
+	}
+}
+
+func marshalHostKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
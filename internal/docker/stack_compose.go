@@ -0,0 +1,948 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/go-units"
+	"gopkg.in/yaml.v3"
+)
+
+// StackImageLabel records the image of a stack service as written in the
+// compose file, like the docker CLI does
+const StackImageLabel = "com.docker.stack.image"
+
+// stackNamePattern matches the names the docker CLI accepts for stacks
+var stackNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Compose keys that are translated to swarm specs; other keys are ignored
+// with a warning
+var (
+	supportedServiceKeys = map[string]bool{
+		"image": true, "command": true, "entrypoint": true, "environment": true,
+		"labels": true, "ports": true, "networks": true, "secrets": true,
+		"configs": true, "volumes": true, "deploy": true, "healthcheck": true,
+		"working_dir": true, "user": true, "hostname": true, "tty": true,
+		"stop_grace_period": true,
+	}
+	supportedDeployKeys = map[string]bool{
+		"mode": true, "replicas": true, "labels": true, "resources": true,
+		"restart_policy": true, "placement": true, "update_config": true,
+		"rollback_config": true,
+	}
+)
+
+// composeFile is the subset of a version 3 compose file that can be
+// deployed as a stack
+type composeFile struct {
+	Services map[string]yaml.Node         `yaml:"services"`
+	Networks map[string]*composeNetwork   `yaml:"networks"`
+	Volumes  map[string]*composeVolume    `yaml:"volumes"`
+	Secrets  map[string]*composeSwarmFile `yaml:"secrets"`
+	Configs  map[string]*composeSwarmFile `yaml:"configs"`
+	Extra    map[string]interface{}       `yaml:",inline"`
+}
+
+type composeService struct {
+	Image           string           `yaml:"image"`
+	Command         shellCommand     `yaml:"command"`
+	Entrypoint      shellCommand     `yaml:"entrypoint"`
+	Environment     mappingOrList    `yaml:"environment"`
+	Labels          mappingOrList    `yaml:"labels"`
+	Ports           []composePort    `yaml:"ports"`
+	Networks        serviceNetworks  `yaml:"networks"`
+	Secrets         []composeFileRef `yaml:"secrets"`
+	Configs         []composeFileRef `yaml:"configs"`
+	Volumes         []string         `yaml:"volumes"`
+	Deploy          composeDeploy    `yaml:"deploy"`
+	Healthcheck     *composeHealth   `yaml:"healthcheck"`
+	WorkingDir      string           `yaml:"working_dir"`
+	User            string           `yaml:"user"`
+	Hostname        string           `yaml:"hostname"`
+	TTY             bool             `yaml:"tty"`
+	StopGracePeriod string           `yaml:"stop_grace_period"`
+}
+
+type composeDeploy struct {
+	Mode      string        `yaml:"mode"`
+	Replicas  *uint64       `yaml:"replicas"`
+	Labels    mappingOrList `yaml:"labels"`
+	Resources struct {
+		Limits       composeResources `yaml:"limits"`
+		Reservations composeResources `yaml:"reservations"`
+	} `yaml:"resources"`
+	RestartPolicy *struct {
+		Condition   string  `yaml:"condition"`
+		Delay       string  `yaml:"delay"`
+		MaxAttempts *uint64 `yaml:"max_attempts"`
+		Window      string  `yaml:"window"`
+	} `yaml:"restart_policy"`
+	Placement struct {
+		Constraints []string `yaml:"constraints"`
+	} `yaml:"placement"`
+	UpdateConfig   *composeUpdateConfig   `yaml:"update_config"`
+	RollbackConfig *composeUpdateConfig   `yaml:"rollback_config"`
+	Extra          map[string]interface{} `yaml:",inline"`
+}
+
+type composeResources struct {
+	CPUs   string `yaml:"cpus"`
+	Memory string `yaml:"memory"`
+}
+
+type composeUpdateConfig struct {
+	Parallelism     *uint64 `yaml:"parallelism"`
+	Delay           string  `yaml:"delay"`
+	FailureAction   string  `yaml:"failure_action"`
+	Monitor         string  `yaml:"monitor"`
+	MaxFailureRatio float32 `yaml:"max_failure_ratio"`
+	Order           string  `yaml:"order"`
+}
+
+type composeHealth struct {
+	Test        shellCommand `yaml:"test"`
+	Interval    string       `yaml:"interval"`
+	Timeout     string       `yaml:"timeout"`
+	Retries     int          `yaml:"retries"`
+	StartPeriod string       `yaml:"start_period"`
+	Disable     bool         `yaml:"disable"`
+}
+
+type composeNetwork struct {
+	Name       string            `yaml:"name"`
+	External   bool              `yaml:"external"`
+	Driver     string            `yaml:"driver"`
+	DriverOpts map[string]string `yaml:"driver_opts"`
+	Attachable bool              `yaml:"attachable"`
+	Internal   bool              `yaml:"internal"`
+	Labels     mappingOrList     `yaml:"labels"`
+}
+
+type composeVolume struct {
+	Name       string            `yaml:"name"`
+	External   bool              `yaml:"external"`
+	Driver     string            `yaml:"driver"`
+	DriverOpts map[string]string `yaml:"driver_opts"`
+	Labels     mappingOrList     `yaml:"labels"`
+}
+
+// composeSwarmFile is a top-level secret or config. Files cannot be read on
+// the agent, so the data has to be inline or the object has to exist.
+type composeSwarmFile struct {
+	Name     string        `yaml:"name"`
+	External bool          `yaml:"external"`
+	Content  string        `yaml:"content"`
+	File     string        `yaml:"file"`
+	Labels   mappingOrList `yaml:"labels"`
+}
+
+// composeFileRef is a secret or config reference of a service, either a
+// name or the long syntax
+type composeFileRef struct {
+	Source string  `yaml:"source"`
+	Target string  `yaml:"target"`
+	UID    string  `yaml:"uid"`
+	GID    string  `yaml:"gid"`
+	Mode   *uint32 `yaml:"mode"`
+}
+
+// UnmarshalYAML accepts the short and the long syntax
+func (r *composeFileRef) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		r.Source = node.Value
+		return nil
+	}
+	type plain composeFileRef
+	return node.Decode((*plain)(r))
+}
+
+// composePort is a published port, either "[published:]target[/protocol]"
+// or the long syntax
+type composePort struct {
+	Target    uint32 `yaml:"target"`
+	Published uint32 `yaml:"published"`
+	Protocol  string `yaml:"protocol"`
+	Mode      string `yaml:"mode"`
+}
+
+// UnmarshalYAML accepts the short and the long syntax
+func (p *composePort) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		type plain composePort
+		return node.Decode((*plain)(p))
+	}
+
+	spec, protocol, _ := strings.Cut(node.Value, "/")
+	p.Protocol = protocol
+	parts := strings.Split(spec, ":")
+	if len(parts) > 2 {
+		return fmt.Errorf("port %q: host IPs are not supported in swarm mode", node.Value)
+	}
+	target, err := strconv.ParseUint(parts[len(parts)-1], 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port %q", node.Value)
+	}
+	p.Target = uint32(target)
+	if len(parts) == 2 {
+		published, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port %q", node.Value)
+		}
+		p.Published = uint32(published)
+	}
+	return nil
+}
+
+// serviceNetworks is the networks of a service with their aliases, from a
+// list of names or a map of names to options
+type serviceNetworks map[string][]string
+
+// UnmarshalYAML accepts a list or a map
+func (n *serviceNetworks) UnmarshalYAML(node *yaml.Node) error {
+	*n = serviceNetworks{}
+	if node.Kind == yaml.SequenceNode {
+		var names []string
+		if err := node.Decode(&names); err != nil {
+			return err
+		}
+		for _, name := range names {
+			(*n)[name] = nil
+		}
+		return nil
+	}
+
+	var networks map[string]*struct {
+		Aliases []string `yaml:"aliases"`
+	}
+	if err := node.Decode(&networks); err != nil {
+		return err
+	}
+	for name, options := range networks {
+		if options != nil {
+			(*n)[name] = options.Aliases
+		} else {
+			(*n)[name] = nil
+		}
+	}
+	return nil
+}
+
+// mappingOrList is a string map written as a map or as a list of KEY=VALUE.
+// A key without a value maps to nil.
+type mappingOrList map[string]*string
+
+// UnmarshalYAML accepts a list or a map
+func (m *mappingOrList) UnmarshalYAML(node *yaml.Node) error {
+	*m = mappingOrList{}
+	if node.Kind == yaml.SequenceNode {
+		var items []string
+		if err := node.Decode(&items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			key, value, ok := strings.Cut(item, "=")
+			if ok {
+				(*m)[key] = &value
+			} else {
+				(*m)[key] = nil
+			}
+		}
+		return nil
+	}
+
+	var values map[string]yaml.Node
+	if err := node.Decode(&values); err != nil {
+		return err
+	}
+	for key, value := range values {
+		if value.Tag == "!!null" {
+			(*m)[key] = nil
+			continue
+		}
+		v := value.Value
+		(*m)[key] = &v
+	}
+	return nil
+}
+
+// strings returns the map without nil values
+func (m mappingOrList) strings() map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(m))
+	for key, value := range m {
+		if value != nil {
+			values[key] = *value
+		} else {
+			values[key] = ""
+		}
+	}
+	return values
+}
+
+// env returns the map as sorted KEY=VALUE pairs
+func (m mappingOrList) env() []string {
+	env := make([]string, 0, len(m))
+	for key, value := range m {
+		if value != nil {
+			env = append(env, key+"="+*value)
+		} else {
+			env = append(env, key)
+		}
+	}
+	sort.Strings(env)
+	return env
+}
+
+// shellCommand is a command written as a list or as a string that is split
+// like a shell would
+type shellCommand []string
+
+// UnmarshalYAML accepts a list or a string
+func (c *shellCommand) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		var args []string
+		if err := node.Decode(&args); err != nil {
+			return err
+		}
+		*c = args
+		return nil
+	}
+	args, err := splitShellWords(node.Value)
+	if err != nil {
+		return err
+	}
+	*c = args
+	return nil
+}
+
+// splitShellWords splits a command line into words, honoring quotes and
+// backslash escapes
+func splitShellWords(line string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", line)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// stackSpec is a compose file translated to the swarm objects of a stack.
+// Secret and config references carry names only; their IDs are resolved
+// when the stack is deployed.
+type stackSpec struct {
+	Name     string
+	Services []swarm.ServiceSpec
+	Networks []stackNetwork
+	Secrets  []stackSwarmFile
+	Configs  []stackSwarmFile
+	Warnings []string
+}
+
+type stackNetwork struct {
+	Name       string
+	External   bool
+	Driver     string
+	DriverOpts map[string]string
+	Attachable bool
+	Internal   bool
+	Labels     map[string]string
+}
+
+type stackSwarmFile struct {
+	Name     string
+	External bool
+	Data     []byte
+	Labels   map[string]string
+}
+
+// parseStack translates a compose file into the swarm objects of a stack.
+// Objects that are not external are prefixed with the stack name and
+// labelled with it, like `docker stack deploy` does.
+func parseStack(name, compose string) (*stackSpec, error) {
+	if !stackNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid stack name %q", name)
+	}
+
+	var file composeFile
+	if err := yaml.Unmarshal([]byte(compose), &file); err != nil {
+		return nil, fmt.Errorf("invalid compose file: %w", err)
+	}
+	if len(file.Services) == 0 {
+		return nil, fmt.Errorf("compose file defines no services")
+	}
+
+	stack := &stackSpec{Name: name}
+	for key := range file.Extra {
+		if key != "version" && !strings.HasPrefix(key, "x-") {
+			stack.warnf("top-level key %q is not supported and was ignored", key)
+		}
+	}
+
+	networkNames, err := stack.addNetworks(file.Networks)
+	if err != nil {
+		return nil, err
+	}
+	secretNames, err := stack.addSwarmFiles(file.Secrets, &stack.Secrets, "secret")
+	if err != nil {
+		return nil, err
+	}
+	configNames, err := stack.addSwarmFiles(file.Configs, &stack.Configs, "config")
+	if err != nil {
+		return nil, err
+	}
+	volumes := file.Volumes
+
+	serviceKeys := make([]string, 0, len(file.Services))
+	for key := range file.Services {
+		serviceKeys = append(serviceKeys, key)
+	}
+	sort.Strings(serviceKeys)
+
+	usesDefaultNetwork := false
+	for _, key := range serviceKeys {
+		node := file.Services[key]
+		spec, usesDefault, err := stack.convertService(key, &node, networkNames, secretNames, configNames, volumes)
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", key, err)
+		}
+		usesDefaultNetwork = usesDefaultNetwork || usesDefault
+		stack.Services = append(stack.Services, *spec)
+	}
+
+	if usesDefaultNetwork {
+		if _, defined := file.Networks["default"]; !defined {
+			stack.Networks = append(stack.Networks, stackNetwork{
+				Name:   stack.scoped("default"),
+				Driver: "overlay",
+				Labels: stack.labels(nil),
+			})
+		}
+	}
+
+	return stack, nil
+}
+
+// scoped returns the name of a stack object
+func (s *stackSpec) scoped(name string) string {
+	return s.Name + "_" + name
+}
+
+// labels returns the labels of a stack object
+func (s *stackSpec) labels(labels map[string]string) map[string]string {
+	merged := make(map[string]string, len(labels)+1)
+	for key, value := range labels {
+		merged[key] = value
+	}
+	merged[StackNamespaceLabel] = s.Name
+	return merged
+}
+
+func (s *stackSpec) warnf(format string, args ...interface{}) {
+	s.Warnings = append(s.Warnings, fmt.Sprintf(format, args...))
+}
+
+// addNetworks adds the top-level networks and returns their names by key
+func (s *stackSpec) addNetworks(networks map[string]*composeNetwork) (map[string]string, error) {
+	names := make(map[string]string, len(networks)+1)
+	names["default"] = s.scoped("default")
+
+	for _, key := range sortedKeys(networks) {
+		network := networks[key]
+		if network == nil {
+			network = &composeNetwork{}
+		}
+
+		name := s.scoped(key)
+		if network.Name != "" {
+			name = network.Name
+		}
+		if network.External {
+			if network.Name == "" {
+				name = key
+			}
+			names[key] = name
+			s.Networks = append(s.Networks, stackNetwork{Name: name, External: true})
+			continue
+		}
+
+		driver := network.Driver
+		if driver == "" {
+			driver = "overlay"
+		}
+		names[key] = name
+		s.Networks = append(s.Networks, stackNetwork{
+			Name:       name,
+			Driver:     driver,
+			DriverOpts: network.DriverOpts,
+			Attachable: network.Attachable,
+			Internal:   network.Internal,
+			Labels:     s.labels(network.Labels.strings()),
+		})
+	}
+	return names, nil
+}
+
+// addSwarmFiles adds the top-level secrets or configs and returns their
+// names by key
+func (s *stackSpec) addSwarmFiles(files map[string]*composeSwarmFile, out *[]stackSwarmFile, kind string) (map[string]string, error) {
+	names := make(map[string]string, len(files))
+	for _, key := range sortedKeys(files) {
+		file := files[key]
+		if file == nil {
+			return nil, fmt.Errorf("%s %s: external or content is required", kind, key)
+		}
+
+		if file.External {
+			name := key
+			if file.Name != "" {
+				name = file.Name
+			}
+			names[key] = name
+			*out = append(*out, stackSwarmFile{Name: name, External: true})
+			continue
+		}
+		if file.File != "" {
+			return nil, fmt.Errorf("%s %s: files cannot be read by the agent, create the %s first and mark it external or use content", kind, key, kind)
+		}
+
+		name := s.scoped(key)
+		if file.Name != "" {
+			name = file.Name
+		}
+		names[key] = name
+		*out = append(*out, stackSwarmFile{
+			Name:   name,
+			Data:   []byte(file.Content),
+			Labels: s.labels(file.Labels.strings()),
+		})
+	}
+	return names, nil
+}
+
+// convertService translates a compose service into a service spec. It
+// reports whether the service is attached to the default network.
+func (s *stackSpec) convertService(key string, node *yaml.Node, networks, secrets, configs map[string]string, volumes map[string]*composeVolume) (*swarm.ServiceSpec, bool, error) {
+	var keys map[string]interface{}
+	if err := node.Decode(&keys); err != nil {
+		return nil, false, err
+	}
+	for _, k := range sortedKeys(keys) {
+		if !supportedServiceKeys[k] {
+			s.warnf("service %s: key %q is not supported and was ignored", key, k)
+		}
+	}
+
+	var service composeService
+	if err := node.Decode(&service); err != nil {
+		return nil, false, err
+	}
+	if service.Image == "" {
+		return nil, false, fmt.Errorf("image is required")
+	}
+	for _, k := range sortedKeys(service.Deploy.Extra) {
+		if !supportedDeployKeys[k] {
+			s.warnf("service %s: deploy key %q is not supported and was ignored", key, k)
+		}
+	}
+
+	containerSpec := &swarm.ContainerSpec{
+		Image:    service.Image,
+		Labels:   s.labels(service.Labels.strings()),
+		Command:  service.Entrypoint,
+		Args:     service.Command,
+		Env:      service.Environment.env(),
+		Dir:      service.WorkingDir,
+		User:     service.User,
+		Hostname: service.Hostname,
+		TTY:      service.TTY,
+	}
+	if len(containerSpec.Env) == 0 {
+		containerSpec.Env = nil
+	}
+	if service.StopGracePeriod != "" {
+		d, err := time.ParseDuration(service.StopGracePeriod)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid stop_grace_period: %w", err)
+		}
+		containerSpec.StopGracePeriod = &d
+	}
+	if service.Healthcheck != nil {
+		health, err := convertHealthcheck(service.Healthcheck)
+		if err != nil {
+			return nil, false, err
+		}
+		containerSpec.Healthcheck = health
+	}
+
+	for _, ref := range service.Secrets {
+		name, ok := secrets[ref.Source]
+		if !ok {
+			return nil, false, fmt.Errorf("undefined secret %q", ref.Source)
+		}
+		containerSpec.Secrets = append(containerSpec.Secrets, &swarm.SecretReference{
+			SecretName: name,
+			File:       fileTarget(ref, ref.Source),
+		})
+	}
+	for _, ref := range service.Configs {
+		name, ok := configs[ref.Source]
+		if !ok {
+			return nil, false, fmt.Errorf("undefined config %q", ref.Source)
+		}
+		target := ref
+		if target.Target == "" {
+			target.Target = "/" + ref.Source
+		}
+		containerSpec.Configs = append(containerSpec.Configs, &swarm.ConfigReference{
+			ConfigName: name,
+			File:       (*swarm.ConfigReferenceFileTarget)(fileTarget(target, ref.Source)),
+		})
+	}
+
+	for _, volume := range service.Volumes {
+		m, err := s.convertVolume(volume, volumes)
+		if err != nil {
+			return nil, false, err
+		}
+		containerSpec.Mounts = append(containerSpec.Mounts, m)
+	}
+
+	spec := &swarm.ServiceSpec{
+		Annotations: swarm.Annotations{
+			Name:   s.scoped(key),
+			Labels: s.labels(service.Deploy.Labels.strings()),
+		},
+		TaskTemplate: swarm.TaskSpec{
+			ContainerSpec: containerSpec,
+			Placement:     &swarm.Placement{Constraints: service.Deploy.Placement.Constraints},
+		},
+	}
+	spec.Labels[StackImageLabel] = service.Image
+
+	switch service.Deploy.Mode {
+	case "", "replicated":
+		replicas := uint64(1)
+		if service.Deploy.Replicas != nil {
+			replicas = *service.Deploy.Replicas
+		}
+		spec.Mode.Replicated = &swarm.ReplicatedService{Replicas: &replicas}
+	case "global":
+		spec.Mode.Global = &swarm.GlobalService{}
+	default:
+		return nil, false, fmt.Errorf("invalid deploy mode %q", service.Deploy.Mode)
+	}
+
+	resources, err := convertResources(&service.Deploy)
+	if err != nil {
+		return nil, false, err
+	}
+	spec.TaskTemplate.Resources = resources
+
+	if rp := service.Deploy.RestartPolicy; rp != nil {
+		policy := &swarm.RestartPolicy{
+			Condition:   swarm.RestartPolicyCondition(rp.Condition),
+			MaxAttempts: rp.MaxAttempts,
+		}
+		if policy.Delay, err = parseOptionalDuration(rp.Delay); err != nil {
+			return nil, false, fmt.Errorf("invalid restart_policy delay: %w", err)
+		}
+		if policy.Window, err = parseOptionalDuration(rp.Window); err != nil {
+			return nil, false, fmt.Errorf("invalid restart_policy window: %w", err)
+		}
+		spec.TaskTemplate.RestartPolicy = policy
+	}
+	if spec.UpdateConfig, err = convertUpdateConfig(service.Deploy.UpdateConfig); err != nil {
+		return nil, false, fmt.Errorf("invalid update_config: %w", err)
+	}
+	if spec.RollbackConfig, err = convertUpdateConfig(service.Deploy.RollbackConfig); err != nil {
+		return nil, false, fmt.Errorf("invalid rollback_config: %w", err)
+	}
+
+	// Services without networks join the default network of the stack
+	serviceNetworks := service.Networks
+	usesDefault := len(serviceNetworks) == 0
+	if usesDefault {
+		serviceNetworks = map[string][]string{"default": nil}
+	}
+	for _, networkKey := range sortedKeys(serviceNetworks) {
+		name, ok := networks[networkKey]
+		if !ok {
+			return nil, false, fmt.Errorf("undefined network %q", networkKey)
+		}
+		if networkKey == "default" {
+			usesDefault = true
+		}
+		spec.TaskTemplate.Networks = append(spec.TaskTemplate.Networks, swarm.NetworkAttachmentConfig{
+			Target:  name,
+			Aliases: append([]string{key}, serviceNetworks[networkKey]...),
+		})
+	}
+
+	if len(service.Ports) > 0 {
+		endpoint := &swarm.EndpointSpec{}
+		for _, port := range service.Ports {
+			protocol := swarm.PortConfigProtocol(port.Protocol)
+			if protocol == "" {
+				protocol = swarm.PortConfigProtocolTCP
+			}
+			mode := swarm.PortConfigPublishMode(port.Mode)
+			if mode == "" {
+				mode = swarm.PortConfigPublishModeIngress
+			}
+			endpoint.Ports = append(endpoint.Ports, swarm.PortConfig{
+				Protocol:      protocol,
+				TargetPort:    port.Target,
+				PublishedPort: port.Published,
+				PublishMode:   mode,
+			})
+		}
+		spec.EndpointSpec = endpoint
+	}
+
+	return spec, usesDefault, nil
+}
+
+// convertVolume translates a "source:target[:ro]" volume into a mount.
+// Absolute sources are bind mounts, other sources are named volumes.
+func (s *stackSpec) convertVolume(volume string, volumes map[string]*composeVolume) (mount.Mount, error) {
+	parts := strings.Split(volume, ":")
+	m := mount.Mount{Type: mount.TypeVolume}
+	switch len(parts) {
+	case 1:
+		m.Target = parts[0]
+	case 2, 3:
+		m.Source, m.Target = parts[0], parts[1]
+		if len(parts) == 3 {
+			if parts[2] != "ro" && parts[2] != "rw" {
+				return m, fmt.Errorf("invalid volume mode in %q", volume)
+			}
+			m.ReadOnly = parts[2] == "ro"
+		}
+	default:
+		return m, fmt.Errorf("invalid volume %q", volume)
+	}
+
+	switch {
+	case strings.HasPrefix(m.Source, "/"):
+		m.Type = mount.TypeBind
+	case strings.HasPrefix(m.Source, "."):
+		return m, fmt.Errorf("relative bind mount %q cannot be resolved in swarm mode", volume)
+	case m.Source != "":
+		definition, ok := volumes[m.Source]
+		if !ok {
+			return m, fmt.Errorf("undefined volume %q", m.Source)
+		}
+		if definition == nil {
+			definition = &composeVolume{}
+		}
+		switch {
+		case definition.External && definition.Name != "":
+			m.Source = definition.Name
+		case definition.External:
+		case definition.Name != "":
+			m.Source = definition.Name
+		default:
+			m.Source = s.scoped(m.Source)
+		}
+		if !definition.External {
+			m.VolumeOptions = &mount.VolumeOptions{Labels: s.labels(definition.Labels.strings())}
+			if definition.Driver != "" {
+				m.VolumeOptions.DriverConfig = &mount.Driver{Name: definition.Driver, Options: definition.DriverOpts}
+			}
+		}
+	}
+	return m, nil
+}
+
+// fileTarget returns the file a secret or config is mounted as
+func fileTarget(ref composeFileRef, source string) *swarm.SecretReferenceFileTarget {
+	target := &swarm.SecretReferenceFileTarget{
+		Name: ref.Target,
+		UID:  ref.UID,
+		GID:  ref.GID,
+		Mode: 0o444,
+	}
+	if target.Name == "" {
+		target.Name = source
+	}
+	if target.UID == "" {
+		target.UID = "0"
+	}
+	if target.GID == "" {
+		target.GID = "0"
+	}
+	if ref.Mode != nil {
+		target.Mode = os.FileMode(*ref.Mode)
+	}
+	return target
+}
+
+// convertHealthcheck translates a compose healthcheck
+func convertHealthcheck(h *composeHealth) (*container.HealthConfig, error) {
+	if h.Disable {
+		return &container.HealthConfig{Test: []string{"NONE"}}, nil
+	}
+	health := &container.HealthConfig{Test: h.Test, Retries: h.Retries}
+	// A string test runs through the shell
+	if len(h.Test) > 0 && h.Test[0] != "CMD" && h.Test[0] != "CMD-SHELL" && h.Test[0] != "NONE" {
+		health.Test = []string{"CMD-SHELL", strings.Join(h.Test, " ")}
+	}
+	for _, d := range []struct {
+		value  string
+		target *time.Duration
+		name   string
+	}{
+		{h.Interval, &health.Interval, "interval"},
+		{h.Timeout, &health.Timeout, "timeout"},
+		{h.StartPeriod, &health.StartPeriod, "start_period"},
+	} {
+		if d.value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid healthcheck %s: %w", d.name, err)
+		}
+		*d.target = parsed
+	}
+	return health, nil
+}
+
+// convertResources translates the resource limits and reservations
+func convertResources(deploy *composeDeploy) (*swarm.ResourceRequirements, error) {
+	limits, err := convertResourceValues(deploy.Resources.Limits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource limits: %w", err)
+	}
+	reservations, err := convertResourceValues(deploy.Resources.Reservations)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource reservations: %w", err)
+	}
+	if limits == nil && reservations == nil {
+		return nil, nil
+	}
+
+	requirements := &swarm.ResourceRequirements{}
+	if limits != nil {
+		requirements.Limits = &swarm.Limit{NanoCPUs: limits.NanoCPUs, MemoryBytes: limits.MemoryBytes}
+	}
+	requirements.Reservations = reservations
+	return requirements, nil
+}
+
+func convertResourceValues(values composeResources) (*swarm.Resources, error) {
+	if values.CPUs == "" && values.Memory == "" {
+		return nil, nil
+	}
+	resources := &swarm.Resources{}
+	if values.CPUs != "" {
+		cpus, err := strconv.ParseFloat(values.CPUs, 64)
+		if err != nil || cpus < 0 {
+			return nil, fmt.Errorf("invalid cpus %q", values.CPUs)
+		}
+		resources.NanoCPUs = int64(cpus * 1e9)
+	}
+	if values.Memory != "" {
+		memory, err := units.RAMInBytes(values.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory %q", values.Memory)
+		}
+		resources.MemoryBytes = memory
+	}
+	return resources, nil
+}
+
+// convertUpdateConfig translates an update or rollback config
+func convertUpdateConfig(c *composeUpdateConfig) (*swarm.UpdateConfig, error) {
+	if c == nil {
+		return nil, nil
+	}
+	config := &swarm.UpdateConfig{
+		Parallelism:     1,
+		FailureAction:   c.FailureAction,
+		MaxFailureRatio: c.MaxFailureRatio,
+		Order:           c.Order,
+	}
+	if c.Parallelism != nil {
+		config.Parallelism = *c.Parallelism
+	}
+	delay, err := parseOptionalDuration(c.Delay)
+	if err != nil {
+		return nil, err
+	}
+	if delay != nil {
+		config.Delay = *delay
+	}
+	monitor, err := parseOptionalDuration(c.Monitor)
+	if err != nil {
+		return nil, err
+	}
+	if monitor != nil {
+		config.Monitor = *monitor
+	}
+	return config, nil
+}
+
+func parseOptionalDuration(value string) (*time.Duration, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,183 @@
+package docker
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStackCompose = `
+version: "3.8"
+services:
+  web:
+    image: nginx:1.27
+    command: nginx -g "daemon off;"
+    environment:
+      APP_ENV: prod
+      EMPTY:
+    ports:
+      - "8080:80"
+      - target: 443
+        published: 8443
+        mode: host
+    networks:
+      frontend:
+        aliases: [www]
+    secrets:
+      - db_password
+    configs:
+      - source: nginx_conf
+        target: /etc/nginx/nginx.conf
+        mode: 0440
+    volumes:
+      - data:/usr/share/nginx/html:ro
+      - /var/log/nginx:/var/log/nginx
+    healthcheck:
+      test: curl -f http://localhost
+      interval: 10s
+      retries: 3
+    stop_grace_period: 20s
+    deploy:
+      replicas: 3
+      resources:
+        limits:
+          cpus: 0.5
+          memory: 256M
+      restart_policy:
+        condition: on-failure
+        max_attempts: 3
+      placement:
+        constraints: [node.role == worker]
+      update_config:
+        parallelism: 1
+        delay: 5s
+        order: start-first
+  worker:
+    image: registry.example.com/team/worker:2
+    deploy:
+      mode: global
+    build: .
+networks:
+  frontend:
+  shared:
+    external: true
+volumes:
+  data:
+secrets:
+  db_password:
+    external: true
+configs:
+  nginx_conf:
+    content: "worker_processes 1;"
+x-common: {}
+`
+
+func TestParseStack(t *testing.T) {
+	stack, err := parseStack("shop", testStackCompose)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`service worker: key "build" is not supported and was ignored`}, stack.Warnings)
+
+	require.Len(t, stack.Services, 2)
+	web := stack.Services[0]
+	assert.Equal(t, "shop_web", web.Name)
+	assert.Equal(t, "shop", web.Labels[StackNamespaceLabel])
+	assert.Equal(t, "nginx:1.27", web.Labels[StackImageLabel])
+	require.NotNil(t, web.Mode.Replicated)
+	assert.EqualValues(t, 3, *web.Mode.Replicated.Replicas)
+
+	cs := web.TaskTemplate.ContainerSpec
+	assert.Equal(t, []string{"nginx", "-g", "daemon off;"}, cs.Args)
+	assert.Equal(t, []string{"APP_ENV=prod", "EMPTY"}, cs.Env)
+	assert.Equal(t, 20*time.Second, *cs.StopGracePeriod)
+	assert.Equal(t, []string{"CMD-SHELL", "curl -f http://localhost"}, cs.Healthcheck.Test)
+	assert.Equal(t, 10*time.Second, cs.Healthcheck.Interval)
+
+	require.Len(t, cs.Secrets, 1)
+	assert.Equal(t, "db_password", cs.Secrets[0].SecretName)
+	assert.Equal(t, "db_password", cs.Secrets[0].File.Name)
+	require.Len(t, cs.Configs, 1)
+	assert.Equal(t, "shop_nginx_conf", cs.Configs[0].ConfigName)
+	assert.Equal(t, "/etc/nginx/nginx.conf", cs.Configs[0].File.Name)
+	assert.Equal(t, os.FileMode(0o440), cs.Configs[0].File.Mode)
+
+	require.Len(t, cs.Mounts, 2)
+	assert.Equal(t, mount.TypeVolume, cs.Mounts[0].Type)
+	assert.Equal(t, "shop_data", cs.Mounts[0].Source)
+	assert.True(t, cs.Mounts[0].ReadOnly)
+	assert.Equal(t, mount.TypeBind, cs.Mounts[1].Type)
+
+	require.Len(t, web.EndpointSpec.Ports, 2)
+	assert.Equal(t, swarm.PortConfig{Protocol: "tcp", TargetPort: 80, PublishedPort: 8080, PublishMode: "ingress"}, web.EndpointSpec.Ports[0])
+	assert.Equal(t, swarm.PortConfigPublishModeHost, web.EndpointSpec.Ports[1].PublishMode)
+
+	assert.Equal(t, int64(5e8), web.TaskTemplate.Resources.Limits.NanoCPUs)
+	assert.Equal(t, int64(256<<20), web.TaskTemplate.Resources.Limits.MemoryBytes)
+	assert.Equal(t, swarm.RestartPolicyConditionOnFailure, web.TaskTemplate.RestartPolicy.Condition)
+	assert.Equal(t, []string{"node.role == worker"}, web.TaskTemplate.Placement.Constraints)
+	assert.Equal(t, "start-first", web.UpdateConfig.Order)
+	assert.Equal(t, 5*time.Second, web.UpdateConfig.Delay)
+
+	require.Len(t, web.TaskTemplate.Networks, 1)
+	assert.Equal(t, "shop_frontend", web.TaskTemplate.Networks[0].Target)
+	assert.Equal(t, []string{"web", "www"}, web.TaskTemplate.Networks[0].Aliases)
+
+	worker := stack.Services[1]
+	assert.NotNil(t, worker.Mode.Global)
+	require.Len(t, worker.TaskTemplate.Networks, 1)
+	assert.Equal(t, "shop_default", worker.TaskTemplate.Networks[0].Target)
+
+	names := make([]string, 0, len(stack.Networks))
+	for _, nw := range stack.Networks {
+		names = append(names, nw.Name)
+	}
+	assert.Equal(t, []string{"shop_frontend", "shared", "shop_default"}, names)
+	assert.True(t, stack.Networks[1].External)
+	assert.Equal(t, "overlay", stack.Networks[2].Driver)
+
+	require.Len(t, stack.Secrets, 1)
+	assert.True(t, stack.Secrets[0].External)
+	require.Len(t, stack.Configs, 1)
+	assert.Equal(t, []byte("worker_processes 1;"), stack.Configs[0].Data)
+	assert.Equal(t, "shop", stack.Configs[0].Labels[StackNamespaceLabel])
+}
+
+func TestParseStack_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		stack   string
+		compose string
+		err     string
+	}{
+		{"invalid name", "-shop", "services: {web: {image: nginx}}", "invalid stack name"},
+		{"no services", "shop", "version: '3'", "defines no services"},
+		{"missing image", "shop", "services: {web: {command: run}}", "image is required"},
+		{"undefined network", "shop", "services: {web: {image: nginx, networks: [back]}}", `undefined network "back"`},
+		{"undefined secret", "shop", "services: {web: {image: nginx, secrets: [token]}}", `undefined secret "token"`},
+		{"relative bind", "shop", "services: {web: {image: nginx, volumes: ['./html:/html']}}", "relative bind mount"},
+		{"file secret", "shop", "services: {web: {image: nginx}}\nsecrets: {token: {file: ./token}}", "files cannot be read"},
+		{"host ip port", "shop", "services: {web: {image: nginx, ports: ['127.0.0.1:80:80']}}", "host IPs are not supported"},
+		{"bad mode", "shop", "services: {web: {image: nginx, deploy: {mode: daemon}}}", `invalid deploy mode "daemon"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseStack(tt.stack, tt.compose)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestSplitShellWords(t *testing.T) {
+	words, err := splitShellWords(`sh -c 'echo "hi there"' a\ b ""`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sh", "-c", `echo "hi there"`, "a b", ""}, words)
+
+	_, err = splitShellWords(`echo "unterminated`)
+	assert.Error(t, err)
+}
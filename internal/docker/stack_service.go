@@ -0,0 +1,273 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/docker/errdefs"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// ListStacks implements the ListStacks RPC method
+// Stacks are the distinct namespace labels of the swarm services
+func (s *DockerService) ListStacks(ctx context.Context, req *pb.ListStacksRequest) (*pb.ListStacksResponse, error) {
+	services, err := s.dockerClient.Client().ServiceList(ctx, types.ServiceListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", StackNamespaceLabel)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int32)
+	for _, service := range services {
+		counts[service.Spec.Labels[StackNamespaceLabel]]++
+	}
+
+	stacks := make([]*pb.Stack, 0, len(counts))
+	for _, name := range sortedKeys(counts) {
+		stacks = append(stacks, &pb.Stack{Name: name, Services: counts[name]})
+	}
+	return &pb.ListStacksResponse{Stacks: stacks}, nil
+}
+
+// DeployStack implements the DeployStack RPC method
+// It creates or updates the networks, secrets, configs and services of a
+// compose file, like `docker stack deploy`. Secrets and configs that exist
+// are left unchanged because the engine does not allow changing their data.
+func (s *DockerService) DeployStack(ctx context.Context, req *pb.DeployStackRequest) (*pb.DeployStackResponse, error) {
+	stack, err := parseStack(req.Name, req.Compose)
+	if err != nil {
+		return nil, err
+	}
+	cli := s.dockerClient.Client()
+	resp := &pb.DeployStackResponse{Warnings: stack.Warnings}
+
+	for _, nw := range stack.Networks {
+		_, err := cli.NetworkInspect(ctx, nw.Name, network.InspectOptions{})
+		if err == nil {
+			continue
+		}
+		if !errdefs.IsNotFound(err) {
+			return resp, fmt.Errorf("failed to inspect network %s: %w", nw.Name, err)
+		}
+		if nw.External {
+			return resp, fmt.Errorf("external network %s does not exist", nw.Name)
+		}
+		if _, err := cli.NetworkCreate(ctx, nw.Name, network.CreateOptions{
+			Driver:     nw.Driver,
+			Options:    nw.DriverOpts,
+			Attachable: nw.Attachable,
+			Internal:   nw.Internal,
+			Labels:     nw.Labels,
+			Scope:      "swarm",
+		}); err != nil {
+			return resp, fmt.Errorf("failed to create network %s: %w", nw.Name, err)
+		}
+		resp.Created = append(resp.Created, "network "+nw.Name)
+	}
+
+	secretIDs, err := s.ensureSwarmFiles(ctx, stack.Secrets, "secret", resp)
+	if err != nil {
+		return resp, err
+	}
+	configIDs, err := s.ensureSwarmFiles(ctx, stack.Configs, "config", resp)
+	if err != nil {
+		return resp, err
+	}
+
+	existing, err := cli.ServiceList(ctx, types.ServiceListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", StackNamespaceLabel+"="+stack.Name)),
+	})
+	if err != nil {
+		return resp, err
+	}
+	current := make(map[string]swarm.Service, len(existing))
+	for _, service := range existing {
+		current[service.Spec.Name] = service
+	}
+
+	deployed := make(map[string]bool, len(stack.Services))
+	for i := range stack.Services {
+		spec := stack.Services[i]
+		deployed[spec.Name] = true
+		containerSpec := spec.TaskTemplate.ContainerSpec
+		for _, secret := range containerSpec.Secrets {
+			secret.SecretID = secretIDs[secret.SecretName]
+		}
+		for _, config := range containerSpec.Configs {
+			config.ConfigID = configIDs[config.ConfigName]
+		}
+		registryAuth := req.RegistryAuth[containerSpec.Image]
+
+		service, ok := current[spec.Name]
+		if !ok {
+			created, err := cli.ServiceCreate(ctx, spec, types.ServiceCreateOptions{
+				EncodedRegistryAuth: registryAuth,
+				QueryRegistry:       true,
+			})
+			if err != nil {
+				return resp, fmt.Errorf("failed to create service %s: %w", spec.Name, err)
+			}
+			resp.Created = append(resp.Created, "service "+spec.Name)
+			resp.Warnings = append(resp.Warnings, created.Warnings...)
+			continue
+		}
+
+		options := types.ServiceUpdateOptions{
+			EncodedRegistryAuth: registryAuth,
+			QueryRegistry:       true,
+		}
+		if registryAuth == "" {
+			options.RegistryAuthFrom = types.RegistryAuthFromSpec
+		}
+		updated, err := cli.ServiceUpdate(ctx, service.ID, service.Version, spec, options)
+		if err != nil {
+			return resp, fmt.Errorf("failed to update service %s: %w", spec.Name, err)
+		}
+		resp.Updated = append(resp.Updated, "service "+spec.Name)
+		resp.Warnings = append(resp.Warnings, updated.Warnings...)
+	}
+
+	if req.Prune {
+		for _, name := range sortedKeys(current) {
+			if deployed[name] {
+				continue
+			}
+			if err := cli.ServiceRemove(ctx, current[name].ID); err != nil {
+				return resp, fmt.Errorf("failed to remove service %s: %w", name, err)
+			}
+			resp.Removed = append(resp.Removed, "service "+name)
+		}
+	}
+
+	resp.Success = true
+	return resp, nil
+}
+
+// ensureSwarmFiles creates the missing secrets or configs of a stack and
+// returns the IDs of all of them by name
+func (s *DockerService) ensureSwarmFiles(ctx context.Context, files []stackSwarmFile, kind string, resp *pb.DeployStackResponse) (map[string]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	cli := s.dockerClient.Client()
+
+	ids := make(map[string]string)
+	if kind == "secret" {
+		secrets, err := cli.SecretList(ctx, types.SecretListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets {
+			ids[secret.Spec.Name] = secret.ID
+		}
+	} else {
+		configs, err := cli.ConfigList(ctx, types.ConfigListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range configs {
+			ids[config.Spec.Name] = config.ID
+		}
+	}
+
+	for _, file := range files {
+		if _, ok := ids[file.Name]; ok {
+			continue
+		}
+		if file.External {
+			return nil, fmt.Errorf("external %s %s does not exist", kind, file.Name)
+		}
+
+		annotations := swarm.Annotations{Name: file.Name, Labels: file.Labels}
+		var id string
+		if kind == "secret" {
+			created, err := cli.SecretCreate(ctx, swarm.SecretSpec{Annotations: annotations, Data: file.Data})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create secret %s: %w", file.Name, err)
+			}
+			id = created.ID
+		} else {
+			created, err := cli.ConfigCreate(ctx, swarm.ConfigSpec{Annotations: annotations, Data: file.Data})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create config %s: %w", file.Name, err)
+			}
+			id = created.ID
+		}
+		ids[file.Name] = id
+		resp.Created = append(resp.Created, kind+" "+file.Name)
+	}
+	return ids, nil
+}
+
+// RemoveStack implements the RemoveStack RPC method
+// It removes the services, secrets, configs and networks of a stack
+func (s *DockerService) RemoveStack(ctx context.Context, req *pb.RemoveStackRequest) (*pb.RemoveStackResponse, error) {
+	if !stackNamePattern.MatchString(req.Name) {
+		return nil, fmt.Errorf("invalid stack name %q", req.Name)
+	}
+	cli := s.dockerClient.Client()
+	args := filters.NewArgs(filters.Arg("label", StackNamespaceLabel+"="+req.Name))
+	resp := &pb.RemoveStackResponse{}
+
+	services, err := cli.ServiceList(ctx, types.ServiceListOptions{Filters: args})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Spec.Name < services[j].Spec.Name })
+	for _, service := range services {
+		if err := cli.ServiceRemove(ctx, service.ID); err != nil {
+			return resp, fmt.Errorf("failed to remove service %s: %w", service.Spec.Name, err)
+		}
+		resp.Removed = append(resp.Removed, "service "+service.Spec.Name)
+	}
+
+	secrets, err := cli.SecretList(ctx, types.SecretListOptions{Filters: args})
+	if err != nil {
+		return resp, err
+	}
+	for _, secret := range secrets {
+		if err := cli.SecretRemove(ctx, secret.ID); err != nil {
+			return resp, fmt.Errorf("failed to remove secret %s: %w", secret.Spec.Name, err)
+		}
+		resp.Removed = append(resp.Removed, "secret "+secret.Spec.Name)
+	}
+
+	configs, err := cli.ConfigList(ctx, types.ConfigListOptions{Filters: args})
+	if err != nil {
+		return resp, err
+	}
+	for _, config := range configs {
+		if err := cli.ConfigRemove(ctx, config.ID); err != nil {
+			return resp, fmt.Errorf("failed to remove config %s: %w", config.Spec.Name, err)
+		}
+		resp.Removed = append(resp.Removed, "config "+config.Spec.Name)
+	}
+
+	// Networks can only be removed once the tasks using them are gone, so a
+	// busy network is reported instead of failing the removal. Removing the
+	// stack again later cleans it up.
+	networks, err := cli.NetworkList(ctx, network.ListOptions{Filters: args})
+	if err != nil {
+		return resp, err
+	}
+	for _, nw := range networks {
+		if err := cli.NetworkRemove(ctx, nw.ID); err != nil {
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf("network %s: %v", nw.Name, err))
+			continue
+		}
+		resp.Removed = append(resp.Removed, "network "+nw.Name)
+	}
+
+	if len(resp.Removed) == 0 && len(resp.Warnings) == 0 {
+		return nil, fmt.Errorf("stack %s not found", req.Name)
+	}
+	resp.Success = true
+	return resp, nil
+}
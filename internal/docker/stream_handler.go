@@ -1,11 +1,13 @@
-package main
+package docker
 
 import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"sync"
 	"time"
 
@@ -14,32 +16,57 @@ import (
 	"github.com/docker/docker/api/types/image"
 	"github.com/sirupsen/logrus"
 
-	"github.com/ysicing/tiga/internal/docker"
 	"github.com/ysicing/tiga/proto"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
 )
 
-// DockerStreamHandler handles Docker streaming operations
+// StreamConn is the engine side of a DockerStream connection. Agents use
+// their gRPC client stream; the server uses an in-process pipe for
+// instances it connects to directly.
+type StreamConn interface {
+	Send(*proto.DockerStreamMessage) error
+	Recv() (*proto.DockerStreamMessage, error)
+}
+
+// DockerStreamHandler runs the streaming operations of DockerStream
+// sessions (exec, logs, stats, pull, events, file copy) against an engine
 type DockerStreamHandler struct {
-	dockerClient *docker.DockerClient
+	dockerClient *DockerClient
 	sessions     sync.Map // session_id -> active stream context
+	uploads      sync.Map // session_id -> *io.PipeWriter of a copy_to_container session
+	execs        sync.Map // session_id -> *execSession of an exec_container session
+}
+
+// execSession is the attached exec of an exec_container session
+type execSession struct {
+	id   string
+	conn net.Conn
 }
 
 // NewDockerStreamHandler creates a new Docker stream handler
-func NewDockerStreamHandler(dockerClient *docker.DockerClient) *DockerStreamHandler {
+func NewDockerStreamHandler(dockerClient *DockerClient) *DockerStreamHandler {
 	return &DockerStreamHandler{
 		dockerClient: dockerClient,
 	}
 }
 
 // HandleDockerStream processes Docker stream requests from server
-func (h *DockerStreamHandler) HandleDockerStream(stream proto.HostMonitor_DockerStreamClient) error {
+func (h *DockerStreamHandler) HandleDockerStream(stream StreamConn) error {
 	logrus.Info("[DockerStream] Stream connection established")
 
 	// Goroutine to receive messages from server
 	errCh := make(chan error, 1)
 	go func() {
+		var sessionID string
 		for {
 			msg, err := stream.Recv()
+			if err != nil {
+				// An unfinished upload must not be extracted as if it were complete
+				h.abortUpload(sessionID, io.ErrUnexpectedEOF)
+				// Stop operations that only end with the session, such as get_events
+				h.cancelSession(sessionID)
+			}
 			if err == io.EOF {
 				logrus.Info("[DockerStream] Server closed stream")
 				errCh <- nil
@@ -50,6 +77,9 @@ func (h *DockerStreamHandler) HandleDockerStream(stream proto.HostMonitor_Docker
 				errCh <- err
 				return
 			}
+			if init := msg.GetInit(); init != nil {
+				sessionID = init.SessionId
+			}
 
 			// Handle message
 			if err := h.handleMessage(stream, msg); err != nil {
@@ -65,7 +95,7 @@ func (h *DockerStreamHandler) HandleDockerStream(stream proto.HostMonitor_Docker
 }
 
 // handleMessage handles a single Docker stream message
-func (h *DockerStreamHandler) handleMessage(stream proto.HostMonitor_DockerStreamClient, msg *proto.DockerStreamMessage) error {
+func (h *DockerStreamHandler) handleMessage(stream StreamConn, msg *proto.DockerStreamMessage) error {
 	switch m := msg.Message.(type) {
 	case *proto.DockerStreamMessage_Init:
 		return h.handleInit(stream, m.Init)
@@ -81,7 +111,7 @@ func (h *DockerStreamHandler) handleMessage(stream proto.HostMonitor_DockerStrea
 }
 
 // handleInit handles stream initialization from server
-func (h *DockerStreamHandler) handleInit(stream proto.HostMonitor_DockerStreamClient, init *proto.DockerStreamInit) error {
+func (h *DockerStreamHandler) handleInit(stream StreamConn, init *proto.DockerStreamInit) error {
 	sessionID := init.SessionId
 
 	// Check if this is a Ready confirmation from Server
@@ -121,6 +151,13 @@ func (h *DockerStreamHandler) handleInit(stream proto.HostMonitor_DockerStreamCl
 		go h.handlePullImage(ctx, stream, init)
 	case "get_events":
 		go h.handleGetEvents(ctx, stream, init)
+	case "copy_from_container":
+		go h.handleCopyFromContainer(ctx, stream, init)
+	case "copy_to_container":
+		// The pipe has to exist before the first archive chunk arrives
+		archive, archiveWriter := io.Pipe()
+		h.uploads.Store(sessionID, archiveWriter)
+		go h.handleCopyToContainer(ctx, stream, init, archive)
 	default:
 		cancel()
 		return fmt.Errorf("unknown operation: %s", operation)
@@ -129,41 +166,79 @@ func (h *DockerStreamHandler) handleInit(stream proto.HostMonitor_DockerStreamCl
 	return nil
 }
 
-// handleData handles data from server (e.g., terminal input)
-func (h *DockerStreamHandler) handleData(stream proto.HostMonitor_DockerStreamClient, data *proto.DockerStreamData) error {
-	// This is typically used for terminal input in exec_container
-	// The actual implementation will forward data to the running container exec session
+// handleData handles data from server: terminal input and upload archives
+func (h *DockerStreamHandler) handleData(stream StreamConn, data *proto.DockerStreamData) error {
 	logrus.Debugf("[DockerStream] Received data: session=%s type=%s len=%d", data.SessionId, data.DataType, len(data.Data))
+
+	// Terminal input of an exec_container session goes to the exec
+	if data.DataType == "stdin" {
+		if value, ok := h.execs.Load(data.SessionId); ok {
+			if _, err := value.(*execSession).conn.Write(data.Data); err != nil {
+				logrus.WithError(err).Error("[DockerStream] Error writing to stdin")
+				h.cancelSession(data.SessionId)
+			}
+		}
+		return nil
+	}
+
+	// Archive chunks of a copy_to_container session go to its pipe
+	if value, ok := h.uploads.Load(data.SessionId); ok {
+		archiveWriter := value.(*io.PipeWriter)
+		switch data.DataType {
+		case "archive":
+			// A failed extraction closes the pipe and reports the error itself
+			if _, err := archiveWriter.Write(data.Data); err != nil {
+				logrus.WithError(err).Warn("[DockerStream] Dropping archive data")
+			}
+		case "archive_end":
+			h.uploads.Delete(data.SessionId)
+			archiveWriter.Close()
+		}
+	}
 	return nil
 }
 
 // handleResize handles terminal resize requests
-func (h *DockerStreamHandler) handleResize(stream proto.HostMonitor_DockerStreamClient, resize *proto.DockerStreamResize) error {
-	logrus.Infof("[DockerStream] Terminal resize: session=%s width=%d height=%d", resize.SessionId, resize.Width, resize.Height)
-	// TODO: Implement terminal resize for exec sessions
+func (h *DockerStreamHandler) handleResize(stream StreamConn, resize *proto.DockerStreamResize) error {
+	logrus.Debugf("[DockerStream] Terminal resize: session=%s width=%d height=%d", resize.SessionId, resize.Width, resize.Height)
+	value, ok := h.execs.Load(resize.SessionId)
+	if !ok {
+		return nil
+	}
+	if err := h.dockerClient.Client().ContainerExecResize(context.Background(), value.(*execSession).id, container.ResizeOptions{
+		Height: uint(resize.Height),
+		Width:  uint(resize.Width),
+	}); err != nil {
+		logrus.WithError(err).Warn("[DockerStream] Failed to resize TTY")
+	}
 	return nil
 }
 
 // handleClose handles stream close requests
-func (h *DockerStreamHandler) handleClose(stream proto.HostMonitor_DockerStreamClient, close *proto.DockerStreamClose) error {
+func (h *DockerStreamHandler) handleClose(stream StreamConn, close *proto.DockerStreamClose) error {
 	sessionID := close.SessionId
 	logrus.WithFields(logrus.Fields{
 		"session_id": sessionID,
 		"reason":     close.Reason,
 	}).Info("[DockerStream] Closing session")
 
-	// Cancel session context
+	h.abortUpload(sessionID, errors.New("session closed by server"))
+
+	h.cancelSession(sessionID)
+	return nil
+}
+
+// cancelSession cancels the context of a session's operation
+func (h *DockerStreamHandler) cancelSession(sessionID string) {
 	if cancel, ok := h.sessions.LoadAndDelete(sessionID); ok {
 		if cancelFunc, ok := cancel.(context.CancelFunc); ok {
 			cancelFunc()
 		}
 	}
-
-	return nil
 }
 
 // handleExecContainer handles container exec operation
-func (h *DockerStreamHandler) handleExecContainer(ctx context.Context, stream proto.HostMonitor_DockerStreamClient, init *proto.DockerStreamInit) {
+func (h *DockerStreamHandler) handleExecContainer(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit) {
 	sessionID := init.SessionId
 	containerID := init.ContainerId
 
@@ -228,167 +303,121 @@ func (h *DockerStreamHandler) handleExecContainer(ctx context.Context, stream pr
 
 	logrus.Info("[DockerStream] Attached to exec instance, starting I/O forwarding")
 
-	// Channel to coordinate shutdown
-	done := make(chan bool, 1)
+	// Input and resize messages reach the exec through handleData and
+	// handleResize, the stream has a single receiver
+	h.execs.Store(sessionID, &execSession{id: execID.ID, conn: attachResp.Conn})
+	defer h.execs.Delete(sessionID)
 
-	// Goroutine: Read output from Docker exec and send to Server with buffering
+	// Read output in the background, reads block until the exec writes
+	done := make(chan struct{})
+	defer close(done)
+	output := make(chan []byte, 16)
 	go func() {
+		defer close(output)
 		readBuf := make([]byte, 8192)
-		sendBuf := make([]byte, 0, 16384)               // Accumulated buffer
-		ticker := time.NewTicker(50 * time.Millisecond) // Flush every 50ms
-		defer ticker.Stop()
-
-		flushBuffer := func() {
-			if len(sendBuf) > 0 {
-				// Send accumulated data
-				if err := stream.Send(&proto.DockerStreamMessage{
-					Message: &proto.DockerStreamMessage_Data{
-						Data: &proto.DockerStreamData{
-							SessionId: sessionID,
-							Data:      append([]byte(nil), sendBuf...), // Copy to avoid data race
-							DataType:  "stdout",
-						},
-					},
-				}); err != nil {
-					logrus.WithError(err).Error("[DockerStream] Failed to send output")
-					done <- true
+		for {
+			n, err := attachResp.Reader.Read(readBuf)
+			if n > 0 {
+				select {
+				case output <- append([]byte(nil), readBuf[:n]...):
+				case <-done:
 					return
 				}
-				sendBuf = sendBuf[:0] // Clear buffer
 			}
-		}
-
-		for {
-			select {
-			case <-ticker.C:
-				// Periodic flush
-				flushBuffer()
-
-			case <-done:
-				return
-
-			default:
-				// Try to read with timeout
-				attachResp.Conn.SetReadDeadline(time.Now().Add(30 * time.Millisecond))
-				n, err := attachResp.Reader.Read(readBuf)
-
-				if n > 0 {
-					// Append to send buffer
-					sendBuf = append(sendBuf, readBuf[:n]...)
-
-					// Flush if buffer is getting large (> 4KB) for responsiveness
-					if len(sendBuf) > 4096 {
-						flushBuffer()
-					}
-				}
-
-				if err != nil {
-					if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
-						// Read timeout, continue to next iteration
-						continue
-					}
-					if err != io.EOF {
-						logrus.WithError(err).Error("[DockerStream] Error reading from exec")
-					}
-					// Flush any remaining data before exiting
-					flushBuffer()
-					done <- true
-					return
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					logrus.WithError(err).Error("[DockerStream] Error reading from exec")
 				}
+				return
 			}
 		}
 	}()
 
-	// Goroutine: Read messages from Server (stdin, resize)
-	go func() {
-		for {
-			msg, err := stream.Recv()
-			if err != nil {
-				if err != io.EOF {
-					logrus.WithError(err).Error("[DockerStream] Error receiving from server")
-				}
-				done <- true
+	// Send output to the server in batches, flushing every 50ms or once
+	// more than 4KB is pending
+	sendBuf := make([]byte, 0, 16384)
+	flushBuffer := func() error {
+		if len(sendBuf) == 0 {
+			return nil
+		}
+		err := stream.Send(&proto.DockerStreamMessage{
+			Message: &proto.DockerStreamMessage_Data{
+				Data: &proto.DockerStreamData{
+					SessionId: sessionID,
+					Data:      append([]byte(nil), sendBuf...), // Copy to avoid data race
+					DataType:  "stdout",
+				},
+			},
+		})
+		sendBuf = sendBuf[:0]
+		return err
+	}
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logrus.Info("[DockerStream] Exec session closed by server")
+			return
+		case <-ticker.C:
+			if err := flushBuffer(); err != nil {
+				logrus.WithError(err).Error("[DockerStream] Failed to send output")
 				return
 			}
-
-			switch m := msg.Message.(type) {
-			case *proto.DockerStreamMessage_Data:
-				// Forward stdin to exec
-				if m.Data.DataType == "stdin" {
-					if _, err := attachResp.Conn.Write(m.Data.Data); err != nil {
-						logrus.WithError(err).Error("[DockerStream] Error writing to stdin")
-						done <- true
+		case data, ok := <-output:
+			if ok {
+				sendBuf = append(sendBuf, data...)
+				if len(sendBuf) > 4096 {
+					if err := flushBuffer(); err != nil {
+						logrus.WithError(err).Error("[DockerStream] Failed to send output")
 						return
 					}
 				}
-
-			case *proto.DockerStreamMessage_Resize:
-				// Resize TTY
-				if err := h.dockerClient.Client().ContainerExecResize(ctx, execID.ID, container.ResizeOptions{
-					Height: uint(m.Resize.Height),
-					Width:  uint(m.Resize.Width),
-				}); err != nil {
-					logrus.WithError(err).Warn("[DockerStream] Failed to resize TTY")
-				} else {
-					logrus.WithFields(logrus.Fields{
-						"width":  m.Resize.Width,
-						"height": m.Resize.Height,
-					}).Debug("[DockerStream] TTY resized")
-				}
-
-			case *proto.DockerStreamMessage_Close:
-				// Client requested close
-				logrus.Info("[DockerStream] Received close request from server")
-				done <- true
-				return
+				continue
 			}
-		}
-	}()
 
-	// Goroutine: Poll exec status and send exit code
-	go func() {
-		ticker := time.NewTicker(500 * time.Millisecond)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				done <- true
-				return
-			case <-done:
+			// The exec ended, send the remaining output and the exit code
+			if err := flushBuffer(); err != nil {
+				logrus.WithError(err).Error("[DockerStream] Failed to send output")
 				return
-			case <-ticker.C:
-				inspectResp, err := h.dockerClient.Client().ContainerExecInspect(ctx, execID.ID)
-				if err != nil {
-					logrus.WithError(err).Error("[DockerStream] Error inspecting exec")
-					continue
-				}
-
-				if !inspectResp.Running {
-					logrus.WithField("exit_code", inspectResp.ExitCode).Info("[DockerStream] Exec finished")
-					// Send close message with exit code
-					stream.Send(&proto.DockerStreamMessage{
-						Message: &proto.DockerStreamMessage_Close{
-							Close: &proto.DockerStreamClose{
-								SessionId: sessionID,
-								Reason:    fmt.Sprintf("Process exited with code %d", inspectResp.ExitCode),
-							},
-						},
-					})
-					done <- true
-					return
-				}
 			}
+			reason := "Process exited"
+			if exitCode, ok := h.execExitCode(ctx, execID.ID); ok {
+				logrus.WithField("exit_code", exitCode).Info("[DockerStream] Exec finished")
+				reason = fmt.Sprintf("Process exited with code %d", exitCode)
+			}
+			h.sendClose(stream, sessionID, reason)
+			logrus.Info("[DockerStream] Exec session completed")
+			return
 		}
-	}()
+	}
+}
 
-	// Wait for completion
-	<-done
-	logrus.Info("[DockerStream] Exec session completed")
+// execExitCode waits briefly for an exec whose output ended to be reported
+// as stopped and returns its exit code
+func (h *DockerStreamHandler) execExitCode(ctx context.Context, execID string) (int, bool) {
+	for i := 0; i < 10; i++ {
+		inspectResp, err := h.dockerClient.Client().ContainerExecInspect(ctx, execID)
+		if err != nil {
+			logrus.WithError(err).Error("[DockerStream] Error inspecting exec")
+			return 0, false
+		}
+		if !inspectResp.Running {
+			return inspectResp.ExitCode, true
+		}
+		select {
+		case <-ctx.Done():
+			return 0, false
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return 0, false
 }
 
 // handleGetLogs handles container logs streaming
-func (h *DockerStreamHandler) handleGetLogs(ctx context.Context, stream proto.HostMonitor_DockerStreamClient, init *proto.DockerStreamInit) {
+func (h *DockerStreamHandler) handleGetLogs(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit) {
 	sessionID := init.SessionId
 	containerID := init.ContainerId
 
@@ -460,7 +489,7 @@ func (h *DockerStreamHandler) handleGetLogs(ctx context.Context, stream proto.Ho
 }
 
 // handleGetStats handles container stats streaming
-func (h *DockerStreamHandler) handleGetStats(ctx context.Context, stream proto.HostMonitor_DockerStreamClient, init *proto.DockerStreamInit) {
+func (h *DockerStreamHandler) handleGetStats(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit) {
 	sessionID := init.SessionId
 	containerID := init.ContainerId
 
@@ -525,7 +554,7 @@ func (h *DockerStreamHandler) handleGetStats(ctx context.Context, stream proto.H
 }
 
 // handlePullImage handles image pull with progress streaming
-func (h *DockerStreamHandler) handlePullImage(ctx context.Context, stream proto.HostMonitor_DockerStreamClient, init *proto.DockerStreamInit) {
+func (h *DockerStreamHandler) handlePullImage(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit) {
 	sessionID := init.SessionId
 	imageName := init.ImageName
 
@@ -628,14 +657,115 @@ func (h *DockerStreamHandler) handlePullImage(ctx context.Context, stream proto.
 }
 
 // handleGetEvents handles Docker events streaming
-func (h *DockerStreamHandler) handleGetEvents(ctx context.Context, stream proto.HostMonitor_DockerStreamClient, init *proto.DockerStreamInit) {
-	// TODO: Implement Docker events streaming
-	logrus.Info("[DockerStream] get_events not yet implemented")
-	h.sendError(stream, init.SessionId, "get_events not yet implemented")
+// Each event is sent as a JSON encoded DockerEvent until the server closes
+// the session or the until time is reached
+func (h *DockerStreamHandler) handleGetEvents(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit) {
+	sessionID := init.SessionId
+
+	logrus.WithFields(logrus.Fields{
+		"session_id": sessionID,
+		"since":      init.Params["since"],
+		"filters":    init.Params["filters"],
+	}).Info("[DockerStream] Starting events stream")
+
+	req := &pb.GetEventsRequest{
+		Since:   init.Params["since"],
+		Until:   init.Params["until"],
+		Filters: init.Params["filters"],
+	}
+	err := h.dockerClient.StreamEvents(ctx, req, func(event *pb.DockerEvent) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&proto.DockerStreamMessage{
+			Message: &proto.DockerStreamMessage_Data{
+				Data: &proto.DockerStreamData{
+					SessionId: sessionID,
+					Data:      data,
+					DataType:  "event",
+				},
+			},
+		})
+	})
+	if ctx.Err() != nil {
+		logrus.WithField("session_id", sessionID).Info("[DockerStream] Events stream closed")
+		return
+	}
+	if err != nil {
+		logrus.WithError(err).Error("[DockerStream] Events stream failed")
+		h.sendError(stream, sessionID, err.Error())
+		return
+	}
+
+	h.sendClose(stream, sessionID, "completed")
+}
+
+// handleCopyFromContainer streams a tar archive of a path in a container
+func (h *DockerStreamHandler) handleCopyFromContainer(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit) {
+	sessionID := init.SessionId
+	srcPath := init.Params["path"]
+
+	logrus.WithFields(logrus.Fields{
+		"session_id":   sessionID,
+		"container_id": init.ContainerId,
+		"path":         srcPath,
+	}).Info("[DockerStream] Starting copy_from_container")
+
+	send := ChunkWriter(func(data []byte) error {
+		return stream.Send(&proto.DockerStreamMessage{
+			Message: &proto.DockerStreamMessage_Data{
+				Data: &proto.DockerStreamData{
+					SessionId: sessionID,
+					Data:      data,
+					DataType:  "archive",
+				},
+			},
+		})
+	})
+	if err := h.dockerClient.CopyArchiveFrom(ctx, init.ContainerId, srcPath, init.Params["compress"] == "true", send); err != nil {
+		logrus.WithError(err).Error("[DockerStream] Failed to copy from container")
+		h.sendError(stream, sessionID, err.Error())
+		return
+	}
+
+	h.sendClose(stream, sessionID, "completed")
+}
+
+// handleCopyToContainer extracts the archive chunks sent by the server into
+// a directory of a container
+func (h *DockerStreamHandler) handleCopyToContainer(ctx context.Context, stream StreamConn, init *proto.DockerStreamInit, archive *io.PipeReader) {
+	sessionID := init.SessionId
+	dstPath := init.Params["path"]
+
+	logrus.WithFields(logrus.Fields{
+		"session_id":   sessionID,
+		"container_id": init.ContainerId,
+		"path":         dstPath,
+	}).Info("[DockerStream] Starting copy_to_container")
+
+	err := h.dockerClient.CopyArchiveTo(ctx, init.ContainerId, dstPath, archive)
+	// Unblock handleData if Docker stopped reading early
+	archive.Close()
+	h.uploads.Delete(sessionID)
+	if err != nil {
+		logrus.WithError(err).Error("[DockerStream] Failed to copy to container")
+		h.sendError(stream, sessionID, err.Error())
+		return
+	}
+
+	h.sendClose(stream, sessionID, "completed")
+}
+
+// abortUpload fails the pending extraction of a copy_to_container session
+func (h *DockerStreamHandler) abortUpload(sessionID string, err error) {
+	if value, ok := h.uploads.LoadAndDelete(sessionID); ok {
+		value.(*io.PipeWriter).CloseWithError(err)
+	}
 }
 
 // sendError sends error message to server
-func (h *DockerStreamHandler) sendError(stream proto.HostMonitor_DockerStreamClient, sessionID, errMsg string) {
+func (h *DockerStreamHandler) sendError(stream StreamConn, sessionID, errMsg string) {
 	if err := stream.Send(&proto.DockerStreamMessage{
 		Message: &proto.DockerStreamMessage_Error{
 			Error: &proto.DockerStreamError{
@@ -649,7 +779,7 @@ func (h *DockerStreamHandler) sendError(stream proto.HostMonitor_DockerStreamCli
 }
 
 // sendClose sends close message to server
-func (h *DockerStreamHandler) sendClose(stream proto.HostMonitor_DockerStreamClient, sessionID, reason string) {
+func (h *DockerStreamHandler) sendClose(stream StreamConn, sessionID, reason string) {
 	if err := stream.Send(&proto.DockerStreamMessage{
 		Message: &proto.DockerStreamMessage_Close{
 			Close: &proto.DockerStreamClose{
@@ -0,0 +1,439 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/swarm"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// StackNamespaceLabel is the label the docker CLI puts on every object of a
+// stack, so stacks deployed here and with `docker stack deploy` mix freely
+const StackNamespaceLabel = "com.docker.stack.namespace"
+
+// ListSwarmNodes implements the ListSwarmNodes RPC method
+func (s *DockerService) ListSwarmNodes(ctx context.Context, req *pb.ListSwarmNodesRequest) (*pb.ListSwarmNodesResponse, error) {
+	nodes, err := s.dockerClient.Client().NodeList(ctx, types.NodeListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pbNodes := make([]*pb.SwarmNode, 0, len(nodes))
+	for i := range nodes {
+		pbNodes = append(pbNodes, convertSwarmNode(&nodes[i]))
+	}
+	sort.Slice(pbNodes, func(i, j int) bool { return pbNodes[i].Hostname < pbNodes[j].Hostname })
+
+	return &pb.ListSwarmNodesResponse{Nodes: pbNodes}, nil
+}
+
+// UpdateSwarmNode implements the UpdateSwarmNode RPC method
+// It changes the availability or role of a node
+func (s *DockerService) UpdateSwarmNode(ctx context.Context, req *pb.UpdateSwarmNodeRequest) (*pb.UpdateSwarmNodeResponse, error) {
+	cli := s.dockerClient.Client()
+
+	node, _, err := cli.NodeInspectWithRaw(ctx, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := node.Spec
+	switch swarm.NodeAvailability(req.Availability) {
+	case "":
+	case swarm.NodeAvailabilityActive, swarm.NodeAvailabilityPause, swarm.NodeAvailabilityDrain:
+		spec.Availability = swarm.NodeAvailability(req.Availability)
+	default:
+		return nil, fmt.Errorf("invalid availability %q", req.Availability)
+	}
+	switch swarm.NodeRole(req.Role) {
+	case "":
+	case swarm.NodeRoleWorker, swarm.NodeRoleManager:
+		spec.Role = swarm.NodeRole(req.Role)
+	default:
+		return nil, fmt.Errorf("invalid role %q", req.Role)
+	}
+
+	if err := cli.NodeUpdate(ctx, node.ID, node.Version, spec); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSwarmNodeResponse{
+		Success: true,
+		Message: "Node updated successfully",
+	}, nil
+}
+
+// ListSwarmServices implements the ListSwarmServices RPC method
+// It includes the running and desired task counts of every service
+func (s *DockerService) ListSwarmServices(ctx context.Context, req *pb.ListSwarmServicesRequest) (*pb.ListSwarmServicesResponse, error) {
+	args := filters.NewArgs()
+	if req.Stack != "" {
+		args.Add("label", StackNamespaceLabel+"="+req.Stack)
+	}
+
+	services, err := s.dockerClient.Client().ServiceList(ctx, types.ServiceListOptions{Filters: args, Status: true})
+	if err != nil {
+		return nil, err
+	}
+
+	pbServices := make([]*pb.SwarmService, 0, len(services))
+	for i := range services {
+		pbServices = append(pbServices, convertSwarmService(&services[i]))
+	}
+	sort.Slice(pbServices, func(i, j int) bool { return pbServices[i].Name < pbServices[j].Name })
+
+	return &pb.ListSwarmServicesResponse{Services: pbServices}, nil
+}
+
+// GetSwarmService implements the GetSwarmService RPC method
+func (s *DockerService) GetSwarmService(ctx context.Context, req *pb.GetSwarmServiceRequest) (*pb.GetSwarmServiceResponse, error) {
+	service, _, err := s.dockerClient.Client().ServiceInspectWithRaw(ctx, req.ServiceId, types.ServiceInspectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := json.Marshal(service.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSwarmServiceResponse{
+		Service:  convertSwarmService(&service),
+		SpecJson: string(spec),
+	}, nil
+}
+
+// ScaleSwarmService implements the ScaleSwarmService RPC method
+// Only replicated services can be scaled
+func (s *DockerService) ScaleSwarmService(ctx context.Context, req *pb.ScaleSwarmServiceRequest) (*pb.UpdateSwarmServiceResponse, error) {
+	cli := s.dockerClient.Client()
+
+	service, _, err := cli.ServiceInspectWithRaw(ctx, req.ServiceId, types.ServiceInspectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if service.Spec.Mode.Replicated == nil {
+		return nil, fmt.Errorf("service %s is not a replicated service", service.Spec.Name)
+	}
+
+	spec := service.Spec
+	replicas := req.Replicas
+	spec.Mode.Replicated.Replicas = &replicas
+
+	resp, err := cli.ServiceUpdate(ctx, service.ID, service.Version, spec, types.ServiceUpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSwarmServiceResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("Service %s scaled to %d", service.Spec.Name, replicas),
+		Warnings: resp.Warnings,
+	}, nil
+}
+
+// UpdateSwarmServiceImage implements the UpdateSwarmServiceImage RPC method
+// It starts a rolling update of the service to a new image
+func (s *DockerService) UpdateSwarmServiceImage(ctx context.Context, req *pb.UpdateSwarmServiceImageRequest) (*pb.UpdateSwarmServiceResponse, error) {
+	if req.Image == "" {
+		return nil, fmt.Errorf("image is required")
+	}
+	cli := s.dockerClient.Client()
+
+	service, _, err := cli.ServiceInspectWithRaw(ctx, req.ServiceId, types.ServiceInspectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if service.Spec.TaskTemplate.ContainerSpec == nil {
+		return nil, fmt.Errorf("service %s does not run containers", service.Spec.Name)
+	}
+
+	spec := service.Spec
+	containerSpec := *spec.TaskTemplate.ContainerSpec
+	containerSpec.Image = req.Image
+	spec.TaskTemplate.ContainerSpec = &containerSpec
+
+	options := types.ServiceUpdateOptions{
+		EncodedRegistryAuth: req.RegistryAuth,
+		QueryRegistry:       true, // Pin the image digest like `docker service update --image`
+	}
+	if req.RegistryAuth == "" {
+		options.RegistryAuthFrom = types.RegistryAuthFromSpec
+	}
+
+	resp, err := cli.ServiceUpdate(ctx, service.ID, service.Version, spec, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSwarmServiceResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("Service %s is updating to %s", service.Spec.Name, req.Image),
+		Warnings: resp.Warnings,
+	}, nil
+}
+
+// RollbackSwarmService implements the RollbackSwarmService RPC method
+// It reverts the service to its previous spec
+func (s *DockerService) RollbackSwarmService(ctx context.Context, req *pb.RollbackSwarmServiceRequest) (*pb.UpdateSwarmServiceResponse, error) {
+	cli := s.dockerClient.Client()
+
+	service, _, err := cli.ServiceInspectWithRaw(ctx, req.ServiceId, types.ServiceInspectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if service.PreviousSpec == nil {
+		return nil, fmt.Errorf("service %s has no previous spec to roll back to", service.Spec.Name)
+	}
+
+	resp, err := cli.ServiceUpdate(ctx, service.ID, service.Version, service.Spec, types.ServiceUpdateOptions{
+		Rollback: "previous",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSwarmServiceResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("Service %s is rolling back", service.Spec.Name),
+		Warnings: resp.Warnings,
+	}, nil
+}
+
+// DeleteSwarmService implements the DeleteSwarmService RPC method
+func (s *DockerService) DeleteSwarmService(ctx context.Context, req *pb.DeleteSwarmServiceRequest) (*pb.DeleteSwarmServiceResponse, error) {
+	if err := s.dockerClient.Client().ServiceRemove(ctx, req.ServiceId); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSwarmServiceResponse{
+		Success: true,
+		Message: "Service deleted successfully",
+	}, nil
+}
+
+// ListSwarmTasks implements the ListSwarmTasks RPC method
+func (s *DockerService) ListSwarmTasks(ctx context.Context, req *pb.ListSwarmTasksRequest) (*pb.ListSwarmTasksResponse, error) {
+	args := filters.NewArgs()
+	if req.ServiceId != "" {
+		args.Add("service", req.ServiceId)
+	}
+	if req.NodeId != "" {
+		args.Add("node", req.NodeId)
+	}
+	if req.DesiredState != "" {
+		args.Add("desired-state", req.DesiredState)
+	}
+
+	tasks, err := s.dockerClient.Client().TaskList(ctx, types.TaskListOptions{Filters: args})
+	if err != nil {
+		return nil, err
+	}
+
+	pbTasks := make([]*pb.SwarmTask, 0, len(tasks))
+	for i := range tasks {
+		pbTasks = append(pbTasks, convertSwarmTask(&tasks[i]))
+	}
+	// Newest first, like `docker service ps`
+	sort.Slice(pbTasks, func(i, j int) bool { return pbTasks[i].CreatedAt > pbTasks[j].CreatedAt })
+
+	return &pb.ListSwarmTasksResponse{Tasks: pbTasks}, nil
+}
+
+// ListSwarmSecrets implements the ListSwarmSecrets RPC method
+// Secret data can never be read back from the engine
+func (s *DockerService) ListSwarmSecrets(ctx context.Context, req *pb.ListSwarmSecretsRequest) (*pb.ListSwarmSecretsResponse, error) {
+	secrets, err := s.dockerClient.Client().SecretList(ctx, types.SecretListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pbSecrets := make([]*pb.SwarmSecret, 0, len(secrets))
+	for _, secret := range secrets {
+		pbSecrets = append(pbSecrets, &pb.SwarmSecret{
+			Id:        secret.ID,
+			Name:      secret.Spec.Name,
+			Labels:    secret.Spec.Labels,
+			CreatedAt: secret.CreatedAt.Unix(),
+			UpdatedAt: secret.UpdatedAt.Unix(),
+		})
+	}
+	sort.Slice(pbSecrets, func(i, j int) bool { return pbSecrets[i].Name < pbSecrets[j].Name })
+
+	return &pb.ListSwarmSecretsResponse{Secrets: pbSecrets}, nil
+}
+
+// CreateSwarmSecret implements the CreateSwarmSecret RPC method
+func (s *DockerService) CreateSwarmSecret(ctx context.Context, req *pb.CreateSwarmSecretRequest) (*pb.CreateSwarmSecretResponse, error) {
+	resp, err := s.dockerClient.Client().SecretCreate(ctx, swarm.SecretSpec{
+		Annotations: swarm.Annotations{Name: req.Name, Labels: req.Labels},
+		Data:        req.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSwarmSecretResponse{Id: resp.ID}, nil
+}
+
+// DeleteSwarmSecret implements the DeleteSwarmSecret RPC method
+func (s *DockerService) DeleteSwarmSecret(ctx context.Context, req *pb.DeleteSwarmSecretRequest) (*pb.DeleteSwarmSecretResponse, error) {
+	if err := s.dockerClient.Client().SecretRemove(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSwarmSecretResponse{
+		Success: true,
+		Message: "Secret deleted successfully",
+	}, nil
+}
+
+// ListSwarmConfigs implements the ListSwarmConfigs RPC method
+func (s *DockerService) ListSwarmConfigs(ctx context.Context, req *pb.ListSwarmConfigsRequest) (*pb.ListSwarmConfigsResponse, error) {
+	configs, err := s.dockerClient.Client().ConfigList(ctx, types.ConfigListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pbConfigs := make([]*pb.SwarmConfig, 0, len(configs))
+	for _, config := range configs {
+		pbConfigs = append(pbConfigs, &pb.SwarmConfig{
+			Id:        config.ID,
+			Name:      config.Spec.Name,
+			Labels:    config.Spec.Labels,
+			Data:      config.Spec.Data,
+			CreatedAt: config.CreatedAt.Unix(),
+			UpdatedAt: config.UpdatedAt.Unix(),
+		})
+	}
+	sort.Slice(pbConfigs, func(i, j int) bool { return pbConfigs[i].Name < pbConfigs[j].Name })
+
+	return &pb.ListSwarmConfigsResponse{Configs: pbConfigs}, nil
+}
+
+// CreateSwarmConfig implements the CreateSwarmConfig RPC method
+func (s *DockerService) CreateSwarmConfig(ctx context.Context, req *pb.CreateSwarmConfigRequest) (*pb.CreateSwarmConfigResponse, error) {
+	resp, err := s.dockerClient.Client().ConfigCreate(ctx, swarm.ConfigSpec{
+		Annotations: swarm.Annotations{Name: req.Name, Labels: req.Labels},
+		Data:        req.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSwarmConfigResponse{Id: resp.ID}, nil
+}
+
+// DeleteSwarmConfig implements the DeleteSwarmConfig RPC method
+func (s *DockerService) DeleteSwarmConfig(ctx context.Context, req *pb.DeleteSwarmConfigRequest) (*pb.DeleteSwarmConfigResponse, error) {
+	if err := s.dockerClient.Client().ConfigRemove(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSwarmConfigResponse{
+		Success: true,
+		Message: "Config deleted successfully",
+	}, nil
+}
+
+// convertSwarmNode converts a swarm node to protobuf format
+func convertSwarmNode(node *swarm.Node) *pb.SwarmNode {
+	pbNode := &pb.SwarmNode{
+		Id:            node.ID,
+		Hostname:      node.Description.Hostname,
+		Role:          string(node.Spec.Role),
+		Availability:  string(node.Spec.Availability),
+		State:         string(node.Status.State),
+		Addr:          node.Status.Addr,
+		EngineVersion: node.Description.Engine.EngineVersion,
+		Os:            node.Description.Platform.OS,
+		Architecture:  node.Description.Platform.Architecture,
+		NanoCpus:      node.Description.Resources.NanoCPUs,
+		MemoryBytes:   node.Description.Resources.MemoryBytes,
+		Labels:        node.Spec.Labels,
+		CreatedAt:     node.CreatedAt.Unix(),
+		UpdatedAt:     node.UpdatedAt.Unix(),
+	}
+	if node.ManagerStatus != nil {
+		pbNode.Leader = node.ManagerStatus.Leader
+		pbNode.ManagerReachability = string(node.ManagerStatus.Reachability)
+	}
+	return pbNode
+}
+
+// convertSwarmService converts a swarm service to protobuf format
+func convertSwarmService(service *swarm.Service) *pb.SwarmService {
+	spec := &service.Spec
+	pbService := &pb.SwarmService{
+		Id:          service.ID,
+		Name:        spec.Name,
+		Stack:       spec.Labels[StackNamespaceLabel],
+		Labels:      spec.Labels,
+		CanRollback: service.PreviousSpec != nil,
+		Version:     service.Version.Index,
+		CreatedAt:   service.CreatedAt.Unix(),
+		UpdatedAt:   service.UpdatedAt.Unix(),
+	}
+	if spec.TaskTemplate.ContainerSpec != nil {
+		pbService.Image = spec.TaskTemplate.ContainerSpec.Image
+	}
+
+	switch {
+	case spec.Mode.Replicated != nil:
+		pbService.Mode = "replicated"
+		if spec.Mode.Replicated.Replicas != nil {
+			pbService.Replicas = *spec.Mode.Replicated.Replicas
+		}
+	case spec.Mode.Global != nil:
+		pbService.Mode = "global"
+	case spec.Mode.ReplicatedJob != nil:
+		pbService.Mode = "replicated-job"
+	case spec.Mode.GlobalJob != nil:
+		pbService.Mode = "global-job"
+	}
+
+	if service.ServiceStatus != nil {
+		pbService.RunningTasks = service.ServiceStatus.RunningTasks
+		pbService.DesiredTasks = service.ServiceStatus.DesiredTasks
+	}
+	if service.UpdateStatus != nil {
+		pbService.UpdateState = string(service.UpdateStatus.State)
+		pbService.UpdateMessage = service.UpdateStatus.Message
+	}
+
+	for _, port := range service.Endpoint.Ports {
+		pbService.Ports = append(pbService.Ports, &pb.SwarmPort{
+			Protocol:      string(port.Protocol),
+			TargetPort:    port.TargetPort,
+			PublishedPort: port.PublishedPort,
+			PublishMode:   string(port.PublishMode),
+		})
+	}
+
+	return pbService
+}
+
+// convertSwarmTask converts a swarm task to protobuf format
+func convertSwarmTask(task *swarm.Task) *pb.SwarmTask {
+	pbTask := &pb.SwarmTask{
+		Id:           task.ID,
+		ServiceId:    task.ServiceID,
+		NodeId:       task.NodeID,
+		Slot:         int32(task.Slot),
+		DesiredState: string(task.DesiredState),
+		State:        string(task.Status.State),
+		Message:      task.Status.Message,
+		Error:        task.Status.Err,
+		CreatedAt:    task.CreatedAt.Unix(),
+		UpdatedAt:    task.UpdatedAt.Unix(),
+	}
+	if task.Spec.ContainerSpec != nil {
+		pbTask.Image = task.Spec.ContainerSpec.Image
+	}
+	if status := task.Status.ContainerStatus; status != nil {
+		pbTask.ContainerId = status.ContainerID
+		pbTask.ExitCode = int32(status.ExitCode)
+	}
+	return pbTask
+}
@@ -0,0 +1,697 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// Task is a Docker operation with its parameters and JSON payload, as sent
+// by the server in an agent task
+type Task struct {
+	Operation string
+	Params    map[string]string
+	Payload   []byte
+}
+
+// ErrUnknownOperation is returned by ExecuteTask for operations it does not know
+var ErrUnknownOperation = errors.New("unknown operation")
+
+// ExecuteTask runs a Docker operation and returns its response. The agent
+// runs the tasks queued by the server with it, and the server runs the
+// operations of instances it reaches directly.
+func (s *DockerService) ExecuteTask(ctx context.Context, task *Task) (interface{}, error) {
+	h := taskHandler{service: s}
+
+	switch task.Operation {
+	case "list_containers":
+		return h.listContainers(ctx, task)
+	case "get_container":
+		return h.getContainer(ctx, task)
+	case "start_container":
+		return h.startContainer(ctx, task)
+	case "stop_container":
+		return h.stopContainer(ctx, task)
+	case "restart_container":
+		return h.restartContainer(ctx, task)
+	case "pause_container":
+		return h.pauseContainer(ctx, task)
+	case "unpause_container":
+		return h.unpauseContainer(ctx, task)
+	case "delete_container":
+		return h.deleteContainer(ctx, task)
+	case "prune_containers":
+		return h.pruneContainers(ctx, task)
+	case "recreate_container":
+		return h.recreateContainer(ctx, task)
+	case "list_container_files":
+		return h.listContainerFiles(ctx, task)
+	case "list_images":
+		return h.listImages(ctx, task)
+	case "get_image":
+		return h.getImage(ctx, task)
+	case "delete_image":
+		return h.deleteImage(ctx, task)
+	case "tag_image":
+		return h.tagImage(ctx, task)
+	case "prune_images":
+		return h.pruneImages(ctx, task)
+	case "list_networks":
+		return h.listNetworks(ctx, task)
+	case "get_network":
+		return h.getNetwork(ctx, task)
+	case "create_network":
+		return h.createNetwork(ctx, task)
+	case "delete_network":
+		return h.deleteNetwork(ctx, task)
+	case "connect_network":
+		return h.connectNetwork(ctx, task)
+	case "disconnect_network":
+		return h.disconnectNetwork(ctx, task)
+	case "list_volumes":
+		return h.listVolumes(ctx, task)
+	case "get_volume":
+		return h.getVolume(ctx, task)
+	case "create_volume":
+		return h.createVolume(ctx, task)
+	case "delete_volume":
+		return h.deleteVolume(ctx, task)
+	case "prune_volumes":
+		return h.pruneVolumes(ctx, task)
+	case "get_system_info":
+		return h.getSystemInfo(ctx, task)
+	case "get_docker_info":
+		return h.getDockerInfo(ctx, task)
+	case "get_version":
+		return h.getVersion(ctx, task)
+	case "get_disk_usage":
+		return h.getDiskUsage(ctx, task)
+	case "prune_build_cache":
+		return h.pruneBuildCache(ctx, task)
+	case "ping":
+		return h.ping(ctx, task)
+	case "list_swarm_nodes":
+		return h.listSwarmNodes(ctx, task)
+	case "update_swarm_node":
+		return h.updateSwarmNode(ctx, task)
+	case "list_swarm_services":
+		return h.listSwarmServices(ctx, task)
+	case "get_swarm_service":
+		return h.getSwarmService(ctx, task)
+	case "scale_swarm_service":
+		return h.scaleSwarmService(ctx, task)
+	case "update_swarm_service_image":
+		return h.updateSwarmServiceImage(ctx, task)
+	case "rollback_swarm_service":
+		return h.rollbackSwarmService(ctx, task)
+	case "delete_swarm_service":
+		return h.deleteSwarmService(ctx, task)
+	case "list_swarm_tasks":
+		return h.listSwarmTasks(ctx, task)
+	case "list_swarm_secrets":
+		return h.listSwarmSecrets(ctx, task)
+	case "create_swarm_secret":
+		return h.createSwarmSecret(ctx, task)
+	case "delete_swarm_secret":
+		return h.deleteSwarmSecret(ctx, task)
+	case "list_swarm_configs":
+		return h.listSwarmConfigs(ctx, task)
+	case "create_swarm_config":
+		return h.createSwarmConfig(ctx, task)
+	case "delete_swarm_config":
+		return h.deleteSwarmConfig(ctx, task)
+	case "list_stacks":
+		return h.listStacks(ctx, task)
+	case "deploy_stack":
+		return h.deployStack(ctx, task)
+	case "remove_stack":
+		return h.removeStack(ctx, task)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOperation, task.Operation)
+	}
+}
+
+// taskHandler decodes the request of each operation of ExecuteTask
+type taskHandler struct {
+	service *DockerService
+}
+
+// unmarshalPayload decodes the JSON request of a task, if there is one
+func unmarshalPayload(task *Task, req interface{}) error {
+	if len(task.Payload) == 0 {
+		return nil
+	}
+	return json.Unmarshal(task.Payload, req)
+}
+
+// Container operations
+func (h taskHandler) listContainers(ctx context.Context, task *Task) (interface{}, error) {
+	// Parse request from payload
+	var req pb.ListContainersRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.ListContainers(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) getContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.GetContainerRequest{ContainerId: containerID}
+	resp, err := h.service.GetContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) startContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.StartContainerRequest{ContainerId: containerID}
+	resp, err := h.service.StartContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) stopContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.StopContainerRequest{ContainerId: containerID}
+	resp, err := h.service.StopContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) restartContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.RestartContainerRequest{ContainerId: containerID}
+	resp, err := h.service.RestartContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) pauseContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.PauseContainerRequest{ContainerId: containerID}
+	resp, err := h.service.PauseContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) unpauseContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.UnpauseContainerRequest{ContainerId: containerID}
+	resp, err := h.service.UnpauseContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) deleteContainer(ctx context.Context, task *Task) (interface{}, error) {
+	containerID, ok := task.Params["container_id"]
+	if !ok {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	req := &pb.DeleteContainerRequest{ContainerId: containerID}
+	resp, err := h.service.DeleteContainer(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) pruneContainers(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.PruneContainersRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.PruneContainers(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) recreateContainer(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.RecreateContainerRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+	if req.ContainerId == "" {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	resp, err := h.service.RecreateContainer(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) listContainerFiles(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ListContainerFilesRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+	if req.ContainerId == "" {
+		return nil, ErrMissingParameter("container_id")
+	}
+
+	resp, err := h.service.ListContainerFiles(ctx, &req)
+	return resp, err
+}
+
+// Image operations
+func (h taskHandler) listImages(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ListImagesRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.ListImages(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) getImage(ctx context.Context, task *Task) (interface{}, error) {
+	imageID, ok := task.Params["image_id"]
+	if !ok {
+		return nil, ErrMissingParameter("image_id")
+	}
+
+	req := &pb.GetImageRequest{ImageId: imageID}
+	resp, err := h.service.GetImage(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) deleteImage(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.DeleteImageRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.DeleteImage(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) tagImage(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.TagImageRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.TagImage(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) pruneImages(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.PruneImagesRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.PruneImages(ctx, &req)
+	return resp, err
+}
+
+// Network operations
+func (h taskHandler) listNetworks(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ListNetworksRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.ListNetworks(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) getNetwork(ctx context.Context, task *Task) (interface{}, error) {
+	networkID, ok := task.Params["network_id"]
+	if !ok {
+		return nil, ErrMissingParameter("network_id")
+	}
+
+	req := &pb.GetNetworkRequest{NetworkId: networkID}
+	resp, err := h.service.GetNetwork(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) createNetwork(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.CreateNetworkRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.CreateNetwork(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) deleteNetwork(ctx context.Context, task *Task) (interface{}, error) {
+	networkID, ok := task.Params["network_id"]
+	if !ok {
+		return nil, ErrMissingParameter("network_id")
+	}
+
+	req := &pb.DeleteNetworkRequest{NetworkId: networkID}
+	resp, err := h.service.DeleteNetwork(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) connectNetwork(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ConnectNetworkRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.ConnectNetwork(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) disconnectNetwork(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.DisconnectNetworkRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.DisconnectNetwork(ctx, &req)
+	return resp, err
+}
+
+// Volume operations
+func (h taskHandler) listVolumes(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ListVolumesRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.ListVolumes(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) getVolume(ctx context.Context, task *Task) (interface{}, error) {
+	volumeName, ok := task.Params["volume_name"]
+	if !ok {
+		return nil, ErrMissingParameter("volume_name")
+	}
+
+	req := &pb.GetVolumeRequest{Name: volumeName}
+	resp, err := h.service.GetVolume(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) createVolume(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.CreateVolumeRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.CreateVolume(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) deleteVolume(ctx context.Context, task *Task) (interface{}, error) {
+	volumeName, ok := task.Params["volume_name"]
+	if !ok {
+		return nil, ErrMissingParameter("volume_name")
+	}
+
+	req := &pb.DeleteVolumeRequest{Name: volumeName}
+	resp, err := h.service.DeleteVolume(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) pruneVolumes(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.PruneVolumesRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.PruneVolumes(ctx, &req)
+	return resp, err
+}
+
+// System operations
+func (h taskHandler) getSystemInfo(ctx context.Context, task *Task) (interface{}, error) {
+	req := &pb.GetSystemInfoRequest{}
+	resp, err := h.service.GetSystemInfo(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) getDockerInfo(ctx context.Context, task *Task) (interface{}, error) {
+	req := &pb.GetSystemInfoRequest{}
+	resp, err := h.service.GetSystemInfo(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) getVersion(ctx context.Context, task *Task) (interface{}, error) {
+	req := &pb.GetVersionRequest{}
+	resp, err := h.service.GetVersion(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) getDiskUsage(ctx context.Context, task *Task) (interface{}, error) {
+	req := &pb.GetDiskUsageRequest{}
+	resp, err := h.service.GetDiskUsage(ctx, req)
+	return resp, err
+}
+
+func (h taskHandler) pruneBuildCache(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.PruneBuildCacheRequest
+	if len(task.Payload) > 0 {
+		if err := json.Unmarshal(task.Payload, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := h.service.PruneBuildCache(ctx, &req)
+	return resp, err
+}
+
+func (h taskHandler) ping(ctx context.Context, task *Task) (interface{}, error) {
+	req := &pb.PingRequest{}
+	resp, err := h.service.Ping(ctx, req)
+	return resp, err
+}
+
+// Swarm operations, which fail with the engine error unless the engine is
+// a swarm manager
+func (h taskHandler) listSwarmNodes(ctx context.Context, task *Task) (interface{}, error) {
+	return h.service.ListSwarmNodes(ctx, &pb.ListSwarmNodesRequest{})
+}
+
+func (h taskHandler) updateSwarmNode(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.UpdateSwarmNodeRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.NodeId == "" {
+		return nil, ErrMissingParameter("node_id")
+	}
+	return h.service.UpdateSwarmNode(ctx, &req)
+}
+
+func (h taskHandler) listSwarmServices(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ListSwarmServicesRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	return h.service.ListSwarmServices(ctx, &req)
+}
+
+func (h taskHandler) getSwarmService(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.GetSwarmServiceRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.ServiceId == "" {
+		return nil, ErrMissingParameter("service_id")
+	}
+	return h.service.GetSwarmService(ctx, &req)
+}
+
+func (h taskHandler) scaleSwarmService(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ScaleSwarmServiceRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.ServiceId == "" {
+		return nil, ErrMissingParameter("service_id")
+	}
+	return h.service.ScaleSwarmService(ctx, &req)
+}
+
+func (h taskHandler) updateSwarmServiceImage(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.UpdateSwarmServiceImageRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.ServiceId == "" {
+		return nil, ErrMissingParameter("service_id")
+	}
+	return h.service.UpdateSwarmServiceImage(ctx, &req)
+}
+
+func (h taskHandler) rollbackSwarmService(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.RollbackSwarmServiceRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.ServiceId == "" {
+		return nil, ErrMissingParameter("service_id")
+	}
+	return h.service.RollbackSwarmService(ctx, &req)
+}
+
+func (h taskHandler) deleteSwarmService(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.DeleteSwarmServiceRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.ServiceId == "" {
+		return nil, ErrMissingParameter("service_id")
+	}
+	return h.service.DeleteSwarmService(ctx, &req)
+}
+
+func (h taskHandler) listSwarmTasks(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.ListSwarmTasksRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	return h.service.ListSwarmTasks(ctx, &req)
+}
+
+func (h taskHandler) listSwarmSecrets(ctx context.Context, task *Task) (interface{}, error) {
+	return h.service.ListSwarmSecrets(ctx, &pb.ListSwarmSecretsRequest{})
+}
+
+func (h taskHandler) createSwarmSecret(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.CreateSwarmSecretRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, ErrMissingParameter("name")
+	}
+	return h.service.CreateSwarmSecret(ctx, &req)
+}
+
+func (h taskHandler) deleteSwarmSecret(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.DeleteSwarmSecretRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, ErrMissingParameter("id")
+	}
+	return h.service.DeleteSwarmSecret(ctx, &req)
+}
+
+func (h taskHandler) listSwarmConfigs(ctx context.Context, task *Task) (interface{}, error) {
+	return h.service.ListSwarmConfigs(ctx, &pb.ListSwarmConfigsRequest{})
+}
+
+func (h taskHandler) createSwarmConfig(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.CreateSwarmConfigRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, ErrMissingParameter("name")
+	}
+	return h.service.CreateSwarmConfig(ctx, &req)
+}
+
+func (h taskHandler) deleteSwarmConfig(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.DeleteSwarmConfigRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, ErrMissingParameter("id")
+	}
+	return h.service.DeleteSwarmConfig(ctx, &req)
+}
+
+func (h taskHandler) listStacks(ctx context.Context, task *Task) (interface{}, error) {
+	return h.service.ListStacks(ctx, &pb.ListStacksRequest{})
+}
+
+func (h taskHandler) deployStack(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.DeployStackRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, ErrMissingParameter("name")
+	}
+	if req.Compose == "" {
+		return nil, ErrMissingParameter("compose")
+	}
+	return h.service.DeployStack(ctx, &req)
+}
+
+func (h taskHandler) removeStack(ctx context.Context, task *Task) (interface{}, error) {
+	var req pb.RemoveStackRequest
+	if err := unmarshalPayload(task, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, ErrMissingParameter("name")
+	}
+	return h.service.RemoveStack(ctx, &req)
+}
+
+// ErrMissingParameter creates a parameter missing error
+func ErrMissingParameter(param string) error {
+	return &MissingParameterError{Param: param}
+}
+
+// MissingParameterError represents a missing parameter error
+type MissingParameterError struct {
+	Param string
+}
+
+func (e *MissingParameterError) Error() string {
+	return "missing required parameter: " + e.Param
+}
@@ -0,0 +1,40 @@
+package docker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteTask_UnknownOperation(t *testing.T) {
+	service := NewDockerService(nil)
+
+	_, err := service.ExecuteTask(context.Background(), &Task{Operation: "format_disk"})
+	require.ErrorIs(t, err, ErrUnknownOperation)
+	assert.Contains(t, err.Error(), "format_disk")
+}
+
+func TestExecuteTask_MissingParameter(t *testing.T) {
+	service := NewDockerService(nil)
+
+	tests := []struct {
+		task  *Task
+		param string
+	}{
+		{&Task{Operation: "get_container"}, "container_id"},
+		{&Task{Operation: "list_container_files", Payload: []byte(`{}`)}, "container_id"},
+		{&Task{Operation: "get_image", Params: map[string]string{}}, "image_id"},
+		{&Task{Operation: "remove_stack", Payload: []byte(`{}`)}, "name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.task.Operation, func(t *testing.T) {
+			_, err := service.ExecuteTask(context.Background(), tt.task)
+			var missing *MissingParameterError
+			require.ErrorAs(t, err, &missing)
+			assert.Equal(t, tt.param, missing.Param)
+		})
+	}
+}
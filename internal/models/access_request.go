@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessRequestStatus represents the lifecycle state of a just-in-time access request
+type AccessRequestStatus string
+
+const (
+	AccessRequestPending   AccessRequestStatus = "pending"
+	AccessRequestApproved  AccessRequestStatus = "approved"
+	AccessRequestRejected  AccessRequestStatus = "rejected"
+	AccessRequestCancelled AccessRequestStatus = "cancelled"
+	AccessRequestRevoked   AccessRequestStatus = "revoked"
+	AccessRequestExpired   AccessRequestStatus = "expired"
+)
+
+// AccessScopeType identifies what kind of privileged access is being requested
+type AccessScopeType string
+
+const (
+	// AccessScopeK8sExec grants pod exec in a cluster namespace
+	AccessScopeK8sExec AccessScopeType = "k8s_exec"
+	// AccessScopeWebSSH grants WebSSH to the hosts of a host group
+	AccessScopeWebSSH AccessScopeType = "webssh"
+)
+
+// JITRolePrefix prefixes the names of roles created for approved access requests
+const JITRolePrefix = "jit:"
+
+// AccessRequest represents a time-bound privileged access request
+// An approved request is materialized as a dedicated role bound to the
+// requester through user_roles with an expiry, so the normal RBAC path
+// (and the expiry sweeper) handles the grant lifecycle.
+type AccessRequest struct {
+	BaseModel
+
+	// Requester
+	RequesterID   uuid.UUID `gorm:"type:char(36);not null;index" json:"requester_id"`
+	RequesterName string    `gorm:"type:varchar(64)" json:"requester_name"`
+
+	// Requested scope
+	ScopeType AccessScopeType `gorm:"type:varchar(32);not null;index" json:"scope_type"`
+	Cluster   string          `gorm:"type:varchar(255)" json:"cluster,omitempty"`    // k8s_exec: cluster name
+	Namespace string          `gorm:"type:varchar(255)" json:"namespace,omitempty"`  // k8s_exec: namespace, "*" for all
+	HostGroup string          `gorm:"type:varchar(255)" json:"host_group,omitempty"` // webssh: host group name
+
+	Reason          string `gorm:"type:text;not null" json:"reason"`
+	DurationMinutes int    `gorm:"not null" json:"duration_minutes"`
+
+	// Lifecycle
+	Status       AccessRequestStatus `gorm:"type:varchar(32);not null;default:'pending';index" json:"status"`
+	ApproverID   *uuid.UUID          `gorm:"type:char(36)" json:"approver_id,omitempty"`
+	ApproverName string              `gorm:"type:varchar(64)" json:"approver_name,omitempty"`
+	DecisionNote string              `gorm:"type:text" json:"decision_note,omitempty"`
+	DecidedAt    *time.Time          `json:"decided_at,omitempty"`
+	ExpiresAt    *time.Time          `gorm:"index" json:"expires_at,omitempty"`
+	RevokedAt    *time.Time          `json:"revoked_at,omitempty"`
+
+	// Role created when the request was approved
+	RoleID *uuid.UUID `gorm:"type:char(36)" json:"role_id,omitempty"`
+}
+
+// TableName specifies the table name for AccessRequest
+func (AccessRequest) TableName() string {
+	return "access_requests"
+}
+
+// IsActive reports whether the request currently grants access
+func (r *AccessRequest) IsActive() bool {
+	if r.Status != AccessRequestApproved || r.ExpiresAt == nil {
+		return false
+	}
+	return time.Now().Before(*r.ExpiresAt)
+}
+
+// JITRoleName returns the name of the role backing this request
+func (r *AccessRequest) JITRoleName() string {
+	return JITRolePrefix + r.ID.String()
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revocation reasons recorded on agent certificates
+const (
+	CertRevokeSuperseded  = "superseded"   // A newer certificate was issued to the host
+	CertRevokeKeyRotated  = "key_rotated"  // The host secret key was regenerated
+	CertRevokeHostDeleted = "host_deleted" // The host was removed
+)
+
+// AgentCertificateAuthority is the built-in CA that signs agent client
+// certificates and the agent gRPC server certificate. Only one row exists.
+type AgentCertificateAuthority struct {
+	BaseModel
+
+	CertificatePEM string       `gorm:"type:text;not null" json:"certificate_pem"`
+	PrivateKeyPEM  SecretString `gorm:"type:text;not null" json:"-"`
+	NotAfter       time.Time    `json:"not_after"`
+}
+
+// TableName specifies the table name for AgentCertificateAuthority
+func (AgentCertificateAuthority) TableName() string {
+	return "agent_certificate_authorities"
+}
+
+// AgentCertificate records a client certificate issued to a host agent
+type AgentCertificate struct {
+	BaseModel
+
+	HostNodeID   uuid.UUID  `gorm:"type:char(36);index;not null" json:"host_node_id"`
+	SerialNumber string     `gorm:"uniqueIndex;not null" json:"serial_number"` // Hex encoded
+	Fingerprint  string     `gorm:"not null" json:"fingerprint"`               // SHA-256 of the DER certificate
+	NotBefore    time.Time  `json:"not_before"`
+	NotAfter     time.Time  `gorm:"index" json:"not_after"`
+	RevokedAt    *time.Time `gorm:"index" json:"revoked_at,omitempty"`
+	RevokeReason string     `json:"revoke_reason,omitempty"`
+}
+
+// TableName specifies the table name for AgentCertificate
+func (AgentCertificate) TableName() string {
+	return "agent_certificates"
+}
+
+// IsRevoked reports whether the certificate has been revoked
+func (c *AgentCertificate) IsRevoked() bool {
+	return c.RevokedAt != nil
+}
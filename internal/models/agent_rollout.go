@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Agent rollout statuses
+const (
+	RolloutStatusRunning   = "running"
+	RolloutStatusCompleted = "completed"
+	RolloutStatusFailed    = "failed"
+	RolloutStatusCancelled = "cancelled"
+)
+
+// Per-host upgrade statuses
+const (
+	RolloutHostPending    = "pending"     // Waiting for its phase or for the agent to come online
+	RolloutHostUpgrading  = "upgrading"   // Upgrade task sent, waiting for the agent to reconnect
+	RolloutHostSucceeded  = "succeeded"   // Agent reconnected with the target version
+	RolloutHostFailed     = "failed"      // Upgrade task failed or the agent did not come back
+	RolloutHostRolledBack = "rolled_back" // Agent reconnected with its previous version
+	RolloutHostSkipped    = "skipped"     // Already up to date, or the rollout stopped
+)
+
+// AgentRollout upgrades the agents of a set of hosts to one version. A
+// canary share of the hosts is upgraded first; the rest follows in batches
+// once every canary succeeded.
+type AgentRollout struct {
+	BaseModel
+
+	Version       string      `gorm:"not null;index" json:"version"`
+	HostGroups    StringArray `gorm:"type:text" json:"host_groups"` // Empty targets every group
+	HostIDs       StringArray `gorm:"type:text" json:"host_ids"`    // Explicit hosts, combined with HostGroups
+	CanaryPercent int         `gorm:"default:0" json:"canary_percent"`
+	BatchSize     int         `gorm:"default:10" json:"batch_size"` // Concurrent upgrades after the canary phase
+	Status        string      `gorm:"index;not null" json:"status"`
+	Message       string      `gorm:"type:text" json:"message,omitempty"`
+	CreatedBy     string      `json:"created_by"`
+	CompletedAt   *time.Time  `json:"completed_at,omitempty"`
+
+	Hosts []AgentRolloutHost `gorm:"foreignKey:RolloutID" json:"hosts,omitempty"`
+}
+
+// TableName specifies the table name for AgentRollout
+func (AgentRollout) TableName() string {
+	return "agent_rollouts"
+}
+
+// AgentRolloutHost is the upgrade status of one host in a rollout
+type AgentRolloutHost struct {
+	BaseModel
+
+	RolloutID    uuid.UUID  `gorm:"type:char(36);index;not null" json:"rollout_id"`
+	HostNodeID   uuid.UUID  `gorm:"type:char(36);index;not null" json:"host_node_id"`
+	HostName     string     `json:"host_name"`
+	Canary       bool       `gorm:"default:false" json:"canary"`
+	Status       string     `gorm:"index;not null" json:"status"`
+	FromVersion  string     `json:"from_version"`
+	TaskID       string     `gorm:"index" json:"task_id,omitempty"`
+	Error        string     `gorm:"type:text" json:"error,omitempty"`
+	DispatchedAt *time.Time `json:"dispatched_at,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+}
+
+// TableName specifies the table name for AgentRolloutHost
+func (AgentRolloutHost) TableName() string {
+	return "agent_rollout_hosts"
+}
+
+// IsFinished reports whether the host reached a final status
+func (h *AgentRolloutHost) IsFinished() bool {
+	switch h.Status {
+	case RolloutHostSucceeded, RolloutHostFailed, RolloutHostRolledBack, RolloutHostSkipped:
+		return true
+	}
+	return false
+}
@@ -1,11 +1,30 @@
 package models
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 )
 
+var (
+	// auditChainKey 哈希链的 HMAC 密钥，来自服务端配置而非数据库
+	// 仅能写数据库的攻击者无法重新计算出合法的链哈希
+	auditChainKeyMu sync.RWMutex
+	auditChainKey   []byte
+)
+
+// SetAuditChainKey 设置审计哈希链的 HMAC 密钥
+// 更换密钥后，已有事件将无法通过校验
+func SetAuditChainKey(key []byte) {
+	auditChainKeyMu.Lock()
+	defer auditChainKeyMu.Unlock()
+	auditChainKey = append([]byte(nil), key...)
+}
+
 // AuditEvent 统一的审计事件模型
 // 用途：记录所有关键操作的审计日志，支持追溯和合规审查。不可修改和删除。
 //
@@ -39,6 +58,12 @@ type AuditEvent struct {
 	// 自定义数据
 	Data map[string]string `gorm:"type:text;serializer:json" json:"data,omitempty"`
 
+	// 哈希链（防篡改）：Sequence 唯一且单调递增，Hash = HMAC-SHA256(密钥, PrevHash + 事件内容)
+	// 链外的历史事件 Sequence 为 NULL（读取为 0）、Hash 为空
+	Sequence int64  `gorm:"default:null;uniqueIndex:idx_audit_events_sequence" json:"sequence"`
+	PrevHash string `gorm:"type:varchar(64)" json:"prev_hash,omitempty"`
+	Hash     string `gorm:"type:varchar(64)" json:"hash,omitempty"`
+
 	// 时间戳（仅创建）
 	CreatedAt time.Time `gorm:"index" json:"created_at"`
 }
@@ -147,17 +172,19 @@ const (
 	ActionRevoked Action = "revoked"
 
 	// Host 管理操作 (T038)
-	ActionAgentConnected    Action = "agent_connected"
-	ActionAgentDisconnected Action = "agent_disconnected"
-	ActionAgentReconnected  Action = "agent_reconnected"
-	ActionTerminalCreated   Action = "terminal_created"
-	ActionTerminalClosed    Action = "terminal_closed"
-	ActionTerminalReplay    Action = "terminal_replay"
-	ActionNodeCreated       Action = "node_created"
-	ActionNodeUpdated       Action = "node_updated"
-	ActionNodeDeleted       Action = "node_deleted"
-	ActionSystemAlert       Action = "system_alert"
-	ActionSystemError       Action = "system_error"
+	ActionAgentConnected     Action = "agent_connected"
+	ActionAgentDisconnected  Action = "agent_disconnected"
+	ActionAgentReconnected   Action = "agent_reconnected"
+	ActionTerminalCreated    Action = "terminal_created"
+	ActionTerminalClosed     Action = "terminal_closed"
+	ActionTerminalReplay     Action = "terminal_replay"
+	ActionTerminalJoined     Action = "terminal_joined"
+	ActionTerminalTerminated Action = "terminal_terminated"
+	ActionNodeCreated        Action = "node_created"
+	ActionNodeUpdated        Action = "node_updated"
+	ActionNodeDeleted        Action = "node_deleted"
+	ActionSystemAlert        Action = "system_alert"
+	ActionSystemError        Action = "system_error"
 )
 
 // Validate 验证操作类型有效性
@@ -169,6 +196,7 @@ func (a Action) Validate() error {
 		// Host 管理操作
 		ActionAgentConnected, ActionAgentDisconnected, ActionAgentReconnected,
 		ActionTerminalCreated, ActionTerminalClosed, ActionTerminalReplay,
+		ActionTerminalJoined, ActionTerminalTerminated,
 		ActionNodeCreated, ActionNodeUpdated, ActionNodeDeleted,
 		ActionSystemAlert, ActionSystemError:
 		return nil
@@ -216,6 +244,9 @@ const (
 	// Host 资源（T038）
 	ResourceTypeHost ResourceType = "host"
 
+	// 终端会话资源
+	ResourceTypeTerminalSession ResourceType = "terminal_session"
+
 	// Docker 资源（T036-T037）
 	ResourceTypeDockerInstance  ResourceType = "docker_instance"
 	ResourceTypeDockerContainer ResourceType = "docker_container"
@@ -234,7 +265,7 @@ func (rt ResourceType) Validate() error {
 		ResourceTypeDatabase, ResourceTypeDatabaseInstance, ResourceTypeDatabaseUser,
 		ResourceTypeMinIO, ResourceTypeRedis, ResourceTypeMySQL, ResourceTypePostgreSQL,
 		ResourceTypeUser, ResourceTypeRole, ResourceTypeInstance,
-		ResourceTypeScheduledTask, ResourceTypeHost,
+		ResourceTypeScheduledTask, ResourceTypeHost, ResourceTypeTerminalSession,
 		// Docker 资源 (T036-T037)
 		ResourceTypeDockerInstance, ResourceTypeDockerContainer, ResourceTypeDockerImage,
 		ResourceTypeDockerNetwork, ResourceTypeDockerVolume, ResourceTypeDockerSystem,
@@ -311,6 +342,69 @@ func (ae *AuditEvent) Validate() error {
 	return nil
 }
 
+// chainContent 参与哈希计算的事件内容
+// CreatedAt 不参与计算：不同数据库的时间精度不同，Timestamp 已记录事件时间
+type chainContent struct {
+	ID            string            `json:"id"`
+	Sequence      int64             `json:"sequence"`
+	Timestamp     int64             `json:"timestamp"`
+	Action        Action            `json:"action"`
+	ResourceType  ResourceType      `json:"resource_type"`
+	Resource      Resource          `json:"resource"`
+	Subsystem     SubsystemType     `json:"subsystem"`
+	User          Principal         `json:"user"`
+	SpacePath     string            `json:"space_path"`
+	DiffObject    DiffObject        `json:"diff_object"`
+	ClientIP      string            `json:"client_ip"`
+	UserAgent     string            `json:"user_agent"`
+	RequestMethod string            `json:"request_method"`
+	RequestID     string            `json:"request_id"`
+	Data          map[string]string `json:"data"`
+}
+
+// ComputeHash 基于前一事件的哈希，使用 SetAuditChainKey 设置的密钥计算本事件的链哈希
+func (ae *AuditEvent) ComputeHash(prevHash string) (string, error) {
+	content, err := json.Marshal(chainContent{
+		ID:            ae.ID,
+		Sequence:      ae.Sequence,
+		Timestamp:     ae.Timestamp,
+		Action:        ae.Action,
+		ResourceType:  ae.ResourceType,
+		Resource:      ae.Resource,
+		Subsystem:     ae.Subsystem,
+		User:          ae.User,
+		SpacePath:     ae.SpacePath,
+		DiffObject:    ae.DiffObject,
+		ClientIP:      ae.ClientIP,
+		UserAgent:     ae.UserAgent,
+		RequestMethod: ae.RequestMethod,
+		RequestID:     ae.RequestID,
+		Data:          ae.Data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal audit event for hashing: %w", err)
+	}
+
+	auditChainKeyMu.RLock()
+	h := hmac.New(sha256.New, auditChainKey)
+	auditChainKeyMu.RUnlock()
+	h.Write([]byte(prevHash))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Chain 将事件链接到前一事件之后（设置 Sequence、PrevHash、Hash）
+func (ae *AuditEvent) Chain(prevSequence int64, prevHash string) error {
+	ae.Sequence = prevSequence + 1
+	ae.PrevHash = prevHash
+	hash, err := ae.ComputeHash(prevHash)
+	if err != nil {
+		return err
+	}
+	ae.Hash = hash
+	return nil
+}
+
 // MarshalOldObject 序列化 OldObject 到 JSON
 func (ae *AuditEvent) MarshalOldObject(obj interface{}) error {
 	if obj == nil {
@@ -74,17 +74,22 @@ const (
 	DockerActionTestConnection = "test_connection"
 
 	// Container operations
-	DockerActionListContainers    = "list_containers"
-	DockerActionGetContainer      = "get_container"
-	DockerActionStartContainer    = "start_container"
-	DockerActionStopContainer     = "stop_container"
-	DockerActionRestartContainer  = "restart_container"
-	DockerActionPauseContainer    = "pause_container"
-	DockerActionUnpauseContainer  = "unpause_container"
-	DockerActionDeleteContainer   = "delete_container"
-	DockerActionExecContainer     = "exec_container"
-	DockerActionGetContainerLogs  = "get_container_logs"
-	DockerActionGetContainerStats = "get_container_stats"
+	DockerActionListContainers     = "list_containers"
+	DockerActionGetContainer       = "get_container"
+	DockerActionStartContainer     = "start_container"
+	DockerActionStopContainer      = "stop_container"
+	DockerActionRestartContainer   = "restart_container"
+	DockerActionPauseContainer     = "pause_container"
+	DockerActionUnpauseContainer   = "unpause_container"
+	DockerActionDeleteContainer    = "delete_container"
+	DockerActionExecContainer      = "exec_container"
+	DockerActionGetContainerLogs   = "get_container_logs"
+	DockerActionGetContainerStats  = "get_container_stats"
+	DockerActionPruneContainers    = "prune_containers"
+	DockerActionUpdateContainer    = "update_container"
+	DockerActionListContainerFiles = "list_container_files"
+	DockerActionCopyFromContainer  = "copy_from_container"
+	DockerActionCopyToContainer    = "copy_to_container"
 
 	// Image operations
 	DockerActionListImages  = "list_images"
@@ -92,6 +97,7 @@ const (
 	DockerActionPullImage   = "pull_image"
 	DockerActionDeleteImage = "delete_image"
 	DockerActionTagImage    = "tag_image"
+	DockerActionPruneImages = "prune_images"
 
 	// Network operations
 	DockerActionListNetworks      = "list_networks"
@@ -109,11 +115,25 @@ const (
 	DockerActionPruneVolumes = "prune_volumes"
 
 	// System operations
-	DockerActionGetSystemInfo = "get_system_info"
-	DockerActionGetVersion    = "get_version"
-	DockerActionGetDiskUsage  = "get_disk_usage"
-	DockerActionPing          = "ping"
-	DockerActionGetEvents     = "get_events"
+	DockerActionGetSystemInfo   = "get_system_info"
+	DockerActionGetVersion      = "get_version"
+	DockerActionGetDiskUsage    = "get_disk_usage"
+	DockerActionPing            = "ping"
+	DockerActionGetEvents       = "get_events"
+	DockerActionPruneBuildCache = "prune_build_cache"
+
+	// Swarm operations
+	DockerActionUpdateSwarmNode         = "update_swarm_node"
+	DockerActionScaleSwarmService       = "scale_swarm_service"
+	DockerActionUpdateSwarmServiceImage = "update_swarm_service_image"
+	DockerActionRollbackSwarmService    = "rollback_swarm_service"
+	DockerActionDeleteSwarmService      = "delete_swarm_service"
+	DockerActionCreateSwarmSecret       = "create_swarm_secret"
+	DockerActionDeleteSwarmSecret       = "delete_swarm_secret"
+	DockerActionCreateSwarmConfig       = "create_swarm_config"
+	DockerActionDeleteSwarmConfig       = "delete_swarm_config"
+	DockerActionDeployStack             = "deploy_stack"
+	DockerActionRemoveStack             = "remove_stack"
 
 	// Terminal recording operations
 	DockerActionListRecordings  = "list_recordings"
@@ -124,13 +144,18 @@ const (
 
 // Docker-specific resource types (ResourceType field values)
 const (
-	DockerResourceTypeInstance  = "docker_instance"
-	DockerResourceTypeContainer = "docker_container"
-	DockerResourceTypeImage     = "docker_image"
-	DockerResourceTypeNetwork   = "docker_network"
-	DockerResourceTypeVolume    = "docker_volume"
-	DockerResourceTypeSystem    = "docker_system"
-	DockerResourceTypeRecording = "docker_recording"
+	DockerResourceTypeInstance     = "docker_instance"
+	DockerResourceTypeContainer    = "docker_container"
+	DockerResourceTypeImage        = "docker_image"
+	DockerResourceTypeNetwork      = "docker_network"
+	DockerResourceTypeVolume       = "docker_volume"
+	DockerResourceTypeSystem       = "docker_system"
+	DockerResourceTypeRecording    = "docker_recording"
+	DockerResourceTypeSwarmNode    = "docker_swarm_node"
+	DockerResourceTypeSwarmService = "docker_swarm_service"
+	DockerResourceTypeSwarmSecret  = "docker_swarm_secret"
+	DockerResourceTypeSwarmConfig  = "docker_swarm_config"
+	DockerResourceTypeStack        = "docker_stack"
 )
 
 // DockerOperationDetails contains Docker-specific operation details stored in Changes field
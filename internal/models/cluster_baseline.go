@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Baseline drift states
+const (
+	DriftStatusUnknown = "unknown" // Not checked yet
+	DriftStatusInSync  = "in_sync"
+	DriftStatusDrifted = "drifted"
+	DriftStatusError   = "error" // Last check could not reach the cluster
+)
+
+// ClusterBaseline is a saved, normalized snapshot of a namespace (or a set of
+// objects in it). Scheduled drift checks compare the live objects of the
+// target cluster against the snapshot.
+type ClusterBaseline struct {
+	ID          uuid.UUID `gorm:"type:char(36);primary_key" json:"id"`
+	Name        string    `gorm:"type:varchar(128);not null;uniqueIndex" json:"name"`
+	Description string    `gorm:"type:text" json:"description,omitempty"`
+
+	// Snapshot source
+	ClusterID uuid.UUID   `gorm:"type:char(36);not null;index" json:"cluster_id"`
+	Namespace string      `gorm:"type:varchar(100);not null" json:"namespace"`
+	Kinds     StringArray `gorm:"type:text" json:"kinds"`
+	Names     StringArray `gorm:"type:text" json:"names"` // "name" or "Kind/name"; empty selects all
+
+	// Drift check target; defaults to the snapshot source
+	TargetClusterID *uuid.UUID `gorm:"type:char(36);index" json:"target_cluster_id,omitempty"`
+	TargetNamespace string     `gorm:"type:varchar(100)" json:"target_namespace,omitempty"`
+
+	// Snapshot holds the normalized objects as JSON
+	Snapshot    string    `gorm:"type:text" json:"-"`
+	ObjectCount int       `json:"object_count"`
+	CapturedAt  time.Time `json:"captured_at"`
+
+	// Drift checking
+	DriftCheckEnabled bool       `gorm:"default:true;index" json:"drift_check_enabled"`
+	NotifyWebhookURL  string     `gorm:"type:varchar(512)" json:"notify_webhook_url,omitempty"`
+	DriftStatus       string     `gorm:"type:varchar(16);default:'unknown'" json:"drift_status"`
+	DriftSummary      string     `gorm:"type:text" json:"drift_summary,omitempty"` // JSON CompareSummary of the last check
+	LastError         string     `gorm:"type:text" json:"last_error,omitempty"`
+	LastCheckedAt     *time.Time `json:"last_checked_at,omitempty"`
+	LastDriftAt       *time.Time `json:"last_drift_at,omitempty"`
+
+	CreatedBy uuid.UUID `gorm:"type:char(36)" json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName overrides the table name
+func (ClusterBaseline) TableName() string {
+	return "cluster_baselines"
+}
+
+// BeforeCreate hook
+func (b *ClusterBaseline) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	if b.DriftStatus == "" {
+		b.DriftStatus = DriftStatusUnknown
+	}
+	return nil
+}
+
+// CheckTarget returns the cluster and namespace a drift check compares against
+func (b *ClusterBaseline) CheckTarget() (uuid.UUID, string) {
+	clusterID := b.ClusterID
+	if b.TargetClusterID != nil && *b.TargetClusterID != uuid.Nil {
+		clusterID = *b.TargetClusterID
+	}
+	namespace := b.Namespace
+	if b.TargetNamespace != "" {
+		namespace = b.TargetNamespace
+	}
+	return clusterID, namespace
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Cluster event sources
+const (
+	ClusterEventSourceEvent = "event" // core/v1 Event watched from the cluster
+	ClusterEventSourceAudit = "audit" // Entry received on the audit webhook
+)
+
+// ClusterEvent records a Kubernetes warning event or API server audit entry
+// collected from a cluster. Together with ResourceHistory (changes made through
+// tiga) it answers "what happened to resource X" for changes made anywhere.
+type ClusterEvent struct {
+	ID        uuid.UUID `gorm:"type:char(36);primary_key" json:"id"`
+	ClusterID uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_cluster_events_uid,priority:1" json:"cluster_id"`
+
+	// Source: event or audit
+	Source string `gorm:"type:varchar(16);not null;index" json:"source"`
+	// UID deduplicates entries: Event UID or audit ID
+	UID string `gorm:"type:varchar(64);not null;uniqueIndex:idx_cluster_events_uid,priority:2" json:"uid"`
+
+	// Involved object
+	Kind        string `gorm:"type:varchar(100);index" json:"kind"`
+	Namespace   string `gorm:"type:varchar(100)" json:"namespace"`
+	Name        string `gorm:"type:varchar(255)" json:"name"`
+	APIGroup    string `gorm:"type:varchar(100)" json:"api_group,omitempty"`
+	ObjectUID   string `gorm:"type:varchar(64)" json:"object_uid,omitempty"`
+	Subresource string `gorm:"type:varchar(64)" json:"subresource,omitempty"` // audit: exec, log, scale...
+
+	// Event fields
+	Type    string `gorm:"type:varchar(32)" json:"type,omitempty"` // Warning / Normal
+	Reason  string `gorm:"type:varchar(128)" json:"reason,omitempty"`
+	Message string `gorm:"type:text" json:"message,omitempty"`
+	Count   int32  `gorm:"default:1" json:"count"`
+
+	// Audit fields
+	Verb         string `gorm:"type:varchar(32)" json:"verb,omitempty"`
+	Username     string `gorm:"type:varchar(255);index" json:"username,omitempty"`
+	UserAgent    string `gorm:"type:varchar(512)" json:"user_agent,omitempty"`
+	SourceIPs    string `gorm:"type:varchar(512)" json:"source_ips,omitempty"` // Comma separated
+	ResponseCode int    `json:"response_code,omitempty"`
+
+	// FirstSeen/LastSeen bound the occurrence window (equal for audit entries)
+	FirstSeen time.Time `gorm:"index" json:"first_seen"`
+	LastSeen  time.Time `gorm:"index" json:"last_seen"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName overrides the table name
+func (ClusterEvent) TableName() string {
+	return "cluster_events"
+}
+
+// BeforeCreate hook
+func (e *ClusterEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// AfterMigrate creates the composite index used by resource timeline lookups
+func (ClusterEvent) AfterMigrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_cluster_events_object
+		ON cluster_events (cluster_id, kind, namespace, name, last_seen DESC)
+	`).Error
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DockerCleanupPolicy selects what the scheduled cleanup prunes on a
+// Docker instance
+type DockerCleanupPolicy struct {
+	BaseModelWithoutSoftDelete
+
+	InstanceID uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_docker_cleanup_policy_instance" json:"instance_id"`
+	Enabled    bool      `gorm:"index" json:"enabled"`
+
+	PruneContainers bool `json:"prune_containers"` // Stopped containers
+	PruneImages     bool `json:"prune_images"`     // Dangling images
+	PruneAllImages  bool `json:"prune_all_images"` // All unused images, implies PruneImages
+	PruneBuildCache bool `json:"prune_build_cache"`
+	PruneVolumes    bool `json:"prune_volumes"`   // Unused volumes, regardless of age
+	OlderThanDays   int  `json:"older_than_days"` // Only prune objects older than N days, 0 for any age
+	DryRun          bool `json:"dry_run"`         // Only report reclaimable space
+
+	// Result of the last run
+	LastRunAt          *time.Time `json:"last_run_at,omitempty"`
+	LastSpaceReclaimed int64      `json:"last_space_reclaimed"`
+	LastResult         string     `gorm:"type:text" json:"last_result,omitempty"`
+	LastError          string     `gorm:"type:text" json:"last_error,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (DockerCleanupPolicy) TableName() string {
+	return "docker_cleanup_policies"
+}
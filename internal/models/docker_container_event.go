@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Container event actions kept in the Docker event history
+const (
+	DockerContainerEventDie          = "die"
+	DockerContainerEventOOM          = "oom"
+	DockerContainerEventRestart      = "restart"
+	DockerContainerEventHealthStatus = "health_status"
+)
+
+// DockerContainerEvents lists the container event actions that are collected
+var DockerContainerEvents = []string{
+	DockerContainerEventDie,
+	DockerContainerEventOOM,
+	DockerContainerEventRestart,
+	DockerContainerEventHealthStatus,
+}
+
+// DockerContainerEvent records a container exit, OOM kill, restart or health
+// status change reported by the Docker engine of an instance. The history
+// answers "why did this container restart" after the fact.
+type DockerContainerEvent struct {
+	ID         uuid.UUID `gorm:"type:char(36);primary_key" json:"id"`
+	InstanceID uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_docker_container_events_uid,priority:1;index:idx_docker_container_events_instance,priority:1" json:"instance_id"`
+
+	// Container
+	ContainerID   string `gorm:"type:varchar(64);not null;uniqueIndex:idx_docker_container_events_uid,priority:2" json:"container_id"`
+	ContainerName string `gorm:"type:varchar(255);index" json:"container_name"`
+	Image         string `gorm:"type:varchar(512)" json:"image,omitempty"`
+
+	// Event fields
+	Action       string `gorm:"type:varchar(32);not null;uniqueIndex:idx_docker_container_events_uid,priority:3" json:"action"`
+	ExitCode     *int   `json:"exit_code,omitempty"`                             // die
+	HealthStatus string `gorm:"type:varchar(32)" json:"health_status,omitempty"` // health_status
+
+	// TimeNano is the engine timestamp; it deduplicates events replayed
+	// when the collector reconnects
+	TimeNano   int64     `gorm:"not null;uniqueIndex:idx_docker_container_events_uid,priority:4" json:"-"`
+	OccurredAt time.Time `gorm:"not null;index;index:idx_docker_container_events_instance,priority:2" json:"occurred_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName overrides the table name
+func (DockerContainerEvent) TableName() string {
+	return "docker_container_events"
+}
+
+// BeforeCreate hook
+func (e *DockerContainerEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
@@ -7,7 +7,14 @@ import (
 	"gorm.io/gorm"
 )
 
+// Docker instance connection types
+const (
+	DockerConnectionAgent  = "agent"  // Operations are forwarded to an agent
+	DockerConnectionDirect = "direct" // The server connects to the engine itself
+)
+
 // DockerInstance represents a Docker daemon instance managed through Agent
+// or reached directly over TCP+TLS or SSH
 type DockerInstance struct {
 	BaseModel
 	Name        string    `gorm:"not null;index;uniqueIndex:idx_docker_instance_name_agent" json:"name"`
@@ -15,6 +22,15 @@ type DockerInstance struct {
 	AgentID     uuid.UUID `gorm:"type:uuid;not null;index:idx_docker_instance_agent;uniqueIndex:idx_docker_instance_name_agent" json:"agent_id"`
 	HostID      uuid.UUID `gorm:"type:uuid;index" json:"host_id,omitempty"` // Optional: associated host node
 
+	// Direct connection settings (AgentID is uuid.Nil for direct instances)
+	ConnectionType string `gorm:"not null;default:'agent'" json:"connection_type"` // agent, direct
+	Endpoint       string `json:"endpoint,omitempty"`                              // tcp://host:port or ssh://user@host[:port][/socket]
+	TLSCACert      string `gorm:"type:text" json:"tls_ca_cert,omitempty"`
+	TLSCert        string `gorm:"type:text" json:"tls_cert,omitempty"`
+	TLSKey         string `gorm:"type:text" json:"-"`                      // Encrypted
+	SSHKey         string `gorm:"type:text" json:"-"`                      // Encrypted
+	SSHHostKey     string `gorm:"type:text" json:"ssh_host_key,omitempty"` // Trusted on first use when empty
+
 	// Health and connection status
 	HealthStatus    string    `gorm:"not null;index;default:'unknown'" json:"status"` // unknown, online, offline, archived
 	LastConnectedAt time.Time `json:"last_connected_at"`
@@ -31,6 +47,12 @@ type DockerInstance struct {
 	MemTotal        int64  `json:"mem_total"`
 	NCPU            int    `json:"n_cpu"`
 
+	// Swarm membership (updated from system info)
+	SwarmNodeState        string `json:"swarm_node_state"` // inactive, pending, active, error, locked
+	SwarmControlAvailable bool   `gorm:"default:false" json:"swarm_control_available"`
+	SwarmNodeID           string `json:"swarm_node_id,omitempty"`
+	SwarmClusterID        string `json:"swarm_cluster_id,omitempty"`
+
 	// Resource statistics (updated by health checks)
 	ContainerCount int `gorm:"default:0" json:"container_count"`
 	ImageCount     int `gorm:"default:0" json:"image_count"`
@@ -56,9 +78,17 @@ func (d *DockerInstance) BeforeCreate(tx *gorm.DB) error {
 	if d.HealthStatus == "" {
 		d.HealthStatus = "unknown"
 	}
+	if d.ConnectionType == "" {
+		d.ConnectionType = DockerConnectionAgent
+	}
 	return nil
 }
 
+// IsDirect checks if the server connects to the engine without an agent
+func (d *DockerInstance) IsDirect() bool {
+	return d.ConnectionType == DockerConnectionDirect
+}
+
 // IsOnline checks if the instance is currently online
 func (d *DockerInstance) IsOnline() bool {
 	return d.HealthStatus == "online"
@@ -74,6 +104,12 @@ func (d *DockerInstance) IsArchived() bool {
 	return d.HealthStatus == "archived"
 }
 
+// IsSwarmManager checks if the daemon is an active swarm manager, which is
+// required for swarm service and stack operations
+func (d *DockerInstance) IsSwarmManager() bool {
+	return d.SwarmNodeState == "active" && d.SwarmControlAvailable
+}
+
 // CanOperate checks if operations can be performed on this instance
 func (d *DockerInstance) CanOperate() bool {
 	return d.IsOnline()
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HostCustomMetric is a sample of a metric reported by an agent plugin
+// (exec script or scraped Prometheus endpoint)
+type HostCustomMetric struct {
+	TimeSeriesModel
+
+	HostNodeID uuid.UUID `gorm:"type:char(36);index:idx_host_metric_time,priority:1;not null" json:"host_node_id"`
+	Name       string    `gorm:"type:varchar(255);index:idx_host_metric_time,priority:2;not null" json:"name"`
+	Timestamp  time.Time `gorm:"index:idx_host_metric_time,priority:3;index;not null" json:"timestamp"`
+	Labels     JSONB     `gorm:"type:text" json:"labels"` // Label name to value, includes the plugin name
+	Value      float64   `json:"value"`
+}
+
+// TableName specifies the table name for HostCustomMetric
+func (HostCustomMetric) TableName() string {
+	return "host_custom_metrics"
+}
+
+// Matches reports whether the metric has all the given label values
+func (m *HostCustomMetric) Matches(labels map[string]string) bool {
+	for k, v := range labels {
+		if value, ok := m.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
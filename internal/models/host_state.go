@@ -1,6 +1,9 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -54,6 +57,14 @@ type HostState struct {
 	Temperatures string  `gorm:"type:text" json:"temperatures,omitempty"` // Temperature sensors (JSON array)
 	GPUUsage     float64 `json:"gpu_usage,omitempty"`                     // GPU usage percentage
 
+	// Breakdowns, not included in aggregated history
+	Disks         DiskPartitionStates `gorm:"type:text" json:"disks,omitempty"`          // Usage of each partition
+	NetInterfaces NetInterfaceStates  `gorm:"type:text" json:"net_interfaces,omitempty"` // Traffic of each network interface
+	TopProcesses  ProcessStates       `gorm:"type:text" json:"top_processes,omitempty"`  // Processes using the most resources
+
+	// Plugin metrics of this report, stored in host_custom_metrics
+	CustomMetrics []HostCustomMetric `gorm:"-" json:"custom_metrics,omitempty"`
+
 	// Relationship
 	HostNode *HostNode `gorm:"foreignKey:HostNodeID" json:"-"`
 }
@@ -62,3 +73,120 @@ type HostState struct {
 func (HostState) TableName() string {
 	return "host_states"
 }
+
+// HostStateDetailColumns are the breakdown columns of host_states
+var HostStateDetailColumns = []string{"disks", "net_interfaces", "top_processes"}
+
+// DiskPartitionState is the usage of a single partition
+type DiskPartitionState struct {
+	MountPoint  string  `json:"mount_point"`
+	Device      string  `json:"device"`
+	Fstype      string  `json:"fstype"`
+	Total       uint64  `json:"total"`        // Total space in bytes
+	Used        uint64  `json:"used"`         // Used space in bytes
+	Usage       float64 `json:"usage"`        // Usage percentage
+	InodesTotal uint64  `json:"inodes_total"` // Zero on Windows
+	InodesUsed  uint64  `json:"inodes_used"`
+	InodesUsage float64 `json:"inodes_usage"` // Inode usage percentage
+}
+
+// NetInterfaceState is the traffic of a single network interface
+type NetInterfaceState struct {
+	Name     string `json:"name"`
+	RxBytes  uint64 `json:"rx_bytes"` // Total bytes received
+	TxBytes  uint64 `json:"tx_bytes"` // Total bytes sent
+	RxSpeed  uint64 `json:"rx_speed"` // Receive speed in bytes/sec
+	TxSpeed  uint64 `json:"tx_speed"` // Send speed in bytes/sec
+	RxErrors uint64 `json:"rx_errors"`
+	TxErrors uint64 `json:"tx_errors"`
+}
+
+// ProcessState is the resource usage of a single process
+type ProcessState struct {
+	PID          int32   `json:"pid"`
+	Name         string  `json:"name"`
+	User         string  `json:"user"`
+	CPUUsage     float64 `json:"cpu_usage"`      // CPU usage percentage, above 100 on several cores
+	MemUsage     float64 `json:"mem_usage"`      // Memory usage percentage
+	MemRSS       uint64  `json:"mem_rss"`        // Resident memory in bytes
+	IOReadSpeed  uint64  `json:"io_read_speed"`  // Disk read speed in bytes/sec
+	IOWriteSpeed uint64  `json:"io_write_speed"` // Disk write speed in bytes/sec
+}
+
+// DiskPartitionStates is stored as TEXT in all databases (JSON array string)
+type DiskPartitionStates []DiskPartitionState
+
+// Scan implements the sql.Scanner interface for DiskPartitionStates
+func (d *DiskPartitionStates) Scan(value interface{}) error {
+	*d = nil
+	return scanJSONArray(value, d)
+}
+
+// Value implements the driver.Valuer interface for DiskPartitionStates
+func (d DiskPartitionStates) Value() (driver.Value, error) {
+	if len(d) == 0 {
+		return nil, nil
+	}
+	return jsonArrayValue(d)
+}
+
+// NetInterfaceStates is stored as TEXT in all databases (JSON array string)
+type NetInterfaceStates []NetInterfaceState
+
+// Scan implements the sql.Scanner interface for NetInterfaceStates
+func (n *NetInterfaceStates) Scan(value interface{}) error {
+	*n = nil
+	return scanJSONArray(value, n)
+}
+
+// Value implements the driver.Valuer interface for NetInterfaceStates
+func (n NetInterfaceStates) Value() (driver.Value, error) {
+	if len(n) == 0 {
+		return nil, nil
+	}
+	return jsonArrayValue(n)
+}
+
+// ProcessStates is stored as TEXT in all databases (JSON array string)
+type ProcessStates []ProcessState
+
+// Scan implements the sql.Scanner interface for ProcessStates
+func (p *ProcessStates) Scan(value interface{}) error {
+	*p = nil
+	return scanJSONArray(value, p)
+}
+
+// Value implements the driver.Valuer interface for ProcessStates
+func (p ProcessStates) Value() (driver.Value, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	return jsonArrayValue(p)
+}
+
+// scanJSONArray decodes a JSON array column into dest, leaving NULL as nil
+func scanJSONArray(value interface{}, dest interface{}) error {
+	var bytes []byte
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into %T", value, dest)
+	}
+	if len(bytes) == 0 {
+		return nil
+	}
+	return json.Unmarshal(bytes, dest)
+}
+
+func jsonArrayValue(v interface{}) (driver.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,259 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RollupTier is a downsampling tier of the host state history
+type RollupTier struct {
+	Name       string        // 1m/1h/1d
+	Resolution time.Duration // Bucket size
+	Table      string
+}
+
+var (
+	// RollupTierMinute holds 1-minute aggregates of raw host states
+	RollupTierMinute = RollupTier{Name: "1m", Resolution: time.Minute, Table: "host_states_1m"}
+	// RollupTierHour holds 1-hour aggregates of the 1-minute tier
+	RollupTierHour = RollupTier{Name: "1h", Resolution: time.Hour, Table: "host_states_1h"}
+	// RollupTierDay holds 1-day aggregates of the 1-hour tier
+	RollupTierDay = RollupTier{Name: "1d", Resolution: 24 * time.Hour, Table: "host_states_1d"}
+
+	// RollupTiers lists the tiers from the finest to the coarsest
+	RollupTiers = []RollupTier{RollupTierMinute, RollupTierHour, RollupTierDay}
+)
+
+// HostStateAggregate holds the aggregated values of the host states
+// reported within a bucket. Gauges keep their average, maximum and minimum
+// or just their average, counters keep their last value and traffic deltas
+// their sum.
+type HostStateAggregate struct {
+	Samples int `gorm:"not null" json:"samples"` // Raw states in the bucket
+
+	CPUUsage    float64 `json:"cpu_usage"`
+	CPUUsageMax float64 `json:"cpu_usage_max"`
+	CPUUsageMin float64 `json:"cpu_usage_min"`
+
+	MemUsage    float64 `json:"mem_usage"`
+	MemUsageMax float64 `json:"mem_usage_max"`
+	MemUsageMin float64 `json:"mem_usage_min"`
+
+	DiskUsage    float64 `json:"disk_usage"`
+	DiskUsageMax float64 `json:"disk_usage_max"`
+	DiskUsageMin float64 `json:"disk_usage_min"`
+
+	GPUUsage    float64 `json:"gpu_usage"`
+	GPUUsageMax float64 `json:"gpu_usage_max"`
+	GPUUsageMin float64 `json:"gpu_usage_min"`
+
+	Load1    float64 `json:"load_1"`
+	Load1Max float64 `json:"load_1_max"`
+	Load1Min float64 `json:"load_1_min"`
+	Load5    float64 `json:"load_5"`
+	Load15   float64 `json:"load_15"`
+
+	NetInSpeed     uint64 `json:"net_in_speed"`
+	NetInSpeedMax  uint64 `json:"net_in_speed_max"`
+	NetInSpeedMin  uint64 `json:"net_in_speed_min"`
+	NetOutSpeed    uint64 `json:"net_out_speed"`
+	NetOutSpeedMax uint64 `json:"net_out_speed_max"`
+	NetOutSpeedMin uint64 `json:"net_out_speed_min"`
+
+	MemUsed      uint64 `json:"mem_used"`
+	SwapUsed     uint64 `json:"swap_used"`
+	DiskUsed     uint64 `json:"disk_used"`
+	TCPConnCount uint64 `json:"tcp_conn_count"`
+	UDPConnCount uint64 `json:"udp_conn_count"`
+	ProcessCount uint64 `json:"process_count"`
+
+	NetInTransfer  uint64 `json:"net_in_transfer"`
+	NetOutTransfer uint64 `json:"net_out_transfer"`
+	TrafficSent    uint64 `json:"traffic_sent"`
+	TrafficRecv    uint64 `json:"traffic_recv"`
+	Uptime         uint64 `json:"uptime"`
+
+	TrafficDeltaSent uint64 `json:"traffic_delta_sent"`
+	TrafficDeltaRecv uint64 `json:"traffic_delta_recv"`
+}
+
+// HostStateRollup is a bucket of a downsampling tier. It is read and
+// written with the table of its tier.
+type HostStateRollup struct {
+	TimeSeriesModel
+
+	HostNodeID uuid.UUID `gorm:"type:char(36);not null" json:"host_node_id"`
+	Timestamp  time.Time `gorm:"not null" json:"timestamp"` // Bucket start
+
+	HostStateAggregate
+}
+
+// HostStateMinute is the schema of the 1-minute tier
+type HostStateMinute struct {
+	TimeSeriesModel
+
+	HostNodeID uuid.UUID `gorm:"type:char(36);uniqueIndex:idx_host_state_1m_bucket,priority:1;not null"`
+	Timestamp  time.Time `gorm:"uniqueIndex:idx_host_state_1m_bucket,priority:2;index;not null"`
+
+	HostStateAggregate
+}
+
+// TableName specifies the table name for HostStateMinute
+func (HostStateMinute) TableName() string {
+	return RollupTierMinute.Table
+}
+
+// HostStateHour is the schema of the 1-hour tier
+type HostStateHour struct {
+	TimeSeriesModel
+
+	HostNodeID uuid.UUID `gorm:"type:char(36);uniqueIndex:idx_host_state_1h_bucket,priority:1;not null"`
+	Timestamp  time.Time `gorm:"uniqueIndex:idx_host_state_1h_bucket,priority:2;index;not null"`
+
+	HostStateAggregate
+}
+
+// TableName specifies the table name for HostStateHour
+func (HostStateHour) TableName() string {
+	return RollupTierHour.Table
+}
+
+// HostStateDay is the schema of the 1-day tier
+type HostStateDay struct {
+	TimeSeriesModel
+
+	HostNodeID uuid.UUID `gorm:"type:char(36);uniqueIndex:idx_host_state_1d_bucket,priority:1;not null"`
+	Timestamp  time.Time `gorm:"uniqueIndex:idx_host_state_1d_bucket,priority:2;index;not null"`
+
+	HostStateAggregate
+}
+
+// TableName specifies the table name for HostStateDay
+func (HostStateDay) TableName() string {
+	return RollupTierDay.Table
+}
+
+// Add merges a raw state into the aggregate
+func (a *HostStateAggregate) Add(state *HostState) {
+	a.Merge(&HostStateAggregate{
+		Samples:          1,
+		CPUUsage:         state.CPUUsage,
+		CPUUsageMax:      state.CPUUsage,
+		CPUUsageMin:      state.CPUUsage,
+		MemUsage:         state.MemUsage,
+		MemUsageMax:      state.MemUsage,
+		MemUsageMin:      state.MemUsage,
+		DiskUsage:        state.DiskUsage,
+		DiskUsageMax:     state.DiskUsage,
+		DiskUsageMin:     state.DiskUsage,
+		GPUUsage:         state.GPUUsage,
+		GPUUsageMax:      state.GPUUsage,
+		GPUUsageMin:      state.GPUUsage,
+		Load1:            state.Load1,
+		Load1Max:         state.Load1,
+		Load1Min:         state.Load1,
+		Load5:            state.Load5,
+		Load15:           state.Load15,
+		NetInSpeed:       state.NetInSpeed,
+		NetInSpeedMax:    state.NetInSpeed,
+		NetInSpeedMin:    state.NetInSpeed,
+		NetOutSpeed:      state.NetOutSpeed,
+		NetOutSpeedMax:   state.NetOutSpeed,
+		NetOutSpeedMin:   state.NetOutSpeed,
+		MemUsed:          state.MemUsed,
+		SwapUsed:         state.SwapUsed,
+		DiskUsed:         state.DiskUsed,
+		TCPConnCount:     state.TCPConnCount,
+		UDPConnCount:     state.UDPConnCount,
+		ProcessCount:     state.ProcessCount,
+		NetInTransfer:    state.NetInTransfer,
+		NetOutTransfer:   state.NetOutTransfer,
+		TrafficSent:      state.TrafficSent,
+		TrafficRecv:      state.TrafficRecv,
+		Uptime:           state.Uptime,
+		TrafficDeltaSent: state.TrafficDeltaSent,
+		TrafficDeltaRecv: state.TrafficDeltaRecv,
+	})
+}
+
+// Merge merges a later aggregate into this one, weighting averages by
+// their samples
+func (a *HostStateAggregate) Merge(o *HostStateAggregate) {
+	if o.Samples <= 0 {
+		return
+	}
+	if a.Samples == 0 {
+		*a = *o
+		return
+	}
+
+	n, m := float64(a.Samples), float64(o.Samples)
+	avg := func(x, y float64) float64 { return (x*n + y*m) / (n + m) }
+	avgUint := func(x, y uint64) uint64 { return uint64(avg(float64(x), float64(y)) + 0.5) }
+
+	a.CPUUsage, a.CPUUsageMax, a.CPUUsageMin = avg(a.CPUUsage, o.CPUUsage), max(a.CPUUsageMax, o.CPUUsageMax), min(a.CPUUsageMin, o.CPUUsageMin)
+	a.MemUsage, a.MemUsageMax, a.MemUsageMin = avg(a.MemUsage, o.MemUsage), max(a.MemUsageMax, o.MemUsageMax), min(a.MemUsageMin, o.MemUsageMin)
+	a.DiskUsage, a.DiskUsageMax, a.DiskUsageMin = avg(a.DiskUsage, o.DiskUsage), max(a.DiskUsageMax, o.DiskUsageMax), min(a.DiskUsageMin, o.DiskUsageMin)
+	a.GPUUsage, a.GPUUsageMax, a.GPUUsageMin = avg(a.GPUUsage, o.GPUUsage), max(a.GPUUsageMax, o.GPUUsageMax), min(a.GPUUsageMin, o.GPUUsageMin)
+	a.Load1, a.Load1Max, a.Load1Min = avg(a.Load1, o.Load1), max(a.Load1Max, o.Load1Max), min(a.Load1Min, o.Load1Min)
+	a.Load5, a.Load15 = avg(a.Load5, o.Load5), avg(a.Load15, o.Load15)
+
+	a.NetInSpeed, a.NetInSpeedMax, a.NetInSpeedMin = avgUint(a.NetInSpeed, o.NetInSpeed), max(a.NetInSpeedMax, o.NetInSpeedMax), min(a.NetInSpeedMin, o.NetInSpeedMin)
+	a.NetOutSpeed, a.NetOutSpeedMax, a.NetOutSpeedMin = avgUint(a.NetOutSpeed, o.NetOutSpeed), max(a.NetOutSpeedMax, o.NetOutSpeedMax), min(a.NetOutSpeedMin, o.NetOutSpeedMin)
+
+	a.MemUsed, a.SwapUsed, a.DiskUsed = avgUint(a.MemUsed, o.MemUsed), avgUint(a.SwapUsed, o.SwapUsed), avgUint(a.DiskUsed, o.DiskUsed)
+	a.TCPConnCount, a.UDPConnCount = avgUint(a.TCPConnCount, o.TCPConnCount), avgUint(a.UDPConnCount, o.UDPConnCount)
+	a.ProcessCount = avgUint(a.ProcessCount, o.ProcessCount)
+
+	// Counters keep the latest value
+	a.NetInTransfer, a.NetOutTransfer = o.NetInTransfer, o.NetOutTransfer
+	a.TrafficSent, a.TrafficRecv = o.TrafficSent, o.TrafficRecv
+	a.Uptime = o.Uptime
+
+	a.TrafficDeltaSent += o.TrafficDeltaSent
+	a.TrafficDeltaRecv += o.TrafficDeltaRecv
+	a.Samples += o.Samples
+}
+
+// ToHostState converts the rollup to a host state carrying the average,
+// maximum ("max") or minimum ("min") of its gauges
+func (r *HostStateRollup) ToHostState(stat string) *HostState {
+	a := &r.HostStateAggregate
+	state := &HostState{
+		HostNodeID:       r.HostNodeID,
+		Timestamp:        r.Timestamp,
+		CPUUsage:         a.CPUUsage,
+		MemUsage:         a.MemUsage,
+		DiskUsage:        a.DiskUsage,
+		GPUUsage:         a.GPUUsage,
+		Load1:            a.Load1,
+		Load5:            a.Load5,
+		Load15:           a.Load15,
+		NetInSpeed:       a.NetInSpeed,
+		NetOutSpeed:      a.NetOutSpeed,
+		MemUsed:          a.MemUsed,
+		SwapUsed:         a.SwapUsed,
+		DiskUsed:         a.DiskUsed,
+		TCPConnCount:     a.TCPConnCount,
+		UDPConnCount:     a.UDPConnCount,
+		ProcessCount:     a.ProcessCount,
+		NetInTransfer:    a.NetInTransfer,
+		NetOutTransfer:   a.NetOutTransfer,
+		TrafficSent:      a.TrafficSent,
+		TrafficRecv:      a.TrafficRecv,
+		Uptime:           a.Uptime,
+		TrafficDeltaSent: a.TrafficDeltaSent,
+		TrafficDeltaRecv: a.TrafficDeltaRecv,
+	}
+
+	switch stat {
+	case "max":
+		state.CPUUsage, state.MemUsage, state.DiskUsage, state.GPUUsage = a.CPUUsageMax, a.MemUsageMax, a.DiskUsageMax, a.GPUUsageMax
+		state.Load1, state.NetInSpeed, state.NetOutSpeed = a.Load1Max, a.NetInSpeedMax, a.NetOutSpeedMax
+	case "min":
+		state.CPUUsage, state.MemUsage, state.DiskUsage, state.GPUUsage = a.CPUUsageMin, a.MemUsageMin, a.DiskUsageMin, a.GPUUsageMin
+		state.Load1, state.NetInSpeed, state.NetOutSpeed = a.Load1Min, a.NetInSpeedMin, a.NetOutSpeedMin
+	}
+	return state
+}
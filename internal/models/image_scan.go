@@ -0,0 +1,98 @@
+package models
+
+import "time"
+
+// Image scan statuses
+const (
+	ImageScanStatusCompleted = "completed"
+	ImageScanStatusFailed    = "failed"
+)
+
+// Vulnerability severities as reported by the scanners
+const (
+	VulnerabilitySeverityCritical = "CRITICAL"
+	VulnerabilitySeverityHigh     = "HIGH"
+	VulnerabilitySeverityMedium   = "MEDIUM"
+	VulnerabilitySeverityLow      = "LOW"
+	VulnerabilitySeverityUnknown  = "UNKNOWN"
+)
+
+// VulnerabilityCounts counts the vulnerabilities of an image per severity
+type VulnerabilityCounts struct {
+	Critical int `gorm:"default:0" json:"critical"`
+	High     int `gorm:"default:0" json:"high"`
+	Medium   int `gorm:"default:0" json:"medium"`
+	Low      int `gorm:"default:0" json:"low"`
+	Unknown  int `gorm:"default:0" json:"unknown"`
+}
+
+// Add counts a vulnerability of the given severity
+func (c *VulnerabilityCounts) Add(severity string) {
+	switch severity {
+	case VulnerabilitySeverityCritical:
+		c.Critical++
+	case VulnerabilitySeverityHigh:
+		c.High++
+	case VulnerabilitySeverityMedium:
+		c.Medium++
+	case VulnerabilitySeverityLow:
+		c.Low++
+	default:
+		c.Unknown++
+	}
+}
+
+// Total returns the number of vulnerabilities
+func (c VulnerabilityCounts) Total() int {
+	return c.Critical + c.High + c.Medium + c.Low + c.Unknown
+}
+
+// ImageVulnerability is a vulnerability found in a package of an image
+type ImageVulnerability struct {
+	ID               string `json:"id"` // e.g. CVE-2024-1234
+	Package          string `json:"package"`
+	InstalledVersion string `json:"installed_version"`
+	FixedVersion     string `json:"fixed_version,omitempty"`
+	Severity         string `json:"severity"`
+	Title            string `json:"title,omitempty"`
+}
+
+// ImagePackage is a package of the software bill of materials of an image
+type ImagePackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Type    string `json:"type,omitempty"` // e.g. debian, gobinary, npm
+}
+
+// ImageScan is the latest scan result of an image, cached by digest
+type ImageScan struct {
+	BaseModel
+
+	Digest    string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_image_scan_digest" json:"digest"`
+	Image     string    `gorm:"type:varchar(512);not null" json:"image"` // Reference that was scanned
+	Scanner   string    `gorm:"type:varchar(32)" json:"scanner"`
+	Status    string    `gorm:"type:varchar(20);not null;index" json:"status"`
+	Error     string    `gorm:"type:text" json:"error,omitempty"`
+	ScannedAt time.Time `gorm:"index" json:"scanned_at"`
+
+	VulnerabilityCounts
+
+	Vulnerabilities []ImageVulnerability `gorm:"type:text;serializer:json" json:"vulnerabilities,omitempty"`
+	Packages        []ImagePackage       `gorm:"type:text;serializer:json" json:"packages,omitempty"` // SBOM, when the scanner reports it
+}
+
+// TableName overrides the default table name.
+func (ImageScan) TableName() string {
+	return "image_scans"
+}
+
+// CriticalIDs returns the IDs of the critical vulnerabilities
+func (s *ImageScan) CriticalIDs() map[string]bool {
+	ids := make(map[string]bool)
+	for _, v := range s.Vulnerabilities {
+		if v.Severity == VulnerabilitySeverityCritical {
+			ids[v.ID+"/"+v.Package] = true
+		}
+	}
+	return ids
+}
@@ -18,8 +18,10 @@ const (
 type AlertType string
 
 const (
-	AlertTypeHost    AlertType = "host"
-	AlertTypeService AlertType = "service"
+	AlertTypeHost     AlertType = "host"
+	AlertTypeService  AlertType = "service"
+	AlertTypeDocker   AlertType = "docker"
+	AlertTypeBaseline AlertType = "baseline"
 )
 
 // MonitorAlertRule represents an alert rule configuration
@@ -28,8 +30,8 @@ type MonitorAlertRule struct {
 
 	// Basic information
 	Name     string        `gorm:"not null" json:"name"`
-	Type     AlertType     `gorm:"not null;index" json:"type"`                    // host/service
-	TargetID uuid.UUID     `gorm:"type:char(36);index;not null" json:"target_id"` // HostNode ID or ServiceMonitor ID
+	Type     AlertType     `gorm:"not null;index" json:"type"`                    // host/service/docker/baseline
+	TargetID uuid.UUID     `gorm:"type:char(36);index;not null" json:"target_id"` // HostNode, ServiceMonitor, DockerInstance or ClusterBaseline ID
 	Severity AlertSeverity `gorm:"not null;index" json:"severity"`
 
 	// Condition expression (using antonmedv/expr)
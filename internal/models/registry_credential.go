@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RegistryCredential is a login to a container registry, used to pull
+// images on Docker instances and to list tags of private repositories.
+// A credential without instances and team applies to every instance.
+type RegistryCredential struct {
+	BaseModel
+
+	Name        string `gorm:"type:varchar(100);not null;uniqueIndex:idx_registry_credential_name" json:"name"`
+	Registry    string `gorm:"type:varchar(255);not null;index:idx_registry_credential_registry" json:"registry"` // e.g. docker.io, ghcr.io
+	Username    string `gorm:"type:varchar(255);not null" json:"username"`
+	Password    string `gorm:"type:text" json:"-"` // encrypted password or access token
+	Description string `gorm:"type:varchar(500)" json:"description"`
+
+	// Scope
+	InstanceIDs StringArray `gorm:"type:text" json:"instance_ids"` // Docker instances the credential applies to
+	Team        string      `gorm:"type:varchar(64)" json:"team"`  // Docker instances tagged with the team
+
+	// Result of the last login test
+	LastTestedAt  *time.Time `json:"last_tested_at,omitempty"`
+	LastTestError string     `gorm:"type:text" json:"last_test_error,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (RegistryCredential) TableName() string {
+	return "registry_credentials"
+}
+
+// IsGlobal reports whether the credential applies to every instance
+func (c *RegistryCredential) IsGlobal() bool {
+	return len(c.InstanceIDs) == 0 && c.Team == ""
+}
+
+// AppliesTo reports whether the credential may be used on a Docker instance
+func (c *RegistryCredential) AppliesTo(instance *DockerInstance) bool {
+	if c.IsGlobal() {
+		return true
+	}
+	if c.HasInstance(instance.ID) {
+		return true
+	}
+	if c.Team == "" {
+		return false
+	}
+	for _, tag := range instance.Tags {
+		if tag == c.Team {
+			return true
+		}
+	}
+	return false
+}
+
+// HasInstance reports whether the credential is scoped to an instance
+func (c *RegistryCredential) HasInstance(id uuid.UUID) bool {
+	for _, instanceID := range c.InstanceIDs {
+		if instanceID == id.String() {
+			return true
+		}
+	}
+	return false
+}
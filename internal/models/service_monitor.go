@@ -1,10 +1,15 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/pkg/healthcheck"
 )
 
 // ProbeType represents the type of service probe
@@ -14,6 +19,11 @@ const (
 	ProbeTypeHTTP ProbeType = "HTTP"
 	ProbeTypeTCP  ProbeType = "TCP"
 	ProbeTypeICMP ProbeType = "ICMP"
+	ProbeTypeDNS  ProbeType = "DNS"
+	ProbeTypeGRPC ProbeType = "GRPC" // grpc.health.v1 health check
+	ProbeTypeTLS  ProbeType = "TLS"  // TLS handshake and certificate check only
+
+	ProbeTypeSynthetic ProbeType = "SYNTHETIC" // Multi-step HTTP transaction
 )
 
 // ProbeStrategy represents how to select probe nodes
@@ -50,11 +60,29 @@ type ServiceMonitor struct {
 	HTTPBody     string `gorm:"type:text" json:"http_body,omitempty"`
 	ExpectStatus int    `json:"expect_status,omitempty"` // Expected HTTP status code
 	ExpectBody   string `json:"expect_body,omitempty"`   // Expected response body substring
+	JSONPath     string `json:"json_path,omitempty"`     // JSONPath assertion on the response body, e.g. $.status
+	JSONExpect   string `json:"json_expect,omitempty"`   // Expected value at JSONPath (any non-null value when empty)
 
 	// TCP-specific configuration
 	TCPSend   string `json:"tcp_send,omitempty"`   // Data to send
 	TCPExpect string `json:"tcp_expect,omitempty"` // Expected response
 
+	// DNS-specific configuration
+	DNSRecordType string `json:"dns_record_type,omitempty"` // A/AAAA/CNAME/MX/NS/TXT/SRV/PTR, default A
+	DNSExpect     string `json:"dns_expect,omitempty"`      // Comma-separated answers that must be present
+	DNSResolver   string `json:"dns_resolver,omitempty"`    // Custom nameserver host[:port]
+
+	// gRPC-specific configuration
+	GRPCService string `json:"grpc_service,omitempty"` // Service name for the health check, empty for the server
+	GRPCTLS     bool   `json:"grpc_tls,omitempty"`     // Connect with TLS
+
+	// TLS-specific configuration
+	TLSServerName       string `json:"tls_server_name,omitempty"`        // SNI and verification name, default target host
+	TLSMinDaysRemaining int    `json:"tls_min_days_remaining,omitempty"` // Fail when the certificate expires sooner
+
+	// Synthetic-specific configuration
+	SyntheticSteps SyntheticSteps `gorm:"type:text" json:"synthetic_steps,omitempty"` // Ordered HTTP steps
+
 	// Alert configuration
 	NotifyOnFailure   bool `gorm:"default:true" json:"notify_on_failure"`
 	FailureThreshold  int  `gorm:"default:3" json:"failure_threshold"`  // Consecutive failures before alert
@@ -128,3 +156,45 @@ func (s *ServiceMonitor) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// SyntheticSteps is stored as TEXT in all databases (JSON array string)
+type SyntheticSteps []healthcheck.SyntheticStep
+
+// Scan implements the sql.Scanner interface for SyntheticSteps
+func (s *SyntheticSteps) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return errors.New("failed to unmarshal SyntheticSteps value")
+	}
+
+	var result SyntheticSteps
+	if len(bytes) > 0 {
+		if err := json.Unmarshal(bytes, &result); err != nil {
+			return err
+		}
+	}
+	*s = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface for SyntheticSteps
+func (s SyntheticSteps) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -27,6 +27,9 @@ type ServiceProbeResult struct {
 	// TCP-specific result
 	TCPResponse string `gorm:"type:text" json:"tcp_response,omitempty"`
 
+	// Synthetic-specific result, saved together with the probe result
+	Steps []ServiceProbeStepResult `gorm:"foreignKey:ProbeResultID" json:"steps,omitempty"`
+
 	// Relationships
 	ServiceMonitor *ServiceMonitor `gorm:"foreignKey:ServiceMonitorID" json:"-"`
 	HostNode       *HostNode       `gorm:"foreignKey:HostNodeID" json:"-"`
@@ -49,3 +52,22 @@ func (s *ServiceProbeResult) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// ServiceProbeStepResult is the outcome of one step of a synthetic monitor run
+type ServiceProbeStepResult struct {
+	BaseModel
+
+	ProbeResultID    uuid.UUID `gorm:"type:char(36);index;not null" json:"probe_result_id"`
+	ServiceMonitorID uuid.UUID `gorm:"type:char(36);index;not null" json:"service_monitor_id"`
+	StepIndex        int       `json:"step_index"`
+	Name             string    `json:"name"`
+	Success          bool      `json:"success"`
+	Latency          int       `json:"latency"` // Latency in milliseconds
+	HTTPStatusCode   int       `json:"http_status_code,omitempty"`
+	ErrorMessage     string    `gorm:"type:text" json:"error_message,omitempty"`
+}
+
+// TableName specifies the table name for ServiceProbeStepResult
+func (ServiceProbeStepResult) TableName() string {
+	return "service_probe_step_results"
+}
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusPage is an unauthenticated page showing the health of selected
+// service monitors and hosts
+type StatusPage struct {
+	BaseModel
+
+	Slug        string             `gorm:"type:varchar(100);uniqueIndex;not null" json:"slug"` // Public URL path
+	Title       string             `gorm:"not null" json:"title"`
+	Description string             `gorm:"type:text" json:"description"`
+	Published   bool               `gorm:"default:false;index" json:"published"` // Unpublished pages are not served publicly
+	Sections    StatusPageSections `gorm:"type:text" json:"sections"`
+}
+
+// TableName specifies the table name for StatusPage
+func (StatusPage) TableName() string {
+	return "status_pages"
+}
+
+// StatusPageSection groups components shown on a status page
+type StatusPageSection struct {
+	Name       string      `json:"name"`
+	MonitorIDs []uuid.UUID `json:"monitor_ids"`
+	HostIDs    []uuid.UUID `json:"host_ids"`
+}
+
+// StatusPageSections is stored as TEXT in all databases (JSON array string)
+type StatusPageSections []StatusPageSection
+
+// Scan implements the sql.Scanner interface for StatusPageSections
+func (s *StatusPageSections) Scan(value interface{}) error {
+	if value == nil {
+		*s = StatusPageSections{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return errors.New("failed to unmarshal StatusPageSections value")
+	}
+
+	var result StatusPageSections
+	if len(bytes) > 0 {
+		if err := json.Unmarshal(bytes, &result); err != nil {
+			return err
+		}
+	}
+	*s = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface for StatusPageSections
+func (s StatusPageSections) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// IncidentStatus represents the lifecycle state of a status page incident
+type IncidentStatus string
+
+const (
+	IncidentStatusInvestigating IncidentStatus = "investigating"
+	IncidentStatusIdentified    IncidentStatus = "identified"
+	IncidentStatusMonitoring    IncidentStatus = "monitoring"
+	IncidentStatusResolved      IncidentStatus = "resolved"
+)
+
+// IsValid reports whether the status is a known incident status
+func (s IncidentStatus) IsValid() bool {
+	switch s {
+	case IncidentStatusInvestigating, IncidentStatusIdentified, IncidentStatusMonitoring, IncidentStatusResolved:
+		return true
+	}
+	return false
+}
+
+// IncidentImpact represents how severely an incident affects customers
+type IncidentImpact string
+
+const (
+	IncidentImpactNone     IncidentImpact = "none"
+	IncidentImpactMinor    IncidentImpact = "minor"
+	IncidentImpactMajor    IncidentImpact = "major"
+	IncidentImpactCritical IncidentImpact = "critical"
+)
+
+// IsValid reports whether the impact is a known incident impact
+func (i IncidentImpact) IsValid() bool {
+	switch i {
+	case IncidentImpactNone, IncidentImpactMinor, IncidentImpactMajor, IncidentImpactCritical:
+		return true
+	}
+	return false
+}
+
+// StatusIncident is an incident post shown on a status page
+type StatusIncident struct {
+	BaseModel
+
+	StatusPageID uuid.UUID      `gorm:"type:char(36);not null;index" json:"status_page_id"`
+	Title        string         `gorm:"not null" json:"title"`
+	Status       IncidentStatus `gorm:"type:varchar(32);not null;index" json:"status"`
+	Impact       IncidentImpact `gorm:"type:varchar(32);not null" json:"impact"`
+	StartedAt    time.Time      `gorm:"index;not null" json:"started_at"`
+	ResolvedAt   *time.Time     `json:"resolved_at,omitempty"`
+	CreatedBy    uuid.UUID      `gorm:"type:char(36)" json:"created_by"`
+
+	// Updates are ordered newest first
+	Updates []StatusIncidentUpdate `gorm:"foreignKey:IncidentID" json:"updates"`
+}
+
+// TableName specifies the table name for StatusIncident
+func (StatusIncident) TableName() string {
+	return "status_incidents"
+}
+
+// StatusIncidentUpdate is a timestamped progress message of an incident
+type StatusIncidentUpdate struct {
+	BaseModel
+
+	IncidentID uuid.UUID      `gorm:"type:char(36);not null;index" json:"incident_id"`
+	Status     IncidentStatus `gorm:"type:varchar(32);not null" json:"status"`
+	Message    string         `gorm:"type:text;not null" json:"message"`
+	CreatedBy  uuid.UUID      `gorm:"type:char(36)" json:"created_by"`
+}
+
+// TableName specifies the table name for StatusIncidentUpdate
+func (StatusIncidentUpdate) TableName() string {
+	return "status_incident_updates"
+}
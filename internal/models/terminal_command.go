@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TerminalCommand is a command line submitted during a recorded terminal
+// session. Commands are extracted from the recording and indexed so that
+// they can be searched across sessions, and Offset locates the moment in
+// the recording for playback.
+type TerminalCommand struct {
+	ID          uuid.UUID `gorm:"type:char(36);primary_key" json:"id"`
+	RecordingID uuid.UUID `gorm:"type:char(36);not null;index:idx_terminal_commands_recording,priority:1" json:"recording_id"`
+	Sequence    int       `gorm:"not null;index:idx_terminal_commands_recording,priority:2" json:"sequence"`
+
+	// Offset is the number of seconds from the start of the recording
+	Offset     float64   `gorm:"not null" json:"offset"`
+	ExecutedAt time.Time `gorm:"not null;index" json:"executed_at"`
+	Command    string    `gorm:"type:text;not null" json:"command"`
+
+	// Copied from the recording for filtering
+	UserID        uuid.UUID `gorm:"type:char(36);not null;index" json:"user_id"`
+	Username      string    `gorm:"type:varchar(255)" json:"username"`
+	RecordingType string    `gorm:"type:varchar(50);index" json:"recording_type"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName overrides the table name
+func (TerminalCommand) TableName() string {
+	return "terminal_commands"
+}
+
+// BeforeCreate hook
+func (c *TerminalCommand) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
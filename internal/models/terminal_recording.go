@@ -46,6 +46,10 @@ type TerminalRecording struct {
 	Description string `gorm:"type:text" json:"description,omitempty"`
 	Tags        string `gorm:"type:text" json:"tags,omitempty"` // Comma-separated tags
 
+	// CommandsIndexedAt is set once the commands of the recording have been
+	// extracted into terminal_commands
+	CommandsIndexedAt *time.Time `json:"commands_indexed_at,omitempty"`
+
 	// Relations
 	Instance *DockerInstance `gorm:"foreignKey:InstanceID" json:"instance,omitempty"`
 }
@@ -127,6 +131,9 @@ type RecordingMetadata struct {
 	Description   string          `json:"description,omitempty"`
 	Tags          string          `json:"tags,omitempty"`
 
+	// Command index
+	CommandsIndexedAt *time.Time `json:"commands_indexed_at,omitempty"`
+
 	// Legacy fields for backward compatibility
 	InstanceID    uuid.UUID       `json:"instance_id,omitempty"`
 	ContainerID   string          `json:"container_id,omitempty"`
@@ -155,6 +162,8 @@ func (r *TerminalRecording) ToMetadata() *RecordingMetadata {
 		Tags:          r.Tags,
 		InstanceID:    r.InstanceID,
 		ContainerID:   r.ContainerID,
+
+		CommandsIndexedAt: r.CommandsIndexedAt,
 	}
 }
 
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"gorm.io/gorm"
@@ -52,6 +54,41 @@ type AuditEventRepository interface {
 	// DeleteOlderThan 删除指定时间之前的审计事件
 	// 用于审计日志清理任务
 	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+
+	// ListChain 按 Sequence 升序返回 afterSequence 之后的链内事件（用于哈希链校验）
+	ListChain(ctx context.Context, afterSequence int64, limit int) ([]*models.AuditEvent, error)
+}
+
+// AuditEventHook 在审计事件成功写入后被调用（如转发到外部 sink）
+// 钩子在写入协程中同步执行，实现方不应阻塞
+type AuditEventHook func(ctx context.Context, events []*models.AuditEvent)
+
+// maxAuditChainAttempts 链尾被其他写入者抢占时的最大尝试次数
+const maxAuditChainAttempts = 5
+
+var (
+	// auditChainMu 串行化本进程内的链尾读取与写入，减少 Sequence 冲突重试
+	// 跨进程的串行化由 Sequence 唯一索引保证
+	auditChainMu sync.Mutex
+
+	auditHooksMu sync.RWMutex
+	auditHooks   []AuditEventHook
+)
+
+// RegisterAuditEventHook 注册审计事件写入后的钩子
+func RegisterAuditEventHook(hook AuditEventHook) {
+	auditHooksMu.Lock()
+	defer auditHooksMu.Unlock()
+	auditHooks = append(auditHooks, hook)
+}
+
+func runAuditEventHooks(ctx context.Context, events []*models.AuditEvent) {
+	auditHooksMu.RLock()
+	hooks := auditHooks
+	auditHooksMu.RUnlock()
+	for _, hook := range hooks {
+		hook(ctx, events)
+	}
 }
 
 // auditEventRepository 审计事件仓储实现
@@ -71,7 +108,7 @@ func (r *auditEventRepository) Create(ctx context.Context, event *models.AuditEv
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+	if err := r.createChained(ctx, []*models.AuditEvent{event}); err != nil {
 		return fmt.Errorf("failed to create audit event: %w", err)
 	}
 
@@ -94,13 +131,62 @@ func (r *auditEventRepository) BatchCreate(ctx context.Context, events []*models
 	}
 
 	// 批量插入
-	if err := r.db.WithContext(ctx).Create(events).Error; err != nil {
+	if err := r.createChained(ctx, events); err != nil {
 		return fmt.Errorf("failed to batch create audit events: %w", err)
 	}
 
 	return nil
 }
 
+// createChained 在事务内将事件链接到当前链尾并写入，成功后触发钩子
+// Sequence 上的唯一索引保证并发写入者（包括其他实例）不会产生分叉：
+// 冲突的一方回滚后重新读取链尾再写入
+func (r *auditEventRepository) createChained(ctx context.Context, events []*models.AuditEvent) error {
+	auditChainMu.Lock()
+	var err error
+	for attempt := 0; attempt < maxAuditChainAttempts; attempt++ {
+		err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var tail models.AuditEvent
+			result := tx.Select("sequence", "hash").
+				Where("sequence > 0").
+				Order("sequence DESC").
+				Limit(1).
+				Find(&tail)
+			if result.Error != nil {
+				return fmt.Errorf("failed to load audit chain tail: %w", result.Error)
+			}
+
+			prevSequence, prevHash := tail.Sequence, tail.Hash
+			for _, event := range events {
+				if err := event.Chain(prevSequence, prevHash); err != nil {
+					return err
+				}
+				prevSequence, prevHash = event.Sequence, event.Hash
+			}
+
+			return tx.Create(events).Error
+		})
+		if err == nil || !r.isDuplicatedKey(err) {
+			break
+		}
+	}
+	auditChainMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	runAuditEventHooks(ctx, events)
+	return nil
+}
+
+// isDuplicatedKey 判断错误是否为唯一约束冲突
+func (r *auditEventRepository) isDuplicatedKey(err error) bool {
+	if translator, ok := r.db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
 // CreateBatch 批量创建（实现 audit.AuditRepository[*models.AuditEvent] 接口）
 // T036-T037: MinIO 和 Database 迁移需要此方法
 // 这是 BatchCreate 的别名，用于满足 audit.AuditRepository 接口
@@ -179,6 +265,20 @@ func (r *auditEventRepository) DeleteOlderThan(ctx context.Context, before time.
 	return result.RowsAffected, nil
 }
 
+// ListChain 按 Sequence 升序返回 afterSequence 之后的链内事件
+func (r *auditEventRepository) ListChain(ctx context.Context, afterSequence int64, limit int) ([]*models.AuditEvent, error) {
+	var events []*models.AuditEvent
+	if err := r.db.WithContext(ctx).
+		Where("sequence > ?", afterSequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list audit chain: %w", err)
+	}
+
+	return events, nil
+}
+
 // applyFilters 应用过滤条件到查询
 // 统一的过滤逻辑，供 List 和 Count 方法使用
 func (r *auditEventRepository) applyFilters(query *gorm.DB, filter map[string]interface{}) *gorm.DB {
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// ClusterBaselineRepository handles saved cluster baselines
+type ClusterBaselineRepository struct {
+	db *gorm.DB
+}
+
+// NewClusterBaselineRepository creates a new cluster baseline repository
+func NewClusterBaselineRepository(db *gorm.DB) *ClusterBaselineRepository {
+	return &ClusterBaselineRepository{db: db}
+}
+
+// Create creates a new baseline
+func (r *ClusterBaselineRepository) Create(ctx context.Context, baseline *models.ClusterBaseline) error {
+	return r.db.WithContext(ctx).Create(baseline).Error
+}
+
+// GetByID retrieves a baseline by ID
+func (r *ClusterBaselineRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ClusterBaseline, error) {
+	var baseline models.ClusterBaseline
+	if err := r.db.WithContext(ctx).First(&baseline, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &baseline, nil
+}
+
+// List retrieves all baselines, optionally limited to one source or target cluster
+func (r *ClusterBaselineRepository) List(ctx context.Context, clusterID *uuid.UUID) ([]*models.ClusterBaseline, error) {
+	query := r.db.WithContext(ctx).Model(&models.ClusterBaseline{})
+	if clusterID != nil {
+		query = query.Where("cluster_id = ? OR target_cluster_id = ?", *clusterID, *clusterID)
+	}
+
+	var baselines []*models.ClusterBaseline
+	if err := query.Order("name ASC").Find(&baselines).Error; err != nil {
+		return nil, err
+	}
+	return baselines, nil
+}
+
+// ListDriftCheckEnabled retrieves baselines with scheduled drift checks enabled
+func (r *ClusterBaselineRepository) ListDriftCheckEnabled(ctx context.Context) ([]*models.ClusterBaseline, error) {
+	var baselines []*models.ClusterBaseline
+	if err := r.db.WithContext(ctx).Where("drift_check_enabled = ?", true).Find(&baselines).Error; err != nil {
+		return nil, err
+	}
+	return baselines, nil
+}
+
+// Update saves all fields of a baseline
+func (r *ClusterBaselineRepository) Update(ctx context.Context, baseline *models.ClusterBaseline) error {
+	return r.db.WithContext(ctx).Save(baseline).Error
+}
+
+// Delete deletes a baseline
+func (r *ClusterBaselineRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.ClusterBaseline{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
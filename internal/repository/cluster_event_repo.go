@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// ClusterEventFilter defines filter for cluster event queries
+type ClusterEventFilter struct {
+	Source    string
+	Kinds     []string // Any of, case-insensitive (e.g. "Deployment", "deployments")
+	Namespace string
+	Name      string
+	// IncludeRelated also matches objects of any kind named "<Name>-..."
+	// (ReplicaSets, Pods owned by a Deployment)
+	IncludeRelated bool
+	Type           string
+	Username       string
+	Query          string // Substring of reason or message
+	Since          *time.Time
+	Until          *time.Time
+	Page           int
+	PageSize       int
+}
+
+// ClusterEventRepository handles collected cluster events and audit entries
+type ClusterEventRepository struct {
+	db *gorm.DB
+}
+
+// NewClusterEventRepository creates a new cluster event repository
+func NewClusterEventRepository(db *gorm.DB) *ClusterEventRepository {
+	return &ClusterEventRepository{db: db}
+}
+
+// Upsert inserts an event or, when the same (cluster, uid) was already stored,
+// refreshes its count, message and last-seen time
+func (r *ClusterEventRepository) Upsert(ctx context.Context, event *models.ClusterEvent) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}, {Name: "uid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"type", "reason", "message", "count", "last_seen", "updated_at"}),
+	}).Create(event).Error
+}
+
+// Search retrieves cluster events matching the filter, newest first
+func (r *ClusterEventRepository) Search(ctx context.Context, clusterID uuid.UUID, filter *ClusterEventFilter) ([]*models.ClusterEvent, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.ClusterEvent{}).Where("cluster_id = ?", clusterID)
+
+	if filter.Source != "" {
+		query = query.Where("source = ?", filter.Source)
+	}
+	kinds := make([]string, 0, len(filter.Kinds))
+	for _, kind := range filter.Kinds {
+		kinds = append(kinds, strings.ToLower(kind))
+	}
+	if filter.Namespace != "" {
+		query = query.Where("namespace = ?", filter.Namespace)
+	}
+	switch {
+	case filter.Name != "" && filter.IncludeRelated && len(kinds) > 0:
+		// The object itself, or any object (of any kind) derived from its name
+		query = query.Where("((LOWER(kind) IN ? AND name = ?) OR name LIKE ?)", kinds, filter.Name, filter.Name+"-%")
+	case filter.Name != "" && filter.IncludeRelated:
+		query = query.Where("(name = ? OR name LIKE ?)", filter.Name, filter.Name+"-%")
+	case filter.Name != "":
+		query = query.Where("name = ?", filter.Name)
+		fallthrough
+	default:
+		if len(kinds) > 0 {
+			query = query.Where("LOWER(kind) IN ?", kinds)
+		}
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Username != "" {
+		query = query.Where("username = ?", filter.Username)
+	}
+	if filter.Query != "" {
+		like := "%" + filter.Query + "%"
+		query = query.Where("(reason LIKE ? OR message LIKE ?)", like, like)
+	}
+	if filter.Since != nil {
+		query = query.Where("last_seen >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("first_seen <= ?", *filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.PageSize > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Offset((page - 1) * filter.PageSize).Limit(filter.PageSize)
+	}
+
+	var events []*models.ClusterEvent
+	if err := query.Order("last_seen DESC").Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// DeleteOlderThan deletes events last seen before the given time
+func (r *ClusterEventRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("last_seen < ?", before).Delete(&models.ClusterEvent{})
+	return result.RowsAffected, result.Error
+}
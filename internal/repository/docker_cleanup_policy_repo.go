@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// DockerCleanupPolicyRepository handles Docker cleanup policy data access
+type DockerCleanupPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewDockerCleanupPolicyRepository creates a new Docker cleanup policy repository
+func NewDockerCleanupPolicyRepository(db *gorm.DB) *DockerCleanupPolicyRepository {
+	return &DockerCleanupPolicyRepository{db: db}
+}
+
+// GetByInstanceID retrieves the policy of an instance, nil when the
+// instance has none
+func (r *DockerCleanupPolicyRepository) GetByInstanceID(ctx context.Context, instanceID uuid.UUID) (*models.DockerCleanupPolicy, error) {
+	var policy models.DockerCleanupPolicy
+	err := r.db.WithContext(ctx).Where("instance_id = ?", instanceID).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Save creates or updates a policy
+func (r *DockerCleanupPolicyRepository) Save(ctx context.Context, policy *models.DockerCleanupPolicy) error {
+	return r.db.WithContext(ctx).Save(policy).Error
+}
+
+// UpdateFields updates specific fields of a policy
+func (r *DockerCleanupPolicyRepository) UpdateFields(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	return r.db.WithContext(ctx).Model(&models.DockerCleanupPolicy{}).Where("id = ?", id).Updates(fields).Error
+}
+
+// DeleteByInstanceID deletes the policy of an instance
+func (r *DockerCleanupPolicyRepository) DeleteByInstanceID(ctx context.Context, instanceID uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("instance_id = ?", instanceID).Delete(&models.DockerCleanupPolicy{}).Error
+}
+
+// List lists all policies, optionally only the enabled ones
+func (r *DockerCleanupPolicyRepository) List(ctx context.Context, enabledOnly bool) ([]*models.DockerCleanupPolicy, error) {
+	query := r.db.WithContext(ctx).Order("created_at ASC")
+	if enabledOnly {
+		query = query.Where("enabled = ?", true)
+	}
+
+	var policies []*models.DockerCleanupPolicy
+	if err := query.Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
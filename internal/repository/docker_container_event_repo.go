@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// DockerContainerEventFilter defines filter for Docker container event queries
+type DockerContainerEventFilter struct {
+	Container string   // Container ID, ID prefix of at least 12 characters, or name
+	Actions   []string // Any of
+	Since     *time.Time
+	Until     *time.Time
+	Page      int
+	PageSize  int
+}
+
+// DockerContainerEventRepository handles collected Docker container events
+type DockerContainerEventRepository struct {
+	db *gorm.DB
+}
+
+// NewDockerContainerEventRepository creates a new Docker container event repository
+func NewDockerContainerEventRepository(db *gorm.DB) *DockerContainerEventRepository {
+	return &DockerContainerEventRepository{db: db}
+}
+
+// Create stores an event and reports whether it was new. Events that were
+// already stored, such as those replayed after a reconnect, are skipped.
+func (r *DockerContainerEventRepository) Create(ctx context.Context, event *models.DockerContainerEvent) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Search retrieves events of an instance matching the filter, newest first
+func (r *DockerContainerEventRepository) Search(ctx context.Context, instanceID uuid.UUID, filter *DockerContainerEventFilter) ([]*models.DockerContainerEvent, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.DockerContainerEvent{}).Where("instance_id = ?", instanceID)
+
+	if filter.Container != "" {
+		if len(filter.Container) >= 12 {
+			query = query.Where("(container_id LIKE ? OR container_name = ?)", filter.Container+"%", filter.Container)
+		} else {
+			query = query.Where("container_name = ?", filter.Container)
+		}
+	}
+	if len(filter.Actions) > 0 {
+		query = query.Where("action IN ?", filter.Actions)
+	}
+	if filter.Since != nil {
+		query = query.Where("occurred_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("occurred_at <= ?", *filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.PageSize > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Offset((page - 1) * filter.PageSize).Limit(filter.PageSize)
+	}
+
+	var events []*models.DockerContainerEvent
+	if err := query.Order("occurred_at DESC").Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// CountSince counts the events of a container with the given action since a time
+func (r *DockerContainerEventRepository) CountSince(ctx context.Context, instanceID uuid.UUID, containerID, action string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.DockerContainerEvent{}).
+		Where("instance_id = ? AND container_id = ? AND action = ? AND occurred_at >= ?", instanceID, containerID, action, since).
+		Count(&count).Error
+	return count, err
+}
+
+// LatestOccurredAt returns the time of the newest event of an instance, or
+// the zero time when none was collected yet
+func (r *DockerContainerEventRepository) LatestOccurredAt(ctx context.Context, instanceID uuid.UUID) (time.Time, error) {
+	var event models.DockerContainerEvent
+	err := r.db.WithContext(ctx).Where("instance_id = ?", instanceID).Order("occurred_at DESC").First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return event.OccurredAt, nil
+}
+
+// DeleteOlderThan deletes events that occurred before the given time
+func (r *DockerContainerEventRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("occurred_at < ?", before).Delete(&models.DockerContainerEvent{})
+	return result.RowsAffected, result.Error
+}
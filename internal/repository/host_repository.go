@@ -31,9 +31,26 @@ type HostRepository interface {
 
 	// State related
 	SaveState(ctx context.Context, state *models.HostState) error
+	SaveStates(ctx context.Context, states []*models.HostState) error
+	GetStateTimestamps(ctx context.Context, hostID uuid.UUID, start, end time.Time) ([]time.Time, error)
 	GetLatestState(ctx context.Context, hostID uuid.UUID) (*models.HostState, error)
 	GetLatestStates(ctx context.Context, hostID uuid.UUID, limit int) ([]*models.HostState, error)
 	GetStatesByTimeRange(ctx context.Context, hostID uuid.UUID, start, end time.Time, intervalSeconds int) ([]*models.HostState, error)
+	GetStateDetailsByTimeRange(ctx context.Context, hostID uuid.UUID, start, end time.Time, intervalSeconds int) ([]*models.HostState, error)
+
+	// Downsampling related
+	ListStates(ctx context.Context, start, end time.Time) ([]*models.HostState, error)
+	GetEarliestStateTime(ctx context.Context) (time.Time, error)
+	DeleteStatesBefore(ctx context.Context, before time.Time) (int64, error)
+	GetRollups(ctx context.Context, tier models.RollupTier, hostID *uuid.UUID, start, end time.Time) ([]*models.HostStateRollup, error)
+	ReplaceRollups(ctx context.Context, tier models.RollupTier, start, end time.Time, rollups []*models.HostStateRollup) error
+	GetRollupTimeBounds(ctx context.Context, tier models.RollupTier) (time.Time, time.Time, error)
+	DeleteRollupsBefore(ctx context.Context, tier models.RollupTier, before time.Time) (int64, error)
+
+	// Custom metric related
+	SaveCustomMetrics(ctx context.Context, metrics []*models.HostCustomMetric) error
+	GetCustomMetrics(ctx context.Context, hostID uuid.UUID, name string, start, end time.Time) ([]*models.HostCustomMetric, error)
+	GetCustomMetricNames(ctx context.Context, hostID uuid.UUID, since time.Time) ([]string, error)
 
 	// Group related
 	GetHostsByGroupName(ctx context.Context, groupName string) ([]*models.HostNode, error)
@@ -158,6 +175,14 @@ func (r *hostRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		if err := tx.Where("host_node_id = ?", id).Delete(&models.HostState{}).Error; err != nil {
 			return err
 		}
+		if err := tx.Where("host_node_id = ?", id).Delete(&models.HostCustomMetric{}).Error; err != nil {
+			return err
+		}
+		for _, tier := range models.RollupTiers {
+			if err := tx.Table(tier.Table).Where("host_node_id = ?", id).Delete(&models.HostStateRollup{}).Error; err != nil {
+				return err
+			}
+		}
 		if err := tx.Where("host_node_id = ?", id).Delete(&models.HostInfo{}).Error; err != nil {
 			return err
 		}
@@ -178,6 +203,24 @@ func (r *hostRepository) SaveState(ctx context.Context, state *models.HostState)
 	return r.db.WithContext(ctx).Create(state).Error
 }
 
+// SaveStates saves several host state snapshots in batches
+func (r *hostRepository) SaveStates(ctx context.Context, states []*models.HostState) error {
+	if len(states) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).CreateInBatches(states, 200).Error
+}
+
+// GetStateTimestamps retrieves the timestamps of the states stored for a host within a time range
+func (r *hostRepository) GetStateTimestamps(ctx context.Context, hostID uuid.UUID, start, end time.Time) ([]time.Time, error) {
+	var timestamps []time.Time
+	err := r.db.WithContext(ctx).
+		Model(&models.HostState{}).
+		Where("host_node_id = ? AND timestamp >= ? AND timestamp <= ?", hostID, start, end).
+		Pluck("timestamp", &timestamps).Error
+	return timestamps, err
+}
+
 // GetLatestState retrieves the most recent state for a host
 func (r *hostRepository) GetLatestState(ctx context.Context, hostID uuid.UUID) (*models.HostState, error) {
 	var state models.HostState
@@ -208,6 +251,7 @@ func (r *hostRepository) GetStatesByTimeRange(ctx context.Context, hostID uuid.U
 	if intervalSeconds <= 0 {
 		var states []*models.HostState
 		err := r.db.WithContext(ctx).
+			Omit(models.HostStateDetailColumns...).
 			Where("host_node_id = ? AND timestamp >= ? AND timestamp <= ?", hostID, start, end).
 			Order("timestamp ASC").
 			Find(&states).Error
@@ -219,6 +263,7 @@ func (r *hostRepository) GetStatesByTimeRange(ctx context.Context, hostID uuid.U
 	if duration.Hours() <= 24 {
 		var states []*models.HostState
 		err := r.db.WithContext(ctx).
+			Omit(models.HostStateDetailColumns...).
 			Where("host_node_id = ? AND timestamp >= ? AND timestamp <= ?", hostID, start, end).
 			Order("timestamp ASC").
 			Find(&states).Error
@@ -266,6 +311,7 @@ func (r *hostRepository) GetStatesByTimeRange(ctx context.Context, hostID uuid.U
 		// Fallback: get all states and sample them based on interval
 		var allStates []*models.HostState
 		err = r.db.WithContext(ctx).
+			Omit(models.HostStateDetailColumns...).
 			Where("host_node_id = ? AND timestamp >= ? AND timestamp <= ?", hostID, start, end).
 			Order("timestamp ASC").
 			Find(&allStates).Error
@@ -300,6 +346,145 @@ func (r *hostRepository) GetStatesByTimeRange(ctx context.Context, hostID uuid.U
 	return states, err
 }
 
+// GetStateDetailsByTimeRange retrieves the partition, interface and process
+// breakdowns within a time range, keeping the last state of each interval
+func (r *hostRepository) GetStateDetailsByTimeRange(ctx context.Context, hostID uuid.UUID, start, end time.Time, intervalSeconds int) ([]*models.HostState, error) {
+	var states []*models.HostState
+	err := r.db.WithContext(ctx).
+		Select(append([]string{"id", "host_node_id", "timestamp"}, models.HostStateDetailColumns...)).
+		Where("host_node_id = ? AND timestamp >= ? AND timestamp <= ?", hostID, start, end).
+		Order("timestamp ASC").
+		Find(&states).Error
+	if err != nil || intervalSeconds <= 0 {
+		return states, err
+	}
+
+	interval := time.Duration(intervalSeconds) * time.Second
+	sampled := make([]*models.HostState, 0)
+	for i, state := range states {
+		bucket := state.Timestamp.Truncate(interval)
+		if i+1 < len(states) && states[i+1].Timestamp.Truncate(interval).Equal(bucket) {
+			continue
+		}
+		sampled = append(sampled, state)
+	}
+	return sampled, nil
+}
+
+// SaveCustomMetrics saves plugin metric samples in batches
+func (r *hostRepository) SaveCustomMetrics(ctx context.Context, metrics []*models.HostCustomMetric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).CreateInBatches(metrics, 200).Error
+}
+
+// GetCustomMetrics retrieves the samples of a plugin metric within a time range
+func (r *hostRepository) GetCustomMetrics(ctx context.Context, hostID uuid.UUID, name string, start, end time.Time) ([]*models.HostCustomMetric, error) {
+	var metrics []*models.HostCustomMetric
+	err := r.db.WithContext(ctx).
+		Where("host_node_id = ? AND name = ? AND timestamp >= ? AND timestamp <= ?", hostID, name, start, end).
+		Order("timestamp ASC").
+		Find(&metrics).Error
+	return metrics, err
+}
+
+// GetCustomMetricNames retrieves the names of the plugin metrics reported since a time
+func (r *hostRepository) GetCustomMetricNames(ctx context.Context, hostID uuid.UUID, since time.Time) ([]string, error) {
+	var names []string
+	err := r.db.WithContext(ctx).
+		Model(&models.HostCustomMetric{}).
+		Where("host_node_id = ? AND timestamp >= ?", hostID, since).
+		Distinct().
+		Order("name ASC").
+		Pluck("name", &names).Error
+	return names, err
+}
+
+// ListStates retrieves the states of all hosts within [start, end), without
+// breakdowns, ordered by host and time
+func (r *hostRepository) ListStates(ctx context.Context, start, end time.Time) ([]*models.HostState, error) {
+	var states []*models.HostState
+	err := r.db.WithContext(ctx).
+		Omit(models.HostStateDetailColumns...).
+		Where("timestamp >= ? AND timestamp < ?", start, end).
+		Order("host_node_id ASC, timestamp ASC").
+		Find(&states).Error
+	return states, err
+}
+
+// GetEarliestStateTime returns the time of the oldest state, or the zero
+// time when there are no states
+func (r *hostRepository) GetEarliestStateTime(ctx context.Context) (time.Time, error) {
+	var state models.HostState
+	err := r.db.WithContext(ctx).
+		Select("timestamp").
+		Order("timestamp ASC").
+		Limit(1).
+		Find(&state).Error
+	return state.Timestamp, err
+}
+
+// DeleteStatesBefore deletes the states older than the given time
+func (r *hostRepository) DeleteStatesBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("timestamp < ?", before).
+		Delete(&models.HostState{})
+	return result.RowsAffected, result.Error
+}
+
+// GetRollups retrieves the buckets of a tier starting within [start, end),
+// of a single host or of all hosts when hostID is nil
+func (r *hostRepository) GetRollups(ctx context.Context, tier models.RollupTier, hostID *uuid.UUID, start, end time.Time) ([]*models.HostStateRollup, error) {
+	query := r.db.WithContext(ctx).
+		Table(tier.Table).
+		Where("timestamp >= ? AND timestamp < ?", start, end)
+	if hostID != nil {
+		query = query.Where("host_node_id = ?", *hostID)
+	}
+
+	var rollups []*models.HostStateRollup
+	err := query.Order("host_node_id ASC, timestamp ASC").Find(&rollups).Error
+	return rollups, err
+}
+
+// ReplaceRollups replaces the buckets of a tier starting within [start, end)
+func (r *hostRepository) ReplaceRollups(ctx context.Context, tier models.RollupTier, start, end time.Time, rollups []*models.HostStateRollup) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(tier.Table).
+			Where("timestamp >= ? AND timestamp < ?", start, end).
+			Delete(&models.HostStateRollup{}).Error; err != nil {
+			return err
+		}
+		if len(rollups) == 0 {
+			return nil
+		}
+		return tx.Table(tier.Table).CreateInBatches(rollups, 200).Error
+	})
+}
+
+// GetRollupTimeBounds returns the start of the oldest and newest buckets of
+// a tier, or zero times when the tier is empty
+func (r *hostRepository) GetRollupTimeBounds(ctx context.Context, tier models.RollupTier) (time.Time, time.Time, error) {
+	var oldest, newest models.HostStateRollup
+	if err := r.db.WithContext(ctx).Table(tier.Table).Select("timestamp").Order("timestamp ASC").Limit(1).Find(&oldest).Error; err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if err := r.db.WithContext(ctx).Table(tier.Table).Select("timestamp").Order("timestamp DESC").Limit(1).Find(&newest).Error; err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return oldest.Timestamp, newest.Timestamp, nil
+}
+
+// DeleteRollupsBefore deletes the buckets of a tier older than the given time
+func (r *hostRepository) DeleteRollupsBefore(ctx context.Context, tier models.RollupTier, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Table(tier.Table).
+		Where("timestamp < ?", before).
+		Delete(&models.HostStateRollup{})
+	return result.RowsAffected, result.Error
+}
+
 // GetHostsByGroupName retrieves all hosts in a group by group name
 func (r *hostRepository) GetHostsByGroupName(ctx context.Context, groupName string) ([]*models.HostNode, error) {
 	var hosts []*models.HostNode
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func TestHostRepository_StateDetails(t *testing.T) {
+	db := testdb.Open(t, &models.HostState{})
+	repo := NewHostRepository(db)
+	ctx := context.Background()
+	hostID := uuid.New()
+	base := time.Now().Add(-time.Hour).Truncate(time.Hour)
+
+	var states []*models.HostState
+	for i := 0; i < 12; i++ {
+		states = append(states, &models.HostState{
+			HostNodeID: hostID,
+			Timestamp:  base.Add(time.Duration(i) * 10 * time.Second),
+			CPUUsage:   float64(i),
+			Disks: models.DiskPartitionStates{
+				{MountPoint: "/", Total: 100, Used: uint64(i), InodesTotal: 1000, InodesUsed: 10},
+				{MountPoint: "/data", Total: 1000, Used: 500},
+			},
+			NetInterfaces: models.NetInterfaceStates{{Name: "eth0", RxSpeed: uint64(i * 100)}},
+			TopProcesses:  models.ProcessStates{{PID: 42, Name: "postgres", CPUUsage: 12.5}},
+		})
+	}
+	// A state from an agent without breakdowns
+	states = append(states, &models.HostState{HostNodeID: hostID, Timestamp: base.Add(2 * time.Minute)})
+	require.NoError(t, repo.SaveStates(ctx, states))
+
+	latest, err := repo.GetLatestState(ctx, hostID)
+	require.NoError(t, err)
+	assert.Nil(t, latest.Disks)
+
+	// The history omits the breakdowns
+	history, err := repo.GetStatesByTimeRange(ctx, hostID, base, base.Add(time.Hour), 0)
+	require.NoError(t, err)
+	require.Len(t, history, 13)
+	assert.Equal(t, 11.0, history[11].CPUUsage)
+	assert.Nil(t, history[11].Disks)
+
+	// Details keep the last state of each minute
+	details, err := repo.GetStateDetailsByTimeRange(ctx, hostID, base, base.Add(time.Hour), 60)
+	require.NoError(t, err)
+	require.Len(t, details, 3)
+	require.Len(t, details[0].Disks, 2)
+	assert.Equal(t, uint64(5), details[0].Disks[0].Used)
+	assert.Equal(t, uint64(1000), details[0].Disks[0].InodesTotal)
+	assert.Equal(t, uint64(1100), details[1].NetInterfaces[0].RxSpeed)
+	assert.Equal(t, "postgres", details[1].TopProcesses[0].Name)
+	assert.Zero(t, details[1].CPUUsage, "only breakdown columns are loaded")
+	assert.Empty(t, details[2].Disks)
+
+	details, err = repo.GetStateDetailsByTimeRange(ctx, hostID, base, base.Add(time.Hour), 0)
+	require.NoError(t, err)
+	assert.Len(t, details, 13)
+}
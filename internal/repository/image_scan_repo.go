@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// imageScanSummaryColumns are the columns of a scan without its
+// vulnerability and package lists
+var imageScanSummaryColumns = []string{
+	"id", "created_at", "updated_at", "digest", "image", "scanner", "status", "error", "scanned_at",
+	"critical", "high", "medium", "low", "unknown",
+}
+
+// ImageScanRepository handles image scan result data access
+type ImageScanRepository struct {
+	db *gorm.DB
+}
+
+// NewImageScanRepository creates a new image scan repository
+func NewImageScanRepository(db *gorm.DB) *ImageScanRepository {
+	return &ImageScanRepository{db: db}
+}
+
+// Save creates or updates a scan result
+func (r *ImageScanRepository) Save(ctx context.Context, scan *models.ImageScan) error {
+	return r.db.WithContext(ctx).Save(scan).Error
+}
+
+// GetByID retrieves a scan result with its vulnerabilities and packages
+func (r *ImageScanRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ImageScan, error) {
+	var scan models.ImageScan
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&scan).Error; err != nil {
+		return nil, err
+	}
+	return &scan, nil
+}
+
+// GetByDigest retrieves the scan result of an image digest, nil when the
+// digest was never scanned
+func (r *ImageScanRepository) GetByDigest(ctx context.Context, digest string) (*models.ImageScan, error) {
+	var scan models.ImageScan
+	err := r.db.WithContext(ctx).Where("digest = ?", digest).First(&scan).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &scan, nil
+}
+
+// ListSummariesByDigests retrieves the scan summaries of image digests,
+// keyed by digest
+func (r *ImageScanRepository) ListSummariesByDigests(ctx context.Context, digests []string) (map[string]*models.ImageScan, error) {
+	result := make(map[string]*models.ImageScan)
+	if len(digests) == 0 {
+		return result, nil
+	}
+
+	var scans []*models.ImageScan
+	if err := r.db.WithContext(ctx).Select(imageScanSummaryColumns).Where("digest IN ?", digests).Find(&scans).Error; err != nil {
+		return nil, err
+	}
+	for _, scan := range scans {
+		result[scan.Digest] = scan
+	}
+	return result, nil
+}
+
+// ListSummaries lists scan summaries, most vulnerable first
+func (r *ImageScanRepository) ListSummaries(ctx context.Context, status string, page, pageSize int) ([]*models.ImageScan, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.ImageScan{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var scans []*models.ImageScan
+	err := query.Select(imageScanSummaryColumns).
+		Order("critical DESC, high DESC, scanned_at DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&scans).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return scans, total, nil
+}
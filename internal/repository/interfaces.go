@@ -128,6 +128,7 @@ type ResourceHistoryRepositoryInterface interface {
 // ResourceHistoryFilter defines filter for resource history queries
 type ResourceHistoryFilter struct {
 	ResourceType  string
+	ResourceTypes []string // Any of, case-insensitive (used for kind/resource aliases)
 	ResourceName  string
 	Namespace     string
 	APIGroup      string
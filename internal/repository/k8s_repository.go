@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -148,6 +149,13 @@ func (r *ResourceHistoryRepository) ListByCluster(ctx context.Context, clusterID
 	if filter.ResourceType != "" {
 		query = query.Where("resource_type = ?", filter.ResourceType)
 	}
+	if len(filter.ResourceTypes) > 0 {
+		types := make([]string, 0, len(filter.ResourceTypes))
+		for _, t := range filter.ResourceTypes {
+			types = append(types, strings.ToLower(t))
+		}
+		query = query.Where("LOWER(resource_type) IN ?", types)
+	}
 	if filter.ResourceName != "" {
 		query = query.Where("resource_name = ?", filter.ResourceName)
 	}
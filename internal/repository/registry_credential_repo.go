@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// RegistryCredentialRepository handles registry credential data access
+type RegistryCredentialRepository struct {
+	db *gorm.DB
+}
+
+// NewRegistryCredentialRepository creates a new registry credential repository
+func NewRegistryCredentialRepository(db *gorm.DB) *RegistryCredentialRepository {
+	return &RegistryCredentialRepository{db: db}
+}
+
+// Create creates a new registry credential
+func (r *RegistryCredentialRepository) Create(ctx context.Context, credential *models.RegistryCredential) error {
+	return r.db.WithContext(ctx).Create(credential).Error
+}
+
+// GetByID retrieves a registry credential by ID
+func (r *RegistryCredentialRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RegistryCredential, error) {
+	var credential models.RegistryCredential
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&credential).Error; err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// ExistsName checks whether another credential uses the name
+func (r *RegistryCredentialRepository) ExistsName(ctx context.Context, name string, excludeID *uuid.UUID) (bool, error) {
+	query := r.db.WithContext(ctx).Model(&models.RegistryCredential{}).Where("name = ?", name)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Update saves a registry credential
+func (r *RegistryCredentialRepository) Update(ctx context.Context, credential *models.RegistryCredential) error {
+	return r.db.WithContext(ctx).Save(credential).Error
+}
+
+// UpdateFields updates specific fields of a registry credential
+func (r *RegistryCredentialRepository) UpdateFields(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	return r.db.WithContext(ctx).Model(&models.RegistryCredential{}).Where("id = ?", id).Updates(fields).Error
+}
+
+// Delete deletes a registry credential
+func (r *RegistryCredentialRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.RegistryCredential{}, "id = ?", id).Error
+}
+
+// List lists registry credentials, optionally of one registry
+func (r *RegistryCredentialRepository) List(ctx context.Context, registry string) ([]*models.RegistryCredential, error) {
+	query := r.db.WithContext(ctx).Order("registry ASC, name ASC")
+	if registry != "" {
+		query = query.Where("registry = ?", registry)
+	}
+	var credentials []*models.RegistryCredential
+	if err := query.Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
@@ -33,6 +33,7 @@ type ServiceRepository interface {
 	GetProbeHistory(ctx context.Context, serviceID uuid.UUID, start, end time.Time, limit int) ([]*models.ServiceProbeResult, int64, error)
 	GetLatestProbeResult(ctx context.Context, serviceID uuid.UUID) (*models.ServiceProbeResult, error)
 	GetProbeResultsByHostAndService(ctx context.Context, hostID, serviceID uuid.UUID, start, end time.Time) ([]*models.ServiceProbeResult, error)
+	GetProbeSummaries(ctx context.Context, since time.Time) ([]*ProbeSummary, error)
 
 	// Availability statistics
 	SaveAvailability(ctx context.Context, availability *models.ServiceAvailability) error
@@ -48,6 +49,15 @@ type ServiceRepository interface {
 	GetActiveHostNodes(ctx context.Context) ([]*models.HostNode, error)
 }
 
+// ProbeSummary is the probe activity of a monitor from a single probe node
+type ProbeSummary struct {
+	ServiceMonitorID uuid.UUID
+	HostNodeID       *uuid.UUID // Nil for server-side probes
+	TotalChecks      int64
+	SuccessfulChecks int64
+	Latest           *models.ServiceProbeResult
+}
+
 // serviceRepository implements ServiceRepository
 type serviceRepository struct {
 	db *gorm.DB
@@ -171,6 +181,11 @@ func (r *serviceRepository) GetProbeHistory(ctx context.Context, serviceID uuid.
 	}
 	query = query.Order("timestamp DESC").Limit(limit)
 
+	// Include per-step results of synthetic monitors
+	query = query.Preload("Steps", func(db *gorm.DB) *gorm.DB {
+		return db.Order("step_index ASC")
+	})
+
 	var results []*models.ServiceProbeResult
 	if err := query.Find(&results).Error; err != nil {
 		return nil, 0, err
@@ -306,6 +321,66 @@ func (r *serviceRepository) GetProbeResultsByHostAndService(ctx context.Context,
 	return results, nil
 }
 
+// GetProbeSummaries returns the check counts and latest result of every
+// monitor and probe node pair with results since the given time
+func (r *serviceRepository) GetProbeSummaries(ctx context.Context, since time.Time) ([]*ProbeSummary, error) {
+	var counts []struct {
+		ServiceMonitorID uuid.UUID
+		HostNodeID       *uuid.UUID
+		Total            int64
+		Successful       int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.ServiceProbeResult{}).
+		Select("service_monitor_id, host_node_id, COUNT(*) AS total, SUM(CASE WHEN success THEN 1 ELSE 0 END) AS successful").
+		Where("timestamp >= ?", since).
+		Group("service_monitor_id, host_node_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	latestTimes := r.db.
+		Model(&models.ServiceProbeResult{}).
+		Select("service_monitor_id, host_node_id, MAX(timestamp) AS timestamp").
+		Where("timestamp >= ?", since).
+		Group("service_monitor_id, host_node_id")
+
+	var latest []*models.ServiceProbeResult
+	err = r.db.WithContext(ctx).
+		Table("service_probe_results AS r").
+		Select("r.*").
+		Joins("JOIN (?) AS l ON r.service_monitor_id = l.service_monitor_id AND r.timestamp = l.timestamp AND (r.host_node_id = l.host_node_id OR (r.host_node_id IS NULL AND l.host_node_id IS NULL))", latestTimes).
+		Where("r.deleted_at IS NULL").
+		Find(&latest).Error
+	if err != nil {
+		return nil, err
+	}
+
+	key := func(serviceID uuid.UUID, hostID *uuid.UUID) string {
+		if hostID == nil {
+			return serviceID.String()
+		}
+		return serviceID.String() + ":" + hostID.String()
+	}
+	latestByKey := make(map[string]*models.ServiceProbeResult, len(latest))
+	for _, result := range latest {
+		latestByKey[key(result.ServiceMonitorID, result.HostNodeID)] = result
+	}
+
+	summaries := make([]*ProbeSummary, 0, len(counts))
+	for _, c := range counts {
+		summaries = append(summaries, &ProbeSummary{
+			ServiceMonitorID: c.ServiceMonitorID,
+			HostNodeID:       c.HostNodeID,
+			TotalChecks:      c.Total,
+			SuccessfulChecks: c.Successful,
+			Latest:           latestByKey[key(c.ServiceMonitorID, c.HostNodeID)],
+		})
+	}
+	return summaries, nil
+}
+
 // GetActiveHostNodes retrieves all active host nodes that participate in monitoring
 func (r *serviceRepository) GetActiveHostNodes(ctx context.Context) ([]*models.HostNode, error) {
 	var hosts []*models.HostNode
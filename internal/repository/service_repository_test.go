@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/healthcheck"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func TestServiceRepository_ProbeStepResults(t *testing.T) {
+	db := testdb.Open(t, &models.ServiceMonitor{}, &models.ServiceProbeResult{}, &models.ServiceProbeStepResult{})
+	repo := NewServiceRepository(db)
+	ctx := context.Background()
+
+	monitor := &models.ServiceMonitor{
+		Name:     "Checkout",
+		Type:     models.ProbeTypeSynthetic,
+		Target:   "checkout flow",
+		Interval: 60,
+		Timeout:  10,
+		SyntheticSteps: models.SyntheticSteps{
+			{Name: "login", URL: "https://shop.example.com/login", Extract: []healthcheck.Extraction{{Name: "token", From: "header", Path: "X-Token"}}},
+			{Name: "orders", URL: "https://shop.example.com/orders", Headers: map[string]string{"Authorization": "Bearer {{token}}"}},
+		},
+	}
+	require.NoError(t, repo.Create(ctx, monitor))
+
+	loaded, err := repo.GetByID(ctx, monitor.ID)
+	require.NoError(t, err)
+	require.Len(t, loaded.SyntheticSteps, 2)
+	assert.Equal(t, "Bearer {{token}}", loaded.SyntheticSteps[1].Headers["Authorization"])
+
+	result := &models.ServiceProbeResult{
+		ServiceMonitorID: monitor.ID,
+		Timestamp:        time.Now(),
+		ErrorMessage:     "step 2 (orders): unexpected status code: 500",
+		Steps: []models.ServiceProbeStepResult{
+			{ServiceMonitorID: monitor.ID, StepIndex: 1, Name: "orders", HTTPStatusCode: 500, Latency: 30},
+			{ServiceMonitorID: monitor.ID, StepIndex: 0, Name: "login", Success: true, HTTPStatusCode: 200, Latency: 12},
+		},
+	}
+	require.NoError(t, repo.SaveProbeResult(ctx, result))
+
+	history, total, err := repo.GetProbeHistory(ctx, monitor.ID, time.Time{}, time.Time{}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, history, 1)
+	require.Len(t, history[0].Steps, 2)
+	assert.Equal(t, "login", history[0].Steps[0].Name, "steps are ordered by index")
+	assert.Equal(t, result.ID, history[0].Steps[1].ProbeResultID)
+}
+
+func TestServiceRepository_GetProbeSummaries(t *testing.T) {
+	db := testdb.Open(t, &models.ServiceMonitor{}, &models.ServiceProbeResult{}, &models.ServiceProbeStepResult{})
+	repo := NewServiceRepository(db)
+	ctx := context.Background()
+
+	monitor := &models.ServiceMonitor{Name: "API", Type: models.ProbeTypeHTTP, Target: "https://api.example.com", Interval: 60, Timeout: 10}
+	require.NoError(t, repo.Create(ctx, monitor))
+
+	now := time.Now()
+	hostID := uuid.New()
+	results := []*models.ServiceProbeResult{
+		{ServiceMonitorID: monitor.ID, Timestamp: now.Add(-2 * time.Hour), Success: true, Latency: 10},
+		{ServiceMonitorID: monitor.ID, Timestamp: now.Add(-3 * time.Minute), Success: true, Latency: 20},
+		{ServiceMonitorID: monitor.ID, Timestamp: now.Add(-2 * time.Minute), Success: false, Latency: 30},
+		{ServiceMonitorID: monitor.ID, HostNodeID: &hostID, Timestamp: now.Add(-time.Minute), Success: true, Latency: 40},
+		{ServiceMonitorID: monitor.ID, HostNodeID: &hostID, Timestamp: now.Add(-48 * time.Hour), Success: false, Latency: 50},
+	}
+	for _, result := range results {
+		require.NoError(t, repo.SaveProbeResult(ctx, result))
+	}
+
+	summaries, err := repo.GetProbeSummaries(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+
+	for _, summary := range summaries {
+		require.NotNil(t, summary.Latest)
+		if summary.HostNodeID == nil {
+			assert.Equal(t, int64(3), summary.TotalChecks)
+			assert.Equal(t, int64(2), summary.SuccessfulChecks)
+			assert.False(t, summary.Latest.Success, "the latest server probe failed")
+			assert.Equal(t, 30, summary.Latest.Latency)
+		} else {
+			assert.Equal(t, hostID, *summary.HostNodeID)
+			assert.Equal(t, int64(1), summary.TotalChecks, "results before the window are ignored")
+			assert.Equal(t, int64(1), summary.SuccessfulChecks)
+			assert.Equal(t, 40, summary.Latest.Latency)
+		}
+	}
+}
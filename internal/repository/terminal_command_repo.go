@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// TerminalCommandFilter defines filter for terminal command searches
+type TerminalCommandFilter struct {
+	Query         string // Substring of the command line
+	UserID        *uuid.UUID
+	RecordingType string
+	Since         *time.Time
+	Until         *time.Time
+	Page          int
+	PageSize      int
+}
+
+// TerminalCommandRepository handles commands indexed from terminal recordings
+type TerminalCommandRepository struct {
+	db *gorm.DB
+}
+
+// NewTerminalCommandRepository creates a new terminal command repository
+func NewTerminalCommandRepository(db *gorm.DB) *TerminalCommandRepository {
+	return &TerminalCommandRepository{db: db}
+}
+
+// ReplaceForRecording replaces the indexed commands of a recording and
+// marks the recording as indexed
+func (r *TerminalCommandRepository) ReplaceForRecording(ctx context.Context, recordingID uuid.UUID, commands []*models.TerminalCommand) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("recording_id = ?", recordingID).Delete(&models.TerminalCommand{}).Error; err != nil {
+			return err
+		}
+		if len(commands) > 0 {
+			if err := tx.CreateInBatches(commands, 100).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&models.TerminalRecording{}).Where("id = ?", recordingID).
+			UpdateColumn("commands_indexed_at", time.Now()).Error
+	})
+}
+
+// UnindexedRecordingIDs returns the IDs of finished recordings whose
+// commands have not been indexed yet, oldest first
+func (r *TerminalCommandRepository) UnindexedRecordingIDs(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&models.TerminalRecording{}).
+		Where("ended_at IS NOT NULL AND commands_indexed_at IS NULL").
+		Order("started_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ListByRecording retrieves the commands of a recording in the order they
+// were submitted
+func (r *TerminalCommandRepository) ListByRecording(ctx context.Context, recordingID uuid.UUID) ([]*models.TerminalCommand, error) {
+	var commands []*models.TerminalCommand
+	err := r.db.WithContext(ctx).Where("recording_id = ?", recordingID).Order("sequence ASC").Find(&commands).Error
+	return commands, err
+}
+
+// Search retrieves commands of all recordings matching the filter, newest
+// first. Commands of deleted recordings are not returned.
+func (r *TerminalCommandRepository) Search(ctx context.Context, filter *TerminalCommandFilter) ([]*models.TerminalCommand, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.TerminalCommand{}).
+		Joins("JOIN terminal_recordings ON terminal_recordings.id = terminal_commands.recording_id AND terminal_recordings.deleted_at IS NULL")
+
+	if filter.Query != "" {
+		query = query.Where("terminal_commands.command LIKE ?", "%"+filter.Query+"%")
+	}
+	if filter.UserID != nil {
+		query = query.Where("terminal_commands.user_id = ?", *filter.UserID)
+	}
+	if filter.RecordingType != "" {
+		query = query.Where("terminal_commands.recording_type = ?", filter.RecordingType)
+	}
+	if filter.Since != nil {
+		query = query.Where("terminal_commands.executed_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("terminal_commands.executed_at <= ?", *filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.PageSize > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Offset((page - 1) * filter.PageSize).Limit(filter.PageSize)
+	}
+
+	var commands []*models.TerminalCommand
+	if err := query.Select("terminal_commands.*").Order("terminal_commands.executed_at DESC").Find(&commands).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return commands, total, nil
+}
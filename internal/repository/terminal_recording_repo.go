@@ -126,17 +126,22 @@ func (r *RecordingRepository) Update(ctx context.Context, recording *models.Term
 	return r.db.WithContext(ctx).Save(recording).Error
 }
 
-// Delete deletes a recording by ID
+// Delete deletes a recording and its indexed commands by ID
 func (r *RecordingRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&models.TerminalRecording{}, id).Error
+	return r.BulkDelete(ctx, []uuid.UUID{id})
 }
 
-// BulkDelete deletes multiple recordings by IDs
+// BulkDelete deletes multiple recordings and their indexed commands by IDs
 func (r *RecordingRepository) BulkDelete(ctx context.Context, ids []uuid.UUID) error {
 	if len(ids) == 0 {
 		return nil
 	}
-	return r.db.WithContext(ctx).Delete(&models.TerminalRecording{}, ids).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("recording_id IN ?", ids).Delete(&models.TerminalCommand{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.TerminalRecording{}, ids).Error
+	})
 }
 
 // List retrieves recordings with filtering, pagination, and sorting
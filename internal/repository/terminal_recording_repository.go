@@ -58,9 +58,14 @@ func (r *TerminalRecordingRepository) Update(ctx context.Context, recording *mod
 	return r.db.WithContext(ctx).Save(recording).Error
 }
 
-// Delete deletes a terminal recording by ID
+// Delete deletes a terminal recording and its indexed commands by ID
 func (r *TerminalRecordingRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&models.TerminalRecording{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("recording_id = ?", id).Delete(&models.TerminalCommand{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.TerminalRecording{}, "id = ?", id).Error
+	})
 }
 
 // List retrieves a list of terminal recordings with filters
@@ -204,6 +209,13 @@ func (r *TerminalRecordingRepository) DeleteOlderThan(ctx context.Context, befor
 		}
 	}
 
+	// Delete the commands indexed from the recordings
+	if err := r.db.WithContext(ctx).
+		Where("recording_id IN (?)", r.db.Model(&models.TerminalRecording{}).Select("id").Where("started_at < ?", before)).
+		Delete(&models.TerminalCommand{}).Error; err != nil {
+		return 0, err
+	}
+
 	// Delete database records
 	result := r.db.WithContext(ctx).
 		Where("started_at < ?", before).
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
@@ -98,7 +99,7 @@ func (e *AlertEngine) evaluateRule(ctx context.Context, rule *models.MonitorAler
 	}
 
 	// Create new alert event
-	contextData, _ := json.Marshal(env)
+	contextData, _ := json.Marshal(eventContext(env))
 	event := &models.MonitorAlertEvent{
 		RuleID:   rule.ID,
 		Status:   models.AlertStatusFiring,
@@ -125,6 +126,30 @@ func (e *AlertEngine) prepareEnv(data interface{}) map[string]interface{} {
 		env["load_5"] = v.Load5
 		env["load_15"] = v.Load15
 
+		// Plugin metrics: metrics["name"] is the first series of a metric,
+		// metric("name", "label", "value", ...) the one matching the labels
+		// (0 when missing)
+		metrics := make(map[string]float64)
+		for _, m := range v.CustomMetrics {
+			if _, ok := metrics[m.Name]; !ok {
+				metrics[m.Name] = m.Value
+			}
+		}
+		env["metrics"] = metrics
+		customMetrics := v.CustomMetrics
+		env["metric"] = func(name string, labels ...string) float64 {
+			selector := make(map[string]string, len(labels)/2)
+			for i := 0; i+1 < len(labels); i += 2 {
+				selector[labels[i]] = labels[i+1]
+			}
+			for i := range customMetrics {
+				if customMetrics[i].Name == name && customMetrics[i].Matches(selector) {
+					return customMetrics[i].Value
+				}
+			}
+			return 0
+		}
+
 	case *models.ServiceAvailability:
 		// Service monitoring metrics
 		env["uptime_percentage"] = v.UptimePercentage
@@ -147,6 +172,18 @@ func (e *AlertEngine) prepareEnv(data interface{}) map[string]interface{} {
 	return env
 }
 
+// eventContext returns the values of env recorded with an event, leaving out functions
+func eventContext(env map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{}, len(env))
+	for k, v := range env {
+		if v != nil && reflect.TypeOf(v).Kind() == reflect.Func {
+			continue
+		}
+		values[k] = v
+	}
+	return values
+}
+
 // resolveEvents resolves any firing events for a rule
 func (e *AlertEngine) resolveEvents(ctx context.Context, ruleID uuid.UUID) {
 	events, _ := e.alertRepo.GetFiringEvents(ctx, ruleID)
@@ -0,0 +1,68 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func TestAlertEngine_HostCustomMetrics(t *testing.T) {
+	db := testdb.Open(t, &models.MonitorAlertRule{}, &models.MonitorAlertEvent{})
+	alertRepo := repository.NewMonitorAlertRepository(db)
+	engine := NewAlertEngine(alertRepo, nil, nil)
+	ctx := context.Background()
+	hostID := uuid.New()
+
+	rule := &models.MonitorAlertRule{
+		Name:      "Mail queue backlog",
+		Type:      models.AlertTypeHost,
+		TargetID:  hostID,
+		Severity:  models.AlertSeverityWarning,
+		Condition: `metric("queue_depth", "queue", "mail") > 10 && metrics["go_goroutines"] < 100 && cpu_usage < 50`,
+		Enabled:   true,
+	}
+	require.NoError(t, alertRepo.CreateRule(ctx, rule))
+
+	state := func(mailQueue float64) *models.HostState {
+		return &models.HostState{
+			HostNodeID: hostID,
+			Timestamp:  time.Now(),
+			CPUUsage:   20,
+			CustomMetrics: []models.HostCustomMetric{
+				{Name: "queue_depth", Labels: models.JSONB{"queue": "billing", "plugin": "app"}, Value: 50},
+				{Name: "queue_depth", Labels: models.JSONB{"queue": "mail", "plugin": "app"}, Value: mailQueue},
+				{Name: "go_goroutines", Labels: models.JSONB{"plugin": "app"}, Value: 42},
+			},
+		}
+	}
+
+	require.NoError(t, engine.EvaluateHostRules(ctx, hostID, state(5)))
+	events, err := alertRepo.GetFiringEvents(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.Empty(t, events, "billing queue must not match the mail selector")
+
+	require.NoError(t, engine.EvaluateHostRules(ctx, hostID, state(25)))
+	events, err = alertRepo.GetFiringEvents(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	var recorded map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(events[0].Context), &recorded))
+	assert.Equal(t, 42.0, recorded["metrics"].(map[string]interface{})["go_goroutines"])
+	assert.NotContains(t, recorded, "metric")
+
+	// A host without the plugin metric resolves the alert
+	require.NoError(t, engine.EvaluateHostRules(ctx, hostID, &models.HostState{HostNodeID: hostID, Timestamp: time.Now()}))
+	events, err = alertRepo.GetFiringEvents(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+// chainVerifyBatchSize is how many events are loaded per query during verification
+const chainVerifyBatchSize = 1000
+
+// maxChainIssues caps the number of issues reported by a single verification
+const maxChainIssues = 100
+
+// ChainIssueType classifies a problem found while verifying the audit hash chain
+type ChainIssueType string
+
+const (
+	// ChainIssueGap means one or more sequence numbers are missing (rows deleted)
+	ChainIssueGap ChainIssueType = "gap"
+	// ChainIssueHashMismatch means the stored hash does not match the event content (row edited)
+	ChainIssueHashMismatch ChainIssueType = "hash_mismatch"
+	// ChainIssueBrokenLink means prev_hash does not match the previous event's hash
+	ChainIssueBrokenLink ChainIssueType = "broken_link"
+)
+
+// ChainIssue describes a single verification failure
+type ChainIssue struct {
+	Type     ChainIssueType `json:"type"`
+	Sequence int64          `json:"sequence"`
+	EventID  string         `json:"event_id,omitempty"`
+	Detail   string         `json:"detail"`
+}
+
+// ChainVerification is the result of walking the audit hash chain
+type ChainVerification struct {
+	Valid         bool         `json:"valid"`
+	Checked       int64        `json:"checked"`
+	FirstSequence int64        `json:"first_sequence"`
+	LastSequence  int64        `json:"last_sequence"`
+	Issues        []ChainIssue `json:"issues"`
+	Truncated     bool         `json:"truncated"` // More issues exist than were reported
+}
+
+// VerifyChain walks the audit events in sequence order and checks that every hash
+// matches its content and links to the previous event.
+//
+// Hashes are keyed with the server's audit chain key, so the first event checked can
+// serve as the anchor even though older events may have been removed by the retention
+// cleanup: a forged or rewritten first event fails its own hash check. Pass
+// afterSequence > 0 to verify only the tail.
+//
+// Deleting the newest events leaves a shorter chain that still verifies. To
+// detect such truncation, compare LastSequence against a head recorded outside
+// the database: the last sequence and hash delivered to an external sink, or the
+// last_sequence and last_hash of a signed export bundle.
+func VerifyChain(ctx context.Context, repo repository.AuditEventRepository, afterSequence int64) (*ChainVerification, error) {
+	result := &ChainVerification{Issues: []ChainIssue{}}
+
+	var prevSequence int64
+	var prevHash string
+	cursor := afterSequence
+
+	for {
+		events, err := repo.ListChain(ctx, cursor, chainVerifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, event := range events {
+			if result.Checked == 0 {
+				result.FirstSequence = event.Sequence
+			} else {
+				if event.Sequence != prevSequence+1 {
+					result.addIssue(ChainIssue{
+						Type:     ChainIssueGap,
+						Sequence: event.Sequence,
+						EventID:  event.ID,
+						Detail:   fmt.Sprintf("missing sequence %d to %d", prevSequence+1, event.Sequence-1),
+					})
+				}
+				if event.PrevHash != prevHash {
+					result.addIssue(ChainIssue{
+						Type:     ChainIssueBrokenLink,
+						Sequence: event.Sequence,
+						EventID:  event.ID,
+						Detail:   "prev_hash does not match the hash of the preceding event",
+					})
+				}
+			}
+
+			expected, err := event.ComputeHash(event.PrevHash)
+			if err != nil {
+				return nil, err
+			}
+			if expected != event.Hash {
+				result.addIssue(ChainIssue{
+					Type:     ChainIssueHashMismatch,
+					Sequence: event.Sequence,
+					EventID:  event.ID,
+					Detail:   "stored hash does not match event content",
+				})
+			}
+
+			result.Checked++
+			result.LastSequence = event.Sequence
+			prevSequence, prevHash = event.Sequence, event.Hash
+		}
+
+		cursor = prevSequence
+		if len(events) < chainVerifyBatchSize {
+			break
+		}
+	}
+
+	result.Valid = len(result.Issues) == 0 && !result.Truncated
+	return result, nil
+}
+
+func (v *ChainVerification) addIssue(issue ChainIssue) {
+	if len(v.Issues) >= maxChainIssues {
+		v.Truncated = true
+		return
+	}
+	v.Issues = append(v.Issues, issue)
+}
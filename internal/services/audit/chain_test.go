@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func setupChainTestRepo(t *testing.T) (*gorm.DB, repository.AuditEventRepository) {
+	db := testdb.Open(t, &models.AuditEvent{})
+	return db, repository.NewAuditEventRepository(db)
+}
+
+func newTestAuditEvent(i int) *models.AuditEvent {
+	return &models.AuditEvent{
+		ID:           uuid.New().String(),
+		Timestamp:    time.Now().UnixMilli() + int64(i),
+		Action:       models.ActionCreated,
+		ResourceType: models.ResourceTypeCluster,
+		Resource: models.Resource{
+			Type:       models.ResourceTypeCluster,
+			Identifier: fmt.Sprintf("cluster-%d", i),
+		},
+		Subsystem: models.SubsystemHTTP,
+		User: models.Principal{
+			UID:      "u1",
+			Username: "admin",
+			Type:     models.PrincipalTypeUser,
+		},
+		ClientIP: "10.0.0.1",
+		Data:     map[string]string{"k": "v"},
+	}
+}
+
+func seedChain(t *testing.T, repo repository.AuditEventRepository, n int) []*models.AuditEvent {
+	ctx := context.Background()
+	events := make([]*models.AuditEvent, 0, n)
+	for i := 0; i < n; i++ {
+		events = append(events, newTestAuditEvent(i))
+	}
+	// Mix single and batch writes; both must extend the same chain
+	require.NoError(t, repo.Create(ctx, events[0]))
+	require.NoError(t, repo.CreateBatch(ctx, events[1:]))
+	return events
+}
+
+func TestVerifyChain_Valid(t *testing.T) {
+	_, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 5)
+
+	for i, e := range events {
+		assert.Equal(t, int64(i+1), e.Sequence)
+		if i > 0 {
+			assert.Equal(t, events[i-1].Hash, e.PrevHash)
+		}
+	}
+
+	result, err := VerifyChain(context.Background(), repo, 0)
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, int64(5), result.Checked)
+	assert.Equal(t, int64(1), result.FirstSequence)
+	assert.Equal(t, int64(5), result.LastSequence)
+	assert.Empty(t, result.Issues)
+}
+
+func TestVerifyChain_DetectsEdit(t *testing.T) {
+	db, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 4)
+
+	require.NoError(t, db.Model(&models.AuditEvent{}).
+		Where("id = ?", events[2].ID).
+		Update("client_ip", "192.168.1.1").Error)
+
+	result, err := VerifyChain(context.Background(), repo, 0)
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, ChainIssueHashMismatch, result.Issues[0].Type)
+	assert.Equal(t, int64(3), result.Issues[0].Sequence)
+}
+
+func TestVerifyChain_DetectsGap(t *testing.T) {
+	db, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 4)
+
+	require.NoError(t, db.Where("id = ?", events[1].ID).Delete(&models.AuditEvent{}).Error)
+
+	result, err := VerifyChain(context.Background(), repo, 0)
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+
+	types := make([]ChainIssueType, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		types = append(types, issue.Type)
+	}
+	assert.Contains(t, types, ChainIssueGap)
+	assert.Contains(t, types, ChainIssueBrokenLink)
+}
+
+func TestVerifyChain_RetentionCleanupKeepsTailValid(t *testing.T) {
+	db, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 4)
+
+	// Removing the oldest events (as the retention task does) must not break verification
+	require.NoError(t, db.Where("id IN ?", []string{events[0].ID, events[1].ID}).Delete(&models.AuditEvent{}).Error)
+
+	result, err := VerifyChain(context.Background(), repo, 0)
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, int64(3), result.FirstSequence)
+}
+
+func TestVerifyChain_DetectsRehashWithoutKey(t *testing.T) {
+	models.SetAuditChainKey([]byte("server-chain-key"))
+	t.Cleanup(func() { models.SetAuditChainKey(nil) })
+
+	db, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 3)
+
+	// Rewrite the head event and re-hash it without knowing the server key
+	forged := *events[0]
+	forged.ClientIP = "192.168.1.1"
+	models.SetAuditChainKey(nil)
+	hash, err := forged.ComputeHash(forged.PrevHash)
+	require.NoError(t, err)
+	models.SetAuditChainKey([]byte("server-chain-key"))
+	require.NoError(t, db.Model(&models.AuditEvent{}).
+		Where("id = ?", forged.ID).
+		Updates(map[string]interface{}{"client_ip": forged.ClientIP, "hash": hash}).Error)
+
+	result, err := VerifyChain(context.Background(), repo, 0)
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	require.NotEmpty(t, result.Issues)
+	assert.Equal(t, ChainIssueHashMismatch, result.Issues[0].Type)
+	assert.Equal(t, int64(1), result.Issues[0].Sequence)
+}
+
+func TestAuditChain_SequenceIsUnique(t *testing.T) {
+	db, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 2)
+
+	dup := newTestAuditEvent(2)
+	require.NoError(t, dup.Chain(events[0].Sequence, events[0].Hash))
+	assert.Error(t, db.Create(dup).Error)
+}
+
+func TestAuditChain_RetriesOnSequenceConflict(t *testing.T) {
+	db, repo := setupChainTestRepo(t)
+	events := seedChain(t, repo, 2)
+
+	// Another writer takes the next sequence between reading the tail and inserting
+	var attempts int
+	require.NoError(t, db.Callback().Create().Before("gorm:create").Register("test:race", func(tx *gorm.DB) {
+		if tx.Statement.Table != "audit_events" {
+			return
+		}
+		attempts++
+		if attempts > 1 {
+			return
+		}
+		racer := newTestAuditEvent(10)
+		require.NoError(t, racer.Chain(events[1].Sequence, events[1].Hash))
+		require.NoError(t, tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+			Exec("INSERT INTO audit_events (id, timestamp, action, resource_type, subsystem, sequence, prev_hash, hash) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+				racer.ID, racer.Timestamp, racer.Action, racer.ResourceType, racer.Subsystem, racer.Sequence, racer.PrevHash, racer.Hash).Error)
+	}))
+
+	event := newTestAuditEvent(3)
+	require.NoError(t, repo.Create(context.Background(), event))
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, int64(3), event.Sequence)
+
+	result, err := VerifyChain(context.Background(), repo, 0)
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, int64(3), result.Checked)
+}
+
+func TestExportEvents_Formats(t *testing.T) {
+	events := []*models.AuditEvent{newTestAuditEvent(0), newTestAuditEvent(1)}
+	require.NoError(t, events[0].Chain(0, ""))
+	require.NoError(t, events[1].Chain(events[0].Sequence, events[0].Hash))
+
+	csvData, err := ExportEvents(events, ExportFormatCSV, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(csvData), "sequence,id,timestamp")
+	assert.Contains(t, string(csvData), events[1].Hash)
+
+	_, err = ExportEvents(events, ExportFormatBundle, nil)
+	assert.ErrorIs(t, err, ErrSigningKeyRequired)
+
+	key := []byte("test-signing-key")
+	bundle, err := ExportEvents(events, ExportFormatBundle, key)
+	require.NoError(t, err)
+
+	manifest, err := VerifyBundle(bundle, key)
+	require.NoError(t, err)
+	assert.Equal(t, 2, manifest.Count)
+	assert.Equal(t, int64(1), manifest.FirstSequence)
+	assert.Equal(t, int64(2), manifest.LastSequence)
+	assert.Equal(t, events[1].Hash, manifest.LastHash)
+
+	_, err = VerifyBundle(bundle, []byte("wrong-key"))
+	assert.Error(t, err)
+
+	_, err = ExportEvents(events, "xml", nil)
+	assert.Error(t, err)
+}
+
+func TestSyslogSink_Format(t *testing.T) {
+	sink, err := NewSyslogSink(SyslogSinkConfig{Address: "127.0.0.1:514"})
+	require.NoError(t, err)
+
+	event := newTestAuditEvent(0)
+	event.User.Username = `ev"il]`
+	require.NoError(t, event.Chain(0, ""))
+
+	msg, err := sink.format(event)
+	require.NoError(t, err)
+	assert.Regexp(t, `^<110>1 \d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}Z \S+ tiga \d+ http \[tiga@32473 `, msg)
+	assert.Contains(t, msg, `user="ev\"il\]"`)
+	assert.Contains(t, msg, fmt.Sprintf(`hash="%s"`, event.Hash))
+}
@@ -0,0 +1,221 @@
+package audit
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// Export formats supported by ExportLogs and ExportEvents
+const (
+	ExportFormatJSON   = "json"
+	ExportFormatCSV    = "csv"
+	ExportFormatBundle = "bundle" // zip with events.jsonl, manifest.json and an HMAC signature
+)
+
+// Signed bundle layout
+const (
+	bundleVersion      = "tiga-audit-bundle/v1"
+	bundleRecordsFile  = "events.jsonl"
+	bundleManifestFile = "manifest.json"
+	bundleSigFile      = "manifest.sig"
+)
+
+// ErrSigningKeyRequired is returned when a signed bundle is requested without a signing key
+var ErrSigningKeyRequired = errors.New("audit signing key is not configured")
+
+// BundleManifest describes the contents of a signed export bundle
+type BundleManifest struct {
+	Version       string    `json:"version"`
+	Kind          string    `json:"kind"` // audit_events
+	GeneratedAt   time.Time `json:"generated_at"`
+	Count         int       `json:"count"`
+	RecordsSHA256 string    `json:"records_sha256"`
+	FirstSequence int64     `json:"first_sequence,omitempty"`
+	LastSequence  int64     `json:"last_sequence,omitempty"`
+	LastHash      string    `json:"last_hash,omitempty"` // Chain hash at LastSequence, to detect later truncation
+}
+
+// ExportContentType returns the MIME type and file extension for a format
+func ExportContentType(format string) (contentType, ext string) {
+	switch format {
+	case ExportFormatCSV:
+		return "text/csv", "csv"
+	case ExportFormatBundle:
+		return "application/zip", "zip"
+	default:
+		return "application/json", "json"
+	}
+}
+
+// ExportEvents renders unified audit events in the requested format
+func ExportEvents(events []*models.AuditEvent, format string, signingKey []byte) ([]byte, error) {
+	switch format {
+	case "", ExportFormatJSON:
+		return json.Marshal(events)
+	case ExportFormatCSV:
+		header := []string{"sequence", "id", "timestamp", "subsystem", "action", "resource_type", "resource_id",
+			"user_uid", "username", "client_ip", "request_method", "request_id", "prev_hash", "hash"}
+		rows := make([][]string, 0, len(events))
+		for _, e := range events {
+			rows = append(rows, []string{
+				strconv.FormatInt(e.Sequence, 10),
+				e.ID,
+				time.UnixMilli(e.Timestamp).UTC().Format(time.RFC3339Nano),
+				string(e.Subsystem),
+				string(e.Action),
+				string(e.ResourceType),
+				e.Resource.Identifier,
+				e.User.UID,
+				e.User.Username,
+				e.ClientIP,
+				e.RequestMethod,
+				e.RequestID,
+				e.PrevHash,
+				e.Hash,
+			})
+		}
+		return writeCSV(header, rows)
+	case ExportFormatBundle:
+		records := make([]interface{}, len(events))
+		manifest := BundleManifest{Kind: "audit_events"}
+		for i, e := range events {
+			records[i] = e
+			if e.Sequence > 0 && (manifest.FirstSequence == 0 || e.Sequence < manifest.FirstSequence) {
+				manifest.FirstSequence = e.Sequence
+			}
+			if e.Sequence > manifest.LastSequence {
+				manifest.LastSequence = e.Sequence
+				manifest.LastHash = e.Hash
+			}
+		}
+		return buildSignedBundle(records, manifest, signingKey)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func writeCSV(header []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// buildSignedBundle zips the records as JSON Lines together with a manifest
+// and an HMAC-SHA256 signature of the manifest. The manifest pins the records
+// by hash, so the signature covers the whole bundle.
+func buildSignedBundle(records []interface{}, manifest BundleManifest, signingKey []byte) ([]byte, error) {
+	if len(signingKey) == 0 {
+		return nil, ErrSigningKeyRequired
+	}
+
+	var recordsBuf bytes.Buffer
+	encoder := json.NewEncoder(&recordsBuf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, fmt.Errorf("failed to encode record: %w", err)
+		}
+	}
+
+	sum := sha256.Sum256(recordsBuf.Bytes())
+	manifest.Version = bundleVersion
+	manifest.GeneratedAt = time.Now().UTC()
+	manifest.Count = len(records)
+	manifest.RecordsSHA256 = hex.EncodeToString(sum[:])
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	var out bytes.Buffer
+	zw := zip.NewWriter(&out)
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{bundleRecordsFile, recordsBuf.Bytes()},
+		{bundleManifestFile, manifestBytes},
+		{bundleSigFile, []byte(signBundle(manifestBytes, signingKey))},
+	}
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to bundle: %w", f.name, err)
+		}
+		if _, err := w.Write(f.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s to bundle: %w", f.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize bundle: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+func signBundle(manifest, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(manifest)
+	return "hmac-sha256:" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyBundle checks the signature and records hash of a signed bundle
+// and returns its manifest
+func VerifyBundle(bundle, signingKey []byte) (*BundleManifest, error) {
+	zr, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle: %w", err)
+	}
+
+	contents := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", f.Name, err)
+		}
+		contents[f.Name] = data
+	}
+
+	manifestBytes, sig := contents[bundleManifestFile], contents[bundleSigFile]
+	if manifestBytes == nil || sig == nil {
+		return nil, fmt.Errorf("bundle is missing manifest or signature")
+	}
+	if !hmac.Equal([]byte(signBundle(manifestBytes, signingKey)), sig) {
+		return nil, fmt.Errorf("bundle signature mismatch")
+	}
+
+	var manifest BundleManifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	sum := sha256.Sum256(contents[bundleRecordsFile])
+	if hex.EncodeToString(sum[:]) != manifest.RecordsSHA256 {
+		return nil, fmt.Errorf("bundle records do not match manifest")
+	}
+
+	return &manifest, nil
+}
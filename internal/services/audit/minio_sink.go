@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// MinIOSinkConfig configures the MinIO sink
+type MinIOSinkConfig struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	Bucket    string
+	Prefix    string // Object key prefix (default: audit)
+	// RetentionDays enables S3 object lock in compliance mode when > 0.
+	// The bucket must have been created with object locking enabled.
+	RetentionDays int
+}
+
+// MinIOSink writes each batch of audit events to a new, never-overwritten
+// JSON Lines object. Object keys embed the chain sequence range so the
+// objects sort in chain order and gaps are visible from a bucket listing.
+type MinIOSink struct {
+	config MinIOSinkConfig
+	client *minio.Client
+}
+
+// NewMinIOSink creates a MinIO sink
+func NewMinIOSink(config MinIOSinkConfig) (*MinIOSink, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("minio endpoint and bucket are required")
+	}
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("minio access_key and secret_key are required")
+	}
+	if config.Prefix == "" {
+		config.Prefix = "audit"
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create minio client: %w", err)
+	}
+
+	return &MinIOSink{config: config, client: client}, nil
+}
+
+// Name returns the sink name
+func (s *MinIOSink) Name() string {
+	return "minio"
+}
+
+// Write stores the batch as a new object
+func (s *MinIOSink) Write(ctx context.Context, events []*models.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return fmt.Errorf("failed to marshal audit event: %w", err)
+		}
+	}
+
+	key := s.objectKey(events)
+	opts := minio.PutObjectOptions{ContentType: "application/x-ndjson"}
+	if s.config.RetentionDays > 0 {
+		opts.Mode = minio.Compliance
+		opts.RetainUntilDate = time.Now().AddDate(0, 0, s.config.RetentionDays)
+	}
+
+	if _, err := s.client.PutObject(ctx, s.config.Bucket, key, &buf, int64(buf.Len()), opts); err != nil {
+		return fmt.Errorf("failed to put audit object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// objectKey builds <prefix>/YYYY/MM/DD/<first-seq>-<last-seq>.jsonl
+func (s *MinIOSink) objectKey(events []*models.AuditEvent) string {
+	first, last := events[0], events[len(events)-1]
+	day := time.UnixMilli(first.Timestamp).UTC().Format("2006/01/02")
+	name := fmt.Sprintf("%020d-%020d.jsonl", first.Sequence, last.Sequence)
+	return path.Join(strings.Trim(s.config.Prefix, "/"), day, name)
+}
+
+// Close is a no-op for the MinIO sink
+func (s *MinIOSink) Close() error {
+	return nil
+}
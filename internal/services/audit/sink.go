@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// Sink receives audit events after they are committed to the database.
+// Sinks provide an external copy of the audit trail that database admins cannot edit.
+type Sink interface {
+	// Name identifies the sink in logs
+	Name() string
+	// Write delivers a batch of committed events
+	Write(ctx context.Context, events []*models.AuditEvent) error
+	// Close releases sink resources
+	Close() error
+}
+
+// SinkDispatcher fans committed audit events out to the configured sinks.
+// Dispatch never blocks the audit write path; batches are dropped (and logged)
+// when the queue is full.
+type SinkDispatcher struct {
+	sinks   []Sink
+	queue   chan []*models.AuditEvent
+	timeout time.Duration
+	wg      sync.WaitGroup
+	once    sync.Once
+}
+
+// NewSinkDispatcher creates a dispatcher and starts its delivery goroutine
+func NewSinkDispatcher(sinks []Sink, queueSize int) *SinkDispatcher {
+	if queueSize <= 0 {
+		queueSize = 1000
+	}
+
+	d := &SinkDispatcher{
+		sinks:   sinks,
+		queue:   make(chan []*models.AuditEvent, queueSize),
+		timeout: 10 * time.Second,
+	}
+
+	d.wg.Add(1)
+	go d.run()
+
+	return d
+}
+
+// Dispatch queues committed events for delivery.
+// Its signature matches repository.AuditEventHook.
+func (d *SinkDispatcher) Dispatch(ctx context.Context, events []*models.AuditEvent) {
+	if len(events) == 0 || len(d.sinks) == 0 {
+		return
+	}
+
+	// Copy the slice so callers may reuse their batch buffer
+	batch := make([]*models.AuditEvent, len(events))
+	copy(batch, events)
+
+	select {
+	case d.queue <- batch:
+	default:
+		logrus.Warnf("Audit sink queue full, dropping %d events", len(batch))
+	}
+}
+
+func (d *SinkDispatcher) run() {
+	defer d.wg.Done()
+
+	for batch := range d.queue {
+		for _, sink := range d.sinks {
+			ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+			if err := sink.Write(ctx, batch); err != nil {
+				logrus.Errorf("Audit sink %s failed to write %d events: %v", sink.Name(), len(batch), err)
+			}
+			cancel()
+		}
+	}
+}
+
+// Close drains queued events and closes all sinks
+func (d *SinkDispatcher) Close() error {
+	d.once.Do(func() {
+		close(d.queue)
+		d.wg.Wait()
+		for _, sink := range d.sinks {
+			if err := sink.Close(); err != nil {
+				logrus.Warnf("Failed to close audit sink %s: %v", sink.Name(), err)
+			}
+		}
+	})
+	return nil
+}
+
+// NewSinksFromConfig builds the sinks enabled in the audit configuration
+func NewSinksFromConfig(cfg config.AuditSinksConfig) ([]Sink, error) {
+	var sinks []Sink
+
+	if cfg.Syslog.Address != "" {
+		sink, err := NewSyslogSink(SyslogSinkConfig{
+			Network: cfg.Syslog.Network,
+			Address: cfg.Syslog.Address,
+			AppName: cfg.Syslog.AppName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if cfg.Webhook.URL != "" {
+		sink, err := NewWebhookSink(WebhookSinkConfig{
+			URL:    cfg.Webhook.URL,
+			Secret: cfg.Webhook.Secret,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if cfg.MinIO.Endpoint != "" {
+		sink, err := NewMinIOSink(MinIOSinkConfig{
+			Endpoint:      cfg.MinIO.Endpoint,
+			AccessKey:     cfg.MinIO.AccessKey,
+			SecretKey:     cfg.MinIO.SecretKey,
+			UseSSL:        cfg.MinIO.UseSSL,
+			Bucket:        cfg.MinIO.Bucket,
+			Prefix:        cfg.MinIO.Prefix,
+			RetentionDays: cfg.MinIO.RetentionDays,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
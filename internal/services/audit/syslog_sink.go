@@ -0,0 +1,184 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+const (
+	// syslogFacilityLogAudit is facility 13 ("log audit") from RFC 5424
+	syslogFacilityLogAudit = 13
+	// syslogSeverityInfo is severity 6 ("informational") from RFC 5424
+	syslogSeverityInfo = 6
+	// syslogEnterpriseID scopes the structured data element ID
+	syslogEnterpriseID = "tiga@32473"
+)
+
+// SyslogSinkConfig configures the RFC 5424 syslog sink
+type SyslogSinkConfig struct {
+	Network string // "udp" or "tcp" (default: udp)
+	Address string // host:port
+	AppName string // APP-NAME field (default: tiga)
+}
+
+// SyslogSink streams audit events to a syslog collector in RFC 5424 format.
+// TCP uses octet-counting framing (RFC 6587); UDP sends one message per datagram.
+type SyslogSink struct {
+	config   SyslogSinkConfig
+	hostname string
+	mu       sync.Mutex
+	conn     net.Conn
+}
+
+// NewSyslogSink creates a syslog sink; the connection is opened lazily
+func NewSyslogSink(config SyslogSinkConfig) (*SyslogSink, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("syslog address is required")
+	}
+	if config.Network == "" {
+		config.Network = "udp"
+	}
+	if config.Network != "udp" && config.Network != "tcp" {
+		return nil, fmt.Errorf("unsupported syslog network: %s", config.Network)
+	}
+	if config.AppName == "" {
+		config.AppName = "tiga"
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &SyslogSink{config: config, hostname: hostname}, nil
+}
+
+// Name returns the sink name
+func (s *SyslogSink) Name() string {
+	return "syslog"
+}
+
+// Write sends each event as a separate syslog message
+func (s *SyslogSink) Write(ctx context.Context, events []*models.AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range events {
+		msg, err := s.format(event)
+		if err != nil {
+			return err
+		}
+		if s.config.Network == "tcp" {
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+
+		if err := s.send(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// send writes one message, reconnecting once on failure
+func (s *SyslogSink) send(ctx context.Context, msg string) error {
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			var dialer net.Dialer
+			conn, err := dialer.DialContext(ctx, s.config.Network, s.config.Address)
+			if err != nil {
+				return fmt.Errorf("failed to connect to syslog: %w", err)
+			}
+			s.conn = conn
+		}
+
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = s.conn.SetWriteDeadline(deadline)
+		}
+		if _, err := s.conn.Write([]byte(msg)); err == nil {
+			return nil
+		} else if attempt == 1 {
+			return fmt.Errorf("failed to write syslog message: %w", err)
+		}
+
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	return nil
+}
+
+// format renders an event as an RFC 5424 message:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD-ELEMENT] MSG
+func (s *SyslogSink) format(event *models.AuditEvent) (string, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+
+	pri := syslogFacilityLogAudit*8 + syslogSeverityInfo
+	timestamp := time.UnixMilli(event.Timestamp).UTC().Format("2006-01-02T15:04:05.000Z")
+	msgID := syslogHeaderValue(string(event.Subsystem), 32)
+
+	sd := fmt.Sprintf(`[%s id="%s" seq="%d" action="%s" resource="%s" user="%s" hash="%s"]`,
+		syslogEnterpriseID,
+		escapeSDParam(event.ID),
+		event.Sequence,
+		escapeSDParam(string(event.Action)),
+		escapeSDParam(string(event.ResourceType)),
+		escapeSDParam(event.User.Username),
+		escapeSDParam(event.Hash),
+	)
+
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s %s %s",
+		pri,
+		timestamp,
+		syslogHeaderValue(s.hostname, 255),
+		syslogHeaderValue(s.config.AppName, 48),
+		os.Getpid(),
+		msgID,
+		sd,
+		body,
+	), nil
+}
+
+// syslogHeaderValue returns a header field value restricted to printable US-ASCII without spaces
+func syslogHeaderValue(value string, maxLen int) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r > 32 && r < 127 {
+			b.WriteRune(r)
+		}
+		if b.Len() >= maxLen {
+			break
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
+
+// escapeSDParam escapes '"', '\' and ']' in structured data parameter values
+func escapeSDParam(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
+
+// Close closes the syslog connection
+func (s *SyslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// WebhookSignatureHeader carries the HMAC-SHA256 of the request body when a secret is configured
+const WebhookSignatureHeader = "X-Tiga-Signature"
+
+// WebhookSinkConfig configures the webhook sink
+type WebhookSinkConfig struct {
+	URL     string
+	Secret  string        // Optional HMAC secret used to sign request bodies
+	Timeout time.Duration // Request timeout (default: 10s)
+}
+
+// WebhookSink posts batches of audit events as JSON to an HTTP endpoint
+type WebhookSink struct {
+	config     WebhookSinkConfig
+	httpClient *http.Client
+}
+
+// webhookPayload is the body sent to the webhook
+type webhookPayload struct {
+	Events []*models.AuditEvent `json:"events"`
+}
+
+// NewWebhookSink creates a webhook sink
+func NewWebhookSink(config WebhookSinkConfig) (*WebhookSink, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("webhook URL is required")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &WebhookSink{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// Name returns the sink name
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Write posts the batch to the webhook
+func (s *WebhookSink) Write(ctx context.Context, events []*models.AuditEvent) error {
+	body, err := json.Marshal(webhookPayload{Events: events})
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit events: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.config.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.config.Secret))
+		mac.Write(body)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Close is a no-op for the webhook sink
+func (s *WebhookSink) Close() error {
+	return nil
+}
@@ -0,0 +1,493 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/notification"
+	"github.com/ysicing/tiga/pkg/rbac"
+)
+
+// Access request errors
+var (
+	ErrAccessRequestNotFound   = errors.New("access request not found")
+	ErrAccessRequestNotPending = errors.New("access request is not pending")
+	ErrAccessRequestNotActive  = errors.New("access request is not active")
+	ErrAccessRequestSelfReview = errors.New("requesters cannot review their own access request")
+)
+
+// DefaultMaxAccessDuration caps the duration of a just-in-time grant when not configured
+const DefaultMaxAccessDuration = 8 * time.Hour
+
+// AccessRequestService implements the request/approve flow for just-in-time privileged access.
+// Approved requests are backed by a dedicated "jit:<id>" role assigned through RBACService
+// with an expiry; pkg/rbac picks the binding up on its next sync.
+type AccessRequestService struct {
+	db              *gorm.DB
+	rbacService     *RBACService
+	notificationSvc *notification.NotificationService
+	channels        []string
+	maxDuration     time.Duration
+}
+
+// NewAccessRequestService creates a new AccessRequestService
+func NewAccessRequestService(db *gorm.DB, rbacService *RBACService, notificationSvc *notification.NotificationService, channels []string, maxDuration time.Duration) *AccessRequestService {
+	if maxDuration <= 0 {
+		maxDuration = DefaultMaxAccessDuration
+	}
+	return &AccessRequestService{
+		db:              db,
+		rbacService:     rbacService,
+		notificationSvc: notificationSvc,
+		channels:        channels,
+		maxDuration:     maxDuration,
+	}
+}
+
+// NewAccessNotificationService builds the notification service used to reach approvers.
+// It returns no channels when webhookURL is empty, which disables notifications.
+func NewAccessNotificationService(webhookURL string) (*notification.NotificationService, []string) {
+	notificationSvc := notification.NewNotificationService()
+	if webhookURL == "" {
+		return notificationSvc, nil
+	}
+
+	if err := notificationSvc.RegisterNotifier("webhook", notification.NewWebhookNotifier(&notification.WebhookConfig{
+		URL:    webhookURL,
+		Method: "POST",
+	})); err != nil {
+		logrus.Warnf("Failed to register access request webhook notifier: %v", err)
+		return notificationSvc, nil
+	}
+
+	return notificationSvc, []string{"webhook"}
+}
+
+// CreateAccessRequest represents a new access request
+type CreateAccessRequest struct {
+	ScopeType       models.AccessScopeType `json:"scope_type" binding:"required"`
+	Cluster         string                 `json:"cluster"`
+	Namespace       string                 `json:"namespace"`
+	HostGroup       string                 `json:"host_group"`
+	Reason          string                 `json:"reason" binding:"required"`
+	DurationMinutes int                    `json:"duration_minutes" binding:"required,min=1"`
+}
+
+// AccessRequestFilter filters access request listings
+type AccessRequestFilter struct {
+	RequesterID *uuid.UUID
+	Status      string
+	Page        int
+	PageSize    int
+}
+
+// Create validates and stores a pending request, then notifies approvers
+func (s *AccessRequestService) Create(ctx context.Context, requester *models.User, req *CreateAccessRequest) (*models.AccessRequest, error) {
+	req.Cluster = strings.TrimSpace(req.Cluster)
+	req.Namespace = strings.TrimSpace(req.Namespace)
+	req.HostGroup = strings.TrimSpace(req.HostGroup)
+
+	switch req.ScopeType {
+	case models.AccessScopeK8sExec:
+		if req.Cluster == "" || req.Namespace == "" {
+			return nil, fmt.Errorf("cluster and namespace are required for %s access", req.ScopeType)
+		}
+	case models.AccessScopeWebSSH:
+		if req.HostGroup == "" {
+			return nil, fmt.Errorf("host_group is required for %s access", req.ScopeType)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported scope type: %s", req.ScopeType)
+	}
+
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+	if time.Duration(req.DurationMinutes)*time.Minute > s.maxDuration {
+		return nil, fmt.Errorf("duration exceeds the maximum of %d minutes", int(s.maxDuration.Minutes()))
+	}
+
+	accessReq := &models.AccessRequest{
+		RequesterID:     requester.ID,
+		RequesterName:   requester.Username,
+		ScopeType:       req.ScopeType,
+		Cluster:         req.Cluster,
+		Namespace:       req.Namespace,
+		HostGroup:       req.HostGroup,
+		Reason:          strings.TrimSpace(req.Reason),
+		DurationMinutes: req.DurationMinutes,
+		Status:          models.AccessRequestPending,
+	}
+
+	if err := s.db.WithContext(ctx).Create(accessReq).Error; err != nil {
+		return nil, fmt.Errorf("failed to create access request: %w", err)
+	}
+
+	s.notify(ctx, accessReq, "Access request awaiting approval",
+		fmt.Sprintf("%s requests %s for %d minutes: %s", accessReq.RequesterName, describeScope(accessReq), accessReq.DurationMinutes, accessReq.Reason),
+		notification.SeverityWarning)
+
+	return accessReq, nil
+}
+
+// Get retrieves an access request by ID
+func (s *AccessRequestService) Get(ctx context.Context, id uuid.UUID) (*models.AccessRequest, error) {
+	var accessReq models.AccessRequest
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&accessReq).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccessRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get access request: %w", err)
+	}
+	return &accessReq, nil
+}
+
+// List lists access requests with filtering and pagination
+func (s *AccessRequestService) List(ctx context.Context, filter AccessRequestFilter) ([]*models.AccessRequest, int64, error) {
+	query := s.db.WithContext(ctx).Model(&models.AccessRequest{})
+
+	if filter.RequesterID != nil {
+		query = query.Where("requester_id = ?", *filter.RequesterID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count access requests: %w", err)
+	}
+
+	if filter.PageSize <= 0 {
+		filter.PageSize = 20
+	}
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+
+	var requests []*models.AccessRequest
+	if err := query.Order("created_at DESC").
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&requests).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list access requests: %w", err)
+	}
+
+	return requests, total, nil
+}
+
+// Approve grants a pending request: it creates the scoped role and binds it to the requester with an expiry.
+// The claim, role and binding are written in one transaction, so a failed or concurrent approval leaves nothing behind.
+func (s *AccessRequestService) Approve(ctx context.Context, id uuid.UUID, approver *models.User, note string) (*models.AccessRequest, error) {
+	accessReq, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if accessReq.Status != models.AccessRequestPending {
+		return nil, ErrAccessRequestNotPending
+	}
+	if accessReq.RequesterID == approver.ID {
+		return nil, ErrAccessRequestSelfReview
+	}
+
+	permissions, err := scopePermissions(accessReq)
+	if err != nil {
+		return nil, err
+	}
+
+	roleID := uuid.New()
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(accessReq.DurationMinutes) * time.Minute)
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Claim the request first so concurrent decisions cannot both apply
+		if err := transition(tx, accessReq.ID, models.AccessRequestPending, ErrAccessRequestNotPending, map[string]interface{}{
+			"status":        models.AccessRequestApproved,
+			"approver_id":   approver.ID,
+			"approver_name": approver.Username,
+			"decision_note": note,
+			"decided_at":    now,
+			"expires_at":    expiresAt,
+			"role_id":       roleID,
+		}); err != nil {
+			return err
+		}
+
+		// Permissions are a JSON array, which models.JSONB cannot represent,
+		// so the role row is inserted directly (same as the default role seeding)
+		if err := tx.Exec(
+			"INSERT INTO roles (id, name, display_name, description, permissions, is_system, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			roleID.String(),
+			accessReq.JITRoleName(),
+			"JIT "+describeScope(accessReq),
+			accessReq.Reason,
+			permissions,
+			true,
+			now,
+			now,
+		).Error; err != nil {
+			return fmt.Errorf("failed to create access role: %w", err)
+		}
+
+		return s.rbacService.WithTx(tx).AssignRoleWithExpiry(ctx, accessReq.RequesterID, roleID, approver.ID, &expiresAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessReq.Status = models.AccessRequestApproved
+	accessReq.ApproverID = &approver.ID
+	accessReq.ApproverName = approver.Username
+	accessReq.DecisionNote = note
+	accessReq.DecidedAt = &now
+	accessReq.ExpiresAt = &expiresAt
+	accessReq.RoleID = &roleID
+
+	rbac.TriggerSync()
+
+	s.notify(ctx, accessReq, "Access request approved",
+		fmt.Sprintf("%s approved %s for %s until %s", approver.Username, describeScope(accessReq), accessReq.RequesterName, expiresAt.Format(time.RFC3339)),
+		notification.SeverityInfo)
+
+	return accessReq, nil
+}
+
+// Reject declines a pending request
+func (s *AccessRequestService) Reject(ctx context.Context, id uuid.UUID, approver *models.User, note string) (*models.AccessRequest, error) {
+	accessReq, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if accessReq.Status != models.AccessRequestPending {
+		return nil, ErrAccessRequestNotPending
+	}
+	if accessReq.RequesterID == approver.ID {
+		return nil, ErrAccessRequestSelfReview
+	}
+
+	now := time.Now()
+	if err := transition(s.db.WithContext(ctx), accessReq.ID, models.AccessRequestPending, ErrAccessRequestNotPending, map[string]interface{}{
+		"status":        models.AccessRequestRejected,
+		"approver_id":   approver.ID,
+		"approver_name": approver.Username,
+		"decision_note": note,
+		"decided_at":    now,
+	}); err != nil {
+		return nil, err
+	}
+
+	accessReq.Status = models.AccessRequestRejected
+	accessReq.ApproverID = &approver.ID
+	accessReq.ApproverName = approver.Username
+	accessReq.DecisionNote = note
+	accessReq.DecidedAt = &now
+
+	return accessReq, nil
+}
+
+// Cancel withdraws a pending request on behalf of its requester
+func (s *AccessRequestService) Cancel(ctx context.Context, id uuid.UUID, requesterID uuid.UUID) (*models.AccessRequest, error) {
+	accessReq, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if accessReq.RequesterID != requesterID {
+		return nil, ErrAccessRequestNotFound
+	}
+	if accessReq.Status != models.AccessRequestPending {
+		return nil, ErrAccessRequestNotPending
+	}
+
+	if err := transition(s.db.WithContext(ctx), accessReq.ID, models.AccessRequestPending, ErrAccessRequestNotPending, map[string]interface{}{
+		"status": models.AccessRequestCancelled,
+	}); err != nil {
+		return nil, err
+	}
+	accessReq.Status = models.AccessRequestCancelled
+
+	return accessReq, nil
+}
+
+// Revoke ends an approved grant before it expires
+func (s *AccessRequestService) Revoke(ctx context.Context, id uuid.UUID, revoker *models.User, note string) (*models.AccessRequest, error) {
+	accessReq, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if accessReq.Status != models.AccessRequestApproved {
+		return nil, ErrAccessRequestNotActive
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":     models.AccessRequestRevoked,
+		"revoked_at": now,
+	}
+	if note != "" {
+		updates["decision_note"] = note
+	}
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := transition(tx, accessReq.ID, models.AccessRequestApproved, ErrAccessRequestNotActive, updates); err != nil {
+			return err
+		}
+		return s.release(ctx, tx, accessReq)
+	}); err != nil {
+		return nil, err
+	}
+
+	accessReq.Status = models.AccessRequestRevoked
+	accessReq.RevokedAt = &now
+	if note != "" {
+		accessReq.DecisionNote = note
+	}
+
+	rbac.TriggerSync()
+
+	s.notify(ctx, accessReq, "Access grant revoked",
+		fmt.Sprintf("%s revoked %s for %s", revoker.Username, describeScope(accessReq), accessReq.RequesterName),
+		notification.SeverityInfo)
+
+	return accessReq, nil
+}
+
+// ExpireDue revokes all approved grants whose expiry has passed and returns how many were expired
+func (s *AccessRequestService) ExpireDue(ctx context.Context) (int, error) {
+	var due []*models.AccessRequest
+	if err := s.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", models.AccessRequestApproved, time.Now()).
+		Find(&due).Error; err != nil {
+		return 0, fmt.Errorf("failed to find expired access requests: %w", err)
+	}
+
+	expired := 0
+	for _, accessReq := range due {
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := transition(tx, accessReq.ID, models.AccessRequestApproved, ErrAccessRequestNotActive, map[string]interface{}{
+				"status": models.AccessRequestExpired,
+			}); err != nil {
+				return err
+			}
+			return s.release(ctx, tx, accessReq)
+		})
+		if errors.Is(err, ErrAccessRequestNotActive) {
+			// Revoked in the meantime
+			continue
+		}
+		if err != nil {
+			logrus.Errorf("Failed to expire access request %s: %v", accessReq.ID, err)
+			continue
+		}
+		accessReq.Status = models.AccessRequestExpired
+		expired++
+	}
+
+	if expired > 0 {
+		rbac.TriggerSync()
+	}
+
+	return expired, nil
+}
+
+// transition moves a request out of status from, applying updates only if no
+// other transition got there first; otherwise it returns notInStatus
+func transition(tx *gorm.DB, id uuid.UUID, from models.AccessRequestStatus, notInStatus error, updates map[string]interface{}) error {
+	result := tx.Model(&models.AccessRequest{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update access request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return notInStatus
+	}
+	return nil
+}
+
+// release removes the role binding and the JIT role backing a request using tx
+func (s *AccessRequestService) release(ctx context.Context, tx *gorm.DB, accessReq *models.AccessRequest) error {
+	if accessReq.RoleID == nil {
+		return nil
+	}
+
+	if err := s.rbacService.WithTx(tx).RevokeRole(ctx, accessReq.RequesterID, *accessReq.RoleID); err != nil &&
+		!errors.Is(err, rbac.ErrRoleAssignmentNotFound) {
+		return err
+	}
+
+	if err := tx.Unscoped().Where("id = ?", *accessReq.RoleID).Delete(&models.Role{}).Error; err != nil {
+		return fmt.Errorf("failed to delete access role: %w", err)
+	}
+
+	return nil
+}
+
+// notify sends a notification about a request to the configured approver channels (best effort)
+func (s *AccessRequestService) notify(ctx context.Context, accessReq *models.AccessRequest, title, message string, severity notification.Severity) {
+	if s.notificationSvc == nil || len(s.channels) == 0 {
+		return
+	}
+
+	notif := &notification.Notification{
+		Title:    title,
+		Message:  message,
+		Severity: severity,
+		Metadata: map[string]interface{}{
+			"access_request_id": accessReq.ID.String(),
+			"requester":         accessReq.RequesterName,
+			"scope_type":        string(accessReq.ScopeType),
+			"status":            string(accessReq.Status),
+		},
+	}
+	if err := s.notificationSvc.Send(ctx, s.channels, notif); err != nil {
+		logrus.Warnf("Failed to send access request notification: %v", err)
+	}
+}
+
+// scopePermissions builds the permissions column of the role backing a request
+func scopePermissions(accessReq *models.AccessRequest) (string, error) {
+	var perm map[string]interface{}
+	switch accessReq.ScopeType {
+	case models.AccessScopeK8sExec:
+		perm = map[string]interface{}{
+			"resource":   "pods",
+			"actions":    []string{"exec"},
+			"clusters":   []string{accessReq.Cluster},
+			"namespaces": []string{accessReq.Namespace},
+		}
+	case models.AccessScopeWebSSH:
+		perm = map[string]interface{}{
+			"resource":    "hosts",
+			"actions":     []string{"webssh"},
+			"host_groups": []string{accessReq.HostGroup},
+		}
+	default:
+		return "", fmt.Errorf("unsupported scope type: %s", accessReq.ScopeType)
+	}
+
+	raw, err := json.Marshal([]interface{}{perm})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode permissions: %w", err)
+	}
+	return string(raw), nil
+}
+
+// describeScope renders a human readable summary of the requested scope
+func describeScope(accessReq *models.AccessRequest) string {
+	switch accessReq.ScopeType {
+	case models.AccessScopeK8sExec:
+		return fmt.Sprintf("exec in cluster %s namespace %s", accessReq.Cluster, accessReq.Namespace)
+	case models.AccessScopeWebSSH:
+		return fmt.Sprintf("WebSSH to host group %s", accessReq.HostGroup)
+	default:
+		return string(accessReq.ScopeType)
+	}
+}
@@ -0,0 +1,304 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func setupAccessTestDB(t *testing.T) *gorm.DB {
+	db := testdb.Open(t, &models.User{}, &models.Role{}, &models.UserRole{}, &models.AccessRequest{})
+	return db
+}
+
+func createAccessTestUser(t *testing.T, db *gorm.DB, username string, admin bool) *models.User {
+	user := &models.User{
+		Username: username,
+		Email:    username + "@example.com",
+		Password: "hashed",
+		IsAdmin:  admin,
+	}
+	require.NoError(t, db.Create(user).Error)
+	return user
+}
+
+func TestAccessRequestService_Lifecycle(t *testing.T) {
+	db := setupAccessTestDB(t)
+	svc := NewAccessRequestService(db, NewRBACService(db), nil, nil, time.Hour)
+	ctx := context.Background()
+
+	requester := createAccessTestUser(t, db, "alice", false)
+	approver := createAccessTestUser(t, db, "admin", true)
+
+	t.Run("rejects duration above maximum", func(t *testing.T) {
+		_, err := svc.Create(ctx, requester, &CreateAccessRequest{
+			ScopeType:       models.AccessScopeK8sExec,
+			Cluster:         "prod",
+			Namespace:       "payments",
+			Reason:          "incident",
+			DurationMinutes: 120,
+		})
+		assert.Error(t, err)
+	})
+
+	req, err := svc.Create(ctx, requester, &CreateAccessRequest{
+		ScopeType:       models.AccessScopeK8sExec,
+		Cluster:         "prod",
+		Namespace:       "payments",
+		Reason:          "incident",
+		DurationMinutes: 30,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.AccessRequestPending, req.Status)
+
+	t.Run("requester cannot approve own request", func(t *testing.T) {
+		_, err := svc.Approve(ctx, req.ID, requester, "")
+		assert.ErrorIs(t, err, ErrAccessRequestSelfReview)
+	})
+
+	approved, err := svc.Approve(ctx, req.ID, approver, "ok")
+	require.NoError(t, err)
+	assert.Equal(t, models.AccessRequestApproved, approved.Status)
+	require.NotNil(t, approved.RoleID)
+	require.NotNil(t, approved.ExpiresAt)
+	assert.True(t, approved.IsActive())
+
+	var binding models.UserRole
+	require.NoError(t, db.Where("user_id = ? AND role_id = ?", requester.ID, *approved.RoleID).First(&binding).Error)
+	require.NotNil(t, binding.ExpiresAt)
+
+	t.Run("approved request cannot be approved again", func(t *testing.T) {
+		_, err := svc.Approve(ctx, req.ID, approver, "")
+		assert.ErrorIs(t, err, ErrAccessRequestNotPending)
+	})
+
+	t.Run("expiry sweep releases the grant", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		require.NoError(t, db.Model(&models.AccessRequest{}).Where("id = ?", req.ID).Update("expires_at", past).Error)
+
+		expired, err := svc.ExpireDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, expired)
+
+		got, err := svc.Get(ctx, req.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AccessRequestExpired, got.Status)
+
+		var count int64
+		db.Model(&models.UserRole{}).Where("role_id = ?", *approved.RoleID).Count(&count)
+		assert.Zero(t, count)
+		db.Unscoped().Model(&models.Role{}).Where("id = ?", *approved.RoleID).Count(&count)
+		assert.Zero(t, count)
+	})
+}
+
+func TestAccessRequestService_CancelAndRevoke(t *testing.T) {
+	db := setupAccessTestDB(t)
+	svc := NewAccessRequestService(db, NewRBACService(db), nil, nil, 0)
+	ctx := context.Background()
+
+	requester := createAccessTestUser(t, db, "bob", false)
+	approver := createAccessTestUser(t, db, "admin", true)
+
+	pending, err := svc.Create(ctx, requester, &CreateAccessRequest{
+		ScopeType:       models.AccessScopeWebSSH,
+		HostGroup:       "db",
+		Reason:          "maintenance",
+		DurationMinutes: 60,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Reject(ctx, pending.ID, requester, "")
+	assert.ErrorIs(t, err, ErrAccessRequestSelfReview)
+
+	cancelled, err := svc.Cancel(ctx, pending.ID, requester.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.AccessRequestCancelled, cancelled.Status)
+
+	granted, err := svc.Create(ctx, requester, &CreateAccessRequest{
+		ScopeType:       models.AccessScopeWebSSH,
+		HostGroup:       "db",
+		Reason:          "maintenance",
+		DurationMinutes: 60,
+	})
+	require.NoError(t, err)
+	_, err = svc.Approve(ctx, granted.ID, approver, "")
+	require.NoError(t, err)
+
+	revoked, err := svc.Revoke(ctx, granted.ID, approver, "done")
+	require.NoError(t, err)
+	assert.Equal(t, models.AccessRequestRevoked, revoked.Status)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	_, err = svc.Revoke(ctx, granted.ID, approver, "")
+	assert.ErrorIs(t, err, ErrAccessRequestNotActive)
+}
+
+func TestAccessRequestService_ApproveIsAtomic(t *testing.T) {
+	db := setupAccessTestDB(t)
+	svc := NewAccessRequestService(db, NewRBACService(db), nil, nil, 0)
+	ctx := context.Background()
+
+	requester := createAccessTestUser(t, db, "carol", false)
+	approver := createAccessTestUser(t, db, "admin", true)
+
+	req, err := svc.Create(ctx, requester, &CreateAccessRequest{
+		ScopeType:       models.AccessScopeWebSSH,
+		HostGroup:       "db",
+		Reason:          "maintenance",
+		DurationMinutes: 60,
+	})
+	require.NoError(t, err)
+
+	t.Run("failed approval leaves the request pending", func(t *testing.T) {
+		// A clashing role name makes the role insert fail after the request was claimed
+		require.NoError(t, db.Exec("INSERT INTO roles (id, name, display_name, permissions, is_system) VALUES (?, ?, ?, ?, ?)",
+			uuid.New().String(), req.JITRoleName(), "clash", "[]", false).Error)
+
+		_, err := svc.Approve(ctx, req.ID, approver, "")
+		require.Error(t, err)
+
+		got, err := svc.Get(ctx, req.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AccessRequestPending, got.Status)
+		assert.Nil(t, got.RoleID)
+
+		var count int64
+		db.Model(&models.UserRole{}).Where("user_id = ?", requester.ID).Count(&count)
+		assert.Zero(t, count)
+
+		require.NoError(t, db.Exec("DELETE FROM roles WHERE name = ?", req.JITRoleName()).Error)
+	})
+
+	t.Run("concurrent approvals grant once", func(t *testing.T) {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var approved, notPending int
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := svc.Approve(ctx, req.ID, approver, "")
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case err == nil:
+					approved++
+				case errors.Is(err, ErrAccessRequestNotPending):
+					notPending++
+				default:
+					t.Errorf("unexpected error: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, approved)
+		assert.Equal(t, 4, notPending)
+
+		var count int64
+		db.Model(&models.UserRole{}).Where("user_id = ?", requester.ID).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+}
+
+func TestAccessRequestService_ConcurrentDecisions(t *testing.T) {
+	db := setupAccessTestDB(t)
+	svc := NewAccessRequestService(db, NewRBACService(db), nil, nil, 0)
+	ctx := context.Background()
+
+	requester := createAccessTestUser(t, db, "dave", false)
+	approver := createAccessTestUser(t, db, "admin", true)
+
+	create := func() *models.AccessRequest {
+		req, err := svc.Create(ctx, requester, &CreateAccessRequest{
+			ScopeType:       models.AccessScopeWebSSH,
+			HostGroup:       "db",
+			Reason:          "maintenance",
+			DurationMinutes: 60,
+		})
+		require.NoError(t, err)
+		return req
+	}
+	bindings := func() int64 {
+		var count int64
+		db.Model(&models.UserRole{}).Where("user_id = ?", requester.ID).Count(&count)
+		return count
+	}
+
+	t.Run("reject racing approve", func(t *testing.T) {
+		req := create()
+
+		var wg sync.WaitGroup
+		var approveErr, rejectErr error
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, approveErr = svc.Approve(ctx, req.ID, approver, "")
+		}()
+		go func() {
+			defer wg.Done()
+			_, rejectErr = svc.Reject(ctx, req.ID, approver, "")
+		}()
+		wg.Wait()
+
+		got, err := svc.Get(ctx, req.ID)
+		require.NoError(t, err)
+		if approveErr == nil {
+			assert.ErrorIs(t, rejectErr, ErrAccessRequestNotPending)
+			assert.Equal(t, models.AccessRequestApproved, got.Status)
+			assert.NotNil(t, got.RoleID)
+			assert.Equal(t, int64(1), bindings())
+			_, err := svc.Revoke(ctx, req.ID, approver, "")
+			require.NoError(t, err)
+		} else {
+			assert.ErrorIs(t, approveErr, ErrAccessRequestNotPending)
+			require.NoError(t, rejectErr)
+			assert.Equal(t, models.AccessRequestRejected, got.Status)
+		}
+		assert.Zero(t, bindings())
+	})
+
+	t.Run("revoke racing expiry", func(t *testing.T) {
+		req := create()
+		approved, err := svc.Approve(ctx, req.ID, approver, "")
+		require.NoError(t, err)
+		require.NoError(t, db.Model(&models.AccessRequest{}).Where("id = ?", req.ID).
+			Update("expires_at", time.Now().Add(-time.Minute)).Error)
+
+		var wg sync.WaitGroup
+		var revokeErr error
+		var expired int
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, revokeErr = svc.Revoke(ctx, req.ID, approver, "")
+		}()
+		go func() {
+			defer wg.Done()
+			expired, _ = svc.ExpireDue(ctx)
+		}()
+		wg.Wait()
+
+		if revokeErr == nil {
+			assert.Zero(t, expired)
+		} else {
+			assert.ErrorIs(t, revokeErr, ErrAccessRequestNotActive)
+			assert.Equal(t, 1, expired)
+		}
+		assert.Zero(t, bindings())
+		var count int64
+		db.Unscoped().Model(&models.Role{}).Where("id = ?", *approved.RoleID).Count(&count)
+		assert.Zero(t, count)
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/rbac"
 )
 
 // RBACService handles role-based access control
@@ -23,6 +25,11 @@ func NewRBACService(db *gorm.DB) *RBACService {
 	}
 }
 
+// WithTx returns a copy of the service that runs its queries in tx
+func (s *RBACService) WithTx(tx *gorm.DB) *RBACService {
+	return &RBACService{db: tx}
+}
+
 // Permission represents a permission check
 type Permission struct {
 	Resource string   // Resource type (instance, user, role, etc.)
@@ -120,14 +127,19 @@ func (s *RBACService) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]*mo
 
 // AssignRole assigns a role to a user
 func (s *RBACService) AssignRole(ctx context.Context, userID, roleID, grantedBy uuid.UUID) error {
-	// Check if role exists
-	var role models.Role
-	if err := s.db.WithContext(ctx).Where("id = ?", roleID).First(&role).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("role not found")
-		}
+	return s.AssignRoleWithExpiry(ctx, userID, roleID, grantedBy, nil)
+}
+
+// AssignRoleWithExpiry assigns a role to a user that lapses at expiresAt (nil means permanent)
+func (s *RBACService) AssignRoleWithExpiry(ctx context.Context, userID, roleID, grantedBy uuid.UUID, expiresAt *time.Time) error {
+	// Check if role exists (count only: array-shaped permissions don't scan into models.JSONB)
+	var roleCount int64
+	if err := s.db.WithContext(ctx).Model(&models.Role{}).Where("id = ?", roleID).Count(&roleCount).Error; err != nil {
 		return fmt.Errorf("failed to find role: %w", err)
 	}
+	if roleCount == 0 {
+		return fmt.Errorf("role not found")
+	}
 
 	// Check if user already has the role
 	var existingUserRole models.UserRole
@@ -147,6 +159,8 @@ func (s *RBACService) AssignRole(ctx context.Context, userID, roleID, grantedBy
 		UserID:    userID,
 		RoleID:    roleID,
 		GrantedBy: &grantedBy,
+		GrantedAt: time.Now(),
+		ExpiresAt: expiresAt,
 	}
 
 	if err := s.db.WithContext(ctx).Create(userRole).Error; err != nil {
@@ -167,7 +181,7 @@ func (s *RBACService) RevokeRole(ctx context.Context, userID, roleID uuid.UUID)
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("role assignment not found")
+		return rbac.ErrRoleAssignmentNotFound
 	}
 
 	return nil
@@ -282,6 +282,45 @@ func (f *AgentForwarder) DeleteContainer(agentID uuid.UUID, req *pb.DeleteContai
 	return client.DeleteContainer(ctx, req)
 }
 
+// PruneContainers forwards PruneContainers request to the agent
+func (f *AgentForwarder) PruneContainers(agentID uuid.UUID, req *pb.PruneContainersRequest) (*pb.PruneContainersResponse, error) {
+	client, err := f.getClient(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	return client.PruneContainers(ctx, req)
+}
+
+// RecreateContainer forwards RecreateContainer request to the agent
+func (f *AgentForwarder) RecreateContainer(agentID uuid.UUID, req *pb.RecreateContainerRequest) (*pb.RecreateContainerResponse, error) {
+	client, err := f.getClient(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), recreateTaskTimeout)
+	defer cancel()
+
+	return client.RecreateContainer(ctx, req)
+}
+
+// ListContainerFiles forwards ListContainerFiles request to the agent
+func (f *AgentForwarder) ListContainerFiles(agentID uuid.UUID, req *pb.ListContainerFilesRequest) (*pb.ListContainerFilesResponse, error) {
+	client, err := f.getClient(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	return client.ListContainerFiles(ctx, req)
+}
+
 // ListImages forwards ListImages request to the agent
 func (f *AgentForwarder) ListImages(agentID uuid.UUID, req *pb.ListImagesRequest) (*pb.ListImagesResponse, error) {
 	client, err := f.getClient(agentID)
@@ -334,6 +373,19 @@ func (f *AgentForwarder) TagImage(agentID uuid.UUID, req *pb.TagImageRequest) (*
 	return client.TagImage(ctx, req)
 }
 
+// PruneImages forwards PruneImages request to the agent
+func (f *AgentForwarder) PruneImages(agentID uuid.UUID, req *pb.PruneImagesRequest) (*pb.PruneImagesResponse, error) {
+	client, err := f.getClient(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	return client.PruneImages(ctx, req)
+}
+
 // GetContainerStats forwards GetContainerStats streaming request to the agent
 // Returns the stream client for the caller to read from
 func (f *AgentForwarder) GetContainerStats(agentID uuid.UUID, req *pb.GetContainerStatsRequest) (pb.DockerService_GetContainerStatsClient, error) {
@@ -577,6 +629,19 @@ func (f *AgentForwarder) GetDiskUsage(agentID uuid.UUID, req *pb.GetDiskUsageReq
 	return client.GetDiskUsage(ctx, req)
 }
 
+// PruneBuildCache forwards PruneBuildCache request to the agent
+func (f *AgentForwarder) PruneBuildCache(agentID uuid.UUID, req *pb.PruneBuildCacheRequest) (*pb.PruneBuildCacheResponse, error) {
+	client, err := f.getClient(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	return client.PruneBuildCache(ctx, req)
+}
+
 // Ping forwards Ping request to the agent
 func (f *AgentForwarder) Ping(agentID uuid.UUID, req *pb.PingRequest) (*pb.PingResponse, error) {
 	client, err := f.getClient(agentID)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,11 +14,18 @@ import (
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/proto"
 
+	engine "github.com/ysicing/tiga/internal/docker"
 	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
 )
 
 const (
 	defaultTaskTimeout = 30 * time.Second
+	// recreateTaskTimeout covers stopping the old container and waiting for
+	// the new one to become healthy
+	recreateTaskTimeout = 5 * time.Minute
+	// swarmTaskTimeout covers swarm calls that query the registry or create
+	// and update several objects
+	swarmTaskTimeout = 2 * time.Minute
 )
 
 // AgentManager interface for task queue operations
@@ -25,10 +33,12 @@ type AgentManager interface {
 	QueueTaskAndWait(ctx context.Context, uuid string, task *proto.AgentTask, timeout time.Duration) (*proto.TaskResult, error)
 }
 
-// AgentForwarderV2 forwards Docker operations to agents via task queue
+// AgentForwarderV2 forwards Docker operations to agents via task queue, or
+// runs them itself for instances connected directly
 type AgentForwarderV2 struct {
 	agentManager AgentManager
 	db           *gorm.DB
+	direct       *DirectBackend
 }
 
 // NewAgentForwarderV2 creates a new AgentForwarderV2 instance
@@ -36,24 +46,25 @@ func NewAgentForwarderV2(agentManager AgentManager, db *gorm.DB) *AgentForwarder
 	return &AgentForwarderV2{
 		agentManager: agentManager,
 		db:           db,
+		direct:       NewDirectBackend(db),
 	}
 }
 
-// getHostUUID retrieves the host UUID for a Docker instance
-func (f *AgentForwarderV2) getHostUUID(instanceID uuid.UUID) (string, error) {
-	// Step 1: Get docker instance to find agent_id
-	var dockerInstance models.DockerInstance
-	if err := f.db.Where("id = ?", instanceID).First(&dockerInstance).Error; err != nil {
-		return "", fmt.Errorf("docker instance not found: %w", err)
-	}
+// EvictDirectConnection closes the engine connection of a direct instance
+// so that the next operation reconnects
+func (f *AgentForwarderV2) EvictDirectConnection(instanceID uuid.UUID) {
+	f.direct.Evict(instanceID)
+}
 
-	// Step 2: Get agent connection to find host_node_id
+// getHostUUID retrieves the host UUID for a Docker instance
+func (f *AgentForwarderV2) getHostUUID(dockerInstance *models.DockerInstance) (string, error) {
+	// Step 1: Get agent connection to find host_node_id
 	var agentConn models.AgentConnection
 	if err := f.db.Where("id = ?", dockerInstance.AgentID).First(&agentConn).Error; err != nil {
 		return "", fmt.Errorf("agent connection not found: %w", err)
 	}
 
-	// Step 3: Get host node to find UUID
+	// Step 2: Get host node to find UUID
 	var hostNode models.HostNode
 	if err := f.db.Where("id = ?", agentConn.HostNodeID).First(&hostNode).Error; err != nil {
 		return "", fmt.Errorf("host node not found: %w", err)
@@ -64,23 +75,40 @@ func (f *AgentForwarderV2) getHostUUID(instanceID uuid.UUID) (string, error) {
 
 // executeTask is a helper to execute a Docker task and unmarshal the result
 func (f *AgentForwarderV2) executeTask(ctx context.Context, instanceID uuid.UUID, operation string, params map[string]string, payload interface{}, result interface{}) error {
-	// Lookup host UUID
-	hostUUID, err := f.getHostUUID(instanceID)
-	if err != nil {
-		return err
-	}
+	return f.executeTaskWithTimeout(ctx, instanceID, operation, params, payload, result, defaultTaskTimeout)
+}
 
-	taskID := uuid.New().String()
+// executeTaskWithTimeout executes a Docker task that may run longer than
+// defaultTaskTimeout
+func (f *AgentForwarderV2) executeTaskWithTimeout(ctx context.Context, instanceID uuid.UUID, operation string, params map[string]string, payload interface{}, result interface{}, timeout time.Duration) error {
+	// Get docker instance to find how it is connected
+	var dockerInstance models.DockerInstance
+	if err := f.db.Where("id = ?", instanceID).First(&dockerInstance).Error; err != nil {
+		return fmt.Errorf("docker instance not found: %w", err)
+	}
 
 	// Marshal payload if provided
 	var payloadBytes []byte
 	if payload != nil {
+		var err error
 		payloadBytes, err = json.Marshal(payload)
 		if err != nil {
 			return fmt.Errorf("failed to marshal payload: %w", err)
 		}
 	}
 
+	if dockerInstance.IsDirect() {
+		return f.executeDirectTask(ctx, &dockerInstance, operation, params, payloadBytes, result, timeout)
+	}
+
+	// Lookup host UUID
+	hostUUID, err := f.getHostUUID(&dockerInstance)
+	if err != nil {
+		return err
+	}
+
+	taskID := uuid.New().String()
+
 	// Create task
 	if params == nil {
 		params = make(map[string]string)
@@ -102,7 +130,7 @@ func (f *AgentForwarderV2) executeTask(ctx context.Context, instanceID uuid.UUID
 	}).Debug("[AgentForwarderV2] Executing Docker task")
 
 	// Execute task and wait for result
-	taskResult, err := f.agentManager.QueueTaskAndWait(ctx, hostUUID, task, defaultTaskTimeout)
+	taskResult, err := f.agentManager.QueueTaskAndWait(ctx, hostUUID, task, timeout)
 	if err != nil {
 		return fmt.Errorf("task execution failed: %w", err)
 	}
@@ -121,6 +149,39 @@ func (f *AgentForwarderV2) executeTask(ctx context.Context, instanceID uuid.UUID
 	return nil
 }
 
+// executeDirectTask runs a Docker task against the engine of a direct
+// instance and converts the response like an agent task result
+func (f *AgentForwarderV2) executeDirectTask(ctx context.Context, instance *models.DockerInstance, operation string, params map[string]string, payload []byte, result interface{}, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logrus.WithFields(logrus.Fields{
+		"instance_id": instance.ID,
+		"endpoint":    instance.Endpoint,
+		"operation":   operation,
+	}).Debug("[AgentForwarderV2] Executing Docker task directly")
+
+	response, err := f.direct.Execute(ctx, instance, &engine.Task{
+		Operation: operation,
+		Params:    params,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("task failed: %w", err)
+	}
+
+	if result != nil && response != nil {
+		data, err := json.Marshal(response)
+		if err != nil {
+			return fmt.Errorf("failed to marshal result: %w", err)
+		}
+		if err := json.Unmarshal(data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal result: %w", err)
+		}
+	}
+	return nil
+}
+
 // ListContainers forwards ListContainers request to the agent
 func (f *AgentForwarderV2) ListContainers(instanceID uuid.UUID, req *pb.ListContainersRequest) (*pb.ListContainersResponse, error) {
 	var resp pb.ListContainersResponse
@@ -184,6 +245,29 @@ func (f *AgentForwarderV2) DeleteContainer(instanceID uuid.UUID, req *pb.DeleteC
 	return &resp, err
 }
 
+// PruneContainers forwards PruneContainers request to the agent
+func (f *AgentForwarderV2) PruneContainers(instanceID uuid.UUID, req *pb.PruneContainersRequest) (*pb.PruneContainersResponse, error) {
+	var resp pb.PruneContainersResponse
+	err := f.executeTask(context.Background(), instanceID, "prune_containers", nil, req, &resp)
+	return &resp, err
+}
+
+// RecreateContainer forwards RecreateContainer request to the agent
+func (f *AgentForwarderV2) RecreateContainer(instanceID uuid.UUID, req *pb.RecreateContainerRequest) (*pb.RecreateContainerResponse, error) {
+	// The agent applies the same timeout to the task
+	params := map[string]string{"timeout": strconv.Itoa(int(recreateTaskTimeout.Seconds()))}
+	var resp pb.RecreateContainerResponse
+	err := f.executeTaskWithTimeout(context.Background(), instanceID, "recreate_container", params, req, &resp, recreateTaskTimeout)
+	return &resp, err
+}
+
+// ListContainerFiles forwards ListContainerFiles request to the agent
+func (f *AgentForwarderV2) ListContainerFiles(instanceID uuid.UUID, req *pb.ListContainerFilesRequest) (*pb.ListContainerFilesResponse, error) {
+	var resp pb.ListContainerFilesResponse
+	err := f.executeTask(context.Background(), instanceID, "list_container_files", nil, req, &resp)
+	return &resp, err
+}
+
 // ListImages forwards ListImages request to the agent
 func (f *AgentForwarderV2) ListImages(instanceID uuid.UUID, req *pb.ListImagesRequest) (*pb.ListImagesResponse, error) {
 	var resp pb.ListImagesResponse
@@ -308,6 +392,142 @@ func (f *AgentForwarderV2) GetDiskUsage(instanceID uuid.UUID, req *pb.GetDiskUsa
 	return &resp, err
 }
 
+// PruneBuildCache forwards PruneBuildCache request to the agent
+func (f *AgentForwarderV2) PruneBuildCache(instanceID uuid.UUID, req *pb.PruneBuildCacheRequest) (*pb.PruneBuildCacheResponse, error) {
+	var resp pb.PruneBuildCacheResponse
+	err := f.executeTask(context.Background(), instanceID, "prune_build_cache", nil, req, &resp)
+	return &resp, err
+}
+
+// ListSwarmNodes forwards ListSwarmNodes request to the agent
+func (f *AgentForwarderV2) ListSwarmNodes(instanceID uuid.UUID, req *pb.ListSwarmNodesRequest) (*pb.ListSwarmNodesResponse, error) {
+	var resp pb.ListSwarmNodesResponse
+	err := f.executeTask(context.Background(), instanceID, "list_swarm_nodes", nil, req, &resp)
+	return &resp, err
+}
+
+// UpdateSwarmNode forwards UpdateSwarmNode request to the agent
+func (f *AgentForwarderV2) UpdateSwarmNode(instanceID uuid.UUID, req *pb.UpdateSwarmNodeRequest) (*pb.UpdateSwarmNodeResponse, error) {
+	var resp pb.UpdateSwarmNodeResponse
+	err := f.executeTask(context.Background(), instanceID, "update_swarm_node", nil, req, &resp)
+	return &resp, err
+}
+
+// ListSwarmServices forwards ListSwarmServices request to the agent
+func (f *AgentForwarderV2) ListSwarmServices(instanceID uuid.UUID, req *pb.ListSwarmServicesRequest) (*pb.ListSwarmServicesResponse, error) {
+	var resp pb.ListSwarmServicesResponse
+	err := f.executeTask(context.Background(), instanceID, "list_swarm_services", nil, req, &resp)
+	return &resp, err
+}
+
+// GetSwarmService forwards GetSwarmService request to the agent
+func (f *AgentForwarderV2) GetSwarmService(instanceID uuid.UUID, req *pb.GetSwarmServiceRequest) (*pb.GetSwarmServiceResponse, error) {
+	var resp pb.GetSwarmServiceResponse
+	err := f.executeTask(context.Background(), instanceID, "get_swarm_service", nil, req, &resp)
+	return &resp, err
+}
+
+// ScaleSwarmService forwards ScaleSwarmService request to the agent
+func (f *AgentForwarderV2) ScaleSwarmService(instanceID uuid.UUID, req *pb.ScaleSwarmServiceRequest) (*pb.UpdateSwarmServiceResponse, error) {
+	var resp pb.UpdateSwarmServiceResponse
+	err := f.executeTask(context.Background(), instanceID, "scale_swarm_service", nil, req, &resp)
+	return &resp, err
+}
+
+// UpdateSwarmServiceImage forwards UpdateSwarmServiceImage request to the agent
+func (f *AgentForwarderV2) UpdateSwarmServiceImage(instanceID uuid.UUID, req *pb.UpdateSwarmServiceImageRequest) (*pb.UpdateSwarmServiceResponse, error) {
+	params := map[string]string{"timeout": strconv.Itoa(int(swarmTaskTimeout.Seconds()))}
+	var resp pb.UpdateSwarmServiceResponse
+	err := f.executeTaskWithTimeout(context.Background(), instanceID, "update_swarm_service_image", params, req, &resp, swarmTaskTimeout)
+	return &resp, err
+}
+
+// RollbackSwarmService forwards RollbackSwarmService request to the agent
+func (f *AgentForwarderV2) RollbackSwarmService(instanceID uuid.UUID, req *pb.RollbackSwarmServiceRequest) (*pb.UpdateSwarmServiceResponse, error) {
+	var resp pb.UpdateSwarmServiceResponse
+	err := f.executeTask(context.Background(), instanceID, "rollback_swarm_service", nil, req, &resp)
+	return &resp, err
+}
+
+// DeleteSwarmService forwards DeleteSwarmService request to the agent
+func (f *AgentForwarderV2) DeleteSwarmService(instanceID uuid.UUID, req *pb.DeleteSwarmServiceRequest) (*pb.DeleteSwarmServiceResponse, error) {
+	var resp pb.DeleteSwarmServiceResponse
+	err := f.executeTask(context.Background(), instanceID, "delete_swarm_service", nil, req, &resp)
+	return &resp, err
+}
+
+// ListSwarmTasks forwards ListSwarmTasks request to the agent
+func (f *AgentForwarderV2) ListSwarmTasks(instanceID uuid.UUID, req *pb.ListSwarmTasksRequest) (*pb.ListSwarmTasksResponse, error) {
+	var resp pb.ListSwarmTasksResponse
+	err := f.executeTask(context.Background(), instanceID, "list_swarm_tasks", nil, req, &resp)
+	return &resp, err
+}
+
+// ListSwarmSecrets forwards ListSwarmSecrets request to the agent
+func (f *AgentForwarderV2) ListSwarmSecrets(instanceID uuid.UUID, req *pb.ListSwarmSecretsRequest) (*pb.ListSwarmSecretsResponse, error) {
+	var resp pb.ListSwarmSecretsResponse
+	err := f.executeTask(context.Background(), instanceID, "list_swarm_secrets", nil, req, &resp)
+	return &resp, err
+}
+
+// CreateSwarmSecret forwards CreateSwarmSecret request to the agent
+func (f *AgentForwarderV2) CreateSwarmSecret(instanceID uuid.UUID, req *pb.CreateSwarmSecretRequest) (*pb.CreateSwarmSecretResponse, error) {
+	var resp pb.CreateSwarmSecretResponse
+	err := f.executeTask(context.Background(), instanceID, "create_swarm_secret", nil, req, &resp)
+	return &resp, err
+}
+
+// DeleteSwarmSecret forwards DeleteSwarmSecret request to the agent
+func (f *AgentForwarderV2) DeleteSwarmSecret(instanceID uuid.UUID, req *pb.DeleteSwarmSecretRequest) (*pb.DeleteSwarmSecretResponse, error) {
+	var resp pb.DeleteSwarmSecretResponse
+	err := f.executeTask(context.Background(), instanceID, "delete_swarm_secret", nil, req, &resp)
+	return &resp, err
+}
+
+// ListSwarmConfigs forwards ListSwarmConfigs request to the agent
+func (f *AgentForwarderV2) ListSwarmConfigs(instanceID uuid.UUID, req *pb.ListSwarmConfigsRequest) (*pb.ListSwarmConfigsResponse, error) {
+	var resp pb.ListSwarmConfigsResponse
+	err := f.executeTask(context.Background(), instanceID, "list_swarm_configs", nil, req, &resp)
+	return &resp, err
+}
+
+// CreateSwarmConfig forwards CreateSwarmConfig request to the agent
+func (f *AgentForwarderV2) CreateSwarmConfig(instanceID uuid.UUID, req *pb.CreateSwarmConfigRequest) (*pb.CreateSwarmConfigResponse, error) {
+	var resp pb.CreateSwarmConfigResponse
+	err := f.executeTask(context.Background(), instanceID, "create_swarm_config", nil, req, &resp)
+	return &resp, err
+}
+
+// DeleteSwarmConfig forwards DeleteSwarmConfig request to the agent
+func (f *AgentForwarderV2) DeleteSwarmConfig(instanceID uuid.UUID, req *pb.DeleteSwarmConfigRequest) (*pb.DeleteSwarmConfigResponse, error) {
+	var resp pb.DeleteSwarmConfigResponse
+	err := f.executeTask(context.Background(), instanceID, "delete_swarm_config", nil, req, &resp)
+	return &resp, err
+}
+
+// ListStacks forwards ListStacks request to the agent
+func (f *AgentForwarderV2) ListStacks(instanceID uuid.UUID, req *pb.ListStacksRequest) (*pb.ListStacksResponse, error) {
+	var resp pb.ListStacksResponse
+	err := f.executeTask(context.Background(), instanceID, "list_stacks", nil, req, &resp)
+	return &resp, err
+}
+
+// DeployStack forwards DeployStack request to the agent
+func (f *AgentForwarderV2) DeployStack(instanceID uuid.UUID, req *pb.DeployStackRequest) (*pb.DeployStackResponse, error) {
+	params := map[string]string{"timeout": strconv.Itoa(int(swarmTaskTimeout.Seconds()))}
+	var resp pb.DeployStackResponse
+	err := f.executeTaskWithTimeout(context.Background(), instanceID, "deploy_stack", params, req, &resp, swarmTaskTimeout)
+	return &resp, err
+}
+
+// RemoveStack forwards RemoveStack request to the agent
+func (f *AgentForwarderV2) RemoveStack(instanceID uuid.UUID, req *pb.RemoveStackRequest) (*pb.RemoveStackResponse, error) {
+	params := map[string]string{"timeout": strconv.Itoa(int(swarmTaskTimeout.Seconds()))}
+	var resp pb.RemoveStackResponse
+	err := f.executeTaskWithTimeout(context.Background(), instanceID, "remove_stack", params, req, &resp, swarmTaskTimeout)
+	return &resp, err
+}
+
 // Ping forwards Ping request to the agent
 func (f *AgentForwarderV2) Ping(instanceID uuid.UUID, req *pb.PingRequest) (*pb.PingResponse, error) {
 	var resp pb.PingResponse
@@ -329,6 +549,13 @@ func (f *AgentForwarderV2) TagImage(instanceID uuid.UUID, req *pb.TagImageReques
 	return &resp, err
 }
 
+// PruneImages forwards PruneImages request to the agent
+func (f *AgentForwarderV2) PruneImages(instanceID uuid.UUID, req *pb.PruneImagesRequest) (*pb.PruneImagesResponse, error) {
+	var resp pb.PruneImagesResponse
+	err := f.executeTask(context.Background(), instanceID, "prune_images", nil, req, &resp)
+	return &resp, err
+}
+
 // Streaming operations are not supported in task queue mode
 // These methods return errors explaining the limitation
 
@@ -170,11 +170,14 @@ func isReadOperation(operation string) bool {
 	readOps := []string{
 		"list_instances", "get_instance",
 		"list_containers", "get_container", "get_container_logs", "get_container_stats",
+		"list_container_files", "copy_from_container",
 		"list_images", "get_image",
 		"list_volumes", "get_volume",
 		"list_networks", "get_network",
 		"get_system_info", "get_docker_info", "get_version", "get_disk_usage", "ping",
 		"list_recordings", "get_recording", "get_recording_playback", "get_recording_statistics",
+		"list_swarm_nodes", "list_swarm_services", "get_swarm_service", "list_swarm_tasks",
+		"list_swarm_secrets", "list_swarm_configs", "list_stacks",
 		"test_connection", // Connection test is also read-only
 	}
 
@@ -193,6 +196,7 @@ func isCreateOperation(operation string) bool {
 		"pull_image", // Pulling image creates a new local image
 		"create_volume",
 		"create_network",
+		"create_swarm_secret", "create_swarm_config",
 	}
 
 	for _, op := range createOps {
@@ -207,11 +211,13 @@ func isCreateOperation(operation string) bool {
 func isDeleteOperation(operation string) bool {
 	deleteOps := []string{
 		"delete_instance",
-		"delete_container",
-		"delete_image",
+		"delete_container", "prune_containers",
+		"delete_image", "prune_images",
 		"delete_volume", "prune_volumes",
+		"prune_build_cache",
 		"delete_network",
 		"delete_recording",
+		"delete_swarm_service", "delete_swarm_secret", "delete_swarm_config", "remove_stack",
 	}
 
 	for _, op := range deleteOps {
@@ -0,0 +1,299 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+var (
+	// ErrCleanupPolicyNotFound is returned when an instance has no cleanup policy
+	ErrCleanupPolicyNotFound = errors.New("cleanup policy not found")
+	// ErrInvalidCleanupPolicy is returned when a cleanup policy is malformed
+	ErrInvalidCleanupPolicy = errors.New("invalid cleanup policy")
+)
+
+// pruneForwarder forwards prune requests to agents; implemented by
+// AgentForwarderV2 and replaced in tests
+type pruneForwarder interface {
+	PruneContainers(instanceID uuid.UUID, req *pb.PruneContainersRequest) (*pb.PruneContainersResponse, error)
+	PruneImages(instanceID uuid.UUID, req *pb.PruneImagesRequest) (*pb.PruneImagesResponse, error)
+	PruneBuildCache(instanceID uuid.UUID, req *pb.PruneBuildCacheRequest) (*pb.PruneBuildCacheResponse, error)
+	PruneVolumes(instanceID uuid.UUID, req *pb.PruneVolumesRequest) (*pb.PruneVolumesResponse, error)
+	GetDiskUsage(instanceID uuid.UUID, req *pb.GetDiskUsageRequest) (*pb.GetDiskUsageResponse, error)
+}
+
+// CleanupResult is the outcome of running a cleanup policy on one instance
+type CleanupResult struct {
+	InstanceID        uuid.UUID `json:"instance_id"`
+	InstanceName      string    `json:"instance_name"`
+	DryRun            bool      `json:"dry_run"`
+	ContainersDeleted int       `json:"containers_deleted"`
+	ImagesDeleted     int       `json:"images_deleted"`
+	CachesDeleted     int       `json:"caches_deleted"`
+	VolumesDeleted    int       `json:"volumes_deleted"`
+	SpaceReclaimed    uint64    `json:"space_reclaimed"` // Reclaimable space on dry run
+	Errors            []string  `json:"errors,omitempty"`
+}
+
+// Summary describes the result in one line
+func (r *CleanupResult) Summary() string {
+	verb := "reclaimed"
+	if r.DryRun {
+		verb = "reclaimable"
+	}
+	summary := fmt.Sprintf("%s: %d containers, %d images, %d build caches, %d volumes, %s %s",
+		r.InstanceName, r.ContainersDeleted, r.ImagesDeleted, r.CachesDeleted, r.VolumesDeleted, formatBytes(r.SpaceReclaimed), verb)
+	if len(r.Errors) > 0 {
+		summary += fmt.Sprintf(" (errors: %s)", strings.Join(r.Errors, "; "))
+	}
+	return summary
+}
+
+// CleanupService manages per-instance cleanup policies and runs them
+type CleanupService struct {
+	repo            *repository.DockerCleanupPolicyRepository
+	instanceService *DockerInstanceService
+	forwarder       pruneForwarder
+}
+
+// NewCleanupService creates a new CleanupService
+func NewCleanupService(db *gorm.DB, instanceService *DockerInstanceService, agentForwarder *AgentForwarderV2) *CleanupService {
+	return &CleanupService{
+		repo:            repository.NewDockerCleanupPolicyRepository(db),
+		instanceService: instanceService,
+		forwarder:       agentForwarder,
+	}
+}
+
+// ListPolicies lists the cleanup policies of all instances
+func (s *CleanupService) ListPolicies(ctx context.Context) ([]*models.DockerCleanupPolicy, error) {
+	return s.repo.List(ctx, false)
+}
+
+// GetPolicy retrieves the cleanup policy of an instance
+func (s *CleanupService) GetPolicy(ctx context.Context, instanceID uuid.UUID) (*models.DockerCleanupPolicy, error) {
+	policy, err := s.repo.GetByInstanceID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleanup policy: %w", err)
+	}
+	if policy == nil {
+		return nil, ErrCleanupPolicyNotFound
+	}
+	return policy, nil
+}
+
+// SavePolicy creates or replaces the cleanup policy of an instance. The
+// result of the last run is kept.
+func (s *CleanupService) SavePolicy(ctx context.Context, instanceID uuid.UUID, update *models.DockerCleanupPolicy) (*models.DockerCleanupPolicy, error) {
+	if update.OlderThanDays < 0 {
+		return nil, fmt.Errorf("%w: older_than_days must not be negative", ErrInvalidCleanupPolicy)
+	}
+	if !update.PruneContainers && !update.PruneImages && !update.PruneAllImages && !update.PruneBuildCache && !update.PruneVolumes {
+		return nil, fmt.Errorf("%w: select at least one of containers, images, build cache or volumes", ErrInvalidCleanupPolicy)
+	}
+	if _, err := s.instanceService.GetByID(ctx, instanceID); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.repo.GetByInstanceID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleanup policy: %w", err)
+	}
+	if policy == nil {
+		policy = &models.DockerCleanupPolicy{InstanceID: instanceID}
+	}
+	policy.Enabled = update.Enabled
+	policy.PruneContainers = update.PruneContainers
+	policy.PruneImages = update.PruneImages || update.PruneAllImages
+	policy.PruneAllImages = update.PruneAllImages
+	policy.PruneBuildCache = update.PruneBuildCache
+	policy.PruneVolumes = update.PruneVolumes
+	policy.OlderThanDays = update.OlderThanDays
+	policy.DryRun = update.DryRun
+
+	if err := s.repo.Save(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to save cleanup policy: %w", err)
+	}
+	return policy, nil
+}
+
+// DeletePolicy deletes the cleanup policy of an instance
+func (s *CleanupService) DeletePolicy(ctx context.Context, instanceID uuid.UUID) error {
+	if _, err := s.GetPolicy(ctx, instanceID); err != nil {
+		return err
+	}
+	return s.repo.DeleteByInstanceID(ctx, instanceID)
+}
+
+// RunPolicy runs the cleanup policy of an instance now. dryRun only
+// reports reclaimable space, as does a policy in dry run mode.
+func (s *CleanupService) RunPolicy(ctx context.Context, instanceID uuid.UUID, dryRun bool) (*CleanupResult, error) {
+	policy, err := s.GetPolicy(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.instanceService.GetByID(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !instance.CanOperate() {
+		return nil, fmt.Errorf("instance is not online (status: %s)", instance.HealthStatus)
+	}
+
+	result := s.run(instance, policy, dryRun || policy.DryRun)
+	s.recordResult(ctx, policy, result)
+	return result, nil
+}
+
+// RunAll runs the enabled cleanup policies of all online instances
+func (s *CleanupService) RunAll(ctx context.Context) ([]*CleanupResult, error) {
+	policies, err := s.repo.List(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cleanup policies: %w", err)
+	}
+
+	var results []*CleanupResult
+	for _, policy := range policies {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+
+		instance, err := s.instanceService.GetByID(ctx, policy.InstanceID)
+		if err != nil {
+			logrus.WithError(err).WithField("instance_id", policy.InstanceID).Warn("Skipping cleanup policy of missing instance")
+			continue
+		}
+		if !instance.CanOperate() {
+			continue
+		}
+
+		result := s.run(instance, policy, policy.DryRun)
+		s.recordResult(ctx, policy, result)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// run prunes what the policy selects, continuing past failed steps
+func (s *CleanupService) run(instance *models.DockerInstance, policy *models.DockerCleanupPolicy, dryRun bool) *CleanupResult {
+	result := &CleanupResult{
+		InstanceID:   instance.ID,
+		InstanceName: instance.Name,
+		DryRun:       dryRun,
+	}
+	olderThanDays := int32(policy.OlderThanDays)
+
+	// Containers go first so the images they used become unused
+	if policy.PruneContainers {
+		resp, err := s.forwarder.PruneContainers(instance.ID, &pb.PruneContainersRequest{OlderThanDays: olderThanDays, DryRun: dryRun})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("containers: %v", err))
+		} else {
+			result.ContainersDeleted = len(resp.ContainersDeleted)
+			result.SpaceReclaimed += resp.SpaceReclaimed
+		}
+	}
+
+	if policy.PruneImages || policy.PruneAllImages {
+		resp, err := s.forwarder.PruneImages(instance.ID, &pb.PruneImagesRequest{All: policy.PruneAllImages, OlderThanDays: olderThanDays, DryRun: dryRun})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("images: %v", err))
+		} else {
+			result.ImagesDeleted = len(resp.ImagesDeleted)
+			result.SpaceReclaimed += resp.SpaceReclaimed
+		}
+	}
+
+	if policy.PruneBuildCache {
+		resp, err := s.forwarder.PruneBuildCache(instance.ID, &pb.PruneBuildCacheRequest{OlderThanDays: olderThanDays, DryRun: dryRun})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("build cache: %v", err))
+		} else {
+			result.CachesDeleted = len(resp.CachesDeleted)
+			result.SpaceReclaimed += resp.SpaceReclaimed
+		}
+	}
+
+	if policy.PruneVolumes {
+		if err := s.pruneVolumes(instance.ID, dryRun, result); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("volumes: %v", err))
+		}
+	}
+	return result
+}
+
+// pruneVolumes prunes unused volumes. Volume prune has no dry run, so the
+// reclaimable space is taken from the disk usage of unreferenced volumes.
+func (s *CleanupService) pruneVolumes(instanceID uuid.UUID, dryRun bool, result *CleanupResult) error {
+	if !dryRun {
+		resp, err := s.forwarder.PruneVolumes(instanceID, &pb.PruneVolumesRequest{})
+		if err != nil {
+			return err
+		}
+		result.VolumesDeleted = len(resp.VolumesDeleted)
+		result.SpaceReclaimed += resp.SpaceReclaimed
+		return nil
+	}
+
+	resp, err := s.forwarder.GetDiskUsage(instanceID, &pb.GetDiskUsageRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Usage == nil {
+		return nil
+	}
+	for _, v := range resp.Usage.Volumes {
+		if v.UsageRefCount != 0 {
+			continue
+		}
+		result.VolumesDeleted++
+		if v.UsageSize > 0 {
+			result.SpaceReclaimed += uint64(v.UsageSize)
+		}
+	}
+	return nil
+}
+
+func (s *CleanupService) recordResult(ctx context.Context, policy *models.DockerCleanupPolicy, result *CleanupResult) {
+	now := time.Now()
+	fields := map[string]interface{}{
+		"last_run_at":          now,
+		"last_space_reclaimed": int64(result.SpaceReclaimed),
+		"last_result":          result.Summary(),
+		"last_error":           strings.Join(result.Errors, "; "),
+	}
+	if err := s.repo.UpdateFields(ctx, policy.ID, fields); err != nil {
+		logrus.WithError(err).WithField("instance_id", policy.InstanceID).Warn("Failed to record cleanup result")
+		return
+	}
+	policy.LastRunAt = &now
+	policy.LastSpaceReclaimed = int64(result.SpaceReclaimed)
+	policy.LastResult = fields["last_result"].(string)
+	policy.LastError = fields["last_error"].(string)
+}
+
+// formatBytes formats a byte count with a binary unit, e.g. 1.5 GiB
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,196 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+type fakePruneForwarder struct {
+	calls       []string
+	imagesReq   *pb.PruneImagesRequest
+	imagesErr   error
+	volumesUsed []*pb.VolumeSummary
+}
+
+func (f *fakePruneForwarder) PruneContainers(instanceID uuid.UUID, req *pb.PruneContainersRequest) (*pb.PruneContainersResponse, error) {
+	f.calls = append(f.calls, "containers")
+	return &pb.PruneContainersResponse{ContainersDeleted: []string{"c1", "c2"}, SpaceReclaimed: 100, DryRun: req.DryRun}, nil
+}
+
+func (f *fakePruneForwarder) PruneImages(instanceID uuid.UUID, req *pb.PruneImagesRequest) (*pb.PruneImagesResponse, error) {
+	f.calls = append(f.calls, "images")
+	f.imagesReq = req
+	if f.imagesErr != nil {
+		return nil, f.imagesErr
+	}
+	return &pb.PruneImagesResponse{ImagesDeleted: []string{"sha256:a"}, SpaceReclaimed: 1000, DryRun: req.DryRun}, nil
+}
+
+func (f *fakePruneForwarder) PruneBuildCache(instanceID uuid.UUID, req *pb.PruneBuildCacheRequest) (*pb.PruneBuildCacheResponse, error) {
+	f.calls = append(f.calls, "build_cache")
+	return &pb.PruneBuildCacheResponse{CachesDeleted: []string{"b1"}, SpaceReclaimed: 10, DryRun: req.DryRun}, nil
+}
+
+func (f *fakePruneForwarder) PruneVolumes(instanceID uuid.UUID, req *pb.PruneVolumesRequest) (*pb.PruneVolumesResponse, error) {
+	f.calls = append(f.calls, "volumes")
+	return &pb.PruneVolumesResponse{VolumesDeleted: []string{"v1"}, SpaceReclaimed: 5}, nil
+}
+
+func (f *fakePruneForwarder) GetDiskUsage(instanceID uuid.UUID, req *pb.GetDiskUsageRequest) (*pb.GetDiskUsageResponse, error) {
+	f.calls = append(f.calls, "disk_usage")
+	return &pb.GetDiskUsageResponse{Usage: &pb.DiskUsage{Volumes: f.volumesUsed}}, nil
+}
+
+func newCleanupTestService(t *testing.T) (*CleanupService, *fakePruneForwarder, *gorm.DB) {
+	db := testdb.Open(t, &models.DockerInstance{}, &models.DockerCleanupPolicy{})
+
+	forwarder := &fakePruneForwarder{}
+	svc := &CleanupService{
+		repo:            repository.NewDockerCleanupPolicyRepository(db),
+		instanceService: NewDockerInstanceService(db),
+		forwarder:       forwarder,
+	}
+	return svc, forwarder, db
+}
+
+func createCleanupTestInstance(t *testing.T, db *gorm.DB, name, status string) *models.DockerInstance {
+	instance := &models.DockerInstance{Name: name, AgentID: uuid.New(), HealthStatus: status}
+	require.NoError(t, db.Create(instance).Error)
+	return instance
+}
+
+func TestCleanupService_SavePolicy(t *testing.T) {
+	svc, _, db := newCleanupTestService(t)
+	ctx := context.Background()
+	instance := createCleanupTestInstance(t, db, "web", "online")
+
+	_, err := svc.SavePolicy(ctx, instance.ID, &models.DockerCleanupPolicy{Enabled: true})
+	assert.True(t, errors.Is(err, ErrInvalidCleanupPolicy))
+
+	_, err = svc.SavePolicy(ctx, instance.ID, &models.DockerCleanupPolicy{PruneImages: true, OlderThanDays: -1})
+	assert.True(t, errors.Is(err, ErrInvalidCleanupPolicy))
+
+	_, err = svc.SavePolicy(ctx, uuid.New(), &models.DockerCleanupPolicy{PruneImages: true})
+	assert.Error(t, err)
+
+	policy, err := svc.SavePolicy(ctx, instance.ID, &models.DockerCleanupPolicy{Enabled: true, PruneAllImages: true, OlderThanDays: 7})
+	require.NoError(t, err)
+	assert.True(t, policy.PruneImages)
+
+	// Saving again replaces the existing policy
+	updated, err := svc.SavePolicy(ctx, instance.ID, &models.DockerCleanupPolicy{PruneContainers: true})
+	require.NoError(t, err)
+	assert.Equal(t, policy.ID, updated.ID)
+	assert.False(t, updated.Enabled)
+	assert.False(t, updated.PruneImages)
+
+	policies, err := svc.ListPolicies(ctx)
+	require.NoError(t, err)
+	assert.Len(t, policies, 1)
+
+	require.NoError(t, svc.DeletePolicy(ctx, instance.ID))
+	_, err = svc.GetPolicy(ctx, instance.ID)
+	assert.True(t, errors.Is(err, ErrCleanupPolicyNotFound))
+}
+
+func TestCleanupService_RunPolicy(t *testing.T) {
+	svc, forwarder, db := newCleanupTestService(t)
+	ctx := context.Background()
+	instance := createCleanupTestInstance(t, db, "web", "online")
+
+	_, err := svc.SavePolicy(ctx, instance.ID, &models.DockerCleanupPolicy{
+		PruneContainers: true,
+		PruneAllImages:  true,
+		PruneBuildCache: true,
+		PruneVolumes:    true,
+		OlderThanDays:   3,
+	})
+	require.NoError(t, err)
+	forwarder.imagesErr = errors.New("daemon busy")
+
+	result, err := svc.RunPolicy(ctx, instance.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"containers", "images", "build_cache", "volumes"}, forwarder.calls)
+	assert.True(t, forwarder.imagesReq.All)
+	assert.Equal(t, int32(3), forwarder.imagesReq.OlderThanDays)
+	assert.Equal(t, 2, result.ContainersDeleted)
+	assert.Equal(t, 0, result.ImagesDeleted)
+	assert.Equal(t, 1, result.CachesDeleted)
+	assert.Equal(t, 1, result.VolumesDeleted)
+	assert.Equal(t, uint64(115), result.SpaceReclaimed)
+	assert.Equal(t, []string{"images: daemon busy"}, result.Errors)
+
+	// The outcome is recorded on the policy
+	policy, err := svc.GetPolicy(ctx, instance.ID)
+	require.NoError(t, err)
+	require.NotNil(t, policy.LastRunAt)
+	assert.Equal(t, int64(115), policy.LastSpaceReclaimed)
+	assert.Contains(t, policy.LastResult, "web: 2 containers")
+	assert.Equal(t, "images: daemon busy", policy.LastError)
+}
+
+func TestCleanupService_RunPolicyDryRun(t *testing.T) {
+	svc, forwarder, db := newCleanupTestService(t)
+	ctx := context.Background()
+	instance := createCleanupTestInstance(t, db, "web", "online")
+
+	_, err := svc.SavePolicy(ctx, instance.ID, &models.DockerCleanupPolicy{PruneImages: true, PruneVolumes: true})
+	require.NoError(t, err)
+	forwarder.volumesUsed = []*pb.VolumeSummary{
+		{Name: "unused", UsageRefCount: 0, UsageSize: 2048},
+		{Name: "mounted", UsageRefCount: 1, UsageSize: 4096},
+	}
+
+	result, err := svc.RunPolicy(ctx, instance.ID, true)
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.True(t, forwarder.imagesReq.DryRun)
+	assert.False(t, forwarder.imagesReq.All)
+	// Volumes are estimated from disk usage instead of being pruned
+	assert.Equal(t, []string{"images", "disk_usage"}, forwarder.calls)
+	assert.Equal(t, 1, result.VolumesDeleted)
+	assert.Equal(t, uint64(1000+2048), result.SpaceReclaimed)
+	assert.Contains(t, result.Summary(), "3.0 KiB reclaimable")
+}
+
+func TestCleanupService_RunAll(t *testing.T) {
+	svc, forwarder, db := newCleanupTestService(t)
+	ctx := context.Background()
+	online := createCleanupTestInstance(t, db, "web", "online")
+	offline := createCleanupTestInstance(t, db, "batch", "offline")
+	disabled := createCleanupTestInstance(t, db, "db", "online")
+
+	for _, id := range []uuid.UUID{online.ID, offline.ID} {
+		_, err := svc.SavePolicy(ctx, id, &models.DockerCleanupPolicy{Enabled: true, PruneContainers: true})
+		require.NoError(t, err)
+	}
+	_, err := svc.SavePolicy(ctx, disabled.ID, &models.DockerCleanupPolicy{PruneContainers: true})
+	require.NoError(t, err)
+
+	results, err := svc.RunAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, online.ID, results[0].InstanceID)
+	assert.Equal(t, []string{"containers"}, forwarder.calls)
+
+	_, err = svc.RunPolicy(ctx, offline.ID, false)
+	assert.Error(t, err)
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "2.0 GiB", formatBytes(2<<30))
+}
@@ -0,0 +1,407 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+const (
+	// A container that exits restartLoopThreshold times within
+	// restartLoopWindow is considered to be in a restart loop
+	restartLoopThreshold = 5
+	restartLoopWindow    = 10 * time.Minute
+
+	// Alert rule conditions of the system rules created per instance
+	alertConditionContainerOOM         = "container_oom"
+	alertConditionContainerRestartLoop = "container_restart_loop"
+)
+
+// containerEventFilters selects the container events that are collected
+var containerEventFilters = func() string {
+	filters, _ := json.Marshal(map[string][]string{
+		"type":  {"container"},
+		"event": models.DockerContainerEvents,
+	})
+	return string(filters)
+}()
+
+// eventSession is the part of *host.DockerStreamSession needed to follow
+// engine events
+type eventSession interface {
+	ID() string
+	WaitForReady(timeout time.Duration) error
+	Reader(ctx context.Context) io.Reader
+}
+
+// instanceEventWatcher is a running event stream of one instance
+type instanceEventWatcher struct {
+	agentID uuid.UUID
+	cancel  context.CancelFunc
+}
+
+// ContainerEventCollector follows the container events of every online
+// Docker instance through the agent and persists them as
+// DockerContainerEvents. OOM kills and restart loops raise alert events.
+// Instances are reconciled periodically so instances coming online or going
+// offline are picked up without a restart.
+type ContainerEventCollector struct {
+	db              *gorm.DB
+	instanceService *DockerInstanceService
+	eventRepo       *repository.DockerContainerEventRepository
+	alertRepo       repository.MonitorAlertRepository
+	streamManager   streamSessionManager
+	interval        time.Duration
+	retryDelay      time.Duration
+
+	mu       sync.Mutex
+	watchers map[uuid.UUID]*instanceEventWatcher
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewContainerEventCollector creates a new ContainerEventCollector instance
+func NewContainerEventCollector(db *gorm.DB, streamManager streamSessionManager) *ContainerEventCollector {
+	return &ContainerEventCollector{
+		db:              db,
+		instanceService: NewDockerInstanceService(db),
+		eventRepo:       repository.NewDockerContainerEventRepository(db),
+		alertRepo:       repository.NewMonitorAlertRepository(db),
+		streamManager:   streamManager,
+		interval:        60 * time.Second, // Reconcile instances every 60 seconds
+		retryDelay:      10 * time.Second,
+		watchers:        make(map[uuid.UUID]*instanceEventWatcher),
+		stopCh:          make(chan struct{}),
+	}
+}
+
+// Start begins collecting events in a background goroutine
+func (s *ContainerEventCollector) Start(ctx context.Context) {
+	logrus.Info("Starting Docker container event collector")
+
+	go func() {
+		s.reconcile(ctx)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.reconcile(ctx)
+			case <-s.stopCh:
+				s.stopAll()
+				logrus.Info("Docker container event collector stopped")
+				return
+			case <-ctx.Done():
+				s.stopAll()
+				logrus.Info("Docker container event collector stopped (context cancelled)")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops all event streams
+func (s *ContainerEventCollector) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+// reconcile starts event streams for instances that came online and stops
+// the ones of instances that went offline or moved to another agent
+func (s *ContainerEventCollector) reconcile(ctx context.Context) {
+	instances, err := s.instanceService.ListOnlineInstances(ctx)
+	if err != nil {
+		logrus.Errorf("Docker event collector: failed to fetch online instances: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	active := make(map[uuid.UUID]struct{}, len(instances))
+	for _, instance := range instances {
+		active[instance.ID] = struct{}{}
+
+		if w, ok := s.watchers[instance.ID]; ok {
+			if w.agentID == instance.AgentID {
+				continue
+			}
+			w.cancel()
+			delete(s.watchers, instance.ID)
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		s.watchers[instance.ID] = &instanceEventWatcher{agentID: instance.AgentID, cancel: cancel}
+		go s.watch(watchCtx, instance)
+		logrus.Debugf("Docker event collector: following container events on instance %s", instance.Name)
+	}
+
+	for id, w := range s.watchers {
+		if _, ok := active[id]; !ok {
+			w.cancel()
+			delete(s.watchers, id)
+		}
+	}
+}
+
+func (s *ContainerEventCollector) stopAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, w := range s.watchers {
+		w.cancel()
+		delete(s.watchers, id)
+	}
+}
+
+// watch follows the events of an instance until ctx is cancelled. Stream
+// sessions end on their own, so it reconnects from the last event seen.
+func (s *ContainerEventCollector) watch(ctx context.Context, instance *models.DockerInstance) {
+	since, err := s.eventRepo.LatestOccurredAt(ctx, instance.ID)
+	if err != nil || since.IsZero() {
+		since = time.Now()
+	}
+
+	for {
+		if err := s.follow(ctx, instance, &since); err != nil && ctx.Err() == nil {
+			logrus.Debugf("Docker event collector: instance %s: %v", instance.Name, err)
+		}
+
+		select {
+		case <-time.After(s.retryDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// follow reads one event stream session and advances since past every
+// stored event
+func (s *ContainerEventCollector) follow(ctx context.Context, instance *models.DockerInstance, since *time.Time) error {
+	sessionInterface, err := s.streamManager.CreateSession(instance.ID, instance.AgentID.String(), "get_events", "", "", map[string]string{
+		"since":   strconv.FormatInt(since.Unix(), 10),
+		"filters": containerEventFilters,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create docker stream session: %w", err)
+	}
+
+	session, ok := sessionInterface.(eventSession)
+	if !ok {
+		return fmt.Errorf("internal error: invalid session type")
+	}
+	defer s.streamManager.CloseSession(session.ID())
+
+	if err := session.WaitForReady(30 * time.Second); err != nil {
+		return fmt.Errorf("agent did not connect in time: %w", err)
+	}
+
+	decoder := json.NewDecoder(session.Reader(ctx))
+	for {
+		var event pb.DockerEvent
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		record := containerEventFromDocker(instance.ID, &event)
+		if record == nil {
+			continue
+		}
+		s.handleEvent(ctx, instance, record)
+		if record.OccurredAt.After(*since) {
+			*since = record.OccurredAt
+		}
+	}
+}
+
+// handleEvent stores an event and raises alerts for OOM kills and restart loops
+func (s *ContainerEventCollector) handleEvent(ctx context.Context, instance *models.DockerInstance, record *models.DockerContainerEvent) {
+	inserted, err := s.eventRepo.Create(ctx, record)
+	if err != nil {
+		logrus.Warnf("Docker event collector: failed to store %s event of container %s: %v", record.Action, record.ContainerName, err)
+		return
+	}
+	if !inserted {
+		return // Replayed after a reconnect
+	}
+
+	alertContext := map[string]interface{}{
+		"instance_name":  instance.Name,
+		"container_id":   record.ContainerID,
+		"container_name": record.ContainerName,
+		"image":          record.Image,
+		"occurred_at":    record.OccurredAt,
+	}
+
+	switch record.Action {
+	case models.DockerContainerEventOOM:
+		s.raiseAlert(ctx, instance, alertConditionContainerOOM, models.AlertSeverityCritical,
+			fmt.Sprintf("Container %s on %s was killed by the OOM killer", record.ContainerName, instance.Name),
+			alertContext)
+
+	case models.DockerContainerEventDie:
+		exits, err := s.eventRepo.CountSince(ctx, instance.ID, record.ContainerID, models.DockerContainerEventDie, record.OccurredAt.Add(-restartLoopWindow))
+		if err != nil {
+			logrus.Warnf("Docker event collector: failed to count exits of container %s: %v", record.ContainerName, err)
+			return
+		}
+		if exits < restartLoopThreshold {
+			return
+		}
+		alertContext["exits"] = exits
+		alertContext["window"] = restartLoopWindow.String()
+		if record.ExitCode != nil {
+			alertContext["exit_code"] = *record.ExitCode
+		}
+		s.raiseAlert(ctx, instance, alertConditionContainerRestartLoop, models.AlertSeverityWarning,
+			fmt.Sprintf("Container %s on %s is in a restart loop", record.ContainerName, instance.Name),
+			alertContext)
+	}
+}
+
+// raiseAlert creates a firing alert event unless the same alert is still firing
+func (s *ContainerEventCollector) raiseAlert(ctx context.Context, instance *models.DockerInstance, condition string, severity models.AlertSeverity, message string, alertContext map[string]interface{}) {
+	rule, err := s.getOrCreateRule(ctx, instance, condition, severity)
+	if err != nil {
+		logrus.Errorf("Docker event collector: failed to get alert rule for instance %s: %v", instance.Name, err)
+		return
+	}
+
+	firing, err := s.alertRepo.GetFiringEvents(ctx, rule.ID)
+	if err != nil {
+		logrus.Errorf("Docker event collector: failed to fetch firing alerts: %v", err)
+		return
+	}
+	for _, event := range firing {
+		if event.Message == message {
+			return
+		}
+	}
+
+	contextData, _ := json.Marshal(alertContext)
+	event := &models.MonitorAlertEvent{
+		RuleID:      rule.ID,
+		Status:      models.AlertStatusFiring,
+		Severity:    severity,
+		Message:     message,
+		Context:     string(contextData),
+		TriggeredAt: time.Now(),
+	}
+	if err := s.alertRepo.CreateEvent(ctx, event); err != nil {
+		logrus.Errorf("Docker event collector: failed to create alert: %v", err)
+		return
+	}
+
+	logrus.Infof("Docker event collector: %s", message)
+}
+
+// getOrCreateRule gets or creates the system alert rule of an instance for a condition
+func (s *ContainerEventCollector) getOrCreateRule(ctx context.Context, instance *models.DockerInstance, condition string, severity models.AlertSeverity) (*models.MonitorAlertRule, error) {
+	var rule models.MonitorAlertRule
+	err := s.db.WithContext(ctx).
+		Where("target_id = ? AND type = ? AND condition = ?", instance.ID, models.AlertTypeDocker, condition).
+		First(&rule).Error
+	if err == nil {
+		return &rule, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	name := "Container OOM kill"
+	if condition == alertConditionContainerRestartLoop {
+		name = "Container restart loop"
+	}
+	rule = models.MonitorAlertRule{
+		Name:           fmt.Sprintf("%s - %s", name, instance.Name),
+		Type:           models.AlertTypeDocker,
+		TargetID:       instance.ID,
+		Severity:       severity,
+		Condition:      condition, // Special condition raised by the event collector
+		Enabled:        true,
+		NotifyChannels: `[]`,
+		NotifyConfig:   `{}`,
+	}
+	if err := s.alertRepo.CreateRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// containerEventFromDocker converts an engine event into a
+// DockerContainerEvent, or returns nil for events that are not collected
+func containerEventFromDocker(instanceID uuid.UUID, event *pb.DockerEvent) *models.DockerContainerEvent {
+	if event.Type != "container" || event.Actor == nil || event.Actor.Id == "" {
+		return nil
+	}
+
+	// Health events carry the status in the action, e.g. "health_status: unhealthy"
+	action, detail, _ := strings.Cut(event.Action, ":")
+	switch action {
+	case models.DockerContainerEventDie, models.DockerContainerEventOOM,
+		models.DockerContainerEventRestart, models.DockerContainerEventHealthStatus:
+	default:
+		return nil
+	}
+
+	timeNano := event.TimeNano
+	if timeNano == 0 {
+		timeNano = event.Time * int64(time.Second)
+	}
+	attributes := event.Actor.Attributes
+	record := &models.DockerContainerEvent{
+		InstanceID:    instanceID,
+		ContainerID:   event.Actor.Id,
+		ContainerName: attributes["name"],
+		Image:         attributes["image"],
+		Action:        action,
+		HealthStatus:  strings.TrimSpace(detail),
+		TimeNano:      timeNano,
+		OccurredAt:    time.Unix(0, timeNano),
+	}
+	if exitCode, err := strconv.Atoi(attributes["exitCode"]); err == nil && action == models.DockerContainerEventDie {
+		record.ExitCode = &exitCode
+	}
+	return record
+}
+
+// ContainerEventService queries the collected container event history
+type ContainerEventService struct {
+	instanceService *DockerInstanceService
+	eventRepo       *repository.DockerContainerEventRepository
+}
+
+// NewContainerEventService creates a new ContainerEventService
+func NewContainerEventService(instanceService *DockerInstanceService, eventRepo *repository.DockerContainerEventRepository) *ContainerEventService {
+	return &ContainerEventService{
+		instanceService: instanceService,
+		eventRepo:       eventRepo,
+	}
+}
+
+// Timeline returns the events of an instance matching the filter, newest first
+func (s *ContainerEventService) Timeline(ctx context.Context, instanceID uuid.UUID, filter *repository.DockerContainerEventFilter) ([]*models.DockerContainerEvent, int64, error) {
+	if _, err := s.instanceService.GetByID(ctx, instanceID); err != nil {
+		return nil, 0, fmt.Errorf("instance not found: %w", err)
+	}
+	return s.eventRepo.Search(ctx, instanceID, filter)
+}
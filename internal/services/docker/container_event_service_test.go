@@ -0,0 +1,180 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+func newContainerEventTestCollector(t *testing.T) (*ContainerEventCollector, *fakeArchiveStreamManager, *models.DockerInstance) {
+	db := testdb.Open(t,
+		&models.DockerInstance{},
+		&models.DockerContainerEvent{},
+		&models.MonitorAlertRule{},
+		&models.MonitorAlertEvent{},
+	)
+	instance := &models.DockerInstance{Name: "web", AgentID: uuid.New(), HealthStatus: "online"}
+	require.NoError(t, db.Create(instance).Error)
+
+	streamManager := &fakeArchiveStreamManager{session: &fakeArchiveSession{}}
+	return NewContainerEventCollector(db, streamManager), streamManager, instance
+}
+
+func dockerEvent(action, containerID string, at time.Time, attributes map[string]string) *pb.DockerEvent {
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	attributes["name"] = "api"
+	attributes["image"] = "nginx:1.27"
+	return &pb.DockerEvent{
+		Type:     "container",
+		Action:   action,
+		Actor:    &pb.Actor{Id: containerID, Attributes: attributes},
+		Time:     at.Unix(),
+		TimeNano: at.UnixNano(),
+	}
+}
+
+func firingAlerts(t *testing.T, c *ContainerEventCollector, condition string) []models.MonitorAlertEvent {
+	var events []models.MonitorAlertEvent
+	require.NoError(t, c.db.
+		Joins("JOIN monitor_alert_rules ON monitor_alert_rules.id = monitor_alert_events.rule_id").
+		Where("monitor_alert_rules.condition = ? AND monitor_alert_events.status = ?", condition, models.AlertStatusFiring).
+		Find(&events).Error)
+	return events
+}
+
+func TestContainerEventFromDocker(t *testing.T) {
+	instanceID := uuid.New()
+	at := time.Unix(1700000000, 123)
+
+	record := containerEventFromDocker(instanceID, dockerEvent("die", "abc", at, map[string]string{"exitCode": "137"}))
+	require.NotNil(t, record)
+	assert.Equal(t, instanceID, record.InstanceID)
+	assert.Equal(t, "abc", record.ContainerID)
+	assert.Equal(t, "api", record.ContainerName)
+	assert.Equal(t, "nginx:1.27", record.Image)
+	assert.Equal(t, models.DockerContainerEventDie, record.Action)
+	require.NotNil(t, record.ExitCode)
+	assert.Equal(t, 137, *record.ExitCode)
+	assert.Equal(t, at.UnixNano(), record.TimeNano)
+	assert.True(t, record.OccurredAt.Equal(at))
+
+	record = containerEventFromDocker(instanceID, dockerEvent("health_status: unhealthy", "abc", at, nil))
+	require.NotNil(t, record)
+	assert.Equal(t, models.DockerContainerEventHealthStatus, record.Action)
+	assert.Equal(t, "unhealthy", record.HealthStatus)
+	assert.Nil(t, record.ExitCode)
+
+	// Older engines only report seconds
+	event := dockerEvent("oom", "abc", at, nil)
+	event.TimeNano = 0
+	record = containerEventFromDocker(instanceID, event)
+	require.NotNil(t, record)
+	assert.Equal(t, at.Unix()*int64(time.Second), record.TimeNano)
+
+	assert.Nil(t, containerEventFromDocker(instanceID, dockerEvent("start", "abc", at, nil)))
+	event = dockerEvent("die", "abc", at, nil)
+	event.Type = "image"
+	assert.Nil(t, containerEventFromDocker(instanceID, event))
+}
+
+func TestContainerEventCollector_RestartLoopAlert(t *testing.T) {
+	collector, _, instance := newContainerEventTestCollector(t)
+	ctx := context.Background()
+	start := time.Now().Add(-5 * time.Minute)
+
+	for i := 0; i < restartLoopThreshold-1; i++ {
+		record := containerEventFromDocker(instance.ID, dockerEvent("die", "abc", start.Add(time.Duration(i)*time.Second), map[string]string{"exitCode": "1"}))
+		collector.handleEvent(ctx, instance, record)
+	}
+	assert.Empty(t, firingAlerts(t, collector, alertConditionContainerRestartLoop))
+
+	// Another container exiting does not count towards the loop
+	collector.handleEvent(ctx, instance, containerEventFromDocker(instance.ID, dockerEvent("die", "def", start, nil)))
+	assert.Empty(t, firingAlerts(t, collector, alertConditionContainerRestartLoop))
+
+	collector.handleEvent(ctx, instance, containerEventFromDocker(instance.ID, dockerEvent("die", "abc", start.Add(time.Minute), map[string]string{"exitCode": "1"})))
+	alerts := firingAlerts(t, collector, alertConditionContainerRestartLoop)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, models.AlertSeverityWarning, alerts[0].Severity)
+	assert.Contains(t, alerts[0].Message, "api")
+
+	var alertContext map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(alerts[0].Context), &alertContext))
+	assert.EqualValues(t, restartLoopThreshold, alertContext["exits"])
+	assert.EqualValues(t, 1, alertContext["exit_code"])
+
+	// Further exits do not raise a second alert while the first one fires
+	collector.handleEvent(ctx, instance, containerEventFromDocker(instance.ID, dockerEvent("die", "abc", start.Add(2*time.Minute), nil)))
+	assert.Len(t, firingAlerts(t, collector, alertConditionContainerRestartLoop), 1)
+}
+
+func TestContainerEventCollector_OOMAlert(t *testing.T) {
+	collector, _, instance := newContainerEventTestCollector(t)
+	ctx := context.Background()
+	at := time.Now()
+
+	record := containerEventFromDocker(instance.ID, dockerEvent("oom", "abc", at, nil))
+	collector.handleEvent(ctx, instance, record)
+
+	// A replayed event is neither stored twice nor alerted again
+	replayed := containerEventFromDocker(instance.ID, dockerEvent("oom", "abc", at, nil))
+	collector.handleEvent(ctx, instance, replayed)
+
+	alerts := firingAlerts(t, collector, alertConditionContainerOOM)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, models.AlertSeverityCritical, alerts[0].Severity)
+
+	var count int64
+	require.NoError(t, collector.db.Model(&models.DockerContainerEvent{}).Count(&count).Error)
+	assert.EqualValues(t, 1, count)
+
+	var rules []models.MonitorAlertRule
+	require.NoError(t, collector.db.Find(&rules).Error)
+	require.Len(t, rules, 1)
+	assert.Equal(t, models.AlertTypeDocker, rules[0].Type)
+	assert.Equal(t, instance.ID, rules[0].TargetID)
+}
+
+func TestContainerEventCollector_Follow(t *testing.T) {
+	collector, streamManager, instance := newContainerEventTestCollector(t)
+	since := time.Now().Add(-time.Hour)
+	at := time.Now().Add(-time.Minute)
+
+	var output strings.Builder
+	for _, event := range []*pb.DockerEvent{
+		dockerEvent("die", "abc", at.Add(-time.Second), map[string]string{"exitCode": "0"}),
+		dockerEvent("start", "abc", at.Add(-time.Second), nil),
+		dockerEvent("restart", "abc", at, nil),
+	} {
+		data, err := json.Marshal(event)
+		require.NoError(t, err)
+		output.Write(data)
+	}
+	streamManager.session.output = output.String()
+
+	require.NoError(t, collector.follow(context.Background(), instance, &since))
+	assert.Equal(t, "get_events", streamManager.operation)
+	assert.Contains(t, streamManager.params["filters"], "health_status")
+	assert.Equal(t, []string{"session-1"}, streamManager.closed)
+	assert.Equal(t, at.UnixNano(), since.UnixNano())
+
+	events, total, err := collector.eventRepo.Search(context.Background(), instance.ID, &repository.DockerContainerEventFilter{Container: "api"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, total)
+	assert.Equal(t, models.DockerContainerEventRestart, events[0].Action)
+	assert.Equal(t, models.DockerContainerEventDie, events[1].Action)
+}
@@ -0,0 +1,279 @@
+package docker
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+const (
+	// archiveChunkSize is the largest archive chunk sent to the agent in one message
+	archiveChunkSize = 32 * 1024
+
+	// MaxContainerUploadSize is the largest file that can be uploaded into a container
+	MaxContainerUploadSize = 100 << 20
+)
+
+// ErrInvalidContainerPath is returned when a container path or file name is malformed
+var ErrInvalidContainerPath = errors.New("invalid container path")
+
+// archiveSession is the part of *host.DockerStreamSession needed to copy
+// archives in and out of a container
+type archiveSession interface {
+	ID() string
+	WaitForReady(timeout time.Duration) error
+	Wait(ctx context.Context) error
+	Reader(ctx context.Context) io.Reader
+	SendData(ctx context.Context, dataType string, data []byte) error
+}
+
+// streamSessionManager is the part of *host.DockerStreamManager needed to
+// open and close stream sessions
+type streamSessionManager interface {
+	DockerStreamManager
+	CloseSession(sessionID string) error
+}
+
+// ContainerFileService browses container filesystems and copies files in
+// and out of containers through the agent
+type ContainerFileService struct {
+	instanceService *DockerInstanceService
+	agentForwarder  *AgentForwarderV2
+	streamManager   streamSessionManager
+	auditHelper     *AuditHelper
+}
+
+// NewContainerFileService creates a new ContainerFileService
+func NewContainerFileService(
+	instanceService *DockerInstanceService,
+	agentForwarder *AgentForwarderV2,
+	streamManager streamSessionManager,
+	auditHelper *AuditHelper,
+) *ContainerFileService {
+	return &ContainerFileService{
+		instanceService: instanceService,
+		agentForwarder:  agentForwarder,
+		streamManager:   streamManager,
+		auditHelper:     auditHelper,
+	}
+}
+
+// ListFiles lists a directory of a container
+func (s *ContainerFileService) ListFiles(c *gin.Context, instanceID uuid.UUID, containerID, dir string) (*pb.ListContainerFilesResponse, error) {
+	startTime := time.Now()
+
+	dir, err := cleanContainerPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.getOperableInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.agentForwarder.ListContainerFiles(instanceID, &pb.ListContainerFilesRequest{
+		ContainerId: containerID,
+		Path:        dir,
+	})
+
+	s.logOperation(c, instance, models.DockerActionListContainerFiles, containerID, map[string]interface{}{
+		"path": dir,
+	}, err, startTime)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	return resp, nil
+}
+
+// Download writes a gzip-compressed tar archive of a file or directory in
+// a container to w and returns the number of bytes written
+func (s *ContainerFileService) Download(c *gin.Context, instanceID uuid.UUID, containerID, srcPath string, w io.Writer) (int64, error) {
+	startTime := time.Now()
+
+	srcPath, err := cleanContainerPath(srcPath)
+	if err != nil {
+		return 0, err
+	}
+	instance, err := s.getOperableInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	var written int64
+	session, err := s.openSession(instance, "copy_from_container", containerID, map[string]string{
+		"path":     srcPath,
+		"compress": "true",
+	})
+	if err == nil {
+		defer s.streamManager.CloseSession(session.ID())
+		written, err = io.Copy(w, session.Reader(c.Request.Context()))
+	}
+
+	s.logOperation(c, instance, models.DockerActionCopyFromContainer, containerID, map[string]interface{}{
+		"path": srcPath,
+		"size": written,
+	}, err, startTime)
+
+	if err != nil {
+		return written, fmt.Errorf("failed to copy from container: %w", err)
+	}
+	return written, nil
+}
+
+// Upload writes a file of the given size into a directory of a container,
+// replacing a file with the same name
+func (s *ContainerFileService) Upload(c *gin.Context, instanceID uuid.UUID, containerID, dstDir, name string, size int64, content io.Reader) error {
+	startTime := time.Now()
+
+	dstDir, err := cleanContainerPath(dstDir)
+	if err != nil {
+		return err
+	}
+	if name == "" || name == "." || name == ".." || path.Base(name) != name {
+		return fmt.Errorf("%w: invalid file name %q", ErrInvalidContainerPath, name)
+	}
+	if size > MaxContainerUploadSize {
+		return fmt.Errorf("%w: file exceeds %d bytes", ErrInvalidContainerPath, MaxContainerUploadSize)
+	}
+	instance, err := s.getOperableInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request.Context()
+	session, err := s.openSession(instance, "copy_to_container", containerID, map[string]string{
+		"path": dstDir,
+	})
+	if err == nil {
+		defer s.streamManager.CloseSession(session.ID())
+		err = writeFileArchive(&archiveSessionWriter{ctx: ctx, session: session}, name, size, content)
+		if err == nil {
+			err = session.SendData(ctx, "archive_end", nil)
+		}
+		if err == nil {
+			err = session.Wait(ctx)
+		}
+	}
+
+	s.logOperation(c, instance, models.DockerActionCopyToContainer, containerID, map[string]interface{}{
+		"path": path.Join(dstDir, name),
+		"size": size,
+	}, err, startTime)
+
+	if err != nil {
+		return fmt.Errorf("failed to copy to container: %w", err)
+	}
+	return nil
+}
+
+// getOperableInstance returns the instance if it is online
+func (s *ContainerFileService) getOperableInstance(ctx context.Context, instanceID uuid.UUID) (*models.DockerInstance, error) {
+	instance, err := s.instanceService.GetByID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("instance not found: %w", err)
+	}
+	if !instance.CanOperate() {
+		return nil, fmt.Errorf("instance is not online (status: %s)", instance.HealthStatus)
+	}
+	return instance, nil
+}
+
+// openSession starts an archive stream on the agent and waits for it to connect
+func (s *ContainerFileService) openSession(instance *models.DockerInstance, operation, containerID string, params map[string]string) (archiveSession, error) {
+	sessionInterface, err := s.streamManager.CreateSession(instance.ID, instance.AgentID.String(), operation, containerID, "", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker stream session: %w", err)
+	}
+
+	session, ok := sessionInterface.(archiveSession)
+	if !ok {
+		return nil, fmt.Errorf("internal error: invalid session type")
+	}
+	if err := session.WaitForReady(30 * time.Second); err != nil {
+		s.streamManager.CloseSession(session.ID())
+		return nil, fmt.Errorf("agent did not connect in time: %w", err)
+	}
+	return session, nil
+}
+
+// logOperation records a container file operation in the audit log
+func (s *ContainerFileService) logOperation(c *gin.Context, instance *models.DockerInstance, action, containerID string, extra map[string]interface{}, err error, startTime time.Time) {
+	extra["container_id"] = containerID
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       action,
+		ResourceType: models.DockerResourceTypeContainer,
+		ResourceID:   instance.ID,
+		ResourceName: containerID,
+		InstanceID:   instance.ID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData:    extra,
+		Error:        err,
+		Duration:     time.Since(startTime).Milliseconds(),
+	})
+}
+
+// archiveSessionWriter sends what is written to it to the agent as archive chunks
+type archiveSessionWriter struct {
+	ctx     context.Context
+	session archiveSession
+}
+
+// Write implements io.Writer. Each chunk is a fresh copy because the
+// session queues it.
+func (w *archiveSessionWriter) Write(p []byte) (int, error) {
+	for written := 0; written < len(p); {
+		n := min(len(p)-written, archiveChunkSize)
+		chunk := make([]byte, n)
+		copy(chunk, p[written:written+n])
+		if err := w.session.SendData(w.ctx, "archive", chunk); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return len(p), nil
+}
+
+// writeFileArchive writes a tar archive holding a single regular file
+func writeFileArchive(w io.Writer, name string, size int64, content io.Reader) error {
+	buf := bufio.NewWriterSize(w, archiveChunkSize)
+	tw := tar.NewWriter(buf)
+
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     size,
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(tw, content, size); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+// cleanContainerPath checks that a container path is absolute and cleans it
+func cleanContainerPath(p string) (string, error) {
+	if !path.IsAbs(p) {
+		return "", fmt.Errorf("%w: %q is not an absolute path", ErrInvalidContainerPath, p)
+	}
+	return path.Clean(p), nil
+}
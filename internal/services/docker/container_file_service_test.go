@@ -0,0 +1,153 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+type fakeArchiveSession struct {
+	sent    [][]byte
+	ended   bool
+	output  string
+	waitErr error
+}
+
+func (s *fakeArchiveSession) ID() string                               { return "session-1" }
+func (s *fakeArchiveSession) WaitForReady(timeout time.Duration) error { return nil }
+func (s *fakeArchiveSession) Wait(ctx context.Context) error           { return s.waitErr }
+
+func (s *fakeArchiveSession) Reader(ctx context.Context) io.Reader {
+	return strings.NewReader(s.output)
+}
+
+func (s *fakeArchiveSession) SendData(ctx context.Context, dataType string, data []byte) error {
+	switch dataType {
+	case "archive":
+		s.sent = append(s.sent, data)
+	case "archive_end":
+		s.ended = true
+	}
+	return nil
+}
+
+type fakeArchiveStreamManager struct {
+	session   *fakeArchiveSession
+	operation string
+	params    map[string]string
+	closed    []string
+}
+
+func (m *fakeArchiveStreamManager) CreateSession(instanceID uuid.UUID, agentID string, operation string, containerID, imageName string, params map[string]string) (interface{}, error) {
+	m.operation = operation
+	m.params = params
+	return m.session, nil
+}
+
+func (m *fakeArchiveStreamManager) CloseSession(sessionID string) error {
+	m.closed = append(m.closed, sessionID)
+	return nil
+}
+
+func newContainerFileTestService(t *testing.T) (*ContainerFileService, *fakeArchiveStreamManager, *models.DockerInstance) {
+	db := testdb.Open(t, &models.DockerInstance{}, &models.AuditEvent{})
+	instance := &models.DockerInstance{Name: "web", AgentID: uuid.New(), HealthStatus: "online"}
+	require.NoError(t, db.Create(instance).Error)
+
+	manager := &fakeArchiveStreamManager{session: &fakeArchiveSession{}}
+	svc := NewContainerFileService(
+		NewDockerInstanceService(db),
+		nil,
+		manager,
+		NewAuditHelper(repository.NewAuditEventRepository(db)),
+	)
+	return svc, manager, instance
+}
+
+func newContainerFileTestContext() *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	return c
+}
+
+func TestContainerFileService_Upload(t *testing.T) {
+	svc, manager, instance := newContainerFileTestService(t)
+	c := newContainerFileTestContext()
+
+	err := svc.Upload(c, instance.ID, "web", "/etc/nginx/", "nginx.conf", 5, strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "copy_to_container", manager.operation)
+	assert.Equal(t, map[string]string{"path": "/etc/nginx"}, manager.params)
+	assert.True(t, manager.session.ended)
+	assert.Equal(t, []string{"session-1"}, manager.closed)
+
+	tr := tar.NewReader(bytes.NewReader(bytes.Join(manager.session.sent, nil)))
+	hdr, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "nginx.conf", hdr.Name)
+	content, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+	_, err = tr.Next()
+	assert.Equal(t, io.EOF, err)
+
+	manager.session.waitErr = errors.New("no such directory")
+	err = svc.Upload(c, instance.ID, "web", "/missing", "a.txt", 1, strings.NewReader("x"))
+	assert.ErrorContains(t, err, "no such directory")
+}
+
+func TestContainerFileService_UploadValidation(t *testing.T) {
+	svc, manager, instance := newContainerFileTestService(t)
+	c := newContainerFileTestContext()
+
+	for _, tc := range []struct{ dir, name string }{
+		{"etc", "a.txt"},
+		{"/etc", ""},
+		{"/etc", ".."},
+		{"/etc", "../passwd"},
+	} {
+		err := svc.Upload(c, instance.ID, "web", tc.dir, tc.name, 1, strings.NewReader("x"))
+		assert.True(t, errors.Is(err, ErrInvalidContainerPath), "%s %s", tc.dir, tc.name)
+	}
+	err := svc.Upload(c, instance.ID, "web", "/etc", "big", MaxContainerUploadSize+1, strings.NewReader(""))
+	assert.True(t, errors.Is(err, ErrInvalidContainerPath))
+	assert.Empty(t, manager.operation)
+
+	// A short upload fails instead of sending a truncated archive
+	err = svc.Upload(c, instance.ID, "web", "/etc", "short", 10, strings.NewReader("x"))
+	assert.ErrorIs(t, err, io.EOF)
+	assert.False(t, manager.session.ended)
+}
+
+func TestContainerFileService_Download(t *testing.T) {
+	svc, manager, instance := newContainerFileTestService(t)
+	c := newContainerFileTestContext()
+	manager.session.output = "archive-bytes"
+
+	var buf bytes.Buffer
+	written, err := svc.Download(c, instance.ID, "web", "/var/log/nginx", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(13), written)
+	assert.Equal(t, "archive-bytes", buf.String())
+	assert.Equal(t, "copy_from_container", manager.operation)
+	assert.Equal(t, map[string]string{"path": "/var/log/nginx", "compress": "true"}, manager.params)
+	assert.Equal(t, []string{"session-1"}, manager.closed)
+
+	_, err = svc.Download(c, uuid.New(), "web", "/var/log", &buf)
+	assert.ErrorContains(t, err, "instance not found")
+}
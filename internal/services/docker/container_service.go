@@ -1,6 +1,7 @@
 package docker
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,12 +14,23 @@ import (
 	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
 )
 
+const (
+	// Limits for a container update, so that stopping the old container and
+	// waiting for the new one fit in recreateTaskTimeout
+	maxUpdateStopTimeout   = 30  // seconds
+	maxUpdateHealthTimeout = 240 // seconds
+)
+
+// ErrInvalidContainerUpdate is returned when container update options are malformed
+var ErrInvalidContainerUpdate = errors.New("invalid container update")
+
 // ContainerService handles Docker container operations
 // T036-T037: Migrated to unified audit system
 type ContainerService struct {
 	instanceService *DockerInstanceService
 	agentForwarder  *AgentForwarderV2
 	auditHelper     *AuditHelper
+	imageService    *ImageService
 }
 
 // NewContainerService creates a new ContainerService
@@ -26,12 +38,122 @@ func NewContainerService(
 	instanceService *DockerInstanceService,
 	agentForwarder *AgentForwarderV2,
 	auditHelper *AuditHelper,
+	imageService *ImageService,
 ) *ContainerService {
 	return &ContainerService{
 		instanceService: instanceService,
 		agentForwarder:  agentForwarder,
 		auditHelper:     auditHelper,
+		imageService:    imageService,
+	}
+}
+
+// UpdateContainerOptions selects what a container update changes
+type UpdateContainerOptions struct {
+	Image         string                 // New image, empty to keep the current one
+	SkipPull      bool                   // Use the local image instead of pulling it first
+	Resources     *pb.ContainerResources // New resource limits, nil to keep them
+	StopTimeout   int32                  // Seconds, 0 for the agent default
+	HealthTimeout int32                  // Seconds, 0 for the agent default
+}
+
+// UpdateContainer recreates a container with a new image or resource
+// limits. The image is pulled first, then the agent stops the container,
+// renames it and creates the replacement with the same settings. If the new
+// container does not become healthy the old one is restored, and the
+// returned response has RolledBack set along with the error.
+func (s *ContainerService) UpdateContainer(c *gin.Context, instanceID uuid.UUID, containerID string, opts UpdateContainerOptions) (*pb.RecreateContainerResponse, error) {
+	startTime := time.Now()
+
+	if opts.StopTimeout < 0 || opts.StopTimeout > maxUpdateStopTimeout {
+		return nil, fmt.Errorf("%w: stop_timeout must be between 0 and %d seconds", ErrInvalidContainerUpdate, maxUpdateStopTimeout)
+	}
+	if opts.HealthTimeout < 0 || opts.HealthTimeout > maxUpdateHealthTimeout {
+		return nil, fmt.Errorf("%w: health_timeout must be between 0 and %d seconds", ErrInvalidContainerUpdate, maxUpdateHealthTimeout)
+	}
+
+	instance, err := s.instanceService.GetByID(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("instance not found: %w", err)
+	}
+
+	if !instance.CanOperate() {
+		return nil, fmt.Errorf("instance is not online (status: %s)", instance.HealthStatus)
+	}
+
+	// Snapshot the current settings to resolve the image and for the audit
+	// record; the agent recreates from the same inspect data
+	snapshot, err := s.agentForwarder.GetContainer(instanceID, &pb.GetContainerRequest{ContainerId: containerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+	detail := snapshot.GetContainer()
+	if detail == nil {
+		return nil, fmt.Errorf("failed to inspect container: empty response")
+	}
+	oldImage := detail.Image
+	if detail.Config != nil && detail.Config.Image != "" {
+		oldImage = detail.Config.Image
+	}
+	image := opts.Image
+	if image == "" {
+		image = oldImage
 	}
+
+	var resp *pb.RecreateContainerResponse
+	if !opts.SkipPull {
+		err = s.imageService.PullImageAndWait(c.Request.Context(), instanceID, image)
+	}
+	if err == nil {
+		resp, err = s.agentForwarder.RecreateContainer(instanceID, &pb.RecreateContainerRequest{
+			ContainerId:   detail.Id,
+			Image:         image,
+			Resources:     opts.Resources,
+			StopTimeout:   opts.StopTimeout,
+			HealthTimeout: opts.HealthTimeout,
+		})
+	}
+
+	duration := time.Since(startTime).Milliseconds()
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionUpdateContainer,
+		ResourceType: models.DockerResourceTypeContainer,
+		ResourceID:   instanceID,
+		ResourceName: detail.Name,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData: map[string]interface{}{
+			"container_id":     detail.Id,
+			"old_image":        oldImage,
+			"image":            image,
+			"pulled":           !opts.SkipPull,
+			"resources":        opts.Resources,
+			"new_container_id": resp.GetContainerId(),
+			"rolled_back":      resp.GetRolledBack(),
+			"result":           resp.GetMessage(),
+		},
+		Error:    err,
+		Duration: duration,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update container: %w", err)
+	}
+
+	if !resp.Success {
+		return resp, fmt.Errorf("failed to update container: %s", resp.Message)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"container":        detail.Name,
+		"instance_id":      instanceID,
+		"old_image":        oldImage,
+		"image":            image,
+		"new_container_id": resp.ContainerId,
+	}).Info("Container updated successfully")
+
+	return resp, nil
 }
 
 // StartContainer starts a container
@@ -53,7 +175,7 @@ func (s *ContainerService) StartContainer(c *gin.Context, instanceID uuid.UUID,
 		ContainerId: containerID,
 	}
 
-	resp, err := s.agentForwarder.StartContainer(instance.AgentID, req)
+	resp, err := s.agentForwarder.StartContainer(instance.ID, req)
 
 	// Log audit (after operation)
 	duration := time.Since(startTime).Milliseconds()
@@ -108,7 +230,7 @@ func (s *ContainerService) StopContainer(c *gin.Context, instanceID uuid.UUID, c
 		Timeout:     timeout,
 	}
 
-	resp, err := s.agentForwarder.StopContainer(instance.AgentID, req)
+	resp, err := s.agentForwarder.StopContainer(instance.ID, req)
 
 	duration := time.Since(startTime).Milliseconds()
 	s.auditHelper.LogDockerOperation(c, AuditParams{
@@ -164,7 +286,7 @@ func (s *ContainerService) RestartContainer(c *gin.Context, instanceID uuid.UUID
 		Timeout:     timeout,
 	}
 
-	resp, err := s.agentForwarder.RestartContainer(instance.AgentID, req)
+	resp, err := s.agentForwarder.RestartContainer(instance.ID, req)
 
 	duration := time.Since(startTime).Milliseconds()
 	s.auditHelper.LogDockerOperation(c, AuditParams{
@@ -216,7 +338,7 @@ func (s *ContainerService) PauseContainer(c *gin.Context, instanceID uuid.UUID,
 	}
 
 	req := &pb.PauseContainerRequest{ContainerId: containerID}
-	resp, err := s.agentForwarder.PauseContainer(instance.AgentID, req)
+	resp, err := s.agentForwarder.PauseContainer(instance.ID, req)
 
 	duration := time.Since(startTime).Milliseconds()
 	s.auditHelper.LogDockerOperation(c, AuditParams{
@@ -265,7 +387,7 @@ func (s *ContainerService) UnpauseContainer(c *gin.Context, instanceID uuid.UUID
 	}
 
 	req := &pb.UnpauseContainerRequest{ContainerId: containerID}
-	resp, err := s.agentForwarder.UnpauseContainer(instance.AgentID, req)
+	resp, err := s.agentForwarder.UnpauseContainer(instance.ID, req)
 
 	duration := time.Since(startTime).Milliseconds()
 	s.auditHelper.LogDockerOperation(c, AuditParams{
@@ -319,7 +441,7 @@ func (s *ContainerService) DeleteContainer(c *gin.Context, instanceID uuid.UUID,
 		RemoveVolumes: removeVolumes,
 	}
 
-	resp, err := s.agentForwarder.DeleteContainer(instance.AgentID, req)
+	resp, err := s.agentForwarder.DeleteContainer(instance.ID, req)
 
 	duration := time.Since(startTime).Milliseconds()
 	s.auditHelper.LogDockerOperation(c, AuditParams{
@@ -0,0 +1,174 @@
+package docker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/crypto"
+
+	engine "github.com/ysicing/tiga/internal/docker"
+)
+
+// DirectBackend runs Docker operations against the engines of direct
+// instances, keeping one connection per instance. A connection is replaced
+// when the connection settings of the instance change.
+type DirectBackend struct {
+	db *gorm.DB
+
+	mu          sync.Mutex
+	connections map[uuid.UUID]*directConnection
+}
+
+type directConnection struct {
+	settings string
+	client   *engine.DockerClient
+	service  *engine.DockerService
+}
+
+// NewDirectBackend creates a new DirectBackend
+func NewDirectBackend(db *gorm.DB) *DirectBackend {
+	return &DirectBackend{
+		db:          db,
+		connections: make(map[uuid.UUID]*directConnection),
+	}
+}
+
+// Execute runs a Docker task against the engine of a direct instance
+func (b *DirectBackend) Execute(ctx context.Context, instance *models.DockerInstance, task *engine.Task) (interface{}, error) {
+	conn, err := b.connection(instance)
+	if err != nil {
+		return nil, err
+	}
+	return conn.service.ExecuteTask(ctx, task)
+}
+
+// Client returns the engine client of a direct instance for streaming
+// operations. The client is shared and must not be closed by the caller.
+func (b *DirectBackend) Client(instance *models.DockerInstance) (*engine.DockerClient, error) {
+	conn, err := b.connection(instance)
+	if err != nil {
+		return nil, err
+	}
+	return conn.client, nil
+}
+
+// connection returns the engine connection of an instance, connecting when
+// there is no current connection
+func (b *DirectBackend) connection(instance *models.DockerInstance) (*directConnection, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	settings := connectionSettings(instance)
+	if conn, ok := b.connections[instance.ID]; ok {
+		if conn.settings == settings {
+			return conn, nil
+		}
+		conn.client.Close()
+		delete(b.connections, instance.ID)
+	}
+
+	endpoint, err := remoteEndpoint(instance)
+	if err != nil {
+		return nil, err
+	}
+	client, err := engine.NewRemoteDockerClient(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", instance.Endpoint, err)
+	}
+
+	// Keep the SSH host key trusted on first use
+	if hostKey := client.HostKey(); hostKey != "" && instance.SSHHostKey == "" {
+		if err := b.db.Model(&models.DockerInstance{}).Where("id = ?", instance.ID).
+			UpdateColumn("ssh_host_key", hostKey).Error; err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to save SSH host key: %w", err)
+		}
+		logrus.WithFields(logrus.Fields{
+			"instance_id": instance.ID,
+			"endpoint":    instance.Endpoint,
+			"host_key":    hostKey,
+		}).Info("Trusted SSH host key of Docker instance on first use")
+		instance.SSHHostKey = hostKey
+		settings = connectionSettings(instance)
+	}
+
+	conn := &directConnection{
+		settings: settings,
+		client:   client,
+		service:  engine.NewDockerService(client),
+	}
+	b.connections[instance.ID] = conn
+	return conn, nil
+}
+
+// Evict closes the connection of an instance, if any
+func (b *DirectBackend) Evict(instanceID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if conn, ok := b.connections[instanceID]; ok {
+		conn.client.Close()
+		delete(b.connections, instanceID)
+	}
+}
+
+// connectionSettings identifies the connection settings of an instance
+func connectionSettings(instance *models.DockerInstance) string {
+	sum := sha256.New()
+	for _, value := range []string{
+		instance.Endpoint, instance.TLSCACert, instance.TLSCert,
+		instance.TLSKey, instance.SSHKey, instance.SSHHostKey,
+	} {
+		sum.Write([]byte(value))
+		sum.Write([]byte{0})
+	}
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// remoteEndpoint builds the engine endpoint of a direct instance with its
+// keys decrypted
+func remoteEndpoint(instance *models.DockerInstance) (engine.RemoteEndpoint, error) {
+	endpoint := engine.RemoteEndpoint{
+		Host:       instance.Endpoint,
+		TLSCACert:  instance.TLSCACert,
+		TLSCert:    instance.TLSCert,
+		SSHHostKey: instance.SSHHostKey,
+	}
+
+	var err error
+	if instance.TLSKey != "" {
+		if endpoint.TLSKey, err = decryptDockerSecret(instance.TLSKey); err != nil {
+			return endpoint, fmt.Errorf("failed to decrypt TLS key: %w", err)
+		}
+	}
+	if instance.SSHKey != "" {
+		if endpoint.SSHKey, err = decryptDockerSecret(instance.SSHKey); err != nil {
+			return endpoint, fmt.Errorf("failed to decrypt SSH key: %w", err)
+		}
+	}
+	return endpoint, nil
+}
+
+func encryptDockerSecret(plaintext string) (string, error) {
+	service := crypto.GetDefaultService()
+	if service == nil {
+		return "", fmt.Errorf("encryption service not initialised")
+	}
+	return service.Encrypt(plaintext)
+}
+
+func decryptDockerSecret(ciphertext string) (string, error) {
+	service := crypto.GetDefaultService()
+	if service == nil {
+		return "", fmt.Errorf("encryption service not initialised")
+	}
+	return service.Decrypt(ciphertext)
+}
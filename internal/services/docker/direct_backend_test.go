@@ -0,0 +1,83 @@
+package docker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/crypto"
+	"github.com/ysicing/tiga/tests/testdb"
+
+	engine "github.com/ysicing/tiga/internal/docker"
+)
+
+func newDirectInstanceTestService(t *testing.T) (*DockerInstanceService, *gorm.DB) {
+	db := testdb.Open(t, &models.DockerInstance{})
+	require.NoError(t, crypto.InitDefaultService([]byte("0123456789abcdef0123456789abcdef")))
+	return NewDockerInstanceService(db), db
+}
+
+func testSSHPrivateKey(t *testing.T) string {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(private, "")
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(block))
+}
+
+func TestDockerInstanceService_CreateDirectInstance(t *testing.T) {
+	svc, db := newDirectInstanceTestService(t)
+	ctx := context.Background()
+	sshKey := testSSHPrivateKey(t)
+
+	instance, err := svc.CreateDirectInstance(ctx, "nas", "storage appliance",
+		engine.RemoteEndpoint{Host: "ssh://admin@nas.local", SSHKey: sshKey}, []string{"storage"})
+	require.NoError(t, err)
+	assert.True(t, instance.IsDirect())
+	assert.Equal(t, uuid.Nil, instance.AgentID)
+
+	// The private key is stored encrypted and decrypted for connecting
+	var stored models.DockerInstance
+	require.NoError(t, db.First(&stored, "id = ?", instance.ID).Error)
+	assert.Equal(t, "ssh://admin@nas.local", stored.Endpoint)
+	assert.NotEmpty(t, stored.SSHKey)
+	assert.NotEqual(t, sshKey, stored.SSHKey)
+	endpoint, err := remoteEndpoint(&stored)
+	require.NoError(t, err)
+	assert.Equal(t, sshKey, endpoint.SSHKey)
+
+	// Several direct instances can exist without an agent
+	_, err = svc.CreateDirectInstance(ctx, "backup", "",
+		engine.RemoteEndpoint{Host: "ssh://backup.local", SSHKey: sshKey}, nil)
+	assert.NoError(t, err)
+
+	_, err = svc.CreateDirectInstance(ctx, "nas", "",
+		engine.RemoteEndpoint{Host: "ssh://other.local", SSHKey: sshKey}, nil)
+	assert.ErrorContains(t, err, "already exists")
+
+	_, err = svc.CreateDirectInstance(ctx, "router", "",
+		engine.RemoteEndpoint{Host: "tcp://10.0.0.1:2376"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidDirectEndpoint)
+}
+
+func TestConnectionSettings(t *testing.T) {
+	instance := &models.DockerInstance{Endpoint: "ssh://nas.local", SSHKey: "encrypted"}
+	settings := connectionSettings(instance)
+
+	// Status updates keep the connection
+	instance.HealthStatus = "online"
+	instance.ContainerCount = 3
+	assert.Equal(t, settings, connectionSettings(instance))
+
+	instance.SSHHostKey = "ssh-ed25519 AAAA"
+	assert.NotEqual(t, settings, connectionSettings(instance))
+}
@@ -58,10 +58,11 @@ func (s *DockerHealthService) CheckInstance(ctx context.Context, instanceID uuid
 	}).Debug("Checking Docker instance health")
 
 	// T032: For integrated architecture (Docker instances from agent auto-discovery),
-	// check agent connection status instead of calling AgentForwarder
+	// check agent connection status instead of calling AgentForwarder.
+	// Direct instances have no agent and are checked by querying the engine.
 	var agentConn models.AgentConnection
 	err = s.db.Where("id = ?", instance.AgentID).First(&agentConn).Error
-	if err == nil {
+	if err == nil && !instance.IsDirect() {
 		// Agent connection record found, check if agent is online
 		if agentConn.Status == models.AgentStatusOnline {
 			// Agent is online, mark Docker instance as online
@@ -88,7 +89,7 @@ func (s *DockerHealthService) CheckInstance(ctx context.Context, instanceID uuid
 
 	// Fall back to old logic for standalone Docker Agent architecture
 	// Call GetDockerInfo via agent forwarder
-	info, err := s.agentForwarder.GetDockerInfo(instance.AgentID)
+	info, err := s.agentForwarder.GetDockerInfo(instance.ID)
 	if err != nil {
 		// Health check failed, mark as offline
 		logrus.WithFields(logrus.Fields{
@@ -134,6 +135,9 @@ func (s *DockerHealthService) CheckInstance(ctx context.Context, instanceID uuid
 		"mem_total":        info.Info.MemTotal,
 		"n_cpu":            int(info.Info.Ncpu),
 	}
+	for key, value := range swarmInfoUpdates(info.Info.Swarm) {
+		dockerInfo[key] = value
+	}
 
 	if err := s.repo.UpdateDockerInfo(ctx, instanceID, dockerInfo); err != nil {
 		logrus.WithError(err).Error("Failed to update Docker info")
@@ -0,0 +1,129 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/imagescan"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+// ContainerImage is the image a container runs
+type ContainerImage struct {
+	InstanceID    uuid.UUID `json:"instance_id"`
+	InstanceName  string    `json:"instance_name"`
+	ContainerID   string    `json:"container_id"`
+	ContainerName string    `json:"container_name"`
+	State         string    `json:"state"`
+	Image         string    `json:"image"`
+	ImageRef      string    `json:"image_ref"` // Pullable reference used for scanning
+	Digest        string    `json:"digest"`
+}
+
+// ImageInventory lists the images that containers run on Docker instances
+type ImageInventory struct {
+	instanceService *DockerInstanceService
+	agentForwarder  *AgentForwarderV2
+}
+
+// NewImageInventory creates a new ImageInventory
+func NewImageInventory(instanceService *DockerInstanceService, agentForwarder *AgentForwarderV2) *ImageInventory {
+	return &ImageInventory{
+		instanceService: instanceService,
+		agentForwarder:  agentForwarder,
+	}
+}
+
+// ListContainerImages lists the images of all containers on an instance
+func (i *ImageInventory) ListContainerImages(ctx context.Context, instance *models.DockerInstance) ([]ContainerImage, error) {
+	if !instance.CanOperate() {
+		return nil, fmt.Errorf("instance is not online (status: %s)", instance.HealthStatus)
+	}
+
+	containers, err := i.agentForwarder.ListContainers(instance.ID, &pb.ListContainersRequest{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+	images, err := i.agentForwarder.ListImages(instance.ID, &pb.ListImagesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	result := make([]ContainerImage, 0, len(containers.Containers))
+	for _, c := range containers.Containers {
+		item := ContainerImage{
+			InstanceID:    instance.ID,
+			InstanceName:  instance.Name,
+			ContainerID:   c.Id,
+			ContainerName: strings.TrimPrefix(firstOrEmpty(c.Names), "/"),
+			State:         c.State,
+			Image:         c.Image,
+			ImageRef:      c.Image,
+			Digest:        c.ImageId,
+		}
+		// Prefer the registry digest so results are shared with other
+		// hosts and clusters running the same image
+		if img := findImage(images.Images, c.ImageId); img != nil && len(img.RepoDigests) > 0 {
+			item.ImageRef = img.RepoDigests[0]
+			item.Digest = repoDigest(img.RepoDigests[0])
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// ListImageRefs lists the images of containers on all online instances
+func (i *ImageInventory) ListImageRefs(ctx context.Context) ([]imagescan.ImageRef, error) {
+	instances, err := i.instanceService.ListOnlineInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list online instances: %w", err)
+	}
+
+	var refs []imagescan.ImageRef
+	var errs []error
+	for _, instance := range instances {
+		items, err := i.ListContainerImages(ctx, instance)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("instance %s: %w", instance.Name, err))
+			continue
+		}
+		for _, item := range items {
+			refs = append(refs, imagescan.ImageRef{Image: item.ImageRef, Digest: item.Digest})
+		}
+	}
+	return refs, errors.Join(errs...)
+}
+
+// findImage finds the image with the given full image ID; listed image IDs
+// are shortened
+func findImage(images []*pb.Image, imageID string) *pb.Image {
+	id := strings.TrimPrefix(imageID, "sha256:")
+	for _, img := range images {
+		short := strings.TrimPrefix(img.Id, "sha256:")
+		if short != "" && strings.HasPrefix(id, short) {
+			return img
+		}
+	}
+	return nil
+}
+
+// repoDigest extracts the digest of a repo digest such as nginx@sha256:...
+func repoDigest(ref string) string {
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		return ref[i+1:]
+	}
+	return ref
+}
+
+func firstOrEmpty(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
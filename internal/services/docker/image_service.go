@@ -26,6 +26,7 @@ type ImageService struct {
 	agentForwarder      *AgentForwarderV2
 	dockerStreamManager DockerStreamManager
 	auditHelper         *AuditHelper
+	credentialService   *RegistryCredentialService
 }
 
 // NewImageService creates a new ImageService
@@ -34,12 +35,14 @@ func NewImageService(
 	agentForwarder *AgentForwarderV2,
 	dockerStreamManager DockerStreamManager,
 	auditHelper *AuditHelper,
+	credentialService *RegistryCredentialService,
 ) *ImageService {
 	return &ImageService{
 		instanceService:     instanceService,
 		agentForwarder:      agentForwarder,
 		dockerStreamManager: dockerStreamManager,
 		auditHelper:         auditHelper,
+		credentialService:   credentialService,
 	}
 }
 
@@ -64,7 +67,7 @@ func (s *ImageService) DeleteImage(c *gin.Context, instanceID uuid.UUID, imageID
 		NoPrune: noPrune,
 	}
 
-	resp, err := s.agentForwarder.DeleteImage(instance.AgentID, req)
+	resp, err := s.agentForwarder.DeleteImage(instance.ID, req)
 
 	// Log audit (after operation)
 	duration := time.Since(startTime).Milliseconds()
@@ -118,7 +121,7 @@ func (s *ImageService) TagImage(c *gin.Context, instanceID uuid.UUID, source str
 		Target: target,
 	}
 
-	resp, err := s.agentForwarder.TagImage(instance.AgentID, req)
+	resp, err := s.agentForwarder.TagImage(instance.ID, req)
 
 	duration := time.Since(startTime).Milliseconds()
 	s.auditHelper.LogDockerOperation(c, AuditParams{
@@ -177,6 +180,13 @@ func (s *ImageService) PullImage(ctx context.Context, instanceID uuid.UUID, imag
 		"agent_id":      instance.AgentID.String(),
 	}).Info("Starting image pull via DockerStream")
 
+	// Use the stored credentials unless the caller sent its own
+	if registryAuth == "" {
+		if registryAuth, err = s.GetRegistryAuth(ctx, instance, image); err != nil {
+			logrus.WithError(err).WithField("image", image).Warn("Failed to resolve registry credentials, pulling anonymously")
+		}
+	}
+
 	// Create parameters for pull operation
 	params := make(map[string]string)
 	if registryAuth != "" {
@@ -199,12 +209,41 @@ func (s *ImageService) PullImage(ctx context.Context, instanceID uuid.UUID, imag
 	return session, nil
 }
 
-// GetRegistryAuth retrieves registry authentication for image operations
-// Phase 1: Read from Docker config.json
-// TODO: Phase 2: Support custom registry credentials stored in database
-func (s *ImageService) GetRegistryAuth(registry string) (string, error) {
-	// Phase 1: Return empty string (Agent will use default Docker config)
-	// The Agent's Docker daemon will use its own config.json for authentication
-	logrus.WithField("registry", registry).Debug("Using Agent's Docker config for registry auth")
-	return "", nil
+// pullWaiter is the part of *host.DockerStreamSession needed to wait for a
+// pull to finish
+type pullWaiter interface {
+	WaitForReady(timeout time.Duration) error
+	Wait(ctx context.Context) error
+}
+
+// PullImageAndWait pulls an image through the same stream as PullImage and
+// waits until the pull has finished
+func (s *ImageService) PullImageAndWait(ctx context.Context, instanceID uuid.UUID, image string) error {
+	sessionInterface, err := s.PullImage(ctx, instanceID, image, "")
+	if err != nil {
+		return err
+	}
+
+	session, ok := sessionInterface.(pullWaiter)
+	if !ok {
+		return fmt.Errorf("internal error: invalid session type")
+	}
+	if err := session.WaitForReady(30 * time.Second); err != nil {
+		return fmt.Errorf("agent did not connect in time: %w", err)
+	}
+	if err := session.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", image, err)
+	}
+	return nil
+}
+
+// GetRegistryAuth returns the stored credentials of the image registry
+// that apply to the instance, encoded for the Docker Engine API. It returns
+// an empty string when there are none, and the agent's Docker daemon falls
+// back to its own config.json.
+func (s *ImageService) GetRegistryAuth(ctx context.Context, instance *models.DockerInstance, image string) (string, error) {
+	if s.credentialService == nil {
+		return "", nil
+	}
+	return s.credentialService.ResolveAuth(ctx, instance, image)
 }
@@ -2,6 +2,7 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -10,8 +11,14 @@ import (
 
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/repository"
+
+	engine "github.com/ysicing/tiga/internal/docker"
 )
 
+// ErrInvalidDirectEndpoint is returned for direct instances with invalid
+// connection settings
+var ErrInvalidDirectEndpoint = errors.New("invalid direct Docker endpoint")
+
 // DockerInstanceService handles business logic for Docker instances
 type DockerInstanceService struct {
 	repo repository.DockerInstanceRepositoryInterface
@@ -32,7 +39,12 @@ func (s *DockerInstanceService) Create(ctx context.Context, instance *models.Doc
 	if instance.Name == "" {
 		return fmt.Errorf("instance name is required")
 	}
-	if instance.AgentID == uuid.Nil {
+	if instance.IsDirect() {
+		if instance.Endpoint == "" {
+			return fmt.Errorf("endpoint is required")
+		}
+		instance.AgentID = uuid.Nil
+	} else if instance.AgentID == uuid.Nil {
 		return fmt.Errorf("agent ID is required")
 	}
 
@@ -43,9 +55,11 @@ func (s *DockerInstanceService) Create(ctx context.Context, instance *models.Doc
 	}
 
 	// Check if instance with same agent ID already exists
-	existing, err = s.repo.GetByAgentID(ctx, instance.AgentID)
-	if err == nil && existing != nil {
-		return fmt.Errorf("instance with agent ID '%s' already exists", instance.AgentID)
+	if !instance.IsDirect() {
+		existing, err = s.repo.GetByAgentID(ctx, instance.AgentID)
+		if err == nil && existing != nil {
+			return fmt.Errorf("instance with agent ID '%s' already exists", instance.AgentID)
+		}
 	}
 
 	// Set default values
@@ -196,7 +210,8 @@ func (s *DockerInstanceService) GetStatistics(ctx context.Context) (*repository.
 	return stats, nil
 }
 
-// TestConnection tests the connection to a Docker instance by checking agent status
+// TestConnection tests the connection to a Docker instance by checking agent status.
+// Direct instances are tested by connecting to their engine.
 func (s *DockerInstanceService) TestConnection(ctx context.Context, id uuid.UUID) (bool, error) {
 	// Get instance
 	instance, err := s.repo.GetByID(ctx, id)
@@ -204,6 +219,10 @@ func (s *DockerInstanceService) TestConnection(ctx context.Context, id uuid.UUID
 		return false, fmt.Errorf("instance not found: %w", err)
 	}
 
+	if instance.IsDirect() {
+		return testDirectConnection(instance), nil
+	}
+
 	// Get the agent connection record to check online status
 	var agentConn models.AgentConnection
 	if err := s.db.Where("id = ?", instance.AgentID).First(&agentConn).Error; err != nil {
@@ -406,6 +425,74 @@ func (s *DockerInstanceService) CreateManualInstance(
 	return instance, nil
 }
 
+// CreateDirectInstance creates a Docker instance whose engine the server
+// connects to directly. The TLS and SSH private keys are stored encrypted.
+func (s *DockerInstanceService) CreateDirectInstance(
+	ctx context.Context,
+	name string,
+	description string,
+	endpoint engine.RemoteEndpoint,
+	tags []string,
+) (*models.DockerInstance, error) {
+	if err := endpoint.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDirectEndpoint, err)
+	}
+
+	instance := &models.DockerInstance{
+		Name:           name,
+		Description:    description,
+		ConnectionType: models.DockerConnectionDirect,
+		Endpoint:       endpoint.Host,
+		TLSCACert:      endpoint.TLSCACert,
+		TLSCert:        endpoint.TLSCert,
+		SSHHostKey:     endpoint.SSHHostKey,
+		HealthStatus:   "unknown",
+		Tags:           tags,
+	}
+
+	var err error
+	if endpoint.TLSKey != "" {
+		if instance.TLSKey, err = encryptDockerSecret(endpoint.TLSKey); err != nil {
+			return nil, fmt.Errorf("failed to encrypt TLS key: %w", err)
+		}
+	}
+	if endpoint.SSHKey != "" {
+		if instance.SSHKey, err = encryptDockerSecret(endpoint.SSHKey); err != nil {
+			return nil, fmt.Errorf("failed to encrypt SSH key: %w", err)
+		}
+	}
+
+	if err := s.Create(ctx, instance); err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// testDirectConnection checks that the engine of a direct instance answers
+func testDirectConnection(instance *models.DockerInstance) bool {
+	endpoint, err := remoteEndpoint(instance)
+	if err == nil {
+		var client *engine.DockerClient
+		if client, err = engine.NewRemoteDockerClient(endpoint); err == nil {
+			client.Close()
+		}
+	}
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"instance_id": instance.ID,
+			"endpoint":    instance.Endpoint,
+		}).Info("Docker engine is not reachable")
+		return false
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"instance_id": instance.ID,
+		"endpoint":    instance.Endpoint,
+	}).Info("Docker engine is reachable")
+	return true
+}
+
 // Archive archives a Docker instance (soft delete)
 func (s *DockerInstanceService) Archive(ctx context.Context, id uuid.UUID) error {
 	return s.MarkArchived(ctx, id)
@@ -0,0 +1,260 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/pkg/crypto"
+	"github.com/ysicing/tiga/pkg/registry"
+	"github.com/ysicing/tiga/pkg/utils"
+)
+
+// RegistryCredentialService manages the registry credentials stored by the
+// server, so that agents no longer depend on their own docker login
+type RegistryCredentialService struct {
+	repo       *repository.RegistryCredentialRepository
+	httpClient *http.Client
+}
+
+// NewRegistryCredentialService creates a new RegistryCredentialService
+func NewRegistryCredentialService(db *gorm.DB) *RegistryCredentialService {
+	return &RegistryCredentialService{
+		repo: repository.NewRegistryCredentialRepository(db),
+	}
+}
+
+// List lists the credentials, optionally of one registry
+func (s *RegistryCredentialService) List(ctx context.Context, registryHost string) ([]*models.RegistryCredential, error) {
+	if registryHost != "" {
+		registryHost = registry.NormalizeHost(registryHost)
+	}
+	return s.repo.List(ctx, registryHost)
+}
+
+// Get retrieves a credential by ID
+func (s *RegistryCredentialService) Get(ctx context.Context, id uuid.UUID) (*models.RegistryCredential, error) {
+	credential, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("registry credential not found")
+		}
+		return nil, fmt.Errorf("failed to get registry credential: %w", err)
+	}
+	return credential, nil
+}
+
+// Create stores a new credential with its password encrypted
+func (s *RegistryCredentialService) Create(ctx context.Context, credential *models.RegistryCredential, password string) error {
+	if password == "" {
+		return fmt.Errorf("password is required")
+	}
+	if err := s.validate(ctx, credential, nil); err != nil {
+		return err
+	}
+
+	encrypted, err := encryptRegistryPassword(password)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt password: %w", err)
+	}
+	credential.Password = encrypted
+
+	if err := s.repo.Create(ctx, credential); err != nil {
+		return fmt.Errorf("failed to create registry credential: %w", err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"credential_id": credential.ID,
+		"registry":      credential.Registry,
+	}).Info("Registry credential created")
+	return nil
+}
+
+// Update replaces the settings of a credential. An empty password keeps
+// the stored one.
+func (s *RegistryCredentialService) Update(ctx context.Context, id uuid.UUID, update *models.RegistryCredential, password string) (*models.RegistryCredential, error) {
+	credential, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, update, &id); err != nil {
+		return nil, err
+	}
+
+	credential.Name = update.Name
+	credential.Registry = update.Registry
+	credential.Username = update.Username
+	credential.Description = update.Description
+	credential.InstanceIDs = update.InstanceIDs
+	credential.Team = update.Team
+	if password != "" {
+		if credential.Password, err = encryptRegistryPassword(password); err != nil {
+			return nil, fmt.Errorf("failed to encrypt password: %w", err)
+		}
+		credential.LastTestedAt = nil
+		credential.LastTestError = ""
+	}
+
+	if err := s.repo.Update(ctx, credential); err != nil {
+		return nil, fmt.Errorf("failed to update registry credential: %w", err)
+	}
+	return credential, nil
+}
+
+// Delete deletes a credential
+func (s *RegistryCredentialService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete registry credential: %w", err)
+	}
+	return nil
+}
+
+// TestLogin logs in to the registry of a credential and records the result
+func (s *RegistryCredentialService) TestLogin(ctx context.Context, id uuid.UUID) error {
+	credential, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	password, err := decryptRegistryPassword(credential.Password)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt password: %w", err)
+	}
+
+	loginErr := registry.NewClient(credential.Registry, credential.Username, password, s.httpClient).Ping(ctx)
+
+	fields := map[string]interface{}{
+		"last_tested_at":  time.Now(),
+		"last_test_error": "",
+	}
+	if loginErr != nil {
+		fields["last_test_error"] = loginErr.Error()
+	}
+	if err := s.repo.UpdateFields(ctx, id, fields); err != nil {
+		logrus.WithError(err).Warn("Failed to record registry login test")
+	}
+	return loginErr
+}
+
+// ResolveAuth returns the encoded registry auth to pull an image on an
+// instance, or an empty string when no credential applies. Credentials
+// scoped to the instance win over team credentials, which win over global
+// ones.
+func (s *RegistryCredentialService) ResolveAuth(ctx context.Context, instance *models.DockerInstance, image string) (string, error) {
+	host, _ := utils.GetImageRegistryAndRepo(image)
+	host = registry.NormalizeHost(host)
+
+	credentials, err := s.repo.List(ctx, host)
+	if err != nil {
+		return "", fmt.Errorf("failed to list registry credentials: %w", err)
+	}
+
+	var selected *models.RegistryCredential
+	rank := 0
+	for _, credential := range credentials {
+		if !credential.AppliesTo(instance) {
+			continue
+		}
+		r := 1
+		if credential.HasInstance(instance.ID) {
+			r = 3
+		} else if !credential.IsGlobal() {
+			r = 2
+		}
+		if r > rank {
+			selected, rank = credential, r
+		}
+	}
+	if selected == nil {
+		return "", nil
+	}
+
+	password, err := decryptRegistryPassword(selected.Password)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt password of registry credential %s: %w", selected.Name, err)
+	}
+	return registry.EncodeAuth(selected.Registry, selected.Username, password)
+}
+
+// NewRegistryClient returns a client for the registry of an image, logged
+// in with a global credential of that registry when there is one. The
+// client is nil when there is none.
+func (s *RegistryCredentialService) NewRegistryClient(ctx context.Context, image string) (*registry.Client, error) {
+	host, username, password, found, err := s.GlobalLogin(ctx, image)
+	if err != nil || !found {
+		return nil, err
+	}
+	return registry.NewClient(host, username, password, s.httpClient), nil
+}
+
+// GlobalLogin returns the registry host and the login of the global
+// credential for an image's registry; found is false when there is none
+func (s *RegistryCredentialService) GlobalLogin(ctx context.Context, image string) (host, username, password string, found bool, err error) {
+	host, _ = utils.GetImageRegistryAndRepo(image)
+	host = registry.NormalizeHost(host)
+
+	credentials, err := s.repo.List(ctx, host)
+	if err != nil {
+		return host, "", "", false, fmt.Errorf("failed to list registry credentials: %w", err)
+	}
+	for _, credential := range credentials {
+		if !credential.IsGlobal() {
+			continue
+		}
+		password, err := decryptRegistryPassword(credential.Password)
+		if err != nil {
+			return host, "", "", false, fmt.Errorf("failed to decrypt password of registry credential %s: %w", credential.Name, err)
+		}
+		return host, credential.Username, password, true, nil
+	}
+	return host, "", "", false, nil
+}
+
+func (s *RegistryCredentialService) validate(ctx context.Context, credential *models.RegistryCredential, excludeID *uuid.UUID) error {
+	if credential.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if credential.Username == "" {
+		return fmt.Errorf("username is required")
+	}
+	credential.Registry = registry.NormalizeHost(credential.Registry)
+	for _, instanceID := range credential.InstanceIDs {
+		if _, err := uuid.Parse(instanceID); err != nil {
+			return fmt.Errorf("invalid instance ID %q", instanceID)
+		}
+	}
+
+	exists, err := s.repo.ExistsName(ctx, credential.Name, excludeID)
+	if err != nil {
+		return fmt.Errorf("failed to check name: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("registry credential with name '%s' already exists", credential.Name)
+	}
+	return nil
+}
+
+func encryptRegistryPassword(plaintext string) (string, error) {
+	service := crypto.GetDefaultService()
+	if service == nil {
+		return "", fmt.Errorf("encryption service not initialised")
+	}
+	return service.Encrypt(plaintext)
+}
+
+func decryptRegistryPassword(ciphertext string) (string, error) {
+	service := crypto.GetDefaultService()
+	if service == nil {
+		return "", fmt.Errorf("encryption service not initialised")
+	}
+	return service.Decrypt(ciphertext)
+}
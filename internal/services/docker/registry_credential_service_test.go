@@ -0,0 +1,87 @@
+package docker
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/crypto"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func newRegistryCredentialTestService(t *testing.T) (*RegistryCredentialService, *gorm.DB) {
+	db := testdb.Open(t, &models.RegistryCredential{})
+	require.NoError(t, crypto.InitDefaultService([]byte("0123456789abcdef0123456789abcdef")))
+	return NewRegistryCredentialService(db), db
+}
+
+func decodeRegistryAuth(t *testing.T, encoded string) map[string]string {
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	var auth map[string]string
+	require.NoError(t, json.Unmarshal(data, &auth))
+	return auth
+}
+
+func TestRegistryCredentialService_ResolveAuth(t *testing.T) {
+	svc, db := newRegistryCredentialTestService(t)
+	ctx := context.Background()
+
+	instance := &models.DockerInstance{Name: "web", Tags: []string{"payments"}}
+	instance.ID = uuid.New()
+	other := &models.DockerInstance{Name: "batch"}
+	other.ID = uuid.New()
+
+	require.NoError(t, svc.Create(ctx, &models.RegistryCredential{Name: "hub", Registry: "index.docker.io", Username: "global"}, "p1"))
+	require.NoError(t, svc.Create(ctx, &models.RegistryCredential{Name: "ghcr-team", Registry: "ghcr.io", Username: "team", Team: "payments"}, "p2"))
+	require.NoError(t, svc.Create(ctx, &models.RegistryCredential{Name: "ghcr-web", Registry: "ghcr.io", Username: "web", InstanceIDs: []string{instance.ID.String()}}, "p3"))
+
+	// Passwords are stored encrypted
+	var stored models.RegistryCredential
+	require.NoError(t, db.Where("name = ?", "hub").First(&stored).Error)
+	assert.Equal(t, "docker.io", stored.Registry)
+	assert.NotEqual(t, "p1", stored.Password)
+
+	auth, err := svc.ResolveAuth(ctx, instance, "nginx:1.27")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "global", "password": "p1", "serveraddress": "docker.io"}, decodeRegistryAuth(t, auth))
+
+	// Instance credentials win over team credentials
+	auth, err = svc.ResolveAuth(ctx, instance, "ghcr.io/acme/api:v1")
+	require.NoError(t, err)
+	assert.Equal(t, "web", decodeRegistryAuth(t, auth)["username"])
+
+	// Scoped credentials do not leak to other instances
+	auth, err = svc.ResolveAuth(ctx, other, "ghcr.io/acme/api:v1")
+	require.NoError(t, err)
+	assert.Empty(t, auth)
+
+	client, err := svc.NewRegistryClient(ctx, "ghcr.io/acme/api")
+	require.NoError(t, err)
+	assert.Nil(t, client, "only global credentials are used to list tags")
+}
+
+func TestRegistryCredentialService_Update(t *testing.T) {
+	svc, _ := newRegistryCredentialTestService(t)
+	ctx := context.Background()
+
+	credential := &models.RegistryCredential{Name: "hub", Username: "alice"}
+	require.Error(t, svc.Create(ctx, credential, ""))
+	require.NoError(t, svc.Create(ctx, credential, "secret"))
+	assert.Error(t, svc.Create(ctx, &models.RegistryCredential{Name: "hub", Username: "bob"}, "secret"), "names are unique")
+
+	// An empty password keeps the stored one
+	updated, err := svc.Update(ctx, credential.ID, &models.RegistryCredential{Name: "hub", Username: "bob"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, credential.Password, updated.Password)
+
+	_, err = svc.Update(ctx, credential.ID, &models.RegistryCredential{Name: "hub", Username: "bob", InstanceIDs: []string{"web"}}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,628 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ysicing/tiga/internal/models"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+var (
+	// ErrNotSwarmManager is returned for swarm operations on an instance
+	// that is not an active swarm manager
+	ErrNotSwarmManager = errors.New("docker instance is not a swarm manager")
+	// ErrInvalidSwarmRequest is returned when swarm request options are malformed
+	ErrInvalidSwarmRequest = errors.New("invalid swarm request")
+)
+
+// SwarmService handles Docker Swarm nodes, services, secrets, configs and
+// stacks. The operations run on the agent of a manager instance.
+type SwarmService struct {
+	instanceService   *DockerInstanceService
+	agentForwarder    *AgentForwarderV2
+	auditHelper       *AuditHelper
+	credentialService *RegistryCredentialService
+}
+
+// NewSwarmService creates a new SwarmService
+func NewSwarmService(
+	instanceService *DockerInstanceService,
+	agentForwarder *AgentForwarderV2,
+	auditHelper *AuditHelper,
+	credentialService *RegistryCredentialService,
+) *SwarmService {
+	return &SwarmService{
+		instanceService:   instanceService,
+		agentForwarder:    agentForwarder,
+		auditHelper:       auditHelper,
+		credentialService: credentialService,
+	}
+}
+
+// swarmInfoUpdates returns the DockerInstance fields for the swarm state of
+// its daemon
+func swarmInfoUpdates(info *pb.SwarmInfo) map[string]interface{} {
+	if info == nil {
+		info = &pb.SwarmInfo{LocalNodeState: "inactive"}
+	}
+	return map[string]interface{}{
+		"swarm_node_state":        info.LocalNodeState,
+		"swarm_control_available": info.ControlAvailable,
+		"swarm_node_id":           info.NodeId,
+		"swarm_cluster_id":        info.ClusterId,
+	}
+}
+
+// GetSwarmInfo detects the swarm state of an instance from its system info
+// and stores it on the instance
+func (s *SwarmService) GetSwarmInfo(ctx context.Context, instanceID uuid.UUID) (*pb.SwarmInfo, error) {
+	instance, err := s.instanceService.GetByID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("instance not found: %w", err)
+	}
+	if !instance.CanOperate() {
+		return nil, fmt.Errorf("instance is not online (status: %s)", instance.HealthStatus)
+	}
+	return s.refreshSwarmInfo(ctx, instance)
+}
+
+// refreshSwarmInfo fetches the swarm state of an instance and updates the
+// instance when it changed
+func (s *SwarmService) refreshSwarmInfo(ctx context.Context, instance *models.DockerInstance) (*pb.SwarmInfo, error) {
+	resp, err := s.agentForwarder.GetSystemInfo(instance.ID, &pb.GetSystemInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system info: %w", err)
+	}
+	info := resp.GetInfo().GetSwarm()
+	if info == nil {
+		info = &pb.SwarmInfo{LocalNodeState: "inactive"}
+	}
+
+	if info.LocalNodeState != instance.SwarmNodeState ||
+		info.ControlAvailable != instance.SwarmControlAvailable ||
+		info.NodeId != instance.SwarmNodeID ||
+		info.ClusterId != instance.SwarmClusterID {
+		if err := s.instanceService.Update(ctx, instance.ID, swarmInfoUpdates(info)); err != nil {
+			logrus.WithError(err).WithField("instance_id", instance.ID).Warn("Failed to store swarm state")
+		}
+		instance.SwarmNodeState = info.LocalNodeState
+		instance.SwarmControlAvailable = info.ControlAvailable
+		instance.SwarmNodeID = info.NodeId
+		instance.SwarmClusterID = info.ClusterId
+	}
+	return info, nil
+}
+
+// getManagerInstance returns an online instance that is a swarm manager.
+// The stored swarm state is refreshed when it does not say so, because the
+// daemon may have joined a swarm since it was last checked.
+func (s *SwarmService) getManagerInstance(ctx context.Context, instanceID uuid.UUID) (*models.DockerInstance, error) {
+	instance, err := s.instanceService.GetByID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("instance not found: %w", err)
+	}
+	if !instance.CanOperate() {
+		return nil, fmt.Errorf("instance is not online (status: %s)", instance.HealthStatus)
+	}
+	if instance.IsSwarmManager() {
+		return instance, nil
+	}
+
+	if _, err := s.refreshSwarmInfo(ctx, instance); err != nil {
+		return nil, err
+	}
+	if !instance.IsSwarmManager() {
+		return nil, fmt.Errorf("%w (swarm state: %s)", ErrNotSwarmManager, instance.SwarmNodeState)
+	}
+	return instance, nil
+}
+
+// ListNodes lists the nodes of the swarm
+func (s *SwarmService) ListNodes(ctx context.Context, instanceID uuid.UUID) ([]*pb.SwarmNode, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.ListSwarmNodes(instanceID, &pb.ListSwarmNodesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list swarm nodes: %w", err)
+	}
+	return resp.Nodes, nil
+}
+
+// UpdateNode changes the availability (active, pause, drain) or the role
+// (worker, manager) of a node. Empty values are left unchanged.
+func (s *SwarmService) UpdateNode(c *gin.Context, instanceID uuid.UUID, nodeID, availability, role string) (*pb.UpdateSwarmNodeResponse, error) {
+	startTime := time.Now()
+
+	switch availability {
+	case "", "active", "pause", "drain":
+	default:
+		return nil, fmt.Errorf("%w: availability must be active, pause or drain", ErrInvalidSwarmRequest)
+	}
+	switch role {
+	case "", "worker", "manager":
+	default:
+		return nil, fmt.Errorf("%w: role must be worker or manager", ErrInvalidSwarmRequest)
+	}
+	if availability == "" && role == "" {
+		return nil, fmt.Errorf("%w: availability or role is required", ErrInvalidSwarmRequest)
+	}
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.agentForwarder.UpdateSwarmNode(instanceID, &pb.UpdateSwarmNodeRequest{
+		NodeId:       nodeID,
+		Availability: availability,
+		Role:         role,
+	})
+
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionUpdateSwarmNode,
+		ResourceType: models.DockerResourceTypeSwarmNode,
+		ResourceID:   instanceID,
+		ResourceName: nodeID,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData: map[string]interface{}{
+			"node_id":      nodeID,
+			"availability": availability,
+			"role":         role,
+		},
+		Error:    err,
+		Duration: time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update swarm node: %w", err)
+	}
+	return resp, nil
+}
+
+// ListServices lists the swarm services, optionally of one stack
+func (s *SwarmService) ListServices(ctx context.Context, instanceID uuid.UUID, stack string) ([]*pb.SwarmService, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.ListSwarmServices(instanceID, &pb.ListSwarmServicesRequest{Stack: stack})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list swarm services: %w", err)
+	}
+	return resp.Services, nil
+}
+
+// GetService returns a swarm service along with its spec
+func (s *SwarmService) GetService(ctx context.Context, instanceID uuid.UUID, serviceID string) (*pb.GetSwarmServiceResponse, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.GetSwarmService(instanceID, &pb.GetSwarmServiceRequest{ServiceId: serviceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get swarm service: %w", err)
+	}
+	return resp, nil
+}
+
+// ScaleService sets the number of replicas of a replicated service
+func (s *SwarmService) ScaleService(c *gin.Context, instanceID uuid.UUID, serviceID string, replicas uint64) (*pb.UpdateSwarmServiceResponse, error) {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.agentForwarder.ScaleSwarmService(instanceID, &pb.ScaleSwarmServiceRequest{
+		ServiceId: serviceID,
+		Replicas:  replicas,
+	})
+	s.logServiceUpdate(c, instance, models.DockerActionScaleSwarmService, serviceID, map[string]interface{}{
+		"replicas": replicas,
+	}, resp, err, startTime)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to scale swarm service: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdateServiceImage starts a rolling update of a service to a new image.
+// The stored registry credentials of the image apply; without any the
+// service keeps using the auth of its current spec.
+func (s *SwarmService) UpdateServiceImage(c *gin.Context, instanceID uuid.UUID, serviceID, image string) (*pb.UpdateSwarmServiceResponse, error) {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryAuth, err := s.credentialService.ResolveAuth(c.Request.Context(), instance, image)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.agentForwarder.UpdateSwarmServiceImage(instanceID, &pb.UpdateSwarmServiceImageRequest{
+		ServiceId:    serviceID,
+		Image:        image,
+		RegistryAuth: registryAuth,
+	})
+	s.logServiceUpdate(c, instance, models.DockerActionUpdateSwarmServiceImage, serviceID, map[string]interface{}{
+		"image": image,
+	}, resp, err, startTime)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update swarm service image: %w", err)
+	}
+	return resp, nil
+}
+
+// RollbackService reverts a service to its previous spec
+func (s *SwarmService) RollbackService(c *gin.Context, instanceID uuid.UUID, serviceID string) (*pb.UpdateSwarmServiceResponse, error) {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.agentForwarder.RollbackSwarmService(instanceID, &pb.RollbackSwarmServiceRequest{ServiceId: serviceID})
+	s.logServiceUpdate(c, instance, models.DockerActionRollbackSwarmService, serviceID, nil, resp, err, startTime)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back swarm service: %w", err)
+	}
+	return resp, nil
+}
+
+// logServiceUpdate records the audit log of a service update
+func (s *SwarmService) logServiceUpdate(c *gin.Context, instance *models.DockerInstance, action, serviceID string, extra map[string]interface{}, resp *pb.UpdateSwarmServiceResponse, err error, startTime time.Time) {
+	if extra == nil {
+		extra = map[string]interface{}{}
+	}
+	extra["service_id"] = serviceID
+	if len(resp.GetWarnings()) > 0 {
+		extra["warnings"] = resp.GetWarnings()
+	}
+
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       action,
+		ResourceType: models.DockerResourceTypeSwarmService,
+		ResourceID:   instance.ID,
+		ResourceName: serviceID,
+		InstanceID:   instance.ID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData:    extra,
+		Error:        err,
+		Duration:     time.Since(startTime).Milliseconds(),
+	})
+}
+
+// DeleteService removes a swarm service
+func (s *SwarmService) DeleteService(c *gin.Context, instanceID uuid.UUID, serviceID string) error {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.agentForwarder.DeleteSwarmService(instanceID, &pb.DeleteSwarmServiceRequest{ServiceId: serviceID})
+	s.logServiceUpdate(c, instance, models.DockerActionDeleteSwarmService, serviceID, nil, nil, err, startTime)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete swarm service: %w", err)
+	}
+	return nil
+}
+
+// ListTasks lists the swarm tasks matching the filters; empty filters match all
+func (s *SwarmService) ListTasks(ctx context.Context, instanceID uuid.UUID, req *pb.ListSwarmTasksRequest) ([]*pb.SwarmTask, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.ListSwarmTasks(instanceID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list swarm tasks: %w", err)
+	}
+	return resp.Tasks, nil
+}
+
+// ListSecrets lists the swarm secrets. Their data is never returned by the engine.
+func (s *SwarmService) ListSecrets(ctx context.Context, instanceID uuid.UUID) ([]*pb.SwarmSecret, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.ListSwarmSecrets(instanceID, &pb.ListSwarmSecretsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list swarm secrets: %w", err)
+	}
+	return resp.Secrets, nil
+}
+
+// CreateSecret creates a swarm secret and returns its ID
+func (s *SwarmService) CreateSecret(c *gin.Context, instanceID uuid.UUID, req *pb.CreateSwarmSecretRequest) (string, error) {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.agentForwarder.CreateSwarmSecret(instanceID, req)
+
+	// The secret data is not part of the audit log
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionCreateSwarmSecret,
+		ResourceType: models.DockerResourceTypeSwarmSecret,
+		ResourceID:   instanceID,
+		ResourceName: req.Name,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData: map[string]interface{}{
+			"secret_id": resp.GetId(),
+			"labels":    req.Labels,
+		},
+		Error:    err,
+		Duration: time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to create swarm secret: %w", err)
+	}
+	return resp.Id, nil
+}
+
+// DeleteSecret removes a swarm secret
+func (s *SwarmService) DeleteSecret(c *gin.Context, instanceID uuid.UUID, secretID string) error {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.agentForwarder.DeleteSwarmSecret(instanceID, &pb.DeleteSwarmSecretRequest{Id: secretID})
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionDeleteSwarmSecret,
+		ResourceType: models.DockerResourceTypeSwarmSecret,
+		ResourceID:   instanceID,
+		ResourceName: secretID,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		Error:        err,
+		Duration:     time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete swarm secret: %w", err)
+	}
+	return nil
+}
+
+// ListConfigs lists the swarm configs with their data
+func (s *SwarmService) ListConfigs(ctx context.Context, instanceID uuid.UUID) ([]*pb.SwarmConfig, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.ListSwarmConfigs(instanceID, &pb.ListSwarmConfigsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list swarm configs: %w", err)
+	}
+	return resp.Configs, nil
+}
+
+// CreateConfig creates a swarm config and returns its ID
+func (s *SwarmService) CreateConfig(c *gin.Context, instanceID uuid.UUID, req *pb.CreateSwarmConfigRequest) (string, error) {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.agentForwarder.CreateSwarmConfig(instanceID, req)
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionCreateSwarmConfig,
+		ResourceType: models.DockerResourceTypeSwarmConfig,
+		ResourceID:   instanceID,
+		ResourceName: req.Name,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData: map[string]interface{}{
+			"config_id": resp.GetId(),
+			"labels":    req.Labels,
+			"size":      len(req.Data),
+		},
+		Error:    err,
+		Duration: time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to create swarm config: %w", err)
+	}
+	return resp.Id, nil
+}
+
+// DeleteConfig removes a swarm config
+func (s *SwarmService) DeleteConfig(c *gin.Context, instanceID uuid.UUID, configID string) error {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.agentForwarder.DeleteSwarmConfig(instanceID, &pb.DeleteSwarmConfigRequest{Id: configID})
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionDeleteSwarmConfig,
+		ResourceType: models.DockerResourceTypeSwarmConfig,
+		ResourceID:   instanceID,
+		ResourceName: configID,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		Error:        err,
+		Duration:     time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete swarm config: %w", err)
+	}
+	return nil
+}
+
+// ListStacks lists the stacks deployed on the swarm
+func (s *SwarmService) ListStacks(ctx context.Context, instanceID uuid.UUID) ([]*pb.Stack, error) {
+	if _, err := s.getManagerInstance(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	resp, err := s.agentForwarder.ListStacks(instanceID, &pb.ListStacksRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stacks: %w", err)
+	}
+	return resp.Stacks, nil
+}
+
+// composeImages returns the images of the services in a compose file
+func composeImages(compose string) ([]string, error) {
+	var file struct {
+		Services map[string]struct {
+			Image string `yaml:"image"`
+		} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal([]byte(compose), &file); err != nil {
+		return nil, fmt.Errorf("%w: invalid compose file: %v", ErrInvalidSwarmRequest, err)
+	}
+	if len(file.Services) == 0 {
+		return nil, fmt.Errorf("%w: compose file has no services", ErrInvalidSwarmRequest)
+	}
+
+	seen := make(map[string]bool)
+	var images []string
+	for _, service := range file.Services {
+		if service.Image != "" && !seen[service.Image] {
+			seen[service.Image] = true
+			images = append(images, service.Image)
+		}
+	}
+	sort.Strings(images)
+	return images, nil
+}
+
+// DeployStack creates or updates a stack from a compose file. The agent
+// resolves the services' images, so the stored registry credentials of
+// each image are passed along.
+func (s *SwarmService) DeployStack(c *gin.Context, instanceID uuid.UUID, name, compose string, prune bool) (*pb.DeployStackResponse, error) {
+	startTime := time.Now()
+
+	images, err := composeImages(compose)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryAuth := make(map[string]string)
+	for _, image := range images {
+		auth, err := s.credentialService.ResolveAuth(c.Request.Context(), instance, image)
+		if err != nil {
+			return nil, err
+		}
+		if auth != "" {
+			registryAuth[image] = auth
+		}
+	}
+
+	resp, err := s.agentForwarder.DeployStack(instanceID, &pb.DeployStackRequest{
+		Name:         name,
+		Compose:      compose,
+		Prune:        prune,
+		RegistryAuth: registryAuth,
+	})
+
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionDeployStack,
+		ResourceType: models.DockerResourceTypeStack,
+		ResourceID:   instanceID,
+		ResourceName: name,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData: map[string]interface{}{
+			"prune":    prune,
+			"created":  resp.GetCreated(),
+			"updated":  resp.GetUpdated(),
+			"removed":  resp.GetRemoved(),
+			"warnings": resp.GetWarnings(),
+		},
+		Error:    err,
+		Duration: time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return resp, fmt.Errorf("failed to deploy stack: %w", err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"stack":       name,
+		"instance_id": instanceID,
+		"created":     len(resp.Created),
+		"updated":     len(resp.Updated),
+		"removed":     len(resp.Removed),
+	}).Info("Stack deployed successfully")
+
+	return resp, nil
+}
+
+// RemoveStack removes the services, secrets, configs and networks of a stack
+func (s *SwarmService) RemoveStack(c *gin.Context, instanceID uuid.UUID, name string) (*pb.RemoveStackResponse, error) {
+	startTime := time.Now()
+
+	instance, err := s.getManagerInstance(c.Request.Context(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.agentForwarder.RemoveStack(instanceID, &pb.RemoveStackRequest{Name: name})
+	s.auditHelper.LogDockerOperation(c, AuditParams{
+		Action:       models.DockerActionRemoveStack,
+		ResourceType: models.DockerResourceTypeStack,
+		ResourceID:   instanceID,
+		ResourceName: name,
+		InstanceID:   instanceID,
+		InstanceName: instance.Name,
+		AgentID:      &instance.AgentID,
+		ExtraData: map[string]interface{}{
+			"removed":  resp.GetRemoved(),
+			"warnings": resp.GetWarnings(),
+		},
+		Error:    err,
+		Duration: time.Since(startTime).Milliseconds(),
+	})
+
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove stack: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+package docker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+
+	pb "github.com/ysicing/tiga/pkg/grpc/proto/docker"
+)
+
+func TestComposeImages(t *testing.T) {
+	images, err := composeImages(`
+services:
+  web:
+    image: nginx:1.27
+  api:
+    image: registry.example.com/team/api:2
+  worker:
+    image: registry.example.com/team/api:2
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nginx:1.27", "registry.example.com/team/api:2"}, images)
+
+	_, err = composeImages("version: '3'")
+	assert.True(t, errors.Is(err, ErrInvalidSwarmRequest))
+
+	_, err = composeImages("services: [")
+	assert.True(t, errors.Is(err, ErrInvalidSwarmRequest))
+}
+
+func TestSwarmInfoUpdates(t *testing.T) {
+	updates := swarmInfoUpdates(&pb.SwarmInfo{
+		NodeId:           "node1",
+		LocalNodeState:   "active",
+		ControlAvailable: true,
+		ClusterId:        "cluster1",
+	})
+	instance := &models.DockerInstance{
+		SwarmNodeState:        updates["swarm_node_state"].(string),
+		SwarmControlAvailable: updates["swarm_control_available"].(bool),
+	}
+	assert.True(t, instance.IsSwarmManager())
+	assert.Equal(t, "node1", updates["swarm_node_id"])
+	assert.Equal(t, "cluster1", updates["swarm_cluster_id"])
+
+	// Daemons that report no swarm are not part of one
+	updates = swarmInfoUpdates(nil)
+	assert.Equal(t, "inactive", updates["swarm_node_state"])
+	assert.Equal(t, false, updates["swarm_control_available"])
+}
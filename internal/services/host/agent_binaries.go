@@ -0,0 +1,339 @@
+package host
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Agent binary errors
+var (
+	ErrAgentBinaryNotFound = errors.New("agent binary not found")
+	ErrInvalidAgentBinary  = errors.New("invalid agent binary")
+)
+
+// MaxAgentBinarySize limits uploaded and proxied agent binaries
+const MaxAgentBinarySize = 256 << 20
+
+var binaryPartPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+-]*$`)
+
+// AgentBinary describes an agent build for one platform. Signature is the
+// base64 Ed25519 signature of "<version>\n<os>/<arch>\n<sha256 hex>",
+// empty when unsigned.
+type AgentBinary struct {
+	Version   string    `json:"version"`
+	OS        string    `json:"os"`
+	Arch      string    `json:"arch"`
+	Size      int64     `json:"size"`
+	SHA256    string    `json:"sha256"`
+	Signature string    `json:"signature,omitempty"`
+	ModTime   time.Time `json:"mod_time"`
+
+	path string
+}
+
+type binaryDigest struct {
+	size    int64
+	modTime time.Time
+	sum     string
+}
+
+// AgentBinaryStore serves agent binaries for self-update from a local
+// directory laid out as <dir>/<version>/tiga-agent-<os>-<arch>[.exe] with an
+// optional .sig file next to each binary. Versions missing locally are
+// fetched from releaseURL when set; {version} and {name} in the URL are
+// replaced by the version and binary file name.
+type AgentBinaryStore struct {
+	dir        string
+	releaseURL string
+	client     *http.Client
+
+	mu      sync.Mutex
+	digests map[string]binaryDigest
+}
+
+// NewAgentBinaryStore creates a new AgentBinaryStore
+func NewAgentBinaryStore(dir, releaseURL string) *AgentBinaryStore {
+	return &AgentBinaryStore{
+		dir:        dir,
+		releaseURL: releaseURL,
+		client:     &http.Client{Timeout: 10 * time.Minute},
+		digests:    make(map[string]binaryDigest),
+	}
+}
+
+// AgentBinaryName returns the file name of the agent build for a platform,
+// matching the names produced by the agent-cross build task
+func AgentBinaryName(goos, goarch string) string {
+	name := fmt.Sprintf("tiga-agent-%s-%s", goos, goarch)
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+func validateBinaryParts(parts ...string) error {
+	for _, part := range parts {
+		if !binaryPartPattern.MatchString(part) || strings.Contains(part, "..") {
+			return fmt.Errorf("%w: bad version or platform %q", ErrInvalidAgentBinary, part)
+		}
+	}
+	return nil
+}
+
+func (s *AgentBinaryStore) binaryPath(version, goos, goarch string) string {
+	return filepath.Join(s.dir, version, AgentBinaryName(goos, goarch))
+}
+
+// Get returns the binary of a version for a platform, fetching it from the
+// release URL when it is not available locally
+func (s *AgentBinaryStore) Get(ctx context.Context, version, goos, goarch string) (*AgentBinary, error) {
+	if err := validateBinaryParts(version, goos, goarch); err != nil {
+		return nil, err
+	}
+	path := s.binaryPath(version, goos, goarch)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		if s.releaseURL == "" {
+			return nil, ErrAgentBinaryNotFound
+		}
+		if err := s.fetch(ctx, version, goos, goarch); err != nil {
+			return nil, err
+		}
+	}
+	return s.describe(version, goos, goarch)
+}
+
+// HasVersion reports whether binaries of a version can be served
+func (s *AgentBinaryStore) HasVersion(version string) bool {
+	if validateBinaryParts(version) != nil {
+		return false
+	}
+	if s.releaseURL != "" {
+		return true
+	}
+	entries, err := os.ReadDir(filepath.Join(s.dir, version))
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "tiga-agent-") && !strings.HasSuffix(entry.Name(), ".sig") {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the locally available binaries sorted by version and platform
+func (s *AgentBinaryStore) List() ([]AgentBinary, error) {
+	versions, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []AgentBinary{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agent binary directory: %w", err)
+	}
+
+	binaries := []AgentBinary{}
+	for _, v := range versions {
+		if !v.IsDir() {
+			continue
+		}
+		files, err := os.ReadDir(filepath.Join(s.dir, v.Name()))
+		if err != nil {
+			continue
+		}
+		for _, f := range files {
+			goos, goarch, ok := parseBinaryName(f.Name())
+			if !ok {
+				continue
+			}
+			binary, err := s.describe(v.Name(), goos, goarch)
+			if err != nil {
+				continue
+			}
+			binaries = append(binaries, *binary)
+		}
+	}
+
+	sort.SliceStable(binaries, func(i, j int) bool {
+		if binaries[i].Version != binaries[j].Version {
+			return binaries[i].Version > binaries[j].Version
+		}
+		return binaries[i].OS+binaries[i].Arch < binaries[j].OS+binaries[j].Arch
+	})
+	return binaries, nil
+}
+
+// Save stores an uploaded binary and its optional signature
+func (s *AgentBinaryStore) Save(version, goos, goarch string, r io.Reader, signature string) (*AgentBinary, error) {
+	if err := validateBinaryParts(version, goos, goarch); err != nil {
+		return nil, err
+	}
+	signature = strings.TrimSpace(signature)
+	if signature != "" {
+		if sig, err := base64.StdEncoding.DecodeString(signature); err != nil || len(sig) != ed25519.SignatureSize {
+			return nil, fmt.Errorf("%w: signature must be a base64 Ed25519 signature", ErrInvalidAgentBinary)
+		}
+	}
+	if err := s.write(version, goos, goarch, r, signature); err != nil {
+		return nil, err
+	}
+	return s.describe(version, goos, goarch)
+}
+
+// Open opens a binary for streaming
+func (s *AgentBinaryStore) Open(binary *AgentBinary) (*os.File, error) {
+	return os.Open(binary.path)
+}
+
+func (s *AgentBinaryStore) write(version, goos, goarch string, r io.Reader, signature string) error {
+	path := s.binaryPath(version, goos, goarch)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create agent binary directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to store agent binary: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, io.LimitReader(r, MaxAgentBinarySize+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store agent binary: %w", err)
+	}
+	if n == 0 || n > MaxAgentBinarySize {
+		return fmt.Errorf("%w: size must be between 1 byte and %d MB", ErrInvalidAgentBinary, MaxAgentBinarySize>>20)
+	}
+
+	sigPath := path + ".sig"
+	if signature != "" {
+		if err := os.WriteFile(sigPath, []byte(signature+"\n"), 0o644); err != nil {
+			return fmt.Errorf("failed to store agent binary signature: %w", err)
+		}
+	} else if err := os.Remove(sigPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove stale signature: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store agent binary: %w", err)
+	}
+	return nil
+}
+
+// fetch downloads a binary and its signature from the release URL
+func (s *AgentBinaryStore) fetch(ctx context.Context, version, goos, goarch string) error {
+	url := strings.NewReplacer("{version}", version, "{name}", AgentBinaryName(goos, goarch)).Replace(s.releaseURL)
+
+	body, status, err := s.download(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if status == http.StatusNotFound {
+		return ErrAgentBinaryNotFound
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("failed to fetch agent binary from %s: HTTP %d", url, status)
+	}
+
+	signature := ""
+	sigBody, sigStatus, err := s.download(ctx, url+".sig")
+	if err == nil {
+		if sigStatus == http.StatusOK {
+			data, _ := io.ReadAll(io.LimitReader(sigBody, 1024))
+			signature = strings.TrimSpace(string(data))
+		}
+		sigBody.Close()
+	}
+
+	_, err = s.Save(version, goos, goarch, body, signature)
+	return err
+}
+
+func (s *AgentBinaryStore) download(ctx context.Context, url string) (io.ReadCloser, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid agent release URL: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	return resp.Body, resp.StatusCode, nil
+}
+
+// describe stats a local binary and computes its digest, caching the digest
+// until the file changes
+func (s *AgentBinaryStore) describe(version, goos, goarch string) (*AgentBinary, error) {
+	path := s.binaryPath(version, goos, goarch)
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrAgentBinaryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	cached, ok := s.digests[path]
+	s.mu.Unlock()
+	if !ok || cached.size != info.Size() || !cached.modTime.Equal(info.ModTime()) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		cached = binaryDigest{size: info.Size(), modTime: info.ModTime(), sum: hex.EncodeToString(h.Sum(nil))}
+		s.mu.Lock()
+		s.digests[path] = cached
+		s.mu.Unlock()
+	}
+
+	signature := ""
+	if data, err := os.ReadFile(path + ".sig"); err == nil {
+		signature = strings.TrimSpace(string(data))
+	}
+
+	return &AgentBinary{
+		Version:   version,
+		OS:        goos,
+		Arch:      goarch,
+		Size:      info.Size(),
+		SHA256:    cached.sum,
+		Signature: signature,
+		ModTime:   info.ModTime(),
+		path:      path,
+	}, nil
+}
+
+func parseBinaryName(name string) (goos, goarch string, ok bool) {
+	if !strings.HasPrefix(name, "tiga-agent-") || strings.HasSuffix(name, ".sig") {
+		return "", "", false
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(name, "tiga-agent-"), ".exe"), "-")
+	if len(parts) != 2 || AgentBinaryName(parts[0], parts[1]) != name {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
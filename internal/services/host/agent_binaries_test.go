@@ -0,0 +1,115 @@
+package host
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentBinaryStore_SaveAndList(t *testing.T) {
+	store := NewAgentBinaryStore(t.TempDir(), "")
+	ctx := context.Background()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	content := "agent binary v1.2.0"
+	sum := sha256.Sum256([]byte(content))
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, sum[:]))
+
+	assert.False(t, store.HasVersion("v1.2.0"))
+	binary, err := store.Save("v1.2.0", "linux", "amd64", strings.NewReader(content), signature)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), binary.Size)
+	assert.Equal(t, hex.EncodeToString(sum[:]), binary.SHA256)
+	assert.Equal(t, signature, binary.Signature)
+	assert.True(t, store.HasVersion("v1.2.0"))
+
+	_, err = store.Save("v1.1.0", "windows", "amd64", strings.NewReader("old"), "")
+	require.NoError(t, err)
+
+	got, err := store.Get(ctx, "v1.2.0", "linux", "amd64")
+	require.NoError(t, err)
+	f, err := store.Open(got)
+	require.NoError(t, err)
+	data, err := io.ReadAll(f)
+	f.Close()
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+
+	_, err = store.Get(ctx, "v1.2.0", "darwin", "arm64")
+	assert.ErrorIs(t, err, ErrAgentBinaryNotFound)
+
+	binaries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, binaries, 2)
+	assert.Equal(t, "v1.2.0", binaries[0].Version)
+	assert.Equal(t, "windows", binaries[1].OS)
+	assert.Empty(t, binaries[1].Signature)
+
+	// Re-uploading without a signature drops the stale one
+	binary, err = store.Save("v1.2.0", "linux", "amd64", strings.NewReader("rebuilt"), "")
+	require.NoError(t, err)
+	assert.Empty(t, binary.Signature)
+}
+
+func TestAgentBinaryStore_Validation(t *testing.T) {
+	store := NewAgentBinaryStore(t.TempDir(), "")
+
+	for _, parts := range [][3]string{
+		{"../etc", "linux", "amd64"},
+		{"v1", "linux/../..", "amd64"},
+		{"", "linux", "amd64"},
+		{"v1", "linux", ".."},
+	} {
+		_, err := store.Save(parts[0], parts[1], parts[2], strings.NewReader("x"), "")
+		assert.ErrorIs(t, err, ErrInvalidAgentBinary, parts)
+	}
+
+	_, err := store.Save("v1", "linux", "amd64", strings.NewReader("x"), "not-a-signature")
+	assert.ErrorIs(t, err, ErrInvalidAgentBinary)
+	_, err = store.Save("v1", "linux", "amd64", strings.NewReader(""), "")
+	assert.ErrorIs(t, err, ErrInvalidAgentBinary)
+	assert.False(t, store.HasVersion("v1"))
+}
+
+func TestAgentBinaryStore_ReleaseURL(t *testing.T) {
+	var requested []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/releases/v2.0.0/tiga-agent-linux-arm64":
+			io.WriteString(w, "released agent")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	store := NewAgentBinaryStore(t.TempDir(), srv.URL+"/releases/{version}/{name}")
+	ctx := context.Background()
+	assert.True(t, store.HasVersion("v2.0.0"))
+
+	binary, err := store.Get(ctx, "v2.0.0", "linux", "arm64")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("released agent")), binary.Size)
+	assert.Empty(t, binary.Signature)
+
+	// Cached after the first fetch
+	_, err = store.Get(ctx, "v2.0.0", "linux", "arm64")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/releases/v2.0.0/tiga-agent-linux-arm64", "/releases/v2.0.0/tiga-agent-linux-arm64.sig"}, requested)
+
+	_, err = store.Get(ctx, "v2.0.0", "windows", "amd64")
+	assert.ErrorIs(t, err, ErrAgentBinaryNotFound)
+}
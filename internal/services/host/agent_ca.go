@@ -0,0 +1,314 @@
+package host
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// Agent certificate errors
+var (
+	ErrInvalidCSR              = errors.New("invalid certificate signing request")
+	ErrAgentCertificateUnknown = errors.New("agent certificate was not issued by this server")
+	ErrAgentCertificateRevoked = errors.New("agent certificate has been revoked")
+)
+
+const (
+	agentCACommonName        = "Tiga Agent CA"
+	agentCAValidity          = 10 * 365 * 24 * time.Hour
+	agentServerCertValidity  = 365 * 24 * time.Hour
+	defaultAgentCertValidity = 90 * 24 * time.Hour
+	certBackdate             = 5 * time.Minute // Tolerate clock skew between server and agents
+)
+
+// AgentCA is the built-in certificate authority of the agent gRPC channel.
+// It signs per-host client certificates at enrolment and, unless an
+// external certificate is configured, the gRPC server certificate. The CA
+// key is stored encrypted in the database so every server instance shares
+// it.
+type AgentCA struct {
+	db       *gorm.DB
+	validity time.Duration
+	cert     *x509.Certificate
+	certPEM  []byte
+	key      crypto.Signer
+}
+
+// LoadAgentCA loads the CA from the database, creating it on first use.
+// validity is the lifetime of issued agent certificates (90 days when zero).
+func LoadAgentCA(ctx context.Context, db *gorm.DB, validity time.Duration) (*AgentCA, error) {
+	if validity <= 0 {
+		validity = defaultAgentCertValidity
+	}
+
+	var record models.AgentCertificateAuthority
+	err := db.WithContext(ctx).Order("created_at ASC").First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if err := createAgentCA(ctx, db); err != nil {
+			return nil, err
+		}
+		// Re-read so concurrent first starts converge on the oldest CA
+		err = db.WithContext(ctx).Order("created_at ASC").First(&record).Error
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load agent CA: %w", err)
+	}
+
+	certBlock, _ := pem.Decode([]byte(record.CertificatePEM))
+	if certBlock == nil {
+		return nil, fmt.Errorf("agent CA certificate is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse agent CA certificate: %w", err)
+	}
+	keyBlock, _ := pem.Decode([]byte(record.PrivateKeyPEM))
+	if keyBlock == nil {
+		return nil, fmt.Errorf("agent CA key is not PEM encoded")
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse agent CA key: %w", err)
+	}
+
+	return &AgentCA{
+		db:       db,
+		validity: validity,
+		cert:     cert,
+		certPEM:  []byte(record.CertificatePEM),
+		key:      key,
+	}, nil
+}
+
+func createAgentCA(ctx context.Context, db *gorm.DB) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate agent CA key: %w", err)
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: agentCACommonName, Organization: []string{"Tiga"}},
+		NotBefore:             now.Add(-certBackdate),
+		NotAfter:              now.Add(agentCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return fmt.Errorf("failed to create agent CA certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to encode agent CA key: %w", err)
+	}
+
+	record := &models.AgentCertificateAuthority{
+		CertificatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKeyPEM:  models.SecretString(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		NotAfter:       template.NotAfter,
+	}
+	if err := db.WithContext(ctx).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to save agent CA: %w", err)
+	}
+	return nil
+}
+
+// CertificatePEM returns the PEM encoded CA certificate
+func (c *AgentCA) CertificatePEM() []byte {
+	return c.certPEM
+}
+
+// CertPool returns a pool containing only the CA certificate
+func (c *AgentCA) CertPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(c.cert)
+	return pool
+}
+
+// Pin returns the SHA-256 pin of the CA public key. Agents started with
+// --server-pin accept any server certificate signed by this CA.
+func (c *AgentCA) Pin() string {
+	return SPKIPin(c.cert)
+}
+
+// SPKIPin formats the SHA-256 hash of a certificate's public key as
+// "sha256:<hex>"
+func SPKIPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// IssueServerCertificate signs a certificate for the agent gRPC server.
+// hosts may contain DNS names and IP addresses. The returned chain includes
+// the CA certificate so agents can pin it.
+func (c *AgentCA) IssueServerCertificate(hosts []string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to generate server key: %w", err)
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "tiga-grpc"},
+		NotBefore:    now.Add(-certBackdate),
+		NotAfter:     now.Add(agentServerCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, h := range hosts {
+		if h == "" {
+			continue
+		}
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+	if len(template.DNSNames) > 0 {
+		template.Subject.CommonName = template.DNSNames[0]
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, &key.PublicKey, c.key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to sign server certificate: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der, c.cert.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+// IssueAgentCertificate signs a client certificate for a host from a PEM
+// encoded CSR. The certificate common name is the host ID whatever the CSR
+// asks for. Earlier certificates of the host are revoked as superseded.
+func (c *AgentCA) IssueAgentCertificate(ctx context.Context, hostID uuid.UUID, csrPEM []byte) ([]byte, *models.AgentCertificate, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, nil, fmt.Errorf("%w: expected a PEM encoded CERTIFICATE REQUEST", ErrInvalidCSR)
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCSR, err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCSR, err)
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: hostID.String(), Organization: []string{"Tiga Agent"}},
+		NotBefore:    now.Add(-certBackdate),
+		NotAfter:     now.Add(c.validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, csr.PublicKey, c.key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign agent certificate: %w", err)
+	}
+
+	fingerprint := sha256.Sum256(der)
+	record := &models.AgentCertificate{
+		HostNodeID:   hostID,
+		SerialNumber: serial.Text(16),
+		Fingerprint:  hex.EncodeToString(fingerprint[:]),
+		NotBefore:    template.NotBefore,
+		NotAfter:     template.NotAfter,
+	}
+	err = c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := revokeAgentCertificates(tx, hostID, models.CertRevokeSuperseded); err != nil {
+			return err
+		}
+		return tx.Create(record).Error
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record agent certificate: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), record, nil
+}
+
+// VerifyAgentCertificate checks a client certificate that already passed
+// chain validation against the issuance records and returns the host it
+// belongs to. Revoked certificates and certificates whose host no longer
+// matches the record are rejected.
+func (c *AgentCA) VerifyAgentCertificate(ctx context.Context, cert *x509.Certificate) (uuid.UUID, error) {
+	var record models.AgentCertificate
+	err := c.db.WithContext(ctx).Where("serial_number = ?", cert.SerialNumber.Text(16)).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, ErrAgentCertificateUnknown
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to look up agent certificate: %w", err)
+	}
+	if record.IsRevoked() {
+		return uuid.Nil, ErrAgentCertificateRevoked
+	}
+	if cert.Subject.CommonName != record.HostNodeID.String() {
+		return uuid.Nil, ErrAgentCertificateUnknown
+	}
+	if time.Now().After(record.NotAfter) {
+		return uuid.Nil, fmt.Errorf("agent certificate expired on %s", record.NotAfter.Format(time.RFC3339))
+	}
+	return record.HostNodeID, nil
+}
+
+// revokeAgentCertificates revokes every active certificate of a host and
+// returns how many were revoked
+func revokeAgentCertificates(db *gorm.DB, hostID uuid.UUID, reason string) (int64, error) {
+	now := time.Now()
+	result := db.Model(&models.AgentCertificate{}).
+		Where("host_node_id = ? AND revoked_at IS NULL", hostID).
+		Updates(map[string]interface{}{"revoked_at": now, "revoke_reason": reason})
+	return result.RowsAffected, result.Error
+}
+
+func randomSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	return serial, nil
+}
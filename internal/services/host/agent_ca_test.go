@@ -0,0 +1,213 @@
+package host
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/proto"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func newAgentCATestDB(t *testing.T) *gorm.DB {
+	db := testdb.Open(t, &models.AgentCertificateAuthority{}, &models.AgentCertificate{})
+	return db
+}
+
+// newAgentCSR returns a PEM CSR and the matching key
+func newAgentCSR(t *testing.T, commonName string) ([]byte, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), key
+}
+
+func parsePEMCertificate(t *testing.T, data []byte) *x509.Certificate {
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestAgentCA_Lifecycle(t *testing.T) {
+	db := newAgentCATestDB(t)
+	ctx := context.Background()
+
+	ca, err := LoadAgentCA(ctx, db, 24*time.Hour)
+	require.NoError(t, err)
+
+	// The CA is persisted and reused
+	again, err := LoadAgentCA(ctx, db, 0)
+	require.NoError(t, err)
+	assert.Equal(t, ca.CertificatePEM(), again.CertificatePEM())
+	assert.Equal(t, ca.Pin(), again.Pin())
+	assert.Regexp(t, `^sha256:[0-9a-f]{64}$`, ca.Pin())
+
+	hostID := uuid.New()
+	csr, _ := newAgentCSR(t, "spoofed-name")
+	certPEM, record, err := ca.IssueAgentCertificate(ctx, hostID, csr)
+	require.NoError(t, err)
+	cert := parsePEMCertificate(t, certPEM)
+	assert.Equal(t, hostID.String(), cert.Subject.CommonName, "CN is the host ID whatever the CSR asks")
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), record.NotAfter, time.Minute)
+
+	_, err = cert.Verify(x509.VerifyOptions{Roots: ca.CertPool(), KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	require.NoError(t, err)
+
+	got, err := ca.VerifyAgentCertificate(ctx, cert)
+	require.NoError(t, err)
+	assert.Equal(t, hostID, got)
+
+	// Re-enrolment supersedes the previous certificate
+	renewedPEM, _, err := ca.IssueAgentCertificate(ctx, hostID, csr)
+	require.NoError(t, err)
+	_, err = ca.VerifyAgentCertificate(ctx, cert)
+	assert.ErrorIs(t, err, ErrAgentCertificateRevoked)
+	renewed := parsePEMCertificate(t, renewedPEM)
+	_, err = ca.VerifyAgentCertificate(ctx, renewed)
+	require.NoError(t, err)
+
+	revoked, err := revokeAgentCertificates(db, hostID, models.CertRevokeKeyRotated)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), revoked)
+	_, err = ca.VerifyAgentCertificate(ctx, renewed)
+	assert.ErrorIs(t, err, ErrAgentCertificateRevoked)
+
+	var records []models.AgentCertificate
+	require.NoError(t, db.Order("created_at").Find(&records).Error)
+	require.Len(t, records, 2)
+	assert.Equal(t, models.CertRevokeSuperseded, records[0].RevokeReason)
+	assert.Equal(t, models.CertRevokeKeyRotated, records[1].RevokeReason)
+
+	// Certificates signed by another CA are unknown
+	otherDB := newAgentCATestDB(t)
+	other, err := LoadAgentCA(ctx, otherDB, 0)
+	require.NoError(t, err)
+	foreignPEM, _, err := other.IssueAgentCertificate(ctx, hostID, csr)
+	require.NoError(t, err)
+	_, err = ca.VerifyAgentCertificate(ctx, parsePEMCertificate(t, foreignPEM))
+	assert.ErrorIs(t, err, ErrAgentCertificateUnknown)
+
+	_, _, err = ca.IssueAgentCertificate(ctx, hostID, []byte("not a csr"))
+	assert.ErrorIs(t, err, ErrInvalidCSR)
+}
+
+func TestAgentCA_ServerCertificate(t *testing.T) {
+	ca, err := LoadAgentCA(context.Background(), newAgentCATestDB(t), 0)
+	require.NoError(t, err)
+
+	cert, err := ca.IssueServerCertificate([]string{"grpc.example.com", "10.0.0.5", ""})
+	require.NoError(t, err)
+	require.Len(t, cert.Certificate, 2, "chain carries the CA for pinning")
+	assert.Equal(t, []string{"grpc.example.com"}, cert.Leaf.DNSNames)
+	require.Len(t, cert.Leaf.IPAddresses, 1)
+	assert.Equal(t, "10.0.0.5", cert.Leaf.IPAddresses[0].String())
+
+	_, err = cert.Leaf.Verify(x509.VerifyOptions{DNSName: "grpc.example.com", Roots: ca.CertPool()})
+	assert.NoError(t, err)
+}
+
+type stubHostMonitorServer struct {
+	proto.UnimplementedHostMonitorServer
+}
+
+func (stubHostMonitorServer) Heartbeat(context.Context, *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error) {
+	return &proto.HeartbeatResponse{Success: true}, nil
+}
+
+func (stubHostMonitorServer) EnrollAgent(context.Context, *proto.EnrollAgentRequest) (*proto.EnrollAgentResponse, error) {
+	return &proto.EnrollAgentResponse{Success: true}, nil
+}
+
+func TestAgentAuthInterceptor(t *testing.T) {
+	db := newAgentCATestDB(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ca, err := LoadAgentCA(ctx, db, 0)
+	require.NoError(t, err)
+	serverTLS, err := ca.ServerTLSConfig("", "", []string{"localhost"})
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	opts := append([]grpc.ServerOption{grpc.Creds(credentials.NewTLS(serverTLS))},
+		NewAgentAuthInterceptor(ca, true).ServerOptions()...)
+	server := grpc.NewServer(opts...)
+	proto.RegisterHostMonitorServer(server, stubHostMonitorServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	dial := func(clientCert *tls.Certificate) proto.HostMonitorClient {
+		tlsConfig := &tls.Config{RootCAs: ca.CertPool(), ServerName: "localhost"}
+		if clientCert != nil {
+			tlsConfig.Certificates = []tls.Certificate{*clientCert}
+		}
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return proto.NewHostMonitorClient(conn)
+	}
+
+	hostID := uuid.New()
+
+	// Without a certificate only enrolment is allowed
+	anonymous := dial(nil)
+	_, err = anonymous.Heartbeat(ctx, &proto.HeartbeatRequest{Uuid: hostID.String()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = anonymous.EnrollAgent(ctx, &proto.EnrollAgentRequest{Uuid: hostID.String()})
+	require.NoError(t, err)
+
+	csr, key := newAgentCSR(t, hostID.String())
+	certPEM, _, err := ca.IssueAgentCertificate(ctx, hostID, csr)
+	require.NoError(t, err)
+	clientCert := &tls.Certificate{Certificate: [][]byte{parsePEMCertificate(t, certPEM).Raw}, PrivateKey: key}
+
+	agent := dial(clientCert)
+	_, err = agent.Heartbeat(ctx, &proto.HeartbeatRequest{Uuid: hostID.String()})
+	require.NoError(t, err)
+
+	// A certificate only speaks for its own host
+	_, err = agent.Heartbeat(ctx, &proto.HeartbeatRequest{Uuid: uuid.NewString()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Revocation applies to the next call on an open connection
+	_, err = revokeAgentCertificates(db, hostID, models.CertRevokeHostDeleted)
+	require.NoError(t, err)
+	_, err = agent.Heartbeat(ctx, &proto.HeartbeatRequest{Uuid: hostID.String()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The revoked agent can still re-enrol with its secret key
+	_, err = agent.EnrollAgent(ctx, &proto.EnrollAgentRequest{Uuid: hostID.String()})
+	require.NoError(t, err)
+
+	csr, key = newAgentCSR(t, hostID.String())
+	certPEM, _, err = ca.IssueAgentCertificate(ctx, hostID, csr)
+	require.NoError(t, err)
+	renewed := dial(&tls.Certificate{Certificate: [][]byte{parsePEMCertificate(t, certPEM).Raw}, PrivateKey: key})
+	_, err = renewed.Heartbeat(ctx, &proto.HeartbeatRequest{Uuid: hostID.String()})
+	assert.NoError(t, err)
+}
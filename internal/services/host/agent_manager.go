@@ -2,8 +2,11 @@ package host
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
@@ -47,10 +50,17 @@ type AgentManager struct {
 	auditLogger           *AuditLogger                  // T038: 统一审计
 	dockerInstanceService *docker.DockerInstanceService // T032: Docker实例集成
 
+	// Agent mTLS, nil when the gRPC server runs without TLS
+	ca        *AgentCA
+	serverPin string
+
 	// Active connections map: UUID -> Connection
 	connections sync.Map
 	mu          sync.RWMutex
 
+	// Handlers for task results no caller waits for: task ID prefix -> TaskResultHandler
+	resultHandlers sync.Map
+
 	// Heartbeat monitoring
 	heartbeatInterval time.Duration
 	heartbeatTimeout  time.Duration
@@ -76,26 +86,46 @@ func NewAgentManager(
 	}
 }
 
-// RegisterAgent handles agent registration
-func (m *AgentManager) RegisterAgent(ctx context.Context, req *proto.RegisterAgentRequest) (*proto.RegisterAgentResponse, error) {
-	// Validate UUID and secret key
-	host, err := m.hostRepo.GetByUUID(ctx, req.Uuid)
+// EnableAgentTLS turns on certificate enrolment. serverPin is handed to
+// new agents to verify the server and is empty when the server certificate
+// is not issued by ca.
+func (m *AgentManager) EnableAgentTLS(ca *AgentCA, serverPin string) {
+	m.ca = ca
+	m.serverPin = serverPin
+}
+
+// authenticateAgent looks up a host and checks the agent secret key. A nil
+// host without error means the credentials were rejected.
+func (m *AgentManager) authenticateAgent(ctx context.Context, hostUUID, secretKey string) (*models.HostNode, error) {
+	if _, err := uuid.Parse(hostUUID); err != nil {
+		return nil, nil
+	}
+	host, err := m.hostRepo.GetByUUID(ctx, hostUUID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &proto.RegisterAgentResponse{
-				Success: false,
-				Message: "Host not found or invalid credentials",
-			}, nil
+			return nil, nil
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get host: %v", err)
 	}
 
-	// TODO: Verify secret key (should be encrypted comparison)
-	// For now, simplified verification
-	if host.SecretKey == "" {
+	// TODO: Compare against the encrypted secret key once keys are encrypted
+	if host.SecretKey == "" || subtle.ConstantTimeCompare([]byte(host.SecretKey), []byte(secretKey)) != 1 {
+		return nil, nil
+	}
+	return host, nil
+}
+
+// RegisterAgent handles agent registration
+func (m *AgentManager) RegisterAgent(ctx context.Context, req *proto.RegisterAgentRequest) (*proto.RegisterAgentResponse, error) {
+	// Validate UUID and secret key
+	host, err := m.authenticateAgent(ctx, req.Uuid, req.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	if host == nil {
 		return &proto.RegisterAgentResponse{
 			Success: false,
-			Message: "Invalid credentials",
+			Message: "Host not found or invalid credentials",
 		}, nil
 	}
 
@@ -177,6 +207,71 @@ func (m *AgentManager) RegisterAgent(ctx context.Context, req *proto.RegisterAge
 	}, nil
 }
 
+// EnrollAgent issues a client certificate to an agent that presents its
+// secret key. Certificates issued earlier to the host are superseded.
+func (m *AgentManager) EnrollAgent(ctx context.Context, req *proto.EnrollAgentRequest) (*proto.EnrollAgentResponse, error) {
+	if m.ca == nil {
+		return nil, status.Error(codes.FailedPrecondition, "certificate enrolment is disabled, agent TLS is not enabled on the server")
+	}
+
+	host, err := m.authenticateAgent(ctx, req.Uuid, req.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	if host == nil {
+		return &proto.EnrollAgentResponse{
+			Success: false,
+			Message: "Host not found or invalid credentials",
+		}, nil
+	}
+
+	certPEM, record, err := m.ca.IssueAgentCertificate(ctx, host.ID, req.Csr)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCSR) {
+			return &proto.EnrollAgentResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to issue certificate: %v", err)
+	}
+
+	logrus.Infof("[AgentManager] Issued agent certificate: hostID=%s, serial=%s, expires=%s",
+		host.ID, record.SerialNumber, record.NotAfter.Format(time.RFC3339))
+
+	return &proto.EnrollAgentResponse{
+		Success:       true,
+		Message:       "Certificate issued",
+		Certificate:   certPEM,
+		CaCertificate: m.ca.CertificatePEM(),
+		ExpiresAt:     record.NotAfter.Unix(),
+	}, nil
+}
+
+// RevokeCertificates revokes every active client certificate of a host.
+// It works whether or not agent TLS is currently enabled.
+func (m *AgentManager) RevokeCertificates(ctx context.Context, hostID uuid.UUID, reason string) error {
+	revoked, err := revokeAgentCertificates(m.db.WithContext(ctx), hostID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to revoke agent certificates: %w", err)
+	}
+	if revoked > 0 {
+		logrus.Infof("[AgentManager] Revoked %d agent certificates: hostID=%s, reason=%s", revoked, hostID, reason)
+	}
+	return nil
+}
+
+// ServerPin returns the pin new agents use to verify the gRPC server, empty
+// when agent TLS is disabled or uses an external server certificate
+func (m *AgentManager) ServerPin() string {
+	return m.serverPin
+}
+
+// AgentTLSEnabled reports whether the gRPC server requires TLS
+func (m *AgentManager) AgentTLSEnabled() bool {
+	return m.ca != nil
+}
+
 // HandleReportState handles the bidirectional streaming of host states
 func (m *AgentManager) HandleReportState(stream proto.HostMonitor_ReportStateServer) error {
 	ctx := stream.Context()
@@ -238,6 +333,14 @@ func (m *AgentManager) HandleReportState(stream proto.HostMonitor_ReportStateSer
 				}
 			}
 
+			// Store states buffered by the agent while it was disconnected
+			var backfillErr error
+			if len(req.Backfill) > 0 {
+				if backfillErr = m.processBackfill(hostNodeID, req.Backfill); backfillErr != nil {
+					logrus.WithError(backfillErr).Warn("[AgentManager] Failed to store backfilled states")
+				}
+			}
+
 			// Process task results
 			if len(req.TaskResults) > 0 {
 				if err := m.processTaskResults(currentUUID, req.TaskResults); err != nil {
@@ -251,6 +354,11 @@ func (m *AgentManager) HandleReportState(stream proto.HostMonitor_ReportStateSer
 				Message: "State received",
 				Tasks:   m.getPendingTasks(currentUUID),
 			}
+			if backfillErr != nil {
+				// The agent keeps the buffered states and replays them later
+				resp.Success = false
+				resp.Message = "Failed to store backfilled states"
+			}
 			if err := stream.Send(resp); err != nil {
 				return err
 			}
@@ -270,6 +378,54 @@ func (m *AgentManager) processStateReport(hostNodeID uuid.UUID, state *proto.Hos
 		}).Debug("Agent version info received")
 	}
 
+	hostState := hostStateFromProto(hostNodeID, state)
+
+	// Save the state to database and broadcast to subscribers
+	if err := m.stateCollector.CollectState(context.Background(), hostNodeID, hostState); err != nil {
+		return err
+	}
+
+	// Accumulate traffic delta into host_node.traffic_used
+	if state.TrafficDeltaSent > 0 || state.TrafficDeltaRecv > 0 {
+		totalDelta := state.TrafficDeltaSent + state.TrafficDeltaRecv
+		if err := m.accumulateTraffic(hostNodeID, int64(totalDelta)); err != nil {
+			// Log error but don't fail the entire operation
+			fmt.Printf("Failed to accumulate traffic for host %d: %v\n", hostNodeID, err)
+		}
+	}
+
+	return nil
+}
+
+// processBackfill stores states replayed by an agent after an outage. Only
+// states not stored before count towards the traffic used.
+func (m *AgentManager) processBackfill(hostNodeID uuid.UUID, states []*proto.HostState) error {
+	hostStates := make([]*models.HostState, 0, len(states))
+	for _, state := range states {
+		hostStates = append(hostStates, hostStateFromProto(hostNodeID, state))
+	}
+
+	stored, err := m.stateCollector.CollectBackfill(context.Background(), hostNodeID, hostStates)
+	if err != nil {
+		return err
+	}
+
+	var totalDelta uint64
+	for _, state := range stored {
+		totalDelta += state.TrafficDeltaSent + state.TrafficDeltaRecv
+	}
+	if totalDelta > 0 {
+		if err := m.accumulateTraffic(hostNodeID, int64(totalDelta)); err != nil {
+			return err
+		}
+	}
+
+	logrus.Infof("[AgentManager] Backfilled %d of %d buffered states for host %s", len(stored), len(states), hostNodeID)
+	return nil
+}
+
+// hostStateFromProto converts a reported state to its model
+func hostStateFromProto(hostNodeID uuid.UUID, state *proto.HostState) *models.HostState {
 	hostState := &models.HostState{
 		HostNodeID:       hostNodeID,
 		Timestamp:        time.UnixMilli(state.Timestamp),
@@ -302,21 +458,57 @@ func (m *AgentManager) processStateReport(hostNodeID uuid.UUID, state *proto.Hos
 		// TODO: Marshal temperatures to JSON
 	}
 
-	// Save the state to database and broadcast to subscribers
-	if err := m.stateCollector.CollectState(context.Background(), hostNodeID, hostState); err != nil {
-		return err
+	for _, d := range state.Disks {
+		hostState.Disks = append(hostState.Disks, models.DiskPartitionState{
+			MountPoint:  d.MountPoint,
+			Device:      d.Device,
+			Fstype:      d.Fstype,
+			Total:       d.Total,
+			Used:        d.Used,
+			Usage:       d.Usage,
+			InodesTotal: d.InodesTotal,
+			InodesUsed:  d.InodesUsed,
+			InodesUsage: d.InodesUsage,
+		})
 	}
-
-	// Accumulate traffic delta into host_node.traffic_used
-	if state.TrafficDeltaSent > 0 || state.TrafficDeltaRecv > 0 {
-		totalDelta := state.TrafficDeltaSent + state.TrafficDeltaRecv
-		if err := m.accumulateTraffic(hostNodeID, int64(totalDelta)); err != nil {
-			// Log error but don't fail the entire operation
-			fmt.Printf("Failed to accumulate traffic for host %d: %v\n", hostNodeID, err)
+	for _, nic := range state.NetInterfaces {
+		hostState.NetInterfaces = append(hostState.NetInterfaces, models.NetInterfaceState{
+			Name:     nic.Name,
+			RxBytes:  nic.RxBytes,
+			TxBytes:  nic.TxBytes,
+			RxSpeed:  nic.RxSpeed,
+			TxSpeed:  nic.TxSpeed,
+			RxErrors: nic.RxErrors,
+			TxErrors: nic.TxErrors,
+		})
+	}
+	for _, m := range state.CustomMetrics {
+		labels := make(models.JSONB, len(m.Labels))
+		for k, v := range m.Labels {
+			labels[k] = v
 		}
+		hostState.CustomMetrics = append(hostState.CustomMetrics, models.HostCustomMetric{
+			HostNodeID: hostNodeID,
+			Timestamp:  hostState.Timestamp,
+			Name:       m.Name,
+			Labels:     labels,
+			Value:      m.Value,
+		})
+	}
+	for _, p := range state.TopProcesses {
+		hostState.TopProcesses = append(hostState.TopProcesses, models.ProcessState{
+			PID:          p.Pid,
+			Name:         p.Name,
+			User:         p.User,
+			CPUUsage:     p.CpuUsage,
+			MemUsage:     p.MemUsage,
+			MemRSS:       p.MemRss,
+			IOReadSpeed:  p.IoReadSpeed,
+			IOWriteSpeed: p.IoWriteSpeed,
+		})
 	}
 
-	return nil
+	return hostState
 }
 
 // accumulateTraffic adds delta traffic to the host's total traffic_used
@@ -727,7 +919,7 @@ func (m *AgentManager) processTaskResults(uuid string, results []*proto.TaskResu
 			default:
 				logrus.Warnf("[AgentManager] Result channel full or closed: task_id=%s", result.TaskId)
 			}
-		} else {
+		} else if !m.handleTaskResult(uuid, result) {
 			logrus.Warnf("[AgentManager] No waiting channel for task result: task_id=%s", result.TaskId)
 		}
 	}
@@ -735,6 +927,28 @@ func (m *AgentManager) processTaskResults(uuid string, results []*proto.TaskResu
 	return nil
 }
 
+// TaskResultHandler receives results of fire-and-forget tasks
+type TaskResultHandler func(hostUUID string, result *proto.TaskResult)
+
+// HandleTaskResults registers a handler for results of tasks whose ID starts
+// with prefix and that were queued without waiting
+func (m *AgentManager) HandleTaskResults(prefix string, handler TaskResultHandler) {
+	m.resultHandlers.Store(prefix, handler)
+}
+
+func (m *AgentManager) handleTaskResult(uuid string, result *proto.TaskResult) bool {
+	handled := false
+	m.resultHandlers.Range(func(key, value interface{}) bool {
+		if strings.HasPrefix(result.TaskId, key.(string)) {
+			value.(TaskResultHandler)(uuid, result)
+			handled = true
+			return false
+		}
+		return true
+	})
+	return handled
+}
+
 // QueueTaskAndWait queues a task to the agent and waits for the result
 func (m *AgentManager) QueueTaskAndWait(ctx context.Context, uuid string, task *proto.AgentTask, timeout time.Duration) (*proto.TaskResult, error) {
 	conn, ok := m.connections.Load(uuid)
@@ -0,0 +1,478 @@
+package host
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/proto"
+)
+
+// UpgradeTaskType is the agent task type that upgrades the agent binary
+const UpgradeTaskType = "upgrade"
+
+const (
+	upgradeTaskPrefix       = "upgrade-"
+	defaultRolloutBatchSize = 10
+	maxRolloutBatchSize     = 100
+
+	// upgradeTimeout bounds download, restart and reconnect of one agent. It
+	// exceeds the agent's own rollback window so that a rolled back agent is
+	// reported as rolled back rather than timed out.
+	upgradeTimeout = 10 * time.Minute
+)
+
+// Agent rollout errors
+var (
+	ErrRolloutNotFound           = errors.New("rollout not found")
+	ErrRolloutNotRunning         = errors.New("rollout is not running")
+	ErrRolloutNoHosts            = errors.New("no hosts match the rollout target")
+	ErrRolloutVersionUnavailable = errors.New("no agent binaries are available for this version")
+	ErrInvalidRollout            = errors.New("invalid rollout")
+)
+
+// CreateRolloutRequest describes a new agent rollout. Without host groups or
+// host IDs every host is targeted.
+type CreateRolloutRequest struct {
+	Version       string   `json:"version" binding:"required"`
+	HostGroups    []string `json:"host_groups"`
+	HostIDs       []string `json:"host_ids"`
+	CanaryPercent int      `json:"canary_percent"`
+	BatchSize     int      `json:"batch_size"`
+}
+
+// AgentRolloutService upgrades agents in canary-first batches. Hosts are
+// dispatched and checked by Reconcile, which runs periodically and after
+// each change; an upgrade counts as succeeded once the agent reconnects with
+// the target version.
+type AgentRolloutService struct {
+	db       *gorm.DB
+	binaries *AgentBinaryStore
+
+	// Overridable for tests
+	isOnline func(hostID uuid.UUID) bool
+	dispatch func(hostID uuid.UUID, task *proto.AgentTask) error
+	now      func() time.Time
+
+	mu sync.Mutex // Serialises reconciliation
+}
+
+// NewAgentRolloutService creates a new AgentRolloutService and subscribes to
+// the results of upgrade tasks
+func NewAgentRolloutService(db *gorm.DB, agentManager *AgentManager, binaries *AgentBinaryStore) *AgentRolloutService {
+	s := &AgentRolloutService{
+		db:       db,
+		binaries: binaries,
+		isOnline: func(hostID uuid.UUID) bool {
+			return agentManager.IsAgentOnline(hostID.String())
+		},
+		dispatch: func(hostID uuid.UUID, task *proto.AgentTask) error {
+			return agentManager.QueueTask(hostID.String(), task)
+		},
+		now: time.Now,
+	}
+	agentManager.HandleTaskResults(upgradeTaskPrefix, s.handleTaskResult)
+	return s
+}
+
+// CreateRollout selects the target hosts, picks the canaries and starts the rollout
+func (s *AgentRolloutService) CreateRollout(ctx context.Context, req *CreateRolloutRequest, createdBy string) (*models.AgentRollout, error) {
+	if req.CanaryPercent < 0 || req.CanaryPercent > 100 {
+		return nil, fmt.Errorf("%w: canary_percent must be between 0 and 100", ErrInvalidRollout)
+	}
+	if req.BatchSize < 0 || req.BatchSize > maxRolloutBatchSize {
+		return nil, fmt.Errorf("%w: batch_size must be between 1 and %d", ErrInvalidRollout, maxRolloutBatchSize)
+	}
+	if req.BatchSize == 0 {
+		req.BatchSize = defaultRolloutBatchSize
+	}
+	if !s.binaries.HasVersion(req.Version) {
+		return nil, ErrRolloutVersionUnavailable
+	}
+
+	hostIDs := make([]uuid.UUID, 0, len(req.HostIDs))
+	for _, id := range req.HostIDs {
+		hostID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad host id %q", ErrInvalidRollout, id)
+		}
+		hostIDs = append(hostIDs, hostID)
+	}
+
+	query := s.db.WithContext(ctx).Model(&models.HostNode{})
+	switch {
+	case len(req.HostGroups) > 0 && len(hostIDs) > 0:
+		query = query.Where("group_name IN ? OR id IN ?", req.HostGroups, hostIDs)
+	case len(req.HostGroups) > 0:
+		query = query.Where("group_name IN ?", req.HostGroups)
+	case len(hostIDs) > 0:
+		query = query.Where("id IN ?", hostIDs)
+	}
+	var hosts []models.HostNode
+	if err := query.Order("name").Find(&hosts).Error; err != nil {
+		return nil, fmt.Errorf("failed to select hosts: %w", err)
+	}
+	if len(hosts) == 0 {
+		return nil, ErrRolloutNoHosts
+	}
+
+	versions, err := s.agentVersions(ctx, hostNodeIDs(hosts))
+	if err != nil {
+		return nil, err
+	}
+
+	rollout := &models.AgentRollout{
+		Version:       req.Version,
+		HostGroups:    req.HostGroups,
+		HostIDs:       req.HostIDs,
+		CanaryPercent: req.CanaryPercent,
+		BatchSize:     req.BatchSize,
+		Status:        models.RolloutStatusRunning,
+		CreatedBy:     createdBy,
+	}
+
+	var eligible []*models.AgentRolloutHost
+	for _, h := range hosts {
+		rh := models.AgentRolloutHost{
+			HostNodeID:  h.ID,
+			HostName:    h.Name,
+			Status:      models.RolloutHostPending,
+			FromVersion: versions[h.ID],
+		}
+		if rh.FromVersion == req.Version {
+			rh.Status = models.RolloutHostSkipped
+			rh.Error = "already at the target version"
+		}
+		rollout.Hosts = append(rollout.Hosts, rh)
+	}
+	for i := range rollout.Hosts {
+		if rollout.Hosts[i].Status == models.RolloutHostPending {
+			eligible = append(eligible, &rollout.Hosts[i])
+		}
+	}
+	for _, rh := range pickCanaries(eligible, req.CanaryPercent) {
+		rh.Canary = true
+	}
+
+	if err := s.db.WithContext(ctx).Create(rollout).Error; err != nil {
+		return nil, fmt.Errorf("failed to create rollout: %w", err)
+	}
+
+	logrus.Infof("Agent rollout %s to %s created by %s: %d hosts, %d to upgrade",
+		rollout.ID, rollout.Version, createdBy, len(rollout.Hosts), len(eligible))
+
+	if err := s.Reconcile(ctx); err != nil {
+		logrus.Warnf("Failed to start agent rollout %s: %v", rollout.ID, err)
+	}
+	return s.GetRollout(ctx, rollout.ID)
+}
+
+// pickCanaries returns ceil(percent%) of hosts in random order
+func pickCanaries(hosts []*models.AgentRolloutHost, percent int) []*models.AgentRolloutHost {
+	count := (len(hosts)*percent + 99) / 100
+	if count == 0 {
+		return nil
+	}
+	shuffled := append([]*models.AgentRolloutHost(nil), hosts...)
+	rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled[:count]
+}
+
+// GetRollout returns a rollout with the status of each host
+func (s *AgentRolloutService) GetRollout(ctx context.Context, id uuid.UUID) (*models.AgentRollout, error) {
+	var rollout models.AgentRollout
+	err := s.db.WithContext(ctx).
+		Preload("Hosts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("canary DESC, host_name")
+		}).
+		First(&rollout, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRolloutNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rollout, nil
+}
+
+// ListRollouts returns rollouts newest first, without their hosts
+func (s *AgentRolloutService) ListRollouts(ctx context.Context, page, pageSize int) ([]models.AgentRollout, int64, error) {
+	var total int64
+	if err := s.db.WithContext(ctx).Model(&models.AgentRollout{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var rollouts []models.AgentRollout
+	err := s.db.WithContext(ctx).
+		Order("created_at DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&rollouts).Error
+	return rollouts, total, err
+}
+
+// CancelRollout stops dispatching a running rollout. Upgrades already sent
+// to agents are still tracked to completion.
+func (s *AgentRolloutService) CancelRollout(ctx context.Context, id uuid.UUID) (*models.AgentRollout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rollout, err := s.GetRollout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.Status != models.RolloutStatusRunning {
+		return nil, ErrRolloutNotRunning
+	}
+
+	now := s.now()
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.AgentRolloutHost{}).
+			Where("rollout_id = ? AND status = ?", id, models.RolloutHostPending).
+			Updates(map[string]interface{}{"status": models.RolloutHostSkipped, "error": "rollout cancelled", "finished_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Model(rollout).Updates(map[string]interface{}{
+			"status":       models.RolloutStatusCancelled,
+			"message":      "cancelled",
+			"completed_at": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel rollout: %w", err)
+	}
+	return s.GetRollout(ctx, id)
+}
+
+// Reconcile advances every running rollout: finished upgrades are detected,
+// the canary phase is evaluated and further hosts are dispatched. Upgrades
+// still in flight in cancelled rollouts are tracked as well.
+func (s *AgentRolloutService) Reconcile(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inFlight := s.db.Model(&models.AgentRolloutHost{}).Select("rollout_id").
+		Where("status = ?", models.RolloutHostUpgrading)
+	var rollouts []models.AgentRollout
+	if err := s.db.WithContext(ctx).Preload("Hosts").
+		Where("status = ? OR (status = ? AND id IN (?))", models.RolloutStatusRunning, models.RolloutStatusCancelled, inFlight).
+		Order("created_at").
+		Find(&rollouts).Error; err != nil {
+		return fmt.Errorf("failed to load active rollouts: %w", err)
+	}
+
+	var errs []error
+	for i := range rollouts {
+		if err := s.reconcileRollout(ctx, &rollouts[i]); err != nil {
+			errs = append(errs, fmt.Errorf("rollout %s: %w", rollouts[i].ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *AgentRolloutService) reconcileRollout(ctx context.Context, rollout *models.AgentRollout) error {
+	now := s.now()
+
+	if err := s.checkUpgrades(ctx, rollout, now); err != nil {
+		return err
+	}
+	if rollout.Status != models.RolloutStatusRunning {
+		return nil
+	}
+
+	canaryActive, canaryFailed := false, false
+	inFlight := 0
+	for _, h := range rollout.Hosts {
+		if h.Canary && !h.IsFinished() {
+			canaryActive = true
+		}
+		if h.Canary && (h.Status == models.RolloutHostFailed || h.Status == models.RolloutHostRolledBack) {
+			canaryFailed = true
+		}
+		if h.Status == models.RolloutHostUpgrading {
+			inFlight++
+		}
+	}
+
+	switch {
+	case canaryActive:
+		for i := range rollout.Hosts {
+			if h := &rollout.Hosts[i]; h.Canary && h.Status == models.RolloutHostPending {
+				s.dispatchUpgrade(ctx, rollout, h, now)
+			}
+		}
+	case canaryFailed:
+		if err := s.db.WithContext(ctx).Model(&models.AgentRolloutHost{}).
+			Where("rollout_id = ? AND status = ?", rollout.ID, models.RolloutHostPending).
+			Updates(map[string]interface{}{"status": models.RolloutHostSkipped, "error": "canary upgrade failed", "finished_at": now}).Error; err != nil {
+			return err
+		}
+		logrus.Warnf("Agent rollout %s to %s stopped: canary upgrade failed", rollout.ID, rollout.Version)
+		return s.finishRollout(ctx, rollout, models.RolloutStatusFailed, "canary upgrade failed, remaining hosts skipped", now)
+	default:
+		for i := range rollout.Hosts {
+			if inFlight >= rollout.BatchSize {
+				break
+			}
+			if h := &rollout.Hosts[i]; h.Status == models.RolloutHostPending {
+				if s.dispatchUpgrade(ctx, rollout, h, now) {
+					inFlight++
+				}
+			}
+		}
+	}
+
+	failed := 0
+	for _, h := range rollout.Hosts {
+		if !h.IsFinished() {
+			return nil
+		}
+		if h.Status == models.RolloutHostFailed || h.Status == models.RolloutHostRolledBack {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return s.finishRollout(ctx, rollout, models.RolloutStatusFailed, fmt.Sprintf("%d hosts failed to upgrade", failed), now)
+	}
+	return s.finishRollout(ctx, rollout, models.RolloutStatusCompleted, "", now)
+}
+
+// checkUpgrades resolves upgrading hosts from the version their agent
+// reconnected with
+func (s *AgentRolloutService) checkUpgrades(ctx context.Context, rollout *models.AgentRollout, now time.Time) error {
+	var ids []uuid.UUID
+	for _, h := range rollout.Hosts {
+		if h.Status == models.RolloutHostUpgrading {
+			ids = append(ids, h.HostNodeID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var conns []models.AgentConnection
+	if err := s.db.WithContext(ctx).Where("host_node_id IN ?", ids).Find(&conns).Error; err != nil {
+		return fmt.Errorf("failed to load agent connections: %w", err)
+	}
+	byHost := make(map[uuid.UUID]models.AgentConnection, len(conns))
+	for _, c := range conns {
+		byHost[c.HostNodeID] = c
+	}
+
+	for i := range rollout.Hosts {
+		h := &rollout.Hosts[i]
+		if h.Status != models.RolloutHostUpgrading {
+			continue
+		}
+		conn, ok := byHost[h.HostNodeID]
+		online := ok && s.isOnline(h.HostNodeID)
+		reconnected := ok && conn.ConnectedAt != nil && h.DispatchedAt != nil && conn.ConnectedAt.After(*h.DispatchedAt)
+
+		switch {
+		case online && conn.AgentVersion == rollout.Version:
+			h.Status = models.RolloutHostSucceeded
+		case online && reconnected:
+			h.Status = models.RolloutHostRolledBack
+			h.Error = fmt.Sprintf("agent reconnected with version %s", conn.AgentVersion)
+		case h.DispatchedAt != nil && now.Sub(*h.DispatchedAt) > upgradeTimeout:
+			h.Status = models.RolloutHostFailed
+			h.Error = fmt.Sprintf("agent did not reconnect with version %s within %s", rollout.Version, upgradeTimeout)
+		default:
+			continue
+		}
+		h.FinishedAt = &now
+		if err := s.db.WithContext(ctx).Save(h).Error; err != nil {
+			return fmt.Errorf("failed to update rollout host: %w", err)
+		}
+	}
+	return nil
+}
+
+// dispatchUpgrade sends the upgrade task to an online agent. Offline hosts
+// stay pending until their agent connects.
+func (s *AgentRolloutService) dispatchUpgrade(ctx context.Context, rollout *models.AgentRollout, h *models.AgentRolloutHost, now time.Time) bool {
+	if !s.isOnline(h.HostNodeID) {
+		return false
+	}
+
+	task := &proto.AgentTask{
+		TaskId:   upgradeTaskPrefix + uuid.NewString(),
+		TaskType: UpgradeTaskType,
+		Params: map[string]string{
+			"version":    rollout.Version,
+			"rollout_id": rollout.ID.String(),
+		},
+	}
+	if err := s.dispatch(h.HostNodeID, task); err != nil {
+		logrus.Warnf("Failed to send agent upgrade to host %s: %v", h.HostName, err)
+		return false
+	}
+
+	h.Status = models.RolloutHostUpgrading
+	h.TaskID = task.TaskId
+	h.DispatchedAt = &now
+	if err := s.db.WithContext(ctx).Save(h).Error; err != nil {
+		logrus.Errorf("Failed to update rollout host %s: %v", h.HostName, err)
+	}
+	return true
+}
+
+func (s *AgentRolloutService) finishRollout(ctx context.Context, rollout *models.AgentRollout, status, message string, now time.Time) error {
+	rollout.Status = status
+	rollout.Message = message
+	rollout.CompletedAt = &now
+	logrus.Infof("Agent rollout %s to %s %s", rollout.ID, rollout.Version, status)
+	return s.db.WithContext(ctx).Model(rollout).Updates(map[string]interface{}{
+		"status":       status,
+		"message":      message,
+		"completed_at": now,
+	}).Error
+}
+
+// handleTaskResult marks hosts whose agent reported a failed upgrade. A
+// successful result only means the agent is restarting; success is
+// confirmed when it reconnects.
+func (s *AgentRolloutService) handleTaskResult(hostUUID string, result *proto.TaskResult) {
+	if result.Success {
+		return
+	}
+	now := s.now()
+	res := s.db.Model(&models.AgentRolloutHost{}).
+		Where("task_id = ? AND status = ?", result.TaskId, models.RolloutHostUpgrading).
+		Updates(map[string]interface{}{"status": models.RolloutHostFailed, "error": result.Error, "finished_at": now})
+	if res.Error != nil {
+		logrus.Errorf("Failed to record agent upgrade failure of %s: %v", hostUUID, res.Error)
+		return
+	}
+	if res.RowsAffected > 0 {
+		logrus.Warnf("Agent upgrade failed on %s: %s", hostUUID, result.Error)
+	}
+}
+
+func (s *AgentRolloutService) agentVersions(ctx context.Context, hostIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	var infos []models.HostInfo
+	if err := s.db.WithContext(ctx).Select("host_node_id", "agent_version").
+		Where("host_node_id IN ?", hostIDs).Find(&infos).Error; err != nil {
+		return nil, fmt.Errorf("failed to load agent versions: %w", err)
+	}
+	versions := make(map[uuid.UUID]string, len(infos))
+	for _, info := range infos {
+		versions[info.HostNodeID] = info.AgentVersion
+	}
+	return versions, nil
+}
+
+func hostNodeIDs(hosts []models.HostNode) []uuid.UUID {
+	ids := make([]uuid.UUID, len(hosts))
+	for i, h := range hosts {
+		ids[i] = h.ID
+	}
+	return ids
+}
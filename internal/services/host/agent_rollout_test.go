@@ -0,0 +1,272 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/proto"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+type rolloutTestEnv struct {
+	t          *testing.T
+	db         *gorm.DB
+	svc        *AgentRolloutService
+	now        time.Time
+	online     map[uuid.UUID]bool
+	dispatched map[uuid.UUID]*proto.AgentTask
+}
+
+func newRolloutTestEnv(t *testing.T) *rolloutTestEnv {
+	db := testdb.Open(t,
+		&models.HostNode{},
+		&models.HostInfo{},
+		&models.AgentConnection{},
+		&models.AgentRollout{},
+		&models.AgentRolloutHost{},
+	)
+
+	binaries := NewAgentBinaryStore(t.TempDir(), "")
+	_, err := binaries.Save("v2.0.0", "linux", "amd64", strings.NewReader("agent"), "")
+	require.NoError(t, err)
+
+	env := &rolloutTestEnv{
+		t:          t,
+		db:         db,
+		now:        time.Date(2025, 6, 1, 12, 0, 0, 0, time.Local),
+		online:     map[uuid.UUID]bool{},
+		dispatched: map[uuid.UUID]*proto.AgentTask{},
+	}
+	env.svc = &AgentRolloutService{
+		db:       db,
+		binaries: binaries,
+		isOnline: func(id uuid.UUID) bool { return env.online[id] },
+		dispatch: func(id uuid.UUID, task *proto.AgentTask) error {
+			env.dispatched[id] = task
+			return nil
+		},
+		now: func() time.Time { return env.now },
+	}
+	return env
+}
+
+// addHost creates an online host whose agent runs agentVersion
+func (e *rolloutTestEnv) addHost(name, group, agentVersion string) uuid.UUID {
+	h := &models.HostNode{Name: name, SecretKey: "secret", GroupName: group}
+	require.NoError(e.t, e.db.Create(h).Error)
+	require.NoError(e.t, e.db.Create(&models.HostInfo{HostNodeID: h.ID, AgentVersion: agentVersion}).Error)
+	e.connect(h.ID, agentVersion, e.now.Add(-time.Hour))
+	return h.ID
+}
+
+// connect records an agent (re)registration
+func (e *rolloutTestEnv) connect(hostID uuid.UUID, agentVersion string, at time.Time) {
+	conn := models.AgentConnection{HostNodeID: hostID, Status: models.AgentStatusOnline, AgentVersion: agentVersion, ConnectedAt: &at}
+	require.NoError(e.t, e.db.Where("host_node_id = ?", hostID).Assign(conn).FirstOrCreate(&conn).Error)
+	e.online[hostID] = true
+}
+
+func (e *rolloutTestEnv) hostStatuses(rolloutID uuid.UUID) map[string]int {
+	rollout, err := e.svc.GetRollout(context.Background(), rolloutID)
+	require.NoError(e.t, err)
+	counts := map[string]int{}
+	for _, h := range rollout.Hosts {
+		counts[h.Status]++
+	}
+	return counts
+}
+
+func (e *rolloutTestEnv) upgrading(rolloutID uuid.UUID) []models.AgentRolloutHost {
+	var hosts []models.AgentRolloutHost
+	require.NoError(e.t, e.db.Where("rollout_id = ? AND status = ?", rolloutID, models.RolloutHostUpgrading).
+		Order("host_name").Find(&hosts).Error)
+	return hosts
+}
+
+func TestAgentRollout_CanaryThenBatches(t *testing.T) {
+	env := newRolloutTestEnv(t)
+	ctx := context.Background()
+
+	for i := 0; i < 9; i++ {
+		env.addHost(fmt.Sprintf("prod-%d", i), "prod", "v1.0.0")
+	}
+	env.addHost("prod-current", "prod", "v2.0.0")
+	env.addHost("dev-0", "dev", "v1.0.0")
+
+	_, err := env.svc.CreateRollout(ctx, &CreateRolloutRequest{Version: "v9.9.9"}, "admin")
+	assert.ErrorIs(t, err, ErrRolloutVersionUnavailable)
+	_, err = env.svc.CreateRollout(ctx, &CreateRolloutRequest{Version: "v2.0.0", HostGroups: []string{"staging"}}, "admin")
+	assert.ErrorIs(t, err, ErrRolloutNoHosts)
+	_, err = env.svc.CreateRollout(ctx, &CreateRolloutRequest{Version: "v2.0.0", CanaryPercent: 150}, "admin")
+	assert.ErrorIs(t, err, ErrInvalidRollout)
+
+	rollout, err := env.svc.CreateRollout(ctx, &CreateRolloutRequest{
+		Version:       "v2.0.0",
+		HostGroups:    []string{"prod"},
+		CanaryPercent: 20,
+		BatchSize:     3,
+	}, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusRunning, rollout.Status)
+	require.Len(t, rollout.Hosts, 10)
+
+	// ceil(20% of 9 eligible hosts) canaries are dispatched first
+	canaries := env.upgrading(rollout.ID)
+	require.Len(t, canaries, 2)
+	for _, c := range canaries {
+		assert.True(t, c.Canary)
+		task := env.dispatched[c.HostNodeID]
+		require.NotNil(t, task)
+		assert.Equal(t, UpgradeTaskType, task.TaskType)
+		assert.Equal(t, "v2.0.0", task.Params["version"])
+		assert.Equal(t, task.TaskId, c.TaskID)
+	}
+	assert.Equal(t, map[string]int{
+		models.RolloutHostUpgrading: 2,
+		models.RolloutHostPending:   7,
+		models.RolloutHostSkipped:   1,
+	}, env.hostStatuses(rollout.ID))
+
+	// Nothing moves until the canaries come back
+	env.now = env.now.Add(time.Minute)
+	require.NoError(t, env.svc.Reconcile(ctx))
+	assert.Len(t, env.dispatched, 2)
+
+	for _, c := range canaries {
+		env.connect(c.HostNodeID, "v2.0.0", env.now)
+	}
+	env.now = env.now.Add(time.Minute)
+	require.NoError(t, env.svc.Reconcile(ctx))
+	batch := env.upgrading(rollout.ID)
+	require.Len(t, batch, 3, "batch size limits concurrent upgrades")
+	for _, h := range batch {
+		assert.False(t, h.Canary)
+	}
+
+	// One agent reports a failure, one comes back on its old version and one never returns
+	env.svc.handleTaskResult(batch[0].HostNodeID.String(), &proto.TaskResult{TaskId: batch[0].TaskID, Error: "signature is invalid"})
+	env.connect(batch[1].HostNodeID, "v1.0.0", env.now.Add(time.Minute))
+	env.now = env.now.Add(upgradeTimeout + time.Minute)
+	require.NoError(t, env.svc.Reconcile(ctx))
+
+	var failed models.AgentRolloutHost
+	require.NoError(t, env.db.First(&failed, "id = ?", batch[0].ID).Error)
+	assert.Equal(t, models.RolloutHostFailed, failed.Status)
+	assert.Equal(t, "signature is invalid", failed.Error)
+	assert.Equal(t, map[string]int{
+		models.RolloutHostSucceeded:  2,
+		models.RolloutHostFailed:     2,
+		models.RolloutHostRolledBack: 1,
+		models.RolloutHostUpgrading:  3,
+		models.RolloutHostPending:    1,
+		models.RolloutHostSkipped:    1,
+	}, env.hostStatuses(rollout.ID))
+
+	// Finish the remaining hosts
+	for i := 0; i < 2; i++ {
+		for _, h := range env.upgrading(rollout.ID) {
+			env.connect(h.HostNodeID, "v2.0.0", env.now)
+		}
+		env.now = env.now.Add(time.Minute)
+		require.NoError(t, env.svc.Reconcile(ctx))
+	}
+
+	rollout, err = env.svc.GetRollout(ctx, rollout.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusFailed, rollout.Status)
+	assert.Equal(t, "3 hosts failed to upgrade", rollout.Message)
+	assert.NotNil(t, rollout.CompletedAt)
+	assert.Equal(t, 6, env.hostStatuses(rollout.ID)[models.RolloutHostSucceeded])
+}
+
+func TestAgentRollout_CanaryFailureStops(t *testing.T) {
+	env := newRolloutTestEnv(t)
+	ctx := context.Background()
+
+	var ids []string
+	for i := 0; i < 4; i++ {
+		ids = append(ids, env.addHost(fmt.Sprintf("web-%d", i), "web", "v1.0.0").String())
+	}
+	offline := env.addHost("db-0", "db", "v1.0.0")
+	env.online[offline] = false
+
+	rollout, err := env.svc.CreateRollout(ctx, &CreateRolloutRequest{
+		Version:       "v2.0.0",
+		HostIDs:       append(ids, offline.String()),
+		CanaryPercent: 1,
+	}, "admin")
+	require.NoError(t, err)
+	require.Len(t, rollout.Hosts, 5)
+
+	var canary models.AgentRolloutHost
+	require.NoError(t, env.db.Where("rollout_id = ? AND canary = ?", rollout.ID, true).First(&canary).Error)
+	if canary.HostNodeID == offline {
+		// An offline canary waits for its agent
+		assert.Empty(t, env.dispatched)
+		env.connect(offline, "v1.0.0", env.now)
+		require.NoError(t, env.svc.Reconcile(ctx))
+	}
+	require.Len(t, env.dispatched, 1)
+	require.NoError(t, env.db.First(&canary, "id = ?", canary.ID).Error)
+	require.Equal(t, models.RolloutHostUpgrading, canary.Status)
+
+	env.svc.handleTaskResult(canary.HostNodeID.String(), &proto.TaskResult{TaskId: canary.TaskID, Error: "disk full"})
+	require.NoError(t, env.svc.Reconcile(ctx))
+
+	rollout, err = env.svc.GetRollout(ctx, rollout.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusFailed, rollout.Status)
+	assert.Contains(t, rollout.Message, "canary")
+	assert.Equal(t, map[string]int{models.RolloutHostFailed: 1, models.RolloutHostSkipped: 4}, env.hostStatuses(rollout.ID))
+	assert.Len(t, env.dispatched, 1)
+
+	_, err = env.svc.CancelRollout(ctx, rollout.ID)
+	assert.ErrorIs(t, err, ErrRolloutNotRunning)
+}
+
+func TestAgentRollout_Cancel(t *testing.T) {
+	env := newRolloutTestEnv(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		env.addHost(fmt.Sprintf("node-%d", i), "", "v1.0.0")
+	}
+	rollout, err := env.svc.CreateRollout(ctx, &CreateRolloutRequest{Version: "v2.0.0", BatchSize: 1}, "admin")
+	require.NoError(t, err)
+	assert.Len(t, env.dispatched, 1)
+
+	rollout, err = env.svc.CancelRollout(ctx, rollout.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusCancelled, rollout.Status)
+	assert.Equal(t, map[string]int{models.RolloutHostUpgrading: 1, models.RolloutHostSkipped: 2}, env.hostStatuses(rollout.ID))
+
+	require.NoError(t, env.svc.Reconcile(ctx))
+	assert.Len(t, env.dispatched, 1, "cancelled rollouts dispatch nothing")
+
+	// The upgrade already sent is still tracked
+	for hostID := range env.dispatched {
+		env.connect(hostID, "v2.0.0", env.now.Add(time.Minute))
+	}
+	require.NoError(t, env.svc.Reconcile(ctx))
+	assert.Equal(t, map[string]int{models.RolloutHostSucceeded: 1, models.RolloutHostSkipped: 2}, env.hostStatuses(rollout.ID))
+	rollout, err = env.svc.GetRollout(ctx, rollout.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusCancelled, rollout.Status)
+
+	rollouts, total, err := env.svc.ListRollouts(ctx, 1, 20)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, rollouts, 1)
+
+	_, err = env.svc.GetRollout(ctx, uuid.New())
+	assert.ErrorIs(t, err, ErrRolloutNotFound)
+}
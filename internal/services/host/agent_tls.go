@@ -0,0 +1,149 @@
+package host
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/ysicing/tiga/proto"
+)
+
+// ServerTLSConfig returns the TLS configuration of the agent gRPC server.
+// The certificate is loaded from certFile/keyFile when both are set and
+// issued by the CA for hosts otherwise. Client certificates are optional at
+// the TLS layer so agents can enrol; AgentAuthInterceptor enforces them.
+func (c *AgentCA) ServerTLSConfig(certFile, keyFile string, hosts []string) (*tls.Config, error) {
+	var cert tls.Certificate
+	var err error
+	if certFile != "" && keyFile != "" {
+		cert, err = tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gRPC server certificate: %w", err)
+		}
+	} else {
+		cert, err = c.IssueServerCertificate(hosts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    c.CertPool(),
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// AgentAuthInterceptor authenticates agents by their client certificate on
+// every gRPC call. Certificates must be active in the issuance records and
+// requests carrying a host UUID must match the certificate. Without a client
+// certificate only enrolment is allowed when requireClientCert is set;
+// otherwise the call falls back to secret key authentication. Enrolment
+// also falls back to the secret key when the certificate was revoked or is
+// unknown, so agents can recover after a secret key rotation.
+type AgentAuthInterceptor struct {
+	ca                *AgentCA
+	requireClientCert bool
+}
+
+// NewAgentAuthInterceptor creates a new AgentAuthInterceptor
+func NewAgentAuthInterceptor(ca *AgentCA, requireClientCert bool) *AgentAuthInterceptor {
+	return &AgentAuthInterceptor{ca: ca, requireClientCert: requireClientCert}
+}
+
+// ServerOptions returns the interceptors as gRPC server options
+func (i *AgentAuthInterceptor) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(i.Unary),
+		grpc.StreamInterceptor(i.Stream),
+	}
+}
+
+// Unary authenticates unary calls
+func (i *AgentAuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	hostID, err := i.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAgentIdentity(hostID, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream authenticates streaming calls and checks every received message
+func (i *AgentAuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	hostID, err := i.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	if hostID != uuid.Nil {
+		ss = &identityCheckedStream{ServerStream: ss, hostID: hostID}
+	}
+	return handler(srv, ss)
+}
+
+// authenticate returns the host of the verified client certificate, or
+// uuid.Nil when the call may proceed without one
+func (i *AgentAuthInterceptor) authenticate(ctx context.Context, method string) (uuid.UUID, error) {
+	p, ok := peer.FromContext(ctx)
+	var tlsInfo credentials.TLSInfo
+	if ok {
+		tlsInfo, ok = p.AuthInfo.(credentials.TLSInfo)
+	}
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		if i.requireClientCert && method != proto.HostMonitor_EnrollAgent_FullMethodName {
+			return uuid.Nil, status.Error(codes.Unauthenticated, "client certificate required, enrol the agent first")
+		}
+		return uuid.Nil, nil
+	}
+
+	hostID, err := i.ca.VerifyAgentCertificate(ctx, tlsInfo.State.VerifiedChains[0][0])
+	if err != nil && method == proto.HostMonitor_EnrollAgent_FullMethodName &&
+		(errors.Is(err, ErrAgentCertificateRevoked) || errors.Is(err, ErrAgentCertificateUnknown)) {
+		// Agents holding a revoked or foreign certificate re-enrol with their secret key
+		logrus.Infof("[AgentAuth] Ignoring invalid client certificate on enrolment: %v", err)
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		logrus.Warnf("[AgentAuth] Rejected client certificate on %s: %v", method, err)
+		return uuid.Nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return hostID, nil
+}
+
+// checkAgentIdentity rejects messages whose host UUID differs from the
+// certificate host
+func checkAgentIdentity(hostID uuid.UUID, msg interface{}) error {
+	if hostID == uuid.Nil {
+		return nil
+	}
+	if m, ok := msg.(interface{ GetUuid() string }); ok {
+		if id := m.GetUuid(); id != "" && id != hostID.String() {
+			return status.Errorf(codes.PermissionDenied, "client certificate was issued to host %s", hostID)
+		}
+	}
+	return nil
+}
+
+type identityCheckedStream struct {
+	grpc.ServerStream
+	hostID uuid.UUID
+}
+
+func (s *identityCheckedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkAgentIdentity(s.hostID, m)
+}
@@ -0,0 +1,93 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/docker"
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/proto"
+)
+
+// createDirectSession creates a session for a Docker instance the server
+// connects to directly. The engine side runs the same stream handler as
+// the agent, connected to the session over an in-process pipe instead of
+// the DockerStream RPC.
+func (m *DockerStreamManager) createDirectSession(instanceID uuid.UUID, operation string, containerID, imageName string, params map[string]string) (*DockerStreamSession, error) {
+	var instance models.DockerInstance
+	if err := m.db.Where("id = ?", instanceID).First(&instance).Error; err != nil {
+		return nil, fmt.Errorf("failed to find docker instance: %w", err)
+	}
+	client, err := m.direct.Client(&instance)
+	if err != nil {
+		return nil, err
+	}
+
+	session := newDockerStreamSession(instanceID, operation, containerID, imageName, params)
+	m.sessions.Store(session.SessionID, session)
+	logrus.Infof("[DockerStreamMgr] Created direct session: %s, instance: %s, operation: %s", session.SessionID, instanceID, operation)
+
+	serverEnd, engineEnd := newStreamPipe(session.ctx)
+	go func() {
+		if err := m.HandleDockerStream(serverEnd); err != nil {
+			logrus.Errorf("[DockerStreamMgr] Direct session %s failed: %v", session.SessionID, err)
+		}
+	}()
+	go func() {
+		// Open the session the way an agent does
+		if err := engineEnd.Send(&proto.DockerStreamMessage{
+			Message: &proto.DockerStreamMessage_Init{
+				Init: &proto.DockerStreamInit{SessionId: session.SessionID},
+			},
+		}); err != nil {
+			return
+		}
+		if err := docker.NewDockerStreamHandler(client).HandleDockerStream(engineEnd); err != nil {
+			logrus.Errorf("[DockerStreamMgr] Direct session %s stream error: %v", session.SessionID, err)
+		}
+	}()
+
+	return session, nil
+}
+
+// streamPipeEnd is one end of an in-process DockerStream connection. Both
+// ends report io.EOF once the session context is done.
+type streamPipeEnd struct {
+	ctx  context.Context
+	recv <-chan *proto.DockerStreamMessage
+	send chan<- *proto.DockerStreamMessage
+}
+
+// newStreamPipe returns the server and engine ends of a connection
+func newStreamPipe(ctx context.Context) (*streamPipeEnd, *streamPipeEnd) {
+	toEngine := make(chan *proto.DockerStreamMessage, 16)
+	toServer := make(chan *proto.DockerStreamMessage, 16)
+	return &streamPipeEnd{ctx: ctx, recv: toServer, send: toEngine},
+		&streamPipeEnd{ctx: ctx, recv: toEngine, send: toServer}
+}
+
+func (p *streamPipeEnd) Context() context.Context {
+	return p.ctx
+}
+
+func (p *streamPipeEnd) Send(msg *proto.DockerStreamMessage) error {
+	select {
+	case p.send <- msg:
+		return nil
+	case <-p.ctx.Done():
+		return io.EOF
+	}
+}
+
+func (p *streamPipeEnd) Recv() (*proto.DockerStreamMessage, error) {
+	select {
+	case msg := <-p.recv:
+		return msg, nil
+	case <-p.ctx.Done():
+		return nil, io.EOF
+	}
+}
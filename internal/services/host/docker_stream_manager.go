@@ -14,6 +14,8 @@ import (
 
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/proto"
+
+	dockerservices "github.com/ysicing/tiga/internal/services/docker"
 )
 
 // DockerStreamSession represents an active Docker streaming operation session
@@ -43,11 +45,21 @@ type DockerStreamSession struct {
 	ready  bool // Agent confirmed ready
 }
 
-// DockerStreamManager manages active Docker stream sessions
+// DockerStreamManager manages active Docker stream sessions. Sessions of
+// agent instances are served by the agent over the DockerStream RPC;
+// sessions of direct instances are served by the server itself.
 type DockerStreamManager struct {
 	sessions     sync.Map // SessionID -> *DockerStreamSession
 	agentManager *AgentManager
 	db           *gorm.DB
+	direct       *dockerservices.DirectBackend
+}
+
+// dockerStreamConn is the server side of a DockerStream connection
+type dockerStreamConn interface {
+	Context() context.Context
+	Send(*proto.DockerStreamMessage) error
+	Recv() (*proto.DockerStreamMessage, error)
 }
 
 // NewDockerStreamManager creates a new Docker stream manager
@@ -55,11 +67,12 @@ func NewDockerStreamManager(agentManager *AgentManager, db *gorm.DB) *DockerStre
 	return &DockerStreamManager{
 		agentManager: agentManager,
 		db:           db,
+		direct:       dockerservices.NewDirectBackend(db),
 	}
 }
 
 // HandleDockerStream handles the DockerStream RPC from Agent
-func (m *DockerStreamManager) HandleDockerStream(stream proto.HostMonitor_DockerStreamServer) error {
+func (m *DockerStreamManager) HandleDockerStream(stream dockerStreamConn) error {
 	ctx := stream.Context()
 
 	// First message should be Init from Agent
@@ -240,25 +253,14 @@ func (m *DockerStreamManager) HandleDockerStream(stream proto.HostMonitor_Docker
 
 // CreateSession creates a new Docker stream session and triggers Agent to connect
 func (m *DockerStreamManager) CreateSession(instanceID uuid.UUID, agentID string, operation string, containerID, imageName string, params map[string]string) (interface{}, error) {
-	sessionID := uuid.New().String()
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute) // 5 min timeout
-
-	session := &DockerStreamSession{
-		SessionID:   sessionID,
-		InstanceID:  instanceID,
-		Operation:   operation,
-		ContainerID: containerID,
-		ImageName:   imageName,
-		Params:      params,
-		StartedAt:   time.Now(),
-		DataChan:    make(chan *proto.DockerStreamData, 100),
-		ErrorChan:   make(chan *proto.DockerStreamError, 10),
-		CloseChan:   make(chan *proto.DockerStreamClose, 1),
-		InputChan:   make(chan *proto.DockerStreamMessage, 100), // Server → Agent
-		ctx:         ctx,
-		cancel:      cancel,
+	// Direct instances have no agent, the server runs the operation itself
+	if agentID == uuid.Nil.String() {
+		return m.createDirectSession(instanceID, operation, containerID, imageName, params)
 	}
 
+	session := newDockerStreamSession(instanceID, operation, containerID, imageName, params)
+	sessionID := session.SessionID
+
 	m.sessions.Store(sessionID, session)
 	logrus.Infof("[DockerStreamMgr] Created session: %s, instance: %s, operation: %s", sessionID, instanceID, operation)
 
@@ -313,6 +315,27 @@ func (m *DockerStreamManager) CreateSession(instanceID uuid.UUID, agentID string
 	return session, nil
 }
 
+// newDockerStreamSession creates a session that is not registered yet
+func newDockerStreamSession(instanceID uuid.UUID, operation string, containerID, imageName string, params map[string]string) *DockerStreamSession {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute) // 5 min timeout
+
+	return &DockerStreamSession{
+		SessionID:   uuid.New().String(),
+		InstanceID:  instanceID,
+		Operation:   operation,
+		ContainerID: containerID,
+		ImageName:   imageName,
+		Params:      params,
+		StartedAt:   time.Now(),
+		DataChan:    make(chan *proto.DockerStreamData, 100),
+		ErrorChan:   make(chan *proto.DockerStreamError, 10),
+		CloseChan:   make(chan *proto.DockerStreamClose, 1),
+		InputChan:   make(chan *proto.DockerStreamMessage, 100), // Server → Agent
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
 // GetSession retrieves a session by SessionID
 func (m *DockerStreamManager) GetSession(sessionID string) (*DockerStreamSession, bool) {
 	sessionI, exists := m.sessions.Load(sessionID)
@@ -402,6 +425,135 @@ func (s *DockerStreamSession) ReceiveClose() (*proto.DockerStreamClose, error) {
 	}
 }
 
+// Wait drains the stream until it closes and returns an error unless the
+// operation completed. It is for callers that only need the outcome, such
+// as an image pull before a container is recreated.
+func (s *DockerStreamSession) Wait(ctx context.Context) error {
+	_, err := io.Copy(io.Discard, s.Reader(ctx))
+	return err
+}
+
+// Reader returns the data sent by the Agent as a byte stream, such as the
+// archive of a copy_from_container session. It ends with io.EOF once the
+// Agent closes the session as completed and with an error otherwise.
+func (s *DockerStreamSession) Reader(ctx context.Context) io.Reader {
+	return &dockerStreamReader{
+		ctx:       ctx,
+		session:   s,
+		dataChan:  s.DataChan,
+		errorChan: s.ErrorChan,
+	}
+}
+
+// SendData sends data to the Agent, such as the archive chunks of a
+// copy_to_container session
+func (s *DockerStreamSession) SendData(ctx context.Context, dataType string, data []byte) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return errors.New("session is closed")
+	}
+
+	msg := &proto.DockerStreamMessage{
+		Message: &proto.DockerStreamMessage_Data{
+			Data: &proto.DockerStreamData{
+				SessionId: s.SessionID,
+				Data:      data,
+				DataType:  dataType,
+			},
+		},
+	}
+	select {
+	case s.InputChan <- msg:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// dockerStreamReader reads the data messages of a session in order
+type dockerStreamReader struct {
+	ctx       context.Context
+	session   *DockerStreamSession
+	dataChan  <-chan *proto.DockerStreamData
+	errorChan <-chan *proto.DockerStreamError
+	completed bool
+	buf       []byte
+	err       error
+}
+
+// Read implements io.Reader
+func (r *dockerStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.buf, r.err = r.next()
+	}
+	if len(r.buf) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next returns the payload of the next message. Data queued before the
+// close message is still returned before io.EOF.
+func (r *dockerStreamReader) next() ([]byte, error) {
+	s := r.session
+	closeChan := s.CloseChan
+	if r.completed {
+		closeChan = nil
+	}
+
+	select {
+	case data, ok := <-r.dataChan:
+		if !ok {
+			r.dataChan = nil
+			if r.completed {
+				return nil, io.EOF
+			}
+			return nil, nil
+		}
+		return data.Data, nil
+	case streamErr, ok := <-r.errorChan:
+		if !ok {
+			r.errorChan = nil
+			return nil, nil
+		}
+		return nil, errors.New(streamErr.Error)
+	case closeMsg, ok := <-closeChan:
+		if !ok {
+			// An error sent just before the stream ended may still be queued
+			select {
+			case streamErr, ok := <-s.ErrorChan:
+				if ok {
+					return nil, errors.New(streamErr.Error)
+				}
+			default:
+			}
+			return nil, errors.New("stream ended before the operation completed")
+		}
+		if closeMsg.Reason != "completed" {
+			return nil, fmt.Errorf("stream closed: %s", closeMsg.Reason)
+		}
+		r.completed = true
+		if r.dataChan == nil {
+			return nil, io.EOF
+		}
+		return nil, nil
+	case <-r.ctx.Done():
+		return nil, r.ctx.Err()
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// ID returns the session ID
+func (s *DockerStreamSession) ID() string {
+	return s.SessionID
+}
+
 // IsClosed returns whether the session is closed
 func (s *DockerStreamSession) IsClosed() bool {
 	s.mu.RLock()
@@ -0,0 +1,184 @@
+package host
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/crypto"
+	"github.com/ysicing/tiga/proto"
+	"github.com/ysicing/tiga/tests/testdb"
+
+	dockerengine "github.com/ysicing/tiga/internal/docker"
+	dockerservices "github.com/ysicing/tiga/internal/services/docker"
+)
+
+func newTestDockerStreamSession(t *testing.T) *DockerStreamSession {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return &DockerStreamSession{
+		DataChan:  make(chan *proto.DockerStreamData, 100),
+		ErrorChan: make(chan *proto.DockerStreamError, 10),
+		CloseChan: make(chan *proto.DockerStreamClose, 1),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// endStream mimics the agent stream ending: queued messages stay readable
+// after the channels are closed
+func endStream(session *DockerStreamSession) {
+	close(session.DataChan)
+	close(session.ErrorChan)
+	close(session.CloseChan)
+}
+
+func TestDockerStreamSession_Wait(t *testing.T) {
+	t.Run("completed", func(t *testing.T) {
+		session := newTestDockerStreamSession(t)
+		session.DataChan <- &proto.DockerStreamData{Data: []byte(`{"status":"Downloading"}`)}
+		session.CloseChan <- &proto.DockerStreamClose{Reason: "completed"}
+		endStream(session)
+		assert.NoError(t, session.Wait(context.Background()))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		session := newTestDockerStreamSession(t)
+		session.ErrorChan <- &proto.DockerStreamError{Error: "manifest unknown"}
+		endStream(session)
+		assert.EqualError(t, session.Wait(context.Background()), "manifest unknown")
+	})
+
+	t.Run("ended without close", func(t *testing.T) {
+		session := newTestDockerStreamSession(t)
+		endStream(session)
+		assert.EqualError(t, session.Wait(context.Background()), "stream ended before the operation completed")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		session := newTestDockerStreamSession(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, session.Wait(ctx), context.Canceled)
+	})
+}
+
+func TestDockerStreamSession_Reader(t *testing.T) {
+	session := newTestDockerStreamSession(t)
+	session.DataChan <- &proto.DockerStreamData{Data: []byte("hello ")}
+	session.DataChan <- &proto.DockerStreamData{}
+	session.DataChan <- &proto.DockerStreamData{Data: []byte("world")}
+	session.CloseChan <- &proto.DockerStreamClose{Reason: "completed"}
+	endStream(session)
+
+	data, err := io.ReadAll(session.Reader(context.Background()))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	failed := newTestDockerStreamSession(t)
+	failed.DataChan <- &proto.DockerStreamData{Data: []byte("partial")}
+	failed.ErrorChan <- &proto.DockerStreamError{Error: "no such file"}
+	_, err = io.ReadAll(failed.Reader(context.Background()))
+	assert.EqualError(t, err, "no such file")
+}
+
+func TestDockerStreamSession_SendData(t *testing.T) {
+	session := newTestDockerStreamSession(t)
+	session.SessionID = "s1"
+	session.InputChan = make(chan *proto.DockerStreamMessage, 1)
+
+	require.NoError(t, session.SendData(context.Background(), "archive", []byte("chunk")))
+	msg := <-session.InputChan
+	assert.Equal(t, &proto.DockerStreamData{SessionId: "s1", Data: []byte("chunk"), DataType: "archive"}, msg.GetData())
+
+	session.closed = true
+	assert.EqualError(t, session.SendData(context.Background(), "archive", nil), "session is closed")
+}
+
+// newFakeDockerEngine serves the version and container logs endpoints of the
+// Docker API over TLS
+func newFakeDockerEngine(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/_ping"):
+			w.Header().Set("API-Version", "1.43")
+			w.WriteHeader(http.StatusOK)
+		case strings.HasSuffix(r.URL.Path, "/version"):
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"Version":"24.0.7","ApiVersion":"1.43","Os":"linux","Arch":"amd64"}`)
+		case strings.HasSuffix(r.URL.Path, "/containers/web/logs"):
+			assert.Equal(t, "2", r.URL.Query().Get("tail"))
+			fmt.Fprint(w, "starting\nlistening on :80\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testClientCertificate returns a self-signed PEM client certificate and key
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tiga"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestDockerStreamManager_DirectSession(t *testing.T) {
+	db := testdb.Open(t, &models.DockerInstance{})
+	require.NoError(t, crypto.InitDefaultService([]byte("0123456789abcdef0123456789abcdef")))
+
+	engine := newFakeDockerEngine(t)
+	cert, key := testClientCertificate(t)
+	instance, err := dockerservices.NewDockerInstanceService(db).CreateDirectInstance(context.Background(), "nas", "", dockerengine.RemoteEndpoint{
+		Host:      "tcp://" + engine.Listener.Addr().String(),
+		TLSCACert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: engine.Certificate().Raw})),
+		TLSCert:   cert,
+		TLSKey:    key,
+	}, nil)
+	require.NoError(t, err)
+
+	m := NewDockerStreamManager(nil, db)
+	sessionInterface, err := m.CreateSession(instance.ID, uuid.Nil.String(), "get_logs", "web", "", map[string]string{"tail": "2"})
+	require.NoError(t, err)
+	session := sessionInterface.(*DockerStreamSession)
+	defer m.CloseSession(session.ID())
+
+	require.NoError(t, session.WaitForReady(5*time.Second))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	data, err := io.ReadAll(session.Reader(ctx))
+	require.NoError(t, err)
+	assert.Equal(t, "starting\nlistening on :80\n", string(data))
+}
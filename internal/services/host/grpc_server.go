@@ -2,12 +2,17 @@ package host
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/services/monitor"
 	"github.com/ysicing/tiga/proto"
 )
@@ -19,15 +24,20 @@ type GRPCServer struct {
 	terminalManager     *TerminalManager
 	dockerStreamManager *DockerStreamManager
 	probeScheduler      *monitor.ServiceProbeScheduler
+	agentBinaries       *AgentBinaryStore
 }
 
+// agentBinaryChunkSize is the payload size of DownloadAgentBinary chunks
+const agentBinaryChunkSize = 64 << 10
+
 // NewGRPCServer creates a new gRPC server
-func NewGRPCServer(agentManager *AgentManager, terminalManager *TerminalManager, dockerStreamManager *DockerStreamManager, probeScheduler *monitor.ServiceProbeScheduler) *GRPCServer {
+func NewGRPCServer(agentManager *AgentManager, terminalManager *TerminalManager, dockerStreamManager *DockerStreamManager, probeScheduler *monitor.ServiceProbeScheduler, agentBinaries *AgentBinaryStore) *GRPCServer {
 	return &GRPCServer{
 		agentManager:        agentManager,
 		terminalManager:     terminalManager,
 		dockerStreamManager: dockerStreamManager,
 		probeScheduler:      probeScheduler,
+		agentBinaries:       agentBinaries,
 	}
 }
 
@@ -36,6 +46,11 @@ func (s *GRPCServer) RegisterAgent(ctx context.Context, req *proto.RegisterAgent
 	return s.agentManager.RegisterAgent(ctx, req)
 }
 
+// EnrollAgent handles agent client certificate enrolment
+func (s *GRPCServer) EnrollAgent(ctx context.Context, req *proto.EnrollAgentRequest) (*proto.EnrollAgentResponse, error) {
+	return s.agentManager.EnrollAgent(ctx, req)
+}
+
 // ReportState handles state reporting stream
 func (s *GRPCServer) ReportState(stream proto.HostMonitor_ReportStateServer) error {
 	return s.agentManager.HandleReportState(stream)
@@ -56,6 +71,59 @@ func (s *GRPCServer) DockerStream(stream proto.HostMonitor_DockerStreamServer) e
 	return s.dockerStreamManager.HandleDockerStream(stream)
 }
 
+// DownloadAgentBinary streams an agent binary to a connected agent for
+// self-update. The first chunk carries the size, checksum and signature.
+func (s *GRPCServer) DownloadAgentBinary(req *proto.DownloadAgentBinaryRequest, stream proto.HostMonitor_DownloadAgentBinaryServer) error {
+	if s.agentBinaries == nil {
+		return status.Error(codes.Unavailable, "agent binaries are not configured")
+	}
+	if !s.agentManager.IsAgentOnline(req.Uuid) {
+		return status.Error(codes.PermissionDenied, "agent is not connected")
+	}
+
+	binary, err := s.agentBinaries.Get(stream.Context(), req.Version, req.Os, req.Arch)
+	switch {
+	case errors.Is(err, ErrAgentBinaryNotFound):
+		return status.Errorf(codes.NotFound, "no agent %s binary for %s/%s", req.Version, req.Os, req.Arch)
+	case errors.Is(err, ErrInvalidAgentBinary):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed to load agent binary: %v", err)
+	}
+
+	f, err := s.agentBinaries.Open(binary)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to open agent binary: %v", err)
+	}
+	defer f.Close()
+
+	logrus.Infof("Sending agent %s binary for %s/%s to %s", binary.Version, binary.OS, binary.Arch, req.Uuid)
+
+	buf := make([]byte, agentBinaryChunkSize)
+	first := true
+	for {
+		n, readErr := f.Read(buf)
+		if n > 0 || first {
+			chunk := &proto.AgentBinaryChunk{Data: buf[:n]}
+			if first {
+				chunk.Size = binary.Size
+				chunk.Sha256 = binary.SHA256
+				chunk.Signature = binary.Signature
+				first = false
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return status.Errorf(codes.Internal, "failed to read agent binary: %v", readErr)
+		}
+	}
+}
+
 // ReportProbeResultBatch handles batch probe result reporting from Agents
 func (s *GRPCServer) ReportProbeResultBatch(ctx context.Context, req *proto.ReportProbeResultBatchRequest) (*proto.ReportProbeResultBatchResponse, error) {
 	// Validate request
@@ -125,6 +193,17 @@ func (s *GRPCServer) ReportProbeResultBatch(ctx context.Context, req *proto.Repo
 			ErrorMessage:     item.Result.ErrorMessage,
 			Data:             item.Result.HttpResponseBody,
 		}
+		for _, step := range item.Result.Steps {
+			report.Steps = append(report.Steps, models.ServiceProbeStepResult{
+				ServiceMonitorID: serviceMonitorID,
+				StepIndex:        int(step.Index),
+				Name:             step.Name,
+				Success:          step.Success,
+				Latency:          int(step.Latency),
+				HTTPStatusCode:   int(step.HttpStatusCode),
+				ErrorMessage:     step.ErrorMessage,
+			})
+		}
 
 		reports = append(reports, report)
 		processedCount++
@@ -109,6 +109,10 @@ func (s *HostService) DeleteHost(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
+	if err := s.agentMgr.RevokeCertificates(ctx, host.ID, models.CertRevokeHostDeleted); err != nil {
+		return err
+	}
+
 	// Disconnect the agent if online
 	if s.agentMgr.IsAgentOnline(host.ID.String()) {
 		s.agentMgr.DisconnectAgent(host.ID.String())
@@ -141,6 +145,12 @@ func (s *HostService) GetAgentInstallCommand(ctx context.Context, id uuid.UUID)
 		host.ID.String(),
 		host.SecretKey,
 	)
+	if s.agentMgr.AgentTLSEnabled() {
+		cmd += " --tls"
+		if pin := s.agentMgr.ServerPin(); pin != "" {
+			cmd += " --server-pin " + pin
+		}
+	}
 
 	return cmd, nil
 }
@@ -152,6 +162,11 @@ func (s *HostService) RegenerateSecretKey(ctx context.Context, id uuid.UUID) (st
 		return "", err
 	}
 
+	// Certificates were enrolled with the old key, the agent has to enrol again
+	if err := s.agentMgr.RevokeCertificates(ctx, host.ID, models.CertRevokeKeyRotated); err != nil {
+		return "", err
+	}
+
 	// Disconnect current agent if online
 	if s.agentMgr.IsAgentOnline(host.ID.String()) {
 		s.agentMgr.DisconnectAgent(host.ID.String())
@@ -182,8 +197,100 @@ func (s *HostService) GetHostState(ctx context.Context, id uuid.UUID) (*models.H
 	return s.hostRepo.GetLatestState(ctx, id)
 }
 
-// GetHostStateHistory retrieves historical states for a host
-func (s *HostService) GetHostStateHistory(ctx context.Context, id uuid.UUID, start, end string, interval string) ([]*models.HostState, error) {
+// GetHostStateHistory retrieves historical states for a host. Gauges are
+// the average, maximum or minimum (stat) over each interval.
+func (s *HostService) GetHostStateHistory(ctx context.Context, id uuid.UUID, start, end string, interval string, stat string) ([]*models.HostState, error) {
+	startTime, endTime, intervalSeconds := parseStateHistoryRange(start, end, interval)
+
+	// Read from the rollup tier matching the range and interval
+	states, err := s.stateCollector.QueryHistory(ctx, id, startTime, endTime, time.Duration(intervalSeconds)*time.Second, stat)
+	if err != nil {
+		logrus.Errorf("Failed to get host states by time range: %v", err)
+		// Fallback to latest states
+		return s.hostRepo.GetLatestStates(ctx, id, 100)
+	}
+
+	return states, nil
+}
+
+// GetHostStateDetails retrieves the partition, interface and process
+// breakdowns of a host, one state per interval
+func (s *HostService) GetHostStateDetails(ctx context.Context, id uuid.UUID, start, end string, interval string) ([]*models.HostState, error) {
+	startTime, endTime, intervalSeconds := parseStateHistoryRange(start, end, interval)
+	return s.hostRepo.GetStateDetailsByTimeRange(ctx, id, startTime, endTime, intervalSeconds)
+}
+
+// CustomMetricSeries is the history of a plugin metric with one label set
+type CustomMetricSeries struct {
+	Name   string              `json:"name"`
+	Labels models.JSONB        `json:"labels"`
+	Points []CustomMetricPoint `json:"points"`
+}
+
+// CustomMetricPoint is the average value of a plugin metric over an interval
+type CustomMetricPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// GetCustomMetricNames lists the plugin metrics a host reported in the last week
+func (s *HostService) GetCustomMetricNames(ctx context.Context, id uuid.UUID) ([]string, error) {
+	return s.hostRepo.GetCustomMetricNames(ctx, id, time.Now().Add(-7*24*time.Hour))
+}
+
+// GetCustomMetricHistory retrieves the history of a plugin metric, one
+// series per label set averaged over each interval
+func (s *HostService) GetCustomMetricHistory(ctx context.Context, id uuid.UUID, name, start, end string, interval string) ([]*CustomMetricSeries, error) {
+	startTime, endTime, intervalSeconds := parseStateHistoryRange(start, end, interval)
+	samples, err := s.hostRepo.GetCustomMetrics(ctx, id, name, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return groupCustomMetrics(samples, time.Duration(intervalSeconds)*time.Second), nil
+}
+
+// groupCustomMetrics groups samples ordered by time into series and averages
+// them per interval
+func groupCustomMetrics(samples []*models.HostCustomMetric, interval time.Duration) []*CustomMetricSeries {
+	type bucket struct {
+		start time.Time
+		sum   float64
+		count int
+	}
+
+	series := make([]*CustomMetricSeries, 0)
+	byLabels := make(map[string]int)
+	buckets := make(map[string][]*bucket)
+	for _, sample := range samples {
+		key := sample.Labels.String()
+		if _, ok := byLabels[key]; !ok {
+			byLabels[key] = len(series)
+			series = append(series, &CustomMetricSeries{Name: sample.Name, Labels: sample.Labels})
+		}
+
+		start := sample.Timestamp
+		if interval > 0 {
+			start = start.Truncate(interval)
+		}
+		list := buckets[key]
+		if n := len(list); n > 0 && list[n-1].start.Equal(start) {
+			list[n-1].sum += sample.Value
+			list[n-1].count++
+			continue
+		}
+		buckets[key] = append(list, &bucket{start: start, sum: sample.Value, count: 1})
+	}
+
+	for key, i := range byLabels {
+		for _, b := range buckets[key] {
+			series[i].Points = append(series[i].Points, CustomMetricPoint{Timestamp: b.start, Value: b.sum / float64(b.count)})
+		}
+	}
+	return series
+}
+
+// parseStateHistoryRange parses the time range and interval of a state history query
+func parseStateHistoryRange(start, end string, interval string) (time.Time, time.Time, int) {
 	// Parse time strings (frontend sends UTC time)
 	startTime, err := time.Parse(time.RFC3339, start)
 	if err != nil {
@@ -261,15 +368,7 @@ func (s *HostService) GetHostStateHistory(ctx context.Context, id uuid.UUID, sta
 		}
 	}
 
-	// Get states from repository with time range
-	states, err := s.hostRepo.GetStatesByTimeRange(ctx, id, startTime, endTime, intervalSeconds)
-	if err != nil {
-		logrus.Errorf("Failed to get host states by time range: %v", err)
-		// Fallback to latest states
-		return s.hostRepo.GetLatestStates(ctx, id, 100)
-	}
-
-	return states, nil
+	return startTime, endTime, intervalSeconds
 }
 
 // enrichHostWithRuntimeData adds online status and latest state to host
@@ -0,0 +1,40 @@
+package host
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+func TestGroupCustomMetrics(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	sample := func(offset time.Duration, queue string, value float64) *models.HostCustomMetric {
+		return &models.HostCustomMetric{
+			Name:      "queue_depth",
+			Timestamp: base.Add(offset),
+			Labels:    models.JSONB{"queue": queue, "plugin": "app"},
+			Value:     value,
+		}
+	}
+
+	series := groupCustomMetrics([]*models.HostCustomMetric{
+		sample(0, "mail", 10),
+		sample(0, "billing", 1),
+		sample(30*time.Second, "mail", 20),
+		sample(70*time.Second, "mail", 40),
+	}, time.Minute)
+
+	require.Len(t, series, 2)
+	assert.Equal(t, "mail", series[0].Labels["queue"])
+	assert.Equal(t, []CustomMetricPoint{
+		{Timestamp: base, Value: 15},
+		{Timestamp: base.Add(time.Minute), Value: 40},
+	}, series[0].Points)
+	assert.Equal(t, []CustomMetricPoint{{Timestamp: base, Value: 1}}, series[1].Points)
+
+	assert.Empty(t, groupCustomMetrics(nil, time.Minute))
+}
@@ -22,30 +22,45 @@ type StateSubscriber struct {
 	LastSent time.Time
 }
 
+// HostRuleEvaluator evaluates host alert rules against a reported state
+type HostRuleEvaluator interface {
+	EvaluateHostRules(ctx context.Context, hostID uuid.UUID, state *models.HostState) error
+}
+
 // StateCollector collects and distributes host monitoring states
 type StateCollector struct {
-	hostRepo    repository.HostRepository
-	agentMgr    *AgentManager
-	subscribers sync.Map // map[string]*StateSubscriber
-	mu          sync.RWMutex
+	hostRepo      repository.HostRepository
+	agentMgr      *AgentManager
+	ruleEvaluator HostRuleEvaluator
+	subscribers   sync.Map // map[string]*StateSubscriber
+	mu            sync.RWMutex
 
 	// State cache for quick access
 	latestStates sync.Map // map[uint]*models.HostState
 
-	// Data retention policy
-	retentionRealtime time.Duration // 24 hours
-	retentionShort    time.Duration // 7 days
-	retentionLong     time.Duration // 30 days
+	// Data retention policy, zero keeps data forever
+	retentionRaw   time.Duration
+	retentionTiers map[string]time.Duration // By rollup tier name
+
+	// Downsampling progress
+	rollupMu    sync.Mutex
+	progressMu  sync.Mutex
+	rolledUntil map[string]time.Time // End of the last rolled-up bucket by tier name
+	dirtyFrom   time.Time            // Oldest backfilled state not rolled up yet
 }
 
 // NewStateCollector creates a new StateCollector
 func NewStateCollector(hostRepo repository.HostRepository) *StateCollector {
 	sc := &StateCollector{
-		hostRepo:          hostRepo,
-		agentMgr:          nil, // Will be set later via SetAgentManager
-		retentionRealtime: 24 * time.Hour,
-		retentionShort:    7 * 24 * time.Hour,
-		retentionLong:     30 * 24 * time.Hour,
+		hostRepo:     hostRepo,
+		agentMgr:     nil, // Will be set later via SetAgentManager
+		retentionRaw: 48 * time.Hour,
+		retentionTiers: map[string]time.Duration{
+			models.RollupTierMinute.Name: 14 * 24 * time.Hour,
+			models.RollupTierHour.Name:   90 * 24 * time.Hour,
+			models.RollupTierDay.Name:    730 * 24 * time.Hour,
+		},
+		rolledUntil: make(map[string]time.Time),
 	}
 
 	// Start background tasks
@@ -60,8 +75,20 @@ func (sc *StateCollector) SetAgentManager(agentMgr *AgentManager) {
 	sc.agentMgr = agentMgr
 }
 
-// CollectState processes a new state report from an agent
+// SetRuleEvaluator evaluates host alert rules on every live state
+func (sc *StateCollector) SetRuleEvaluator(evaluator HostRuleEvaluator) {
+	sc.ruleEvaluator = evaluator
+}
+
+// CollectState processes a new state report from an agent. A state that is
+// not newer than the latest one, such as a sample replayed after an outage,
+// is stored as history without touching the live view.
 func (sc *StateCollector) CollectState(ctx context.Context, hostID uuid.UUID, state *models.HostState) error {
+	if latest, ok := sc.GetLatestState(hostID); ok && !state.Timestamp.After(latest.Timestamp) {
+		_, err := sc.CollectBackfill(ctx, hostID, []*models.HostState{state})
+		return err
+	}
+
 	// Sanitize state data to filter out unrealistic values
 	sanitizeState(state)
 
@@ -69,6 +96,7 @@ func (sc *StateCollector) CollectState(ctx context.Context, hostID uuid.UUID, st
 	if err := sc.hostRepo.SaveState(ctx, state); err != nil {
 		return err
 	}
+	sc.saveCustomMetrics(ctx, hostID, state)
 
 	// Update cache
 	sc.latestStates.Store(hostID, state)
@@ -86,9 +114,87 @@ func (sc *StateCollector) CollectState(ctx context.Context, hostID uuid.UUID, st
 	// Broadcast to subscribers
 	sc.broadcastState(state)
 
+	if sc.ruleEvaluator != nil {
+		if err := sc.ruleEvaluator.EvaluateHostRules(ctx, hostID, state); err != nil {
+			logrus.Warnf("[StateCollector] Failed to evaluate alert rules for host %s: %v", hostID.String(), err)
+		}
+	}
+
 	return nil
 }
 
+// CollectBackfill stores states an agent buffered while it was disconnected.
+// States whose timestamp is already stored for the host are skipped, so an
+// agent may replay the same samples more than once. It returns the states
+// that were stored.
+func (sc *StateCollector) CollectBackfill(ctx context.Context, hostID uuid.UUID, states []*models.HostState) ([]*models.HostState, error) {
+	if len(states) == 0 {
+		return nil, nil
+	}
+
+	start, end := states[0].Timestamp, states[0].Timestamp
+	for _, state := range states {
+		if state.Timestamp.Before(start) {
+			start = state.Timestamp
+		}
+		if state.Timestamp.After(end) {
+			end = state.Timestamp
+		}
+	}
+	existing, err := sc.hostRepo.GetStateTimestamps(ctx, hostID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int64]bool, len(existing)+len(states))
+	for _, ts := range existing {
+		seen[ts.UnixMilli()] = true
+	}
+	fresh := make([]*models.HostState, 0, len(states))
+	for _, state := range states {
+		ms := state.Timestamp.UnixMilli()
+		if seen[ms] {
+			continue
+		}
+		seen[ms] = true
+		sanitizeState(state)
+		fresh = append(fresh, state)
+	}
+
+	if err := sc.hostRepo.SaveStates(ctx, fresh); err != nil {
+		return nil, err
+	}
+	for _, state := range fresh {
+		sc.saveCustomMetrics(ctx, hostID, state)
+	}
+
+	if len(fresh) > 0 {
+		sc.markDirty(start)
+	}
+
+	logrus.Debugf("[StateCollector] Backfilled %d of %d states for host %s", len(fresh), len(states), hostID.String())
+	return fresh, nil
+}
+
+// saveCustomMetrics stores the plugin metrics reported with a state
+func (sc *StateCollector) saveCustomMetrics(ctx context.Context, hostID uuid.UUID, state *models.HostState) {
+	if len(state.CustomMetrics) == 0 {
+		return
+	}
+
+	metrics := make([]*models.HostCustomMetric, 0, len(state.CustomMetrics))
+	for i := range state.CustomMetrics {
+		metric := &state.CustomMetrics[i]
+		metric.HostNodeID = hostID
+		metric.Timestamp = state.Timestamp
+		metrics = append(metrics, metric)
+	}
+	if err := sc.hostRepo.SaveCustomMetrics(ctx, metrics); err != nil {
+		// The state itself is stored, keep going
+		logrus.Warnf("[StateCollector] Failed to save %d custom metrics for host %s: %v", len(metrics), hostID.String(), err)
+	}
+}
+
 // sanitizeState filters out unrealistic monitoring values
 func sanitizeState(state *models.HostState) {
 	const (
@@ -192,7 +298,8 @@ func (sc *StateCollector) containsHostID(hostIDs []uuid.UUID, targetID uuid.UUID
 	return false
 }
 
-// GetHistoricalStates retrieves historical states for a time range
+// GetHistoricalStates retrieves historical states for a time range, averaged
+// over the interval from the matching downsampling tier
 func (sc *StateCollector) GetHistoricalStates(ctx context.Context, hostID uuid.UUID, start, end time.Time, interval string) ([]*models.HostState, error) {
 	// Calculate interval in seconds
 	intervalSeconds := 0
@@ -233,19 +340,7 @@ func (sc *StateCollector) GetHistoricalStates(ctx context.Context, hostID uuid.U
 		}
 	}
 
-	states, err := sc.hostRepo.GetStatesByTimeRange(ctx, hostID, start, end, intervalSeconds)
-	if err != nil {
-		return nil, err
-	}
-
-	return states, nil
-}
-
-// aggregateStates aggregates states based on interval (simplified version)
-func (sc *StateCollector) aggregateStates(states []*models.HostState, interval string) []*models.HostState {
-	// TODO: Implement proper aggregation based on interval (1m, 5m, 1h, 1d)
-	// For now, return as-is or apply simple sampling
-	return states
+	return sc.QueryHistory(ctx, hostID, start, end, time.Duration(intervalSeconds)*time.Second, HistoryStatAvg)
 }
 
 // GetStateStatistics calculates statistics for a time period
@@ -316,24 +411,26 @@ func (sc *StateCollector) GetStateStatistics(ctx context.Context, hostID uuid.UU
 	return stats, nil
 }
 
-// startDataCleanup runs periodic cleanup of old monitoring data
+// startDataCleanup rolls host states up into the downsampling tiers every
+// minute and removes data past its retention every hour
 func (sc *StateCollector) startDataCleanup() {
-	ticker := time.NewTicker(1 * time.Hour)
+	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		sc.cleanupOldData()
+	var lastCleanup time.Time
+	for now := range ticker.C {
+		ctx := context.Background()
+		if err := sc.rollup(ctx, now); err != nil {
+			logrus.Warnf("[StateCollector] Failed to roll up host states: %v", err)
+			continue
+		}
+		if now.Sub(lastCleanup) >= time.Hour {
+			sc.cleanupOldData(ctx, now)
+			lastCleanup = now
+		}
 	}
 }
 
-// cleanupOldData removes old states according to retention policy
-func (sc *StateCollector) cleanupOldData() {
-	// TODO: Implement data cleanup based on retention policy
-	// - Delete raw data older than 24 hours
-	// - Aggregate and keep 1-minute averages for 7 days
-	// - Aggregate and keep hourly averages for 30 days
-}
-
 // monitorSubscribers monitors subscriber health and removes stale ones
 func (sc *StateCollector) monitorSubscribers() {
 	ticker := time.NewTicker(5 * time.Minute)
@@ -0,0 +1,137 @@
+package host
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func newStateCollectorTestDB(t *testing.T) *gorm.DB {
+	db := testdb.Open(t, &models.HostState{}, &models.HostStateMinute{}, &models.HostStateHour{}, &models.HostStateDay{}, &models.HostCustomMetric{})
+	return db
+}
+
+func countStates(t *testing.T, db *gorm.DB, hostID uuid.UUID) int64 {
+	var count int64
+	require.NoError(t, db.Model(&models.HostState{}).Where("host_node_id = ?", hostID).Count(&count).Error)
+	return count
+}
+
+func TestStateCollector_CollectBackfill(t *testing.T) {
+	db := newStateCollectorTestDB(t)
+	sc := NewStateCollector(repository.NewHostRepository(db))
+	ctx := context.Background()
+	hostID := uuid.New()
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	states := func(offsets ...int) []*models.HostState {
+		var result []*models.HostState
+		for _, offset := range offsets {
+			result = append(result, &models.HostState{
+				HostNodeID:       hostID,
+				Timestamp:        base.Add(time.Duration(offset) * 10 * time.Second),
+				CPUUsage:         150, // Sanitized to 0
+				TrafficDeltaSent: 100,
+			})
+		}
+		return result
+	}
+
+	stored, err := sc.CollectBackfill(ctx, hostID, states(0, 1, 2))
+	require.NoError(t, err)
+	assert.Len(t, stored, 3)
+	assert.Zero(t, stored[0].CPUUsage)
+
+	// Replaying the same samples again, with a duplicate inside the batch,
+	// only stores the new ones
+	stored, err = sc.CollectBackfill(ctx, hostID, states(1, 2, 3, 3, 4))
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	assert.Equal(t, base.Add(30*time.Second).UnixMilli(), stored[0].Timestamp.UnixMilli())
+	assert.Equal(t, int64(5), countStates(t, db, hostID))
+
+	// Backfill neither updates the live view nor touches other hosts
+	_, ok := sc.GetLatestState(hostID)
+	assert.False(t, ok)
+	other := uuid.New()
+	stored, err = sc.CollectBackfill(ctx, other, []*models.HostState{{HostNodeID: other, Timestamp: base}})
+	require.NoError(t, err)
+	assert.Len(t, stored, 1)
+}
+
+func TestStateCollector_CollectStateRoutesStaleStates(t *testing.T) {
+	db := newStateCollectorTestDB(t)
+	sc := NewStateCollector(repository.NewHostRepository(db))
+	ctx := context.Background()
+	hostID := uuid.New()
+	now := time.Now().Truncate(time.Second)
+
+	latest := &models.HostState{HostNodeID: hostID, Timestamp: now, CPUUsage: 10}
+	require.NoError(t, sc.CollectState(ctx, hostID, latest))
+
+	// An older state and a repeated one are stored once, the live view keeps the latest
+	require.NoError(t, sc.CollectState(ctx, hostID, &models.HostState{HostNodeID: hostID, Timestamp: now.Add(-time.Minute), CPUUsage: 20}))
+	require.NoError(t, sc.CollectState(ctx, hostID, &models.HostState{HostNodeID: hostID, Timestamp: now, CPUUsage: 30}))
+	assert.Equal(t, int64(2), countStates(t, db, hostID))
+
+	got, ok := sc.GetLatestState(hostID)
+	require.True(t, ok)
+	assert.Equal(t, 10.0, got.CPUUsage)
+}
+
+type recordingEvaluator struct {
+	states []*models.HostState
+}
+
+func (e *recordingEvaluator) EvaluateHostRules(_ context.Context, _ uuid.UUID, state *models.HostState) error {
+	e.states = append(e.states, state)
+	return nil
+}
+
+func TestStateCollector_CustomMetrics(t *testing.T) {
+	db := newStateCollectorTestDB(t)
+	repo := repository.NewHostRepository(db)
+	sc := NewStateCollector(repo)
+	evaluator := &recordingEvaluator{}
+	sc.SetRuleEvaluator(evaluator)
+	ctx := context.Background()
+	hostID := uuid.New()
+	now := time.Now().Truncate(time.Second)
+
+	withMetrics := func(ts time.Time, value float64) *models.HostState {
+		return &models.HostState{
+			HostNodeID: hostID,
+			Timestamp:  ts,
+			CustomMetrics: []models.HostCustomMetric{
+				{Name: "queue_depth", Labels: models.JSONB{"queue": "mail", "plugin": "app"}, Value: value},
+				{Name: "backup_age_seconds", Labels: models.JSONB{"plugin": "backup"}, Value: 3600},
+			},
+		}
+	}
+
+	require.NoError(t, sc.CollectState(ctx, hostID, withMetrics(now, 12)))
+	// Backfilled states store their metrics but are not evaluated
+	_, err := sc.CollectBackfill(ctx, hostID, []*models.HostState{withMetrics(now.Add(-time.Minute), 3)})
+	require.NoError(t, err)
+	assert.Len(t, evaluator.states, 1)
+
+	samples, err := repo.GetCustomMetrics(ctx, hostID, "queue_depth", now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, 3.0, samples[0].Value)
+	assert.Equal(t, "mail", samples[1].Labels["queue"])
+	assert.Equal(t, now.UnixMilli(), samples[1].Timestamp.UnixMilli())
+
+	names, err := repo.GetCustomMetricNames(ctx, hostID, now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup_age_seconds", "queue_depth"}, names)
+}
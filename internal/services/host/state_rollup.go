@@ -0,0 +1,356 @@
+package host
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+)
+
+const (
+	// stateRollupDelay leaves a bucket open for states reported late
+	stateRollupDelay = 2 * time.Minute
+
+	// HistoryStatAvg, HistoryStatMax and HistoryStatMin select the value
+	// of gauges returned for a history interval
+	HistoryStatAvg = "avg"
+	HistoryStatMax = "max"
+	HistoryStatMin = "min"
+)
+
+// rollupBatch bounds the source range aggregated at once for each tier
+var rollupBatch = map[string]time.Duration{
+	models.RollupTierMinute.Name: time.Hour,
+	models.RollupTierHour.Name:   24 * time.Hour,
+	models.RollupTierDay.Name:    30 * 24 * time.Hour,
+}
+
+// SetRetention sets the retention of raw states and of each rollup tier
+func (sc *StateCollector) SetRetention(cfg config.HostStateRetentionConfig) {
+	retention := func(v int, unit time.Duration) time.Duration {
+		if v < 0 {
+			return 0
+		}
+		return time.Duration(v) * unit
+	}
+
+	sc.retentionRaw = retention(cfg.RawHours, time.Hour)
+	sc.retentionTiers = map[string]time.Duration{
+		models.RollupTierMinute.Name: retention(cfg.MinuteDays, 24*time.Hour),
+		models.RollupTierHour.Name:   retention(cfg.HourDays, 24*time.Hour),
+		models.RollupTierDay.Name:    retention(cfg.DayDays, 24*time.Hour),
+	}
+}
+
+// markDirty schedules the buckets from the given time on to be rolled up
+// again, as backfilled states were stored in them
+func (sc *StateCollector) markDirty(from time.Time) {
+	sc.progressMu.Lock()
+	defer sc.progressMu.Unlock()
+	if sc.dirtyFrom.IsZero() || from.Before(sc.dirtyFrom) {
+		sc.dirtyFrom = from
+	}
+}
+
+// rollup aggregates the closed buckets of each tier from the tier below it:
+// raw states into 1-minute buckets, 1-minute into 1-hour and 1-hour into
+// 1-day buckets
+func (sc *StateCollector) rollup(ctx context.Context, now time.Time) error {
+	sc.rollupMu.Lock()
+	defer sc.rollupMu.Unlock()
+
+	sc.progressMu.Lock()
+	dirty := sc.dirtyFrom
+	sc.dirtyFrom = time.Time{}
+	sc.progressMu.Unlock()
+
+	// Raw states past their retention may be gone already, rebuilding their
+	// buckets would lose data
+	if sc.retentionRaw > 0 && !dirty.IsZero() && dirty.Before(now.Add(-sc.retentionRaw)) {
+		dirty = now.Add(-sc.retentionRaw)
+	}
+
+	for i, tier := range models.RollupTiers {
+		closed := now.Add(-stateRollupDelay).Truncate(tier.Resolution)
+
+		from, err := sc.rollupStart(ctx, i)
+		if err != nil {
+			sc.markDirty(dirty)
+			return err
+		}
+		if from.IsZero() {
+			// Nothing to roll up yet
+			continue
+		}
+		if !dirty.IsZero() && dirty.Before(from) {
+			from = dirty.Truncate(tier.Resolution)
+		}
+
+		for from.Before(closed) {
+			to := from.Add(rollupBatch[tier.Name])
+			if to.After(closed) {
+				to = closed
+			}
+			if err := sc.rollupRange(ctx, i, from, to); err != nil {
+				sc.markDirty(dirty)
+				return err
+			}
+			from = to
+			sc.setRolledUntil(tier, to)
+		}
+	}
+	return nil
+}
+
+// rollupStart returns the start of the first bucket of a tier to roll up,
+// or the zero time when its source holds no data
+func (sc *StateCollector) rollupStart(ctx context.Context, tierIndex int) (time.Time, error) {
+	tier := models.RollupTiers[tierIndex]
+	if until, err := sc.getRolledUntil(ctx, tier); err != nil || !until.IsZero() {
+		return until, err
+	}
+
+	// First run: start with the oldest source data
+	var oldest time.Time
+	var err error
+	if tierIndex == 0 {
+		oldest, err = sc.hostRepo.GetEarliestStateTime(ctx)
+	} else {
+		oldest, _, err = sc.hostRepo.GetRollupTimeBounds(ctx, models.RollupTiers[tierIndex-1])
+	}
+	if err != nil || oldest.IsZero() {
+		return time.Time{}, err
+	}
+	return oldest.Truncate(tier.Resolution), nil
+}
+
+// rollupRange rebuilds the buckets of a tier within [from, to)
+func (sc *StateCollector) rollupRange(ctx context.Context, tierIndex int, from, to time.Time) error {
+	tier := models.RollupTiers[tierIndex]
+
+	type bucketKey struct {
+		hostID uuid.UUID
+		start  time.Time
+	}
+	buckets := make(map[bucketKey]*models.HostStateRollup)
+	var order []bucketKey
+	bucket := func(hostID uuid.UUID, ts time.Time) *models.HostStateRollup {
+		key := bucketKey{hostID: hostID, start: ts.Truncate(tier.Resolution)}
+		b, ok := buckets[key]
+		if !ok {
+			b = &models.HostStateRollup{HostNodeID: hostID, Timestamp: key.start}
+			buckets[key] = b
+			order = append(order, key)
+		}
+		return b
+	}
+
+	if tierIndex == 0 {
+		states, err := sc.hostRepo.ListStates(ctx, from, to)
+		if err != nil {
+			return err
+		}
+		for _, state := range states {
+			bucket(state.HostNodeID, state.Timestamp).Add(state)
+		}
+	} else {
+		sources, err := sc.hostRepo.GetRollups(ctx, models.RollupTiers[tierIndex-1], nil, from, to)
+		if err != nil {
+			return err
+		}
+		for _, source := range sources {
+			bucket(source.HostNodeID, source.Timestamp).Merge(&source.HostStateAggregate)
+		}
+	}
+
+	rollups := make([]*models.HostStateRollup, 0, len(order))
+	for _, key := range order {
+		rollups = append(rollups, buckets[key])
+	}
+	if err := sc.hostRepo.ReplaceRollups(ctx, tier, from, to, rollups); err != nil {
+		return err
+	}
+	if len(rollups) > 0 {
+		logrus.Debugf("[StateCollector] Rolled up %d %s buckets from %s", len(rollups), tier.Name, from.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// getRolledUntil returns the end of the last rolled-up bucket of a tier, or
+// the zero time when the tier is empty
+func (sc *StateCollector) getRolledUntil(ctx context.Context, tier models.RollupTier) (time.Time, error) {
+	sc.progressMu.Lock()
+	until, ok := sc.rolledUntil[tier.Name]
+	sc.progressMu.Unlock()
+	if ok {
+		return until, nil
+	}
+
+	_, newest, err := sc.hostRepo.GetRollupTimeBounds(ctx, tier)
+	if err != nil || newest.IsZero() {
+		return time.Time{}, err
+	}
+	until = newest.Add(tier.Resolution)
+	sc.setRolledUntil(tier, until)
+	return until, nil
+}
+
+func (sc *StateCollector) setRolledUntil(tier models.RollupTier, until time.Time) {
+	sc.progressMu.Lock()
+	defer sc.progressMu.Unlock()
+	sc.rolledUntil[tier.Name] = until
+}
+
+// cleanupOldData removes raw states and rollups past their retention. Data
+// is only removed once the next tier holds its aggregate.
+func (sc *StateCollector) cleanupOldData(ctx context.Context, now time.Time) {
+	cutoff := func(retention time.Duration, next *models.RollupTier) (time.Time, bool) {
+		if retention <= 0 {
+			return time.Time{}, false
+		}
+		before := now.Add(-retention)
+		if next != nil {
+			until, err := sc.getRolledUntil(ctx, *next)
+			if err != nil || until.IsZero() {
+				return time.Time{}, false
+			}
+			if until.Before(before) {
+				before = until
+			}
+		}
+		return before, true
+	}
+
+	if before, ok := cutoff(sc.retentionRaw, &models.RollupTiers[0]); ok {
+		if deleted, err := sc.hostRepo.DeleteStatesBefore(ctx, before); err != nil {
+			logrus.Warnf("[StateCollector] Failed to delete old host states: %v", err)
+		} else if deleted > 0 {
+			logrus.Infof("[StateCollector] Deleted %d host states older than %s", deleted, before.Format(time.RFC3339))
+		}
+	}
+
+	for i, tier := range models.RollupTiers {
+		var next *models.RollupTier
+		if i+1 < len(models.RollupTiers) {
+			next = &models.RollupTiers[i+1]
+		}
+		before, ok := cutoff(sc.retentionTiers[tier.Name], next)
+		if !ok {
+			continue
+		}
+		if deleted, err := sc.hostRepo.DeleteRollupsBefore(ctx, tier, before); err != nil {
+			logrus.Warnf("[StateCollector] Failed to delete old %s rollups: %v", tier.Name, err)
+		} else if deleted > 0 {
+			logrus.Infof("[StateCollector] Deleted %d %s rollups older than %s", deleted, tier.Name, before.Format(time.RFC3339))
+		}
+	}
+}
+
+// QueryHistory returns the states of a host within [start, end], one per
+// step. The data is read from the coarsest tier not coarser than the step
+// that still holds start; the part of the range not rolled up yet is read
+// from the finer tiers. stat selects the average, maximum or minimum of the
+// gauges over each step. A zero step returns the raw states.
+func (sc *StateCollector) QueryHistory(ctx context.Context, hostID uuid.UUID, start, end time.Time, step time.Duration, stat string) ([]*models.HostState, error) {
+	if step <= 0 {
+		return sc.hostRepo.GetStatesByTimeRange(ctx, hostID, start, end, 0)
+	}
+
+	aggregates, err := sc.loadHistory(ctx, hostID, sc.selectTier(start, step, time.Now()), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-bucket to the requested step
+	var merged []*models.HostStateRollup
+	for _, a := range aggregates {
+		bucketStart := a.Timestamp.Truncate(step)
+		if n := len(merged); n > 0 && merged[n-1].Timestamp.Equal(bucketStart) {
+			merged[n-1].Merge(&a.HostStateAggregate)
+			continue
+		}
+		a.Timestamp = bucketStart
+		merged = append(merged, a)
+	}
+
+	states := make([]*models.HostState, 0, len(merged))
+	for _, m := range merged {
+		states = append(states, m.ToHostState(stat))
+	}
+	return states, nil
+}
+
+// selectTier returns the index of the tier to read a history from, -1 for
+// raw states
+func (sc *StateCollector) selectTier(start time.Time, step time.Duration, now time.Time) int {
+	holds := func(retention time.Duration) bool {
+		return retention <= 0 || !start.Before(now.Add(-retention))
+	}
+
+	tier := -1
+	for i, t := range models.RollupTiers {
+		if t.Resolution <= step {
+			tier = i
+		}
+	}
+	for ; tier < len(models.RollupTiers)-1; tier++ {
+		if tier < 0 && holds(sc.retentionRaw) {
+			break
+		}
+		if tier >= 0 && holds(sc.retentionTiers[models.RollupTiers[tier].Name]) {
+			break
+		}
+	}
+	return tier
+}
+
+// loadHistory reads [start, end] from a tier, completing the part not
+// rolled up yet from the finer tiers
+func (sc *StateCollector) loadHistory(ctx context.Context, hostID uuid.UUID, tierIndex int, start, end time.Time) ([]*models.HostStateRollup, error) {
+	if start.After(end) {
+		return nil, nil
+	}
+
+	if tierIndex < 0 {
+		states, err := sc.hostRepo.GetStatesByTimeRange(ctx, hostID, start, end, 0)
+		if err != nil {
+			return nil, err
+		}
+		aggregates := make([]*models.HostStateRollup, 0, len(states))
+		for _, state := range states {
+			a := &models.HostStateRollup{HostNodeID: hostID, Timestamp: state.Timestamp}
+			a.Add(state)
+			aggregates = append(aggregates, a)
+		}
+		return aggregates, nil
+	}
+
+	tier := models.RollupTiers[tierIndex]
+	until, err := sc.getRolledUntil(ctx, tier)
+	if err != nil {
+		return nil, err
+	}
+	if until.IsZero() || !until.After(start) {
+		return sc.loadHistory(ctx, hostID, tierIndex-1, start, end)
+	}
+	if until.After(end) {
+		until = end.Add(time.Nanosecond)
+	}
+
+	aggregates, err := sc.hostRepo.GetRollups(ctx, tier, &hostID, start.Truncate(tier.Resolution), until)
+	if err != nil {
+		return nil, err
+	}
+	rest, err := sc.loadHistory(ctx, hostID, tierIndex-1, until, end)
+	if err != nil {
+		return nil, err
+	}
+	aggregates = append(aggregates, rest...)
+	sort.SliceStable(aggregates, func(i, j int) bool { return aggregates[i].Timestamp.Before(aggregates[j].Timestamp) })
+	return aggregates, nil
+}
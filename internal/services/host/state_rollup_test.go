@@ -0,0 +1,117 @@
+package host
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+func countRollups(t *testing.T, db *gorm.DB, tier models.RollupTier) int64 {
+	var count int64
+	require.NoError(t, db.Table(tier.Table).Count(&count).Error)
+	return count
+}
+
+func TestStateCollector_Rollup(t *testing.T) {
+	db := newStateCollectorTestDB(t)
+	sc := NewStateCollector(repository.NewHostRepository(db))
+	sc.SetRetention(config.HostStateRetentionConfig{RawHours: -1, MinuteDays: -1, HourDays: -1, DayDays: -1})
+	ctx := context.Background()
+	hostID := uuid.New()
+
+	// Two hours of 10-second states, the CPU usage cycling from 0 to 50
+	// within each minute
+	now := time.Date(2026, 1, 10, 1, 0, 0, 0, time.UTC).Local()
+	start := now.Add(-3 * time.Hour)
+	var states []*models.HostState
+	for ts := start; ts.Before(now.Add(-time.Hour)); ts = ts.Add(10 * time.Second) {
+		states = append(states, &models.HostState{
+			HostNodeID:       hostID,
+			Timestamp:        ts,
+			CPUUsage:         float64(ts.Second()),
+			NetInTransfer:    uint64(ts.Sub(start).Seconds()),
+			TrafficDeltaSent: 10,
+		})
+	}
+	require.NoError(t, db.CreateInBatches(states, 200).Error)
+
+	require.NoError(t, sc.rollup(ctx, now))
+	assert.Equal(t, int64(120), countRollups(t, db, models.RollupTierMinute))
+	assert.Equal(t, int64(2), countRollups(t, db, models.RollupTierHour))
+	assert.Equal(t, int64(1), countRollups(t, db, models.RollupTierDay))
+
+	hours, err := sc.hostRepo.GetRollups(ctx, models.RollupTierHour, &hostID, start, now)
+	require.NoError(t, err)
+	require.Len(t, hours, 2)
+	assert.Equal(t, 360, hours[0].Samples)
+	assert.InDelta(t, 25, hours[0].CPUUsage, 0.001)
+	assert.Equal(t, 50.0, hours[0].CPUUsageMax)
+	assert.Equal(t, 0.0, hours[0].CPUUsageMin)
+	assert.Equal(t, uint64(3600), hours[0].TrafficDeltaSent)
+	assert.Equal(t, uint64(3590), hours[0].NetInTransfer)
+
+	// Rolling up again without new data changes nothing
+	require.NoError(t, sc.rollup(ctx, now))
+	assert.Equal(t, int64(120), countRollups(t, db, models.RollupTierMinute))
+
+	// A state reported after the last closed minute is read from the raw table
+	require.NoError(t, db.Create(&models.HostState{HostNodeID: hostID, Timestamp: now.Add(-time.Minute), CPUUsage: 80}).Error)
+
+	history, err := sc.QueryHistory(ctx, hostID, start, now, time.Hour, HistoryStatAvg)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.InDelta(t, 25, history[0].CPUUsage, 0.001)
+	assert.Equal(t, 80.0, history[2].CPUUsage)
+
+	history, err = sc.QueryHistory(ctx, hostID, start, now.Add(-time.Hour), 5*time.Minute, HistoryStatMax)
+	require.NoError(t, err)
+	require.Len(t, history, 24)
+	assert.Equal(t, 50.0, history[0].CPUUsage)
+	assert.True(t, history[1].Timestamp.Equal(start.Add(5*time.Minute)))
+
+	// Backfilled states rebuild the buckets they fall in, in every tier
+	_, err = sc.CollectBackfill(ctx, hostID, []*models.HostState{{HostNodeID: hostID, Timestamp: start.Add(5 * time.Second), CPUUsage: 100}})
+	require.NoError(t, err)
+	require.NoError(t, sc.rollup(ctx, now))
+
+	history, err = sc.QueryHistory(ctx, hostID, start, now.Add(-time.Hour), 24*time.Hour, HistoryStatMax)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, 100.0, history[0].CPUUsage)
+	days, err := sc.hostRepo.GetRollups(ctx, models.RollupTierDay, &hostID, start.Add(-24*time.Hour), now)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, 721, days[0].Samples)
+
+	// Retention removes raw states and minutes already held by the next tier
+	sc.SetRetention(config.HostStateRetentionConfig{RawHours: 1, MinuteDays: 1, HourDays: -1, DayDays: -1})
+	sc.cleanupOldData(ctx, now.Add(48*time.Hour))
+	assert.Equal(t, int64(1), countStates(t, db, hostID))
+	assert.Zero(t, countRollups(t, db, models.RollupTierMinute))
+	assert.Equal(t, int64(2), countRollups(t, db, models.RollupTierHour))
+}
+
+func TestStateCollector_SelectTier(t *testing.T) {
+	sc := NewStateCollector(nil)
+	sc.SetRetention(config.HostStateRetentionConfig{RawHours: 48, MinuteDays: 14, HourDays: 90, DayDays: -1})
+	now := time.Now()
+
+	assert.Equal(t, -1, sc.selectTier(now.Add(-time.Hour), 30*time.Second, now))
+	assert.Equal(t, 0, sc.selectTier(now.Add(-time.Hour), 5*time.Minute, now))
+	assert.Equal(t, 1, sc.selectTier(now.Add(-7*24*time.Hour), 3*time.Hour, now))
+	assert.Equal(t, 2, sc.selectTier(now.Add(-7*24*time.Hour), 24*time.Hour, now))
+
+	// Ranges past the retention of a tier are read from a coarser one
+	assert.Equal(t, 0, sc.selectTier(now.Add(-72*time.Hour), 30*time.Second, now))
+	assert.Equal(t, 1, sc.selectTier(now.Add(-30*24*time.Hour), time.Minute, now))
+	assert.Equal(t, 2, sc.selectTier(now.Add(-365*24*time.Hour), time.Hour, now))
+}
@@ -0,0 +1,108 @@
+package imagescan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/registry"
+	"github.com/ysicing/tiga/pkg/utils"
+)
+
+// GrypeScanner scans images with the Grype CLI. Grype only reports the
+// vulnerable packages, so no package inventory is recorded.
+type GrypeScanner struct {
+	binary string
+	run    commandRunner
+}
+
+// NewGrypeScanner creates a Grype scanner. An empty binary uses grype from
+// PATH.
+func NewGrypeScanner(binary string) *GrypeScanner {
+	if binary == "" {
+		binary = "grype"
+	}
+	return &GrypeScanner{binary: binary, run: runCommand}
+}
+
+// Name returns the scanner name
+func (s *GrypeScanner) Name() string {
+	return "grype"
+}
+
+// grypeReport is the part of the Grype JSON report used here
+type grypeReport struct {
+	Matches []struct {
+		Vulnerability struct {
+			ID          string `json:"id"`
+			Severity    string `json:"severity"`
+			Description string `json:"description"`
+			Fix         struct {
+				Versions []string `json:"versions"`
+			} `json:"fix"`
+		} `json:"vulnerability"`
+		Artifact struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"artifact"`
+	} `json:"matches"`
+	Source struct {
+		Target struct {
+			ManifestDigest string   `json:"manifestDigest"`
+			RepoDigests    []string `json:"repoDigests"`
+		} `json:"target"`
+	} `json:"source"`
+}
+
+// Scan scans an image from its registry
+func (s *GrypeScanner) Scan(ctx context.Context, image string, creds *Credentials) (*Report, error) {
+	var env []string
+	if creds != nil {
+		host, _ := utils.GetImageRegistryAndRepo(image)
+		env = append(env,
+			"GRYPE_REGISTRY_AUTH_AUTHORITY="+registryAuthority(host),
+			"GRYPE_REGISTRY_AUTH_USERNAME="+creds.Username,
+			"GRYPE_REGISTRY_AUTH_PASSWORD="+creds.Password,
+		)
+	}
+
+	out, err := s.run(ctx, env, s.binary, "registry:"+image, "--output", "json", "--quiet")
+	if err != nil {
+		return nil, err
+	}
+	return parseGrypeReport(out)
+}
+
+// registryAuthority returns the registry host Grype matches credentials to
+func registryAuthority(host string) string {
+	if host = registry.NormalizeHost(host); host == registry.DockerHub {
+		return "index.docker.io"
+	}
+	return host
+}
+
+func parseGrypeReport(data []byte) (*Report, error) {
+	var raw grypeReport
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode grype report: %w", err)
+	}
+
+	report := &Report{Digest: raw.Source.Target.ManifestDigest}
+	if len(raw.Source.Target.RepoDigests) > 0 {
+		report.Digest = digestOf(raw.Source.Target.RepoDigests[0])
+	}
+
+	for _, m := range raw.Matches {
+		report.Vulnerabilities = append(report.Vulnerabilities, models.ImageVulnerability{
+			ID:               m.Vulnerability.ID,
+			Package:          m.Artifact.Name,
+			InstalledVersion: m.Artifact.Version,
+			FixedVersion:     strings.Join(m.Vulnerability.Fix.Versions, ", "),
+			Severity:         normalizeSeverity(m.Vulnerability.Severity),
+			Title:            m.Vulnerability.Description,
+		})
+	}
+	return report, nil
+}
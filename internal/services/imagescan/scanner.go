@@ -0,0 +1,96 @@
+// Package imagescan scans container images for vulnerabilities with an
+// external scanner such as Trivy or Grype and caches the results by digest.
+package imagescan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// Credentials are the registry login used to pull an image for scanning
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Report is the result of scanning one image
+type Report struct {
+	Digest          string // Manifest digest of the scanned image, when known
+	Vulnerabilities []models.ImageVulnerability
+	Packages        []models.ImagePackage
+}
+
+// Scanner scans a container image reference
+type Scanner interface {
+	// Name returns the scanner name, e.g. trivy
+	Name() string
+	// Scan pulls the image from its registry and scans it. creds may be nil.
+	Scan(ctx context.Context, image string, creds *Credentials) (*Report, error)
+}
+
+// commandRunner runs a scanner binary and returns its standard output;
+// replaced in tests
+type commandRunner func(ctx context.Context, env []string, name string, args ...string) ([]byte, error)
+
+func runCommand(ctx context.Context, env []string, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(os.Environ(), env...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > 1024 {
+			msg = msg[len(msg)-1024:]
+		}
+		return nil, fmt.Errorf("%s failed: %w: %s", name, err, msg)
+	}
+	return stdout.Bytes(), nil
+}
+
+// NewScanner creates the scanner selected by the configuration, nil when
+// scanning is disabled
+func NewScanner(cfg config.ImageScanConfig) (Scanner, error) {
+	switch strings.ToLower(cfg.Scanner) {
+	case "":
+		return nil, nil
+	case "trivy":
+		return NewTrivyScanner(cfg.BinaryPath, cfg.ServerURL), nil
+	case "grype":
+		if cfg.ServerURL != "" {
+			return nil, fmt.Errorf("grype does not support a scan server")
+		}
+		return NewGrypeScanner(cfg.BinaryPath), nil
+	default:
+		return nil, fmt.Errorf("unsupported image scanner %q", cfg.Scanner)
+	}
+}
+
+// normalizeSeverity maps scanner severities to the model severities
+func normalizeSeverity(severity string) string {
+	switch s := strings.ToUpper(severity); s {
+	case models.VulnerabilitySeverityCritical, models.VulnerabilitySeverityHigh,
+		models.VulnerabilitySeverityMedium, models.VulnerabilitySeverityLow:
+		return s
+	case "NEGLIGIBLE":
+		return models.VulnerabilitySeverityLow
+	default:
+		return models.VulnerabilitySeverityUnknown
+	}
+}
+
+// digestOf extracts the digest of a repo digest such as nginx@sha256:...
+func digestOf(repoDigest string) string {
+	if i := strings.LastIndex(repoDigest, "@"); i >= 0 {
+		return repoDigest[i+1:]
+	}
+	return repoDigest
+}
@@ -0,0 +1,110 @@
+package imagescan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+)
+
+const trivyOutput = `{
+  "Metadata": {
+    "ImageID": "sha256:cfg",
+    "RepoDigests": ["nginx@sha256:aaa"]
+  },
+  "Results": [
+    {
+      "Target": "nginx (debian 12.5)",
+      "Type": "debian",
+      "Packages": [{"Name": "openssl", "Version": "3.0.11"}, {"Name": "zlib", "Version": "1.2.13"}],
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2024-0001", "PkgName": "openssl", "InstalledVersion": "3.0.11", "FixedVersion": "3.0.13", "Severity": "CRITICAL", "Title": "overflow"},
+        {"VulnerabilityID": "CVE-2024-0002", "PkgName": "zlib", "InstalledVersion": "1.2.13", "Severity": "medium"}
+      ]
+    }
+  ]
+}`
+
+const grypeOutput = `{
+  "matches": [
+    {
+      "vulnerability": {"id": "CVE-2024-0001", "severity": "Critical", "description": "overflow", "fix": {"versions": ["3.0.13", "3.1.5"]}},
+      "artifact": {"name": "openssl", "version": "3.0.11"}
+    },
+    {
+      "vulnerability": {"id": "CVE-2024-0003", "severity": "Negligible", "fix": {"versions": []}},
+      "artifact": {"name": "tar", "version": "1.34"}
+    }
+  ],
+  "source": {"target": {"manifestDigest": "sha256:manifest", "repoDigests": ["index.docker.io/library/nginx@sha256:aaa"]}}
+}`
+
+func TestTrivyScanner_Scan(t *testing.T) {
+	scanner := NewTrivyScanner("", "http://trivy:4954")
+	var gotEnv, gotArgs []string
+	scanner.run = func(ctx context.Context, env []string, name string, args ...string) ([]byte, error) {
+		assert.Equal(t, "trivy", name)
+		gotEnv, gotArgs = env, args
+		return []byte(trivyOutput), nil
+	}
+
+	report, err := scanner.Scan(context.Background(), "nginx:1.27", &Credentials{Username: "bot", Password: "secret"})
+	require.NoError(t, err)
+	assert.Contains(t, gotArgs, "--server")
+	assert.Equal(t, "nginx:1.27", gotArgs[len(gotArgs)-1])
+	assert.Equal(t, []string{"TRIVY_USERNAME=bot", "TRIVY_PASSWORD=secret"}, gotEnv)
+
+	assert.Equal(t, "sha256:aaa", report.Digest)
+	require.Len(t, report.Vulnerabilities, 2)
+	assert.Equal(t, models.ImageVulnerability{
+		ID: "CVE-2024-0001", Package: "openssl", InstalledVersion: "3.0.11", FixedVersion: "3.0.13",
+		Severity: models.VulnerabilitySeverityCritical, Title: "overflow",
+	}, report.Vulnerabilities[0])
+	assert.Equal(t, models.VulnerabilitySeverityMedium, report.Vulnerabilities[1].Severity)
+	assert.Equal(t, []models.ImagePackage{
+		{Name: "openssl", Version: "3.0.11", Type: "debian"},
+		{Name: "zlib", Version: "1.2.13", Type: "debian"},
+	}, report.Packages)
+}
+
+func TestGrypeScanner_Scan(t *testing.T) {
+	scanner := NewGrypeScanner("/usr/local/bin/grype")
+	var gotEnv, gotArgs []string
+	scanner.run = func(ctx context.Context, env []string, name string, args ...string) ([]byte, error) {
+		assert.Equal(t, "/usr/local/bin/grype", name)
+		gotEnv, gotArgs = env, args
+		return []byte(grypeOutput), nil
+	}
+
+	report, err := scanner.Scan(context.Background(), "nginx:1.27", &Credentials{Username: "bot", Password: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "registry:nginx:1.27", gotArgs[0])
+	assert.Contains(t, gotEnv, "GRYPE_REGISTRY_AUTH_AUTHORITY=index.docker.io")
+
+	assert.Equal(t, "sha256:aaa", report.Digest)
+	require.Len(t, report.Vulnerabilities, 2)
+	assert.Equal(t, models.VulnerabilitySeverityCritical, report.Vulnerabilities[0].Severity)
+	assert.Equal(t, "3.0.13, 3.1.5", report.Vulnerabilities[0].FixedVersion)
+	assert.Equal(t, models.VulnerabilitySeverityLow, report.Vulnerabilities[1].Severity)
+	assert.Empty(t, report.Packages)
+}
+
+func TestNewScanner(t *testing.T) {
+	scanner, err := NewScanner(config.ImageScanConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, scanner)
+
+	scanner, err = NewScanner(config.ImageScanConfig{Scanner: "Trivy"})
+	require.NoError(t, err)
+	assert.Equal(t, "trivy", scanner.Name())
+
+	_, err = NewScanner(config.ImageScanConfig{Scanner: "grype", ServerURL: "http://grype"})
+	assert.Error(t, err)
+
+	_, err = NewScanner(config.ImageScanConfig{Scanner: "clair"})
+	assert.Error(t, err)
+}
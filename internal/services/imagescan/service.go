@@ -0,0 +1,299 @@
+package imagescan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/notification"
+)
+
+// ErrScannerDisabled is returned when no scanner is configured
+var ErrScannerDisabled = errors.New("image scanning is not configured")
+
+// ImageRef is an image in use, identified by its digest when known
+type ImageRef struct {
+	Image  string // Pullable reference, e.g. nginx@sha256:... or nginx:1.27
+	Digest string // Manifest or image ID digest, empty when unknown
+}
+
+// ImageSource lists the images in use on Docker instances or clusters
+type ImageSource interface {
+	ListImageRefs(ctx context.Context) ([]ImageRef, error)
+}
+
+// CredentialLookup returns the registry login to pull an image, nil when
+// the image is pulled anonymously
+type CredentialLookup func(ctx context.Context, image string) (*Credentials, error)
+
+// Service scans images and caches the results by digest
+type Service struct {
+	repo        *repository.ImageScanRepository
+	scanner     Scanner
+	credentials CredentialLookup
+	sources     []ImageSource
+	timeout     time.Duration
+	cacheTTL    time.Duration
+	webhookURL  string
+	// notify sends new critical vulnerability notifications; replaced in tests
+	notify func(ctx context.Context, webhookURL string, n *notification.Notification) error
+}
+
+// NewService creates a new image scan service. A nil scanner disables
+// scanning; stored results stay readable.
+func NewService(db *gorm.DB, scanner Scanner, cfg config.ImageScanConfig) *Service {
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Minute
+	}
+	return &Service{
+		repo:       repository.NewImageScanRepository(db),
+		scanner:    scanner,
+		timeout:    timeout,
+		cacheTTL:   time.Duration(cfg.CacheHours) * time.Hour,
+		webhookURL: cfg.NotifyWebhookURL,
+		notify:     sendWebhookNotification,
+	}
+}
+
+func sendWebhookNotification(ctx context.Context, webhookURL string, n *notification.Notification) error {
+	return notification.NewWebhookNotifier(&notification.WebhookConfig{
+		URL:    webhookURL,
+		Method: "POST",
+	}).Send(ctx, n)
+}
+
+// SetCredentialLookup sets how registry logins are found for private images
+func (s *Service) SetCredentialLookup(lookup CredentialLookup) {
+	s.credentials = lookup
+}
+
+// AddSource adds a source of images rescanned by RescanAll
+func (s *Service) AddSource(source ImageSource) {
+	s.sources = append(s.sources, source)
+}
+
+// Enabled reports whether a scanner is configured
+func (s *Service) Enabled() bool {
+	return s.scanner != nil
+}
+
+// Get retrieves a scan result with its vulnerabilities and packages
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*models.ImageScan, error) {
+	scan, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("image scan not found")
+		}
+		return nil, fmt.Errorf("failed to get image scan: %w", err)
+	}
+	return scan, nil
+}
+
+// List lists scan summaries, most vulnerable first
+func (s *Service) List(ctx context.Context, status string, page, pageSize int) ([]*models.ImageScan, int64, error) {
+	return s.repo.ListSummaries(ctx, status, page, pageSize)
+}
+
+// Summaries returns the scan summaries of image digests, keyed by digest
+func (s *Service) Summaries(ctx context.Context, digests []string) (map[string]*models.ImageScan, error) {
+	return s.repo.ListSummariesByDigests(ctx, digests)
+}
+
+// ScanImage scans an image, reusing a cached result of its digest unless
+// force is set or the result is older than the cache TTL
+func (s *Service) ScanImage(ctx context.Context, ref ImageRef, force bool) (*models.ImageScan, error) {
+	scan, _, err := s.scan(ctx, ref, force)
+	return scan, err
+}
+
+// scan scans an image and also returns the critical vulnerabilities that
+// the previous result of the digest did not have
+func (s *Service) scan(ctx context.Context, ref ImageRef, force bool) (*models.ImageScan, []models.ImageVulnerability, error) {
+	if ref.Image == "" {
+		return nil, nil, fmt.Errorf("image is required")
+	}
+
+	if ref.Digest != "" && !force {
+		cached, err := s.repo.GetByDigest(ctx, ref.Digest)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get cached scan: %w", err)
+		}
+		if cached != nil && cached.Status == models.ImageScanStatusCompleted && time.Since(cached.ScannedAt) < s.cacheTTL {
+			return cached, nil, nil
+		}
+	}
+
+	if s.scanner == nil {
+		return nil, nil, ErrScannerDisabled
+	}
+
+	var creds *Credentials
+	if s.credentials != nil {
+		var err error
+		if creds, err = s.credentials(ctx, ref.Image); err != nil {
+			logrus.WithError(err).WithField("image", ref.Image).Warn("Failed to look up registry credentials, scanning anonymously")
+		}
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	report, scanErr := s.scanner.Scan(scanCtx, ref.Image, creds)
+	cancel()
+
+	digest := ref.Digest
+	if digest == "" && report != nil {
+		digest = report.Digest
+	}
+	if digest == "" {
+		if scanErr != nil {
+			return nil, nil, scanErr
+		}
+		return nil, nil, fmt.Errorf("scanner reported no digest for %s", ref.Image)
+	}
+
+	previous, err := s.repo.GetByDigest(ctx, digest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get previous scan: %w", err)
+	}
+	scan := previous
+	if scan == nil {
+		scan = &models.ImageScan{Digest: digest}
+	}
+	scan.Image = ref.Image
+	scan.Scanner = s.scanner.Name()
+
+	if scanErr != nil {
+		// Keep the last successful result, only flag the failure
+		if previous == nil || previous.Status != models.ImageScanStatusCompleted {
+			scan.Status = models.ImageScanStatusFailed
+			scan.ScannedAt = time.Now()
+		}
+		scan.Error = scanErr.Error()
+		if err := s.repo.Save(ctx, scan); err != nil {
+			logrus.WithError(err).Warn("Failed to save failed image scan")
+		}
+		return scan, nil, scanErr
+	}
+
+	var known map[string]bool
+	if previous != nil && previous.Status == models.ImageScanStatusCompleted {
+		known = previous.CriticalIDs()
+	}
+
+	scan.Status = models.ImageScanStatusCompleted
+	scan.ScannedAt = time.Now()
+	scan.Error = ""
+	scan.Vulnerabilities = report.Vulnerabilities
+	scan.Packages = report.Packages
+	scan.VulnerabilityCounts = models.VulnerabilityCounts{}
+	for _, v := range report.Vulnerabilities {
+		scan.VulnerabilityCounts.Add(v.Severity)
+	}
+	if err := s.repo.Save(ctx, scan); err != nil {
+		return nil, nil, fmt.Errorf("failed to save image scan: %w", err)
+	}
+
+	var newCriticals []models.ImageVulnerability
+	for _, v := range scan.Vulnerabilities {
+		if v.Severity == models.VulnerabilitySeverityCritical && !known[v.ID+"/"+v.Package] {
+			newCriticals = append(newCriticals, v)
+		}
+	}
+	return scan, newCriticals, nil
+}
+
+// RescanAll rescans every image in use and notifies about critical
+// vulnerabilities not found before
+func (s *Service) RescanAll(ctx context.Context) (scanned, failed int, err error) {
+	if s.scanner == nil {
+		return 0, 0, ErrScannerDisabled
+	}
+
+	var errs []error
+	seen := make(map[string]bool)
+	var refs []ImageRef
+	for _, source := range s.sources {
+		sourceRefs, err := source.ListImageRefs(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, ref := range sourceRefs {
+			key := ref.Digest
+			if key == "" {
+				key = ref.Image
+			}
+			if ref.Image == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	findings := make(map[string][]models.ImageVulnerability)
+	for _, ref := range refs {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		_, newCriticals, err := s.scan(ctx, ref, true)
+		if err != nil {
+			failed++
+			logrus.WithError(err).WithField("image", ref.Image).Warn("Image scan failed")
+			continue
+		}
+		scanned++
+		if len(newCriticals) > 0 {
+			findings[ref.Image] = newCriticals
+		}
+	}
+
+	s.notifyNewCriticals(ctx, findings)
+	return scanned, failed, errors.Join(errs...)
+}
+
+func (s *Service) notifyNewCriticals(ctx context.Context, findings map[string][]models.ImageVulnerability) {
+	if s.webhookURL == "" || len(findings) == 0 {
+		return
+	}
+
+	images := make([]string, 0, len(findings))
+	total := 0
+	for image, vulns := range findings {
+		images = append(images, image)
+		total += len(vulns)
+	}
+	sort.Strings(images)
+
+	var lines []string
+	for _, image := range images {
+		var ids []string
+		for _, v := range findings[image] {
+			ids = append(ids, fmt.Sprintf("%s (%s %s)", v.ID, v.Package, v.InstalledVersion))
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", image, strings.Join(ids, ", ")))
+	}
+
+	n := &notification.Notification{
+		Title:    fmt.Sprintf("%d new critical vulnerabilities in %d images", total, len(images)),
+		Message:  strings.Join(lines, "\n"),
+		Severity: notification.SeverityCritical,
+		Metadata: map[string]interface{}{
+			"images": images,
+			"count":  total,
+		},
+	}
+	if err := s.notify(ctx, s.webhookURL, n); err != nil {
+		logrus.Warnf("Failed to send image vulnerability notification: %v", err)
+	}
+}
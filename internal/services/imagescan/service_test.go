@@ -0,0 +1,208 @@
+package imagescan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/notification"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+// fakeScanner returns canned reports per image and counts its calls
+type fakeScanner struct {
+	reports map[string]*Report
+	errs    map[string]error
+	calls   map[string]int
+	creds   map[string]*Credentials
+}
+
+func newFakeScanner() *fakeScanner {
+	return &fakeScanner{
+		reports: make(map[string]*Report),
+		errs:    make(map[string]error),
+		calls:   make(map[string]int),
+		creds:   make(map[string]*Credentials),
+	}
+}
+
+func (s *fakeScanner) Name() string {
+	return "fake"
+}
+
+func (s *fakeScanner) Scan(ctx context.Context, image string, creds *Credentials) (*Report, error) {
+	s.calls[image]++
+	s.creds[image] = creds
+	if err := s.errs[image]; err != nil {
+		return nil, err
+	}
+	report, ok := s.reports[image]
+	if !ok {
+		return nil, errors.New("image not found")
+	}
+	return report, nil
+}
+
+// fakeSource is a fixed list of images in use
+type fakeSource []ImageRef
+
+func (s fakeSource) ListImageRefs(ctx context.Context) ([]ImageRef, error) {
+	return s, nil
+}
+
+func newTestService(t *testing.T, scanner Scanner) *Service {
+	db := testdb.Open(t, &models.ImageScan{})
+	return NewService(db, scanner, config.ImageScanConfig{
+		TimeoutSeconds:   60,
+		CacheHours:       24,
+		NotifyWebhookURL: "http://hooks.example.com/scan",
+	})
+}
+
+func vuln(id, severity string) models.ImageVulnerability {
+	return models.ImageVulnerability{ID: id, Package: "openssl", InstalledVersion: "3.0.1", Severity: severity}
+}
+
+func TestService_ScanImageCachesByDigest(t *testing.T) {
+	scanner := newFakeScanner()
+	scanner.reports["nginx@sha256:aaa"] = &Report{
+		Digest:          "sha256:aaa",
+		Vulnerabilities: []models.ImageVulnerability{vuln("CVE-1", "CRITICAL"), vuln("CVE-2", "HIGH"), vuln("CVE-3", "HIGH")},
+		Packages:        []models.ImagePackage{{Name: "openssl", Version: "3.0.1", Type: "debian"}},
+	}
+	svc := newTestService(t, scanner)
+	svc.SetCredentialLookup(func(ctx context.Context, image string) (*Credentials, error) {
+		return &Credentials{Username: "bot", Password: "secret"}, nil
+	})
+	ctx := context.Background()
+	ref := ImageRef{Image: "nginx@sha256:aaa", Digest: "sha256:aaa"}
+
+	scan, err := svc.ScanImage(ctx, ref, false)
+	require.NoError(t, err)
+	assert.Equal(t, models.ImageScanStatusCompleted, scan.Status)
+	assert.Equal(t, "fake", scan.Scanner)
+	assert.Equal(t, 1, scan.Critical)
+	assert.Equal(t, 2, scan.High)
+	assert.Len(t, scan.Packages, 1)
+	assert.Equal(t, "bot", scanner.creds[ref.Image].Username)
+
+	// A fresh result of the digest is reused
+	_, err = svc.ScanImage(ctx, ref, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, scanner.calls[ref.Image])
+
+	// Force rescans
+	_, err = svc.ScanImage(ctx, ref, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, scanner.calls[ref.Image])
+
+	summaries, err := svc.Summaries(ctx, []string{"sha256:aaa", "sha256:missing"})
+	require.NoError(t, err)
+	require.Contains(t, summaries, "sha256:aaa")
+	assert.Equal(t, 1, summaries["sha256:aaa"].Critical)
+	assert.Empty(t, summaries["sha256:aaa"].Vulnerabilities)
+	assert.NotContains(t, summaries, "sha256:missing")
+
+	full, err := svc.Get(ctx, scan.ID)
+	require.NoError(t, err)
+	assert.Len(t, full.Vulnerabilities, 3)
+}
+
+func TestService_ScanImageUsesReportedDigest(t *testing.T) {
+	scanner := newFakeScanner()
+	scanner.reports["redis:7"] = &Report{Digest: "sha256:bbb"}
+	svc := newTestService(t, scanner)
+
+	scan, err := svc.ScanImage(context.Background(), ImageRef{Image: "redis:7"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:bbb", scan.Digest)
+	assert.Zero(t, scan.Total())
+}
+
+func TestService_FailedRescanKeepsResult(t *testing.T) {
+	scanner := newFakeScanner()
+	scanner.reports["app@sha256:ccc"] = &Report{Vulnerabilities: []models.ImageVulnerability{vuln("CVE-1", "HIGH")}}
+	svc := newTestService(t, scanner)
+	ctx := context.Background()
+	ref := ImageRef{Image: "app@sha256:ccc", Digest: "sha256:ccc"}
+
+	first, err := svc.ScanImage(ctx, ref, false)
+	require.NoError(t, err)
+
+	scanner.errs[ref.Image] = errors.New("registry unreachable")
+	_, err = svc.ScanImage(ctx, ref, true)
+	require.Error(t, err)
+
+	scan, err := svc.Get(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.ImageScanStatusCompleted, scan.Status)
+	assert.Equal(t, "registry unreachable", scan.Error)
+	assert.Equal(t, 1, scan.High)
+	assert.WithinDuration(t, first.ScannedAt, scan.ScannedAt, time.Second)
+
+	// A digest never scanned successfully is recorded as failed
+	scanner.errs["bad@sha256:ddd"] = errors.New("manifest unknown")
+	failed, err := svc.ScanImage(ctx, ImageRef{Image: "bad@sha256:ddd", Digest: "sha256:ddd"}, false)
+	require.Error(t, err)
+	assert.Equal(t, models.ImageScanStatusFailed, failed.Status)
+}
+
+func TestService_RescanAllNotifiesNewCriticals(t *testing.T) {
+	scanner := newFakeScanner()
+	scanner.reports["nginx@sha256:aaa"] = &Report{Vulnerabilities: []models.ImageVulnerability{vuln("CVE-1", "CRITICAL")}}
+	scanner.reports["redis@sha256:bbb"] = &Report{Vulnerabilities: []models.ImageVulnerability{vuln("CVE-9", "LOW")}}
+	svc := newTestService(t, scanner)
+
+	var sent []*notification.Notification
+	svc.notify = func(ctx context.Context, webhookURL string, n *notification.Notification) error {
+		sent = append(sent, n)
+		return nil
+	}
+
+	// The same image on a Docker host and in a cluster is scanned once
+	svc.AddSource(fakeSource{
+		{Image: "nginx@sha256:aaa", Digest: "sha256:aaa"},
+		{Image: "redis@sha256:bbb", Digest: "sha256:bbb"},
+	})
+	svc.AddSource(fakeSource{{Image: "nginx@sha256:aaa", Digest: "sha256:aaa"}})
+	ctx := context.Background()
+
+	scanned, failed, err := svc.RescanAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, scanned)
+	assert.Zero(t, failed)
+	assert.Equal(t, 1, scanner.calls["nginx@sha256:aaa"])
+	require.Len(t, sent, 1)
+	assert.Equal(t, notification.SeverityCritical, sent[0].Severity)
+	assert.Contains(t, sent[0].Message, "CVE-1")
+
+	// Already known criticals are not notified again
+	_, _, err = svc.RescanAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sent, 1)
+
+	// A new critical is
+	scanner.reports["redis@sha256:bbb"] = &Report{Vulnerabilities: []models.ImageVulnerability{vuln("CVE-9", "LOW"), vuln("CVE-10", "CRITICAL")}}
+	_, _, err = svc.RescanAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, sent, 2)
+	assert.Contains(t, sent[1].Message, "CVE-10")
+	assert.NotContains(t, sent[1].Message, "CVE-1 ")
+}
+
+func TestService_Disabled(t *testing.T) {
+	svc := newTestService(t, nil)
+	assert.False(t, svc.Enabled())
+
+	_, err := svc.ScanImage(context.Background(), ImageRef{Image: "nginx:latest"}, false)
+	assert.ErrorIs(t, err, ErrScannerDisabled)
+
+	_, _, err = svc.RescanAll(context.Background())
+	assert.ErrorIs(t, err, ErrScannerDisabled)
+}
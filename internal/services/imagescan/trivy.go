@@ -0,0 +1,104 @@
+package imagescan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// TrivyScanner scans images with the Trivy CLI, standalone or as a client
+// of a Trivy server. It also records the package inventory of the image.
+type TrivyScanner struct {
+	binary    string
+	serverURL string
+	run       commandRunner
+}
+
+// NewTrivyScanner creates a Trivy scanner. An empty binary uses trivy from
+// PATH; a server URL scans in client/server mode.
+func NewTrivyScanner(binary, serverURL string) *TrivyScanner {
+	if binary == "" {
+		binary = "trivy"
+	}
+	return &TrivyScanner{binary: binary, serverURL: serverURL, run: runCommand}
+}
+
+// Name returns the scanner name
+func (s *TrivyScanner) Name() string {
+	return "trivy"
+}
+
+// trivyReport is the part of the Trivy JSON report used here
+type trivyReport struct {
+	Metadata struct {
+		ImageID     string   `json:"ImageID"`
+		RepoDigests []string `json:"RepoDigests"`
+	} `json:"Metadata"`
+	Results []struct {
+		Target   string `json:"Target"`
+		Type     string `json:"Type"`
+		Packages []struct {
+			Name    string `json:"Name"`
+			Version string `json:"Version"`
+		} `json:"Packages"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// Scan scans an image from its registry
+func (s *TrivyScanner) Scan(ctx context.Context, image string, creds *Credentials) (*Report, error) {
+	args := []string{"image", "--quiet", "--format", "json", "--list-all-pkgs", "--image-src", "remote"}
+	if s.serverURL != "" {
+		args = append(args, "--server", s.serverURL)
+	}
+	args = append(args, image)
+
+	var env []string
+	if creds != nil {
+		env = append(env, "TRIVY_USERNAME="+creds.Username, "TRIVY_PASSWORD="+creds.Password)
+	}
+
+	out, err := s.run(ctx, env, s.binary, args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseTrivyReport(out)
+}
+
+func parseTrivyReport(data []byte) (*Report, error) {
+	var raw trivyReport
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode trivy report: %w", err)
+	}
+
+	report := &Report{Digest: raw.Metadata.ImageID}
+	if len(raw.Metadata.RepoDigests) > 0 {
+		report.Digest = digestOf(raw.Metadata.RepoDigests[0])
+	}
+
+	for _, result := range raw.Results {
+		for _, pkg := range result.Packages {
+			report.Packages = append(report.Packages, models.ImagePackage{Name: pkg.Name, Version: pkg.Version, Type: result.Type})
+		}
+		for _, v := range result.Vulnerabilities {
+			report.Vulnerabilities = append(report.Vulnerabilities, models.ImageVulnerability{
+				ID:               v.VulnerabilityID,
+				Package:          v.PkgName,
+				InstalledVersion: v.InstalledVersion,
+				FixedVersion:     v.FixedVersion,
+				Severity:         normalizeSeverity(v.Severity),
+				Title:            v.Title,
+			})
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,399 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/notification"
+)
+
+// CreateBaselineRequest represents a new baseline
+type CreateBaselineRequest struct {
+	Name              string     `json:"name" binding:"required"`
+	Description       string     `json:"description"`
+	ClusterID         uuid.UUID  `json:"cluster_id" binding:"required"`
+	Namespace         string     `json:"namespace" binding:"required"`
+	Kinds             []string   `json:"kinds"`
+	Names             []string   `json:"names"`
+	TargetClusterID   *uuid.UUID `json:"target_cluster_id"`
+	TargetNamespace   string     `json:"target_namespace"`
+	DriftCheckEnabled *bool      `json:"drift_check_enabled"` // Defaults to true
+	NotifyWebhookURL  string     `json:"notify_webhook_url"`
+}
+
+// UpdateBaselineRequest updates the drift check settings of a baseline
+type UpdateBaselineRequest struct {
+	Description       *string `json:"description"`
+	DriftCheckEnabled *bool   `json:"drift_check_enabled"`
+	NotifyWebhookURL  *string `json:"notify_webhook_url"`
+}
+
+// alertConditionBaselineDrift is the condition of the system alert rule raised on drift
+const alertConditionBaselineDrift = "baseline_drift"
+
+// BaselineService manages saved baselines and drift checks. Drift raises an
+// alert event; a per-baseline webhook can be notified as well.
+type BaselineService struct {
+	db         *gorm.DB
+	repo       *repository.ClusterBaselineRepository
+	alertRepo  repository.MonitorAlertRepository
+	comparator *ClusterComparator
+	// notify sends drift notifications; replaced in tests
+	notify func(ctx context.Context, webhookURL string, n *notification.Notification) error
+}
+
+// NewBaselineService creates a new BaselineService instance
+func NewBaselineService(db *gorm.DB, repo *repository.ClusterBaselineRepository, comparator *ClusterComparator) *BaselineService {
+	return &BaselineService{
+		db:         db,
+		repo:       repo,
+		alertRepo:  repository.NewMonitorAlertRepository(db),
+		comparator: comparator,
+		notify:     sendWebhookNotification,
+	}
+}
+
+func sendWebhookNotification(ctx context.Context, webhookURL string, n *notification.Notification) error {
+	return notification.NewWebhookNotifier(&notification.WebhookConfig{
+		URL:    webhookURL,
+		Method: "POST",
+	}).Send(ctx, n)
+}
+
+// Create captures a snapshot and saves it as a baseline
+func (s *BaselineService) Create(ctx context.Context, req *CreateBaselineRequest, createdBy uuid.UUID) (*models.ClusterBaseline, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if _, err := resolveCompareKinds(req.Kinds); err != nil {
+		return nil, err
+	}
+
+	baseline := &models.ClusterBaseline{
+		Name:              req.Name,
+		Description:       req.Description,
+		ClusterID:         req.ClusterID,
+		Namespace:         req.Namespace,
+		Kinds:             req.Kinds,
+		Names:             req.Names,
+		TargetClusterID:   req.TargetClusterID,
+		TargetNamespace:   req.TargetNamespace,
+		DriftCheckEnabled: req.DriftCheckEnabled == nil || *req.DriftCheckEnabled,
+		NotifyWebhookURL:  req.NotifyWebhookURL,
+		CreatedBy:         createdBy,
+	}
+
+	if err := s.capture(ctx, baseline); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, baseline); err != nil {
+		return nil, fmt.Errorf("failed to save baseline: %w", err)
+	}
+
+	return baseline, nil
+}
+
+// Update changes the description and drift check settings of a baseline
+func (s *BaselineService) Update(ctx context.Context, id uuid.UUID, req *UpdateBaselineRequest) (*models.ClusterBaseline, error) {
+	baseline, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Description != nil {
+		baseline.Description = *req.Description
+	}
+	if req.DriftCheckEnabled != nil {
+		baseline.DriftCheckEnabled = *req.DriftCheckEnabled
+	}
+	if req.NotifyWebhookURL != nil {
+		baseline.NotifyWebhookURL = *req.NotifyWebhookURL
+	}
+
+	if err := s.repo.Update(ctx, baseline); err != nil {
+		return nil, err
+	}
+	return baseline, nil
+}
+
+// Recapture replaces the snapshot with the current state of the source cluster
+// and resets the drift status
+func (s *BaselineService) Recapture(ctx context.Context, id uuid.UUID) (*models.ClusterBaseline, error) {
+	baseline, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.capture(ctx, baseline); err != nil {
+		return nil, err
+	}
+	baseline.DriftStatus = models.DriftStatusUnknown
+	baseline.DriftSummary = ""
+	baseline.LastError = ""
+
+	if err := s.repo.Update(ctx, baseline); err != nil {
+		return nil, err
+	}
+
+	// The live state is the new baseline, so an open drift alert no longer applies
+	s.resolveDriftAlert(ctx, baseline)
+	return baseline, nil
+}
+
+func (s *BaselineService) capture(ctx context.Context, baseline *models.ClusterBaseline) error {
+	snapshots, err := s.comparator.Snapshot(ctx, baseline.ClusterID, &CompareScope{
+		Namespace: baseline.Namespace,
+		Kinds:     baseline.Kinds,
+		Names:     baseline.Names,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to capture snapshot: %w", err)
+	}
+
+	data, err := json.Marshal(snapshots)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	baseline.Snapshot = string(data)
+	baseline.ObjectCount = len(snapshots)
+	baseline.CapturedAt = time.Now()
+	return nil
+}
+
+// Snapshot decodes the stored snapshot of a baseline
+func (s *BaselineService) Snapshot(baseline *models.ClusterBaseline) ([]ResourceSnapshot, error) {
+	var snapshots []ResourceSnapshot
+	if baseline.Snapshot == "" {
+		return snapshots, nil
+	}
+	if err := json.Unmarshal([]byte(baseline.Snapshot), &snapshots); err != nil {
+		return nil, fmt.Errorf("invalid baseline snapshot: %w", err)
+	}
+	return snapshots, nil
+}
+
+// Check compares the live objects of the baseline's target against the
+// snapshot, records the outcome and notifies when the baseline starts drifting
+func (s *BaselineService) Check(ctx context.Context, id uuid.UUID) (*models.ClusterBaseline, *CompareResult, error) {
+	baseline, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := s.check(ctx, baseline)
+	return baseline, result, err
+}
+
+func (s *BaselineService) check(ctx context.Context, baseline *models.ClusterBaseline) (*CompareResult, error) {
+	previousStatus := baseline.DriftStatus
+	now := time.Now()
+	baseline.LastCheckedAt = &now
+
+	result, checkErr := s.diff(ctx, baseline)
+	if checkErr != nil {
+		baseline.DriftStatus = models.DriftStatusError
+		baseline.LastError = checkErr.Error()
+	} else {
+		baseline.LastError = ""
+		summary, _ := json.Marshal(result.Summary)
+		baseline.DriftSummary = string(summary)
+		if result.Summary.Drifted() {
+			baseline.DriftStatus = models.DriftStatusDrifted
+			baseline.LastDriftAt = &now
+		} else {
+			baseline.DriftStatus = models.DriftStatusInSync
+		}
+	}
+
+	if err := s.repo.Update(ctx, baseline); err != nil {
+		return result, errors.Join(checkErr, fmt.Errorf("failed to save drift status: %w", err))
+	}
+	if checkErr != nil {
+		return nil, checkErr
+	}
+
+	// Alert only on the transition so a persistent drift is reported once
+	if baseline.DriftStatus != previousStatus &&
+		(baseline.DriftStatus == models.DriftStatusDrifted || previousStatus == models.DriftStatusDrifted) {
+		s.notifyDrift(ctx, baseline, result)
+	}
+
+	return result, nil
+}
+
+func (s *BaselineService) diff(ctx context.Context, baseline *models.ClusterBaseline) (*CompareResult, error) {
+	snapshot, err := s.Snapshot(baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID, namespace := baseline.CheckTarget()
+	live, err := s.comparator.Snapshot(ctx, clusterID, &CompareScope{
+		Namespace: namespace,
+		Kinds:     baseline.Kinds,
+		Names:     baseline.Names,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffSnapshots(snapshot, live), nil
+}
+
+func (s *BaselineService) notifyDrift(ctx context.Context, baseline *models.ClusterBaseline, result *CompareResult) {
+	clusterID, namespace := baseline.CheckTarget()
+	n := &notification.Notification{
+		Title:    fmt.Sprintf("Baseline %s is back in sync", baseline.Name),
+		Message:  fmt.Sprintf("Namespace %s matches baseline %s again", namespace, baseline.Name),
+		Severity: notification.SeverityInfo,
+		Metadata: map[string]interface{}{
+			"baseline_id": baseline.ID.String(),
+			"cluster_id":  clusterID.String(),
+			"namespace":   namespace,
+			"summary":     result.Summary,
+		},
+	}
+
+	if baseline.DriftStatus == models.DriftStatusDrifted {
+		var drifted []string
+		for _, item := range result.Items {
+			if item.Status != CompareIdentical {
+				drifted = append(drifted, fmt.Sprintf("%s/%s (%s)", item.Kind, item.Name, item.Status))
+			}
+		}
+		n.Title = fmt.Sprintf("Baseline %s drift detected", baseline.Name)
+		n.Message = fmt.Sprintf("Namespace %s diverges from baseline %s: %d changed, %d missing, %d unexpected\n%s",
+			namespace, baseline.Name, result.Summary.Different, result.Summary.OnlyInSource,
+			result.Summary.OnlyInTarget, strings.Join(drifted, "\n"))
+		n.Severity = notification.SeverityWarning
+
+		n.Metadata["drifted"] = drifted
+		s.raiseDriftAlert(ctx, baseline, n.Title, n.Metadata)
+	} else {
+		s.resolveDriftAlert(ctx, baseline)
+	}
+
+	if baseline.NotifyWebhookURL == "" {
+		return
+	}
+	if err := s.notify(ctx, baseline.NotifyWebhookURL, n); err != nil {
+		logrus.Warnf("Failed to send drift notification for baseline %s: %v", baseline.Name, err)
+	}
+}
+
+// raiseDriftAlert creates a firing alert event for a drifted baseline
+func (s *BaselineService) raiseDriftAlert(ctx context.Context, baseline *models.ClusterBaseline, message string, alertContext map[string]interface{}) {
+	rule, err := s.getOrCreateDriftRule(ctx, baseline)
+	if err != nil {
+		logrus.Errorf("Failed to get drift alert rule for baseline %s: %v", baseline.Name, err)
+		return
+	}
+
+	contextData, _ := json.Marshal(alertContext)
+	event := &models.MonitorAlertEvent{
+		RuleID:      rule.ID,
+		Status:      models.AlertStatusFiring,
+		Severity:    rule.Severity,
+		Message:     message,
+		Context:     string(contextData),
+		TriggeredAt: time.Now(),
+	}
+	if err := s.alertRepo.CreateEvent(ctx, event); err != nil {
+		logrus.Errorf("Failed to create drift alert for baseline %s: %v", baseline.Name, err)
+	}
+}
+
+// resolveDriftAlert resolves the firing drift alerts of a baseline that is back in sync
+func (s *BaselineService) resolveDriftAlert(ctx context.Context, baseline *models.ClusterBaseline) {
+	rule, err := s.findDriftRule(ctx, baseline)
+	if err != nil {
+		logrus.Errorf("Failed to get drift alert rule for baseline %s: %v", baseline.Name, err)
+		return
+	}
+	if rule == nil {
+		return
+	}
+
+	firing, err := s.alertRepo.GetFiringEvents(ctx, rule.ID)
+	if err != nil {
+		logrus.Errorf("Failed to fetch drift alerts for baseline %s: %v", baseline.Name, err)
+		return
+	}
+	for _, event := range firing {
+		event.Resolve(uuid.Nil, "Baseline back in sync") // uuid.Nil indicates system auto-resolve
+		if err := s.alertRepo.UpdateEvent(ctx, event); err != nil {
+			logrus.Errorf("Failed to resolve drift alert %s: %v", event.ID, err)
+		}
+	}
+}
+
+// findDriftRule returns the system alert rule of a baseline, or nil if none was created yet
+func (s *BaselineService) findDriftRule(ctx context.Context, baseline *models.ClusterBaseline) (*models.MonitorAlertRule, error) {
+	var rule models.MonitorAlertRule
+	err := s.db.WithContext(ctx).
+		Where("target_id = ? AND type = ? AND condition = ?", baseline.ID, models.AlertTypeBaseline, alertConditionBaselineDrift).
+		First(&rule).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// getOrCreateDriftRule gets or creates the system alert rule of a baseline
+func (s *BaselineService) getOrCreateDriftRule(ctx context.Context, baseline *models.ClusterBaseline) (*models.MonitorAlertRule, error) {
+	rule, err := s.findDriftRule(ctx, baseline)
+	if err != nil || rule != nil {
+		return rule, err
+	}
+
+	rule = &models.MonitorAlertRule{
+		Name:           fmt.Sprintf("Baseline drift - %s", baseline.Name),
+		Type:           models.AlertTypeBaseline,
+		TargetID:       baseline.ID,
+		Severity:       models.AlertSeverityWarning,
+		Condition:      alertConditionBaselineDrift, // Special condition raised by the drift check
+		Enabled:        true,
+		NotifyChannels: `[]`,
+		NotifyConfig:   `{}`,
+	}
+	if err := s.alertRepo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// CheckAll runs the drift check of every enabled baseline
+func (s *BaselineService) CheckAll(ctx context.Context) (checked, drifted int, err error) {
+	baselines, err := s.repo.ListDriftCheckEnabled(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var errs []error
+	for _, baseline := range baselines {
+		if _, err := s.check(ctx, baseline); err != nil {
+			errs = append(errs, fmt.Errorf("baseline %s: %w", baseline.Name, err))
+			continue
+		}
+		checked++
+		if baseline.DriftStatus == models.DriftStatusDrifted {
+			drifted++
+		}
+	}
+
+	return checked, drifted, errors.Join(errs...)
+}
@@ -0,0 +1,170 @@
+package k8s
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// auditEventList mirrors the audit.k8s.io/v1 EventList the API server posts to
+// an audit webhook backend. Only the fields tiga stores are declared.
+type auditEventList struct {
+	Kind       string       `json:"kind"`
+	APIVersion string       `json:"apiVersion"`
+	Items      []auditEvent `json:"items"`
+}
+
+type auditEvent struct {
+	AuditID    string `json:"auditID"`
+	Stage      string `json:"stage"`
+	Verb       string `json:"verb"`
+	RequestURI string `json:"requestURI"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ImpersonatedUser *struct {
+		Username string `json:"username"`
+	} `json:"impersonatedUser,omitempty"`
+	SourceIPs []string `json:"sourceIPs"`
+	UserAgent string   `json:"userAgent"`
+	ObjectRef *struct {
+		Resource    string `json:"resource"`
+		Namespace   string `json:"namespace"`
+		Name        string `json:"name"`
+		UID         string `json:"uid"`
+		APIGroup    string `json:"apiGroup"`
+		Subresource string `json:"subresource"`
+	} `json:"objectRef,omitempty"`
+	ResponseStatus *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp time.Time `json:"requestReceivedTimestamp"`
+	StageTimestamp           time.Time `json:"stageTimestamp"`
+}
+
+// auditedVerbs are the verbs kept from the audit stream; reads are dropped
+var auditedVerbs = map[string]bool{
+	"create":           true,
+	"update":           true,
+	"patch":            true,
+	"delete":           true,
+	"deletecollection": true,
+}
+
+// auditedSubresources are read-verb subresources that are still worth keeping
+// because they grant interactive access to workloads
+var auditedSubresources = map[string]bool{
+	"exec":        true,
+	"attach":      true,
+	"portforward": true,
+}
+
+// ParseAuditEvents converts an audit webhook payload into ClusterEvents.
+// Only completed, mutating requests (plus exec/attach/port-forward) are kept.
+func ParseAuditEvents(clusterID uuid.UUID, body []byte) ([]*models.ClusterEvent, error) {
+	var list auditEventList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("invalid audit event list: %w", err)
+	}
+	if list.Kind != "" && list.Kind != "EventList" {
+		return nil, fmt.Errorf("unexpected audit payload kind: %s", list.Kind)
+	}
+
+	events := make([]*models.ClusterEvent, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Stage != "ResponseComplete" || item.ObjectRef == nil || item.AuditID == "" {
+			continue
+		}
+		if !auditedVerbs[item.Verb] && !auditedSubresources[item.ObjectRef.Subresource] {
+			continue
+		}
+
+		username := item.User.Username
+		if item.ImpersonatedUser != nil && item.ImpersonatedUser.Username != "" {
+			username = fmt.Sprintf("%s (as %s)", item.User.Username, item.ImpersonatedUser.Username)
+		}
+
+		event := &models.ClusterEvent{
+			ClusterID:   clusterID,
+			Source:      models.ClusterEventSourceAudit,
+			UID:         item.AuditID,
+			Kind:        KindForResource(item.ObjectRef.Resource),
+			Namespace:   item.ObjectRef.Namespace,
+			Name:        item.ObjectRef.Name,
+			APIGroup:    item.ObjectRef.APIGroup,
+			ObjectUID:   item.ObjectRef.UID,
+			Subresource: item.ObjectRef.Subresource,
+			Verb:        item.Verb,
+			Username:    username,
+			UserAgent:   truncate(item.UserAgent, 512),
+			SourceIPs:   truncate(strings.Join(item.SourceIPs, ","), 512),
+			Count:       1,
+			FirstSeen:   item.RequestReceivedTimestamp,
+			LastSeen:    item.StageTimestamp,
+		}
+		if event.LastSeen.IsZero() {
+			event.LastSeen = event.FirstSeen
+		}
+		if item.ResponseStatus != nil {
+			event.ResponseCode = item.ResponseStatus.Code
+			if item.ResponseStatus.Code >= 400 {
+				event.Type = "Failed"
+				event.Message = item.ResponseStatus.Message
+			}
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// AuditWebhookToken derives the bearer token a cluster's API server must send
+// to the audit webhook. It is stable per cluster and needs no storage.
+func AuditWebhookToken(secret string, clusterID uuid.UUID) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("k8s-audit-webhook:" + clusterID.String()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyAuditWebhookToken checks a bearer token in constant time
+func VerifyAuditWebhookToken(secret string, clusterID uuid.UUID, token string) bool {
+	return hmac.Equal([]byte(AuditWebhookToken(secret, clusterID)), []byte(token))
+}
+
+// AuditWebhookKubeconfig renders the kubeconfig passed to kube-apiserver via
+// --audit-webhook-config-file
+func AuditWebhookKubeconfig(url, token string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: tiga
+  cluster:
+    server: %s
+contexts:
+- name: tiga
+  context:
+    cluster: tiga
+    user: tiga
+current-context: tiga
+users:
+- name: tiga
+  user:
+    token: %s
+`, url, token)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,251 @@
+package k8s
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+// eventWatcher is a running Warning event informer for one cluster
+type eventWatcher struct {
+	configHash string
+	stopCh     chan struct{}
+}
+
+// ClusterEventCollector watches core/v1 Warning events on every enabled cluster
+// and persists them as ClusterEvents. Clusters are reconciled periodically so
+// added, removed or re-configured clusters are picked up without a restart.
+type ClusterEventCollector struct {
+	clusterRepo repository.ClusterRepositoryInterface
+	eventRepo   *repository.ClusterEventRepository
+	interval    time.Duration
+
+	mu       sync.Mutex
+	watchers map[uuid.UUID]*eventWatcher
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewClusterEventCollector creates a new ClusterEventCollector instance
+func NewClusterEventCollector(
+	clusterRepo repository.ClusterRepositoryInterface,
+	eventRepo *repository.ClusterEventRepository,
+) *ClusterEventCollector {
+	return &ClusterEventCollector{
+		clusterRepo: clusterRepo,
+		eventRepo:   eventRepo,
+		interval:    60 * time.Second, // Reconcile clusters every 60 seconds
+		watchers:    make(map[uuid.UUID]*eventWatcher),
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// Start begins watching events in a background goroutine
+func (s *ClusterEventCollector) Start(ctx context.Context) {
+	logrus.Info("Starting cluster event collector")
+
+	go func() {
+		s.reconcile(ctx)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.reconcile(ctx)
+			case <-s.stopCh:
+				s.stopAll()
+				logrus.Info("Cluster event collector stopped")
+				return
+			case <-ctx.Done():
+				s.stopAll()
+				logrus.Info("Cluster event collector stopped (context cancelled)")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops all event watchers
+func (s *ClusterEventCollector) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+// reconcile starts watchers for new clusters and stops watchers for removed,
+// disabled or re-configured ones
+func (s *ClusterEventCollector) reconcile(ctx context.Context) {
+	clusters, err := s.clusterRepo.GetAllEnabled(ctx)
+	if err != nil {
+		logrus.Errorf("Event collector: failed to fetch enabled clusters: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	active := make(map[uuid.UUID]struct{}, len(clusters))
+	for _, cluster := range clusters {
+		active[cluster.ID] = struct{}{}
+		hash := clusterConfigHash(cluster)
+
+		if w, ok := s.watchers[cluster.ID]; ok {
+			if w.configHash == hash {
+				continue
+			}
+			close(w.stopCh)
+			delete(s.watchers, cluster.ID)
+		}
+
+		w, err := s.startWatcher(cluster, hash)
+		if err != nil {
+			logrus.Warnf("Event collector: cluster %s: %v", cluster.Name, err)
+			continue
+		}
+		s.watchers[cluster.ID] = w
+		logrus.Debugf("Event collector: watching warning events on cluster %s", cluster.Name)
+	}
+
+	for id, w := range s.watchers {
+		if _, ok := active[id]; !ok {
+			close(w.stopCh)
+			delete(s.watchers, id)
+		}
+	}
+}
+
+func (s *ClusterEventCollector) stopAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, w := range s.watchers {
+		close(w.stopCh)
+		delete(s.watchers, id)
+	}
+}
+
+func (s *ClusterEventCollector) startWatcher(cluster *models.Cluster, hash string) (*eventWatcher, error) {
+	config, err := clusterRESTConfig(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build rest config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	// Only Warning events are persisted; Normal events are too noisy to keep
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, 0,
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = "type=" + corev1.EventTypeWarning
+		}),
+	)
+
+	clusterID := cluster.ID
+	informer := factory.Core().V1().Events().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			s.store(clusterID, obj)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			s.store(clusterID, obj)
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to register event handler: %w", err)
+	}
+
+	w := &eventWatcher{configHash: hash, stopCh: make(chan struct{})}
+	factory.Start(w.stopCh)
+	return w, nil
+}
+
+func (s *ClusterEventCollector) store(clusterID uuid.UUID, obj interface{}) {
+	event, ok := obj.(*corev1.Event)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.eventRepo.Upsert(ctx, ClusterEventFromK8s(clusterID, event)); err != nil {
+		logrus.Warnf("Event collector: failed to store event %s/%s: %v", event.Namespace, event.Name, err)
+	}
+}
+
+// ClusterEventFromK8s converts a core/v1 Event into a ClusterEvent
+func ClusterEventFromK8s(clusterID uuid.UUID, event *corev1.Event) *models.ClusterEvent {
+	firstSeen := event.FirstTimestamp.Time
+	if firstSeen.IsZero() {
+		firstSeen = event.EventTime.Time
+	}
+	if firstSeen.IsZero() {
+		firstSeen = event.CreationTimestamp.Time
+	}
+
+	lastSeen := event.LastTimestamp.Time
+	count := event.Count
+	if event.Series != nil {
+		lastSeen = event.Series.LastObservedTime.Time
+		count = event.Series.Count
+	}
+	if lastSeen.IsZero() {
+		lastSeen = firstSeen
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	apiGroup := ""
+	if gv, err := schema.ParseGroupVersion(event.InvolvedObject.APIVersion); err == nil {
+		apiGroup = gv.Group
+	}
+
+	return &models.ClusterEvent{
+		ClusterID: clusterID,
+		Source:    models.ClusterEventSourceEvent,
+		UID:       string(event.UID),
+		Kind:      event.InvolvedObject.Kind,
+		Namespace: event.InvolvedObject.Namespace,
+		Name:      event.InvolvedObject.Name,
+		APIGroup:  apiGroup,
+		ObjectUID: string(event.InvolvedObject.UID),
+		Type:      event.Type,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		Count:     count,
+		FirstSeen: firstSeen,
+		LastSeen:  lastSeen,
+	}
+}
+
+// clusterRESTConfig builds a rest config for a cluster record
+func clusterRESTConfig(cluster *models.Cluster) (*rest.Config, error) {
+	if cluster.InCluster {
+		return rest.InClusterConfig()
+	}
+	return clientcmd.RESTConfigFromKubeConfig([]byte(cluster.Config))
+}
+
+func clusterConfigHash(cluster *models.Cluster) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%t:%s", cluster.InCluster, cluster.Config)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,686 @@
+package k8s
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+// Object comparison states
+const (
+	CompareIdentical    = "identical"
+	CompareDifferent    = "different"
+	CompareOnlyInSource = "only_in_source"
+	CompareOnlyInTarget = "only_in_target"
+)
+
+// Field difference types
+const (
+	FieldAdded   = "added"   // Present only in target
+	FieldRemoved = "removed" // Present only in source
+	FieldChanged = "changed"
+)
+
+// comparableKinds maps the namespaced kinds that can be compared to their GVR
+var comparableKinds = map[string]schema.GroupVersionResource{
+	"Deployment":              {Group: "apps", Version: "v1", Resource: "deployments"},
+	"StatefulSet":             {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"DaemonSet":               {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"Service":                 {Group: "", Version: "v1", Resource: "services"},
+	"ConfigMap":               {Group: "", Version: "v1", Resource: "configmaps"},
+	"Secret":                  {Group: "", Version: "v1", Resource: "secrets"},
+	"ServiceAccount":          {Group: "", Version: "v1", Resource: "serviceaccounts"},
+	"PersistentVolumeClaim":   {Group: "", Version: "v1", Resource: "persistentvolumeclaims"},
+	"Ingress":                 {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	"NetworkPolicy":           {Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+	"CronJob":                 {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	"HorizontalPodAutoscaler": {Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+	"Role":                    {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	"RoleBinding":             {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+}
+
+// DefaultCompareKinds is used when a comparison does not name any kinds
+var DefaultCompareKinds = []string{"Deployment", "StatefulSet", "DaemonSet", "Service", "ConfigMap", "Secret", "Ingress", "CronJob"}
+
+// ignoredAnnotations are written by controllers and tooling, never by users
+var ignoredAnnotations = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+	"kubectl.kubernetes.io/restartedAt":                true,
+	"deployment.kubernetes.io/revision":                true,
+	"autoscaling.alpha.kubernetes.io/conditions":       true,
+	"autoscaling.alpha.kubernetes.io/current-metrics":  true,
+}
+
+// ignoredAnnotationPrefixes cover storage provisioning annotations on PVCs
+var ignoredAnnotationPrefixes = []string{
+	"pv.kubernetes.io/",
+	"volume.beta.kubernetes.io/",
+	"volume.kubernetes.io/",
+}
+
+// ignoredLabels are added to pod templates by the Job controller
+var ignoredLabels = map[string]bool{
+	"controller-uid":                     true,
+	"batch.kubernetes.io/controller-uid": true,
+}
+
+// generatedFields lists per-kind paths assigned by the API server
+var generatedFields = map[string][][]string{
+	"Service": {
+		{"spec", "clusterIP"},
+		{"spec", "clusterIPs"},
+		{"spec", "healthCheckNodePort"},
+	},
+	"ServiceAccount":        {{"secrets"}},
+	"PersistentVolumeClaim": {{"spec", "volumeName"}},
+}
+
+// CompareScope selects the objects of one namespace to compare or snapshot
+type CompareScope struct {
+	Namespace string   `json:"namespace"`
+	Kinds     []string `json:"kinds,omitempty"` // Defaults to DefaultCompareKinds
+	Names     []string `json:"names,omitempty"` // "name" or "Kind/name"; empty selects all
+}
+
+// CompareRequest describes a comparison between two clusters
+type CompareRequest struct {
+	SourceClusterID uuid.UUID `json:"source_cluster_id" binding:"required"`
+	TargetClusterID uuid.UUID `json:"target_cluster_id" binding:"required"`
+	SourceNamespace string    `json:"source_namespace" binding:"required"`
+	TargetNamespace string    `json:"target_namespace"` // Defaults to SourceNamespace
+	Kinds           []string  `json:"kinds,omitempty"`
+	Names           []string  `json:"names,omitempty"`
+}
+
+// ResourceSnapshot is a normalized object as stored in baselines
+type ResourceSnapshot struct {
+	Kind   string                 `json:"kind"`
+	Name   string                 `json:"name"`
+	Object map[string]interface{} `json:"object"`
+}
+
+// FieldDiff is a single differing field of an object
+type FieldDiff struct {
+	Path   string      `json:"path"`
+	Type   string      `json:"type"`
+	Source interface{} `json:"source,omitempty"`
+	Target interface{} `json:"target,omitempty"`
+}
+
+// ObjectDiff is the comparison result of one object
+type ObjectDiff struct {
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name"`
+	Status string      `json:"status"`
+	Diffs  []FieldDiff `json:"diffs,omitempty"`
+}
+
+// CompareSummary counts objects by comparison state
+type CompareSummary struct {
+	Total        int `json:"total"`
+	Identical    int `json:"identical"`
+	Different    int `json:"different"`
+	OnlyInSource int `json:"only_in_source"`
+	OnlyInTarget int `json:"only_in_target"`
+}
+
+// Drifted reports whether any object differs
+func (s CompareSummary) Drifted() bool {
+	return s.Different+s.OnlyInSource+s.OnlyInTarget > 0
+}
+
+// CompareResult is the normalized diff between two sets of objects
+type CompareResult struct {
+	Summary CompareSummary `json:"summary"`
+	Items   []ObjectDiff   `json:"items"`
+}
+
+// DynamicClientFactory builds a dynamic client for a cluster record
+type DynamicClientFactory func(cluster *models.Cluster) (dynamic.Interface, error)
+
+// ClusterComparator fetches and compares resources across clusters
+type ClusterComparator struct {
+	clusterRepo   repository.ClusterRepositoryInterface
+	clientFactory DynamicClientFactory
+	secretKey     []byte // HMAC key for Secret value digests
+}
+
+// NewClusterComparator creates a new ClusterComparator instance
+func NewClusterComparator(clusterRepo repository.ClusterRepositoryInterface) *ClusterComparator {
+	return &ClusterComparator{
+		clusterRepo:   clusterRepo,
+		clientFactory: newDynamicClient,
+	}
+}
+
+// NewClusterComparatorWithFactory creates a ClusterComparator with a custom client factory (used in tests)
+func NewClusterComparatorWithFactory(clusterRepo repository.ClusterRepositoryInterface, factory DynamicClientFactory) *ClusterComparator {
+	return &ClusterComparator{
+		clusterRepo:   clusterRepo,
+		clientFactory: factory,
+	}
+}
+
+// SetSecretKey sets the server-side key used to digest Secret values
+func (c *ClusterComparator) SetSecretKey(key []byte) {
+	c.secretKey = key
+}
+
+func newDynamicClient(cluster *models.Cluster) (dynamic.Interface, error) {
+	config, err := clusterRESTConfig(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(config)
+}
+
+// Compare fetches the selected objects from both clusters and diffs them
+func (c *ClusterComparator) Compare(ctx context.Context, req *CompareRequest) (*CompareResult, error) {
+	targetNamespace := req.TargetNamespace
+	if targetNamespace == "" {
+		targetNamespace = req.SourceNamespace
+	}
+
+	source, err := c.Snapshot(ctx, req.SourceClusterID, &CompareScope{
+		Namespace: req.SourceNamespace,
+		Kinds:     req.Kinds,
+		Names:     req.Names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("source cluster: %w", err)
+	}
+
+	target, err := c.Snapshot(ctx, req.TargetClusterID, &CompareScope{
+		Namespace: targetNamespace,
+		Kinds:     req.Kinds,
+		Names:     req.Names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("target cluster: %w", err)
+	}
+
+	return DiffSnapshots(source, target), nil
+}
+
+// Snapshot fetches and normalizes the objects selected by scope
+func (c *ClusterComparator) Snapshot(ctx context.Context, clusterID uuid.UUID, scope *CompareScope) ([]ResourceSnapshot, error) {
+	if scope.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	kinds, err := resolveCompareKinds(scope.Kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.clusterRepo.GetByID(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cluster %s: %w", clusterID, err)
+	}
+
+	client, err := c.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for cluster %s: %w", cluster.Name, err)
+	}
+
+	var snapshots []ResourceSnapshot
+	for _, kind := range kinds {
+		list, err := client.Resource(comparableKinds[kind]).Namespace(scope.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue // API not served by this cluster
+			}
+			return nil, fmt.Errorf("failed to list %s in cluster %s: %w", kind, cluster.Name, err)
+		}
+
+		for i := range list.Items {
+			item := &list.Items[i]
+			name := item.GetName()
+			if !matchesCompareNames(scope.Names, kind, name) || isClusterManagedObject(kind, item.Object) {
+				continue
+			}
+			snapshots = append(snapshots, ResourceSnapshot{
+				Kind:   kind,
+				Name:   name,
+				Object: NormalizeObject(kind, item.Object, c.secretKey),
+			})
+		}
+	}
+
+	sortSnapshots(snapshots)
+	return snapshots, nil
+}
+
+func resolveCompareKinds(kinds []string) ([]string, error) {
+	if len(kinds) == 0 {
+		return DefaultCompareKinds, nil
+	}
+
+	resolved := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		found := ""
+		for known := range comparableKinds {
+			if strings.EqualFold(known, kind) || strings.EqualFold(comparableKinds[known].Resource, kind) {
+				found = known
+				break
+			}
+		}
+		if found == "" {
+			return nil, fmt.Errorf("unsupported kind for comparison: %s", kind)
+		}
+		resolved = append(resolved, found)
+	}
+	return resolved, nil
+}
+
+func matchesCompareNames(names []string, kind, name string) bool {
+	if len(names) == 0 {
+		return true
+	}
+	for _, n := range names {
+		if k, objName, ok := strings.Cut(n, "/"); ok {
+			if strings.EqualFold(k, kind) && objName == name {
+				return true
+			}
+		} else if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isClusterManagedObject reports objects every cluster generates on its own
+func isClusterManagedObject(kind string, obj map[string]interface{}) bool {
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+
+	switch kind {
+	case "ConfigMap":
+		return name == "kube-root-ca.crt"
+	case "ServiceAccount":
+		return name == "default"
+	case "Secret":
+		secretType, _ := obj["type"].(string)
+		return secretType == "kubernetes.io/service-account-token"
+	}
+	return false
+}
+
+// NormalizeObject strips status, server-populated metadata and generated
+// fields so that semantically equal objects compare equal across clusters.
+// Secret values are replaced by an HMAC-SHA256 keyed with secretKey, so diffs
+// and stored baselines neither expose them nor allow guessing them offline.
+func NormalizeObject(kind string, obj map[string]interface{}, secretKey []byte) map[string]interface{} {
+	out := deepCopyMap(obj)
+	delete(out, "status")
+
+	if metadata, ok := out["metadata"].(map[string]interface{}); ok {
+		out["metadata"] = normalizeMetadata(metadata)
+	}
+
+	// Pod templates carry their own metadata
+	if template, ok := nestedMap(out, "spec", "template"); ok {
+		if metadata, ok := template["metadata"].(map[string]interface{}); ok {
+			template["metadata"] = normalizeMetadata(metadata)
+		}
+	}
+	if template, ok := nestedMap(out, "spec", "jobTemplate", "spec", "template"); ok {
+		if metadata, ok := template["metadata"].(map[string]interface{}); ok {
+			template["metadata"] = normalizeMetadata(metadata)
+		}
+	}
+
+	for _, path := range generatedFields[kind] {
+		removeNested(out, path)
+	}
+
+	if kind == "Service" {
+		if ports, ok := nestedSlice(out, "spec", "ports"); ok {
+			for _, p := range ports {
+				if port, ok := p.(map[string]interface{}); ok {
+					delete(port, "nodePort")
+				}
+			}
+		}
+	}
+
+	if kind == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			if data, ok := out[field].(map[string]interface{}); ok {
+				for k, v := range data {
+					mac := hmac.New(sha256.New, secretKey)
+					mac.Write([]byte(fmt.Sprint(v)))
+					data[k] = "hmac-sha256:" + hex.EncodeToString(mac.Sum(nil))
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+func normalizeMetadata(metadata map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	if name, ok := metadata["name"]; ok {
+		out["name"] = name
+	}
+
+	if labels, ok := metadata["labels"].(map[string]interface{}); ok {
+		kept := make(map[string]interface{})
+		for k, v := range labels {
+			if !ignoredLabels[k] {
+				kept[k] = v
+			}
+		}
+		if len(kept) > 0 {
+			out["labels"] = kept
+		}
+	}
+
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		kept := make(map[string]interface{})
+		for k, v := range annotations {
+			if !isIgnoredAnnotation(k) {
+				kept[k] = v
+			}
+		}
+		if len(kept) > 0 {
+			out["annotations"] = kept
+		}
+	}
+
+	return out
+}
+
+func isIgnoredAnnotation(key string) bool {
+	if ignoredAnnotations[key] {
+		return true
+	}
+	for _, prefix := range ignoredAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffSnapshots compares two normalized object sets
+func DiffSnapshots(source, target []ResourceSnapshot) *CompareResult {
+	key := func(s ResourceSnapshot) string { return s.Kind + "/" + s.Name }
+
+	targetByKey := make(map[string]ResourceSnapshot, len(target))
+	for _, t := range target {
+		targetByKey[key(t)] = t
+	}
+
+	result := &CompareResult{Items: []ObjectDiff{}}
+	seen := make(map[string]bool, len(source))
+	for _, s := range source {
+		k := key(s)
+		seen[k] = true
+
+		t, ok := targetByKey[k]
+		if !ok {
+			result.Items = append(result.Items, ObjectDiff{Kind: s.Kind, Name: s.Name, Status: CompareOnlyInSource})
+			result.Summary.OnlyInSource++
+			continue
+		}
+
+		diffs := DiffObjects(s.Object, t.Object)
+		if len(diffs) == 0 {
+			result.Items = append(result.Items, ObjectDiff{Kind: s.Kind, Name: s.Name, Status: CompareIdentical})
+			result.Summary.Identical++
+		} else {
+			result.Items = append(result.Items, ObjectDiff{Kind: s.Kind, Name: s.Name, Status: CompareDifferent, Diffs: diffs})
+			result.Summary.Different++
+		}
+	}
+
+	for _, t := range target {
+		if !seen[key(t)] {
+			result.Items = append(result.Items, ObjectDiff{Kind: t.Kind, Name: t.Name, Status: CompareOnlyInTarget})
+			result.Summary.OnlyInTarget++
+		}
+	}
+
+	result.Summary.Total = len(result.Items)
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		if result.Items[i].Kind != result.Items[j].Kind {
+			return result.Items[i].Kind < result.Items[j].Kind
+		}
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result
+}
+
+// DiffObjects returns the field-level differences between two normalized objects
+func DiffObjects(source, target map[string]interface{}) []FieldDiff {
+	var diffs []FieldDiff
+	diffValues("", source, target, &diffs)
+	return diffs
+}
+
+func diffValues(path string, source, target interface{}, diffs *[]FieldDiff) {
+	switch s := source.(type) {
+	case map[string]interface{}:
+		if t, ok := target.(map[string]interface{}); ok {
+			diffMaps(path, s, t, diffs)
+			return
+		}
+	case []interface{}:
+		if t, ok := target.([]interface{}); ok {
+			diffSlices(path, s, t, diffs)
+			return
+		}
+	}
+
+	if !scalarEqual(source, target) {
+		*diffs = append(*diffs, FieldDiff{Path: path, Type: FieldChanged, Source: source, Target: target})
+	}
+}
+
+// scalarEqual compares leaf values. Numbers are compared by value because
+// live objects decode to int64 while stored snapshots decode to float64.
+func scalarEqual(a, b interface{}) bool {
+	if x, ok := toFloat(a); ok {
+		if y, ok := toFloat(b); ok {
+			return x == y
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func diffMaps(path string, source, target map[string]interface{}, diffs *[]FieldDiff) {
+	keys := make([]string, 0, len(source)+len(target))
+	for k := range source {
+		keys = append(keys, k)
+	}
+	for k := range target {
+		if _, ok := source[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		childPath := joinPath(path, k)
+		s, inSource := source[k]
+		t, inTarget := target[k]
+		switch {
+		case !inTarget:
+			*diffs = append(*diffs, FieldDiff{Path: childPath, Type: FieldRemoved, Source: s})
+		case !inSource:
+			*diffs = append(*diffs, FieldDiff{Path: childPath, Type: FieldAdded, Target: t})
+		default:
+			diffValues(childPath, s, t, diffs)
+		}
+	}
+}
+
+// diffSlices matches list items by their "name" field when every item has one
+// (containers, env, ports, volumes), and by index otherwise
+func diffSlices(path string, source, target []interface{}, diffs *[]FieldDiff) {
+	sourceNames, sourceNamed := namedItems(source)
+	targetNames, targetNamed := namedItems(target)
+
+	if sourceNamed && targetNamed {
+		names := make([]string, 0, len(sourceNames)+len(targetNames))
+		for _, item := range source {
+			names = append(names, item.(map[string]interface{})["name"].(string))
+		}
+		for _, item := range target {
+			name := item.(map[string]interface{})["name"].(string)
+			if _, ok := sourceNames[name]; !ok {
+				names = append(names, name)
+			}
+		}
+
+		for _, name := range names {
+			childPath := fmt.Sprintf("%s[name=%s]", path, name)
+			s, inSource := sourceNames[name]
+			t, inTarget := targetNames[name]
+			switch {
+			case !inTarget:
+				*diffs = append(*diffs, FieldDiff{Path: childPath, Type: FieldRemoved, Source: s})
+			case !inSource:
+				*diffs = append(*diffs, FieldDiff{Path: childPath, Type: FieldAdded, Target: t})
+			default:
+				diffValues(childPath, s, t, diffs)
+			}
+		}
+		return
+	}
+
+	for i := 0; i < len(source) || i < len(target); i++ {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(target):
+			*diffs = append(*diffs, FieldDiff{Path: childPath, Type: FieldRemoved, Source: source[i]})
+		case i >= len(source):
+			*diffs = append(*diffs, FieldDiff{Path: childPath, Type: FieldAdded, Target: target[i]})
+		default:
+			diffValues(childPath, source[i], target[i], diffs)
+		}
+	}
+}
+
+func namedItems(items []interface{}) (map[string]interface{}, bool) {
+	if len(items) == 0 {
+		return map[string]interface{}{}, true
+	}
+	byName := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		if _, dup := byName[name]; dup {
+			return nil, false
+		}
+		byName[name] = m
+	}
+	return byName, true
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortSnapshots(snapshots []ResourceSnapshot) {
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].Kind != snapshots[j].Kind {
+			return snapshots[i].Kind < snapshots[j].Kind
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+}
+
+func nestedMap(obj map[string]interface{}, fields ...string) (map[string]interface{}, bool) {
+	current := obj
+	for _, f := range fields {
+		next, ok := current[f].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+func nestedSlice(obj map[string]interface{}, fields ...string) ([]interface{}, bool) {
+	parent, ok := nestedMap(obj, fields[:len(fields)-1]...)
+	if !ok {
+		return nil, false
+	}
+	s, ok := parent[fields[len(fields)-1]].([]interface{})
+	return s, ok
+}
+
+func removeNested(obj map[string]interface{}, path []string) {
+	parent, ok := nestedMap(obj, path[:len(path)-1]...)
+	if ok {
+		delete(parent, path[len(path)-1])
+	}
+}
+
+func deepCopyMap(in map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = deepCopyValue(v)
+	}
+	return out
+}
+
+func deepCopyValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return deepCopyMap(val)
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = deepCopyValue(item)
+		}
+		return out
+	default:
+		return val
+	}
+}
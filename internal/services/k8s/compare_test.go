@@ -0,0 +1,292 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/notification"
+)
+
+func testDeployment(name string, replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         "shop",
+			"uid":               uuid.NewString(),
+			"resourceVersion":   "123",
+			"generation":        int64(4),
+			"creationTimestamp": "2025-01-01T00:00:00Z",
+			"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			"annotations": map[string]interface{}{
+				"deployment.kubernetes.io/revision": "7",
+				"team":                              "checkout",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{"kubectl.kubernetes.io/restartedAt": "2025-01-02T00:00:00Z"},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "sidecar", "image": "envoy:1.30"},
+						map[string]interface{}{"name": "app", "image": image},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"readyReplicas": replicas},
+	}}
+}
+
+func TestNormalizeObject(t *testing.T) {
+	obj := NormalizeObject("Deployment", testDeployment("web", 3, "web:1").Object, nil)
+
+	assert.NotContains(t, obj, "status")
+	assert.Equal(t, map[string]interface{}{
+		"name":        "web",
+		"annotations": map[string]interface{}{"team": "checkout"},
+	}, obj["metadata"])
+
+	template, ok := nestedMap(obj, "spec", "template")
+	require.True(t, ok)
+	assert.Empty(t, template["metadata"], "restartedAt is ignored")
+
+	service := NormalizeObject("Service", map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "web"},
+		"spec": map[string]interface{}{
+			"clusterIP":  "10.0.0.1",
+			"clusterIPs": []interface{}{"10.0.0.1"},
+			"ports":      []interface{}{map[string]interface{}{"name": "http", "port": int64(80), "nodePort": int64(31000)}},
+		},
+	}, nil)
+	assert.Equal(t, map[string]interface{}{
+		"ports": []interface{}{map[string]interface{}{"name": "http", "port": int64(80)}},
+	}, service["spec"])
+
+	secretObj := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "db"},
+		"data":     map[string]interface{}{"password": "c2VjcmV0"},
+	}
+	secret := NormalizeObject("Secret", secretObj, []byte("server-key"))
+	hashed := secret["data"].(map[string]interface{})["password"].(string)
+	assert.Contains(t, hashed, "hmac-sha256:")
+	assert.NotContains(t, hashed, "c2VjcmV0")
+	// The digest depends on the server key, so it cannot be recomputed without it
+	other := NormalizeObject("Secret", secretObj, []byte("other-key"))
+	assert.NotEqual(t, hashed, other["data"].(map[string]interface{})["password"])
+}
+
+func TestDiffObjects(t *testing.T) {
+	source := NormalizeObject("Deployment", testDeployment("web", 3, "web:1").Object, nil)
+	target := NormalizeObject("Deployment", testDeployment("web", 3, "web:1").Object, nil)
+	assert.Empty(t, DiffObjects(source, target), "generated fields must not produce a diff")
+
+	target = NormalizeObject("Deployment", testDeployment("web", 5, "web:2").Object, nil)
+	diffs := DiffObjects(source, target)
+	require.Len(t, diffs, 2)
+	assert.Equal(t, "spec.replicas", diffs[0].Path)
+	assert.Equal(t, "spec.template.spec.containers[name=app].image", diffs[1].Path)
+	assert.Equal(t, "web:1", diffs[1].Source)
+	assert.Equal(t, "web:2", diffs[1].Target)
+
+	// Snapshots decoded from JSON carry float64 numbers
+	assert.Empty(t, DiffObjects(map[string]interface{}{"n": int64(3)}, map[string]interface{}{"n": float64(3)}))
+}
+
+func setupCompareTest(t *testing.T, objects map[string][]runtime.Object) (*gorm.DB, *repository.ClusterBaselineRepository, *ClusterComparator, map[string]uuid.UUID) {
+	db, _, _ := setupTimelineTest(t)
+	require.NoError(t, db.AutoMigrate(&models.ClusterBaseline{}, &models.MonitorAlertRule{}, &models.MonitorAlertEvent{}))
+
+	clusterRepo := repository.NewClusterRepository(db)
+	ids := make(map[string]uuid.UUID)
+	for name := range objects {
+		cluster := &models.Cluster{Name: name, Enable: true}
+		require.NoError(t, clusterRepo.Create(context.Background(), cluster))
+		ids[name] = cluster.ID
+	}
+
+	listKinds := make(map[schema.GroupVersionResource]string)
+	for kind, gvr := range comparableKinds {
+		listKinds[gvr] = kind + "List"
+	}
+	clients := make(map[uuid.UUID]dynamic.Interface)
+	for name, objs := range objects {
+		clients[ids[name]] = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objs...)
+	}
+
+	comparator := NewClusterComparatorWithFactory(clusterRepo, func(cluster *models.Cluster) (dynamic.Interface, error) {
+		return clients[cluster.ID], nil
+	})
+	return db, repository.NewClusterBaselineRepository(db), comparator, ids
+}
+
+func TestClusterComparator_Compare(t *testing.T) {
+	_, _, comparator, ids := setupCompareTest(t, map[string][]runtime.Object{
+		"staging": {testDeployment("web", 1, "web:2"), testDeployment("worker", 1, "worker:1"), testDeployment("canary", 1, "web:3")},
+		"prod":    {testDeployment("web", 1, "web:1"), testDeployment("worker", 1, "worker:1"), testDeployment("legacy", 1, "old:1")},
+	})
+
+	result, err := comparator.Compare(context.Background(), &CompareRequest{
+		SourceClusterID: ids["staging"],
+		TargetClusterID: ids["prod"],
+		SourceNamespace: "shop",
+		Kinds:           []string{"deployments"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, CompareSummary{Total: 4, Identical: 1, Different: 1, OnlyInSource: 1, OnlyInTarget: 1}, result.Summary)
+	status := make(map[string]string)
+	for _, item := range result.Items {
+		status[item.Name] = item.Status
+	}
+	assert.Equal(t, map[string]string{
+		"canary": CompareOnlyInSource,
+		"legacy": CompareOnlyInTarget,
+		"web":    CompareDifferent,
+		"worker": CompareIdentical,
+	}, status)
+
+	_, err = comparator.Compare(context.Background(), &CompareRequest{
+		SourceClusterID: ids["staging"],
+		TargetClusterID: ids["prod"],
+		SourceNamespace: "shop",
+		Kinds:           []string{"Widget"},
+	})
+	assert.Error(t, err)
+}
+
+func TestBaselineService_DriftCheck(t *testing.T) {
+	ctx := context.Background()
+	db, baselineRepo, comparator, ids := setupCompareTest(t, map[string][]runtime.Object{
+		"prod": {testDeployment("web", 3, "web:1")},
+	})
+
+	svc := NewBaselineService(db, baselineRepo, comparator)
+	alertRepo := repository.NewMonitorAlertRepository(db)
+	driftAlerts := func(status models.MonitorAlertStatus) []*models.MonitorAlertEvent {
+		var events []*models.MonitorAlertEvent
+		require.NoError(t, db.Where("status = ?", status).Find(&events).Error)
+		return events
+	}
+	var sent []*notification.Notification
+	svc.notify = func(_ context.Context, _ string, n *notification.Notification) error {
+		sent = append(sent, n)
+		return nil
+	}
+
+	baseline, err := svc.Create(ctx, &CreateBaselineRequest{
+		Name:             "prod-shop",
+		ClusterID:        ids["prod"],
+		Namespace:        "shop",
+		Kinds:            []string{"Deployment"},
+		NotifyWebhookURL: "http://example.invalid/hook",
+	}, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, 1, baseline.ObjectCount)
+	assert.True(t, baseline.DriftCheckEnabled)
+
+	checked, drifted, err := svc.CheckAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, checked)
+	assert.Equal(t, 0, drifted)
+	assert.Empty(t, sent)
+
+	// Someone scales the deployment by hand
+	client, err := comparator.clientFactory(&models.Cluster{ID: ids["prod"]})
+	require.NoError(t, err)
+	_, err = client.Resource(comparableKinds["Deployment"]).Namespace("shop").
+		Update(ctx, testDeployment("web", 5, "web:1"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, drifted, err = svc.CheckAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, drifted)
+	require.Len(t, sent, 1)
+	assert.Equal(t, notification.SeverityWarning, sent[0].Severity)
+	firing := driftAlerts(models.AlertStatusFiring)
+	require.Len(t, firing, 1)
+	rule, err := alertRepo.GetRuleByID(ctx, firing[0].RuleID)
+	require.NoError(t, err)
+	assert.Equal(t, models.AlertTypeBaseline, rule.Type)
+	assert.Equal(t, baseline.ID, rule.TargetID)
+
+	// A persistent drift is reported only once
+	_, result, err := svc.Check(ctx, baseline.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Summary.Different)
+	assert.Len(t, sent, 1)
+	assert.Len(t, driftAlerts(models.AlertStatusFiring), 1)
+
+	stored, err := baselineRepo.GetByID(ctx, baseline.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.DriftStatusDrifted, stored.DriftStatus)
+	assert.NotNil(t, stored.LastDriftAt)
+
+	// Recapturing accepts the live state as the new baseline
+	_, err = svc.Recapture(ctx, baseline.ID)
+	require.NoError(t, err)
+	_, result, err = svc.Check(ctx, baseline.ID)
+	require.NoError(t, err)
+	assert.False(t, result.Summary.Drifted())
+	assert.Len(t, sent, 1, "recapture resets the status without a recovery notice")
+	assert.Empty(t, driftAlerts(models.AlertStatusFiring), "recapture resolves the drift alert")
+	assert.Len(t, driftAlerts(models.AlertStatusResolved), 1)
+}
+
+func TestBaselineService_DriftAlertWithoutWebhook(t *testing.T) {
+	ctx := context.Background()
+	db, baselineRepo, comparator, ids := setupCompareTest(t, map[string][]runtime.Object{
+		"prod": {testDeployment("web", 3, "web:1")},
+	})
+	svc := NewBaselineService(db, baselineRepo, comparator)
+	svc.notify = func(context.Context, string, *notification.Notification) error {
+		t.Error("no webhook is configured")
+		return nil
+	}
+
+	baseline, err := svc.Create(ctx, &CreateBaselineRequest{
+		Name:      "prod-shop",
+		ClusterID: ids["prod"],
+		Namespace: "shop",
+		Kinds:     []string{"Deployment"},
+	}, uuid.New())
+	require.NoError(t, err)
+
+	client, err := comparator.clientFactory(&models.Cluster{ID: ids["prod"]})
+	require.NoError(t, err)
+	_, err = client.Resource(comparableKinds["Deployment"]).Namespace("shop").
+		Update(ctx, testDeployment("web", 5, "web:1"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, _, err = svc.Check(ctx, baseline.ID)
+	require.NoError(t, err)
+
+	var firing int64
+	require.NoError(t, db.Model(&models.MonitorAlertEvent{}).Where("status = ?", models.AlertStatusFiring).Count(&firing).Error)
+	assert.Equal(t, int64(1), firing)
+
+	// Back in sync resolves the alert
+	_, err = client.Resource(comparableKinds["Deployment"]).Namespace("shop").
+		Update(ctx, testDeployment("web", 3, "web:1"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, _, err = svc.Check(ctx, baseline.ID)
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&models.MonitorAlertEvent{}).Where("status = ?", models.AlertStatusFiring).Count(&firing).Error)
+	assert.Zero(t, firing)
+}
@@ -0,0 +1,228 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+// Timeline entry sources
+const (
+	TimelineSourceEvent = models.ClusterEventSourceEvent // Warning event from the cluster
+	TimelineSourceAudit = models.ClusterEventSourceAudit // API server audit entry
+	TimelineSourceTiga  = "tiga"                         // Change made through tiga (ResourceHistory)
+)
+
+// kindResources maps well-known kinds to their plural resource names. Events
+// use the kind, audit entries and ResourceHistory use the resource name.
+var kindResources = map[string]string{
+	"pod":                     "pods",
+	"service":                 "services",
+	"configmap":               "configmaps",
+	"secret":                  "secrets",
+	"namespace":               "namespaces",
+	"node":                    "nodes",
+	"persistentvolume":        "persistentvolumes",
+	"persistentvolumeclaim":   "persistentvolumeclaims",
+	"serviceaccount":          "serviceaccounts",
+	"endpoints":               "endpoints",
+	"deployment":              "deployments",
+	"replicaset":              "replicasets",
+	"statefulset":             "statefulsets",
+	"daemonset":               "daemonsets",
+	"job":                     "jobs",
+	"cronjob":                 "cronjobs",
+	"ingress":                 "ingresses",
+	"networkpolicy":           "networkpolicies",
+	"horizontalpodautoscaler": "horizontalpodautoscalers",
+	"poddisruptionbudget":     "poddisruptionbudgets",
+	"role":                    "roles",
+	"rolebinding":             "rolebindings",
+	"clusterrole":             "clusterroles",
+	"clusterrolebinding":      "clusterrolebindings",
+	"storageclass":            "storageclasses",
+	"cloneset":                "clonesets",
+}
+
+// resourceKinds is the reverse of kindResources
+var resourceKinds = func() map[string]string {
+	m := make(map[string]string, len(kindResources))
+	for kind, resource := range kindResources {
+		m[resource] = kind
+	}
+	return m
+}()
+
+// KindForResource returns the kind for a plural resource name ("deployments" -> "deployment").
+// Unknown resources are returned unchanged.
+func KindForResource(resource string) string {
+	if kind, ok := resourceKinds[strings.ToLower(resource)]; ok {
+		return kind
+	}
+	return resource
+}
+
+// KindAliases returns every spelling under which a kind may be stored:
+// the kind itself and its plural resource name, both lower-cased
+func KindAliases(kind string) []string {
+	lower := strings.ToLower(kind)
+	if lower == "" {
+		return nil
+	}
+	if resource, ok := kindResources[lower]; ok {
+		return []string{lower, resource}
+	}
+	if k, ok := resourceKinds[lower]; ok {
+		return []string{k, lower}
+	}
+	return []string{lower}
+}
+
+// TimelineQuery selects the entries returned by ResourceTimeline
+type TimelineQuery struct {
+	Kind      string
+	Namespace string
+	Name      string
+	// IncludeRelated also matches objects named "<Name>-..." such as the
+	// ReplicaSets and Pods of a Deployment
+	IncludeRelated bool
+	Query          string
+	Since          time.Time
+	Limit          int
+}
+
+// TimelineEntry is one item of a resource timeline
+type TimelineEntry struct {
+	Time        time.Time `json:"time"`
+	Source      string    `json:"source"`
+	Kind        string    `json:"kind"`
+	Namespace   string    `json:"namespace"`
+	Name        string    `json:"name"`
+	Action      string    `json:"action"` // Event reason, audit verb or tiga operation
+	Subresource string    `json:"subresource,omitempty"`
+	Type        string    `json:"type,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	Count       int32     `json:"count,omitempty"`
+	User        string    `json:"user,omitempty"`
+	Success     bool      `json:"success"`
+	RefID       uuid.UUID `json:"ref_id"` // ClusterEvent or ResourceHistory ID
+}
+
+// ClusterTimelineService merges collected cluster events, audit entries and
+// tiga's own ResourceHistory into a single per-resource timeline
+type ClusterTimelineService struct {
+	eventRepo   *repository.ClusterEventRepository
+	historyRepo repository.ResourceHistoryRepositoryInterface
+}
+
+// NewClusterTimelineService creates a new ClusterTimelineService instance
+func NewClusterTimelineService(
+	eventRepo *repository.ClusterEventRepository,
+	historyRepo repository.ResourceHistoryRepositoryInterface,
+) *ClusterTimelineService {
+	return &ClusterTimelineService{
+		eventRepo:   eventRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+// ResourceTimeline answers "what happened to <kind>/<name> since <time>",
+// newest first
+func (s *ClusterTimelineService) ResourceTimeline(ctx context.Context, clusterID uuid.UUID, q *TimelineQuery) ([]*TimelineEntry, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 200
+	}
+	since := q.Since
+
+	events, _, err := s.eventRepo.Search(ctx, clusterID, &repository.ClusterEventFilter{
+		Kinds:          KindAliases(q.Kind),
+		Namespace:      q.Namespace,
+		Name:           q.Name,
+		IncludeRelated: q.IncludeRelated,
+		Query:          q.Query,
+		Since:          &since,
+		PageSize:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*TimelineEntry, 0, len(events))
+	for _, e := range events {
+		entries = append(entries, timelineEntryFromEvent(e))
+	}
+
+	// Changes made through tiga. Related objects are never edited directly,
+	// so only the exact resource is matched; free-text search does not apply.
+	if q.Query == "" {
+		histories, _, err := s.historyRepo.ListByCluster(ctx, clusterID, &repository.ResourceHistoryFilter{
+			ResourceTypes: KindAliases(q.Kind),
+			ResourceName:  q.Name,
+			Namespace:     q.Namespace,
+			StartTime:     &since,
+			Page:          1,
+			PageSize:      limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range histories {
+			entries = append(entries, timelineEntryFromHistory(h))
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+func timelineEntryFromEvent(e *models.ClusterEvent) *TimelineEntry {
+	entry := &TimelineEntry{
+		Time:        e.LastSeen,
+		Source:      e.Source,
+		Kind:        e.Kind,
+		Namespace:   e.Namespace,
+		Name:        e.Name,
+		Subresource: e.Subresource,
+		Type:        e.Type,
+		Message:     e.Message,
+		Count:       e.Count,
+		User:        e.Username,
+		RefID:       e.ID,
+	}
+	if e.Source == models.ClusterEventSourceAudit {
+		entry.Action = e.Verb
+		entry.Success = e.ResponseCode < 400
+	} else {
+		entry.Action = e.Reason
+		entry.Success = e.Type != "Warning"
+	}
+	return entry
+}
+
+func timelineEntryFromHistory(h *models.ResourceHistory) *TimelineEntry {
+	return &TimelineEntry{
+		Time:      h.CreatedAt,
+		Source:    TimelineSourceTiga,
+		Kind:      KindForResource(h.ResourceType),
+		Namespace: h.Namespace,
+		Name:      h.ResourceName,
+		Action:    h.OperationType,
+		Message:   h.ErrorMessage,
+		User:      h.OperatorName,
+		Success:   h.Success,
+		RefID:     h.ID,
+	}
+}
@@ -0,0 +1,163 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func setupTimelineTest(t *testing.T) (*gorm.DB, *repository.ClusterEventRepository, *ClusterTimelineService) {
+	db := testdb.Open(t, &models.Cluster{}, &models.User{}, &models.ResourceHistory{}, &models.ClusterEvent{})
+
+	eventRepo := repository.NewClusterEventRepository(db)
+	historyRepo := repository.NewResourceHistoryRepository(db)
+	return db, eventRepo, NewClusterTimelineService(eventRepo, historyRepo)
+}
+
+const testAuditPayload = `{
+  "kind": "EventList",
+  "apiVersion": "audit.k8s.io/v1",
+  "items": [
+    {
+      "auditID": "a-1", "stage": "ResponseComplete", "verb": "patch",
+      "user": {"username": "alice"}, "sourceIPs": ["10.0.0.1"], "userAgent": "kubectl/v1.30",
+      "objectRef": {"resource": "deployments", "namespace": "prod", "name": "web", "apiGroup": "apps"},
+      "responseStatus": {"code": 200},
+      "requestReceivedTimestamp": "2025-01-01T10:00:00Z", "stageTimestamp": "2025-01-01T10:00:01Z"
+    },
+    {
+      "auditID": "a-2", "stage": "ResponseComplete", "verb": "get",
+      "user": {"username": "bob"},
+      "objectRef": {"resource": "deployments", "namespace": "prod", "name": "web"},
+      "requestReceivedTimestamp": "2025-01-01T10:00:00Z", "stageTimestamp": "2025-01-01T10:00:01Z"
+    },
+    {
+      "auditID": "a-3", "stage": "ResponseComplete", "verb": "create",
+      "user": {"username": "bob"},
+      "objectRef": {"resource": "pods", "namespace": "prod", "name": "web-7d4-abc", "subresource": "exec"},
+      "responseStatus": {"code": 403, "message": "forbidden"},
+      "requestReceivedTimestamp": "2025-01-01T10:01:00Z", "stageTimestamp": "2025-01-01T10:01:00Z"
+    },
+    {
+      "auditID": "a-4", "stage": "RequestReceived", "verb": "delete",
+      "user": {"username": "bob"},
+      "objectRef": {"resource": "deployments", "namespace": "prod", "name": "web"}
+    }
+  ]
+}`
+
+func TestParseAuditEvents(t *testing.T) {
+	clusterID := uuid.New()
+
+	events, err := ParseAuditEvents(clusterID, []byte(testAuditPayload))
+	require.NoError(t, err)
+	require.Len(t, events, 2, "reads and non-final stages are dropped")
+
+	assert.Equal(t, "a-1", events[0].UID)
+	assert.Equal(t, "deployment", events[0].Kind)
+	assert.Equal(t, "alice", events[0].Username)
+	assert.Equal(t, "10.0.0.1", events[0].SourceIPs)
+	assert.Equal(t, models.ClusterEventSourceAudit, events[0].Source)
+
+	assert.Equal(t, "pod", events[1].Kind)
+	assert.Equal(t, "exec", events[1].Subresource)
+	assert.Equal(t, 403, events[1].ResponseCode)
+	assert.Equal(t, "forbidden", events[1].Message)
+
+	_, err = ParseAuditEvents(clusterID, []byte(`{"kind":"Pod"}`))
+	assert.Error(t, err)
+}
+
+func TestAuditWebhookToken(t *testing.T) {
+	clusterID := uuid.New()
+	token := AuditWebhookToken("secret", clusterID)
+
+	assert.True(t, VerifyAuditWebhookToken("secret", clusterID, token))
+	assert.False(t, VerifyAuditWebhookToken("other", clusterID, token))
+	assert.False(t, VerifyAuditWebhookToken("secret", uuid.New(), token))
+}
+
+func TestKindAliases(t *testing.T) {
+	assert.Equal(t, []string{"deployment", "deployments"}, KindAliases("Deployment"))
+	assert.Equal(t, []string{"deployment", "deployments"}, KindAliases("deployments"))
+	assert.Equal(t, []string{"widget"}, KindAliases("Widget"))
+	assert.Nil(t, KindAliases(""))
+}
+
+func TestResourceTimeline(t *testing.T) {
+	db, eventRepo, svc := setupTimelineTest(t)
+	ctx := context.Background()
+	clusterID := uuid.New()
+	now := time.Now()
+
+	// Warning event on a pod owned by the deployment, repeated twice
+	warning := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{UID: types.UID("ev-1")},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "prod", Name: "web-7d4-abc", APIVersion: "v1"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container",
+		Count:          1,
+		FirstTimestamp: metav1.NewTime(now.Add(-20 * time.Minute)),
+		LastTimestamp:  metav1.NewTime(now.Add(-20 * time.Minute)),
+	}
+	require.NoError(t, eventRepo.Upsert(ctx, ClusterEventFromK8s(clusterID, warning)))
+	warning.Count = 5
+	warning.LastTimestamp = metav1.NewTime(now.Add(-5 * time.Minute))
+	require.NoError(t, eventRepo.Upsert(ctx, ClusterEventFromK8s(clusterID, warning)))
+
+	// Audit entry on the deployment itself
+	require.NoError(t, eventRepo.Upsert(ctx, &models.ClusterEvent{
+		ClusterID: clusterID, Source: models.ClusterEventSourceAudit, UID: "a-1",
+		Kind: "deployment", Namespace: "prod", Name: "web", Verb: "patch", Username: "alice",
+		ResponseCode: 200, Count: 1, FirstSeen: now.Add(-10 * time.Minute), LastSeen: now.Add(-10 * time.Minute),
+	}))
+
+	// Change made through tiga, and an unrelated old event outside the window
+	require.NoError(t, db.Create(&models.ResourceHistory{
+		ID: uuid.New(), ClusterID: clusterID, ResourceType: "deployments", ResourceName: "web",
+		Namespace: "prod", OperationType: "scale", Success: true, OperatorID: uuid.New(),
+		OperatorName: "admin", CreatedAt: now.Add(-15 * time.Minute),
+	}).Error)
+	require.NoError(t, eventRepo.Upsert(ctx, &models.ClusterEvent{
+		ClusterID: clusterID, Source: models.ClusterEventSourceEvent, UID: "ev-old",
+		Kind: "Deployment", Namespace: "prod", Name: "web", Type: "Warning", Reason: "Old",
+		Count: 1, FirstSeen: now.Add(-3 * time.Hour), LastSeen: now.Add(-3 * time.Hour),
+	}))
+
+	entries, err := svc.ResourceTimeline(ctx, clusterID, &TimelineQuery{
+		Kind:           "Deployment",
+		Namespace:      "prod",
+		Name:           "web",
+		IncludeRelated: true,
+		Since:          now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, TimelineSourceEvent, entries[0].Source)
+	assert.Equal(t, int32(5), entries[0].Count, "repeated events are merged")
+	assert.Equal(t, TimelineSourceAudit, entries[1].Source)
+	assert.Equal(t, "alice", entries[1].User)
+	assert.Equal(t, TimelineSourceTiga, entries[2].Source)
+	assert.Equal(t, "deployment", entries[2].Kind)
+
+	// Without related objects the pod event is excluded
+	entries, err = svc.ResourceTimeline(ctx, clusterID, &TimelineQuery{
+		Kind: "Deployment", Namespace: "prod", Name: "web", Since: now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
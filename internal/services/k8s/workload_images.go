@@ -0,0 +1,190 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/imagescan"
+)
+
+// WorkloadImage is the image a workload container runs
+type WorkloadImage struct {
+	ClusterID   uuid.UUID `json:"cluster_id"`
+	ClusterName string    `json:"cluster_name"`
+	Namespace   string    `json:"namespace"`
+	Kind        string    `json:"kind"`
+	Name        string    `json:"name"`
+	Container   string    `json:"container"`
+	Image       string    `json:"image"`
+	ImageRef    string    `json:"image_ref"` // Pullable reference used for scanning
+	Digest      string    `json:"digest"`
+}
+
+// ClientsetFactory builds a typed client for a cluster record
+type ClientsetFactory func(cluster *models.Cluster) (kubernetes.Interface, error)
+
+// WorkloadImageLister lists the images that workloads run on clusters
+type WorkloadImageLister struct {
+	clusterRepo   repository.ClusterRepositoryInterface
+	clientFactory ClientsetFactory
+}
+
+// NewWorkloadImageLister creates a new WorkloadImageLister instance
+func NewWorkloadImageLister(clusterRepo repository.ClusterRepositoryInterface) *WorkloadImageLister {
+	return &WorkloadImageLister{
+		clusterRepo:   clusterRepo,
+		clientFactory: newClientset,
+	}
+}
+
+// NewWorkloadImageListerWithFactory creates a WorkloadImageLister with a custom client factory (used in tests)
+func NewWorkloadImageListerWithFactory(clusterRepo repository.ClusterRepositoryInterface, factory ClientsetFactory) *WorkloadImageLister {
+	return &WorkloadImageLister{
+		clusterRepo:   clusterRepo,
+		clientFactory: factory,
+	}
+}
+
+func newClientset(cluster *models.Cluster) (kubernetes.Interface, error) {
+	config, err := clusterRESTConfig(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// ListWorkloadImages lists the container images of the workloads in a
+// cluster, one entry per workload container. An empty namespace lists all
+// namespaces.
+func (l *WorkloadImageLister) ListWorkloadImages(ctx context.Context, clusterID uuid.UUID, namespace string) ([]WorkloadImage, error) {
+	cluster, err := l.clusterRepo.GetByID(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cluster %s: %w", clusterID, err)
+	}
+	return l.listWorkloadImages(ctx, cluster, namespace)
+}
+
+func (l *WorkloadImageLister) listWorkloadImages(ctx context.Context, cluster *models.Cluster, namespace string) ([]WorkloadImage, error) {
+	client, err := l.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for cluster %s: %w", cluster.Name, err)
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	seen := make(map[string]int)
+	var result []WorkloadImage
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		kind, name := podWorkload(pod)
+
+		imageIDs := make(map[string]string)
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			imageIDs[status.Name] = status.ImageID
+		}
+
+		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			key := strings.Join([]string{pod.Namespace, kind, name, container.Name}, "/")
+			ref, digest := resolveImageID(container.Image, imageIDs[container.Name])
+			// Replicas of a workload normally run the same image; keep the
+			// first one that reports a digest
+			if idx, ok := seen[key]; ok {
+				if result[idx].Digest == "" && digest != "" {
+					result[idx].ImageRef, result[idx].Digest = ref, digest
+				}
+				continue
+			}
+			seen[key] = len(result)
+			result = append(result, WorkloadImage{
+				ClusterID:   cluster.ID,
+				ClusterName: cluster.Name,
+				Namespace:   pod.Namespace,
+				Kind:        kind,
+				Name:        name,
+				Container:   container.Name,
+				Image:       container.Image,
+				ImageRef:    ref,
+				Digest:      digest,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Container < b.Container
+	})
+	return result, nil
+}
+
+// ListImageRefs lists the workload images of all enabled clusters
+func (l *WorkloadImageLister) ListImageRefs(ctx context.Context) ([]imagescan.ImageRef, error) {
+	clusters, err := l.clusterRepo.GetAllEnabled(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var refs []imagescan.ImageRef
+	var errs []error
+	for _, cluster := range clusters {
+		items, err := l.listWorkloadImages(ctx, cluster, "")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cluster %s: %w", cluster.Name, err))
+			continue
+		}
+		for _, item := range items {
+			refs = append(refs, imagescan.ImageRef{Image: item.ImageRef, Digest: item.Digest})
+		}
+	}
+	return refs, errors.Join(errs...)
+}
+
+// podWorkload returns the kind and name of the workload that owns a pod.
+// Pods of a Deployment are owned by a ReplicaSet named after the
+// Deployment plus the pod template hash.
+func podWorkload(pod *corev1.Pod) (string, string) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "Pod", pod.Name
+	}
+	if owner.Kind == "ReplicaSet" {
+		if hash := pod.Labels["pod-template-hash"]; hash != "" && strings.HasSuffix(owner.Name, "-"+hash) {
+			return "Deployment", strings.TrimSuffix(owner.Name, "-"+hash)
+		}
+	}
+	return owner.Kind, owner.Name
+}
+
+// resolveImageID returns the pullable reference and digest of a container
+// image from its status image ID, e.g. docker-pullable://nginx@sha256:...
+// The spec image is used when the status has no repo digest.
+func resolveImageID(image, imageID string) (string, string) {
+	if i := strings.Index(imageID, "://"); i >= 0 {
+		imageID = imageID[i+3:]
+	}
+	if i := strings.LastIndex(imageID, "@"); i >= 0 {
+		return imageID, imageID[i+1:]
+	}
+	return image, ""
+}
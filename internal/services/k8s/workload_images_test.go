@@ -0,0 +1,81 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/imagescan"
+)
+
+func testPod(name, ownerKind, ownerName, hash string, containers map[string]string, imageIDs map[string]string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop"}}
+	if ownerKind != "" {
+		controller := true
+		pod.OwnerReferences = []metav1.OwnerReference{{Kind: ownerKind, Name: ownerName, Controller: &controller}}
+	}
+	if hash != "" {
+		pod.Labels = map[string]string{"pod-template-hash": hash}
+	}
+	for container, image := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container, Image: image})
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{Name: container, ImageID: imageIDs[container]})
+	}
+	return pod
+}
+
+func TestWorkloadImageLister_ListWorkloadImages(t *testing.T) {
+	db, _, _ := setupTimelineTest(t)
+	clusterRepo := repository.NewClusterRepository(db)
+	cluster := &models.Cluster{Name: "prod", Enable: true}
+	require.NoError(t, clusterRepo.Create(context.Background(), cluster))
+
+	objects := []runtime.Object{
+		// Two replicas of a Deployment; the first has not started yet
+		testPod("web-5d8f-abc", "ReplicaSet", "web-5d8f", "5d8f",
+			map[string]string{"app": "web:1"}, nil),
+		testPod("web-5d8f-def", "ReplicaSet", "web-5d8f", "5d8f",
+			map[string]string{"app": "web:1"}, map[string]string{"app": "docker-pullable://registry.example.com/web@sha256:aaa"}),
+		testPod("db-0", "StatefulSet", "db", "",
+			map[string]string{"postgres": "postgres:16"}, map[string]string{"postgres": "sha256:cfg"}),
+		testPod("debug", "", "", "",
+			map[string]string{"shell": "busybox"}, map[string]string{"shell": "docker.io/library/busybox@sha256:bbb"}),
+	}
+	client := kubefake.NewSimpleClientset(objects...)
+	lister := NewWorkloadImageListerWithFactory(clusterRepo, func(*models.Cluster) (kubernetes.Interface, error) {
+		return client, nil
+	})
+
+	images, err := lister.ListWorkloadImages(context.Background(), cluster.ID, "shop")
+	require.NoError(t, err)
+	require.Len(t, images, 3)
+
+	assert.Equal(t, "Deployment", images[0].Kind)
+	assert.Equal(t, "web", images[0].Name)
+	assert.Equal(t, "registry.example.com/web@sha256:aaa", images[0].ImageRef)
+	assert.Equal(t, "sha256:aaa", images[0].Digest)
+	assert.Equal(t, "prod", images[0].ClusterName)
+
+	assert.Equal(t, "Pod", images[1].Kind)
+	assert.Equal(t, "debug", images[1].Name)
+	assert.Equal(t, "sha256:bbb", images[1].Digest)
+
+	// Without a repo digest the spec image is scanned
+	assert.Equal(t, "StatefulSet", images[2].Kind)
+	assert.Equal(t, "postgres:16", images[2].ImageRef)
+	assert.Empty(t, images[2].Digest)
+
+	refs, err := lister.ListImageRefs(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, refs, imagescan.ImageRef{Image: "registry.example.com/web@sha256:aaa", Digest: "sha256:aaa"})
+	assert.Len(t, refs, 3)
+}
@@ -0,0 +1,344 @@
+package metrics
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+const (
+	// availabilityWindow is the window of the service availability ratio
+	availabilityWindow = 24 * time.Hour
+	// exportTimeout bounds the database queries of a single collection
+	exportTimeout = 10 * time.Second
+	// monitorPageSize is the page size used to list service monitors
+	monitorPageSize = 100
+)
+
+// HostStateSource provides the latest state reported by each host
+type HostStateSource interface {
+	GetLatestState(hostID uuid.UUID) (*models.HostState, bool)
+}
+
+// AgentStatusSource reports whether the agent of a host is connected
+type AgentStatusSource interface {
+	IsAgentOnline(uuid string) bool
+}
+
+var (
+	hostLabels      = []string{"host_id", "host_name", "group"}
+	partitionLabels = append(append([]string{}, hostLabels...), "mountpoint", "device", "fstype")
+	interfaceLabels = append(append([]string{}, hostLabels...), "interface")
+	serviceLabels   = []string{"monitor_id", "monitor_name", "type", "target", "host_id", "host_name"}
+)
+
+func hostDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc("tiga_host_"+name, help, hostLabels, nil)
+}
+
+// hostGauge maps a host state field to a metric
+type hostGauge struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	value     func(state *models.HostState) float64
+}
+
+// inventory is the database state shared by collections within the cache TTL
+type inventory struct {
+	hosts     []*models.HostNode
+	monitors  []*models.ServiceMonitor
+	summaries []*repository.ProbeSummary
+	loadedAt  time.Time
+}
+
+// MonitoringExporter exports the latest host states and service probe
+// results as Prometheus metrics. Host states are read at collection time;
+// hosts, monitors and probe summaries are cached for cacheTTL so frequent
+// scrapes do not query the database each time. Hosts hidden from guests and
+// the monitors probing from them are never exported.
+type MonitoringExporter struct {
+	serviceRepo repository.ServiceRepository
+	states      HostStateSource
+	agents      AgentStatusSource
+	cacheTTL    time.Duration
+
+	mu     sync.Mutex
+	cached *inventory
+
+	hostUp         *prometheus.Desc
+	hostLastReport *prometheus.Desc
+	hostGauges     []hostGauge
+
+	partitionSize      *prometheus.Desc
+	partitionUsed      *prometheus.Desc
+	partitionInodes    *prometheus.Desc
+	partitionInodeUsed *prometheus.Desc
+	interfaceRx        *prometheus.Desc
+	interfaceTx        *prometheus.Desc
+	interfaceRxSpeed   *prometheus.Desc
+	interfaceTxSpeed   *prometheus.Desc
+	interfaceRxErrors  *prometheus.Desc
+	interfaceTxErrors  *prometheus.Desc
+
+	serviceUp           *prometheus.Desc
+	serviceLatency      *prometheus.Desc
+	serviceLastProbe    *prometheus.Desc
+	serviceAvailability *prometheus.Desc
+	serviceChecks       *prometheus.Desc
+
+	exportErrors prometheus.Counter
+}
+
+// NewMonitoringExporter creates a new host and service metrics exporter.
+// A zero cacheTTL reloads the database state on every collection.
+func NewMonitoringExporter(serviceRepo repository.ServiceRepository, states HostStateSource, agents AgentStatusSource, cacheTTL time.Duration) *MonitoringExporter {
+	e := &MonitoringExporter{
+		serviceRepo: serviceRepo,
+		states:      states,
+		agents:      agents,
+		cacheTTL:    cacheTTL,
+
+		hostUp:         hostDesc("up", "Whether the host agent is connected (1) or not (0)"),
+		hostLastReport: hostDesc("last_report_timestamp_seconds", "Time of the latest state reported by the host"),
+
+		partitionSize:      prometheus.NewDesc("tiga_host_filesystem_size_bytes", "Size of a host partition in bytes", partitionLabels, nil),
+		partitionUsed:      prometheus.NewDesc("tiga_host_filesystem_used_bytes", "Used space of a host partition in bytes", partitionLabels, nil),
+		partitionInodes:    prometheus.NewDesc("tiga_host_filesystem_inodes", "Total inodes of a host partition", partitionLabels, nil),
+		partitionInodeUsed: prometheus.NewDesc("tiga_host_filesystem_inodes_used", "Used inodes of a host partition", partitionLabels, nil),
+		interfaceRx:        prometheus.NewDesc("tiga_host_interface_receive_bytes_total", "Bytes received by a host network interface", interfaceLabels, nil),
+		interfaceTx:        prometheus.NewDesc("tiga_host_interface_transmit_bytes_total", "Bytes sent by a host network interface", interfaceLabels, nil),
+		interfaceRxSpeed:   prometheus.NewDesc("tiga_host_interface_receive_bytes_per_second", "Receive speed of a host network interface", interfaceLabels, nil),
+		interfaceTxSpeed:   prometheus.NewDesc("tiga_host_interface_transmit_bytes_per_second", "Send speed of a host network interface", interfaceLabels, nil),
+		interfaceRxErrors:  prometheus.NewDesc("tiga_host_interface_receive_errors_total", "Receive errors of a host network interface", interfaceLabels, nil),
+		interfaceTxErrors:  prometheus.NewDesc("tiga_host_interface_transmit_errors_total", "Send errors of a host network interface", interfaceLabels, nil),
+
+		serviceUp:           prometheus.NewDesc("tiga_service_up", "Whether the latest probe of a service monitor succeeded (1) or not (0)", serviceLabels, nil),
+		serviceLatency:      prometheus.NewDesc("tiga_service_latency_seconds", "Latency of the latest probe of a service monitor", serviceLabels, nil),
+		serviceLastProbe:    prometheus.NewDesc("tiga_service_last_probe_timestamp_seconds", "Time of the latest probe of a service monitor", serviceLabels, nil),
+		serviceAvailability: prometheus.NewDesc("tiga_service_availability_ratio", "Ratio of successful probes of a service monitor over the last 24 hours", serviceLabels, nil),
+		serviceChecks:       prometheus.NewDesc("tiga_service_checks", "Probes of a service monitor over the last 24 hours", append(append([]string{}, serviceLabels...), "result"), nil),
+
+		exportErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "tiga_monitoring_export_errors_total",
+			Help: "Total number of failed host and service metric collections",
+		}),
+	}
+
+	e.hostGauges = []hostGauge{
+		{hostDesc("cpu_usage_percent", "CPU usage of the host in percent"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.CPUUsage }},
+		{hostDesc("load1", "1-minute load average of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.Load1 }},
+		{hostDesc("load5", "5-minute load average of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.Load5 }},
+		{hostDesc("load15", "15-minute load average of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.Load15 }},
+		{hostDesc("memory_used_bytes", "Used memory of the host in bytes"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.MemUsed) }},
+		{hostDesc("memory_usage_percent", "Memory usage of the host in percent"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.MemUsage }},
+		{hostDesc("swap_used_bytes", "Used swap of the host in bytes"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.SwapUsed) }},
+		{hostDesc("disk_used_bytes", "Used disk space of the host in bytes"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.DiskUsed) }},
+		{hostDesc("disk_usage_percent", "Disk usage of the host in percent"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.DiskUsage }},
+		{hostDesc("network_receive_bytes_total", "Bytes received by the host"), prometheus.CounterValue, func(s *models.HostState) float64 { return float64(s.NetInTransfer) }},
+		{hostDesc("network_transmit_bytes_total", "Bytes sent by the host"), prometheus.CounterValue, func(s *models.HostState) float64 { return float64(s.NetOutTransfer) }},
+		{hostDesc("network_receive_bytes_per_second", "Receive speed of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.NetInSpeed) }},
+		{hostDesc("network_transmit_bytes_per_second", "Send speed of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.NetOutSpeed) }},
+		{hostDesc("tcp_connections", "TCP connections of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.TCPConnCount) }},
+		{hostDesc("udp_connections", "UDP connections of the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.UDPConnCount) }},
+		{hostDesc("processes", "Processes running on the host"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.ProcessCount) }},
+		{hostDesc("uptime_seconds", "Uptime of the host in seconds"), prometheus.GaugeValue, func(s *models.HostState) float64 { return float64(s.Uptime) }},
+		{hostDesc("gpu_usage_percent", "GPU usage of the host in percent"), prometheus.GaugeValue, func(s *models.HostState) float64 { return s.GPUUsage }},
+	}
+
+	return e
+}
+
+// Describe implements prometheus.Collector
+func (e *MonitoringExporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		e.hostUp, e.hostLastReport,
+		e.partitionSize, e.partitionUsed, e.partitionInodes, e.partitionInodeUsed,
+		e.interfaceRx, e.interfaceTx, e.interfaceRxSpeed, e.interfaceTxSpeed, e.interfaceRxErrors, e.interfaceTxErrors,
+		e.serviceUp, e.serviceLatency, e.serviceLastProbe, e.serviceAvailability, e.serviceChecks,
+	} {
+		ch <- desc
+	}
+	for _, g := range e.hostGauges {
+		ch <- g.desc
+	}
+	e.exportErrors.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (e *MonitoringExporter) Collect(ch chan<- prometheus.Metric) {
+	inv, err := e.load()
+	if err != nil {
+		logrus.Warnf("[MetricsExport] Failed to load hosts and service monitors: %v", err)
+		e.exportErrors.Inc()
+	} else {
+		e.collectHosts(ch, inv.hosts)
+		e.collectServices(ch, inv)
+	}
+
+	e.exportErrors.Collect(ch)
+}
+
+// load returns the cached inventory, reloading it once it is older than the
+// cache TTL. Hosts hidden from guests are dropped here.
+func (e *MonitoringExporter) load() (*inventory, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cached != nil && time.Since(e.cached.loadedAt) < e.cacheTTL {
+		return e.cached, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	hosts, err := e.serviceRepo.GetActiveHostNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	monitors, err := e.listMonitors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := e.serviceRepo.GetProbeSummaries(ctx, time.Now().Add(-availabilityWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	inv := &inventory{monitors: monitors, summaries: summaries, loadedAt: time.Now()}
+	for _, host := range hosts {
+		if !host.HideForGuest {
+			inv.hosts = append(inv.hosts, host)
+		}
+	}
+	e.cached = inv
+	return inv, nil
+}
+
+func (e *MonitoringExporter) collectHosts(ch chan<- prometheus.Metric, hosts []*models.HostNode) {
+	for _, host := range hosts {
+		labels := []string{host.ID.String(), host.Name, host.GroupName}
+
+		up := 0.0
+		if e.agents != nil && e.agents.IsAgentOnline(host.ID.String()) {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(e.hostUp, prometheus.GaugeValue, up, labels...)
+
+		state, ok := e.states.GetLatestState(host.ID)
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(e.hostLastReport, prometheus.GaugeValue, float64(state.Timestamp.Unix()), labels...)
+		for _, g := range e.hostGauges {
+			ch <- prometheus.MustNewConstMetric(g.desc, g.valueType, g.value(state), labels...)
+		}
+
+		for _, disk := range state.Disks {
+			diskLabels := append(append([]string{}, labels...), disk.MountPoint, disk.Device, disk.Fstype)
+			ch <- prometheus.MustNewConstMetric(e.partitionSize, prometheus.GaugeValue, float64(disk.Total), diskLabels...)
+			ch <- prometheus.MustNewConstMetric(e.partitionUsed, prometheus.GaugeValue, float64(disk.Used), diskLabels...)
+			if disk.InodesTotal > 0 {
+				ch <- prometheus.MustNewConstMetric(e.partitionInodes, prometheus.GaugeValue, float64(disk.InodesTotal), diskLabels...)
+				ch <- prometheus.MustNewConstMetric(e.partitionInodeUsed, prometheus.GaugeValue, float64(disk.InodesUsed), diskLabels...)
+			}
+		}
+
+		for _, nic := range state.NetInterfaces {
+			nicLabels := append(append([]string{}, labels...), nic.Name)
+			ch <- prometheus.MustNewConstMetric(e.interfaceRx, prometheus.CounterValue, float64(nic.RxBytes), nicLabels...)
+			ch <- prometheus.MustNewConstMetric(e.interfaceTx, prometheus.CounterValue, float64(nic.TxBytes), nicLabels...)
+			ch <- prometheus.MustNewConstMetric(e.interfaceRxSpeed, prometheus.GaugeValue, float64(nic.RxSpeed), nicLabels...)
+			ch <- prometheus.MustNewConstMetric(e.interfaceTxSpeed, prometheus.GaugeValue, float64(nic.TxSpeed), nicLabels...)
+			ch <- prometheus.MustNewConstMetric(e.interfaceRxErrors, prometheus.CounterValue, float64(nic.RxErrors), nicLabels...)
+			ch <- prometheus.MustNewConstMetric(e.interfaceTxErrors, prometheus.CounterValue, float64(nic.TxErrors), nicLabels...)
+		}
+	}
+}
+
+// collectServices exports one series per monitor and probe node. Server-side
+// probes have empty host labels. Results of hidden hosts and monitors bound
+// to a hidden host are skipped.
+func (e *MonitoringExporter) collectServices(ch chan<- prometheus.Metric, inv *inventory) {
+	hostNames := make(map[uuid.UUID]string, len(inv.hosts))
+	for _, host := range inv.hosts {
+		hostNames[host.ID] = host.Name
+	}
+	monitorByID := make(map[uuid.UUID]*models.ServiceMonitor, len(inv.monitors))
+	for _, monitor := range inv.monitors {
+		if monitor.HostNodeID != nil {
+			if _, visible := hostNames[*monitor.HostNodeID]; !visible {
+				continue
+			}
+		}
+		monitorByID[monitor.ID] = monitor
+	}
+
+	for _, summary := range inv.summaries {
+		monitor, ok := monitorByID[summary.ServiceMonitorID]
+		if !ok || !monitor.Enabled {
+			continue
+		}
+		hostID, hostName := "", ""
+		if summary.HostNodeID != nil {
+			if hostName, ok = hostNames[*summary.HostNodeID]; !ok {
+				continue
+			}
+			hostID = summary.HostNodeID.String()
+		}
+		labels := []string{monitor.ID.String(), monitor.Name, string(monitor.Type), redactTarget(monitor.Target), hostID, hostName}
+
+		if latest := summary.Latest; latest != nil {
+			up := 0.0
+			if latest.Success {
+				up = 1
+			}
+			ch <- prometheus.MustNewConstMetric(e.serviceUp, prometheus.GaugeValue, up, labels...)
+			ch <- prometheus.MustNewConstMetric(e.serviceLatency, prometheus.GaugeValue, float64(latest.Latency)/1000, labels...)
+			ch <- prometheus.MustNewConstMetric(e.serviceLastProbe, prometheus.GaugeValue, float64(latest.Timestamp.Unix()), labels...)
+		}
+		if summary.TotalChecks > 0 {
+			ratio := float64(summary.SuccessfulChecks) / float64(summary.TotalChecks)
+			ch <- prometheus.MustNewConstMetric(e.serviceAvailability, prometheus.GaugeValue, ratio, labels...)
+		}
+		ch <- prometheus.MustNewConstMetric(e.serviceChecks, prometheus.GaugeValue, float64(summary.SuccessfulChecks), append(labels, "success")...)
+		ch <- prometheus.MustNewConstMetric(e.serviceChecks, prometheus.GaugeValue, float64(summary.TotalChecks-summary.SuccessfulChecks), append(labels, "failure")...)
+	}
+}
+
+// redactTarget strips credentials, query and fragment from URL targets.
+// Targets that are not URLs (host:port, IPs, domains) are kept as they are.
+func redactTarget(target string) string {
+	u, err := url.Parse(target)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return target
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.ForceQuery = false
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String()
+}
+
+// listMonitors pages through all service monitors
+func (e *MonitoringExporter) listMonitors(ctx context.Context) ([]*models.ServiceMonitor, error) {
+	var monitors []*models.ServiceMonitor
+	for page := 1; ; page++ {
+		batch, total, err := e.serviceRepo.List(ctx, repository.ServiceFilter{Page: page, PageSize: monitorPageSize})
+		if err != nil {
+			return nil, err
+		}
+		monitors = append(monitors, batch...)
+		if len(batch) < monitorPageSize || int64(len(monitors)) >= total {
+			return monitors, nil
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/config"
+)
+
+const (
+	// defaultRemoteWriteInterval is used when no interval is configured
+	defaultRemoteWriteInterval = 60 * time.Second
+	// defaultRemoteWriteTimeout is used when no timeout is configured
+	defaultRemoteWriteTimeout = 30 * time.Second
+)
+
+// RemoteWriter pushes the series of a gatherer to a Prometheus
+// remote-write endpoint such as Prometheus, VictoriaMetrics or Mimir
+type RemoteWriter struct {
+	cfg      config.RemoteWriteConfig
+	gatherer prometheus.Gatherer
+	client   *http.Client
+	interval time.Duration
+}
+
+// NewRemoteWriter creates a new remote-write sender
+func NewRemoteWriter(cfg config.RemoteWriteConfig, gatherer prometheus.Gatherer) *RemoteWriter {
+	interval := time.Duration(cfg.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultRemoteWriteInterval
+	}
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultRemoteWriteTimeout
+	}
+
+	return &RemoteWriter{
+		cfg:      cfg,
+		gatherer: gatherer,
+		client:   &http.Client{Timeout: timeout},
+		interval: interval,
+	}
+}
+
+// Run pushes the series at the configured interval until ctx is done
+func (w *RemoteWriter) Run(ctx context.Context) {
+	logrus.Infof("[RemoteWrite] Pushing host and service metrics to %s every %s", w.cfg.URL, w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Push(ctx); err != nil {
+				logrus.Warnf("[RemoteWrite] %v", err)
+			}
+		}
+	}
+}
+
+// Push gathers the series once and sends them to the remote endpoint
+func (w *RemoteWriter) Push(ctx context.Context) error {
+	families, err := w.gatherer.Gather()
+	if err != nil && len(families) == 0 {
+		return fmt.Errorf("failed to gather metrics: %w", err)
+	}
+
+	req := buildWriteRequest(families, w.cfg.ExternalLabels, time.Now())
+	if len(req.Timeseries) == 0 {
+		return nil
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode write request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Encoding", "snappy")
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	httpReq.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	httpReq.Header.Set("User-Agent", "tiga-remote-write")
+	if w.cfg.BearerToken != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+w.cfg.BearerToken)
+	} else if w.cfg.Username != "" {
+		httpReq.SetBasicAuth(w.cfg.Username, w.cfg.Password)
+	}
+
+	resp, err := w.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send %d series: %w", len(req.Timeseries), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("remote endpoint returned %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	logrus.Debugf("[RemoteWrite] Sent %d series", len(req.Timeseries))
+	return nil
+}
+
+// buildWriteRequest converts gathered metric families to remote-write time
+// series stamped with now. Summaries and histograms are sent as their _sum
+// and _count series.
+func buildWriteRequest(families []*dto.MetricFamily, externalLabels map[string]string, now time.Time) *prompb.WriteRequest {
+	timestamp := now.UnixMilli()
+	req := &prompb.WriteRequest{}
+
+	add := func(name string, pairs []*dto.LabelPair, value float64) {
+		if math.IsNaN(value) {
+			return
+		}
+		labels := make([]prompb.Label, 0, len(pairs)+len(externalLabels)+1)
+		labels = append(labels, prompb.Label{Name: "__name__", Value: name})
+		for k, v := range externalLabels {
+			labels = append(labels, prompb.Label{Name: k, Value: v})
+		}
+		for _, pair := range pairs {
+			// Empty labels are absent in Prometheus
+			if pair.GetValue() == "" {
+				continue
+			}
+			labels = append(labels, prompb.Label{Name: pair.GetName(), Value: pair.GetValue()})
+		}
+		// The remote-write protocol requires labels sorted by name
+		sort.SliceStable(labels, func(i, j int) bool { return labels[i].Name < labels[j].Name })
+
+		req.Timeseries = append(req.Timeseries, prompb.TimeSeries{
+			Labels:  dedupLabels(labels),
+			Samples: []prompb.Sample{{Value: value, Timestamp: timestamp}},
+		})
+	}
+
+	for _, family := range families {
+		name := family.GetName()
+		for _, m := range family.GetMetric() {
+			switch family.GetType() {
+			case dto.MetricType_GAUGE:
+				add(name, m.GetLabel(), m.GetGauge().GetValue())
+			case dto.MetricType_COUNTER:
+				add(name, m.GetLabel(), m.GetCounter().GetValue())
+			case dto.MetricType_SUMMARY:
+				add(name+"_sum", m.GetLabel(), m.GetSummary().GetSampleSum())
+				add(name+"_count", m.GetLabel(), float64(m.GetSummary().GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				add(name+"_sum", m.GetLabel(), m.GetHistogram().GetSampleSum())
+				add(name+"_count", m.GetLabel(), float64(m.GetHistogram().GetSampleCount()))
+			default:
+				add(name, m.GetLabel(), m.GetUntyped().GetValue())
+			}
+		}
+	}
+	return req
+}
+
+// dedupLabels keeps the last of sorted labels sharing a name, so metric
+// labels take precedence over external labels
+func dedupLabels(labels []prompb.Label) []prompb.Label {
+	out := labels[:0]
+	for i, label := range labels {
+		if i+1 < len(labels) && labels[i+1].Name == label.Name {
+			continue
+		}
+		out = append(out, label)
+	}
+	return out
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/repository"
 	"github.com/ysicing/tiga/internal/services/alert"
+	"github.com/ysicing/tiga/pkg/healthcheck"
 	"github.com/ysicing/tiga/proto"
 
 	probing "github.com/prometheus-community/pro-bing"
@@ -31,6 +33,9 @@ type AgentTaskDispatcher interface {
 	GetAllAgentUUIDs() []string
 }
 
+// maxProbeBodySize limits how much of an HTTP response body is read for content checks
+const maxProbeBodySize = 1 << 20
+
 // ProbeTask represents a scheduled probe task
 type ProbeTask struct {
 	MonitorID   uuid.UUID
@@ -219,23 +224,9 @@ func (s *ServiceProbeScheduler) executeProbe(monitor *models.ServiceMonitor) {
 // executeServerProbe executes probe on server side
 func (s *ServiceProbeScheduler) executeServerProbe(ctx context.Context, monitor *models.ServiceMonitor) {
 	// Execute probe based on type
-	var (
-		result   *models.ServiceProbeResult
-		certInfo *certificateMetadata
-	)
-
-	switch monitor.Type {
-	case models.ProbeTypeHTTP:
-		logrus.Debugf("[ServiceProbe] 服务端执行HTTP探测 - 方法: %s, URL: %s", monitor.HTTPMethod, monitor.Target)
-		result, certInfo = s.executeHTTPProbe(ctx, monitor)
-	case models.ProbeTypeTCP:
-		logrus.Debugf("[ServiceProbe] 服务端执行TCP探测 - 地址: %s", monitor.Target)
-		result = s.executeTCPProbe(ctx, monitor)
-	case models.ProbeTypeICMP:
-		logrus.Debugf("[ServiceProbe] 服务端执行ICMP探测 - 主机: %s", monitor.Target)
-		result = s.executeICMPProbe(ctx, monitor)
-	default:
-		logrus.Errorf("[ServiceProbe] Unknown probe type: %s", monitor.Type)
+	result, certInfo, err := s.runServerProbe(ctx, monitor)
+	if err != nil {
+		logrus.Errorf("[ServiceProbe] %v", err)
 		return
 	}
 
@@ -283,6 +274,45 @@ func (s *ServiceProbeScheduler) executeServerProbe(ctx context.Context, monitor
 	s.checkCertificateExpiry(ctx, monitor, result, certInfo)
 }
 
+// runServerProbe executes the probe matching the monitor type on the server
+func (s *ServiceProbeScheduler) runServerProbe(ctx context.Context, monitor *models.ServiceMonitor) (*models.ServiceProbeResult, *certificateMetadata, error) {
+	switch monitor.Type {
+	case models.ProbeTypeHTTP:
+		logrus.Debugf("[ServiceProbe] 服务端执行HTTP探测 - 方法: %s, URL: %s", monitor.HTTPMethod, monitor.Target)
+		result, certInfo := s.executeHTTPProbe(ctx, monitor)
+		return result, certInfo, nil
+	case models.ProbeTypeTCP:
+		logrus.Debugf("[ServiceProbe] 服务端执行TCP探测 - 地址: %s", monitor.Target)
+		return s.executeTCPProbe(ctx, monitor), nil, nil
+	case models.ProbeTypeICMP:
+		logrus.Debugf("[ServiceProbe] 服务端执行ICMP探测 - 主机: %s", monitor.Target)
+		return s.executeICMPProbe(ctx, monitor), nil, nil
+	case models.ProbeTypeDNS:
+		logrus.Debugf("[ServiceProbe] 服务端执行DNS探测 - 域名: %s, 类型: %s", monitor.Target, monitor.DNSRecordType)
+		return s.executeDNSProbe(ctx, monitor), nil, nil
+	case models.ProbeTypeGRPC:
+		logrus.Debugf("[ServiceProbe] 服务端执行gRPC健康检查 - 地址: %s, 服务: %s", monitor.Target, monitor.GRPCService)
+		return s.executeGRPCProbe(ctx, monitor), nil, nil
+	case models.ProbeTypeTLS:
+		logrus.Debugf("[ServiceProbe] 服务端执行TLS探测 - 地址: %s", monitor.Target)
+		result, certInfo := s.executeTLSProbe(ctx, monitor)
+		return result, certInfo, nil
+	case models.ProbeTypeSynthetic:
+		logrus.Debugf("[ServiceProbe] 服务端执行多步骤事务探测 - 步骤数: %d", len(monitor.SyntheticSteps))
+		return s.executeSyntheticProbe(ctx, monitor), nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown probe type: %s", monitor.Type)
+	}
+}
+
+// probeTimeout returns the monitor timeout, or def when unset
+func probeTimeout(monitor *models.ServiceMonitor, def time.Duration) time.Duration {
+	if monitor.Timeout > 0 {
+		return time.Duration(monitor.Timeout) * time.Second
+	}
+	return def
+}
+
 // executeHTTPProbe executes an HTTP/HTTPS probe
 func (s *ServiceProbeScheduler) executeHTTPProbe(ctx context.Context, monitor *models.ServiceMonitor) (*models.ServiceProbeResult, *certificateMetadata) {
 	start := time.Now()
@@ -360,11 +390,10 @@ func (s *ServiceProbeScheduler) executeHTTPProbe(ctx context.Context, monitor *m
 	}
 
 	// Check response content if configured
-	if result.Success && monitor.ExpectBody != "" {
-		// Read response body (limited to 10KB)
-		bodyBytes := make([]byte, 1024*10)
-		n, _ := io.ReadFull(resp.Body, bodyBytes)
-		body := string(bodyBytes[:n])
+	if result.Success && (monitor.ExpectBody != "" || monitor.JSONPath != "") {
+		// Read response body (limited to 1MB so JSON documents can be parsed)
+		bodyBytes, _ := io.ReadAll(io.LimitReader(resp.Body, maxProbeBodySize))
+		body := string(bodyBytes)
 
 		// Store first 1KB in result
 		if len(body) > 1024 {
@@ -374,10 +403,18 @@ func (s *ServiceProbeScheduler) executeHTTPProbe(ctx context.Context, monitor *m
 		}
 
 		// Check for expected content (substring match)
-		if !strings.Contains(body, monitor.ExpectBody) {
+		if monitor.ExpectBody != "" && !strings.Contains(body, monitor.ExpectBody) {
 			result.Success = false
 			result.ErrorMessage = "Content validation failed: expected content not found"
 		}
+
+		// Check JSONPath assertion
+		if result.Success && monitor.JSONPath != "" {
+			if err := healthcheck.AssertJSONPath(bodyBytes, monitor.JSONPath, monitor.JSONExpect); err != nil {
+				result.Success = false
+				result.ErrorMessage = fmt.Sprintf("JSON validation failed: %v", err)
+			}
+		}
 	}
 
 	// SSL certificate validation for HTTPS
@@ -554,6 +591,148 @@ func (s *ServiceProbeScheduler) executeICMPProbe(ctx context.Context, monitor *m
 	return result
 }
 
+// executeDNSProbe resolves the target and checks the expected answers
+func (s *ServiceProbeScheduler) executeDNSProbe(ctx context.Context, monitor *models.ServiceMonitor) *models.ServiceProbeResult {
+	start := time.Now()
+	result := &models.ServiceProbeResult{
+		ServiceMonitorID: monitor.ID,
+		Timestamp:        start,
+		Success:          false,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout(monitor, 10*time.Second))
+	defer cancel()
+
+	answers, err := healthcheck.CheckDNS(ctx, monitor.Target, healthcheck.DNSOptions{
+		RecordType: monitor.DNSRecordType,
+		Expect:     monitor.DNSExpect,
+		Resolver:   monitor.DNSResolver,
+	})
+	result.Latency = int(time.Since(start).Milliseconds())
+	if err != nil {
+		result.ErrorMessage = err.Error()
+		logrus.Errorf("DNS probe failed for %s: %v", monitor.Name, err)
+		return result
+	}
+	result.Success = true
+
+	logrus.Debugf("DNS probe %s completed: answers=%v, latency=%dms", monitor.Name, answers, result.Latency)
+	return result
+}
+
+// executeGRPCProbe calls the grpc.health.v1 health check of the target
+func (s *ServiceProbeScheduler) executeGRPCProbe(ctx context.Context, monitor *models.ServiceMonitor) *models.ServiceProbeResult {
+	start := time.Now()
+	result := &models.ServiceProbeResult{
+		ServiceMonitorID: monitor.ID,
+		Timestamp:        start,
+		Success:          false,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout(monitor, 10*time.Second))
+	defer cancel()
+
+	status, err := healthcheck.CheckGRPCHealth(ctx, monitor.Target, healthcheck.GRPCOptions{
+		Service: monitor.GRPCService,
+		TLS:     monitor.GRPCTLS,
+	})
+	result.Latency = int(time.Since(start).Milliseconds())
+	if err != nil {
+		result.ErrorMessage = err.Error()
+		logrus.Errorf("gRPC probe failed for %s: %v", monitor.Name, err)
+		return result
+	}
+	result.Success = true
+
+	logrus.Debugf("gRPC probe %s completed: status=%s, latency=%dms", monitor.Name, status, result.Latency)
+	return result
+}
+
+// executeTLSProbe performs a TLS handshake and validates the certificate chain and expiry
+func (s *ServiceProbeScheduler) executeTLSProbe(ctx context.Context, monitor *models.ServiceMonitor) (*models.ServiceProbeResult, *certificateMetadata) {
+	start := time.Now()
+	result := &models.ServiceProbeResult{
+		ServiceMonitorID: monitor.ID,
+		Timestamp:        start,
+		Success:          false,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout(monitor, 10*time.Second))
+	defer cancel()
+
+	cert, err := healthcheck.CheckTLS(ctx, monitor.Target, healthcheck.TLSOptions{
+		ServerName:       monitor.TLSServerName,
+		MinDaysRemaining: monitor.TLSMinDaysRemaining,
+	})
+	result.Latency = int(time.Since(start).Milliseconds())
+
+	var certInfo *certificateMetadata
+	if cert != nil {
+		certInfo = &certificateMetadata{
+			Subject:      cert.Subject,
+			Issuer:       cert.Issuer,
+			NotBefore:    cert.NotBefore.Format(time.RFC3339),
+			NotAfter:     cert.NotAfter.Format(time.RFC3339),
+			DaysToExpiry: cert.DaysToExpiry,
+			DNSNames:     cert.DNSNames,
+		}
+	}
+	if err != nil {
+		result.ErrorMessage = err.Error()
+		logrus.Errorf("TLS probe failed for %s: %v", monitor.Name, err)
+		return result, certInfo
+	}
+	result.Success = true
+
+	logrus.Debugf("TLS probe %s completed: days_to_expiry=%d, latency=%dms", monitor.Name, cert.DaysToExpiry, result.Latency)
+	return result, certInfo
+}
+
+// executeSyntheticProbe runs the HTTP steps of a synthetic monitor in order
+func (s *ServiceProbeScheduler) executeSyntheticProbe(ctx context.Context, monitor *models.ServiceMonitor) *models.ServiceProbeResult {
+	result := &models.ServiceProbeResult{
+		ServiceMonitorID: monitor.ID,
+		Timestamp:        time.Now(),
+		Success:          false,
+	}
+
+	// The monitor timeout applies to each step
+	client := *s.httpClient
+	client.Timeout = probeTimeout(monitor, 30*time.Second)
+
+	run := healthcheck.RunSynthetic(ctx, &client, monitor.SyntheticSteps)
+	applySyntheticResult(result, run)
+	if !result.Success {
+		logrus.Errorf("Synthetic probe failed for %s: %s", monitor.Name, result.ErrorMessage)
+	}
+
+	logrus.Debugf("Synthetic probe %s completed: success=%v, steps=%d, latency=%dms",
+		monitor.Name, result.Success, len(result.Steps), result.Latency)
+	return result
+}
+
+// applySyntheticResult copies a synthetic run into a probe result and its step results
+func applySyntheticResult(result *models.ServiceProbeResult, run *healthcheck.SyntheticResult) {
+	result.Success = run.Success
+	result.Latency = int(run.Latency)
+	result.ErrorMessage = run.Error
+	result.Steps = make([]models.ServiceProbeStepResult, 0, len(run.Steps))
+	for _, step := range run.Steps {
+		result.Steps = append(result.Steps, models.ServiceProbeStepResult{
+			ServiceMonitorID: result.ServiceMonitorID,
+			StepIndex:        step.Index,
+			Name:             step.Name,
+			Success:          step.Success,
+			Latency:          int(step.Latency),
+			HTTPStatusCode:   step.StatusCode,
+			ErrorMessage:     step.Error,
+		})
+	}
+	if n := len(run.Steps); n > 0 {
+		result.HTTPStatusCode = run.Steps[n-1].StatusCode
+	}
+}
+
 // checkProbeFailures checks for consecutive failures and triggers alerts
 func (s *ServiceProbeScheduler) checkProbeFailures(ctx context.Context, monitor *models.ServiceMonitor, latestResult *models.ServiceProbeResult) {
 	if !monitor.NotifyOnFailure || s.alertEngine == nil {
@@ -631,8 +810,8 @@ func (s *ServiceProbeScheduler) checkProbeFailures(ctx context.Context, monitor
 
 // checkCertificateExpiry checks SSL certificate expiry and creates alerts
 func (s *ServiceProbeScheduler) checkCertificateExpiry(ctx context.Context, monitor *models.ServiceMonitor, latestResult *models.ServiceProbeResult, certInfo *certificateMetadata) {
-	// Only check HTTPS monitors
-	if monitor.Type != models.ProbeTypeHTTP {
+	// Only check HTTPS and TLS monitors
+	if monitor.Type != models.ProbeTypeHTTP && monitor.Type != models.ProbeTypeTLS {
 		return
 	}
 
@@ -728,16 +907,9 @@ func (s *ServiceProbeScheduler) TriggerManualProbe(ctx context.Context, monitorI
 	}
 
 	// Execute the probe
-	var result *models.ServiceProbeResult
-	switch monitor.Type {
-	case models.ProbeTypeHTTP:
-		result, _ = s.executeHTTPProbe(ctx, monitor)
-	case models.ProbeTypeTCP:
-		result = s.executeTCPProbe(ctx, monitor)
-	case models.ProbeTypeICMP:
-		result = s.executeICMPProbe(ctx, monitor)
-	default:
-		return nil, fmt.Errorf("unknown probe type: %s", monitor.Type)
+	result, _, err := s.runServerProbe(ctx, monitor)
+	if err != nil {
+		return nil, err
 	}
 
 	// Save result
@@ -785,6 +957,7 @@ func (s *ServiceProbeScheduler) ReportAgentProbeResultBatch(reports []*ProbeRepo
 			Success:          report.Success,
 			Latency:          int(report.Latency),
 			ErrorMessage:     report.ErrorMessage,
+			Steps:            report.Steps,
 		}
 
 		// Set HostNodeID if this is an agent probe (not server-side)
@@ -849,14 +1022,8 @@ func (s *ServiceProbeScheduler) dispatchAgentProbe(ctx context.Context, monitor
 	}
 
 	// Add type-specific parameters
-	if monitor.Type == models.ProbeTypeHTTP {
-		task.Params["method"] = monitor.HTTPMethod
-		if monitor.HTTPHeaders != "" {
-			task.Params["headers"] = monitor.HTTPHeaders
-		}
-		if monitor.ExpectStatus > 0 {
-			task.Params["expected_status"] = fmt.Sprintf("%d", monitor.ExpectStatus)
-		}
+	for key, value := range probeTaskParams(monitor) {
+		task.Params[key] = value
 	}
 
 	// Dispatch task to each agent
@@ -870,6 +1037,47 @@ func (s *ServiceProbeScheduler) dispatchAgentProbe(ctx context.Context, monitor
 	}
 }
 
+// probeTaskParams returns the type-specific agent task parameters of a
+// monitor. Keys mirror the ProbeTask fields of proto/service_probe.proto.
+func probeTaskParams(monitor *models.ServiceMonitor) map[string]string {
+	params := make(map[string]string)
+	if monitor.Timeout > 0 {
+		params["timeout"] = strconv.Itoa(monitor.Timeout)
+	}
+
+	switch monitor.Type {
+	case models.ProbeTypeHTTP:
+		params["method"] = monitor.HTTPMethod
+		if monitor.HTTPHeaders != "" {
+			params["headers"] = monitor.HTTPHeaders
+		}
+		if monitor.ExpectStatus > 0 {
+			params["expected_status"] = fmt.Sprintf("%d", monitor.ExpectStatus)
+		}
+		if monitor.JSONPath != "" {
+			params["json_path"] = monitor.JSONPath
+			params["json_expect"] = monitor.JSONExpect
+		}
+	case models.ProbeTypeDNS:
+		params["dns_record_type"] = monitor.DNSRecordType
+		params["dns_expect"] = monitor.DNSExpect
+		params["dns_resolver"] = monitor.DNSResolver
+	case models.ProbeTypeGRPC:
+		params["grpc_service"] = monitor.GRPCService
+		params["grpc_tls"] = strconv.FormatBool(monitor.GRPCTLS)
+	case models.ProbeTypeTLS:
+		params["tls_server_name"] = monitor.TLSServerName
+		if monitor.TLSMinDaysRemaining > 0 {
+			params["tls_min_days_remaining"] = strconv.Itoa(monitor.TLSMinDaysRemaining)
+		}
+	case models.ProbeTypeSynthetic:
+		if steps, err := json.Marshal(monitor.SyntheticSteps); err == nil {
+			params["synthetic_steps"] = string(steps)
+		}
+	}
+	return params
+}
+
 // selectAgents selects agent UUIDs based on probe strategy
 func (s *ServiceProbeScheduler) selectAgents(ctx context.Context, monitor *models.ServiceMonitor) ([]string, error) {
 	var agentUUIDs []string
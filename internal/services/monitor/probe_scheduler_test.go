@@ -2,6 +2,9 @@ package monitor
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 
 	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/pkg/healthcheck"
 	"github.com/ysicing/tiga/proto"
 )
 
@@ -44,6 +48,9 @@ func (m *mockServiceRepository) GetLatestProbeResult(ctx context.Context, servic
 func (m *mockServiceRepository) GetProbeResultsByHostAndService(ctx context.Context, hostID, serviceID uuid.UUID, start, end time.Time) ([]*models.ServiceProbeResult, error) {
 	return nil, nil
 }
+func (m *mockServiceRepository) GetProbeSummaries(ctx context.Context, since time.Time) ([]*repository.ProbeSummary, error) {
+	return nil, nil
+}
 func (m *mockServiceRepository) SaveAvailability(ctx context.Context, availability *models.ServiceAvailability) error {
 	return nil
 }
@@ -69,10 +76,12 @@ func (m *mockServiceRepository) GetServiceHistoryByHost(ctx context.Context, hos
 // mockAgentTaskDispatcher is a minimal mock for testing
 type mockAgentTaskDispatcher struct {
 	queuedTasks []string
+	tasks       []*proto.AgentTask
 }
 
 func (m *mockAgentTaskDispatcher) QueueTask(agentUUID string, task *proto.AgentTask) error {
 	m.queuedTasks = append(m.queuedTasks, agentUUID)
+	m.tasks = append(m.tasks, task)
 	return nil
 }
 
@@ -523,3 +532,149 @@ func TestMultipleSchedulersIsolation(t *testing.T) {
 
 	scheduler1.UnscheduleMonitor(monitorID1)
 }
+
+// TestProbeTaskParams tests the agent task parameters of the probe types
+func TestProbeTaskParams(t *testing.T) {
+	params := probeTaskParams(&models.ServiceMonitor{
+		Type:       models.ProbeTypeHTTP,
+		Timeout:    5,
+		HTTPMethod: "GET",
+		JSONPath:   "$.status",
+		JSONExpect: "ok",
+	})
+	assert.Equal(t, "5", params["timeout"])
+	assert.Equal(t, "$.status", params["json_path"])
+	assert.Equal(t, "ok", params["json_expect"])
+
+	params = probeTaskParams(&models.ServiceMonitor{
+		Type:          models.ProbeTypeDNS,
+		DNSRecordType: "MX",
+		DNSExpect:     "mx.example.com",
+		DNSResolver:   "1.1.1.1",
+	})
+	assert.Equal(t, "MX", params["dns_record_type"])
+	assert.Equal(t, "mx.example.com", params["dns_expect"])
+	assert.Equal(t, "1.1.1.1", params["dns_resolver"])
+
+	params = probeTaskParams(&models.ServiceMonitor{Type: models.ProbeTypeGRPC, GRPCService: "api", GRPCTLS: true})
+	assert.Equal(t, "api", params["grpc_service"])
+	assert.Equal(t, "true", params["grpc_tls"])
+
+	params = probeTaskParams(&models.ServiceMonitor{Type: models.ProbeTypeTLS, TLSServerName: "example.com", TLSMinDaysRemaining: 14})
+	assert.Equal(t, "example.com", params["tls_server_name"])
+	assert.Equal(t, "14", params["tls_min_days_remaining"])
+}
+
+// TestDispatchAgentProbeParams tests that new probe types are dispatched with their configuration
+func TestDispatchAgentProbeParams(t *testing.T) {
+	scheduler := NewServiceProbeScheduler(&mockServiceRepository{}, nil)
+	dispatcher := &mockAgentTaskDispatcher{}
+	scheduler.SetAgentManager(dispatcher)
+
+	monitor := &models.ServiceMonitor{
+		Type:          models.ProbeTypeDNS,
+		Target:        "example.com",
+		ProbeStrategy: models.ProbeStrategyInclude,
+		ProbeNodeIDs:  `["agent-1"]`,
+		DNSRecordType: "AAAA",
+	}
+	monitor.ID = uuid.New()
+
+	scheduler.dispatchAgentProbe(context.Background(), monitor)
+	require.Len(t, dispatcher.tasks, 1)
+	assert.Equal(t, "DNS", dispatcher.tasks[0].Params["type"])
+	assert.Equal(t, "AAAA", dispatcher.tasks[0].Params["dns_record_type"])
+}
+
+// TestServerProbeJSONPath tests HTTP JSONPath assertions on the server
+func TestServerProbeJSONPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"healthy":true,"version":"1.2.3"}}`))
+	}))
+	defer server.Close()
+
+	scheduler := NewServiceProbeScheduler(&mockServiceRepository{}, nil)
+	monitor := &models.ServiceMonitor{
+		Type:       models.ProbeTypeHTTP,
+		Target:     server.URL,
+		JSONPath:   "$.data.version",
+		JSONExpect: "1.2.3",
+	}
+
+	result, _, err := scheduler.runServerProbe(context.Background(), monitor)
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.ErrorMessage)
+
+	monitor.JSONExpect = "2.0.0"
+	result, _, err = scheduler.runServerProbe(context.Background(), monitor)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.ErrorMessage, "JSON validation failed")
+
+	_, _, err = scheduler.runServerProbe(context.Background(), &models.ServiceMonitor{Type: "SMTP"})
+	assert.Error(t, err)
+}
+
+// TestServerProbeTLS tests that untrusted certificates fail but are still reported
+func TestServerProbeTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	scheduler := NewServiceProbeScheduler(&mockServiceRepository{}, nil)
+	monitor := &models.ServiceMonitor{
+		Type:          models.ProbeTypeTLS,
+		Target:        strings.TrimPrefix(server.URL, "https://"),
+		TLSServerName: "example.com",
+	}
+
+	result, certInfo, err := scheduler.runServerProbe(context.Background(), monitor)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.ErrorMessage, "verification failed")
+	require.NotNil(t, certInfo)
+	assert.Contains(t, certInfo.DNSNames, "example.com")
+}
+
+// TestServerProbeSynthetic tests multi-step transactions with per-step results
+func TestServerProbeSynthetic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			_, _ = w.Write([]byte(`{"token":"t-1"}`))
+		case "/orders":
+			if r.Header.Get("Authorization") != "Bearer t-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"count":2}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	scheduler := NewServiceProbeScheduler(&mockServiceRepository{}, nil)
+	monitor := &models.ServiceMonitor{
+		Type:   models.ProbeTypeSynthetic,
+		Target: "orders flow",
+		SyntheticSteps: models.SyntheticSteps{
+			{Name: "login", URL: server.URL + "/login", Extract: []healthcheck.Extraction{{Name: "token", From: healthcheck.ExtractFromJSON, Path: "$.token"}}},
+			{Name: "orders", URL: server.URL + "/orders", Headers: map[string]string{"Authorization": "Bearer {{token}}"}},
+			{Name: "missing", URL: server.URL + "/missing"},
+		},
+	}
+	monitor.ID = uuid.New()
+
+	result, _, err := scheduler.runServerProbe(context.Background(), monitor)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, http.StatusNotFound, result.HTTPStatusCode)
+	assert.Contains(t, result.ErrorMessage, "step 3 (missing)")
+	require.Len(t, result.Steps, 3)
+	assert.True(t, result.Steps[1].Success)
+	assert.Equal(t, monitor.ID, result.Steps[1].ServiceMonitorID)
+	assert.Equal(t, 2, result.Steps[2].StepIndex)
+
+	params := probeTaskParams(monitor)
+	assert.Contains(t, params["synthetic_steps"], `"Bearer {{token}}"`)
+}
@@ -49,7 +49,8 @@ type ProbeReport struct {
 	Latency          float32 // in milliseconds
 	Timestamp        time.Time
 	ErrorMessage     string
-	Data             string // TLS cert info or other metadata
+	Data             string                          // TLS cert info or other metadata
+	Steps            []models.ServiceProbeStepResult // Per-step results of synthetic monitors
 }
 
 // MonthlyStatus stores 30 days of aggregated data
@@ -0,0 +1,473 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// maxCommandLength bounds the length of an extracted command line
+const maxCommandLength = 4096
+
+// ExtractedCommand is a command line submitted during a recorded session
+type ExtractedCommand struct {
+	Offset  float64 // Seconds from the start of the recording
+	Command string
+}
+
+// ExtractCommands reconstructs the command lines submitted in an asciinema
+// v2 recording.
+//
+// Enter key presses in the input events mark submissions. The command is
+// read from the line the shell echoed back, so cursor movement, line
+// editing, history recall and completion are reflected as the user saw
+// them. Lines typed without echo, such as passwords, are not reported, and
+// input to full screen programs like editors is ignored.
+func ExtractCommands(r io.Reader) ([]ExtractedCommand, error) {
+	reader := bufio.NewReader(r)
+
+	line, err := readCastLine(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	var header models.AsciinemaHeader
+	if err := json.Unmarshal(line, &header); err != nil {
+		return nil, fmt.Errorf("invalid asciinema header: %w", err)
+	}
+
+	e := newCommandExtractor(header.Width)
+	for {
+		line, err := readCastLine(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		frame, ok := parseCastFrame(line)
+		if !ok {
+			continue
+		}
+		switch frame.Type {
+		case "o":
+			e.output(frame.Data)
+		case "i":
+			e.input(frame.Timestamp, frame.Data)
+		case "r":
+			e.resize(frame.Data)
+		}
+	}
+	return e.commands, nil
+}
+
+// readCastLine reads the next non-empty line of a recording
+func readCastLine(reader *bufio.Reader) ([]byte, error) {
+	for {
+		line, err := reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseCastFrame parses an event line, either the asciinema array form
+// [time, type, data] or a models.RecordingFrame object
+func parseCastFrame(line []byte) (models.RecordingFrame, bool) {
+	var frame models.RecordingFrame
+	if line[0] == '{' {
+		return frame, json.Unmarshal(line, &frame) == nil
+	}
+
+	var fields []json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil || len(fields) != 3 {
+		return frame, false
+	}
+	if json.Unmarshal(fields[0], &frame.Timestamp) != nil ||
+		json.Unmarshal(fields[1], &frame.Type) != nil ||
+		json.Unmarshal(fields[2], &frame.Data) != nil {
+		return frame, false
+	}
+	return frame, true
+}
+
+// Escape sequence states of the output parser
+const (
+	stateGround = iota
+	stateEscape
+	stateCharset
+	stateCSI
+	stateOSC
+	stateOSCEscape
+)
+
+// commandExtractor follows the terminal output closely enough to read back
+// the line being edited. It only keeps the screen rows written since the
+// current prompt, with the cursor relative to them.
+type commandExtractor struct {
+	width int
+
+	rows      [][]rune
+	row, col  int
+	wrapNext  bool
+	altScreen bool
+
+	state  int
+	params []rune
+
+	// Start of the command line being edited. When the prompt had to be
+	// redrawn, it is taken to start the first row and the prompt text seen
+	// before is stripped from it.
+	editing        bool
+	promptRow      int
+	promptCol      int
+	promptText     string
+	promptInferred bool
+
+	// Offsets of submitted lines whose echo has not been seen yet
+	pending []float64
+	lastCR  bool
+
+	commands []ExtractedCommand
+}
+
+func newCommandExtractor(width int) *commandExtractor {
+	return &commandExtractor{width: width, rows: [][]rune{nil}}
+}
+
+// input handles the keys typed by the user
+func (e *commandExtractor) input(offset float64, data string) {
+	for _, r := range data {
+		if e.altScreen {
+			continue
+		}
+
+		switch r {
+		case '\r', '\n':
+			// Enter sends \r, pasted text may contain \r\n
+			if r == '\n' && e.lastCR {
+				e.lastCR = false
+				continue
+			}
+			e.lastCR = r == '\r'
+			if !e.editing {
+				e.startEditing()
+			}
+			e.pending = append(e.pending, offset)
+			e.editing = false
+		case 0x03: // Ctrl-C discards the line
+			e.lastCR = false
+			e.editing = false
+		default:
+			e.lastCR = false
+			if !e.editing {
+				e.startEditing()
+			}
+		}
+	}
+}
+
+// startEditing records where the command line starts
+func (e *commandExtractor) startEditing() {
+	e.editing = true
+	if len(e.pending) > 0 {
+		// Typed ahead of the echo of an earlier line, the prompt is not
+		// on screen yet
+		e.promptRow, e.promptCol, e.promptInferred = 0, 0, true
+		return
+	}
+
+	e.promptRow, e.promptCol, e.promptInferred = e.row, e.col, false
+	line := e.rows[e.row]
+	if e.col < len(line) {
+		line = line[:e.col]
+	}
+	e.promptText = string(line)
+}
+
+// output handles the data written to the terminal
+func (e *commandExtractor) output(data string) {
+	for _, r := range data {
+		switch e.state {
+		case stateEscape:
+			e.escape(r)
+		case stateCharset:
+			e.state = stateGround
+		case stateCSI:
+			if r >= 0x40 && r <= 0x7e {
+				e.csi(r)
+				e.state = stateGround
+			} else {
+				e.params = append(e.params, r)
+			}
+		case stateOSC:
+			switch r {
+			case 0x07:
+				e.state = stateGround
+			case 0x1b:
+				e.state = stateOSCEscape
+			}
+		case stateOSCEscape:
+			e.state = stateGround
+		default:
+			e.control(r)
+		}
+	}
+}
+
+func (e *commandExtractor) control(r rune) {
+	if e.altScreen && r != 0x1b {
+		return
+	}
+
+	switch r {
+	case 0x1b:
+		e.state = stateEscape
+	case '\r':
+		e.col, e.wrapNext = 0, false
+	case '\n':
+		e.newline()
+	case '\b':
+		if e.wrapNext {
+			e.wrapNext = false
+		} else if e.col > 0 {
+			e.col--
+		}
+	case '\t':
+		next := (e.col/8 + 1) * 8
+		for e.col < next {
+			e.put(' ')
+		}
+	default:
+		if r >= 0x20 && r != 0x7f {
+			e.put(r)
+		}
+	}
+}
+
+func (e *commandExtractor) escape(r rune) {
+	switch r {
+	case '[':
+		e.state = stateCSI
+		e.params = e.params[:0]
+	case ']', 'P', '_', '^':
+		e.state = stateOSC
+	case '(', ')', '*', '+', '#', '%':
+		e.state = stateCharset
+	default:
+		e.state = stateGround
+	}
+}
+
+// csi handles a control sequence with the final byte r
+func (e *commandExtractor) csi(r rune) {
+	params := string(e.params)
+	if strings.HasPrefix(params, "?") {
+		if r == 'h' || r == 'l' {
+			for _, mode := range strings.Split(params[1:], ";") {
+				if mode == "1049" || mode == "1047" || mode == "47" {
+					e.setAltScreen(r == 'h')
+				}
+			}
+		}
+		return
+	}
+	if e.altScreen {
+		return
+	}
+
+	n := 1
+	if first := strings.SplitN(params, ";", 2)[0]; first != "" {
+		if v, err := strconv.Atoi(first); err == nil {
+			n = v
+		}
+	}
+	line := e.rows[e.row]
+
+	switch r {
+	case 'A':
+		e.row = max(e.row-n, 0)
+		e.wrapNext = false
+	case 'B':
+		e.row += n
+		e.ensureRow()
+		e.wrapNext = false
+	case 'C':
+		e.col += n
+		if e.width > 0 && e.col >= e.width {
+			e.col = e.width - 1
+		}
+		e.wrapNext = false
+	case 'D':
+		e.col = max(e.col-max(n, 1), 0)
+		e.wrapNext = false
+	case 'G':
+		e.col = max(n-1, 0)
+		e.wrapNext = false
+	case 'K':
+		switch params {
+		case "", "0":
+			if e.col < len(line) {
+				e.rows[e.row] = line[:e.col]
+			}
+		case "1":
+			for i := 0; i <= e.col && i < len(line); i++ {
+				line[i] = ' '
+			}
+		case "2":
+			e.rows[e.row] = nil
+		}
+	case 'P':
+		if e.col < len(line) {
+			end := min(e.col+n, len(line))
+			e.rows[e.row] = append(line[:e.col], line[end:]...)
+		}
+	case '@':
+		if e.col < len(line) {
+			blanks := []rune(strings.Repeat(" ", n))
+			e.rows[e.row] = append(line[:e.col], append(blanks, line[e.col:]...)...)
+		}
+	case 'X':
+		for i := e.col; i < e.col+n && i < len(line); i++ {
+			line[i] = ' '
+		}
+	case 'J':
+		if params == "" || params == "0" {
+			if e.col < len(line) {
+				e.rows[e.row] = line[:e.col]
+			}
+			e.rows = e.rows[:e.row+1]
+		} else {
+			e.redrawn()
+		}
+	case 'H', 'f':
+		e.redrawn()
+	}
+}
+
+// put writes a character at the cursor, wrapping at the right margin
+func (e *commandExtractor) put(r rune) {
+	if e.wrapNext {
+		e.row++
+		e.col = 0
+		e.wrapNext = false
+		e.ensureRow()
+	}
+
+	line := e.rows[e.row]
+	for len(line) <= e.col {
+		line = append(line, ' ')
+	}
+	line[e.col] = r
+	e.rows[e.row] = line
+
+	e.col++
+	if e.width > 0 && e.col >= e.width {
+		e.col = e.width - 1
+		e.wrapNext = true
+	}
+}
+
+func (e *commandExtractor) ensureRow() {
+	for len(e.rows) <= e.row {
+		e.rows = append(e.rows, nil)
+	}
+}
+
+// newline completes a submitted line, or starts a new row
+func (e *commandExtractor) newline() {
+	e.wrapNext = false
+	if len(e.pending) > 0 {
+		offset := e.pending[0]
+		e.pending = e.pending[1:]
+		if command := e.commandLine(); command != "" {
+			e.commands = append(e.commands, ExtractedCommand{Offset: offset, Command: command})
+		}
+		e.resetRows()
+		if len(e.pending) > 0 || e.editing {
+			e.promptRow, e.promptCol, e.promptInferred = 0, 0, true
+		}
+		return
+	}
+
+	if e.editing {
+		// Output while editing, like a completion listing, is followed
+		// by the prompt and line being drawn again
+		e.redrawn()
+		return
+	}
+	e.resetRows()
+}
+
+// redrawn forgets the rows on screen, after which the prompt and the line
+// being edited are expected to be drawn again
+func (e *commandExtractor) redrawn() {
+	e.resetRows()
+	e.col = 0
+	if e.editing {
+		e.promptRow, e.promptCol, e.promptInferred = 0, 0, true
+	}
+}
+
+func (e *commandExtractor) resetRows() {
+	e.rows = [][]rune{nil}
+	e.row = 0
+}
+
+// commandLine reads the command from the rows after the prompt
+func (e *commandExtractor) commandLine() string {
+	var b strings.Builder
+	for i := e.promptRow; i < len(e.rows); i++ {
+		line := e.rows[i]
+		if i == e.promptRow {
+			if e.promptCol >= len(line) {
+				continue
+			}
+			line = line[e.promptCol:]
+		}
+		b.WriteString(string(line))
+	}
+
+	command := strings.TrimRight(b.String(), " ")
+	if e.promptInferred && e.promptText != "" {
+		command = strings.TrimPrefix(command, e.promptText)
+	}
+	command = strings.TrimSpace(command)
+	if runes := []rune(command); len(runes) > maxCommandLength {
+		command = string(runes[:maxCommandLength])
+	}
+	return command
+}
+
+func (e *commandExtractor) setAltScreen(on bool) {
+	if e.altScreen == on {
+		return
+	}
+	e.altScreen = on
+	e.editing = false
+	e.pending = nil
+	e.resetRows()
+	e.col = 0
+}
+
+// resize handles an asciinema resize event with data "COLSxROWS"
+func (e *commandExtractor) resize(data string) {
+	cols, _, ok := strings.Cut(data, "x")
+	if !ok {
+		return
+	}
+	if width, err := strconv.Atoi(cols); err == nil && width > 0 {
+		e.width = width
+	}
+}
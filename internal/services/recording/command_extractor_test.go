@@ -0,0 +1,189 @@
+package recording
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cast builds an asciinema v2 recording from alternating event types and data
+func cast(t *testing.T, width int, events ...string) string {
+	t.Helper()
+	require.Zero(t, len(events)%2)
+
+	var b strings.Builder
+	header, err := json.Marshal(map[string]int{"version": 2, "width": width, "height": 24})
+	require.NoError(t, err)
+	b.Write(header)
+	b.WriteString("\n")
+
+	for i := 0; i < len(events); i += 2 {
+		frame, err := json.Marshal([]interface{}{float64(i/2 + 1), events[i], events[i+1]})
+		require.NoError(t, err)
+		b.Write(frame)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func extract(t *testing.T, recording string) []ExtractedCommand {
+	t.Helper()
+	commands, err := ExtractCommands(strings.NewReader(recording))
+	require.NoError(t, err)
+	return commands
+}
+
+func commandLines(commands []ExtractedCommand) []string {
+	lines := make([]string, 0, len(commands))
+	for _, c := range commands {
+		lines = append(lines, c.Command)
+	}
+	return lines
+}
+
+func TestExtractCommands_Simple(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "$ ",
+		"i", "l", "o", "l",
+		"i", "s", "o", "s",
+		"i", "\r", "o", "\r\nfile.txt\r\n$ ",
+		"i", "pwd\r", "o", "pwd\r\n/root\r\n$ ",
+	))
+
+	require.Len(t, commands, 2)
+	assert.Equal(t, ExtractedCommand{Offset: 6, Command: "ls"}, commands[0])
+	assert.Equal(t, ExtractedCommand{Offset: 8, Command: "pwd"}, commands[1])
+}
+
+func TestExtractCommands_LineEditing(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "root@web:~# ",
+		// Backspace in cooked mode
+		"i", "lsx\x7f", "o", "lsx\b \b",
+		"i", " -l\r", "o", " -l\r\n",
+		// Cursor left and insertion with readline
+		"o", "root@web:~# ",
+		"i", "cat fle", "o", "cat fle",
+		"i", "\x1b[D\x1b[D", "o", "\b\b",
+		"i", "i", "o", "\x1b[1@i",
+		"i", "\r", "o", "\r\n",
+		// Delete in the middle of the line
+		"o", "root@web:~# ",
+		"i", "echo hiX\x1b[D", "o", "echo hiX\b",
+		"i", "\x1b[3~", "o", "\x1b[P",
+		"i", "\r", "o", "\r\nhi\r\n",
+	))
+
+	assert.Equal(t, []string{"ls -l", "cat file", "echo hi"}, commandLines(commands))
+}
+
+func TestExtractCommands_HistoryAndCompletion(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "$ ",
+		"i", "kubectl get pods\r", "o", "kubectl get pods\r\nNAME\r\n$ ",
+		// History recall replaces the line
+		"i", "\x1b[A", "o", "kubectl get pods",
+		"i", "\x1b[A", "o", "\r$ \x1b[Kls",
+		"i", "\r", "o", "\r\n",
+		// Completion listing redraws the prompt and line
+		"o", "$ ",
+		"i", "cd /e", "o", "cd /e",
+		"i", "\t\t", "o", "\r\netc/  etcd/\r\n$ cd /etc",
+		"i", "d\r", "o", "d\r\n",
+	))
+
+	assert.Equal(t, []string{"kubectl get pods", "ls", "cd /etcd"}, commandLines(commands))
+}
+
+func TestExtractCommands_Wrap(t *testing.T) {
+	// The terminal wraps echoed input at the margin
+	commands := extract(t, cast(t, 10,
+		"o", "$ ",
+		"i", "echo 0123456789\r", "o", "echo 0123456789\r\n",
+	))
+	assert.Equal(t, []string{"echo 0123456789"}, commandLines(commands))
+
+	// Readline forces the wrap with a space and carriage return
+	commands = extract(t, cast(t, 10,
+		"o", "$ ",
+		"i", "echo 0123456789", "o", "echo 012 \r3456789",
+		"i", "\r", "o", "\r\n",
+	))
+	assert.Equal(t, []string{"echo 0123456789"}, commandLines(commands))
+
+	// A resize changes where lines wrap
+	commands = extract(t, cast(t, 80,
+		"r", "10x24",
+		"o", "$ ",
+		"i", "echo 01234567\r", "o", "echo 01234567\r\n",
+	))
+	assert.Equal(t, []string{"echo 01234567"}, commandLines(commands))
+}
+
+func TestExtractCommands_HiddenInput(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "$ ",
+		"i", "sudo -i\r", "o", "sudo -i\r\n[sudo] password for dev: ",
+		"i", "hunter2\r", "o", "\r\n",
+		"o", "# ",
+		"i", "\r", "o", "\r\n# ",
+	))
+
+	assert.Equal(t, []string{"sudo -i"}, commandLines(commands))
+}
+
+func TestExtractCommands_Interrupt(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "$ ",
+		"i", "rm -rf /tmp/x", "o", "rm -rf /tmp/x",
+		"i", "\x03", "o", "^C\r\n$ ",
+		"i", "whoami\r", "o", "whoami\r\nroot\r\n$ ",
+	))
+
+	assert.Equal(t, []string{"whoami"}, commandLines(commands))
+}
+
+func TestExtractCommands_FullScreenPrograms(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "$ ",
+		"i", "vim a.txt\r", "o", "vim a.txt\r\n\x1b[?1049h\x1b[H\x1b[2J~\r\n~",
+		"i", "ihello\x1b:wq\r", "o", "hello\r\n\x1b[?1049l",
+		"o", "$ ",
+		"i", "cat a.txt\r", "o", "cat a.txt\r\nhello\r\n",
+	))
+
+	assert.Equal(t, []string{"vim a.txt", "cat a.txt"}, commandLines(commands))
+}
+
+func TestExtractCommands_TypeAheadAndPaste(t *testing.T) {
+	commands := extract(t, cast(t, 80,
+		"o", "$ ",
+		"i", "\x1b[200~cd /tmp\r\nls\r\n\x1b[201~",
+		"o", "cd /tmp\r\n$ ls\r\na b\r\n$ ",
+	))
+
+	require.Len(t, commands, 2)
+	assert.Equal(t, []string{"cd /tmp", "ls"}, commandLines(commands))
+	assert.Equal(t, commands[0].Offset, commands[1].Offset)
+}
+
+func TestExtractCommands_ObjectFrames(t *testing.T) {
+	recording := `{"version":2,"width":80,"height":24}
+{"timestamp":0.5,"type":"o","data":"$ "}
+{"timestamp":1.25,"type":"i","data":"id\r"}
+{"timestamp":1.5,"type":"o","data":"id\r\nuid=0\r\n"}
+`
+	commands := extract(t, recording)
+	assert.Equal(t, []ExtractedCommand{{Offset: 1.25, Command: "id"}}, commands)
+}
+
+func TestExtractCommands_InvalidHeader(t *testing.T) {
+	_, err := ExtractCommands(strings.NewReader("not a recording\n"))
+	assert.Error(t, err)
+
+	_, err = ExtractCommands(strings.NewReader(""))
+	assert.Error(t, err)
+}
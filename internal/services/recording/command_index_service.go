@@ -0,0 +1,159 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+)
+
+// indexBatchSize bounds the recordings indexed by one IndexPending run
+const indexBatchSize = 200
+
+// CommandIndexService extracts the commands submitted in finished
+// recordings and searches them across recordings
+type CommandIndexService struct {
+	repo           repository.RecordingRepositoryInterface
+	commandRepo    *repository.TerminalCommandRepository
+	storageService StorageServiceInterface
+}
+
+// NewCommandIndexService creates a new command index service
+func NewCommandIndexService(
+	repo repository.RecordingRepositoryInterface,
+	commandRepo *repository.TerminalCommandRepository,
+	storageService StorageServiceInterface,
+) *CommandIndexService {
+	return &CommandIndexService{
+		repo:           repo,
+		commandRepo:    commandRepo,
+		storageService: storageService,
+	}
+}
+
+// IndexRecording extracts the commands of a finished recording and replaces
+// its indexed commands. A recording whose file cannot be read or parsed is
+// indexed without commands so that it is not retried.
+func (s *CommandIndexService) IndexRecording(ctx context.Context, recording *models.TerminalRecording) (int, error) {
+	if recording.EndedAt == nil {
+		return 0, fmt.Errorf("recording is still in progress")
+	}
+
+	extracted, err := s.extract(recording)
+	if err != nil {
+		logrus.Warnf("[CommandIndexService] Failed to extract commands of recording %s: %v", recording.ID, err)
+	}
+
+	commands := make([]*models.TerminalCommand, 0, len(extracted))
+	for i, c := range extracted {
+		commands = append(commands, &models.TerminalCommand{
+			RecordingID:   recording.ID,
+			Sequence:      i + 1,
+			Offset:        c.Offset,
+			ExecutedAt:    recording.StartedAt.Add(time.Duration(c.Offset * float64(time.Second))),
+			Command:       c.Command,
+			UserID:        recording.UserID,
+			Username:      recording.Username,
+			RecordingType: recording.RecordingType,
+		})
+	}
+
+	if err := s.commandRepo.ReplaceForRecording(ctx, recording.ID, commands); err != nil {
+		return 0, fmt.Errorf("failed to store commands: %w", err)
+	}
+
+	logrus.Debugf("[CommandIndexService] Indexed %d commands of recording %s", len(commands), recording.ID)
+	return len(commands), nil
+}
+
+func (s *CommandIndexService) extract(recording *models.TerminalRecording) ([]ExtractedCommand, error) {
+	reader, err := s.storageService.ReadRecording(recording.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ExtractCommands(reader)
+}
+
+// IndexRecordingByID indexes a finished recording by ID
+func (s *CommandIndexService) IndexRecordingByID(ctx context.Context, id uuid.UUID) error {
+	recording, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("recording not found: %w", err)
+	}
+
+	_, err = s.IndexRecording(ctx, recording)
+	return err
+}
+
+// IndexPending indexes finished recordings whose commands have not been
+// indexed yet and returns the number of recordings indexed
+func (s *CommandIndexService) IndexPending(ctx context.Context) (int, error) {
+	ids, err := s.commandRepo.UnindexedRecordingIDs(ctx, indexBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find unindexed recordings: %w", err)
+	}
+
+	indexed := 0
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return indexed, err
+		}
+		if err := s.IndexRecordingByID(ctx, id); err != nil {
+			logrus.Warnf("[CommandIndexService] Failed to index recording %s: %v", id, err)
+			continue
+		}
+		indexed++
+	}
+
+	return indexed, nil
+}
+
+// ListRecordingCommands retrieves the commands of a recording in the order
+// they were submitted. A finished recording that has not been indexed yet
+// is indexed first.
+func (s *CommandIndexService) ListRecordingCommands(ctx context.Context, id uuid.UUID) ([]*models.TerminalCommand, error) {
+	recording, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("recording not found: %w", err)
+	}
+
+	if recording.CommandsIndexedAt == nil && recording.EndedAt != nil {
+		if _, err := s.IndexRecording(ctx, recording); err != nil {
+			return nil, err
+		}
+	}
+
+	commands, err := s.commandRepo.ListByRecording(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commands: %w", err)
+	}
+
+	return commands, nil
+}
+
+// SearchCommands searches the indexed commands of all recordings
+func (s *CommandIndexService) SearchCommands(
+	ctx context.Context,
+	filter *repository.TerminalCommandFilter,
+) ([]*models.TerminalCommand, int64, error) {
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	commands, total, err := s.commandRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search failed: %w", err)
+	}
+
+	return commands, total, nil
+}
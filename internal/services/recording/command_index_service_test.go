@@ -0,0 +1,140 @@
+package recording
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/config"
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func newCommandIndexTestServices(t *testing.T) (*ManagerService, *CommandIndexService, *gorm.DB) {
+	db := testdb.Open(t, &models.TerminalRecording{}, &models.TerminalCommand{})
+
+	cfg := &config.Config{}
+	cfg.Recording.BasePath = t.TempDir()
+	storage := NewLocalStorageService(cfg)
+	repo := repository.NewRecordingRepository(db)
+
+	return NewManagerService(repo, storage),
+		NewCommandIndexService(repo, repository.NewTerminalCommandRepository(db), storage),
+		db
+}
+
+func TestTerminalRecorder_IndexesCommands(t *testing.T) {
+	manager, indexer, _ := newCommandIndexTestServices(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	recorder, err := StartTerminalRecording(ctx, manager, indexer, &models.TerminalRecording{
+		SessionID:     uuid.New(),
+		UserID:        userID,
+		Username:      "alice",
+		RecordingType: "k8s_pod",
+		Rows:          24,
+		Cols:          80,
+	})
+	require.NoError(t, err)
+
+	recorder.RecordOutput([]byte("$ "))
+	recorder.RecordInput([]byte("kubectl get pods\r"))
+	recorder.RecordOutput([]byte("kubectl get pods\r\nNAME\r\n$ "))
+	recorder.Resize(100, 30)
+	recorder.RecordInput([]byte("exit\r"))
+	recorder.RecordOutput([]byte("exit\r\n"))
+	recorder.Close()
+	recorder.Close()
+
+	recordingID := uuid.MustParse(recorder.RecordingID())
+	recording, err := manager.GetRecording(ctx, recordingID)
+	require.NoError(t, err)
+	assert.NotNil(t, recording.EndedAt)
+	assert.NotNil(t, recording.CommandsIndexedAt)
+	assert.Positive(t, recording.FileSize)
+
+	commands, err := indexer.ListRecordingCommands(ctx, recordingID)
+	require.NoError(t, err)
+	require.Len(t, commands, 2)
+	assert.Equal(t, "kubectl get pods", commands[0].Command)
+	assert.Equal(t, 1, commands[0].Sequence)
+	assert.Equal(t, "exit", commands[1].Command)
+	assert.Equal(t, userID, commands[1].UserID)
+	assert.Equal(t, "k8s_pod", commands[1].RecordingType)
+	assert.GreaterOrEqual(t, commands[1].Offset, commands[0].Offset)
+
+	found, total, err := indexer.SearchCommands(ctx, &repository.TerminalCommandFilter{Query: "get pod"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, total)
+	require.Len(t, found, 1)
+	assert.Equal(t, recordingID, found[0].RecordingID)
+
+	// Commands of deleted recordings are removed from the index
+	require.NoError(t, manager.DeleteRecording(ctx, recordingID))
+	_, total, err = indexer.SearchCommands(ctx, &repository.TerminalCommandFilter{})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestCommandIndexService_IndexPending(t *testing.T) {
+	_, indexer, db := newCommandIndexTestServices(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "session.cast")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":2,"width":80,"height":24}
+[0.1,"o","# "]
+[2.5,"i","uptime\r"]
+[2.6,"o","uptime\r\n 10:00:00 up 1 day\r\n# "]
+`), 0644))
+
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	endedAt := startedAt.Add(time.Minute)
+	finished := &models.TerminalRecording{
+		SessionID: uuid.New(), UserID: uuid.New(), Username: "bob", RecordingType: "webssh",
+		StoragePath: path, StartedAt: startedAt, EndedAt: &endedAt,
+	}
+	missing := &models.TerminalRecording{
+		SessionID: uuid.New(), UserID: uuid.New(), Username: "bob", RecordingType: "docker",
+		StoragePath: filepath.Join(t.TempDir(), "missing.cast"), StartedAt: startedAt, EndedAt: &endedAt,
+	}
+	active := &models.TerminalRecording{
+		SessionID: uuid.New(), UserID: uuid.New(), Username: "bob", RecordingType: "webssh",
+		StoragePath: path, StartedAt: startedAt,
+	}
+	require.NoError(t, db.Create(finished).Error)
+	require.NoError(t, db.Create(missing).Error)
+	require.NoError(t, db.Create(active).Error)
+
+	indexed, err := indexer.IndexPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, indexed)
+
+	// Recordings without a readable file are not retried
+	indexed, err = indexer.IndexPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, indexed)
+
+	since := startedAt.Add(2 * time.Second)
+	commands, total, err := indexer.SearchCommands(ctx, &repository.TerminalCommandFilter{
+		RecordingType: "webssh",
+		Since:         &since,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, total)
+	require.Len(t, commands, 1)
+	assert.Equal(t, "uptime", commands[0].Command)
+	assert.Equal(t, 2.5, commands[0].Offset)
+	assert.True(t, commands[0].ExecutedAt.Equal(startedAt.Add(2500*time.Millisecond)))
+
+	_, err = indexer.ListRecordingCommands(ctx, uuid.New())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -0,0 +1,154 @@
+package recording
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// TerminalRecorder streams an interactive session to an asciinema v2 file
+// registered as a terminal recording. When closed the recording is
+// finalized and its commands are indexed. It is safe for concurrent use.
+type TerminalRecorder struct {
+	manager   *ManagerService
+	indexer   *CommandIndexService
+	recording *models.TerminalRecording
+
+	mu        sync.Mutex
+	file      *os.File
+	writer    *bufio.Writer
+	startTime time.Time
+	closed    bool
+}
+
+// StartTerminalRecording creates the recording and opens its file. The
+// indexer is optional.
+func StartTerminalRecording(
+	ctx context.Context,
+	manager *ManagerService,
+	indexer *CommandIndexService,
+	recording *models.TerminalRecording,
+) (*TerminalRecorder, error) {
+	if recording.StartedAt.IsZero() {
+		recording.StartedAt = time.Now()
+	}
+	if err := manager.CreateRecording(ctx, recording); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(recording.StoragePath), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %w", err)
+	}
+	file, err := os.Create(recording.StoragePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording file: %w", err)
+	}
+
+	r := &TerminalRecorder{
+		manager:   manager,
+		indexer:   indexer,
+		recording: recording,
+		file:      file,
+		writer:    bufio.NewWriter(file),
+		startTime: recording.StartedAt,
+	}
+
+	header, err := json.Marshal(models.AsciinemaHeader{
+		Version:   2,
+		Width:     recording.Cols,
+		Height:    recording.Rows,
+		Timestamp: recording.StartedAt.Unix(),
+		Env:       map[string]string{"TERM": "xterm-256color"},
+	})
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to marshal header: %w", err)
+	}
+	if _, err := r.writer.Write(append(header, '\n')); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordingID returns the ID of the recording
+func (r *TerminalRecorder) RecordingID() string {
+	return r.recording.ID.String()
+}
+
+// RecordInput records data typed by the user
+func (r *TerminalRecorder) RecordInput(data []byte) {
+	r.writeEvent("i", string(data))
+}
+
+// RecordOutput records data written to the terminal
+func (r *TerminalRecorder) RecordOutput(data []byte) {
+	r.writeEvent("o", string(data))
+}
+
+// Resize records a change of the terminal size
+func (r *TerminalRecorder) Resize(cols, rows uint16) {
+	r.writeEvent("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+func (r *TerminalRecorder) writeEvent(eventType, data string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	event, err := json.Marshal([]interface{}{time.Since(r.startTime).Seconds(), eventType, data})
+	if err != nil {
+		logrus.Errorf("[TerminalRecorder] Failed to marshal event: %v", err)
+		return
+	}
+	if _, err := r.writer.Write(append(event, '\n')); err != nil {
+		logrus.Errorf("[TerminalRecorder] Failed to write event of recording %s: %v", r.recording.ID, err)
+	}
+}
+
+// Close closes the recording file, finalizes the recording and indexes its
+// commands. Closing more than once has no effect.
+func (r *TerminalRecorder) Close() {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+	r.closed = true
+
+	if err := r.writer.Flush(); err != nil {
+		logrus.Errorf("[TerminalRecorder] Failed to flush recording %s: %v", r.recording.ID, err)
+	}
+	if err := r.file.Close(); err != nil {
+		logrus.Errorf("[TerminalRecorder] Failed to close recording %s: %v", r.recording.ID, err)
+	}
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	endedAt := time.Now()
+	if err := r.manager.FinalizeRecording(ctx, r.recording.ID, endedAt, int(endedAt.Sub(r.startTime).Seconds())); err != nil {
+		logrus.Errorf("[TerminalRecorder] Failed to finalize recording %s: %v", r.recording.ID, err)
+		return
+	}
+
+	if r.indexer != nil {
+		if err := r.indexer.IndexRecordingByID(ctx, r.recording.ID); err != nil {
+			logrus.Errorf("[TerminalRecorder] Failed to index commands of recording %s: %v", r.recording.ID, err)
+		}
+	}
+}
@@ -3,15 +3,20 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/ysicing/tiga/internal/repository"
 	"github.com/ysicing/tiga/internal/services/alert"
+	"github.com/ysicing/tiga/internal/services/auth"
 	"github.com/ysicing/tiga/internal/services/docker"
 	"github.com/ysicing/tiga/internal/services/host"
+	"github.com/ysicing/tiga/internal/services/imagescan"
 	"github.com/ysicing/tiga/internal/services/k8s"
+	"github.com/ysicing/tiga/internal/services/recording"
+	"github.com/ysicing/tiga/internal/services/statuspage"
 )
 
 // ResultProvider is an optional interface that tasks can implement
@@ -239,6 +244,320 @@ func (t *DockerHealthCheckTask) GetResult() string {
 	return t.lastResult
 }
 
+// AccessGrantExpiryTask revokes just-in-time access grants whose expiry has passed
+type AccessGrantExpiryTask struct {
+	accessService *auth.AccessRequestService
+	lastResult    string // Store last execution result for ResultProvider
+}
+
+// NewAccessGrantExpiryTask creates a new access grant expiry task
+func NewAccessGrantExpiryTask(accessService *auth.AccessRequestService) *AccessGrantExpiryTask {
+	return &AccessGrantExpiryTask{
+		accessService: accessService,
+	}
+}
+
+// Run executes the access grant expiry sweep
+func (t *AccessGrantExpiryTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	expired, err := t.accessService.ExpireDue(ctx)
+
+	duration := time.Since(start)
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Access grant expiry failed after %s: %v", duration.Round(time.Millisecond), err)
+		return err
+	}
+
+	if expired > 0 {
+		logrus.Infof("Expired %d just-in-time access grants", expired)
+	}
+
+	// Store result for ResultProvider interface
+	t.lastResult = fmt.Sprintf("Expired %d access grants in %s", expired, duration.Round(time.Millisecond))
+	return nil
+}
+
+// Name returns the task name
+func (t *AccessGrantExpiryTask) Name() string {
+	return "access_grant_expiry"
+}
+
+// GetResult implements ResultProvider interface
+func (t *AccessGrantExpiryTask) GetResult() string {
+	return t.lastResult
+}
+
+// ClusterEventCleanupTask removes collected Kubernetes events and audit entries past retention
+type ClusterEventCleanupTask struct {
+	eventRepo     *repository.ClusterEventRepository
+	retentionDays int
+	lastResult    string // Store last execution result for ResultProvider
+}
+
+// NewClusterEventCleanupTask creates a new cluster event cleanup task
+func NewClusterEventCleanupTask(eventRepo *repository.ClusterEventRepository, retentionDays int) *ClusterEventCleanupTask {
+	if retentionDays <= 0 {
+		retentionDays = 30 // Default 30 days retention
+	}
+	return &ClusterEventCleanupTask{
+		eventRepo:     eventRepo,
+		retentionDays: retentionDays,
+	}
+}
+
+// Run executes the cluster event cleanup
+func (t *ClusterEventCleanupTask) Run(ctx context.Context) error {
+	cutoff := time.Now().AddDate(0, 0, -t.retentionDays)
+
+	deleted, err := t.eventRepo.DeleteOlderThan(ctx, cutoff)
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Failed to cleanup cluster events: %v", err)
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"deleted_count":  deleted,
+		"retention_days": t.retentionDays,
+	}).Info("Cleaned up cluster events")
+
+	// Store result for ResultProvider interface
+	t.lastResult = fmt.Sprintf("Deleted %d cluster events older than %d days", deleted, t.retentionDays)
+	return nil
+}
+
+// Name returns the task name
+func (t *ClusterEventCleanupTask) Name() string {
+	return "cluster_event_cleanup"
+}
+
+// GetResult implements ResultProvider interface
+func (t *ClusterEventCleanupTask) GetResult() string {
+	return t.lastResult
+}
+
+// ClusterDriftCheckTask compares saved baselines against their live clusters
+type ClusterDriftCheckTask struct {
+	baselineService *k8s.BaselineService
+	lastResult      string // Store last execution result for ResultProvider
+}
+
+// NewClusterDriftCheckTask creates a new cluster drift check task
+func NewClusterDriftCheckTask(baselineService *k8s.BaselineService) *ClusterDriftCheckTask {
+	return &ClusterDriftCheckTask{
+		baselineService: baselineService,
+	}
+}
+
+// Run executes the drift check of every enabled baseline
+func (t *ClusterDriftCheckTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	checked, drifted, err := t.baselineService.CheckAll(ctx)
+
+	duration := time.Since(start)
+	if drifted > 0 {
+		logrus.Warnf("Cluster drift check: %d of %d baselines drifted", drifted, checked)
+	}
+
+	// Store result for ResultProvider interface
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Checked %d baselines (%d drifted) in %s with errors: %v", checked, drifted, duration.Round(time.Millisecond), err)
+		return err
+	}
+	t.lastResult = fmt.Sprintf("Checked %d baselines (%d drifted) in %s", checked, drifted, duration.Round(time.Millisecond))
+	return nil
+}
+
+// Name returns the task name
+func (t *ClusterDriftCheckTask) Name() string {
+	return "cluster_drift_check"
+}
+
+// GetResult implements ResultProvider interface
+func (t *ClusterDriftCheckTask) GetResult() string {
+	return t.lastResult
+}
+
+// ServiceAvailabilityRollupTask aggregates service histories into daily
+// availability rows used by the status page uptime bars
+type ServiceAvailabilityRollupTask struct {
+	statusPages *statuspage.StatusPageService
+	days        int
+	lastResult  string // Store last execution result for ResultProvider
+}
+
+// NewServiceAvailabilityRollupTask creates a new service availability rollup task.
+// The first run backfills every day shown on status pages; later runs only
+// recompute the last two days.
+func NewServiceAvailabilityRollupTask(statusPages *statuspage.StatusPageService) *ServiceAvailabilityRollupTask {
+	return &ServiceAvailabilityRollupTask{
+		statusPages: statusPages,
+		days:        statuspage.UptimeDays,
+	}
+}
+
+// Run executes the daily availability rollup
+func (t *ServiceAvailabilityRollupTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	saved, err := t.statusPages.RollupDailyAvailability(ctx, start, t.days)
+	duration := time.Since(start)
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Failed to roll up service availability: %v", err)
+		return err
+	}
+	t.days = 2
+
+	logrus.WithField("rows", saved).Info("Rolled up daily service availability")
+
+	// Store result for ResultProvider interface
+	t.lastResult = fmt.Sprintf("Saved %d daily availability rows in %s", saved, duration.Round(time.Millisecond))
+	return nil
+}
+
+// Name returns the task name
+func (t *ServiceAvailabilityRollupTask) Name() string {
+	return "service_availability_rollup"
+}
+
+// GetResult implements ResultProvider interface
+func (t *ServiceAvailabilityRollupTask) GetResult() string {
+	return t.lastResult
+}
+
+// AgentRolloutReconcileTask advances running agent rollouts
+type AgentRolloutReconcileTask struct {
+	rollouts   *host.AgentRolloutService
+	lastResult string // Store last execution result for ResultProvider
+}
+
+// NewAgentRolloutReconcileTask creates a new agent rollout reconcile task
+func NewAgentRolloutReconcileTask(rollouts *host.AgentRolloutService) *AgentRolloutReconcileTask {
+	return &AgentRolloutReconcileTask{
+		rollouts: rollouts,
+	}
+}
+
+// Run dispatches pending upgrades and checks upgrading agents
+func (t *AgentRolloutReconcileTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	if err := t.rollouts.Reconcile(ctx); err != nil {
+		t.lastResult = fmt.Sprintf("Failed to reconcile agent rollouts: %v", err)
+		return err
+	}
+
+	// Store result for ResultProvider interface
+	t.lastResult = fmt.Sprintf("Reconciled agent rollouts in %s", time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// Name returns the task name
+func (t *AgentRolloutReconcileTask) Name() string {
+	return "agent_rollout_reconcile"
+}
+
+// GetResult implements ResultProvider interface
+func (t *AgentRolloutReconcileTask) GetResult() string {
+	return t.lastResult
+}
+
+// DockerCleanupTask runs the cleanup policies of all online Docker instances
+type DockerCleanupTask struct {
+	cleanupService *docker.CleanupService
+	lastResult     string // Store last execution result for ResultProvider
+}
+
+// NewDockerCleanupTask creates a new Docker cleanup task
+func NewDockerCleanupTask(cleanupService *docker.CleanupService) *DockerCleanupTask {
+	return &DockerCleanupTask{
+		cleanupService: cleanupService,
+	}
+}
+
+// Run prunes every online instance with an enabled cleanup policy
+func (t *DockerCleanupTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	results, err := t.cleanupService.RunAll(ctx)
+
+	var lines []string
+	failed := 0
+	for _, result := range results {
+		lines = append(lines, result.Summary())
+		if len(result.Errors) > 0 {
+			failed++
+		}
+	}
+	summary := fmt.Sprintf("Ran cleanup policies on %d instances in %s", len(results), time.Since(start).Round(time.Millisecond))
+	if len(lines) > 0 {
+		summary += "\n" + strings.Join(lines, "\n")
+	}
+
+	// Store result for ResultProvider interface
+	t.lastResult = summary
+	if err != nil {
+		return fmt.Errorf("%w\n%s", err, summary)
+	}
+	if failed > 0 {
+		// Failed executions keep only the error, so include the results
+		return fmt.Errorf("cleanup failed on %d of %d instances\n%s", failed, len(results), summary)
+	}
+
+	logrus.Info(strings.SplitN(summary, "\n", 2)[0])
+	return nil
+}
+
+// Name returns the task name
+func (t *DockerCleanupTask) Name() string {
+	return "docker_cleanup"
+}
+
+// GetResult implements ResultProvider interface
+func (t *DockerCleanupTask) GetResult() string {
+	return t.lastResult
+}
+
+// ImageScanTask rescans the images in use on Docker instances and clusters
+// and notifies about new critical vulnerabilities
+type ImageScanTask struct {
+	scans      *imagescan.Service
+	lastResult string // Store last execution result for ResultProvider
+}
+
+// NewImageScanTask creates a new image scan task
+func NewImageScanTask(scans *imagescan.Service) *ImageScanTask {
+	return &ImageScanTask{
+		scans: scans,
+	}
+}
+
+// Run rescans every image in use
+func (t *ImageScanTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	scanned, failed, err := t.scans.RescanAll(ctx)
+
+	duration := time.Since(start)
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Scanned %d images (%d failed) in %s with errors: %v", scanned, failed, duration.Round(time.Millisecond), err)
+		return err
+	}
+	t.lastResult = fmt.Sprintf("Scanned %d images (%d failed) in %s", scanned, failed, duration.Round(time.Millisecond))
+	return nil
+}
+
+// Name returns the task name
+func (t *ImageScanTask) Name() string {
+	return "image_scan"
+}
+
+// GetResult implements ResultProvider interface
+func (t *ImageScanTask) GetResult() string {
+	return t.lastResult
+}
+
 // DockerAuditCleanupTask cleans up old Docker audit logs (T031)
 type DockerAuditCleanupTask struct {
 	auditRepo     repository.AuditLogRepositoryInterface
@@ -394,3 +713,96 @@ func (t *TerminalRecordingCleanupTask) Name() string {
 func (t *TerminalRecordingCleanupTask) GetResult() string {
 	return t.lastResult
 }
+
+// DockerContainerEventCleanupTask removes collected Docker container events past retention
+type DockerContainerEventCleanupTask struct {
+	eventRepo     *repository.DockerContainerEventRepository
+	retentionDays int
+	lastResult    string // Store last execution result for ResultProvider
+}
+
+// NewDockerContainerEventCleanupTask creates a new Docker container event cleanup task
+func NewDockerContainerEventCleanupTask(eventRepo *repository.DockerContainerEventRepository, retentionDays int) *DockerContainerEventCleanupTask {
+	if retentionDays <= 0 {
+		retentionDays = 30 // Default 30 days retention
+	}
+	return &DockerContainerEventCleanupTask{
+		eventRepo:     eventRepo,
+		retentionDays: retentionDays,
+	}
+}
+
+// Run executes the Docker container event cleanup
+func (t *DockerContainerEventCleanupTask) Run(ctx context.Context) error {
+	cutoff := time.Now().AddDate(0, 0, -t.retentionDays)
+
+	deleted, err := t.eventRepo.DeleteOlderThan(ctx, cutoff)
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Failed to cleanup Docker container events: %v", err)
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"deleted_count":  deleted,
+		"retention_days": t.retentionDays,
+	}).Info("Cleaned up Docker container events")
+
+	// Store result for ResultProvider interface
+	t.lastResult = fmt.Sprintf("Deleted %d Docker container events older than %d days", deleted, t.retentionDays)
+	return nil
+}
+
+// Name returns the task name
+func (t *DockerContainerEventCleanupTask) Name() string {
+	return "docker_container_event_cleanup"
+}
+
+// GetResult implements ResultProvider interface
+func (t *DockerContainerEventCleanupTask) GetResult() string {
+	return t.lastResult
+}
+
+// TerminalCommandIndexTask indexes the commands of finished terminal
+// recordings that have not been indexed yet
+type TerminalCommandIndexTask struct {
+	indexService *recording.CommandIndexService
+	lastResult   string // Store last execution result for ResultProvider
+}
+
+// NewTerminalCommandIndexTask creates a new terminal command index task
+func NewTerminalCommandIndexTask(indexService *recording.CommandIndexService) *TerminalCommandIndexTask {
+	return &TerminalCommandIndexTask{
+		indexService: indexService,
+	}
+}
+
+// Run executes the terminal command indexing
+func (t *TerminalCommandIndexTask) Run(ctx context.Context) error {
+	start := time.Now()
+
+	indexed, err := t.indexService.IndexPending(ctx)
+
+	duration := time.Since(start)
+	if err != nil {
+		t.lastResult = fmt.Sprintf("Terminal command indexing failed after %s: %v", duration.Round(time.Millisecond), err)
+		return err
+	}
+
+	if indexed > 0 {
+		logrus.Infof("Indexed the commands of %d terminal recordings", indexed)
+	}
+
+	// Store result for ResultProvider interface
+	t.lastResult = fmt.Sprintf("Indexed the commands of %d recordings in %s", indexed, duration.Round(time.Millisecond))
+	return nil
+}
+
+// Name returns the task name
+func (t *TerminalCommandIndexTask) Name() string {
+	return "terminal_command_index"
+}
+
+// GetResult implements ResultProvider interface
+func (t *TerminalCommandIndexTask) GetResult() string {
+	return t.lastResult
+}
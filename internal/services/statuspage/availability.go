@@ -0,0 +1,149 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// AvailabilityPeriodDay is the ServiceAvailability period of daily rollups
+const AvailabilityPeriodDay = "1d"
+
+type dayStats struct {
+	up    int
+	total int
+}
+
+// dailyAvailability returns per-monitor, per-date check counts. Completed days
+// come from the daily ServiceAvailability rollup; today, which has not been
+// rolled up yet, is aggregated from the service histories.
+func (s *StatusPageService) dailyAvailability(ctx context.Context, monitorIDs []uuid.UUID, from, now time.Time) (map[uuid.UUID]map[string]*dayStats, error) {
+	result := make(map[uuid.UUID]map[string]*dayStats)
+	if len(monitorIDs) == 0 {
+		return result, nil
+	}
+	add := func(id uuid.UUID, day time.Time, up, total int) {
+		if result[id] == nil {
+			result[id] = make(map[string]*dayStats)
+		}
+		date := day.Format("2006-01-02")
+		stats := result[id][date]
+		if stats == nil {
+			stats = &dayStats{}
+			result[id][date] = stats
+		}
+		stats.up += up
+		stats.total += total
+	}
+
+	today := startOfDay(now)
+
+	var rollups []*models.ServiceAvailability
+	if err := s.db.WithContext(ctx).
+		Where("service_monitor_id IN ? AND period = ? AND start_time >= ? AND start_time < ?",
+			monitorIDs, AvailabilityPeriodDay, from, today).
+		Find(&rollups).Error; err != nil {
+		return nil, fmt.Errorf("failed to load availability: %w", err)
+	}
+	for _, rollup := range rollups {
+		add(rollup.ServiceMonitorID, rollup.StartTime.In(now.Location()), rollup.SuccessfulChecks, rollup.TotalChecks)
+	}
+
+	var histories []*models.ServiceHistory
+	if err := s.db.WithContext(ctx).
+		Where("service_monitor_id IN ? AND created_at >= ?", monitorIDs, today).
+		Find(&histories).Error; err != nil {
+		return nil, fmt.Errorf("failed to load service histories: %w", err)
+	}
+	for _, history := range histories {
+		add(history.ServiceMonitorID, today, int(history.Up), int(history.Up+history.Down))
+	}
+
+	return result, nil
+}
+
+// RollupDailyAvailability aggregates the service histories of the given
+// number of completed days before now into one ServiceAvailability row per
+// monitor and day. Rows are upserted, so the rollup can be rerun safely.
+func (s *StatusPageService) RollupDailyAvailability(ctx context.Context, now time.Time, days int) (int, error) {
+	if days <= 0 {
+		days = 1
+	}
+	end := startOfDay(now)
+	start := end.AddDate(0, 0, -days)
+
+	var histories []*models.ServiceHistory
+	if err := s.db.WithContext(ctx).
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Find(&histories).Error; err != nil {
+		return 0, fmt.Errorf("failed to load service histories: %w", err)
+	}
+
+	type key struct {
+		monitorID uuid.UUID
+		day       time.Time
+	}
+	type aggregate struct {
+		up, down     uint64
+		latencyTotal float64
+	}
+	aggregates := make(map[key]*aggregate)
+	for _, history := range histories {
+		k := key{monitorID: history.ServiceMonitorID, day: startOfDay(history.CreatedAt.In(now.Location()))}
+		agg := aggregates[k]
+		if agg == nil {
+			agg = &aggregate{}
+			aggregates[k] = agg
+		}
+		agg.up += history.Up
+		agg.down += history.Down
+		agg.latencyTotal += float64(history.AvgDelay) * float64(history.Up)
+	}
+
+	saved := 0
+	for k, agg := range aggregates {
+		availability := models.ServiceAvailability{
+			ServiceMonitorID: k.monitorID,
+			Period:           AvailabilityPeriodDay,
+			StartTime:        k.day,
+			EndTime:          k.day.AddDate(0, 0, 1),
+			TotalChecks:      int(agg.up + agg.down),
+			SuccessfulChecks: int(agg.up),
+			FailedChecks:     int(agg.down),
+		}
+		availability.CalculateUptime()
+		if agg.up > 0 {
+			availability.AvgLatency = agg.latencyTotal / float64(agg.up)
+		}
+		if availability.TotalChecks > 0 {
+			availability.DowntimeSeconds = int(float64(agg.down) / float64(availability.TotalChecks) * 86400)
+		}
+
+		err := s.db.WithContext(ctx).
+			Where("service_monitor_id = ? AND period = ? AND start_time = ?",
+				availability.ServiceMonitorID, availability.Period, availability.StartTime).
+			Assign(map[string]interface{}{
+				"service_monitor_id": availability.ServiceMonitorID,
+				"period":             availability.Period,
+				"start_time":         availability.StartTime,
+				"end_time":           availability.EndTime,
+				"total_checks":       availability.TotalChecks,
+				"successful_checks":  availability.SuccessfulChecks,
+				"failed_checks":      availability.FailedChecks,
+				"uptime_percentage":  availability.UptimePercentage,
+				"avg_latency":        availability.AvgLatency,
+				"downtime_seconds":   availability.DowntimeSeconds,
+			}).
+			FirstOrCreate(&models.ServiceAvailability{}).Error
+		if err != nil {
+			return saved, fmt.Errorf("failed to save availability: %w", err)
+		}
+		saved++
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,162 @@
+package statuspage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	PubDate     string  `xml:"pubDate"`
+	GUID        rssGUID `xml:"guid"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Content atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// RSSFeed renders the incidents of a page as an RSS 2.0 feed; link is the
+// public URL of the page
+func RSSFeed(page *PublicStatusPage, link string) ([]byte, error) {
+	incidents := feedIncidents(page)
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         page.Title,
+			Link:          link,
+			Description:   page.Description,
+			LastBuildDate: page.GeneratedAt.UTC().Format(time.RFC1123Z),
+		},
+	}
+	for _, incident := range incidents {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       incidentTitle(incident),
+			Link:        incidentLink(link, incident),
+			Description: incidentBody(incident),
+			PubDate:     incidentUpdated(incident).UTC().Format(time.RFC1123Z),
+			GUID:        rssGUID{Value: incident.ID.String()},
+		})
+	}
+	return marshalFeed(feed)
+}
+
+// AtomFeed renders the incidents of a page as an Atom feed; link is the
+// public URL of the page
+func AtomFeed(page *PublicStatusPage, link string) ([]byte, error) {
+	incidents := feedIncidents(page)
+	updated := page.GeneratedAt
+	if len(incidents) > 0 {
+		updated = incidentUpdated(incidents[0])
+	}
+	feed := atomFeed{
+		Title:   page.Title,
+		ID:      link,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Link:    atomLink{Href: link},
+	}
+	for _, incident := range incidents {
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   incidentTitle(incident),
+			ID:      "urn:uuid:" + incident.ID.String(),
+			Updated: incidentUpdated(incident).UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: incidentLink(link, incident), Rel: "alternate"},
+			Content: atomContent{Type: "text", Value: incidentBody(incident)},
+		})
+	}
+	return marshalFeed(feed)
+}
+
+func marshalFeed(feed interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// feedIncidents returns active and past incidents, most recently updated first
+func feedIncidents(page *PublicStatusPage) []*models.StatusIncident {
+	incidents := make([]*models.StatusIncident, 0, len(page.ActiveIncidents)+len(page.PastIncidents))
+	incidents = append(incidents, page.ActiveIncidents...)
+	incidents = append(incidents, page.PastIncidents...)
+	sort.SliceStable(incidents, func(i, j int) bool {
+		return incidentUpdated(incidents[i]).After(incidentUpdated(incidents[j]))
+	})
+	return incidents
+}
+
+func incidentTitle(incident *models.StatusIncident) string {
+	return fmt.Sprintf("[%s] %s", incident.Status, incident.Title)
+}
+
+func incidentLink(link string, incident *models.StatusIncident) string {
+	return fmt.Sprintf("%s#incident-%s", link, incident.ID)
+}
+
+// incidentUpdated is the time of the latest update; updates are newest first
+func incidentUpdated(incident *models.StatusIncident) time.Time {
+	if len(incident.Updates) > 0 {
+		return incident.Updates[0].CreatedAt
+	}
+	return incident.StartedAt
+}
+
+func incidentBody(incident *models.StatusIncident) string {
+	var b strings.Builder
+	for i, update := range incident.Updates {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		fmt.Fprintf(&b, "%s - %s: %s", update.CreatedAt.UTC().Format(time.RFC3339), update.Status, update.Message)
+	}
+	return b.String()
+}
@@ -0,0 +1,352 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// Status page errors
+var (
+	ErrStatusPageNotFound = errors.New("status page not found")
+	ErrIncidentNotFound   = errors.New("incident not found")
+	ErrSlugTaken          = errors.New("status page slug is already in use")
+	ErrInvalidInput       = errors.New("invalid input")
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,98}[a-z0-9])?$`)
+
+// StatusPageService manages status pages and their incidents and renders
+// the public view
+type StatusPageService struct {
+	db *gorm.DB
+	// isHostOnline reports live agent connectivity; when nil, hosts are
+	// considered online if their agent was active recently
+	isHostOnline func(hostID uuid.UUID) bool
+	now          func() time.Time
+}
+
+// NewStatusPageService creates a new StatusPageService
+func NewStatusPageService(db *gorm.DB, isHostOnline func(hostID uuid.UUID) bool) *StatusPageService {
+	return &StatusPageService{
+		db:           db,
+		isHostOnline: isHostOnline,
+		now:          time.Now,
+	}
+}
+
+// StatusPageRequest creates or replaces the configuration of a status page
+type StatusPageRequest struct {
+	Slug        string                     `json:"slug" binding:"required"`
+	Title       string                     `json:"title" binding:"required"`
+	Description string                     `json:"description"`
+	Published   bool                       `json:"published"`
+	Sections    []models.StatusPageSection `json:"sections"`
+}
+
+// CreateIncidentRequest opens a new incident
+type CreateIncidentRequest struct {
+	Title     string                `json:"title" binding:"required"`
+	Impact    models.IncidentImpact `json:"impact"`
+	Status    models.IncidentStatus `json:"status"`
+	Message   string                `json:"message" binding:"required"`
+	StartedAt *time.Time            `json:"started_at"`
+}
+
+// IncidentUpdateRequest posts a progress update to an incident
+type IncidentUpdateRequest struct {
+	Status  models.IncidentStatus `json:"status" binding:"required"`
+	Message string                `json:"message" binding:"required"`
+}
+
+func invalidInput(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidInput, fmt.Sprintf(format, args...))
+}
+
+// List returns all status pages
+func (s *StatusPageService) List(ctx context.Context) ([]*models.StatusPage, error) {
+	var pages []*models.StatusPage
+	if err := s.db.WithContext(ctx).Order("title ASC").Find(&pages).Error; err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// Get returns a status page by ID
+func (s *StatusPageService) Get(ctx context.Context, id uuid.UUID) (*models.StatusPage, error) {
+	var page models.StatusPage
+	if err := s.db.WithContext(ctx).First(&page, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStatusPageNotFound
+		}
+		return nil, err
+	}
+	return &page, nil
+}
+
+// GetPublished returns a published status page by slug
+func (s *StatusPageService) GetPublished(ctx context.Context, slug string) (*models.StatusPage, error) {
+	var page models.StatusPage
+	err := s.db.WithContext(ctx).
+		Where("slug = ? AND published = ?", strings.ToLower(slug), true).
+		First(&page).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStatusPageNotFound
+		}
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Create creates a status page
+func (s *StatusPageService) Create(ctx context.Context, req *StatusPageRequest) (*models.StatusPage, error) {
+	page := &models.StatusPage{}
+	if err := s.apply(ctx, page, req); err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Create(page).Error; err != nil {
+		return nil, fmt.Errorf("failed to create status page: %w", err)
+	}
+	return page, nil
+}
+
+// Update replaces the configuration of a status page
+func (s *StatusPageService) Update(ctx context.Context, id uuid.UUID, req *StatusPageRequest) (*models.StatusPage, error) {
+	page, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apply(ctx, page, req); err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Save(page).Error; err != nil {
+		return nil, fmt.Errorf("failed to update status page: %w", err)
+	}
+	return page, nil
+}
+
+func (s *StatusPageService) apply(ctx context.Context, page *models.StatusPage, req *StatusPageRequest) error {
+	slug := strings.ToLower(strings.TrimSpace(req.Slug))
+	if !slugPattern.MatchString(slug) {
+		return invalidInput("slug must be lowercase letters, digits and dashes")
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		return invalidInput("title is required")
+	}
+
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.StatusPage{}).
+		Where("slug = ? AND id <> ?", slug, page.ID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrSlugTaken
+	}
+
+	for i, section := range req.Sections {
+		if strings.TrimSpace(section.Name) == "" {
+			return invalidInput("section %d has no name", i+1)
+		}
+	}
+
+	page.Slug = slug
+	page.Title = strings.TrimSpace(req.Title)
+	page.Description = req.Description
+	page.Published = req.Published
+	page.Sections = req.Sections
+	return nil
+}
+
+// Delete deletes a status page together with its incidents
+func (s *StatusPageService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var incidentIDs []uuid.UUID
+		if err := tx.Model(&models.StatusIncident{}).Where("status_page_id = ?", id).Pluck("id", &incidentIDs).Error; err != nil {
+			return err
+		}
+		if len(incidentIDs) > 0 {
+			if err := tx.Unscoped().Where("incident_id IN ?", incidentIDs).Delete(&models.StatusIncidentUpdate{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Where("id IN ?", incidentIDs).Delete(&models.StatusIncident{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// Hard delete so the slug can be reused
+		result := tx.Unscoped().Delete(&models.StatusPage{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrStatusPageNotFound
+		}
+		return nil
+	})
+}
+
+// ListIncidents returns the incidents of a page started after since (all
+// incidents when since is zero), newest first with their updates
+func (s *StatusPageService) ListIncidents(ctx context.Context, pageID uuid.UUID, since time.Time, limit int) ([]*models.StatusIncident, error) {
+	query := s.db.WithContext(ctx).
+		Preload("Updates", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at DESC")
+		}).
+		Where("status_page_id = ?", pageID)
+	if !since.IsZero() {
+		// Unresolved incidents stay visible however old they are
+		query = query.Where("started_at >= ? OR status <> ?", since, models.IncidentStatusResolved)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var incidents []*models.StatusIncident
+	if err := query.Order("started_at DESC").Find(&incidents).Error; err != nil {
+		return nil, err
+	}
+	return incidents, nil
+}
+
+// CreateIncident opens an incident on a page with its first update
+func (s *StatusPageService) CreateIncident(ctx context.Context, pageID uuid.UUID, req *CreateIncidentRequest, createdBy uuid.UUID) (*models.StatusIncident, error) {
+	if _, err := s.Get(ctx, pageID); err != nil {
+		return nil, err
+	}
+
+	if req.Status == "" {
+		req.Status = models.IncidentStatusInvestigating
+	}
+	if req.Impact == "" {
+		req.Impact = models.IncidentImpactMinor
+	}
+	if !req.Status.IsValid() {
+		return nil, invalidInput("invalid incident status %q", req.Status)
+	}
+	if !req.Impact.IsValid() {
+		return nil, invalidInput("invalid incident impact %q", req.Impact)
+	}
+
+	now := s.now()
+	incident := &models.StatusIncident{
+		StatusPageID: pageID,
+		Title:        strings.TrimSpace(req.Title),
+		Status:       req.Status,
+		Impact:       req.Impact,
+		StartedAt:    now,
+		CreatedBy:    createdBy,
+	}
+	if req.StartedAt != nil {
+		incident.StartedAt = *req.StartedAt
+	}
+	if incident.Status == models.IncidentStatusResolved {
+		incident.ResolvedAt = &now
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(incident).Error; err != nil {
+			return err
+		}
+		update := models.StatusIncidentUpdate{
+			IncidentID: incident.ID,
+			Status:     incident.Status,
+			Message:    req.Message,
+			CreatedBy:  createdBy,
+		}
+		if err := tx.Create(&update).Error; err != nil {
+			return err
+		}
+		incident.Updates = []models.StatusIncidentUpdate{update}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incident: %w", err)
+	}
+	return incident, nil
+}
+
+// AddIncidentUpdate posts an update and moves the incident to its status
+func (s *StatusPageService) AddIncidentUpdate(ctx context.Context, incidentID uuid.UUID, req *IncidentUpdateRequest, createdBy uuid.UUID) (*models.StatusIncident, error) {
+	if !req.Status.IsValid() {
+		return nil, invalidInput("invalid incident status %q", req.Status)
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var incident models.StatusIncident
+		if err := tx.First(&incident, "id = ?", incidentID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrIncidentNotFound
+			}
+			return err
+		}
+
+		if err := tx.Create(&models.StatusIncidentUpdate{
+			IncidentID: incident.ID,
+			Status:     req.Status,
+			Message:    req.Message,
+			CreatedBy:  createdBy,
+		}).Error; err != nil {
+			return err
+		}
+
+		incident.Status = req.Status
+		if req.Status == models.IncidentStatusResolved {
+			if incident.ResolvedAt == nil {
+				now := s.now()
+				incident.ResolvedAt = &now
+			}
+		} else {
+			incident.ResolvedAt = nil
+		}
+		return tx.Save(&incident).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetIncident(ctx, incidentID)
+}
+
+// GetIncident returns an incident with its updates
+func (s *StatusPageService) GetIncident(ctx context.Context, id uuid.UUID) (*models.StatusIncident, error) {
+	var incident models.StatusIncident
+	err := s.db.WithContext(ctx).
+		Preload("Updates", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at DESC")
+		}).
+		First(&incident, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrIncidentNotFound
+		}
+		return nil, err
+	}
+	return &incident, nil
+}
+
+// DeleteIncident deletes an incident and its updates
+func (s *StatusPageService) DeleteIncident(ctx context.Context, id uuid.UUID) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("incident_id = ?", id).Delete(&models.StatusIncidentUpdate{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Delete(&models.StatusIncident{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrIncidentNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,168 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func setupStatusPageTest(t *testing.T) (*gorm.DB, *StatusPageService) {
+	db := testdb.Open(t,
+		&models.HostNode{},
+		&models.ServiceMonitor{},
+		&models.ServiceProbeResult{},
+		&models.ServiceAvailability{},
+		&models.ServiceHistory{},
+		&models.StatusPage{},
+		&models.StatusIncident{},
+		&models.StatusIncidentUpdate{},
+	)
+
+	online := map[uuid.UUID]bool{}
+	svc := NewStatusPageService(db, func(id uuid.UUID) bool { return online[id] })
+	return db, svc
+}
+
+func TestStatusPageService_Render(t *testing.T) {
+	db, svc := setupStatusPageTest(t)
+	ctx := context.Background()
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.Local)
+	svc.now = func() time.Time { return now }
+
+	api := &models.ServiceMonitor{Name: "API", Type: models.ProbeTypeHTTP, Target: "https://api.example.com", Interval: 60, Timeout: 5, Enabled: true}
+	require.NoError(t, db.Create(api).Error)
+	web := &models.HostNode{Name: "web-1", SecretKey: "secret", PublicNote: "Frontend"}
+	hidden := &models.HostNode{Name: "db-1", SecretKey: "secret", HideForGuest: true}
+	require.NoError(t, db.Create(web).Error)
+	require.NoError(t, db.Create(hidden).Error)
+
+	// Two completed days of history plus some of today
+	for _, h := range []models.ServiceHistory{
+		{ServiceMonitorID: api.ID, CreatedAt: now.AddDate(0, 0, -2), Up: 100},
+		{ServiceMonitorID: api.ID, CreatedAt: now.AddDate(0, 0, -1), Up: 90, Down: 10},
+		{ServiceMonitorID: api.ID, CreatedAt: now.Add(-time.Hour), Up: 10},
+	} {
+		h := h
+		require.NoError(t, db.Create(&h).Error)
+	}
+	saved, err := svc.RollupDailyAvailability(ctx, now, UptimeDays)
+	require.NoError(t, err)
+	assert.Equal(t, 2, saved)
+	saved, err = svc.RollupDailyAvailability(ctx, now, UptimeDays)
+	require.NoError(t, err)
+	assert.Equal(t, 2, saved, "rollup is idempotent")
+	var rollups int64
+	require.NoError(t, db.Model(&models.ServiceAvailability{}).Where("period = ?", AvailabilityPeriodDay).Count(&rollups).Error)
+	assert.Equal(t, int64(2), rollups)
+
+	require.NoError(t, db.Create(&models.ServiceProbeResult{ServiceMonitorID: api.ID, Timestamp: now.Add(-30 * time.Second), Success: true}).Error)
+
+	page, err := svc.Create(ctx, &StatusPageRequest{
+		Slug:  "Acme",
+		Title: "Acme Status",
+		Sections: []models.StatusPageSection{
+			{Name: "Services", MonitorIDs: []uuid.UUID{api.ID}},
+			{Name: "Infrastructure", HostIDs: []uuid.UUID{web.ID, hidden.ID}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", page.Slug)
+
+	_, err = svc.Render(ctx, "acme")
+	assert.ErrorIs(t, err, ErrStatusPageNotFound, "unpublished pages are not public")
+
+	_, err = svc.Create(ctx, &StatusPageRequest{Slug: "acme", Title: "Duplicate"})
+	assert.ErrorIs(t, err, ErrSlugTaken)
+
+	_, err = svc.Update(ctx, page.ID, &StatusPageRequest{Slug: "acme", Title: "Acme Status", Published: true, Sections: page.Sections})
+	require.NoError(t, err)
+
+	view, err := svc.Render(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, PageStatusMajorOutage, view.Status, "web-1 has no agent connected")
+	require.Len(t, view.Sections, 2)
+
+	api0 := view.Sections[0].Components[0]
+	assert.Equal(t, StatusOperational, api0.Status)
+	require.Len(t, api0.Days, UptimeDays)
+	assert.Equal(t, now.Format("2006-01-02"), api0.Days[UptimeDays-1].Date)
+	assert.Equal(t, 10, api0.Days[UptimeDays-1].TotalChecks)
+	require.NotNil(t, api0.Days[UptimeDays-2].UptimePercentage)
+	assert.InDelta(t, 90.0, *api0.Days[UptimeDays-2].UptimePercentage, 0.001)
+	assert.Equal(t, StatusDegraded, api0.Days[UptimeDays-2].Status)
+	assert.Nil(t, api0.Days[0].UptimePercentage)
+	require.NotNil(t, api0.UptimePercentage)
+	assert.InDelta(t, 200.0/210.0*100, *api0.UptimePercentage, 0.001)
+
+	hosts := view.Sections[1].Components
+	require.Len(t, hosts, 1, "hosts hidden from guests are skipped")
+	assert.Equal(t, "Frontend", hosts[0].Description)
+	assert.Equal(t, StatusDown, hosts[0].Status)
+}
+
+func TestStatusPageService_Incidents(t *testing.T) {
+	db, svc := setupStatusPageTest(t)
+	ctx := context.Background()
+
+	page, err := svc.Create(ctx, &StatusPageRequest{Slug: "acme", Title: "Acme Status", Published: true})
+	require.NoError(t, err)
+
+	_, err = svc.CreateIncident(ctx, page.ID, &CreateIncidentRequest{Title: "Bad", Impact: "huge", Message: "x"}, uuid.Nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	incident, err := svc.CreateIncident(ctx, page.ID, &CreateIncidentRequest{
+		Title:   "Elevated API errors",
+		Impact:  models.IncidentImpactMajor,
+		Message: "We are looking into elevated error rates",
+	}, uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.IncidentStatusInvestigating, incident.Status)
+
+	view, err := svc.Render(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, PageStatusMajorOutage, view.Status)
+	require.Len(t, view.ActiveIncidents, 1)
+
+	svc.now = func() time.Time { return time.Now().Add(time.Minute) }
+	incident, err = svc.AddIncidentUpdate(ctx, incident.ID, &IncidentUpdateRequest{
+		Status:  models.IncidentStatusResolved,
+		Message: "Error rates are back to normal",
+	}, uuid.Nil)
+	require.NoError(t, err)
+	assert.NotNil(t, incident.ResolvedAt)
+	require.Len(t, incident.Updates, 2)
+
+	view, err = svc.Render(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, PageStatusOperational, view.Status)
+	assert.Empty(t, view.ActiveIncidents)
+	require.Len(t, view.PastIncidents, 1)
+
+	rss, err := RSSFeed(view, "https://status.example.com/status/acme")
+	require.NoError(t, err)
+	var feed rssFeed
+	require.NoError(t, xml.Unmarshal(rss, &feed))
+	require.Len(t, feed.Channel.Items, 1)
+	assert.Equal(t, "[resolved] Elevated API errors", feed.Channel.Items[0].Title)
+	assert.Contains(t, feed.Channel.Items[0].Description, "back to normal")
+
+	atom, err := AtomFeed(view, "https://status.example.com/status/acme")
+	require.NoError(t, err)
+	assert.Contains(t, string(atom), `<feed xmlns="http://www.w3.org/2005/Atom">`)
+
+	require.NoError(t, svc.Delete(ctx, page.ID))
+	var updates int64
+	require.NoError(t, db.Unscoped().Model(&models.StatusIncidentUpdate{}).Count(&updates).Error)
+	assert.Zero(t, updates)
+	_, err = svc.Create(ctx, &StatusPageRequest{Slug: "acme", Title: "Acme Status"})
+	assert.NoError(t, err, "slug is reusable after delete")
+}
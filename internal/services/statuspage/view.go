@@ -0,0 +1,348 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+)
+
+// UptimeDays is the number of daily bars rendered per monitor
+const UptimeDays = 90
+
+// Component statuses
+const (
+	StatusOperational = "operational"
+	StatusDegraded    = "degraded"
+	StatusDown        = "down"
+	StatusUnknown     = "unknown"
+)
+
+// Overall page statuses
+const (
+	PageStatusOperational = "operational"
+	PageStatusDegraded    = "degraded"
+	PageStatusMajorOutage = "major_outage"
+)
+
+// Component types
+const (
+	ComponentMonitor = "monitor"
+	ComponentHost    = "host"
+)
+
+// hostActiveWindow is how recently an agent must have reported for its host
+// to count as online when live connectivity is unknown
+const hostActiveWindow = 3 * time.Minute
+
+// PublicStatusPage is the unauthenticated view of a status page
+type PublicStatusPage struct {
+	Slug            string                   `json:"slug"`
+	Title           string                   `json:"title"`
+	Description     string                   `json:"description"`
+	Status          string                   `json:"status"`
+	GeneratedAt     time.Time                `json:"generated_at"`
+	Sections        []PublicSection          `json:"sections"`
+	ActiveIncidents []*models.StatusIncident `json:"active_incidents"`
+	PastIncidents   []*models.StatusIncident `json:"past_incidents"`
+}
+
+// PublicSection is a named group of components
+type PublicSection struct {
+	Name       string            `json:"name"`
+	Components []PublicComponent `json:"components"`
+}
+
+// PublicComponent is a monitor or host as shown to the public
+type PublicComponent struct {
+	ID          uuid.UUID `json:"id"`
+	Type        string    `json:"type"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Status      string    `json:"status"`
+	// Uptime over the rendered days; monitors only
+	UptimePercentage *float64    `json:"uptime_percentage,omitempty"`
+	Days             []UptimeDay `json:"days,omitempty"`
+}
+
+// UptimeDay is one bar of the uptime history, oldest first
+type UptimeDay struct {
+	Date             string   `json:"date"` // YYYY-MM-DD
+	UptimePercentage *float64 `json:"uptime_percentage"`
+	TotalChecks      int      `json:"total_checks"`
+	Status           string   `json:"status"`
+}
+
+// Render builds the public view of a published page. Hosts hidden from
+// guests are never shown, even when selected.
+func (s *StatusPageService) Render(ctx context.Context, slug string) (*PublicStatusPage, error) {
+	page, err := s.GetPublished(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	today := startOfDay(now)
+	firstDay := today.AddDate(0, 0, -(UptimeDays - 1))
+
+	monitorIDs, hostIDs := collectComponentIDs(page.Sections)
+	monitors, err := s.loadMonitors(ctx, monitorIDs)
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := s.loadHosts(ctx, hostIDs)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.dailyAvailability(ctx, monitorIDs, firstDay, now)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &PublicStatusPage{
+		Slug:        page.Slug,
+		Title:       page.Title,
+		Description: page.Description,
+		GeneratedAt: now,
+		Sections:    make([]PublicSection, 0, len(page.Sections)),
+	}
+
+	worst := StatusOperational
+	for _, section := range page.Sections {
+		out := PublicSection{Name: section.Name, Components: []PublicComponent{}}
+		for _, id := range section.MonitorIDs {
+			monitor, ok := monitors[id]
+			if !ok {
+				continue
+			}
+			component, err := s.monitorComponent(ctx, monitor, days[id], firstDay, now)
+			if err != nil {
+				return nil, err
+			}
+			out.Components = append(out.Components, component)
+			worst = worseStatus(worst, component.Status)
+		}
+		for _, id := range section.HostIDs {
+			host, ok := hosts[id]
+			if !ok || host.HideForGuest {
+				continue
+			}
+			component := s.hostComponent(host, now)
+			out.Components = append(out.Components, component)
+			worst = worseStatus(worst, component.Status)
+		}
+		view.Sections = append(view.Sections, out)
+	}
+
+	incidents, err := s.ListIncidents(ctx, page.ID, today.AddDate(0, 0, -UptimeDays), 0)
+	if err != nil {
+		return nil, err
+	}
+	view.ActiveIncidents = []*models.StatusIncident{}
+	view.PastIncidents = []*models.StatusIncident{}
+	for _, incident := range incidents {
+		if incident.Status == models.IncidentStatusResolved {
+			view.PastIncidents = append(view.PastIncidents, incident)
+			continue
+		}
+		view.ActiveIncidents = append(view.ActiveIncidents, incident)
+		switch incident.Impact {
+		case models.IncidentImpactMajor, models.IncidentImpactCritical:
+			worst = worseStatus(worst, StatusDown)
+		case models.IncidentImpactMinor:
+			worst = worseStatus(worst, StatusDegraded)
+		}
+	}
+
+	switch worst {
+	case StatusDown:
+		view.Status = PageStatusMajorOutage
+	case StatusDegraded:
+		view.Status = PageStatusDegraded
+	default:
+		view.Status = PageStatusOperational
+	}
+
+	return view, nil
+}
+
+func (s *StatusPageService) monitorComponent(ctx context.Context, monitor *models.ServiceMonitor, days map[string]*dayStats, firstDay, now time.Time) (PublicComponent, error) {
+	component := PublicComponent{
+		ID:   monitor.ID,
+		Type: ComponentMonitor,
+		Name: monitor.Name,
+		Days: make([]UptimeDay, 0, UptimeDays),
+	}
+
+	var up, total int
+	for day := firstDay; !day.After(now); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		bar := UptimeDay{Date: date, Status: StatusUnknown}
+		if stats := days[date]; stats != nil && stats.total > 0 {
+			pct := float64(stats.up) / float64(stats.total) * 100
+			bar.UptimePercentage = &pct
+			bar.TotalChecks = stats.total
+			bar.Status = uptimeStatus(pct)
+			up += stats.up
+			total += stats.total
+		}
+		component.Days = append(component.Days, bar)
+	}
+	if total > 0 {
+		pct := float64(up) / float64(total) * 100
+		component.UptimePercentage = &pct
+	}
+
+	status, err := s.currentMonitorStatus(ctx, monitor, now)
+	if err != nil {
+		return component, err
+	}
+	component.Status = status
+	return component, nil
+}
+
+// currentMonitorStatus looks at the probe results of the last two intervals:
+// all successful is operational, all failed is down, mixed is degraded
+func (s *StatusPageService) currentMonitorStatus(ctx context.Context, monitor *models.ServiceMonitor, now time.Time) (string, error) {
+	if !monitor.Enabled {
+		return StatusUnknown, nil
+	}
+
+	window := 2 * time.Duration(monitor.Interval) * time.Second
+	if window < 2*time.Minute {
+		window = 2 * time.Minute
+	}
+
+	var rows []struct {
+		Success bool
+		Count   int
+	}
+	err := s.db.WithContext(ctx).Model(&models.ServiceProbeResult{}).
+		Select("success, COUNT(*) AS count").
+		Where("service_monitor_id = ? AND timestamp >= ?", monitor.ID, now.Add(-window)).
+		Group("success").
+		Scan(&rows).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to load probe results: %w", err)
+	}
+
+	var up, down int
+	for _, row := range rows {
+		if row.Success {
+			up += row.Count
+		} else {
+			down += row.Count
+		}
+	}
+	switch {
+	case up == 0 && down == 0:
+		return StatusUnknown, nil
+	case down == 0:
+		return StatusOperational, nil
+	case up == 0:
+		return StatusDown, nil
+	default:
+		return StatusDegraded, nil
+	}
+}
+
+func (s *StatusPageService) hostComponent(host *models.HostNode, now time.Time) PublicComponent {
+	online := host.LastActive != nil && now.Sub(*host.LastActive) < hostActiveWindow
+	if s.isHostOnline != nil {
+		online = s.isHostOnline(host.ID)
+	}
+
+	status := StatusDown
+	if online {
+		status = StatusOperational
+	}
+	return PublicComponent{
+		ID:          host.ID,
+		Type:        ComponentHost,
+		Name:        host.Name,
+		Description: host.PublicNote,
+		Status:      status,
+	}
+}
+
+func (s *StatusPageService) loadMonitors(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.ServiceMonitor, error) {
+	result := make(map[uuid.UUID]*models.ServiceMonitor)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	var monitors []*models.ServiceMonitor
+	if err := s.db.WithContext(ctx).Where("id IN ?", ids).Find(&monitors).Error; err != nil {
+		return nil, fmt.Errorf("failed to load service monitors: %w", err)
+	}
+	for _, monitor := range monitors {
+		result[monitor.ID] = monitor
+	}
+	return result, nil
+}
+
+func (s *StatusPageService) loadHosts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.HostNode, error) {
+	result := make(map[uuid.UUID]*models.HostNode)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	var hosts []*models.HostNode
+	if err := s.db.WithContext(ctx).Where("id IN ?", ids).Find(&hosts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load hosts: %w", err)
+	}
+	for _, host := range hosts {
+		result[host.ID] = host
+	}
+	return result, nil
+}
+
+func collectComponentIDs(sections models.StatusPageSections) (monitorIDs, hostIDs []uuid.UUID) {
+	seen := make(map[uuid.UUID]bool)
+	for _, section := range sections {
+		for _, id := range section.MonitorIDs {
+			if !seen[id] {
+				seen[id] = true
+				monitorIDs = append(monitorIDs, id)
+			}
+		}
+		for _, id := range section.HostIDs {
+			if !seen[id] {
+				seen[id] = true
+				hostIDs = append(hostIDs, id)
+			}
+		}
+	}
+	return monitorIDs, hostIDs
+}
+
+// uptimeStatus classifies a day using the same thresholds as ServiceHistory
+func uptimeStatus(pct float64) string {
+	switch {
+	case pct >= 95:
+		return StatusOperational
+	case pct >= 80:
+		return StatusDegraded
+	default:
+		return StatusDown
+	}
+}
+
+var statusRank = map[string]int{
+	StatusOperational: 0,
+	StatusUnknown:     0,
+	StatusDegraded:    1,
+	StatusDown:        2,
+}
+
+func worseStatus(a, b string) string {
+	if statusRank[b] > statusRank[a] {
+		return b
+	}
+	return a
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,473 @@
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/internal/services/audit"
+)
+
+// Kind identifies the kind of a terminal session
+type Kind string
+
+const (
+	KindWebSSH  Kind = "webssh"
+	KindDocker  Kind = "docker"
+	KindK8sPod  Kind = "k8s_pod"
+	KindK8sNode Kind = "k8s_node"
+)
+
+const (
+	// backlogSize is the amount of recent output replayed to joining viewers
+	backlogSize = 64 * 1024
+	// viewerBuffer is the number of events buffered for a viewer before it
+	// is disconnected as too slow
+	viewerBuffer = 256
+)
+
+var (
+	// ErrSessionNotFound is returned for sessions that are not active
+	ErrSessionNotFound = errors.New("terminal session not found")
+	// ErrSessionClosed is returned when joining a session that is closing
+	ErrSessionClosed = errors.New("terminal session is closed")
+)
+
+// Actor identifies the admin joining or terminating a session
+type Actor struct {
+	UserID    uuid.UUID
+	Username  string
+	ClientIP  string
+	UserAgent string
+}
+
+// ViewerInfo describes a viewer of a session
+type ViewerInfo struct {
+	ID       string    `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	ClientIP string    `json:"client_ip"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// SessionInfo describes an active terminal session
+type SessionInfo struct {
+	ID        string       `json:"id"`
+	Kind      Kind         `json:"kind"`
+	UserID    uuid.UUID    `json:"user_id"`
+	Username  string       `json:"username"`
+	Target    string       `json:"target"` // Host, container, pod or node the terminal is attached to
+	ClientIP  string       `json:"client_ip"`
+	StartedAt time.Time    `json:"started_at"`
+	Cols      uint16       `json:"cols"`
+	Rows      uint16       `json:"rows"`
+	Viewers   []ViewerInfo `json:"viewers"`
+}
+
+// Controls lets the registry act on the connection of the session owner
+type Controls struct {
+	// Notify writes a notice to the owner's terminal without recording it
+	Notify func(message string)
+	// Terminate closes the underlying stream of the session
+	Terminate func(reason string)
+}
+
+// Registry tracks the active interactive terminal sessions so that admins
+// can watch them live and terminate them. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+	logger   *audit.AsyncLogger[*models.AuditEvent]
+}
+
+// NewRegistry creates a terminal session registry. Joins and terminations
+// are audited when repo is set.
+func NewRegistry(repo repository.AuditEventRepository, config *audit.Config) *Registry {
+	r := &Registry{sessions: make(map[string]*Session)}
+	if repo != nil {
+		r.logger = audit.NewAsyncLogger[*models.AuditEvent](repo, "Terminal", config)
+	}
+	return r
+}
+
+// Register adds an active session. The session must be unregistered when its
+// connection closes.
+func (r *Registry) Register(info SessionInfo, controls Controls) *Session {
+	if info.StartedAt.IsZero() {
+		info.StartedAt = time.Now()
+	}
+	info.Viewers = nil
+
+	s := &Session{
+		registry: r,
+		controls: controls,
+		info:     info,
+		viewers:  make(map[*Viewer]struct{}),
+	}
+
+	r.mu.Lock()
+	r.sessions[info.ID] = s
+	r.mu.Unlock()
+
+	return s
+}
+
+// Unregister removes a session and disconnects its viewers
+func (r *Registry) Unregister(s *Session) {
+	r.mu.Lock()
+	if r.sessions[s.info.ID] == s {
+		delete(r.sessions, s.info.ID)
+	}
+	r.mu.Unlock()
+
+	s.close()
+}
+
+// List returns the active sessions, oldest first
+func (r *Registry) List() []SessionInfo {
+	r.mu.RLock()
+	sessions := make([]*Session, 0, len(r.sessions))
+	for _, s := range r.sessions {
+		sessions = append(sessions, s)
+	}
+	r.mu.RUnlock()
+
+	infos := make([]SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		infos = append(infos, s.Info())
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].StartedAt.Before(infos[j].StartedAt)
+	})
+	return infos
+}
+
+// Get returns an active session
+func (r *Registry) Get(id string) (*Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	return s, nil
+}
+
+// Join attaches a read-only viewer to a session. The owner is told who is
+// watching.
+func (r *Registry) Join(id string, actor Actor) (*Viewer, error) {
+	s, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.join(actor)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(fmt.Sprintf("%s is now viewing this session (read-only)", actor.Username))
+	r.audit(s.Info(), actor, models.ActionTerminalJoined, map[string]string{
+		"viewer_id": v.info.ID,
+	})
+	return v, nil
+}
+
+// Terminate closes a session on behalf of an admin. The owner is told who
+// terminated the session and why.
+func (r *Registry) Terminate(id string, actor Actor, reason string) error {
+	s, err := r.Get(id)
+	if err != nil {
+		return err
+	}
+
+	info := s.Info()
+	s.notify(fmt.Sprintf("session terminated by %s: %s", actor.Username, reason))
+	r.audit(info, actor, models.ActionTerminalTerminated, map[string]string{
+		"reason": reason,
+	})
+
+	if s.controls.Terminate != nil {
+		s.controls.Terminate(reason)
+	}
+	s.closeWithReason("terminated by " + actor.Username + ": " + reason)
+	return nil
+}
+
+// Shutdown flushes pending audit events
+func (r *Registry) Shutdown(timeout time.Duration) error {
+	if r.logger == nil {
+		return nil
+	}
+	return r.logger.Shutdown(timeout)
+}
+
+func (r *Registry) audit(info SessionInfo, actor Actor, action models.Action, data map[string]string) {
+	if r.logger == nil {
+		return
+	}
+
+	data["session_kind"] = string(info.Kind)
+	data["owner"] = info.Username
+
+	event := &models.AuditEvent{
+		ID:           uuid.New().String(),
+		Timestamp:    time.Now().UnixMilli(),
+		Subsystem:    subsystemOf(info.Kind),
+		Action:       action,
+		ResourceType: models.ResourceTypeTerminalSession,
+		Resource: models.Resource{
+			Type:       models.ResourceTypeTerminalSession,
+			Identifier: info.ID,
+			Data: map[string]string{
+				"kind":     string(info.Kind),
+				"target":   info.Target,
+				"owner_id": info.UserID.String(),
+				"owner":    info.Username,
+			},
+		},
+		User: models.Principal{
+			UID:      actor.UserID.String(),
+			Username: actor.Username,
+			Type:     models.PrincipalTypeUser,
+		},
+		ClientIP:  actor.ClientIP,
+		UserAgent: actor.UserAgent,
+		Data:      data,
+	}
+
+	if err := r.logger.Enqueue(event); err != nil {
+		logrus.WithError(err).Warnf("Failed to audit %s of terminal session %s", action, info.ID)
+	}
+}
+
+func subsystemOf(kind Kind) models.SubsystemType {
+	switch kind {
+	case KindWebSSH:
+		return models.SubsystemWebSSH
+	case KindDocker:
+		return models.SubsystemDocker
+	default:
+		return models.SubsystemKubernetes
+	}
+}
+
+// Session is an active terminal session whose output can be mirrored to
+// viewers
+type Session struct {
+	registry *Registry
+	controls Controls
+
+	mu      sync.Mutex
+	info    SessionInfo
+	viewers map[*Viewer]struct{}
+	backlog []byte
+	closed  bool
+}
+
+// ID returns the ID of the session
+func (s *Session) ID() string {
+	return s.info.ID
+}
+
+// Info returns a snapshot of the session
+func (s *Session) Info() SessionInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info := s.info
+	info.Viewers = make([]ViewerInfo, 0, len(s.viewers))
+	for v := range s.viewers {
+		info.Viewers = append(info.Viewers, v.info)
+	}
+	sort.Slice(info.Viewers, func(i, j int) bool {
+		return info.Viewers[i].JoinedAt.Before(info.Viewers[j].JoinedAt)
+	})
+	return info
+}
+
+// Output mirrors terminal output to the viewers of the session
+func (s *Session) Output(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.backlog = append(s.backlog, data...)
+	if len(s.backlog) > backlogSize {
+		s.backlog = append(s.backlog[:0], s.backlog[len(s.backlog)-backlogSize:]...)
+	}
+
+	if len(s.viewers) == 0 {
+		return
+	}
+	chunk := append([]byte(nil), data...)
+	s.broadcast(Event{Type: EventOutput, Data: chunk})
+}
+
+// Resize records a change of the terminal size and mirrors it to the viewers
+func (s *Session) Resize(cols, rows uint16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.info.Cols = cols
+	s.info.Rows = rows
+	s.broadcast(Event{Type: EventResize, Cols: cols, Rows: rows})
+}
+
+// broadcast sends an event to all viewers, disconnecting those that do not
+// keep up. The caller must hold s.mu.
+func (s *Session) broadcast(event Event) {
+	for v := range s.viewers {
+		select {
+		case v.events <- event:
+		default:
+			delete(s.viewers, v)
+			v.closeLocked("viewer is too slow")
+			logrus.Warnf("Disconnected slow viewer %s of terminal session %s", v.info.Username, s.info.ID)
+		}
+	}
+}
+
+func (s *Session) join(actor Actor) (*Viewer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrSessionClosed
+	}
+
+	v := &Viewer{
+		session: s,
+		info: ViewerInfo{
+			ID:       uuid.New().String(),
+			UserID:   actor.UserID,
+			Username: actor.Username,
+			ClientIP: actor.ClientIP,
+			JoinedAt: time.Now(),
+		},
+		events: make(chan Event, viewerBuffer),
+	}
+
+	// Bring the viewer up to date before live output
+	if s.info.Cols > 0 && s.info.Rows > 0 {
+		v.events <- Event{Type: EventResize, Cols: s.info.Cols, Rows: s.info.Rows}
+	}
+	if len(s.backlog) > 0 {
+		v.events <- Event{Type: EventOutput, Data: append([]byte(nil), s.backlog...)}
+	}
+
+	s.viewers[v] = struct{}{}
+	return v, nil
+}
+
+func (s *Session) leave(v *Viewer) {
+	s.mu.Lock()
+	_, ok := s.viewers[v]
+	if ok {
+		delete(s.viewers, v)
+		v.closeLocked("viewer left")
+	}
+	s.mu.Unlock()
+
+	if ok {
+		s.notify(fmt.Sprintf("%s stopped viewing this session", v.info.Username))
+	}
+}
+
+// notify writes a notice to the owner's terminal
+func (s *Session) notify(message string) {
+	if s.controls.Notify == nil {
+		return
+	}
+	s.controls.Notify("\r\n\x1b[33m[tiga] " + message + "\x1b[0m\r\n")
+}
+
+func (s *Session) close() {
+	s.closeWithReason("session closed")
+}
+
+func (s *Session) closeWithReason(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	for v := range s.viewers {
+		delete(s.viewers, v)
+		v.closeLocked(reason)
+	}
+	s.backlog = nil
+}
+
+// EventType is the type of an event sent to viewers
+type EventType string
+
+const (
+	EventOutput EventType = "output"
+	EventResize EventType = "resize"
+)
+
+// Event is terminal output or a size change mirrored to a viewer
+type Event struct {
+	Type EventType
+	Data []byte
+	Cols uint16
+	Rows uint16
+}
+
+// Viewer is a read-only attachment to a session
+type Viewer struct {
+	session *Session
+	info    ViewerInfo
+	events  chan Event
+	reason  string
+}
+
+// Info returns the description of the viewer
+func (v *Viewer) Info() ViewerInfo {
+	return v.info
+}
+
+// Events returns the events of the session. The channel is closed when the
+// viewer is disconnected; Reason then tells why.
+func (v *Viewer) Events() <-chan Event {
+	return v.events
+}
+
+// Reason returns why the viewer was disconnected. It must only be called
+// after the events channel is closed.
+func (v *Viewer) Reason() string {
+	return v.reason
+}
+
+// Leave detaches the viewer from the session
+func (v *Viewer) Leave() {
+	v.session.leave(v)
+}
+
+// closeLocked closes the events channel. The caller must hold the session
+// lock and have removed the viewer from the session.
+func (v *Viewer) closeLocked(reason string) {
+	v.reason = reason
+	close(v.events)
+}
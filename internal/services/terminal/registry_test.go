@@ -0,0 +1,200 @@
+package terminal
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/repository"
+	"github.com/ysicing/tiga/tests/testdb"
+)
+
+func setupRegistryTestDB(t *testing.T) (*gorm.DB, *Registry) {
+	db := testdb.Open(t, &models.AuditEvent{})
+	return db, NewRegistry(repository.NewAuditEventRepository(db), nil)
+}
+
+// ownerConn records what the registry does to the connection of the owner
+type ownerConn struct {
+	mu         sync.Mutex
+	notices    []string
+	terminated string
+}
+
+func (o *ownerConn) controls() Controls {
+	return Controls{
+		Notify: func(message string) {
+			o.mu.Lock()
+			defer o.mu.Unlock()
+			o.notices = append(o.notices, message)
+		},
+		Terminate: func(reason string) {
+			o.mu.Lock()
+			defer o.mu.Unlock()
+			o.terminated = reason
+		},
+	}
+}
+
+func drain(v *Viewer) []Event {
+	var events []Event
+	for {
+		select {
+		case event, ok := <-v.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestRegistry_JoinMirrorsOutput(t *testing.T) {
+	_, registry := setupRegistryTestDB(t)
+	owner := &ownerConn{}
+
+	session := registry.Register(SessionInfo{
+		ID:       "stream-1",
+		Kind:     KindWebSSH,
+		UserID:   uuid.New(),
+		Username: "alice",
+		Target:   "web-01",
+		Cols:     80,
+		Rows:     24,
+	}, owner.controls())
+
+	// Output before anyone joins is replayed on join
+	session.Output([]byte("$ ls\r\n"))
+
+	viewer, err := registry.Join("stream-1", Actor{UserID: uuid.New(), Username: "admin"})
+	require.NoError(t, err)
+
+	session.Output([]byte("README.md\r\n"))
+	session.Resize(120, 40)
+
+	events := drain(viewer)
+	require.Len(t, events, 4)
+	assert.Equal(t, Event{Type: EventResize, Cols: 80, Rows: 24}, events[0])
+	assert.Equal(t, "$ ls\r\n", string(events[1].Data))
+	assert.Equal(t, "README.md\r\n", string(events[2].Data))
+	assert.Equal(t, Event{Type: EventResize, Cols: 120, Rows: 40}, events[3])
+
+	sessions := registry.List()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, uint16(120), sessions[0].Cols)
+	require.Len(t, sessions[0].Viewers, 1)
+	assert.Equal(t, "admin", sessions[0].Viewers[0].Username)
+
+	viewer.Leave()
+	_, open := <-viewer.Events()
+	assert.False(t, open)
+	assert.Equal(t, "viewer left", viewer.Reason())
+	assert.Empty(t, registry.List()[0].Viewers)
+
+	require.Len(t, owner.notices, 2)
+	assert.Contains(t, owner.notices[0], "admin is now viewing this session")
+	assert.Contains(t, owner.notices[1], "admin stopped viewing this session")
+
+	registry.Unregister(session)
+	assert.Empty(t, registry.List())
+	_, err = registry.Join("stream-1", Actor{Username: "admin"})
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestRegistry_BacklogIsBounded(t *testing.T) {
+	registry := NewRegistry(nil, nil)
+	session := registry.Register(SessionInfo{ID: "s", Kind: KindDocker}, Controls{})
+
+	session.Output([]byte(strings.Repeat("a", backlogSize)))
+	session.Output([]byte("tail"))
+
+	viewer, err := registry.Join("s", Actor{Username: "admin"})
+	require.NoError(t, err)
+
+	events := drain(viewer)
+	require.Len(t, events, 1)
+	assert.Len(t, events[0].Data, backlogSize)
+	assert.True(t, strings.HasSuffix(string(events[0].Data), "tail"))
+}
+
+func TestRegistry_DisconnectsSlowViewer(t *testing.T) {
+	registry := NewRegistry(nil, nil)
+	session := registry.Register(SessionInfo{ID: "s", Kind: KindK8sPod}, Controls{})
+
+	viewer, err := registry.Join("s", Actor{Username: "admin"})
+	require.NoError(t, err)
+
+	for i := 0; i <= viewerBuffer; i++ {
+		session.Output([]byte("x"))
+	}
+
+	events := 0
+	for range viewer.Events() {
+		events++
+	}
+	assert.Equal(t, viewerBuffer, events)
+	assert.Equal(t, "viewer is too slow", viewer.Reason())
+	assert.Empty(t, session.Info().Viewers)
+
+	// Leaving after being disconnected has no effect
+	viewer.Leave()
+}
+
+func TestRegistry_TerminateIsAudited(t *testing.T) {
+	db, registry := setupRegistryTestDB(t)
+	owner := &ownerConn{}
+	ownerID := uuid.New()
+	adminID := uuid.New()
+
+	session := registry.Register(SessionInfo{
+		ID:       "exec-1",
+		Kind:     KindDocker,
+		UserID:   ownerID,
+		Username: "alice",
+		Target:   "nginx",
+	}, owner.controls())
+
+	viewer, err := registry.Join("exec-1", Actor{UserID: adminID, Username: "admin", ClientIP: "10.0.0.9"})
+	require.NoError(t, err)
+
+	require.NoError(t, registry.Terminate("exec-1", Actor{UserID: adminID, Username: "admin", ClientIP: "10.0.0.9"}, "dropping tables"))
+	assert.Equal(t, "dropping tables", owner.terminated)
+	assert.Contains(t, owner.notices[len(owner.notices)-1], "session terminated by admin: dropping tables")
+
+	_, open := <-viewer.Events()
+	assert.False(t, open)
+	assert.Equal(t, "terminated by admin: dropping tables", viewer.Reason())
+
+	// The session stays listed until its connection unregisters it
+	_, err = registry.Join("exec-1", Actor{Username: "admin"})
+	assert.ErrorIs(t, err, ErrSessionClosed)
+	registry.Unregister(session)
+	assert.ErrorIs(t, registry.Terminate("exec-1", Actor{Username: "admin"}, "again"), ErrSessionNotFound)
+
+	require.NoError(t, registry.Shutdown(5*time.Second))
+
+	var events []models.AuditEvent
+	require.NoError(t, db.Order("action ASC").Find(&events).Error)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, models.ActionTerminalJoined, events[0].Action)
+	assert.Equal(t, models.ActionTerminalTerminated, events[1].Action)
+	for _, event := range events {
+		assert.Equal(t, models.ResourceTypeTerminalSession, event.ResourceType)
+		assert.Equal(t, models.SubsystemDocker, event.Subsystem)
+		assert.Equal(t, "exec-1", event.Resource.Identifier)
+		assert.Equal(t, ownerID.String(), event.Resource.Data["owner_id"])
+		assert.Equal(t, adminID.String(), event.User.UID)
+		assert.Equal(t, "10.0.0.9", event.ClientIP)
+	}
+	assert.Equal(t, "dropping tables", events[1].Data["reason"])
+}
@@ -26,4 +26,5 @@ const (
 	ErrCodeHostNotFound       = "HOST_NOT_FOUND"
 	ErrCodeAgentOffline       = "AGENT_OFFLINE"
 	ErrCodeInternalError      = "INTERNAL_ERROR"
+	ErrCodeSessionTerminated  = "SESSION_TERMINATED"
 )
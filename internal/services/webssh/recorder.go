@@ -24,7 +24,7 @@ type AsciinemaHeader struct {
 // AsciinemaEvent represents a single event in the recording
 type AsciinemaEvent struct {
 	Time      float64 `json:"time"`      // Time offset from start in seconds
-	EventType string  `json:"eventType"` // "o" for output, "i" for input
+	EventType string  `json:"eventType"` // "o" for output, "i" for input, "r" for resize
 	Data      string  `json:"data"`      // The actual data
 }
 
@@ -113,6 +113,11 @@ func (r *SessionRecorder) recordEvent(eventType string, data []byte) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	return r.writeEvent(eventType, data)
+}
+
+// writeEvent writes an event with the lock held
+func (r *SessionRecorder) writeEvent(eventType string, data []byte) error {
 	if r.closed {
 		return fmt.Errorf("recorder is closed")
 	}
@@ -151,7 +156,7 @@ func (r *SessionRecorder) recordEvent(eventType string, data []byte) error {
 	return nil
 }
 
-// Resize updates the terminal size (not part of asciicast v2, but useful for logging)
+// Resize updates the terminal size and records an asciicast v2 resize event
 func (r *SessionRecorder) Resize(cols, rows int) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -159,7 +164,11 @@ func (r *SessionRecorder) Resize(cols, rows int) {
 	r.cols = cols
 	r.rows = rows
 
-	// In asciicast v2, resize events are not standard, but we can log them
+	if !r.closed {
+		if err := r.writeEvent("r", []byte(fmt.Sprintf("%dx%d", cols, rows))); err != nil {
+			logrus.Debugf("Failed to record resize of session %s: %v", r.sessionID, err)
+		}
+	}
 	logrus.Debugf("Session %s resized to %dx%d", r.sessionID, cols, rows)
 }
 
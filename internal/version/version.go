@@ -14,6 +14,10 @@ var (
 	// CommitID is the 7-character short git commit hash
 	// Example: "a1b2c3d"
 	CommitID = "0000000"
+
+	// AgentUpdatePublicKey is the base64 Ed25519 public key the agent uses to
+	// verify self-update binaries. Empty means no built-in key.
+	AgentUpdatePublicKey = ""
 )
 
 // Info represents version information
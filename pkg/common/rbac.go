@@ -19,6 +19,7 @@ type Role struct {
 	Resources   []string `yaml:"resources" json:"resources"`
 	Namespaces  []string `yaml:"namespaces" json:"namespaces"`
 	Verbs       []string `yaml:"verbs" json:"verbs"`
+	HostGroups  []string `yaml:"hostGroups,omitempty" json:"hostGroups,omitempty"`
 }
 
 type RoleMapping struct {
@@ -2202,29 +2202,28 @@ func (x *DeleteContainerResponse) GetMessage() string {
 	return ""
 }
 
-// Container stats (streaming)
-type GetContainerStatsRequest struct {
+type PruneContainersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Stream        bool                   `protobuf:"varint,2,opt,name=stream,proto3" json:"stream,omitempty"` // Continuous stream or one-shot
+	OlderThanDays int32                  `protobuf:"varint,1,opt,name=older_than_days,json=olderThanDays,proto3" json:"older_than_days,omitempty"` // Only stopped containers created more than N days ago (0 = any age)
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Report what would be removed without removing it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContainerStatsRequest) Reset() {
-	*x = GetContainerStatsRequest{}
+func (x *PruneContainersRequest) Reset() {
+	*x = PruneContainersRequest{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerStatsRequest) String() string {
+func (x *PruneContainersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerStatsRequest) ProtoMessage() {}
+func (*PruneContainersRequest) ProtoMessage() {}
 
-func (x *GetContainerStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *PruneContainersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2236,59 +2235,48 @@ func (x *GetContainerStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetContainerStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PruneContainersRequest.ProtoReflect.Descriptor instead.
+func (*PruneContainersRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetContainerStatsRequest) GetContainerId() string {
+func (x *PruneContainersRequest) GetOlderThanDays() int32 {
 	if x != nil {
-		return x.ContainerId
+		return x.OlderThanDays
 	}
-	return ""
+	return 0
 }
 
-func (x *GetContainerStatsRequest) GetStream() bool {
+func (x *PruneContainersRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Stream
+		return x.DryRun
 	}
 	return false
 }
 
-type ContainerStats struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Timestamp   int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// CPU stats
-	CpuStats    *CPUStats `protobuf:"bytes,4,opt,name=cpu_stats,json=cpuStats,proto3" json:"cpu_stats,omitempty"`
-	PrecpuStats *CPUStats `protobuf:"bytes,5,opt,name=precpu_stats,json=precpuStats,proto3" json:"precpu_stats,omitempty"`
-	// Memory stats
-	MemoryStats *MemoryStats `protobuf:"bytes,6,opt,name=memory_stats,json=memoryStats,proto3" json:"memory_stats,omitempty"`
-	// Block I/O stats
-	BlkioStats *BlkioStats `protobuf:"bytes,7,opt,name=blkio_stats,json=blkioStats,proto3" json:"blkio_stats,omitempty"`
-	// Network stats
-	Networks map[string]*NetworkStats `protobuf:"bytes,8,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// PIDs
-	PidsStats     *PidsStats `protobuf:"bytes,9,opt,name=pids_stats,json=pidsStats,proto3" json:"pids_stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PruneContainersResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ContainersDeleted []string               `protobuf:"bytes,1,rep,name=containers_deleted,json=containersDeleted,proto3" json:"containers_deleted,omitempty"`
+	SpaceReclaimed    uint64                 `protobuf:"varint,2,opt,name=space_reclaimed,json=spaceReclaimed,proto3" json:"space_reclaimed,omitempty"` // Space reclaimed (or reclaimable on dry run) in bytes
+	DryRun            bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ContainerStats) Reset() {
-	*x = ContainerStats{}
+func (x *PruneContainersResponse) Reset() {
+	*x = PruneContainersResponse{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerStats) String() string {
+func (x *PruneContainersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStats) ProtoMessage() {}
+func (*PruneContainersResponse) ProtoMessage() {}
 
-func (x *ContainerStats) ProtoReflect() protoreflect.Message {
+func (x *PruneContainersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2300,103 +2288,60 @@ func (x *ContainerStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStats.ProtoReflect.Descriptor instead.
-func (*ContainerStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use PruneContainersResponse.ProtoReflect.Descriptor instead.
+func (*PruneContainersResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ContainerStats) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *ContainerStats) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ContainerStats) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *ContainerStats) GetCpuStats() *CPUStats {
-	if x != nil {
-		return x.CpuStats
-	}
-	return nil
-}
-
-func (x *ContainerStats) GetPrecpuStats() *CPUStats {
-	if x != nil {
-		return x.PrecpuStats
-	}
-	return nil
-}
-
-func (x *ContainerStats) GetMemoryStats() *MemoryStats {
-	if x != nil {
-		return x.MemoryStats
-	}
-	return nil
-}
-
-func (x *ContainerStats) GetBlkioStats() *BlkioStats {
+func (x *PruneContainersResponse) GetContainersDeleted() []string {
 	if x != nil {
-		return x.BlkioStats
+		return x.ContainersDeleted
 	}
 	return nil
 }
 
-func (x *ContainerStats) GetNetworks() map[string]*NetworkStats {
+func (x *PruneContainersResponse) GetSpaceReclaimed() uint64 {
 	if x != nil {
-		return x.Networks
+		return x.SpaceReclaimed
 	}
-	return nil
+	return 0
 }
 
-func (x *ContainerStats) GetPidsStats() *PidsStats {
+func (x *PruneContainersResponse) GetDryRun() bool {
 	if x != nil {
-		return x.PidsStats
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-type CPUStats struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	CpuUsageTotal        uint64                 `protobuf:"varint,1,opt,name=cpu_usage_total,json=cpuUsageTotal,proto3" json:"cpu_usage_total,omitempty"`
-	CpuUsagePercpu       []uint64               `protobuf:"varint,2,rep,packed,name=cpu_usage_percpu,json=cpuUsagePercpu,proto3" json:"cpu_usage_percpu,omitempty"`
-	CpuUsageInKernelmode uint64                 `protobuf:"varint,3,opt,name=cpu_usage_in_kernelmode,json=cpuUsageInKernelmode,proto3" json:"cpu_usage_in_kernelmode,omitempty"`
-	CpuUsageInUsermode   uint64                 `protobuf:"varint,4,opt,name=cpu_usage_in_usermode,json=cpuUsageInUsermode,proto3" json:"cpu_usage_in_usermode,omitempty"`
-	SystemCpuUsage       uint64                 `protobuf:"varint,5,opt,name=system_cpu_usage,json=systemCpuUsage,proto3" json:"system_cpu_usage,omitempty"`
-	OnlineCpus           uint64                 `protobuf:"varint,6,opt,name=online_cpus,json=onlineCpus,proto3" json:"online_cpus,omitempty"`
-	ThrottlingPeriods    uint64                 `protobuf:"varint,7,opt,name=throttling_periods,json=throttlingPeriods,proto3" json:"throttling_periods,omitempty"`
-	ThrottledPeriods     uint64                 `protobuf:"varint,8,opt,name=throttled_periods,json=throttledPeriods,proto3" json:"throttled_periods,omitempty"`
-	ThrottledTime        uint64                 `protobuf:"varint,9,opt,name=throttled_time,json=throttledTime,proto3" json:"throttled_time,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// Recreate a container with the same settings and a new image or limits.
+// The old container is kept, renamed, until the new one is healthy and is
+// restored if the new one fails.
+type RecreateContainerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                                       // New image (must exist locally), empty to keep the current one
+	Resources     *ContainerResources    `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`                               // New resource limits, unset fields keep the current value
+	StopTimeout   int32                  `protobuf:"varint,4,opt,name=stop_timeout,json=stopTimeout,proto3" json:"stop_timeout,omitempty"`       // Seconds to wait for the old container to stop (default: 10)
+	HealthTimeout int32                  `protobuf:"varint,5,opt,name=health_timeout,json=healthTimeout,proto3" json:"health_timeout,omitempty"` // Seconds to wait for the new container to become healthy (default: 60)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CPUStats) Reset() {
-	*x = CPUStats{}
+func (x *RecreateContainerRequest) Reset() {
+	*x = RecreateContainerRequest{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CPUStats) String() string {
+func (x *RecreateContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CPUStats) ProtoMessage() {}
+func (*RecreateContainerRequest) ProtoMessage() {}
 
-func (x *CPUStats) ProtoReflect() protoreflect.Message {
+func (x *RecreateContainerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2408,100 +2353,70 @@ func (x *CPUStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CPUStats.ProtoReflect.Descriptor instead.
-func (*CPUStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecreateContainerRequest.ProtoReflect.Descriptor instead.
+func (*RecreateContainerRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CPUStats) GetCpuUsageTotal() uint64 {
-	if x != nil {
-		return x.CpuUsageTotal
-	}
-	return 0
-}
-
-func (x *CPUStats) GetCpuUsagePercpu() []uint64 {
-	if x != nil {
-		return x.CpuUsagePercpu
-	}
-	return nil
-}
-
-func (x *CPUStats) GetCpuUsageInKernelmode() uint64 {
-	if x != nil {
-		return x.CpuUsageInKernelmode
-	}
-	return 0
-}
-
-func (x *CPUStats) GetCpuUsageInUsermode() uint64 {
-	if x != nil {
-		return x.CpuUsageInUsermode
-	}
-	return 0
-}
-
-func (x *CPUStats) GetSystemCpuUsage() uint64 {
+func (x *RecreateContainerRequest) GetContainerId() string {
 	if x != nil {
-		return x.SystemCpuUsage
+		return x.ContainerId
 	}
-	return 0
+	return ""
 }
 
-func (x *CPUStats) GetOnlineCpus() uint64 {
+func (x *RecreateContainerRequest) GetImage() string {
 	if x != nil {
-		return x.OnlineCpus
+		return x.Image
 	}
-	return 0
+	return ""
 }
 
-func (x *CPUStats) GetThrottlingPeriods() uint64 {
+func (x *RecreateContainerRequest) GetResources() *ContainerResources {
 	if x != nil {
-		return x.ThrottlingPeriods
+		return x.Resources
 	}
-	return 0
+	return nil
 }
 
-func (x *CPUStats) GetThrottledPeriods() uint64 {
+func (x *RecreateContainerRequest) GetStopTimeout() int32 {
 	if x != nil {
-		return x.ThrottledPeriods
+		return x.StopTimeout
 	}
 	return 0
 }
 
-func (x *CPUStats) GetThrottledTime() uint64 {
+func (x *RecreateContainerRequest) GetHealthTimeout() int32 {
 	if x != nil {
-		return x.ThrottledTime
+		return x.HealthTimeout
 	}
 	return 0
 }
 
-type MemoryStats struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Usage             uint64                 `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
-	MaxUsage          uint64                 `protobuf:"varint,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
-	Limit             uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Commit            uint64                 `protobuf:"varint,4,opt,name=commit,proto3" json:"commit,omitempty"`
-	CommitPeak        uint64                 `protobuf:"varint,5,opt,name=commit_peak,json=commitPeak,proto3" json:"commit_peak,omitempty"`
-	Privateworkingset uint64                 `protobuf:"varint,6,opt,name=privateworkingset,proto3" json:"privateworkingset,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ContainerResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        int64                  `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`                           // Memory limit in bytes (0 = keep, -1 = unlimited)
+	MemorySwap    int64                  `protobuf:"varint,2,opt,name=memory_swap,json=memorySwap,proto3" json:"memory_swap,omitempty"` // Memory + swap limit in bytes (0 = keep, -1 = unlimited swap)
+	NanoCpus      int64                  `protobuf:"varint,3,opt,name=nano_cpus,json=nanoCpus,proto3" json:"nano_cpus,omitempty"`       // CPU quota in 1e-9 CPUs (0 = keep, -1 = unlimited)
+	CpuShares     int64                  `protobuf:"varint,4,opt,name=cpu_shares,json=cpuShares,proto3" json:"cpu_shares,omitempty"`    // Relative CPU weight (0 = keep, -1 = default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoryStats) Reset() {
-	*x = MemoryStats{}
+func (x *ContainerResources) Reset() {
+	*x = ContainerResources{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoryStats) String() string {
+func (x *ContainerResources) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoryStats) ProtoMessage() {}
+func (*ContainerResources) ProtoMessage() {}
 
-func (x *MemoryStats) ProtoReflect() protoreflect.Message {
+func (x *ContainerResources) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2513,75 +2428,66 @@ func (x *MemoryStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoryStats.ProtoReflect.Descriptor instead.
-func (*MemoryStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerResources.ProtoReflect.Descriptor instead.
+func (*ContainerResources) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MemoryStats) GetUsage() uint64 {
-	if x != nil {
-		return x.Usage
-	}
-	return 0
-}
-
-func (x *MemoryStats) GetMaxUsage() uint64 {
-	if x != nil {
-		return x.MaxUsage
-	}
-	return 0
-}
-
-func (x *MemoryStats) GetLimit() uint64 {
+func (x *ContainerResources) GetMemory() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Memory
 	}
 	return 0
 }
 
-func (x *MemoryStats) GetCommit() uint64 {
+func (x *ContainerResources) GetMemorySwap() int64 {
 	if x != nil {
-		return x.Commit
+		return x.MemorySwap
 	}
 	return 0
 }
 
-func (x *MemoryStats) GetCommitPeak() uint64 {
+func (x *ContainerResources) GetNanoCpus() int64 {
 	if x != nil {
-		return x.CommitPeak
+		return x.NanoCpus
 	}
 	return 0
 }
 
-func (x *MemoryStats) GetPrivateworkingset() uint64 {
+func (x *ContainerResources) GetCpuShares() int64 {
 	if x != nil {
-		return x.Privateworkingset
+		return x.CpuShares
 	}
 	return 0
 }
 
-type BlkioStats struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	IoServiceBytesRecursive []*BlkioStatEntry      `protobuf:"bytes,1,rep,name=io_service_bytes_recursive,json=ioServiceBytesRecursive,proto3" json:"io_service_bytes_recursive,omitempty"`
-	IoServicedRecursive     []*BlkioStatEntry      `protobuf:"bytes,2,rep,name=io_serviced_recursive,json=ioServicedRecursive,proto3" json:"io_serviced_recursive,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type RecreateContainerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ContainerId    string                 `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // New container, or the restored old one after a rollback
+	OldContainerId string                 `protobuf:"bytes,4,opt,name=old_container_id,json=oldContainerId,proto3" json:"old_container_id,omitempty"`
+	OldImage       string                 `protobuf:"bytes,5,opt,name=old_image,json=oldImage,proto3" json:"old_image,omitempty"`
+	Image          string                 `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	RolledBack     bool                   `protobuf:"varint,7,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *BlkioStats) Reset() {
-	*x = BlkioStats{}
+func (x *RecreateContainerResponse) Reset() {
+	*x = RecreateContainerResponse{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlkioStats) String() string {
+func (x *RecreateContainerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlkioStats) ProtoMessage() {}
+func (*RecreateContainerResponse) ProtoMessage() {}
 
-func (x *BlkioStats) ProtoReflect() protoreflect.Message {
+func (x *RecreateContainerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2593,49 +2499,83 @@ func (x *BlkioStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlkioStats.ProtoReflect.Descriptor instead.
-func (*BlkioStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecreateContainerResponse.ProtoReflect.Descriptor instead.
+func (*RecreateContainerResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatEntry {
+func (x *RecreateContainerResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IoServiceBytesRecursive
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *BlkioStats) GetIoServicedRecursive() []*BlkioStatEntry {
+func (x *RecreateContainerResponse) GetMessage() string {
 	if x != nil {
-		return x.IoServicedRecursive
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type BlkioStatEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Major         uint64                 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
-	Minor         uint64                 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
-	Op            string                 `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
-	Value         uint64                 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RecreateContainerResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
 }
 
-func (x *BlkioStatEntry) Reset() {
-	*x = BlkioStatEntry{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+func (x *RecreateContainerResponse) GetOldContainerId() string {
+	if x != nil {
+		return x.OldContainerId
+	}
+	return ""
+}
 
-func (x *BlkioStatEntry) String() string {
+func (x *RecreateContainerResponse) GetOldImage() string {
+	if x != nil {
+		return x.OldImage
+	}
+	return ""
+}
+
+func (x *RecreateContainerResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *RecreateContainerResponse) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+// Container stats (streaming)
+type GetContainerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Stream        bool                   `protobuf:"varint,2,opt,name=stream,proto3" json:"stream,omitempty"` // Continuous stream or one-shot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContainerStatsRequest) Reset() {
+	*x = GetContainerStatsRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContainerStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlkioStatEntry) ProtoMessage() {}
+func (*GetContainerStatsRequest) ProtoMessage() {}
 
-func (x *BlkioStatEntry) ProtoReflect() protoreflect.Message {
+func (x *GetContainerStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2647,67 +2587,59 @@ func (x *BlkioStatEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlkioStatEntry.ProtoReflect.Descriptor instead.
-func (*BlkioStatEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContainerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetContainerStatsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *BlkioStatEntry) GetMajor() uint64 {
-	if x != nil {
-		return x.Major
-	}
-	return 0
-}
-
-func (x *BlkioStatEntry) GetMinor() uint64 {
-	if x != nil {
-		return x.Minor
-	}
-	return 0
-}
-
-func (x *BlkioStatEntry) GetOp() string {
+func (x *GetContainerStatsRequest) GetContainerId() string {
 	if x != nil {
-		return x.Op
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *BlkioStatEntry) GetValue() uint64 {
+func (x *GetContainerStatsRequest) GetStream() bool {
 	if x != nil {
-		return x.Value
+		return x.Stream
 	}
-	return 0
+	return false
 }
 
-type NetworkStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RxBytes       uint64                 `protobuf:"varint,1,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
-	RxPackets     uint64                 `protobuf:"varint,2,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
-	RxErrors      uint64                 `protobuf:"varint,3,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
-	RxDropped     uint64                 `protobuf:"varint,4,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
-	TxBytes       uint64                 `protobuf:"varint,5,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
-	TxPackets     uint64                 `protobuf:"varint,6,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
-	TxErrors      uint64                 `protobuf:"varint,7,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
-	TxDropped     uint64                 `protobuf:"varint,8,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
+type ContainerStats struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Timestamp   int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// CPU stats
+	CpuStats    *CPUStats `protobuf:"bytes,4,opt,name=cpu_stats,json=cpuStats,proto3" json:"cpu_stats,omitempty"`
+	PrecpuStats *CPUStats `protobuf:"bytes,5,opt,name=precpu_stats,json=precpuStats,proto3" json:"precpu_stats,omitempty"`
+	// Memory stats
+	MemoryStats *MemoryStats `protobuf:"bytes,6,opt,name=memory_stats,json=memoryStats,proto3" json:"memory_stats,omitempty"`
+	// Block I/O stats
+	BlkioStats *BlkioStats `protobuf:"bytes,7,opt,name=blkio_stats,json=blkioStats,proto3" json:"blkio_stats,omitempty"`
+	// Network stats
+	Networks map[string]*NetworkStats `protobuf:"bytes,8,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// PIDs
+	PidsStats     *PidsStats `protobuf:"bytes,9,opt,name=pids_stats,json=pidsStats,proto3" json:"pids_stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkStats) Reset() {
-	*x = NetworkStats{}
+func (x *ContainerStats) Reset() {
+	*x = ContainerStats{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkStats) String() string {
+func (x *ContainerStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkStats) ProtoMessage() {}
+func (*ContainerStats) ProtoMessage() {}
 
-func (x *NetworkStats) ProtoReflect() protoreflect.Message {
+func (x *ContainerStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2719,89 +2651,103 @@ func (x *NetworkStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkStats.ProtoReflect.Descriptor instead.
-func (*NetworkStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerStats.ProtoReflect.Descriptor instead.
+func (*ContainerStats) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *NetworkStats) GetRxBytes() uint64 {
+func (x *ContainerStats) GetContainerId() string {
 	if x != nil {
-		return x.RxBytes
+		return x.ContainerId
 	}
-	return 0
+	return ""
 }
 
-func (x *NetworkStats) GetRxPackets() uint64 {
+func (x *ContainerStats) GetName() string {
 	if x != nil {
-		return x.RxPackets
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *NetworkStats) GetRxErrors() uint64 {
+func (x *ContainerStats) GetTimestamp() int64 {
 	if x != nil {
-		return x.RxErrors
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *NetworkStats) GetRxDropped() uint64 {
+func (x *ContainerStats) GetCpuStats() *CPUStats {
 	if x != nil {
-		return x.RxDropped
+		return x.CpuStats
 	}
-	return 0
+	return nil
 }
 
-func (x *NetworkStats) GetTxBytes() uint64 {
+func (x *ContainerStats) GetPrecpuStats() *CPUStats {
 	if x != nil {
-		return x.TxBytes
+		return x.PrecpuStats
 	}
-	return 0
+	return nil
 }
 
-func (x *NetworkStats) GetTxPackets() uint64 {
+func (x *ContainerStats) GetMemoryStats() *MemoryStats {
 	if x != nil {
-		return x.TxPackets
+		return x.MemoryStats
 	}
-	return 0
+	return nil
 }
 
-func (x *NetworkStats) GetTxErrors() uint64 {
+func (x *ContainerStats) GetBlkioStats() *BlkioStats {
 	if x != nil {
-		return x.TxErrors
+		return x.BlkioStats
 	}
-	return 0
+	return nil
 }
 
-func (x *NetworkStats) GetTxDropped() uint64 {
+func (x *ContainerStats) GetNetworks() map[string]*NetworkStats {
 	if x != nil {
-		return x.TxDropped
+		return x.Networks
 	}
-	return 0
+	return nil
 }
 
-type PidsStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Current       uint64                 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
-	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ContainerStats) GetPidsStats() *PidsStats {
+	if x != nil {
+		return x.PidsStats
+	}
+	return nil
 }
 
-func (x *PidsStats) Reset() {
-	*x = PidsStats{}
+type CPUStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CpuUsageTotal        uint64                 `protobuf:"varint,1,opt,name=cpu_usage_total,json=cpuUsageTotal,proto3" json:"cpu_usage_total,omitempty"`
+	CpuUsagePercpu       []uint64               `protobuf:"varint,2,rep,packed,name=cpu_usage_percpu,json=cpuUsagePercpu,proto3" json:"cpu_usage_percpu,omitempty"`
+	CpuUsageInKernelmode uint64                 `protobuf:"varint,3,opt,name=cpu_usage_in_kernelmode,json=cpuUsageInKernelmode,proto3" json:"cpu_usage_in_kernelmode,omitempty"`
+	CpuUsageInUsermode   uint64                 `protobuf:"varint,4,opt,name=cpu_usage_in_usermode,json=cpuUsageInUsermode,proto3" json:"cpu_usage_in_usermode,omitempty"`
+	SystemCpuUsage       uint64                 `protobuf:"varint,5,opt,name=system_cpu_usage,json=systemCpuUsage,proto3" json:"system_cpu_usage,omitempty"`
+	OnlineCpus           uint64                 `protobuf:"varint,6,opt,name=online_cpus,json=onlineCpus,proto3" json:"online_cpus,omitempty"`
+	ThrottlingPeriods    uint64                 `protobuf:"varint,7,opt,name=throttling_periods,json=throttlingPeriods,proto3" json:"throttling_periods,omitempty"`
+	ThrottledPeriods     uint64                 `protobuf:"varint,8,opt,name=throttled_periods,json=throttledPeriods,proto3" json:"throttled_periods,omitempty"`
+	ThrottledTime        uint64                 `protobuf:"varint,9,opt,name=throttled_time,json=throttledTime,proto3" json:"throttled_time,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CPUStats) Reset() {
+	*x = CPUStats{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PidsStats) String() string {
+func (x *CPUStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PidsStats) ProtoMessage() {}
+func (*CPUStats) ProtoMessage() {}
 
-func (x *PidsStats) ProtoReflect() protoreflect.Message {
+func (x *CPUStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2813,149 +2759,180 @@ func (x *PidsStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PidsStats.ProtoReflect.Descriptor instead.
-func (*PidsStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use CPUStats.ProtoReflect.Descriptor instead.
+func (*CPUStats) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *PidsStats) GetCurrent() uint64 {
+func (x *CPUStats) GetCpuUsageTotal() uint64 {
 	if x != nil {
-		return x.Current
+		return x.CpuUsageTotal
 	}
 	return 0
 }
 
-func (x *PidsStats) GetLimit() uint64 {
+func (x *CPUStats) GetCpuUsagePercpu() []uint64 {
 	if x != nil {
-		return x.Limit
+		return x.CpuUsagePercpu
 	}
-	return 0
+	return nil
 }
 
-// Container logs (streaming)
-type GetContainerLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Follow        bool                   `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`         // Stream logs
-	Stdout        bool                   `protobuf:"varint,3,opt,name=stdout,proto3" json:"stdout,omitempty"`         // Include stdout (default: true)
-	Stderr        bool                   `protobuf:"varint,4,opt,name=stderr,proto3" json:"stderr,omitempty"`         // Include stderr (default: true)
-	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`           // Unix timestamp
-	Until         int64                  `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`           // Unix timestamp
-	Timestamps    bool                   `protobuf:"varint,7,opt,name=timestamps,proto3" json:"timestamps,omitempty"` // Include timestamps
-	Tail          string                 `protobuf:"bytes,8,opt,name=tail,proto3" json:"tail,omitempty"`              // Number of lines from end ("all" or "100")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CPUStats) GetCpuUsageInKernelmode() uint64 {
+	if x != nil {
+		return x.CpuUsageInKernelmode
+	}
+	return 0
 }
 
-func (x *GetContainerLogsRequest) Reset() {
-	*x = GetContainerLogsRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CPUStats) GetCpuUsageInUsermode() uint64 {
+	if x != nil {
+		return x.CpuUsageInUsermode
+	}
+	return 0
 }
 
-func (x *GetContainerLogsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CPUStats) GetSystemCpuUsage() uint64 {
+	if x != nil {
+		return x.SystemCpuUsage
+	}
+	return 0
 }
 
-func (*GetContainerLogsRequest) ProtoMessage() {}
-
-func (x *GetContainerLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[36]
+func (x *CPUStats) GetOnlineCpus() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OnlineCpus
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetContainerLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetContainerLogsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{36}
+func (x *CPUStats) GetThrottlingPeriods() uint64 {
+	if x != nil {
+		return x.ThrottlingPeriods
+	}
+	return 0
 }
 
-func (x *GetContainerLogsRequest) GetContainerId() string {
+func (x *CPUStats) GetThrottledPeriods() uint64 {
 	if x != nil {
-		return x.ContainerId
+		return x.ThrottledPeriods
 	}
-	return ""
+	return 0
 }
 
-func (x *GetContainerLogsRequest) GetFollow() bool {
+func (x *CPUStats) GetThrottledTime() uint64 {
 	if x != nil {
-		return x.Follow
+		return x.ThrottledTime
 	}
-	return false
+	return 0
 }
 
-func (x *GetContainerLogsRequest) GetStdout() bool {
+type MemoryStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Usage             uint64                 `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	MaxUsage          uint64                 `protobuf:"varint,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
+	Limit             uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Commit            uint64                 `protobuf:"varint,4,opt,name=commit,proto3" json:"commit,omitempty"`
+	CommitPeak        uint64                 `protobuf:"varint,5,opt,name=commit_peak,json=commitPeak,proto3" json:"commit_peak,omitempty"`
+	Privateworkingset uint64                 `protobuf:"varint,6,opt,name=privateworkingset,proto3" json:"privateworkingset,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MemoryStats) Reset() {
+	*x = MemoryStats{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStats) ProtoMessage() {}
+
+func (x *MemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[36]
 	if x != nil {
-		return x.Stdout
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetContainerLogsRequest) GetStderr() bool {
+// Deprecated: Use MemoryStats.ProtoReflect.Descriptor instead.
+func (*MemoryStats) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MemoryStats) GetUsage() uint64 {
 	if x != nil {
-		return x.Stderr
+		return x.Usage
 	}
-	return false
+	return 0
 }
 
-func (x *GetContainerLogsRequest) GetSince() int64 {
+func (x *MemoryStats) GetMaxUsage() uint64 {
 	if x != nil {
-		return x.Since
+		return x.MaxUsage
 	}
 	return 0
 }
 
-func (x *GetContainerLogsRequest) GetUntil() int64 {
+func (x *MemoryStats) GetLimit() uint64 {
 	if x != nil {
-		return x.Until
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetContainerLogsRequest) GetTimestamps() bool {
+func (x *MemoryStats) GetCommit() uint64 {
 	if x != nil {
-		return x.Timestamps
+		return x.Commit
 	}
-	return false
+	return 0
 }
 
-func (x *GetContainerLogsRequest) GetTail() string {
+func (x *MemoryStats) GetCommitPeak() uint64 {
 	if x != nil {
-		return x.Tail
+		return x.CommitPeak
 	}
-	return ""
+	return 0
 }
 
-type LogEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"` // stdout/stderr
-	Log           string                 `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`       // Log line
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MemoryStats) GetPrivateworkingset() uint64 {
+	if x != nil {
+		return x.Privateworkingset
+	}
+	return 0
 }
 
-func (x *LogEntry) Reset() {
-	*x = LogEntry{}
+type BlkioStats struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	IoServiceBytesRecursive []*BlkioStatEntry      `protobuf:"bytes,1,rep,name=io_service_bytes_recursive,json=ioServiceBytesRecursive,proto3" json:"io_service_bytes_recursive,omitempty"`
+	IoServicedRecursive     []*BlkioStatEntry      `protobuf:"bytes,2,rep,name=io_serviced_recursive,json=ioServicedRecursive,proto3" json:"io_serviced_recursive,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *BlkioStats) Reset() {
+	*x = BlkioStats{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogEntry) String() string {
+func (x *BlkioStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogEntry) ProtoMessage() {}
+func (*BlkioStats) ProtoMessage() {}
 
-func (x *LogEntry) ProtoReflect() protoreflect.Message {
+func (x *BlkioStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2967,59 +2944,49 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
-func (*LogEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlkioStats.ProtoReflect.Descriptor instead.
+func (*BlkioStats) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *LogEntry) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *LogEntry) GetStream() string {
+func (x *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatEntry {
 	if x != nil {
-		return x.Stream
+		return x.IoServiceBytesRecursive
 	}
-	return ""
+	return nil
 }
 
-func (x *LogEntry) GetLog() string {
+func (x *BlkioStats) GetIoServicedRecursive() []*BlkioStatEntry {
 	if x != nil {
-		return x.Log
+		return x.IoServicedRecursive
 	}
-	return ""
+	return nil
 }
 
-// Container exec (bidirectional streaming)
-type ExecRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Request:
-	//
-	//	*ExecRequest_Start
-	//	*ExecRequest_Input
-	//	*ExecRequest_Resize
-	Request       isExecRequest_Request `protobuf_oneof:"request"`
+type BlkioStatEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Major         uint64                 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor         uint64                 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Op            string                 `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	Value         uint64                 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecRequest) Reset() {
-	*x = ExecRequest{}
+func (x *BlkioStatEntry) Reset() {
+	*x = BlkioStatEntry{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecRequest) String() string {
+func (x *BlkioStatEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRequest) ProtoMessage() {}
+func (*BlkioStatEntry) ProtoMessage() {}
 
-func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+func (x *BlkioStatEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3031,94 +2998,67 @@ func (x *ExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
-func (*ExecRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlkioStatEntry.ProtoReflect.Descriptor instead.
+func (*BlkioStatEntry) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ExecRequest) GetRequest() isExecRequest_Request {
+func (x *BlkioStatEntry) GetMajor() uint64 {
 	if x != nil {
-		return x.Request
+		return x.Major
 	}
-	return nil
+	return 0
 }
 
-func (x *ExecRequest) GetStart() *ExecStart {
+func (x *BlkioStatEntry) GetMinor() uint64 {
 	if x != nil {
-		if x, ok := x.Request.(*ExecRequest_Start); ok {
-			return x.Start
-		}
+		return x.Minor
 	}
-	return nil
+	return 0
 }
 
-func (x *ExecRequest) GetInput() *ExecInput {
+func (x *BlkioStatEntry) GetOp() string {
 	if x != nil {
-		if x, ok := x.Request.(*ExecRequest_Input); ok {
-			return x.Input
-		}
+		return x.Op
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecRequest) GetResize() *ExecResize {
+func (x *BlkioStatEntry) GetValue() uint64 {
 	if x != nil {
-		if x, ok := x.Request.(*ExecRequest_Resize); ok {
-			return x.Resize
-		}
+		return x.Value
 	}
-	return nil
-}
-
-type isExecRequest_Request interface {
-	isExecRequest_Request()
-}
-
-type ExecRequest_Start struct {
-	Start *ExecStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
-}
-
-type ExecRequest_Input struct {
-	Input *ExecInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
-}
-
-type ExecRequest_Resize struct {
-	Resize *ExecResize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+	return 0
 }
 
-func (*ExecRequest_Start) isExecRequest_Request() {}
-
-func (*ExecRequest_Input) isExecRequest_Request() {}
-
-func (*ExecRequest_Resize) isExecRequest_Request() {}
-
-type ExecStart struct {
+type NetworkStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Cmd           []string               `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`  // Command to execute (e.g., ["/bin/sh"])
-	Tty           bool                   `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"` // Allocate TTY
-	AttachStdin   bool                   `protobuf:"varint,4,opt,name=attach_stdin,json=attachStdin,proto3" json:"attach_stdin,omitempty"`
-	AttachStdout  bool                   `protobuf:"varint,5,opt,name=attach_stdout,json=attachStdout,proto3" json:"attach_stdout,omitempty"`
-	AttachStderr  bool                   `protobuf:"varint,6,opt,name=attach_stderr,json=attachStderr,proto3" json:"attach_stderr,omitempty"`
-	Env           map[string]string      `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Environment variables
+	RxBytes       uint64                 `protobuf:"varint,1,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	RxPackets     uint64                 `protobuf:"varint,2,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	RxErrors      uint64                 `protobuf:"varint,3,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	RxDropped     uint64                 `protobuf:"varint,4,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+	TxBytes       uint64                 `protobuf:"varint,5,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	TxPackets     uint64                 `protobuf:"varint,6,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	TxErrors      uint64                 `protobuf:"varint,7,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	TxDropped     uint64                 `protobuf:"varint,8,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecStart) Reset() {
-	*x = ExecStart{}
+func (x *NetworkStats) Reset() {
+	*x = NetworkStats{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecStart) String() string {
+func (x *NetworkStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecStart) ProtoMessage() {}
+func (*NetworkStats) ProtoMessage() {}
 
-func (x *ExecStart) ProtoReflect() protoreflect.Message {
+func (x *NetworkStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3130,81 +3070,89 @@ func (x *ExecStart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecStart.ProtoReflect.Descriptor instead.
-func (*ExecStart) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetworkStats.ProtoReflect.Descriptor instead.
+func (*NetworkStats) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ExecStart) GetContainerId() string {
+func (x *NetworkStats) GetRxBytes() uint64 {
 	if x != nil {
-		return x.ContainerId
+		return x.RxBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecStart) GetCmd() []string {
+func (x *NetworkStats) GetRxPackets() uint64 {
 	if x != nil {
-		return x.Cmd
+		return x.RxPackets
 	}
-	return nil
+	return 0
 }
 
-func (x *ExecStart) GetTty() bool {
+func (x *NetworkStats) GetRxErrors() uint64 {
 	if x != nil {
-		return x.Tty
+		return x.RxErrors
 	}
-	return false
+	return 0
 }
 
-func (x *ExecStart) GetAttachStdin() bool {
+func (x *NetworkStats) GetRxDropped() uint64 {
 	if x != nil {
-		return x.AttachStdin
+		return x.RxDropped
 	}
-	return false
+	return 0
 }
 
-func (x *ExecStart) GetAttachStdout() bool {
+func (x *NetworkStats) GetTxBytes() uint64 {
 	if x != nil {
-		return x.AttachStdout
+		return x.TxBytes
 	}
-	return false
+	return 0
 }
 
-func (x *ExecStart) GetAttachStderr() bool {
+func (x *NetworkStats) GetTxPackets() uint64 {
 	if x != nil {
-		return x.AttachStderr
+		return x.TxPackets
 	}
-	return false
+	return 0
 }
 
-func (x *ExecStart) GetEnv() map[string]string {
+func (x *NetworkStats) GetTxErrors() uint64 {
 	if x != nil {
-		return x.Env
+		return x.TxErrors
 	}
-	return nil
+	return 0
 }
 
-type ExecInput struct {
+func (x *NetworkStats) GetTxDropped() uint64 {
+	if x != nil {
+		return x.TxDropped
+	}
+	return 0
+}
+
+type PidsStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // stdin input
+	Current       uint64                 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecInput) Reset() {
-	*x = ExecInput{}
+func (x *PidsStats) Reset() {
+	*x = PidsStats{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecInput) String() string {
+func (x *PidsStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecInput) ProtoMessage() {}
+func (*PidsStats) ProtoMessage() {}
 
-func (x *ExecInput) ProtoReflect() protoreflect.Message {
+func (x *PidsStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3216,40 +3164,54 @@ func (x *ExecInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecInput.ProtoReflect.Descriptor instead.
-func (*ExecInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use PidsStats.ProtoReflect.Descriptor instead.
+func (*PidsStats) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ExecInput) GetData() []byte {
+func (x *PidsStats) GetCurrent() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Current
 	}
-	return nil
+	return 0
 }
 
-type ExecResize struct {
+func (x *PidsStats) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Container logs (streaming)
+type GetContainerLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Width         uint32                 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`   // Terminal width
-	Height        uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // Terminal height
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Follow        bool                   `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`         // Stream logs
+	Stdout        bool                   `protobuf:"varint,3,opt,name=stdout,proto3" json:"stdout,omitempty"`         // Include stdout (default: true)
+	Stderr        bool                   `protobuf:"varint,4,opt,name=stderr,proto3" json:"stderr,omitempty"`         // Include stderr (default: true)
+	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`           // Unix timestamp
+	Until         int64                  `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`           // Unix timestamp
+	Timestamps    bool                   `protobuf:"varint,7,opt,name=timestamps,proto3" json:"timestamps,omitempty"` // Include timestamps
+	Tail          string                 `protobuf:"bytes,8,opt,name=tail,proto3" json:"tail,omitempty"`              // Number of lines from end ("all" or "100")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecResize) Reset() {
-	*x = ExecResize{}
+func (x *GetContainerLogsRequest) Reset() {
+	*x = GetContainerLogsRequest{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecResize) String() string {
+func (x *GetContainerLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecResize) ProtoMessage() {}
+func (*GetContainerLogsRequest) ProtoMessage() {}
 
-func (x *ExecResize) ProtoReflect() protoreflect.Message {
+func (x *GetContainerLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3261,51 +3223,90 @@ func (x *ExecResize) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecResize.ProtoReflect.Descriptor instead.
-func (*ExecResize) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContainerLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetContainerLogsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ExecResize) GetWidth() uint32 {
+func (x *GetContainerLogsRequest) GetContainerId() string {
 	if x != nil {
-		return x.Width
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetContainerLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetContainerLogsRequest) GetStdout() bool {
+	if x != nil {
+		return x.Stdout
+	}
+	return false
+}
+
+func (x *GetContainerLogsRequest) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+func (x *GetContainerLogsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
 	}
 	return 0
 }
 
-func (x *ExecResize) GetHeight() uint32 {
+func (x *GetContainerLogsRequest) GetUntil() int64 {
 	if x != nil {
-		return x.Height
+		return x.Until
 	}
 	return 0
 }
 
-type ExecResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Response:
-	//
-	//	*ExecResponse_Output
-	//	*ExecResponse_Error
-	//	*ExecResponse_Exit
-	Response      isExecResponse_Response `protobuf_oneof:"response"`
+func (x *GetContainerLogsRequest) GetTimestamps() bool {
+	if x != nil {
+		return x.Timestamps
+	}
+	return false
+}
+
+func (x *GetContainerLogsRequest) GetTail() string {
+	if x != nil {
+		return x.Tail
+	}
+	return ""
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"` // stdout/stderr
+	Log           string                 `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`       // Log line
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecResponse) Reset() {
-	*x = ExecResponse{}
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecResponse) String() string {
+func (x *LogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecResponse) ProtoMessage() {}
+func (*LogEntry) ProtoMessage() {}
 
-func (x *ExecResponse) ProtoReflect() protoreflect.Message {
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3317,89 +3318,59 @@ func (x *ExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
-func (*ExecResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ExecResponse) GetResponse() isExecResponse_Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-func (x *ExecResponse) GetOutput() *ExecOutput {
+func (x *LogEntry) GetTimestamp() int64 {
 	if x != nil {
-		if x, ok := x.Response.(*ExecResponse_Output); ok {
-			return x.Output
-		}
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *ExecResponse) GetError() *ExecError {
+func (x *LogEntry) GetStream() string {
 	if x != nil {
-		if x, ok := x.Response.(*ExecResponse_Error); ok {
-			return x.Error
-		}
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecResponse) GetExit() *ExecExit {
+func (x *LogEntry) GetLog() string {
 	if x != nil {
-		if x, ok := x.Response.(*ExecResponse_Exit); ok {
-			return x.Exit
-		}
+		return x.Log
 	}
-	return nil
-}
-
-type isExecResponse_Response interface {
-	isExecResponse_Response()
-}
-
-type ExecResponse_Output struct {
-	Output *ExecOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
-}
-
-type ExecResponse_Error struct {
-	Error *ExecError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
-}
-
-type ExecResponse_Exit struct {
-	Exit *ExecExit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"`
+	return ""
 }
 
-func (*ExecResponse_Output) isExecResponse_Response() {}
-
-func (*ExecResponse_Error) isExecResponse_Response() {}
-
-func (*ExecResponse_Exit) isExecResponse_Response() {}
-
-type ExecOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`     // stdout/stderr output
-	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"` // stdout/stderr
+// Container exec (bidirectional streaming)
+type ExecRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*ExecRequest_Start
+	//	*ExecRequest_Input
+	//	*ExecRequest_Resize
+	Request       isExecRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecOutput) Reset() {
-	*x = ExecOutput{}
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecOutput) String() string {
+func (x *ExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecOutput) ProtoMessage() {}
+func (*ExecRequest) ProtoMessage() {}
 
-func (x *ExecOutput) ProtoReflect() protoreflect.Message {
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3411,46 +3382,94 @@ func (x *ExecOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecOutput.ProtoReflect.Descriptor instead.
-func (*ExecOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ExecOutput) GetData() []byte {
+func (x *ExecRequest) GetRequest() isExecRequest_Request {
 	if x != nil {
-		return x.Data
+		return x.Request
 	}
 	return nil
 }
 
-func (x *ExecOutput) GetStream() string {
+func (x *ExecRequest) GetStart() *ExecStart {
 	if x != nil {
-		return x.Stream
+		if x, ok := x.Request.(*ExecRequest_Start); ok {
+			return x.Start
+		}
 	}
-	return ""
+	return nil
 }
 
-type ExecError struct {
+func (x *ExecRequest) GetInput() *ExecInput {
+	if x != nil {
+		if x, ok := x.Request.(*ExecRequest_Input); ok {
+			return x.Input
+		}
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetResize() *ExecResize {
+	if x != nil {
+		if x, ok := x.Request.(*ExecRequest_Resize); ok {
+			return x.Resize
+		}
+	}
+	return nil
+}
+
+type isExecRequest_Request interface {
+	isExecRequest_Request()
+}
+
+type ExecRequest_Start struct {
+	Start *ExecStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ExecRequest_Input struct {
+	Input *ExecInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+type ExecRequest_Resize struct {
+	Resize *ExecResize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+func (*ExecRequest_Start) isExecRequest_Request() {}
+
+func (*ExecRequest_Input) isExecRequest_Request() {}
+
+func (*ExecRequest_Resize) isExecRequest_Request() {}
+
+type ExecStart struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Cmd           []string               `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`  // Command to execute (e.g., ["/bin/sh"])
+	Tty           bool                   `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"` // Allocate TTY
+	AttachStdin   bool                   `protobuf:"varint,4,opt,name=attach_stdin,json=attachStdin,proto3" json:"attach_stdin,omitempty"`
+	AttachStdout  bool                   `protobuf:"varint,5,opt,name=attach_stdout,json=attachStdout,proto3" json:"attach_stdout,omitempty"`
+	AttachStderr  bool                   `protobuf:"varint,6,opt,name=attach_stderr,json=attachStderr,proto3" json:"attach_stderr,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Environment variables
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecError) Reset() {
-	*x = ExecError{}
+func (x *ExecStart) Reset() {
+	*x = ExecStart{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecError) String() string {
+func (x *ExecStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecError) ProtoMessage() {}
+func (*ExecStart) ProtoMessage() {}
 
-func (x *ExecError) ProtoReflect() protoreflect.Message {
+func (x *ExecStart) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3462,39 +3481,81 @@ func (x *ExecError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecError.ProtoReflect.Descriptor instead.
-func (*ExecError) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecStart.ProtoReflect.Descriptor instead.
+func (*ExecStart) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ExecError) GetMessage() string {
+func (x *ExecStart) GetContainerId() string {
 	if x != nil {
-		return x.Message
+		return x.ContainerId
 	}
 	return ""
 }
 
-type ExecExit struct {
+func (x *ExecStart) GetCmd() []string {
+	if x != nil {
+		return x.Cmd
+	}
+	return nil
+}
+
+func (x *ExecStart) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ExecStart) GetAttachStdin() bool {
+	if x != nil {
+		return x.AttachStdin
+	}
+	return false
+}
+
+func (x *ExecStart) GetAttachStdout() bool {
+	if x != nil {
+		return x.AttachStdout
+	}
+	return false
+}
+
+func (x *ExecStart) GetAttachStderr() bool {
+	if x != nil {
+		return x.AttachStderr
+	}
+	return false
+}
+
+func (x *ExecStart) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+type ExecInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // stdin input
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecExit) Reset() {
-	*x = ExecExit{}
+func (x *ExecInput) Reset() {
+	*x = ExecInput{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecExit) String() string {
+func (x *ExecInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecExit) ProtoMessage() {}
+func (*ExecInput) ProtoMessage() {}
 
-func (x *ExecExit) ProtoReflect() protoreflect.Message {
+func (x *ExecInput) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3506,41 +3567,40 @@ func (x *ExecExit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecExit.ProtoReflect.Descriptor instead.
-func (*ExecExit) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecInput.ProtoReflect.Descriptor instead.
+func (*ExecInput) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ExecExit) GetExitCode() int32 {
+func (x *ExecInput) GetData() []byte {
 	if x != nil {
-		return x.ExitCode
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// Image operations
-type ListImagesRequest struct {
+type ExecResize struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`        // Include intermediate images
-	Filters       string                 `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"` // Docker filters in JSON format
+	Width         uint32                 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`   // Terminal width
+	Height        uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // Terminal height
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListImagesRequest) Reset() {
-	*x = ListImagesRequest{}
+func (x *ExecResize) Reset() {
+	*x = ExecResize{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListImagesRequest) String() string {
+func (x *ExecResize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListImagesRequest) ProtoMessage() {}
+func (*ExecResize) ProtoMessage() {}
 
-func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecResize) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3552,46 +3612,51 @@ func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
-func (*ListImagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecResize.ProtoReflect.Descriptor instead.
+func (*ExecResize) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListImagesRequest) GetAll() bool {
+func (x *ExecResize) GetWidth() uint32 {
 	if x != nil {
-		return x.All
+		return x.Width
 	}
-	return false
+	return 0
 }
 
-func (x *ListImagesRequest) GetFilters() string {
+func (x *ExecResize) GetHeight() uint32 {
 	if x != nil {
-		return x.Filters
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-type ListImagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Images        []*Image               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+type ExecResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*ExecResponse_Output
+	//	*ExecResponse_Error
+	//	*ExecResponse_Exit
+	Response      isExecResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListImagesResponse) Reset() {
-	*x = ListImagesResponse{}
+func (x *ExecResponse) Reset() {
+	*x = ExecResponse{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListImagesResponse) String() string {
+func (x *ExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListImagesResponse) ProtoMessage() {}
+func (*ExecResponse) ProtoMessage() {}
 
-func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3603,155 +3668,140 @@ func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
-func (*ListImagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
+func (*ExecResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListImagesResponse) GetImages() []*Image {
+func (x *ExecResponse) GetResponse() isExecResponse_Response {
 	if x != nil {
-		return x.Images
+		return x.Response
 	}
 	return nil
 }
 
-type Image struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Image ID (short 12 chars)
-	RepoTags      []string               `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
-	RepoDigests   []string               `protobuf:"bytes,3,rep,name=repo_digests,json=repoDigests,proto3" json:"repo_digests,omitempty"`
-	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Created       int64                  `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
-	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
-	VirtualSize   int64                  `protobuf:"varint,7,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
-	SharedSize    int64                  `protobuf:"varint,8,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Containers    int32                  `protobuf:"varint,10,opt,name=containers,proto3" json:"containers,omitempty"` // Number of containers using this image
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Image) Reset() {
-	*x = Image{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Image) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecResponse) GetOutput() *ExecOutput {
+	if x != nil {
+		if x, ok := x.Response.(*ExecResponse_Output); ok {
+			return x.Output
+		}
+	}
+	return nil
 }
 
-func (*Image) ProtoMessage() {}
-
-func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[48]
+func (x *ExecResponse) GetError() *ExecError {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Response.(*ExecResponse_Error); ok {
+			return x.Error
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Image.ProtoReflect.Descriptor instead.
-func (*Image) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *Image) GetId() string {
+func (x *ExecResponse) GetExit() *ExecExit {
 	if x != nil {
-		return x.Id
+		if x, ok := x.Response.(*ExecResponse_Exit); ok {
+			return x.Exit
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *Image) GetRepoTags() []string {
-	if x != nil {
-		return x.RepoTags
-	}
-	return nil
+type isExecResponse_Response interface {
+	isExecResponse_Response()
 }
 
-func (x *Image) GetRepoDigests() []string {
-	if x != nil {
-		return x.RepoDigests
-	}
-	return nil
+type ExecResponse_Output struct {
+	Output *ExecOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
 }
 
-func (x *Image) GetParentId() string {
-	if x != nil {
-		return x.ParentId
-	}
-	return ""
+type ExecResponse_Error struct {
+	Error *ExecError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (x *Image) GetCreated() int64 {
-	if x != nil {
-		return x.Created
-	}
-	return 0
+type ExecResponse_Exit struct {
+	Exit *ExecExit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"`
 }
 
-func (x *Image) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (*ExecResponse_Output) isExecResponse_Response() {}
+
+func (*ExecResponse_Error) isExecResponse_Response() {}
+
+func (*ExecResponse_Exit) isExecResponse_Response() {}
+
+type ExecOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`     // stdout/stderr output
+	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"` // stdout/stderr
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Image) GetVirtualSize() int64 {
-	if x != nil {
-		return x.VirtualSize
-	}
-	return 0
+func (x *ExecOutput) Reset() {
+	*x = ExecOutput{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Image) GetSharedSize() int64 {
+func (x *ExecOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecOutput) ProtoMessage() {}
+
+func (x *ExecOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[48]
 	if x != nil {
-		return x.SharedSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Image) GetLabels() map[string]string {
+// Deprecated: Use ExecOutput.ProtoReflect.Descriptor instead.
+func (*ExecOutput) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExecOutput) GetData() []byte {
 	if x != nil {
-		return x.Labels
+		return x.Data
 	}
 	return nil
 }
 
-func (x *Image) GetContainers() int32 {
+func (x *ExecOutput) GetStream() string {
 	if x != nil {
-		return x.Containers
+		return x.Stream
 	}
-	return 0
+	return ""
 }
 
-type GetImageRequest struct {
+type ExecError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"` // Image ID or name:tag
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetImageRequest) Reset() {
-	*x = GetImageRequest{}
+func (x *ExecError) Reset() {
+	*x = ExecError{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetImageRequest) String() string {
+func (x *ExecError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImageRequest) ProtoMessage() {}
+func (*ExecError) ProtoMessage() {}
 
-func (x *GetImageRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecError) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3763,39 +3813,39 @@ func (x *GetImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImageRequest.ProtoReflect.Descriptor instead.
-func (*GetImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecError.ProtoReflect.Descriptor instead.
+func (*ExecError) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetImageRequest) GetImageId() string {
+func (x *ExecError) GetMessage() string {
 	if x != nil {
-		return x.ImageId
+		return x.Message
 	}
 	return ""
 }
 
-type GetImageResponse struct {
+type ExecExit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         *ImageDetail           `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetImageResponse) Reset() {
-	*x = GetImageResponse{}
+func (x *ExecExit) Reset() {
+	*x = ExecExit{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetImageResponse) String() string {
+func (x *ExecExit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImageResponse) ProtoMessage() {}
+func (*ExecExit) ProtoMessage() {}
 
-func (x *GetImageResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecExit) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3807,53 +3857,41 @@ func (x *GetImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImageResponse.ProtoReflect.Descriptor instead.
-func (*GetImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecExit.ProtoReflect.Descriptor instead.
+func (*ExecExit) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetImageResponse) GetImage() *ImageDetail {
+func (x *ExecExit) GetExitCode() int32 {
 	if x != nil {
-		return x.Image
+		return x.ExitCode
 	}
-	return nil
+	return 0
 }
 
-type ImageDetail struct {
+// Container filesystem
+type ListContainerFilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RepoTags      []string               `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
-	RepoDigests   []string               `protobuf:"bytes,3,rep,name=repo_digests,json=repoDigests,proto3" json:"repo_digests,omitempty"`
-	Parent        string                 `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
-	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
-	Created       int64                  `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
-	Container     string                 `protobuf:"bytes,7,opt,name=container,proto3" json:"container,omitempty"`
-	Config        *ImageConfig           `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
-	DockerVersion string                 `protobuf:"bytes,9,opt,name=docker_version,json=dockerVersion,proto3" json:"docker_version,omitempty"`
-	Author        string                 `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
-	Architecture  string                 `protobuf:"bytes,11,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	Os            string                 `protobuf:"bytes,12,opt,name=os,proto3" json:"os,omitempty"`
-	Size          int64                  `protobuf:"varint,13,opt,name=size,proto3" json:"size,omitempty"`
-	VirtualSize   int64                  `protobuf:"varint,14,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
-	RootFs        *RootFS                `protobuf:"bytes,15,opt,name=root_fs,json=rootFs,proto3" json:"root_fs,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // Directory to list
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageDetail) Reset() {
-	*x = ImageDetail{}
+func (x *ListContainerFilesRequest) Reset() {
+	*x = ListContainerFilesRequest{}
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageDetail) String() string {
+func (x *ListContainerFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageDetail) ProtoMessage() {}
+func (*ListContainerFilesRequest) ProtoMessage() {}
 
-func (x *ImageDetail) ProtoReflect() protoreflect.Message {
+func (x *ListContainerFilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3865,148 +3903,210 @@ func (x *ImageDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageDetail.ProtoReflect.Descriptor instead.
-func (*ImageDetail) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListContainerFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListContainerFilesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ImageDetail) GetId() string {
+func (x *ListContainerFilesRequest) GetContainerId() string {
 	if x != nil {
-		return x.Id
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *ImageDetail) GetRepoTags() []string {
+func (x *ListContainerFilesRequest) GetPath() string {
 	if x != nil {
-		return x.RepoTags
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *ImageDetail) GetRepoDigests() []string {
-	if x != nil {
-		return x.RepoDigests
-	}
-	return nil
+type ListContainerFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Files         []*ContainerFile       `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"` // More entries exist than were returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageDetail) GetParent() string {
+func (x *ListContainerFilesResponse) Reset() {
+	*x = ListContainerFilesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContainerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContainerFilesResponse) ProtoMessage() {}
+
+func (x *ListContainerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[52]
 	if x != nil {
-		return x.Parent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ImageDetail) GetComment() string {
+// Deprecated: Use ListContainerFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListContainerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListContainerFilesResponse) GetPath() string {
 	if x != nil {
-		return x.Comment
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ImageDetail) GetCreated() int64 {
+func (x *ListContainerFilesResponse) GetFiles() []*ContainerFile {
 	if x != nil {
-		return x.Created
+		return x.Files
 	}
-	return 0
+	return nil
 }
 
-func (x *ImageDetail) GetContainer() string {
+func (x *ListContainerFilesResponse) GetTruncated() bool {
 	if x != nil {
-		return x.Container
+		return x.Truncated
 	}
-	return ""
+	return false
 }
 
-func (x *ImageDetail) GetConfig() *ImageConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+type ContainerFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir         bool                   `protobuf:"varint,3,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	IsSymlink     bool                   `protobuf:"varint,4,opt,name=is_symlink,json=isSymlink,proto3" json:"is_symlink,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Mode          string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`                                // e.g. -rw-r--r--
+	ModifiedAt    int64                  `protobuf:"varint,7,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"` // Unix timestamp
+	LinkTarget    string                 `protobuf:"bytes,8,opt,name=link_target,json=linkTarget,proto3" json:"link_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageDetail) GetDockerVersion() string {
+func (x *ContainerFile) Reset() {
+	*x = ContainerFile{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerFile) ProtoMessage() {}
+
+func (x *ContainerFile) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[53]
 	if x != nil {
-		return x.DockerVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ImageDetail) GetAuthor() string {
+// Deprecated: Use ContainerFile.ProtoReflect.Descriptor instead.
+func (*ContainerFile) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ContainerFile) GetName() string {
 	if x != nil {
-		return x.Author
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ImageDetail) GetArchitecture() string {
+func (x *ContainerFile) GetPath() string {
 	if x != nil {
-		return x.Architecture
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ImageDetail) GetOs() string {
+func (x *ContainerFile) GetIsDir() bool {
 	if x != nil {
-		return x.Os
+		return x.IsDir
 	}
-	return ""
+	return false
 }
 
-func (x *ImageDetail) GetSize() int64 {
+func (x *ContainerFile) GetIsSymlink() bool {
+	if x != nil {
+		return x.IsSymlink
+	}
+	return false
+}
+
+func (x *ContainerFile) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *ImageDetail) GetVirtualSize() int64 {
+func (x *ContainerFile) GetMode() string {
 	if x != nil {
-		return x.VirtualSize
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetModifiedAt() int64 {
+	if x != nil {
+		return x.ModifiedAt
 	}
 	return 0
 }
 
-func (x *ImageDetail) GetRootFs() *RootFS {
+func (x *ContainerFile) GetLinkTarget() string {
 	if x != nil {
-		return x.RootFs
+		return x.LinkTarget
 	}
-	return nil
+	return ""
 }
 
-type ImageConfig struct {
+// Copy a file or directory out of a container as a tar archive
+type CopyFromContainerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Domainname    string                 `protobuf:"bytes,2,opt,name=domainname,proto3" json:"domainname,omitempty"`
-	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Env           []string               `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
-	Cmd           []string               `protobuf:"bytes,5,rep,name=cmd,proto3" json:"cmd,omitempty"`
-	Image         string                 `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
-	Volumes       map[string]bool        `protobuf:"bytes,7,rep,name=volumes,proto3" json:"volumes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	WorkingDir    string                 `protobuf:"bytes,8,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	Entrypoint    []string               `protobuf:"bytes,9,rep,name=entrypoint,proto3" json:"entrypoint,omitempty"`
-	ExposedPorts  map[string]bool        `protobuf:"bytes,10,rep,name=exposed_ports,json=exposedPorts,proto3" json:"exposed_ports,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	Labels        map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Compress      bool                   `protobuf:"varint,3,opt,name=compress,proto3" json:"compress,omitempty"` // gzip the archive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageConfig) Reset() {
-	*x = ImageConfig{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[52]
+func (x *CopyFromContainerRequest) Reset() {
+	*x = CopyFromContainerRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageConfig) String() string {
+func (x *CopyFromContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageConfig) ProtoMessage() {}
+func (*CopyFromContainerRequest) ProtoMessage() {}
 
-func (x *ImageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[52]
+func (x *CopyFromContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4017,112 +4117,102 @@ func (x *ImageConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageConfig.ProtoReflect.Descriptor instead.
-func (*ImageConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *ImageConfig) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
+// Deprecated: Use CopyFromContainerRequest.ProtoReflect.Descriptor instead.
+func (*CopyFromContainerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ImageConfig) GetDomainname() string {
+func (x *CopyFromContainerRequest) GetContainerId() string {
 	if x != nil {
-		return x.Domainname
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *ImageConfig) GetUser() string {
+func (x *CopyFromContainerRequest) GetPath() string {
 	if x != nil {
-		return x.User
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ImageConfig) GetEnv() []string {
+func (x *CopyFromContainerRequest) GetCompress() bool {
 	if x != nil {
-		return x.Env
+		return x.Compress
 	}
-	return nil
+	return false
 }
 
-func (x *ImageConfig) GetCmd() []string {
-	if x != nil {
-		return x.Cmd
-	}
-	return nil
+type ArchiveChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageConfig) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+func (x *ArchiveChunk) Reset() {
+	*x = ArchiveChunk{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageConfig) GetVolumes() map[string]bool {
-	if x != nil {
-		return x.Volumes
-	}
-	return nil
+func (x *ArchiveChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ImageConfig) GetWorkingDir() string {
-	if x != nil {
-		return x.WorkingDir
-	}
-	return ""
-}
+func (*ArchiveChunk) ProtoMessage() {}
 
-func (x *ImageConfig) GetEntrypoint() []string {
+func (x *ArchiveChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[55]
 	if x != nil {
-		return x.Entrypoint
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ImageConfig) GetExposedPorts() map[string]bool {
-	if x != nil {
-		return x.ExposedPorts
-	}
-	return nil
+// Deprecated: Use ArchiveChunk.ProtoReflect.Descriptor instead.
+func (*ArchiveChunk) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ImageConfig) GetLabels() map[string]string {
+func (x *ArchiveChunk) GetData() []byte {
 	if x != nil {
-		return x.Labels
+		return x.Data
 	}
 	return nil
 }
 
-type RootFS struct {
+// Extract a tar archive into a directory of a container. The first message
+// carries the container and path, the following ones the archive data.
+type CopyToContainerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Layers        []string               `protobuf:"bytes,2,rep,name=layers,proto3" json:"layers,omitempty"`
-	BaseLayer     string                 `protobuf:"bytes,3,opt,name=base_layer,json=baseLayer,proto3" json:"base_layer,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RootFS) Reset() {
-	*x = RootFS{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[53]
+func (x *CopyToContainerRequest) Reset() {
+	*x = CopyToContainerRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RootFS) String() string {
+func (x *CopyToContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RootFS) ProtoMessage() {}
+func (*CopyToContainerRequest) ProtoMessage() {}
 
-func (x *RootFS) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[53]
+func (x *CopyToContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4133,56 +4223,55 @@ func (x *RootFS) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RootFS.ProtoReflect.Descriptor instead.
-func (*RootFS) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use CopyToContainerRequest.ProtoReflect.Descriptor instead.
+func (*CopyToContainerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RootFS) GetType() string {
+func (x *CopyToContainerRequest) GetContainerId() string {
 	if x != nil {
-		return x.Type
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *RootFS) GetLayers() []string {
+func (x *CopyToContainerRequest) GetPath() string {
 	if x != nil {
-		return x.Layers
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *RootFS) GetBaseLayer() string {
+func (x *CopyToContainerRequest) GetData() []byte {
 	if x != nil {
-		return x.BaseLayer
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type DeleteImageRequest struct {
+type CopyToContainerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                    // Force remove
-	NoPrune       bool                   `protobuf:"varint,3,opt,name=no_prune,json=noPrune,proto3" json:"no_prune,omitempty"` // Don't delete untagged parents
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteImageRequest) Reset() {
-	*x = DeleteImageRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[54]
+func (x *CopyToContainerResponse) Reset() {
+	*x = CopyToContainerResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteImageRequest) String() string {
+func (x *CopyToContainerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteImageRequest) ProtoMessage() {}
+func (*CopyToContainerResponse) ProtoMessage() {}
 
-func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[54]
+func (x *CopyToContainerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4193,54 +4282,49 @@ func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteImageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteImageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *DeleteImageRequest) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
+// Deprecated: Use CopyToContainerResponse.ProtoReflect.Descriptor instead.
+func (*CopyToContainerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DeleteImageRequest) GetForce() bool {
+func (x *CopyToContainerResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Force
+		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteImageRequest) GetNoPrune() bool {
+func (x *CopyToContainerResponse) GetMessage() string {
 	if x != nil {
-		return x.NoPrune
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-type DeleteImageResponse struct {
+// Image operations
+type ListImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deleted       []*ImageDeleteResponse `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`        // Include intermediate images
+	Filters       string                 `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"` // Docker filters in JSON format
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteImageResponse) Reset() {
-	*x = DeleteImageResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[55]
+func (x *ListImagesRequest) Reset() {
+	*x = ListImagesRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteImageResponse) String() string {
+func (x *ListImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteImageResponse) ProtoMessage() {}
+func (*ListImagesRequest) ProtoMessage() {}
 
-func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[55]
+func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4251,41 +4335,47 @@ func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteImageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteImageResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteImageResponse) GetDeleted() []*ImageDeleteResponse {
+func (x *ListImagesRequest) GetAll() bool {
 	if x != nil {
-		return x.Deleted
+		return x.All
 	}
-	return nil
+	return false
 }
 
-type ImageDeleteResponse struct {
+func (x *ListImagesRequest) GetFilters() string {
+	if x != nil {
+		return x.Filters
+	}
+	return ""
+}
+
+type ListImagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Untagged      string                 `protobuf:"bytes,1,opt,name=untagged,proto3" json:"untagged,omitempty"`
-	Deleted       string                 `protobuf:"bytes,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Images        []*Image               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageDeleteResponse) Reset() {
-	*x = ImageDeleteResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[56]
+func (x *ListImagesResponse) Reset() {
+	*x = ListImagesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageDeleteResponse) String() string {
+func (x *ListImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageDeleteResponse) ProtoMessage() {}
+func (*ListImagesResponse) ProtoMessage() {}
 
-func (x *ImageDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[56]
+func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4296,48 +4386,49 @@ func (x *ImageDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageDeleteResponse.ProtoReflect.Descriptor instead.
-func (*ImageDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *ImageDeleteResponse) GetUntagged() string {
-	if x != nil {
-		return x.Untagged
-	}
-	return ""
+// Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ImageDeleteResponse) GetDeleted() string {
+func (x *ListImagesResponse) GetImages() []*Image {
 	if x != nil {
-		return x.Deleted
+		return x.Images
 	}
-	return ""
+	return nil
 }
 
-type PullImageRequest struct {
+type Image struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`                                   // Image name (e.g., "nginx:latest")
-	RegistryAuth  string                 `protobuf:"bytes,2,opt,name=registry_auth,json=registryAuth,proto3" json:"registry_auth,omitempty"` // Base64 encoded auth config
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Image ID (short 12 chars)
+	RepoTags      []string               `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
+	RepoDigests   []string               `protobuf:"bytes,3,rep,name=repo_digests,json=repoDigests,proto3" json:"repo_digests,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Created       int64                  `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	VirtualSize   int64                  `protobuf:"varint,7,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
+	SharedSize    int64                  `protobuf:"varint,8,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Containers    int32                  `protobuf:"varint,10,opt,name=containers,proto3" json:"containers,omitempty"` // Number of containers using this image
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PullImageRequest) Reset() {
-	*x = PullImageRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[57]
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PullImageRequest) String() string {
+func (x *Image) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullImageRequest) ProtoMessage() {}
+func (*Image) ProtoMessage() {}
 
-func (x *PullImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[57]
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4348,124 +4439,3214 @@ func (x *PullImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullImageRequest.ProtoReflect.Descriptor instead.
-func (*PullImageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *PullImageRequest) GetImage() string {
+func (x *Image) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Image) GetRepoTags() []string {
+	if x != nil {
+		return x.RepoTags
+	}
+	return nil
+}
+
+func (x *Image) GetRepoDigests() []string {
+	if x != nil {
+		return x.RepoDigests
+	}
+	return nil
+}
+
+func (x *Image) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Image) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Image) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Image) GetVirtualSize() int64 {
+	if x != nil {
+		return x.VirtualSize
+	}
+	return 0
+}
+
+func (x *Image) GetSharedSize() int64 {
+	if x != nil {
+		return x.SharedSize
+	}
+	return 0
+}
+
+func (x *Image) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Image) GetContainers() int32 {
+	if x != nil {
+		return x.Containers
+	}
+	return 0
+}
+
+type GetImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"` // Image ID or name:tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImageRequest) Reset() {
+	*x = GetImageRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageRequest) ProtoMessage() {}
+
+func (x *GetImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageRequest.ProtoReflect.Descriptor instead.
+func (*GetImageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type GetImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ImageDetail           `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImageResponse) Reset() {
+	*x = GetImageResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageResponse) ProtoMessage() {}
+
+func (x *GetImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageResponse.ProtoReflect.Descriptor instead.
+func (*GetImageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetImageResponse) GetImage() *ImageDetail {
 	if x != nil {
 		return x.Image
 	}
+	return nil
+}
+
+type ImageDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoTags      []string               `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
+	RepoDigests   []string               `protobuf:"bytes,3,rep,name=repo_digests,json=repoDigests,proto3" json:"repo_digests,omitempty"`
+	Parent        string                 `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Created       int64                  `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
+	Container     string                 `protobuf:"bytes,7,opt,name=container,proto3" json:"container,omitempty"`
+	Config        *ImageConfig           `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	DockerVersion string                 `protobuf:"bytes,9,opt,name=docker_version,json=dockerVersion,proto3" json:"docker_version,omitempty"`
+	Author        string                 `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	Architecture  string                 `protobuf:"bytes,11,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Os            string                 `protobuf:"bytes,12,opt,name=os,proto3" json:"os,omitempty"`
+	Size          int64                  `protobuf:"varint,13,opt,name=size,proto3" json:"size,omitempty"`
+	VirtualSize   int64                  `protobuf:"varint,14,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
+	RootFs        *RootFS                `protobuf:"bytes,15,opt,name=root_fs,json=rootFs,proto3" json:"root_fs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageDetail) Reset() {
+	*x = ImageDetail{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageDetail) ProtoMessage() {}
+
+func (x *ImageDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageDetail.ProtoReflect.Descriptor instead.
+func (*ImageDetail) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ImageDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetRepoTags() []string {
+	if x != nil {
+		return x.RepoTags
+	}
+	return nil
+}
+
+func (x *ImageDetail) GetRepoDigests() []string {
+	if x != nil {
+		return x.RepoDigests
+	}
+	return nil
+}
+
+func (x *ImageDetail) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImageDetail) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetConfig() *ImageConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ImageDetail) GetDockerVersion() string {
+	if x != nil {
+		return x.DockerVersion
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *ImageDetail) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageDetail) GetVirtualSize() int64 {
+	if x != nil {
+		return x.VirtualSize
+	}
+	return 0
+}
+
+func (x *ImageDetail) GetRootFs() *RootFS {
+	if x != nil {
+		return x.RootFs
+	}
+	return nil
+}
+
+type ImageConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Domainname    string                 `protobuf:"bytes,2,opt,name=domainname,proto3" json:"domainname,omitempty"`
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Env           []string               `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
+	Cmd           []string               `protobuf:"bytes,5,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	Image         string                 `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	Volumes       map[string]bool        `protobuf:"bytes,7,rep,name=volumes,proto3" json:"volumes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	WorkingDir    string                 `protobuf:"bytes,8,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	Entrypoint    []string               `protobuf:"bytes,9,rep,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	ExposedPorts  map[string]bool        `protobuf:"bytes,10,rep,name=exposed_ports,json=exposedPorts,proto3" json:"exposed_ports,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Labels        map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageConfig) Reset() {
+	*x = ImageConfig{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageConfig) ProtoMessage() {}
+
+func (x *ImageConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageConfig.ProtoReflect.Descriptor instead.
+func (*ImageConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ImageConfig) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ImageConfig) GetDomainname() string {
+	if x != nil {
+		return x.Domainname
+	}
+	return ""
+}
+
+func (x *ImageConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ImageConfig) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ImageConfig) GetCmd() []string {
+	if x != nil {
+		return x.Cmd
+	}
+	return nil
+}
+
+func (x *ImageConfig) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ImageConfig) GetVolumes() map[string]bool {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ImageConfig) GetWorkingDir() string {
+	if x != nil {
+		return x.WorkingDir
+	}
+	return ""
+}
+
+func (x *ImageConfig) GetEntrypoint() []string {
+	if x != nil {
+		return x.Entrypoint
+	}
+	return nil
+}
+
+func (x *ImageConfig) GetExposedPorts() map[string]bool {
+	if x != nil {
+		return x.ExposedPorts
+	}
+	return nil
+}
+
+func (x *ImageConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type RootFS struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Layers        []string               `protobuf:"bytes,2,rep,name=layers,proto3" json:"layers,omitempty"`
+	BaseLayer     string                 `protobuf:"bytes,3,opt,name=base_layer,json=baseLayer,proto3" json:"base_layer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RootFS) Reset() {
+	*x = RootFS{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RootFS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RootFS) ProtoMessage() {}
+
+func (x *RootFS) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RootFS.ProtoReflect.Descriptor instead.
+func (*RootFS) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RootFS) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RootFS) GetLayers() []string {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+func (x *RootFS) GetBaseLayer() string {
+	if x != nil {
+		return x.BaseLayer
+	}
+	return ""
+}
+
+type DeleteImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                    // Force remove
+	NoPrune       bool                   `protobuf:"varint,3,opt,name=no_prune,json=noPrune,proto3" json:"no_prune,omitempty"` // Don't delete untagged parents
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImageRequest) Reset() {
+	*x = DeleteImageRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImageRequest) ProtoMessage() {}
+
+func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *DeleteImageRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteImageRequest) GetNoPrune() bool {
+	if x != nil {
+		return x.NoPrune
+	}
+	return false
+}
+
+type DeleteImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       []*ImageDeleteResponse `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImageResponse) Reset() {
+	*x = DeleteImageResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImageResponse) ProtoMessage() {}
+
+func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteImageResponse) GetDeleted() []*ImageDeleteResponse {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+type ImageDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Untagged      string                 `protobuf:"bytes,1,opt,name=untagged,proto3" json:"untagged,omitempty"`
+	Deleted       string                 `protobuf:"bytes,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageDeleteResponse) Reset() {
+	*x = ImageDeleteResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageDeleteResponse) ProtoMessage() {}
+
+func (x *ImageDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ImageDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ImageDeleteResponse) GetUntagged() string {
+	if x != nil {
+		return x.Untagged
+	}
+	return ""
+}
+
+func (x *ImageDeleteResponse) GetDeleted() string {
+	if x != nil {
+		return x.Deleted
+	}
+	return ""
+}
+
+type PullImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`                                   // Image name (e.g., "nginx:latest")
+	RegistryAuth  string                 `protobuf:"bytes,2,opt,name=registry_auth,json=registryAuth,proto3" json:"registry_auth,omitempty"` // Base64 encoded auth config
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullImageRequest) Reset() {
+	*x = PullImageRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullImageRequest) ProtoMessage() {}
+
+func (x *PullImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullImageRequest.ProtoReflect.Descriptor instead.
+func (*PullImageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PullImageRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *PullImageRequest) GetRegistryAuth() string {
+	if x != nil {
+		return x.RegistryAuth
+	}
+	return ""
+}
+
+type PullImageProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`     // Status message
+	Progress      string                 `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"` // Progress bar
+	Current       int64                  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`  // Current bytes
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`      // Total bytes
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`             // Layer ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullImageProgress) Reset() {
+	*x = PullImageProgress{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullImageProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullImageProgress) ProtoMessage() {}
+
+func (x *PullImageProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullImageProgress.ProtoReflect.Descriptor instead.
+func (*PullImageProgress) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PullImageProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullImageProgress) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+func (x *PullImageProgress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *PullImageProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PullImageProgress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TagImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // Source image
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // Target tag (repo:tag)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagImageRequest) Reset() {
+	*x = TagImageRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagImageRequest) ProtoMessage() {}
+
+func (x *TagImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagImageRequest.ProtoReflect.Descriptor instead.
+func (*TagImageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TagImageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TagImageRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type TagImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagImageResponse) Reset() {
+	*x = TagImageResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagImageResponse) ProtoMessage() {}
+
+func (x *TagImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagImageResponse.ProtoReflect.Descriptor instead.
+func (*TagImageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TagImageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TagImageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PruneImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`                                            // Remove all unused images, not only dangling ones
+	OlderThanDays int32                  `protobuf:"varint,2,opt,name=older_than_days,json=olderThanDays,proto3" json:"older_than_days,omitempty"` // Only images created more than N days ago (0 = any age)
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Report what would be removed without removing it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneImagesRequest) Reset() {
+	*x = PruneImagesRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneImagesRequest) ProtoMessage() {}
+
+func (x *PruneImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneImagesRequest.ProtoReflect.Descriptor instead.
+func (*PruneImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PruneImagesRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *PruneImagesRequest) GetOlderThanDays() int32 {
+	if x != nil {
+		return x.OlderThanDays
+	}
+	return 0
+}
+
+func (x *PruneImagesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PruneImagesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ImagesDeleted  []string               `protobuf:"bytes,1,rep,name=images_deleted,json=imagesDeleted,proto3" json:"images_deleted,omitempty"`
+	SpaceReclaimed uint64                 `protobuf:"varint,2,opt,name=space_reclaimed,json=spaceReclaimed,proto3" json:"space_reclaimed,omitempty"` // Space reclaimed (or reclaimable on dry run) in bytes
+	DryRun         bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PruneImagesResponse) Reset() {
+	*x = PruneImagesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneImagesResponse) ProtoMessage() {}
+
+func (x *PruneImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneImagesResponse.ProtoReflect.Descriptor instead.
+func (*PruneImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PruneImagesResponse) GetImagesDeleted() []string {
+	if x != nil {
+		return x.ImagesDeleted
+	}
+	return nil
+}
+
+func (x *PruneImagesResponse) GetSpaceReclaimed() uint64 {
+	if x != nil {
+		return x.SpaceReclaimed
+	}
+	return 0
+}
+
+func (x *PruneImagesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{75}
+}
+
+type ListVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ListVolumesResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type Volume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Mountpoint    string                 `protobuf:"bytes,3,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Scope         string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UsageData     *VolumeUsageData       `protobuf:"bytes,9,opt,name=usage_data,json=usageData,proto3" json:"usage_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Volume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Volume) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Volume) GetMountpoint() string {
+	if x != nil {
+		return x.Mountpoint
+	}
+	return ""
+}
+
+func (x *Volume) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Volume) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Volume) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Volume) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Volume) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Volume) GetUsageData() *VolumeUsageData {
+	if x != nil {
+		return x.UsageData
+	}
+	return nil
+}
+
+type VolumeUsageData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int64                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`                         // Size in bytes
+	RefCount      int64                  `protobuf:"varint,2,opt,name=ref_count,json=refCount,proto3" json:"ref_count,omitempty"` // Number of containers using this volume
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeUsageData) Reset() {
+	*x = VolumeUsageData{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeUsageData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeUsageData) ProtoMessage() {}
+
+func (x *VolumeUsageData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeUsageData.ProtoReflect.Descriptor instead.
+func (*VolumeUsageData) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VolumeUsageData) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *VolumeUsageData) GetRefCount() int64 {
+	if x != nil {
+		return x.RefCount
+	}
+	return 0
+}
+
+type GetVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeRequest) Reset() {
+	*x = GetVolumeRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeRequest) ProtoMessage() {}
+
+func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeResponse) Reset() {
+	*x = GetVolumeResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeResponse) ProtoMessage() {}
+
+func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type CreateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // Volume driver (default: "local")
+	DriverOpts    map[string]string      `protobuf:"bytes,3,rep,name=driver_opts,json=driverOpts,proto3" json:"driver_opts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeRequest) ProtoMessage() {}
+
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriverOpts() map[string]string {
+	if x != nil {
+		return x.DriverOpts
+	}
+	return nil
+}
+
+func (x *CreateVolumeRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // Force remove volume
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeRequest) Reset() {
+	*x = DeleteVolumeRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeRequest) ProtoMessage() {}
+
+func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DeleteVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteVolumeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeResponse) Reset() {
+	*x = DeleteVolumeResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeResponse) ProtoMessage() {}
+
+func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteVolumeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PruneVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       map[string]string      `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Optional filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneVolumesRequest) Reset() {
+	*x = PruneVolumesRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneVolumesRequest) ProtoMessage() {}
+
+func (x *PruneVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneVolumesRequest.ProtoReflect.Descriptor instead.
+func (*PruneVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PruneVolumesRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type PruneVolumesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VolumesDeleted []string               `protobuf:"bytes,1,rep,name=volumes_deleted,json=volumesDeleted,proto3" json:"volumes_deleted,omitempty"`
+	SpaceReclaimed uint64                 `protobuf:"varint,2,opt,name=space_reclaimed,json=spaceReclaimed,proto3" json:"space_reclaimed,omitempty"` // Space reclaimed in bytes
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PruneVolumesResponse) Reset() {
+	*x = PruneVolumesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneVolumesResponse) ProtoMessage() {}
+
+func (x *PruneVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneVolumesResponse.ProtoReflect.Descriptor instead.
+func (*PruneVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PruneVolumesResponse) GetVolumesDeleted() []string {
+	if x != nil {
+		return x.VolumesDeleted
+	}
+	return nil
+}
+
+func (x *PruneVolumesResponse) GetSpaceReclaimed() uint64 {
+	if x != nil {
+		return x.SpaceReclaimed
+	}
+	return 0
+}
+
+type ListNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       string                 `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"` // Docker filters in JSON format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksRequest) Reset() {
+	*x = ListNetworksRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksRequest) ProtoMessage() {}
+
+func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListNetworksRequest) GetFilters() string {
+	if x != nil {
+		return x.Filters
+	}
+	return ""
+}
+
+type ListNetworksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      []*Network             `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksResponse) Reset() {
+	*x = ListNetworksResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksResponse) ProtoMessage() {}
+
+func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListNetworksResponse) GetNetworks() []*Network {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type Network struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Id            string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Created       int64                        `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Scope         string                       `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"` // local, swarm, global
+	Driver        string                       `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
+	EnableIpv6    bool                         `protobuf:"varint,6,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
+	Ipam          *IPAMConfig                  `protobuf:"bytes,7,opt,name=ipam,proto3" json:"ipam,omitempty"`
+	Internal      bool                         `protobuf:"varint,8,opt,name=internal,proto3" json:"internal,omitempty"`
+	Attachable    bool                         `protobuf:"varint,9,opt,name=attachable,proto3" json:"attachable,omitempty"`
+	Ingress       bool                         `protobuf:"varint,10,opt,name=ingress,proto3" json:"ingress,omitempty"`
+	Containers    map[string]*NetworkContainer `protobuf:"bytes,11,rep,name=containers,proto3" json:"containers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Options       map[string]string            `protobuf:"bytes,12,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string            `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Network) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Network) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Network) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Network) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Network) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Network) GetEnableIpv6() bool {
+	if x != nil {
+		return x.EnableIpv6
+	}
+	return false
+}
+
+func (x *Network) GetIpam() *IPAMConfig {
+	if x != nil {
+		return x.Ipam
+	}
+	return nil
+}
+
+func (x *Network) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *Network) GetAttachable() bool {
+	if x != nil {
+		return x.Attachable
+	}
+	return false
+}
+
+func (x *Network) GetIngress() bool {
+	if x != nil {
+		return x.Ingress
+	}
+	return false
+}
+
+func (x *Network) GetContainers() map[string]*NetworkContainer {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *Network) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Network) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type IPAMConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Config        []*IPAMPool            `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPAMConfig) Reset() {
+	*x = IPAMConfig{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPAMConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAMConfig) ProtoMessage() {}
+
+func (x *IPAMConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAMConfig.ProtoReflect.Descriptor instead.
+func (*IPAMConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *IPAMConfig) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *IPAMConfig) GetConfig() []*IPAMPool {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *IPAMConfig) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type IPAMPool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subnet        string                 `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	IpRange       string                 `protobuf:"bytes,2,opt,name=ip_range,json=ipRange,proto3" json:"ip_range,omitempty"`
+	Gateway       string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	AuxAddresses  map[string]string      `protobuf:"bytes,4,rep,name=aux_addresses,json=auxAddresses,proto3" json:"aux_addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPAMPool) Reset() {
+	*x = IPAMPool{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPAMPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAMPool) ProtoMessage() {}
+
+func (x *IPAMPool) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAMPool.ProtoReflect.Descriptor instead.
+func (*IPAMPool) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *IPAMPool) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *IPAMPool) GetIpRange() string {
+	if x != nil {
+		return x.IpRange
+	}
+	return ""
+}
+
+func (x *IPAMPool) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *IPAMPool) GetAuxAddresses() map[string]string {
+	if x != nil {
+		return x.AuxAddresses
+	}
+	return nil
+}
+
+type NetworkContainer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EndpointId    string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	MacAddress    string                 `protobuf:"bytes,3,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	Ipv4Address   string                 `protobuf:"bytes,4,opt,name=ipv4_address,json=ipv4Address,proto3" json:"ipv4_address,omitempty"`
+	Ipv6Address   string                 `protobuf:"bytes,5,opt,name=ipv6_address,json=ipv6Address,proto3" json:"ipv6_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkContainer) Reset() {
+	*x = NetworkContainer{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkContainer) ProtoMessage() {}
+
+func (x *NetworkContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkContainer.ProtoReflect.Descriptor instead.
+func (*NetworkContainer) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *NetworkContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkContainer) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *NetworkContainer) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *NetworkContainer) GetIpv4Address() string {
+	if x != nil {
+		return x.Ipv4Address
+	}
+	return ""
+}
+
+func (x *NetworkContainer) GetIpv6Address() string {
+	if x != nil {
+		return x.Ipv6Address
+	}
+	return ""
+}
+
+type GetNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"` // Network ID or name
+	Verbose       bool                   `protobuf:"varint,2,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkRequest) Reset() {
+	*x = GetNetworkRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkRequest) ProtoMessage() {}
+
+func (x *GetNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetNetworkRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *GetNetworkRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *GetNetworkRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type GetNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkResponse) Reset() {
+	*x = GetNetworkResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkResponse) ProtoMessage() {}
+
+func (x *GetNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetNetworkResponse) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+type CreateNetworkRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CheckDuplicate bool                   `protobuf:"varint,2,opt,name=check_duplicate,json=checkDuplicate,proto3" json:"check_duplicate,omitempty"`
+	Driver         string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Internal       bool                   `protobuf:"varint,4,opt,name=internal,proto3" json:"internal,omitempty"`
+	Attachable     bool                   `protobuf:"varint,5,opt,name=attachable,proto3" json:"attachable,omitempty"`
+	Ingress        bool                   `protobuf:"varint,6,opt,name=ingress,proto3" json:"ingress,omitempty"`
+	Ipam           *IPAMConfig            `protobuf:"bytes,7,opt,name=ipam,proto3" json:"ipam,omitempty"`
+	EnableIpv6     bool                   `protobuf:"varint,8,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
+	Options        map[string]string      `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels         map[string]string      `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateNetworkRequest) Reset() {
+	*x = CreateNetworkRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNetworkRequest) ProtoMessage() {}
+
+func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNetworkRequest.ProtoReflect.Descriptor instead.
+func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateNetworkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetCheckDuplicate() bool {
+	if x != nil {
+		return x.CheckDuplicate
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetAttachable() bool {
+	if x != nil {
+		return x.Attachable
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetIngress() bool {
+	if x != nil {
+		return x.Ingress
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetIpam() *IPAMConfig {
+	if x != nil {
+		return x.Ipam
+	}
+	return nil
+}
+
+func (x *CreateNetworkRequest) GetEnableIpv6() bool {
+	if x != nil {
+		return x.EnableIpv6
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateNetworkRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Warning       string                 `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNetworkResponse) Reset() {
+	*x = CreateNetworkResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNetworkResponse) ProtoMessage() {}
+
+func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNetworkResponse.ProtoReflect.Descriptor instead.
+func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateNetworkResponse) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *CreateNetworkResponse) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+type DeleteNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNetworkRequest) Reset() {
+	*x = DeleteNetworkRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNetworkRequest) ProtoMessage() {}
+
+func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNetworkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteNetworkRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+type DeleteNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNetworkResponse) Reset() {
+	*x = DeleteNetworkResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNetworkResponse) ProtoMessage() {}
+
+func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNetworkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteNetworkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteNetworkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConnectNetworkRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId      string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ContainerId    string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	EndpointConfig *EndpointConfig        `protobuf:"bytes,3,opt,name=endpoint_config,json=endpointConfig,proto3" json:"endpoint_config,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConnectNetworkRequest) Reset() {
+	*x = ConnectNetworkRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectNetworkRequest) ProtoMessage() {}
+
+func (x *ConnectNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectNetworkRequest.ProtoReflect.Descriptor instead.
+func (*ConnectNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ConnectNetworkRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *ConnectNetworkRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ConnectNetworkRequest) GetEndpointConfig() *EndpointConfig {
+	if x != nil {
+		return x.EndpointConfig
+	}
+	return nil
+}
+
+type EndpointConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpamConfig    map[string]string      `protobuf:"bytes,1,rep,name=ipam_config,json=ipamConfig,proto3" json:"ipam_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Links         []string               `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndpointConfig) Reset() {
+	*x = EndpointConfig{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndpointConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointConfig) ProtoMessage() {}
+
+func (x *EndpointConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointConfig.ProtoReflect.Descriptor instead.
+func (*EndpointConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *EndpointConfig) GetIpamConfig() map[string]string {
+	if x != nil {
+		return x.IpamConfig
+	}
+	return nil
+}
+
+func (x *EndpointConfig) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *EndpointConfig) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type ConnectNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectNetworkResponse) Reset() {
+	*x = ConnectNetworkResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectNetworkResponse) ProtoMessage() {}
+
+func (x *ConnectNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectNetworkResponse.ProtoReflect.Descriptor instead.
+func (*ConnectNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ConnectNetworkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConnectNetworkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DisconnectNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectNetworkRequest) Reset() {
+	*x = DisconnectNetworkRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectNetworkRequest) ProtoMessage() {}
+
+func (x *DisconnectNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectNetworkRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DisconnectNetworkRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *DisconnectNetworkRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *DisconnectNetworkRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DisconnectNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectNetworkResponse) Reset() {
+	*x = DisconnectNetworkResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectNetworkResponse) ProtoMessage() {}
+
+func (x *DisconnectNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectNetworkResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DisconnectNetworkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DisconnectNetworkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetSystemInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoRequest) Reset() {
+	*x = GetSystemInfoRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoRequest) ProtoMessage() {}
+
+func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{104}
+}
+
+type GetSystemInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *SystemInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse) Reset() {
+	*x = GetSystemInfoResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetSystemInfoResponse) GetInfo() *SystemInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type SystemInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Containers         int32                  `protobuf:"varint,2,opt,name=containers,proto3" json:"containers,omitempty"`
+	ContainersRunning  int32                  `protobuf:"varint,3,opt,name=containers_running,json=containersRunning,proto3" json:"containers_running,omitempty"`
+	ContainersPaused   int32                  `protobuf:"varint,4,opt,name=containers_paused,json=containersPaused,proto3" json:"containers_paused,omitempty"`
+	ContainersStopped  int32                  `protobuf:"varint,5,opt,name=containers_stopped,json=containersStopped,proto3" json:"containers_stopped,omitempty"`
+	Images             int32                  `protobuf:"varint,6,opt,name=images,proto3" json:"images,omitempty"`
+	Driver             string                 `protobuf:"bytes,7,opt,name=driver,proto3" json:"driver,omitempty"`
+	DriverStatus       []*DriverStatus        `protobuf:"bytes,8,rep,name=driver_status,json=driverStatus,proto3" json:"driver_status,omitempty"`
+	DockerRootDir      string                 `protobuf:"bytes,9,opt,name=docker_root_dir,json=dockerRootDir,proto3" json:"docker_root_dir,omitempty"`
+	SystemStatus       map[string]string      `protobuf:"bytes,10,rep,name=system_status,json=systemStatus,proto3" json:"system_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Plugins            []*Plugin              `protobuf:"bytes,11,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	MemoryLimit        bool                   `protobuf:"varint,12,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	SwapLimit          bool                   `protobuf:"varint,13,opt,name=swap_limit,json=swapLimit,proto3" json:"swap_limit,omitempty"`
+	KernelMemory       bool                   `protobuf:"varint,14,opt,name=kernel_memory,json=kernelMemory,proto3" json:"kernel_memory,omitempty"`
+	CpuCfsPeriod       bool                   `protobuf:"varint,15,opt,name=cpu_cfs_period,json=cpuCfsPeriod,proto3" json:"cpu_cfs_period,omitempty"`
+	CpuCfsQuota        bool                   `protobuf:"varint,16,opt,name=cpu_cfs_quota,json=cpuCfsQuota,proto3" json:"cpu_cfs_quota,omitempty"`
+	CpuShares          bool                   `protobuf:"varint,17,opt,name=cpu_shares,json=cpuShares,proto3" json:"cpu_shares,omitempty"`
+	CpuSet             bool                   `protobuf:"varint,18,opt,name=cpu_set,json=cpuSet,proto3" json:"cpu_set,omitempty"`
+	PidsLimit          bool                   `protobuf:"varint,19,opt,name=pids_limit,json=pidsLimit,proto3" json:"pids_limit,omitempty"`
+	Ipv4Forwarding     bool                   `protobuf:"varint,20,opt,name=ipv4_forwarding,json=ipv4Forwarding,proto3" json:"ipv4_forwarding,omitempty"`
+	BridgeNfIptables   bool                   `protobuf:"varint,21,opt,name=bridge_nf_iptables,json=bridgeNfIptables,proto3" json:"bridge_nf_iptables,omitempty"`
+	BridgeNfIp6Tables  bool                   `protobuf:"varint,22,opt,name=bridge_nf_ip6tables,json=bridgeNfIp6tables,proto3" json:"bridge_nf_ip6tables,omitempty"`
+	Debug              bool                   `protobuf:"varint,23,opt,name=debug,proto3" json:"debug,omitempty"`
+	NFd                int32                  `protobuf:"varint,24,opt,name=n_fd,json=nFd,proto3" json:"n_fd,omitempty"`
+	OomKillDisable     bool                   `protobuf:"varint,25,opt,name=oom_kill_disable,json=oomKillDisable,proto3" json:"oom_kill_disable,omitempty"`
+	NGoroutines        int32                  `protobuf:"varint,26,opt,name=n_goroutines,json=nGoroutines,proto3" json:"n_goroutines,omitempty"`
+	SystemTime         string                 `protobuf:"bytes,27,opt,name=system_time,json=systemTime,proto3" json:"system_time,omitempty"`
+	LoggingDriver      string                 `protobuf:"bytes,28,opt,name=logging_driver,json=loggingDriver,proto3" json:"logging_driver,omitempty"`
+	CgroupDriver       string                 `protobuf:"bytes,29,opt,name=cgroup_driver,json=cgroupDriver,proto3" json:"cgroup_driver,omitempty"`
+	NEventsListener    int32                  `protobuf:"varint,30,opt,name=n_events_listener,json=nEventsListener,proto3" json:"n_events_listener,omitempty"`
+	KernelVersion      string                 `protobuf:"bytes,31,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	OperatingSystem    string                 `protobuf:"bytes,32,opt,name=operating_system,json=operatingSystem,proto3" json:"operating_system,omitempty"`
+	OsType             string                 `protobuf:"bytes,33,opt,name=os_type,json=osType,proto3" json:"os_type,omitempty"`
+	Architecture       string                 `protobuf:"bytes,34,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Ncpu               int32                  `protobuf:"varint,35,opt,name=ncpu,proto3" json:"ncpu,omitempty"`
+	MemTotal           int64                  `protobuf:"varint,36,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`
+	IndexServerAddress string                 `protobuf:"bytes,37,opt,name=index_server_address,json=indexServerAddress,proto3" json:"index_server_address,omitempty"`
+	RegistryConfig     *RegistryConfig        `protobuf:"bytes,38,opt,name=registry_config,json=registryConfig,proto3" json:"registry_config,omitempty"`
+	Warnings           []string               `protobuf:"bytes,39,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Swarm              *SwarmInfo             `protobuf:"bytes,40,opt,name=swarm,proto3" json:"swarm,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SystemInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetContainers() int32 {
+	if x != nil {
+		return x.Containers
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetContainersRunning() int32 {
+	if x != nil {
+		return x.ContainersRunning
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetContainersPaused() int32 {
+	if x != nil {
+		return x.ContainersPaused
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetContainersStopped() int32 {
+	if x != nil {
+		return x.ContainersStopped
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetImages() int32 {
+	if x != nil {
+		return x.Images
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetDriverStatus() []*DriverStatus {
+	if x != nil {
+		return x.DriverStatus
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetDockerRootDir() string {
+	if x != nil {
+		return x.DockerRootDir
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetSystemStatus() map[string]string {
+	if x != nil {
+		return x.SystemStatus
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetPlugins() []*Plugin {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetMemoryLimit() bool {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return false
+}
+
+func (x *SystemInfo) GetSwapLimit() bool {
+	if x != nil {
+		return x.SwapLimit
+	}
+	return false
+}
+
+func (x *SystemInfo) GetKernelMemory() bool {
+	if x != nil {
+		return x.KernelMemory
+	}
+	return false
+}
+
+func (x *SystemInfo) GetCpuCfsPeriod() bool {
+	if x != nil {
+		return x.CpuCfsPeriod
+	}
+	return false
+}
+
+func (x *SystemInfo) GetCpuCfsQuota() bool {
+	if x != nil {
+		return x.CpuCfsQuota
+	}
+	return false
+}
+
+func (x *SystemInfo) GetCpuShares() bool {
+	if x != nil {
+		return x.CpuShares
+	}
+	return false
+}
+
+func (x *SystemInfo) GetCpuSet() bool {
+	if x != nil {
+		return x.CpuSet
+	}
+	return false
+}
+
+func (x *SystemInfo) GetPidsLimit() bool {
+	if x != nil {
+		return x.PidsLimit
+	}
+	return false
+}
+
+func (x *SystemInfo) GetIpv4Forwarding() bool {
+	if x != nil {
+		return x.Ipv4Forwarding
+	}
+	return false
+}
+
+func (x *SystemInfo) GetBridgeNfIptables() bool {
+	if x != nil {
+		return x.BridgeNfIptables
+	}
+	return false
+}
+
+func (x *SystemInfo) GetBridgeNfIp6Tables() bool {
+	if x != nil {
+		return x.BridgeNfIp6Tables
+	}
+	return false
+}
+
+func (x *SystemInfo) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
+}
+
+func (x *SystemInfo) GetNFd() int32 {
+	if x != nil {
+		return x.NFd
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetOomKillDisable() bool {
+	if x != nil {
+		return x.OomKillDisable
+	}
+	return false
+}
+
+func (x *SystemInfo) GetNGoroutines() int32 {
+	if x != nil {
+		return x.NGoroutines
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetSystemTime() string {
+	if x != nil {
+		return x.SystemTime
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetLoggingDriver() string {
+	if x != nil {
+		return x.LoggingDriver
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetCgroupDriver() string {
+	if x != nil {
+		return x.CgroupDriver
+	}
 	return ""
 }
 
-func (x *PullImageRequest) GetRegistryAuth() string {
+func (x *SystemInfo) GetNEventsListener() int32 {
 	if x != nil {
-		return x.RegistryAuth
+		return x.NEventsListener
 	}
-	return ""
+	return 0
 }
 
-type PullImageProgress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`     // Status message
-	Progress      string                 `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"` // Progress bar
-	Current       int64                  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`  // Current bytes
-	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`      // Total bytes
-	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`             // Layer ID
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SystemInfo) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
 }
 
-func (x *PullImageProgress) Reset() {
-	*x = PullImageProgress{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SystemInfo) GetOperatingSystem() string {
+	if x != nil {
+		return x.OperatingSystem
+	}
+	return ""
 }
 
-func (x *PullImageProgress) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SystemInfo) GetOsType() string {
+	if x != nil {
+		return x.OsType
+	}
+	return ""
 }
 
-func (*PullImageProgress) ProtoMessage() {}
-
-func (x *PullImageProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[58]
+func (x *SystemInfo) GetArchitecture() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Architecture
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PullImageProgress.ProtoReflect.Descriptor instead.
-func (*PullImageProgress) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{58}
+func (x *SystemInfo) GetNcpu() int32 {
+	if x != nil {
+		return x.Ncpu
+	}
+	return 0
 }
 
-func (x *PullImageProgress) GetStatus() string {
+func (x *SystemInfo) GetMemTotal() int64 {
 	if x != nil {
-		return x.Status
+		return x.MemTotal
 	}
-	return ""
+	return 0
 }
 
-func (x *PullImageProgress) GetProgress() string {
+func (x *SystemInfo) GetIndexServerAddress() string {
 	if x != nil {
-		return x.Progress
+		return x.IndexServerAddress
 	}
 	return ""
 }
 
-func (x *PullImageProgress) GetCurrent() int64 {
+func (x *SystemInfo) GetRegistryConfig() *RegistryConfig {
 	if x != nil {
-		return x.Current
+		return x.RegistryConfig
 	}
-	return 0
+	return nil
 }
 
-func (x *PullImageProgress) GetTotal() int64 {
+func (x *SystemInfo) GetWarnings() []string {
 	if x != nil {
-		return x.Total
+		return x.Warnings
 	}
-	return 0
+	return nil
 }
 
-func (x *PullImageProgress) GetId() string {
+func (x *SystemInfo) GetSwarm() *SwarmInfo {
 	if x != nil {
-		return x.Id
+		return x.Swarm
 	}
-	return ""
+	return nil
 }
 
-type TagImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // Source image
-	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // Target tag (repo:tag)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SwarmInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NodeId           string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeAddr         string                 `protobuf:"bytes,2,opt,name=node_addr,json=nodeAddr,proto3" json:"node_addr,omitempty"`
+	LocalNodeState   string                 `protobuf:"bytes,3,opt,name=local_node_state,json=localNodeState,proto3" json:"local_node_state,omitempty"`      // inactive, pending, active, error, locked
+	ControlAvailable bool                   `protobuf:"varint,4,opt,name=control_available,json=controlAvailable,proto3" json:"control_available,omitempty"` // Whether the node is a swarm manager
+	ClusterId        string                 `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Nodes            int32                  `protobuf:"varint,6,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Managers         int32                  `protobuf:"varint,7,opt,name=managers,proto3" json:"managers,omitempty"`
+	Error            string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *TagImageRequest) Reset() {
-	*x = TagImageRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[59]
+func (x *SwarmInfo) Reset() {
+	*x = SwarmInfo{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagImageRequest) String() string {
+func (x *SwarmInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagImageRequest) ProtoMessage() {}
+func (*SwarmInfo) ProtoMessage() {}
 
-func (x *TagImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[59]
+func (x *SwarmInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4476,98 +7657,90 @@ func (x *TagImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagImageRequest.ProtoReflect.Descriptor instead.
-func (*TagImageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use SwarmInfo.ProtoReflect.Descriptor instead.
+func (*SwarmInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *TagImageRequest) GetSource() string {
+func (x *SwarmInfo) GetNodeId() string {
 	if x != nil {
-		return x.Source
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *TagImageRequest) GetTarget() string {
+func (x *SwarmInfo) GetNodeAddr() string {
 	if x != nil {
-		return x.Target
+		return x.NodeAddr
 	}
 	return ""
 }
 
-type TagImageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TagImageResponse) Reset() {
-	*x = TagImageResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SwarmInfo) GetLocalNodeState() string {
+	if x != nil {
+		return x.LocalNodeState
+	}
+	return ""
 }
 
-func (x *TagImageResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwarmInfo) GetControlAvailable() bool {
+	if x != nil {
+		return x.ControlAvailable
+	}
+	return false
 }
 
-func (*TagImageResponse) ProtoMessage() {}
-
-func (x *TagImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[60]
+func (x *SwarmInfo) GetClusterId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClusterId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TagImageResponse.ProtoReflect.Descriptor instead.
-func (*TagImageResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{60}
+func (x *SwarmInfo) GetNodes() int32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
 }
 
-func (x *TagImageResponse) GetSuccess() bool {
+func (x *SwarmInfo) GetManagers() int32 {
 	if x != nil {
-		return x.Success
+		return x.Managers
 	}
-	return false
+	return 0
 }
 
-func (x *TagImageResponse) GetMessage() string {
+func (x *SwarmInfo) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-type ListVolumesRequest struct {
+type Plugin struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVolumesRequest) Reset() {
-	*x = ListVolumesRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[61]
+func (x *Plugin) Reset() {
+	*x = Plugin{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVolumesRequest) String() string {
+func (x *Plugin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVolumesRequest) ProtoMessage() {}
+func (*Plugin) ProtoMessage() {}
 
-func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[61]
+func (x *Plugin) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4578,34 +7751,48 @@ func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
-func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use Plugin.ProtoReflect.Descriptor instead.
+func (*Plugin) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{108}
 }
 
-type ListVolumesResponse struct {
+func (x *Plugin) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Plugin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DriverStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVolumesResponse) Reset() {
-	*x = ListVolumesResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[62]
+func (x *DriverStatus) Reset() {
+	*x = DriverStatus{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVolumesResponse) String() string {
+func (x *DriverStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVolumesResponse) ProtoMessage() {}
+func (*DriverStatus) ProtoMessage() {}
 
-func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[62]
+func (x *DriverStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4616,55 +7803,49 @@ func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
-func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use DriverStatus.ProtoReflect.Descriptor instead.
+func (*DriverStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ListVolumesResponse) GetVolumes() []*Volume {
+func (x *DriverStatus) GetName() string {
 	if x != nil {
-		return x.Volumes
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListVolumesResponse) GetWarnings() []string {
+func (x *DriverStatus) GetValue() string {
 	if x != nil {
-		return x.Warnings
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type Volume struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
-	Mountpoint    string                 `protobuf:"bytes,3,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Scope         string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
-	Options       map[string]string      `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UsageData     *VolumeUsageData       `protobuf:"bytes,9,opt,name=usage_data,json=usageData,proto3" json:"usage_data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RegistryConfig struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	InsecureRegistryCidrs []string               `protobuf:"bytes,1,rep,name=insecure_registry_cidrs,json=insecureRegistryCidrs,proto3" json:"insecure_registry_cidrs,omitempty"`
+	IndexConfigs          []string               `protobuf:"bytes,2,rep,name=index_configs,json=indexConfigs,proto3" json:"index_configs,omitempty"`
+	Mirrors               []string               `protobuf:"bytes,3,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *Volume) Reset() {
-	*x = Volume{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[63]
+func (x *RegistryConfig) Reset() {
+	*x = RegistryConfig{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Volume) String() string {
+func (x *RegistryConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Volume) ProtoMessage() {}
+func (*RegistryConfig) ProtoMessage() {}
 
-func (x *Volume) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[63]
+func (x *RegistryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4675,97 +7856,90 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
-func (*Volume) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use RegistryConfig.ProtoReflect.Descriptor instead.
+func (*RegistryConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *Volume) GetName() string {
+func (x *RegistryConfig) GetInsecureRegistryCidrs() []string {
 	if x != nil {
-		return x.Name
+		return x.InsecureRegistryCidrs
 	}
-	return ""
+	return nil
 }
 
-func (x *Volume) GetDriver() string {
+func (x *RegistryConfig) GetIndexConfigs() []string {
 	if x != nil {
-		return x.Driver
+		return x.IndexConfigs
 	}
-	return ""
+	return nil
 }
 
-func (x *Volume) GetMountpoint() string {
+func (x *RegistryConfig) GetMirrors() []string {
 	if x != nil {
-		return x.Mountpoint
+		return x.Mirrors
 	}
-	return ""
+	return nil
 }
 
-func (x *Volume) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
+type GetVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Volume) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Volume) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+func (x *GetVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Volume) GetScope() string {
-	if x != nil {
-		return x.Scope
-	}
-	return ""
-}
+func (*GetVersionRequest) ProtoMessage() {}
 
-func (x *Volume) GetOptions() map[string]string {
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[111]
 	if x != nil {
-		return x.Options
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Volume) GetUsageData() *VolumeUsageData {
-	if x != nil {
-		return x.UsageData
-	}
-	return nil
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{111}
 }
 
-type VolumeUsageData struct {
+type GetVersionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Size          int64                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`                         // Size in bytes
-	RefCount      int64                  `protobuf:"varint,2,opt,name=ref_count,json=refCount,proto3" json:"ref_count,omitempty"` // Number of containers using this volume
+	Version       *VersionInfo           `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeUsageData) Reset() {
-	*x = VolumeUsageData{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[64]
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeUsageData) String() string {
+func (x *GetVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeUsageData) ProtoMessage() {}
+func (*GetVersionResponse) ProtoMessage() {}
 
-func (x *VolumeUsageData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[64]
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4776,47 +7950,49 @@ func (x *VolumeUsageData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeUsageData.ProtoReflect.Descriptor instead.
-func (*VolumeUsageData) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *VolumeUsageData) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *VolumeUsageData) GetRefCount() int64 {
+func (x *GetVersionResponse) GetVersion() *VersionInfo {
 	if x != nil {
-		return x.RefCount
+		return x.Version
 	}
-	return 0
+	return nil
 }
 
-type GetVolumeRequest struct {
+type VersionInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	ApiVersion    string                 `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	MinApiVersion string                 `protobuf:"bytes,3,opt,name=min_api_version,json=minApiVersion,proto3" json:"min_api_version,omitempty"`
+	GitCommit     string                 `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	GoVersion     string                 `protobuf:"bytes,5,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Os            string                 `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`
+	Arch          string                 `protobuf:"bytes,7,opt,name=arch,proto3" json:"arch,omitempty"`
+	KernelVersion string                 `protobuf:"bytes,8,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	BuildTime     string                 `protobuf:"bytes,9,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`
+	Components    []*ComponentVersion    `protobuf:"bytes,10,rep,name=components,proto3" json:"components,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVolumeRequest) Reset() {
-	*x = GetVolumeRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[65]
+func (x *VersionInfo) Reset() {
+	*x = VersionInfo{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVolumeRequest) String() string {
+func (x *VersionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolumeRequest) ProtoMessage() {}
+func (*VersionInfo) ProtoMessage() {}
 
-func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[65]
+func (x *VersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,87 +8003,105 @@ func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
-func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
+func (*VersionInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetVolumeRequest) GetName() string {
+func (x *VersionInfo) GetVersion() string {
 	if x != nil {
-		return x.Name
+		return x.Version
 	}
 	return ""
 }
 
-type GetVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *VersionInfo) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
 }
 
-func (x *GetVolumeResponse) Reset() {
-	*x = GetVolumeResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[66]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *VersionInfo) GetMinApiVersion() string {
+	if x != nil {
+		return x.MinApiVersion
+	}
+	return ""
 }
 
-func (x *GetVolumeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *VersionInfo) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
 }
 
-func (*GetVolumeResponse) ProtoMessage() {}
+func (x *VersionInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
 
-func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[66]
+func (x *VersionInfo) GetOs() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Os
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
-func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{66}
+func (x *VersionInfo) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
 }
 
-func (x *GetVolumeResponse) GetVolume() *Volume {
+func (x *VersionInfo) GetKernelVersion() string {
 	if x != nil {
-		return x.Volume
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *VersionInfo) GetBuildTime() string {
+	if x != nil {
+		return x.BuildTime
+	}
+	return ""
+}
+
+func (x *VersionInfo) GetComponents() []*ComponentVersion {
+	if x != nil {
+		return x.Components
 	}
 	return nil
 }
 
-type CreateVolumeRequest struct {
+type ComponentVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // Volume driver (default: "local")
-	DriverOpts    map[string]string      `protobuf:"bytes,3,rep,name=driver_opts,json=driverOpts,proto3" json:"driver_opts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeRequest) Reset() {
-	*x = CreateVolumeRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[67]
+func (x *ComponentVersion) Reset() {
+	*x = ComponentVersion{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeRequest) String() string {
+func (x *ComponentVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeRequest) ProtoMessage() {}
+func (*ComponentVersion) ProtoMessage() {}
 
-func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[67]
+func (x *ComponentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4918,61 +8112,53 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use ComponentVersion.ProtoReflect.Descriptor instead.
+func (*ComponentVersion) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *CreateVolumeRequest) GetName() string {
+func (x *ComponentVersion) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateVolumeRequest) GetDriver() string {
-	if x != nil {
-		return x.Driver
-	}
-	return ""
-}
-
-func (x *CreateVolumeRequest) GetDriverOpts() map[string]string {
+func (x *ComponentVersion) GetVersion() string {
 	if x != nil {
-		return x.DriverOpts
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateVolumeRequest) GetLabels() map[string]string {
+func (x *ComponentVersion) GetDetails() map[string]string {
 	if x != nil {
-		return x.Labels
+		return x.Details
 	}
 	return nil
 }
 
-type CreateVolumeResponse struct {
+type GetDiskUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeResponse) Reset() {
-	*x = CreateVolumeResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[68]
+func (x *GetDiskUsageRequest) Reset() {
+	*x = GetDiskUsageRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeResponse) String() string {
+func (x *GetDiskUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeResponse) ProtoMessage() {}
+func (*GetDiskUsageRequest) ProtoMessage() {}
 
-func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[68]
+func (x *GetDiskUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4983,41 +8169,33 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *CreateVolumeResponse) GetVolume() *Volume {
-	if x != nil {
-		return x.Volume
-	}
-	return nil
+// Deprecated: Use GetDiskUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{115}
 }
 
-type DeleteVolumeRequest struct {
+type GetDiskUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // Force remove volume
+	Usage         *DiskUsage             `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVolumeRequest) Reset() {
-	*x = DeleteVolumeRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[69]
+func (x *GetDiskUsageResponse) Reset() {
+	*x = GetDiskUsageResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVolumeRequest) String() string {
+func (x *GetDiskUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeRequest) ProtoMessage() {}
+func (*GetDiskUsageResponse) ProtoMessage() {}
 
-func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[69]
+func (x *GetDiskUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5028,48 +8206,42 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *DeleteVolumeRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetDiskUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *DeleteVolumeRequest) GetForce() bool {
+func (x *GetDiskUsageResponse) GetUsage() *DiskUsage {
 	if x != nil {
-		return x.Force
+		return x.Usage
 	}
-	return false
+	return nil
 }
 
-type DeleteVolumeResponse struct {
+type PruneBuildCacheRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`                                            // Remove all unused cache, not only dangling records
+	OlderThanDays int32                  `protobuf:"varint,2,opt,name=older_than_days,json=olderThanDays,proto3" json:"older_than_days,omitempty"` // Only cache unused for more than N days (0 = any age)
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Report what would be removed without removing it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVolumeResponse) Reset() {
-	*x = DeleteVolumeResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[70]
+func (x *PruneBuildCacheRequest) Reset() {
+	*x = PruneBuildCacheRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVolumeResponse) String() string {
+func (x *PruneBuildCacheRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeResponse) ProtoMessage() {}
+func (*PruneBuildCacheRequest) ProtoMessage() {}
 
-func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[70]
+func (x *PruneBuildCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5080,47 +8252,56 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use PruneBuildCacheRequest.ProtoReflect.Descriptor instead.
+func (*PruneBuildCacheRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *DeleteVolumeResponse) GetSuccess() bool {
+func (x *PruneBuildCacheRequest) GetAll() bool {
 	if x != nil {
-		return x.Success
+		return x.All
 	}
 	return false
 }
 
-func (x *DeleteVolumeResponse) GetMessage() string {
+func (x *PruneBuildCacheRequest) GetOlderThanDays() int32 {
 	if x != nil {
-		return x.Message
+		return x.OlderThanDays
 	}
-	return ""
+	return 0
 }
 
-type PruneVolumesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filters       map[string]string      `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Optional filters
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PruneBuildCacheRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (x *PruneVolumesRequest) Reset() {
-	*x = PruneVolumesRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[71]
+type PruneBuildCacheResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CachesDeleted  []string               `protobuf:"bytes,1,rep,name=caches_deleted,json=cachesDeleted,proto3" json:"caches_deleted,omitempty"`
+	SpaceReclaimed uint64                 `protobuf:"varint,2,opt,name=space_reclaimed,json=spaceReclaimed,proto3" json:"space_reclaimed,omitempty"` // Space reclaimed (or reclaimable on dry run) in bytes
+	DryRun         bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PruneBuildCacheResponse) Reset() {
+	*x = PruneBuildCacheResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PruneVolumesRequest) String() string {
+func (x *PruneBuildCacheResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PruneVolumesRequest) ProtoMessage() {}
+func (*PruneBuildCacheResponse) ProtoMessage() {}
 
-func (x *PruneVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[71]
+func (x *PruneBuildCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5131,41 +8312,58 @@ func (x *PruneVolumesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PruneVolumesRequest.ProtoReflect.Descriptor instead.
-func (*PruneVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use PruneBuildCacheResponse.ProtoReflect.Descriptor instead.
+func (*PruneBuildCacheResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *PruneVolumesRequest) GetFilters() map[string]string {
+func (x *PruneBuildCacheResponse) GetCachesDeleted() []string {
 	if x != nil {
-		return x.Filters
+		return x.CachesDeleted
 	}
 	return nil
 }
 
-type PruneVolumesResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	VolumesDeleted []string               `protobuf:"bytes,1,rep,name=volumes_deleted,json=volumesDeleted,proto3" json:"volumes_deleted,omitempty"`
-	SpaceReclaimed uint64                 `protobuf:"varint,2,opt,name=space_reclaimed,json=spaceReclaimed,proto3" json:"space_reclaimed,omitempty"` // Space reclaimed in bytes
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PruneBuildCacheResponse) GetSpaceReclaimed() uint64 {
+	if x != nil {
+		return x.SpaceReclaimed
+	}
+	return 0
 }
 
-func (x *PruneVolumesResponse) Reset() {
-	*x = PruneVolumesResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[72]
+func (x *PruneBuildCacheResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DiskUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ImageSummary        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Containers    []*ContainerSummary    `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	Volumes       []*VolumeSummary       `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	BuildCache    []*BuildCacheSummary   `protobuf:"bytes,4,rep,name=build_cache,json=buildCache,proto3" json:"build_cache,omitempty"`
+	LayersSize    int64                  `protobuf:"varint,5,opt,name=layers_size,json=layersSize,proto3" json:"layers_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PruneVolumesResponse) String() string {
+func (x *DiskUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PruneVolumesResponse) ProtoMessage() {}
+func (*DiskUsage) ProtoMessage() {}
 
-func (x *PruneVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[72]
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5176,47 +8374,74 @@ func (x *PruneVolumesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PruneVolumesResponse.ProtoReflect.Descriptor instead.
-func (*PruneVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *PruneVolumesResponse) GetVolumesDeleted() []string {
+func (x *DiskUsage) GetImages() []*ImageSummary {
 	if x != nil {
-		return x.VolumesDeleted
+		return x.Images
 	}
 	return nil
 }
 
-func (x *PruneVolumesResponse) GetSpaceReclaimed() uint64 {
+func (x *DiskUsage) GetContainers() []*ContainerSummary {
 	if x != nil {
-		return x.SpaceReclaimed
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *DiskUsage) GetVolumes() []*VolumeSummary {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *DiskUsage) GetBuildCache() []*BuildCacheSummary {
+	if x != nil {
+		return x.BuildCache
+	}
+	return nil
+}
+
+func (x *DiskUsage) GetLayersSize() int64 {
+	if x != nil {
+		return x.LayersSize
 	}
 	return 0
 }
 
-type ListNetworksRequest struct {
+type ImageSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filters       string                 `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"` // Docker filters in JSON format
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoTags      []string               `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
+	Created       int64                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	SharedSize    int64                  `protobuf:"varint,5,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
+	VirtualSize   int64                  `protobuf:"varint,6,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
+	Containers    int32                  `protobuf:"varint,7,opt,name=containers,proto3" json:"containers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNetworksRequest) Reset() {
-	*x = ListNetworksRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[73]
+func (x *ImageSummary) Reset() {
+	*x = ImageSummary{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworksRequest) String() string {
+func (x *ImageSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworksRequest) ProtoMessage() {}
+func (*ImageSummary) ProtoMessage() {}
 
-func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[73]
+func (x *ImageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5227,96 +8452,91 @@ func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
-func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ImageSummary.ProtoReflect.Descriptor instead.
+func (*ImageSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *ListNetworksRequest) GetFilters() string {
+func (x *ImageSummary) GetId() string {
 	if x != nil {
-		return x.Filters
+		return x.Id
 	}
 	return ""
 }
 
-type ListNetworksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Networks      []*Network             `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImageSummary) GetRepoTags() []string {
+	if x != nil {
+		return x.RepoTags
+	}
+	return nil
 }
 
-func (x *ListNetworksResponse) Reset() {
-	*x = ListNetworksResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[74]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ImageSummary) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
 }
 
-func (x *ListNetworksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImageSummary) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (*ListNetworksResponse) ProtoMessage() {}
-
-func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[74]
+func (x *ImageSummary) GetSharedSize() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SharedSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
-func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{74}
+func (x *ImageSummary) GetVirtualSize() int64 {
+	if x != nil {
+		return x.VirtualSize
+	}
+	return 0
 }
 
-func (x *ListNetworksResponse) GetNetworks() []*Network {
+func (x *ImageSummary) GetContainers() int32 {
 	if x != nil {
-		return x.Networks
+		return x.Containers
 	}
-	return nil
+	return 0
 }
 
-type Network struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Id            string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Created       int64                        `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
-	Scope         string                       `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"` // local, swarm, global
-	Driver        string                       `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
-	EnableIpv6    bool                         `protobuf:"varint,6,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
-	Ipam          *IPAMConfig                  `protobuf:"bytes,7,opt,name=ipam,proto3" json:"ipam,omitempty"`
-	Internal      bool                         `protobuf:"varint,8,opt,name=internal,proto3" json:"internal,omitempty"`
-	Attachable    bool                         `protobuf:"varint,9,opt,name=attachable,proto3" json:"attachable,omitempty"`
-	Ingress       bool                         `protobuf:"varint,10,opt,name=ingress,proto3" json:"ingress,omitempty"`
-	Containers    map[string]*NetworkContainer `protobuf:"bytes,11,rep,name=containers,proto3" json:"containers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Options       map[string]string            `protobuf:"bytes,12,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Labels        map[string]string            `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type ContainerSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ImageId       string                 `protobuf:"bytes,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Command       string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	Created       int64                  `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	SizeRw        int64                  `protobuf:"varint,9,opt,name=size_rw,json=sizeRw,proto3" json:"size_rw,omitempty"`
+	SizeRootFs    int64                  `protobuf:"varint,10,opt,name=size_root_fs,json=sizeRootFs,proto3" json:"size_root_fs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Network) Reset() {
-	*x = Network{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[75]
+func (x *ContainerSummary) Reset() {
+	*x = ContainerSummary{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Network) String() string {
+func (x *ContainerSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Network) ProtoMessage() {}
+func (*ContainerSummary) ProtoMessage() {}
 
-func (x *Network) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[75]
+func (x *ContainerSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5327,126 +8547,107 @@ func (x *Network) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Network.ProtoReflect.Descriptor instead.
-func (*Network) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ContainerSummary.ProtoReflect.Descriptor instead.
+func (*ContainerSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *Network) GetId() string {
+func (x *ContainerSummary) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Network) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Network) GetCreated() int64 {
+func (x *ContainerSummary) GetNames() []string {
 	if x != nil {
-		return x.Created
+		return x.Names
 	}
-	return 0
+	return nil
 }
 
-func (x *Network) GetScope() string {
+func (x *ContainerSummary) GetImage() string {
 	if x != nil {
-		return x.Scope
+		return x.Image
 	}
 	return ""
 }
 
-func (x *Network) GetDriver() string {
+func (x *ContainerSummary) GetImageId() string {
 	if x != nil {
-		return x.Driver
+		return x.ImageId
 	}
 	return ""
 }
 
-func (x *Network) GetEnableIpv6() bool {
-	if x != nil {
-		return x.EnableIpv6
-	}
-	return false
-}
-
-func (x *Network) GetIpam() *IPAMConfig {
-	if x != nil {
-		return x.Ipam
-	}
-	return nil
-}
-
-func (x *Network) GetInternal() bool {
+func (x *ContainerSummary) GetCommand() string {
 	if x != nil {
-		return x.Internal
+		return x.Command
 	}
-	return false
+	return ""
 }
 
-func (x *Network) GetAttachable() bool {
+func (x *ContainerSummary) GetCreated() int64 {
 	if x != nil {
-		return x.Attachable
+		return x.Created
 	}
-	return false
+	return 0
 }
 
-func (x *Network) GetIngress() bool {
+func (x *ContainerSummary) GetState() string {
 	if x != nil {
-		return x.Ingress
+		return x.State
 	}
-	return false
+	return ""
 }
 
-func (x *Network) GetContainers() map[string]*NetworkContainer {
+func (x *ContainerSummary) GetStatus() string {
 	if x != nil {
-		return x.Containers
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *Network) GetOptions() map[string]string {
+func (x *ContainerSummary) GetSizeRw() int64 {
 	if x != nil {
-		return x.Options
+		return x.SizeRw
 	}
-	return nil
+	return 0
 }
 
-func (x *Network) GetLabels() map[string]string {
+func (x *ContainerSummary) GetSizeRootFs() int64 {
 	if x != nil {
-		return x.Labels
+		return x.SizeRootFs
 	}
-	return nil
+	return 0
 }
 
-type IPAMConfig struct {
+type VolumeSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Config        []*IPAMPool            `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty"`
-	Options       map[string]string      `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Mountpoint    string                 `protobuf:"bytes,3,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	UsageRefCount int64                  `protobuf:"varint,4,opt,name=usage_ref_count,json=usageRefCount,proto3" json:"usage_ref_count,omitempty"`
+	UsageSize     int64                  `protobuf:"varint,5,opt,name=usage_size,json=usageSize,proto3" json:"usage_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IPAMConfig) Reset() {
-	*x = IPAMConfig{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[76]
+func (x *VolumeSummary) Reset() {
+	*x = VolumeSummary{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IPAMConfig) String() string {
+func (x *VolumeSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPAMConfig) ProtoMessage() {}
+func (*VolumeSummary) ProtoMessage() {}
 
-func (x *IPAMConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[76]
+func (x *VolumeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5457,57 +8658,77 @@ func (x *IPAMConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPAMConfig.ProtoReflect.Descriptor instead.
-func (*IPAMConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use VolumeSummary.ProtoReflect.Descriptor instead.
+func (*VolumeSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *IPAMConfig) GetDriver() string {
+func (x *VolumeSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeSummary) GetDriver() string {
 	if x != nil {
 		return x.Driver
 	}
 	return ""
 }
 
-func (x *IPAMConfig) GetConfig() []*IPAMPool {
+func (x *VolumeSummary) GetMountpoint() string {
 	if x != nil {
-		return x.Config
+		return x.Mountpoint
 	}
-	return nil
+	return ""
 }
 
-func (x *IPAMConfig) GetOptions() map[string]string {
+func (x *VolumeSummary) GetUsageRefCount() int64 {
 	if x != nil {
-		return x.Options
+		return x.UsageRefCount
 	}
-	return nil
+	return 0
 }
 
-type IPAMPool struct {
+func (x *VolumeSummary) GetUsageSize() int64 {
+	if x != nil {
+		return x.UsageSize
+	}
+	return 0
+}
+
+type BuildCacheSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subnet        string                 `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
-	IpRange       string                 `protobuf:"bytes,2,opt,name=ip_range,json=ipRange,proto3" json:"ip_range,omitempty"`
-	Gateway       string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	AuxAddresses  map[string]string      `protobuf:"bytes,4,rep,name=aux_addresses,json=auxAddresses,proto3" json:"aux_addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Parent        string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	InUse         bool                   `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Shared        bool                   `protobuf:"varint,6,opt,name=shared,proto3" json:"shared,omitempty"`
+	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    int64                  `protobuf:"varint,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	UsageCount    int64                  `protobuf:"varint,10,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IPAMPool) Reset() {
-	*x = IPAMPool{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[77]
+func (x *BuildCacheSummary) Reset() {
+	*x = BuildCacheSummary{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IPAMPool) String() string {
+func (x *BuildCacheSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPAMPool) ProtoMessage() {}
+func (*BuildCacheSummary) ProtoMessage() {}
 
-func (x *IPAMPool) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[77]
+func (x *BuildCacheSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5518,65 +8739,102 @@ func (x *IPAMPool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPAMPool.ProtoReflect.Descriptor instead.
-func (*IPAMPool) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use BuildCacheSummary.ProtoReflect.Descriptor instead.
+func (*BuildCacheSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *BuildCacheSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BuildCacheSummary) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *BuildCacheSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BuildCacheSummary) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BuildCacheSummary) GetInUse() bool {
+	if x != nil {
+		return x.InUse
+	}
+	return false
+}
+
+func (x *BuildCacheSummary) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
 }
 
-func (x *IPAMPool) GetSubnet() string {
+func (x *BuildCacheSummary) GetSize() int64 {
 	if x != nil {
-		return x.Subnet
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *IPAMPool) GetIpRange() string {
+func (x *BuildCacheSummary) GetCreatedAt() int64 {
 	if x != nil {
-		return x.IpRange
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *IPAMPool) GetGateway() string {
+func (x *BuildCacheSummary) GetLastUsedAt() int64 {
 	if x != nil {
-		return x.Gateway
+		return x.LastUsedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *IPAMPool) GetAuxAddresses() map[string]string {
+func (x *BuildCacheSummary) GetUsageCount() int64 {
 	if x != nil {
-		return x.AuxAddresses
+		return x.UsageCount
 	}
-	return nil
+	return 0
 }
 
-type NetworkContainer struct {
+type PingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	EndpointId    string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
-	MacAddress    string                 `protobuf:"bytes,3,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	Ipv4Address   string                 `protobuf:"bytes,4,opt,name=ipv4_address,json=ipv4Address,proto3" json:"ipv4_address,omitempty"`
-	Ipv6Address   string                 `protobuf:"bytes,5,opt,name=ipv6_address,json=ipv6Address,proto3" json:"ipv6_address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkContainer) Reset() {
-	*x = NetworkContainer{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[78]
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkContainer) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkContainer) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *NetworkContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[78]
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5587,70 +8845,36 @@ func (x *NetworkContainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkContainer.ProtoReflect.Descriptor instead.
-func (*NetworkContainer) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *NetworkContainer) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *NetworkContainer) GetEndpointId() string {
-	if x != nil {
-		return x.EndpointId
-	}
-	return ""
-}
-
-func (x *NetworkContainer) GetMacAddress() string {
-	if x != nil {
-		return x.MacAddress
-	}
-	return ""
-}
-
-func (x *NetworkContainer) GetIpv4Address() string {
-	if x != nil {
-		return x.Ipv4Address
-	}
-	return ""
-}
-
-func (x *NetworkContainer) GetIpv6Address() string {
-	if x != nil {
-		return x.Ipv6Address
-	}
-	return ""
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{124}
 }
 
-type GetNetworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"` // Network ID or name
-	Verbose       bool                   `protobuf:"varint,2,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PingResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion     string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	OsType         string                 `protobuf:"bytes,2,opt,name=os_type,json=osType,proto3" json:"os_type,omitempty"`
+	Experimental   bool                   `protobuf:"varint,3,opt,name=experimental,proto3" json:"experimental,omitempty"`
+	BuilderVersion string                 `protobuf:"bytes,4,opt,name=builder_version,json=builderVersion,proto3" json:"builder_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetNetworkRequest) Reset() {
-	*x = GetNetworkRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[79]
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkRequest) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkRequest) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *GetNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[79]
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5661,54 +8885,63 @@ func (x *GetNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkRequest.ProtoReflect.Descriptor instead.
-func (*GetNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetNetworkRequest) GetNetworkId() string {
+func (x *PingResponse) GetApiVersion() string {
 	if x != nil {
-		return x.NetworkId
+		return x.ApiVersion
 	}
 	return ""
 }
 
-func (x *GetNetworkRequest) GetVerbose() bool {
+func (x *PingResponse) GetOsType() string {
 	if x != nil {
-		return x.Verbose
+		return x.OsType
+	}
+	return ""
+}
+
+func (x *PingResponse) GetExperimental() bool {
+	if x != nil {
+		return x.Experimental
 	}
 	return false
 }
 
-func (x *GetNetworkRequest) GetScope() string {
+func (x *PingResponse) GetBuilderVersion() string {
 	if x != nil {
-		return x.Scope
+		return x.BuilderVersion
 	}
 	return ""
 }
 
-type GetNetworkResponse struct {
+type GetEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Since         string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`     // Show events since timestamp or relative (e.g., "10m")
+	Until         string                 `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`     // Show events until timestamp or relative
+	Filters       string                 `protobuf:"bytes,3,opt,name=filters,proto3" json:"filters,omitempty"` // Event filters in JSON format
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNetworkResponse) Reset() {
-	*x = GetNetworkResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[80]
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkResponse) String() string {
+func (x *GetEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkResponse) ProtoMessage() {}
+func (*GetEventsRequest) ProtoMessage() {}
 
-func (x *GetNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[80]
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5719,49 +8952,59 @@ func (x *GetNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkResponse.ProtoReflect.Descriptor instead.
-func (*GetNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GetNetworkResponse) GetNetwork() *Network {
+func (x *GetEventsRequest) GetSince() string {
 	if x != nil {
-		return x.Network
+		return x.Since
 	}
-	return nil
+	return ""
 }
 
-type CreateNetworkRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CheckDuplicate bool                   `protobuf:"varint,2,opt,name=check_duplicate,json=checkDuplicate,proto3" json:"check_duplicate,omitempty"`
-	Driver         string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
-	Internal       bool                   `protobuf:"varint,4,opt,name=internal,proto3" json:"internal,omitempty"`
-	Attachable     bool                   `protobuf:"varint,5,opt,name=attachable,proto3" json:"attachable,omitempty"`
-	Ingress        bool                   `protobuf:"varint,6,opt,name=ingress,proto3" json:"ingress,omitempty"`
-	Ipam           *IPAMConfig            `protobuf:"bytes,7,opt,name=ipam,proto3" json:"ipam,omitempty"`
-	EnableIpv6     bool                   `protobuf:"varint,8,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
-	Options        map[string]string      `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Labels         map[string]string      `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetEventsRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
 }
 
-func (x *CreateNetworkRequest) Reset() {
-	*x = CreateNetworkRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[81]
+func (x *GetEventsRequest) GetFilters() string {
+	if x != nil {
+		return x.Filters
+	}
+	return ""
+}
+
+type DockerEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`     // container, image, volume, network, daemon, etc.
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // create, start, stop, destroy, pull, etc.
+	Actor         *Actor                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Time          int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"` // Unix timestamp
+	TimeNano      int64                  `protobuf:"varint,5,opt,name=time_nano,json=timeNano,proto3" json:"time_nano,omitempty"`
+	Scope         string                 `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"` // local or swarm
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DockerEvent) Reset() {
+	*x = DockerEvent{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNetworkRequest) String() string {
+func (x *DockerEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNetworkRequest) ProtoMessage() {}
+func (*DockerEvent) ProtoMessage() {}
 
-func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[81]
+func (x *DockerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5772,104 +9015,76 @@ func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNetworkRequest.ProtoReflect.Descriptor instead.
-func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *CreateNetworkRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateNetworkRequest) GetCheckDuplicate() bool {
-	if x != nil {
-		return x.CheckDuplicate
-	}
-	return false
-}
-
-func (x *CreateNetworkRequest) GetDriver() string {
-	if x != nil {
-		return x.Driver
-	}
-	return ""
-}
-
-func (x *CreateNetworkRequest) GetInternal() bool {
-	if x != nil {
-		return x.Internal
-	}
-	return false
+// Deprecated: Use DockerEvent.ProtoReflect.Descriptor instead.
+func (*DockerEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *CreateNetworkRequest) GetAttachable() bool {
+func (x *DockerEvent) GetType() string {
 	if x != nil {
-		return x.Attachable
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *CreateNetworkRequest) GetIngress() bool {
+func (x *DockerEvent) GetAction() string {
 	if x != nil {
-		return x.Ingress
+		return x.Action
 	}
-	return false
+	return ""
 }
 
-func (x *CreateNetworkRequest) GetIpam() *IPAMConfig {
+func (x *DockerEvent) GetActor() *Actor {
 	if x != nil {
-		return x.Ipam
+		return x.Actor
 	}
 	return nil
 }
 
-func (x *CreateNetworkRequest) GetEnableIpv6() bool {
+func (x *DockerEvent) GetTime() int64 {
 	if x != nil {
-		return x.EnableIpv6
+		return x.Time
 	}
-	return false
+	return 0
 }
 
-func (x *CreateNetworkRequest) GetOptions() map[string]string {
+func (x *DockerEvent) GetTimeNano() int64 {
 	if x != nil {
-		return x.Options
+		return x.TimeNano
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateNetworkRequest) GetLabels() map[string]string {
+func (x *DockerEvent) GetScope() string {
 	if x != nil {
-		return x.Labels
+		return x.Scope
 	}
-	return nil
+	return ""
 }
 
-type CreateNetworkResponse struct {
+type Actor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	Warning       string                 `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNetworkResponse) Reset() {
-	*x = CreateNetworkResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[82]
+func (x *Actor) Reset() {
+	*x = Actor{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNetworkResponse) String() string {
+func (x *Actor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNetworkResponse) ProtoMessage() {}
+func (*Actor) ProtoMessage() {}
 
-func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[82]
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5880,47 +9095,46 @@ func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNetworkResponse.ProtoReflect.Descriptor instead.
-func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CreateNetworkResponse) GetNetworkId() string {
+func (x *Actor) GetId() string {
 	if x != nil {
-		return x.NetworkId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateNetworkResponse) GetWarning() string {
+func (x *Actor) GetAttributes() map[string]string {
 	if x != nil {
-		return x.Warning
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-type DeleteNetworkRequest struct {
+type ListSwarmNodesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNetworkRequest) Reset() {
-	*x = DeleteNetworkRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[83]
+func (x *ListSwarmNodesRequest) Reset() {
+	*x = ListSwarmNodesRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNetworkRequest) String() string {
+func (x *ListSwarmNodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNetworkRequest) ProtoMessage() {}
+func (*ListSwarmNodesRequest) ProtoMessage() {}
 
-func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[83]
+func (x *ListSwarmNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5931,41 +9145,33 @@ func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNetworkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *DeleteNetworkRequest) GetNetworkId() string {
-	if x != nil {
-		return x.NetworkId
-	}
-	return ""
+// Deprecated: Use ListSwarmNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListSwarmNodesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{129}
 }
 
-type DeleteNetworkResponse struct {
+type ListSwarmNodesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Nodes         []*SwarmNode           `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNetworkResponse) Reset() {
-	*x = DeleteNetworkResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[84]
+func (x *ListSwarmNodesResponse) Reset() {
+	*x = ListSwarmNodesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNetworkResponse) String() string {
+func (x *ListSwarmNodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNetworkResponse) ProtoMessage() {}
+func (*ListSwarmNodesResponse) ProtoMessage() {}
 
-func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[84]
+func (x *ListSwarmNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,49 +9182,55 @@ func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNetworkResponse.ProtoReflect.Descriptor instead.
-func (*DeleteNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *DeleteNetworkResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ListSwarmNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListSwarmNodesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *DeleteNetworkResponse) GetMessage() string {
+func (x *ListSwarmNodesResponse) GetNodes() []*SwarmNode {
 	if x != nil {
-		return x.Message
+		return x.Nodes
 	}
-	return ""
-}
-
-type ConnectNetworkRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId      string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ContainerId    string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	EndpointConfig *EndpointConfig        `protobuf:"bytes,3,opt,name=endpoint_config,json=endpointConfig,proto3" json:"endpoint_config,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return nil
 }
 
-func (x *ConnectNetworkRequest) Reset() {
-	*x = ConnectNetworkRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[85]
+type SwarmNode struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname            string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Role                string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                 // worker or manager
+	Availability        string                 `protobuf:"bytes,4,opt,name=availability,proto3" json:"availability,omitempty"` // active, pause or drain
+	State               string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`               // ready, down, unknown or disconnected
+	Addr                string                 `protobuf:"bytes,6,opt,name=addr,proto3" json:"addr,omitempty"`
+	Leader              bool                   `protobuf:"varint,7,opt,name=leader,proto3" json:"leader,omitempty"`
+	ManagerReachability string                 `protobuf:"bytes,8,opt,name=manager_reachability,json=managerReachability,proto3" json:"manager_reachability,omitempty"` // reachable, unreachable or unknown
+	EngineVersion       string                 `protobuf:"bytes,9,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	Os                  string                 `protobuf:"bytes,10,opt,name=os,proto3" json:"os,omitempty"`
+	Architecture        string                 `protobuf:"bytes,11,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	NanoCpus            int64                  `protobuf:"varint,12,opt,name=nano_cpus,json=nanoCpus,proto3" json:"nano_cpus,omitempty"`
+	MemoryBytes         int64                  `protobuf:"varint,13,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	Labels              map[string]string      `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt           int64                  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           int64                  `protobuf:"varint,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SwarmNode) Reset() {
+	*x = SwarmNode{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectNetworkRequest) String() string {
+func (x *SwarmNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectNetworkRequest) ProtoMessage() {}
+func (*SwarmNode) ProtoMessage() {}
 
-func (x *ConnectNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[85]
+func (x *SwarmNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6029,168 +9241,147 @@ func (x *ConnectNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectNetworkRequest.ProtoReflect.Descriptor instead.
-func (*ConnectNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use SwarmNode.ProtoReflect.Descriptor instead.
+func (*SwarmNode) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *ConnectNetworkRequest) GetNetworkId() string {
+func (x *SwarmNode) GetId() string {
 	if x != nil {
-		return x.NetworkId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ConnectNetworkRequest) GetContainerId() string {
+func (x *SwarmNode) GetHostname() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *ConnectNetworkRequest) GetEndpointConfig() *EndpointConfig {
+func (x *SwarmNode) GetRole() string {
 	if x != nil {
-		return x.EndpointConfig
+		return x.Role
 	}
-	return nil
-}
-
-type EndpointConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IpamConfig    map[string]string      `protobuf:"bytes,1,rep,name=ipam_config,json=ipamConfig,proto3" json:"ipam_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Links         []string               `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
-	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *EndpointConfig) Reset() {
-	*x = EndpointConfig{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[86]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *EndpointConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwarmNode) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
 }
 
-func (*EndpointConfig) ProtoMessage() {}
-
-func (x *EndpointConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[86]
+func (x *SwarmNode) GetState() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use EndpointConfig.ProtoReflect.Descriptor instead.
-func (*EndpointConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{86}
+func (x *SwarmNode) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
 }
 
-func (x *EndpointConfig) GetIpamConfig() map[string]string {
+func (x *SwarmNode) GetLeader() bool {
 	if x != nil {
-		return x.IpamConfig
+		return x.Leader
 	}
-	return nil
+	return false
 }
 
-func (x *EndpointConfig) GetLinks() []string {
+func (x *SwarmNode) GetManagerReachability() string {
 	if x != nil {
-		return x.Links
+		return x.ManagerReachability
 	}
-	return nil
+	return ""
 }
 
-func (x *EndpointConfig) GetAliases() []string {
+func (x *SwarmNode) GetEngineVersion() string {
 	if x != nil {
-		return x.Aliases
+		return x.EngineVersion
 	}
-	return nil
+	return ""
 }
 
-type ConnectNetworkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SwarmNode) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
 }
 
-func (x *ConnectNetworkResponse) Reset() {
-	*x = ConnectNetworkResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SwarmNode) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
 }
 
-func (x *ConnectNetworkResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwarmNode) GetNanoCpus() int64 {
+	if x != nil {
+		return x.NanoCpus
+	}
+	return 0
 }
 
-func (*ConnectNetworkResponse) ProtoMessage() {}
-
-func (x *ConnectNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[87]
+func (x *SwarmNode) GetMemoryBytes() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MemoryBytes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ConnectNetworkResponse.ProtoReflect.Descriptor instead.
-func (*ConnectNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{87}
+func (x *SwarmNode) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
-func (x *ConnectNetworkResponse) GetSuccess() bool {
+func (x *SwarmNode) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Success
+		return x.CreatedAt
 	}
-	return false
+	return 0
 }
 
-func (x *ConnectNetworkResponse) GetMessage() string {
+func (x *SwarmNode) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.Message
+		return x.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-type DisconnectNetworkRequest struct {
+type UpdateSwarmNodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ContainerId   string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Availability  string                 `protobuf:"bytes,2,opt,name=availability,proto3" json:"availability,omitempty"` // active, pause or drain; empty to keep
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                 // worker or manager; empty to keep
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectNetworkRequest) Reset() {
-	*x = DisconnectNetworkRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[88]
+func (x *UpdateSwarmNodeRequest) Reset() {
+	*x = UpdateSwarmNodeRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectNetworkRequest) String() string {
+func (x *UpdateSwarmNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectNetworkRequest) ProtoMessage() {}
+func (*UpdateSwarmNodeRequest) ProtoMessage() {}
 
-func (x *DisconnectNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[88]
+func (x *UpdateSwarmNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6201,33 +9392,33 @@ func (x *DisconnectNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectNetworkRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use UpdateSwarmNodeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSwarmNodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *DisconnectNetworkRequest) GetNetworkId() string {
+func (x *UpdateSwarmNodeRequest) GetNodeId() string {
 	if x != nil {
-		return x.NetworkId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *DisconnectNetworkRequest) GetContainerId() string {
+func (x *UpdateSwarmNodeRequest) GetAvailability() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Availability
 	}
 	return ""
 }
 
-func (x *DisconnectNetworkRequest) GetForce() bool {
+func (x *UpdateSwarmNodeRequest) GetRole() string {
 	if x != nil {
-		return x.Force
+		return x.Role
 	}
-	return false
+	return ""
 }
 
-type DisconnectNetworkResponse struct {
+type UpdateSwarmNodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -6235,21 +9426,21 @@ type DisconnectNetworkResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectNetworkResponse) Reset() {
-	*x = DisconnectNetworkResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[89]
+func (x *UpdateSwarmNodeResponse) Reset() {
+	*x = UpdateSwarmNodeResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectNetworkResponse) String() string {
+func (x *UpdateSwarmNodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectNetworkResponse) ProtoMessage() {}
+func (*UpdateSwarmNodeResponse) ProtoMessage() {}
 
-func (x *DisconnectNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[89]
+func (x *UpdateSwarmNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6260,46 +9451,47 @@ func (x *DisconnectNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectNetworkResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use UpdateSwarmNodeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSwarmNodeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *DisconnectNetworkResponse) GetSuccess() bool {
+func (x *UpdateSwarmNodeResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DisconnectNetworkResponse) GetMessage() string {
+func (x *UpdateSwarmNodeResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetSystemInfoRequest struct {
+type ListSwarmServicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stack         string                 `protobuf:"bytes,1,opt,name=stack,proto3" json:"stack,omitempty"` // Only services of this stack
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemInfoRequest) Reset() {
-	*x = GetSystemInfoRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[90]
+func (x *ListSwarmServicesRequest) Reset() {
+	*x = ListSwarmServicesRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemInfoRequest) String() string {
+func (x *ListSwarmServicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemInfoRequest) ProtoMessage() {}
+func (*ListSwarmServicesRequest) ProtoMessage() {}
 
-func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[90]
+func (x *ListSwarmServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6310,33 +9502,40 @@ func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use ListSwarmServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListSwarmServicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{134}
 }
 
-type GetSystemInfoResponse struct {
+func (x *ListSwarmServicesRequest) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+type ListSwarmServicesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          *SystemInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Services      []*SwarmService        `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemInfoResponse) Reset() {
-	*x = GetSystemInfoResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[91]
+func (x *ListSwarmServicesResponse) Reset() {
+	*x = ListSwarmServicesResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemInfoResponse) String() string {
+func (x *ListSwarmServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemInfoResponse) ProtoMessage() {}
+func (*ListSwarmServicesResponse) ProtoMessage() {}
 
-func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[91]
+func (x *ListSwarmServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6347,78 +9546,55 @@ func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use ListSwarmServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListSwarmServicesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *GetSystemInfoResponse) GetInfo() *SystemInfo {
+func (x *ListSwarmServicesResponse) GetServices() []*SwarmService {
 	if x != nil {
-		return x.Info
+		return x.Services
 	}
 	return nil
 }
 
-type SystemInfo struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Containers         int32                  `protobuf:"varint,2,opt,name=containers,proto3" json:"containers,omitempty"`
-	ContainersRunning  int32                  `protobuf:"varint,3,opt,name=containers_running,json=containersRunning,proto3" json:"containers_running,omitempty"`
-	ContainersPaused   int32                  `protobuf:"varint,4,opt,name=containers_paused,json=containersPaused,proto3" json:"containers_paused,omitempty"`
-	ContainersStopped  int32                  `protobuf:"varint,5,opt,name=containers_stopped,json=containersStopped,proto3" json:"containers_stopped,omitempty"`
-	Images             int32                  `protobuf:"varint,6,opt,name=images,proto3" json:"images,omitempty"`
-	Driver             string                 `protobuf:"bytes,7,opt,name=driver,proto3" json:"driver,omitempty"`
-	DriverStatus       []*DriverStatus        `protobuf:"bytes,8,rep,name=driver_status,json=driverStatus,proto3" json:"driver_status,omitempty"`
-	DockerRootDir      string                 `protobuf:"bytes,9,opt,name=docker_root_dir,json=dockerRootDir,proto3" json:"docker_root_dir,omitempty"`
-	SystemStatus       map[string]string      `protobuf:"bytes,10,rep,name=system_status,json=systemStatus,proto3" json:"system_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Plugins            []*Plugin              `protobuf:"bytes,11,rep,name=plugins,proto3" json:"plugins,omitempty"`
-	MemoryLimit        bool                   `protobuf:"varint,12,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
-	SwapLimit          bool                   `protobuf:"varint,13,opt,name=swap_limit,json=swapLimit,proto3" json:"swap_limit,omitempty"`
-	KernelMemory       bool                   `protobuf:"varint,14,opt,name=kernel_memory,json=kernelMemory,proto3" json:"kernel_memory,omitempty"`
-	CpuCfsPeriod       bool                   `protobuf:"varint,15,opt,name=cpu_cfs_period,json=cpuCfsPeriod,proto3" json:"cpu_cfs_period,omitempty"`
-	CpuCfsQuota        bool                   `protobuf:"varint,16,opt,name=cpu_cfs_quota,json=cpuCfsQuota,proto3" json:"cpu_cfs_quota,omitempty"`
-	CpuShares          bool                   `protobuf:"varint,17,opt,name=cpu_shares,json=cpuShares,proto3" json:"cpu_shares,omitempty"`
-	CpuSet             bool                   `protobuf:"varint,18,opt,name=cpu_set,json=cpuSet,proto3" json:"cpu_set,omitempty"`
-	PidsLimit          bool                   `protobuf:"varint,19,opt,name=pids_limit,json=pidsLimit,proto3" json:"pids_limit,omitempty"`
-	Ipv4Forwarding     bool                   `protobuf:"varint,20,opt,name=ipv4_forwarding,json=ipv4Forwarding,proto3" json:"ipv4_forwarding,omitempty"`
-	BridgeNfIptables   bool                   `protobuf:"varint,21,opt,name=bridge_nf_iptables,json=bridgeNfIptables,proto3" json:"bridge_nf_iptables,omitempty"`
-	BridgeNfIp6Tables  bool                   `protobuf:"varint,22,opt,name=bridge_nf_ip6tables,json=bridgeNfIp6tables,proto3" json:"bridge_nf_ip6tables,omitempty"`
-	Debug              bool                   `protobuf:"varint,23,opt,name=debug,proto3" json:"debug,omitempty"`
-	NFd                int32                  `protobuf:"varint,24,opt,name=n_fd,json=nFd,proto3" json:"n_fd,omitempty"`
-	OomKillDisable     bool                   `protobuf:"varint,25,opt,name=oom_kill_disable,json=oomKillDisable,proto3" json:"oom_kill_disable,omitempty"`
-	NGoroutines        int32                  `protobuf:"varint,26,opt,name=n_goroutines,json=nGoroutines,proto3" json:"n_goroutines,omitempty"`
-	SystemTime         string                 `protobuf:"bytes,27,opt,name=system_time,json=systemTime,proto3" json:"system_time,omitempty"`
-	LoggingDriver      string                 `protobuf:"bytes,28,opt,name=logging_driver,json=loggingDriver,proto3" json:"logging_driver,omitempty"`
-	CgroupDriver       string                 `protobuf:"bytes,29,opt,name=cgroup_driver,json=cgroupDriver,proto3" json:"cgroup_driver,omitempty"`
-	NEventsListener    int32                  `protobuf:"varint,30,opt,name=n_events_listener,json=nEventsListener,proto3" json:"n_events_listener,omitempty"`
-	KernelVersion      string                 `protobuf:"bytes,31,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
-	OperatingSystem    string                 `protobuf:"bytes,32,opt,name=operating_system,json=operatingSystem,proto3" json:"operating_system,omitempty"`
-	OsType             string                 `protobuf:"bytes,33,opt,name=os_type,json=osType,proto3" json:"os_type,omitempty"`
-	Architecture       string                 `protobuf:"bytes,34,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	Ncpu               int32                  `protobuf:"varint,35,opt,name=ncpu,proto3" json:"ncpu,omitempty"`
-	MemTotal           int64                  `protobuf:"varint,36,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`
-	IndexServerAddress string                 `protobuf:"bytes,37,opt,name=index_server_address,json=indexServerAddress,proto3" json:"index_server_address,omitempty"`
-	RegistryConfig     *RegistryConfig        `protobuf:"bytes,38,opt,name=registry_config,json=registryConfig,proto3" json:"registry_config,omitempty"`
-	Warnings           []string               `protobuf:"bytes,39,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SwarmService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`          // replicated, global, replicated-job or global-job
+	Replicas      uint64                 `protobuf:"varint,5,opt,name=replicas,proto3" json:"replicas,omitempty"` // Desired replicas of a replicated service
+	RunningTasks  uint64                 `protobuf:"varint,6,opt,name=running_tasks,json=runningTasks,proto3" json:"running_tasks,omitempty"`
+	DesiredTasks  uint64                 `protobuf:"varint,7,opt,name=desired_tasks,json=desiredTasks,proto3" json:"desired_tasks,omitempty"`
+	Stack         string                 `protobuf:"bytes,8,opt,name=stack,proto3" json:"stack,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ports         []*SwarmPort           `protobuf:"bytes,10,rep,name=ports,proto3" json:"ports,omitempty"`
+	UpdateState   string                 `protobuf:"bytes,11,opt,name=update_state,json=updateState,proto3" json:"update_state,omitempty"` // updating, paused, completed, rollback_started, ...
+	UpdateMessage string                 `protobuf:"bytes,12,opt,name=update_message,json=updateMessage,proto3" json:"update_message,omitempty"`
+	CanRollback   bool                   `protobuf:"varint,13,opt,name=can_rollback,json=canRollback,proto3" json:"can_rollback,omitempty"` // Whether a previous spec exists
+	Version       uint64                 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemInfo) Reset() {
-	*x = SystemInfo{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[92]
+func (x *SwarmService) Reset() {
+	*x = SwarmService{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) String() string {
+func (x *SwarmService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInfo) ProtoMessage() {}
+func (*SwarmService) ProtoMessage() {}
 
-func (x *SystemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[92]
+func (x *SwarmService) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,307 +9605,467 @@ func (x *SystemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
-func (*SystemInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use SwarmService.ProtoReflect.Descriptor instead.
+func (*SwarmService) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *SystemInfo) GetId() string {
+func (x *SwarmService) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SystemInfo) GetContainers() int32 {
+func (x *SwarmService) GetName() string {
 	if x != nil {
-		return x.Containers
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemInfo) GetContainersRunning() int32 {
+func (x *SwarmService) GetImage() string {
 	if x != nil {
-		return x.ContainersRunning
+		return x.Image
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemInfo) GetContainersPaused() int32 {
+func (x *SwarmService) GetMode() string {
 	if x != nil {
-		return x.ContainersPaused
+		return x.Mode
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemInfo) GetContainersStopped() int32 {
+func (x *SwarmService) GetReplicas() uint64 {
 	if x != nil {
-		return x.ContainersStopped
+		return x.Replicas
 	}
 	return 0
 }
 
-func (x *SystemInfo) GetImages() int32 {
+func (x *SwarmService) GetRunningTasks() uint64 {
 	if x != nil {
-		return x.Images
+		return x.RunningTasks
 	}
 	return 0
 }
 
-func (x *SystemInfo) GetDriver() string {
+func (x *SwarmService) GetDesiredTasks() uint64 {
 	if x != nil {
-		return x.Driver
+		return x.DesiredTasks
 	}
-	return ""
+	return 0
 }
 
-func (x *SystemInfo) GetDriverStatus() []*DriverStatus {
+func (x *SwarmService) GetStack() string {
 	if x != nil {
-		return x.DriverStatus
+		return x.Stack
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemInfo) GetDockerRootDir() string {
+func (x *SwarmService) GetLabels() map[string]string {
 	if x != nil {
-		return x.DockerRootDir
+		return x.Labels
 	}
-	return ""
+	return nil
 }
 
-func (x *SystemInfo) GetSystemStatus() map[string]string {
+func (x *SwarmService) GetPorts() []*SwarmPort {
 	if x != nil {
-		return x.SystemStatus
+		return x.Ports
 	}
 	return nil
 }
 
-func (x *SystemInfo) GetPlugins() []*Plugin {
+func (x *SwarmService) GetUpdateState() string {
 	if x != nil {
-		return x.Plugins
+		return x.UpdateState
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemInfo) GetMemoryLimit() bool {
+func (x *SwarmService) GetUpdateMessage() string {
 	if x != nil {
-		return x.MemoryLimit
+		return x.UpdateMessage
 	}
-	return false
+	return ""
 }
 
-func (x *SystemInfo) GetSwapLimit() bool {
+func (x *SwarmService) GetCanRollback() bool {
 	if x != nil {
-		return x.SwapLimit
+		return x.CanRollback
 	}
 	return false
 }
 
-func (x *SystemInfo) GetKernelMemory() bool {
+func (x *SwarmService) GetVersion() uint64 {
 	if x != nil {
-		return x.KernelMemory
+		return x.Version
 	}
-	return false
+	return 0
 }
 
-func (x *SystemInfo) GetCpuCfsPeriod() bool {
+func (x *SwarmService) GetCreatedAt() int64 {
 	if x != nil {
-		return x.CpuCfsPeriod
+		return x.CreatedAt
 	}
-	return false
+	return 0
 }
 
-func (x *SystemInfo) GetCpuCfsQuota() bool {
+func (x *SwarmService) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.CpuCfsQuota
+		return x.UpdatedAt
 	}
-	return false
+	return 0
 }
 
-func (x *SystemInfo) GetCpuShares() bool {
+type SwarmPort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Protocol      string                 `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	TargetPort    uint32                 `protobuf:"varint,2,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	PublishedPort uint32                 `protobuf:"varint,3,opt,name=published_port,json=publishedPort,proto3" json:"published_port,omitempty"`
+	PublishMode   string                 `protobuf:"bytes,4,opt,name=publish_mode,json=publishMode,proto3" json:"publish_mode,omitempty"` // ingress or host
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwarmPort) Reset() {
+	*x = SwarmPort{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwarmPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwarmPort) ProtoMessage() {}
+
+func (x *SwarmPort) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[137]
 	if x != nil {
-		return x.CpuShares
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *SystemInfo) GetCpuSet() bool {
+// Deprecated: Use SwarmPort.ProtoReflect.Descriptor instead.
+func (*SwarmPort) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *SwarmPort) GetProtocol() string {
 	if x != nil {
-		return x.CpuSet
+		return x.Protocol
 	}
-	return false
+	return ""
 }
 
-func (x *SystemInfo) GetPidsLimit() bool {
+func (x *SwarmPort) GetTargetPort() uint32 {
 	if x != nil {
-		return x.PidsLimit
+		return x.TargetPort
 	}
-	return false
+	return 0
 }
 
-func (x *SystemInfo) GetIpv4Forwarding() bool {
+func (x *SwarmPort) GetPublishedPort() uint32 {
 	if x != nil {
-		return x.Ipv4Forwarding
+		return x.PublishedPort
 	}
-	return false
+	return 0
 }
 
-func (x *SystemInfo) GetBridgeNfIptables() bool {
+func (x *SwarmPort) GetPublishMode() string {
 	if x != nil {
-		return x.BridgeNfIptables
+		return x.PublishMode
 	}
-	return false
+	return ""
 }
 
-func (x *SystemInfo) GetBridgeNfIp6Tables() bool {
+type GetSwarmServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSwarmServiceRequest) Reset() {
+	*x = GetSwarmServiceRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSwarmServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwarmServiceRequest) ProtoMessage() {}
+
+func (x *GetSwarmServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[138]
 	if x != nil {
-		return x.BridgeNfIp6Tables
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *SystemInfo) GetDebug() bool {
+// Deprecated: Use GetSwarmServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetSwarmServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetSwarmServiceRequest) GetServiceId() string {
 	if x != nil {
-		return x.Debug
+		return x.ServiceId
 	}
-	return false
+	return ""
 }
 
-func (x *SystemInfo) GetNFd() int32 {
+type GetSwarmServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *SwarmService          `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	SpecJson      string                 `protobuf:"bytes,2,opt,name=spec_json,json=specJson,proto3" json:"spec_json,omitempty"` // Full service spec as returned by the engine
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSwarmServiceResponse) Reset() {
+	*x = GetSwarmServiceResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSwarmServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwarmServiceResponse) ProtoMessage() {}
+
+func (x *GetSwarmServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[139]
 	if x != nil {
-		return x.NFd
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SystemInfo) GetOomKillDisable() bool {
-	if x != nil {
-		return x.OomKillDisable
-	}
-	return false
+// Deprecated: Use GetSwarmServiceResponse.ProtoReflect.Descriptor instead.
+func (*GetSwarmServiceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *SystemInfo) GetNGoroutines() int32 {
+func (x *GetSwarmServiceResponse) GetService() *SwarmService {
 	if x != nil {
-		return x.NGoroutines
+		return x.Service
 	}
-	return 0
+	return nil
 }
 
-func (x *SystemInfo) GetSystemTime() string {
+func (x *GetSwarmServiceResponse) GetSpecJson() string {
 	if x != nil {
-		return x.SystemTime
+		return x.SpecJson
 	}
 	return ""
 }
 
-func (x *SystemInfo) GetLoggingDriver() string {
+type ScaleSwarmServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Replicas      uint64                 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleSwarmServiceRequest) Reset() {
+	*x = ScaleSwarmServiceRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleSwarmServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleSwarmServiceRequest) ProtoMessage() {}
+
+func (x *ScaleSwarmServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[140]
 	if x != nil {
-		return x.LoggingDriver
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SystemInfo) GetCgroupDriver() string {
+// Deprecated: Use ScaleSwarmServiceRequest.ProtoReflect.Descriptor instead.
+func (*ScaleSwarmServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ScaleSwarmServiceRequest) GetServiceId() string {
 	if x != nil {
-		return x.CgroupDriver
+		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *SystemInfo) GetNEventsListener() int32 {
+func (x *ScaleSwarmServiceRequest) GetReplicas() uint64 {
 	if x != nil {
-		return x.NEventsListener
+		return x.Replicas
 	}
 	return 0
 }
 
-func (x *SystemInfo) GetKernelVersion() string {
+type UpdateSwarmServiceImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	RegistryAuth  string                 `protobuf:"bytes,3,opt,name=registry_auth,json=registryAuth,proto3" json:"registry_auth,omitempty"` // Base64 encoded auth config, sent to the nodes pulling the image
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSwarmServiceImageRequest) Reset() {
+	*x = UpdateSwarmServiceImageRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSwarmServiceImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSwarmServiceImageRequest) ProtoMessage() {}
+
+func (x *UpdateSwarmServiceImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[141]
 	if x != nil {
-		return x.KernelVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SystemInfo) GetOperatingSystem() string {
+// Deprecated: Use UpdateSwarmServiceImageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSwarmServiceImageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *UpdateSwarmServiceImageRequest) GetServiceId() string {
 	if x != nil {
-		return x.OperatingSystem
+		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *SystemInfo) GetOsType() string {
+func (x *UpdateSwarmServiceImageRequest) GetImage() string {
 	if x != nil {
-		return x.OsType
+		return x.Image
 	}
 	return ""
 }
 
-func (x *SystemInfo) GetArchitecture() string {
+func (x *UpdateSwarmServiceImageRequest) GetRegistryAuth() string {
 	if x != nil {
-		return x.Architecture
+		return x.RegistryAuth
 	}
 	return ""
 }
 
-func (x *SystemInfo) GetNcpu() int32 {
-	if x != nil {
-		return x.Ncpu
-	}
-	return 0
+type RollbackSwarmServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemInfo) GetMemTotal() int64 {
-	if x != nil {
-		return x.MemTotal
-	}
-	return 0
+func (x *RollbackSwarmServiceRequest) Reset() {
+	*x = RollbackSwarmServiceRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) GetIndexServerAddress() string {
-	if x != nil {
-		return x.IndexServerAddress
-	}
-	return ""
+func (x *RollbackSwarmServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SystemInfo) GetRegistryConfig() *RegistryConfig {
+func (*RollbackSwarmServiceRequest) ProtoMessage() {}
+
+func (x *RollbackSwarmServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[142]
 	if x != nil {
-		return x.RegistryConfig
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SystemInfo) GetWarnings() []string {
+// Deprecated: Use RollbackSwarmServiceRequest.ProtoReflect.Descriptor instead.
+func (*RollbackSwarmServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RollbackSwarmServiceRequest) GetServiceId() string {
 	if x != nil {
-		return x.Warnings
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-type Plugin struct {
+type UpdateSwarmServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Plugin) Reset() {
-	*x = Plugin{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[93]
+func (x *UpdateSwarmServiceResponse) Reset() {
+	*x = UpdateSwarmServiceResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Plugin) String() string {
+func (x *UpdateSwarmServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plugin) ProtoMessage() {}
+func (*UpdateSwarmServiceResponse) ProtoMessage() {}
 
-func (x *Plugin) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[93]
+func (x *UpdateSwarmServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6740,48 +10076,54 @@ func (x *Plugin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plugin.ProtoReflect.Descriptor instead.
-func (*Plugin) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use UpdateSwarmServiceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSwarmServiceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *Plugin) GetType() string {
+func (x *UpdateSwarmServiceResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Type
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *Plugin) GetName() string {
+func (x *UpdateSwarmServiceResponse) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-type DriverStatus struct {
+func (x *UpdateSwarmServiceResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DeleteSwarmServiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DriverStatus) Reset() {
-	*x = DriverStatus{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[94]
+func (x *DeleteSwarmServiceRequest) Reset() {
+	*x = DeleteSwarmServiceRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DriverStatus) String() string {
+func (x *DeleteSwarmServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DriverStatus) ProtoMessage() {}
+func (*DeleteSwarmServiceRequest) ProtoMessage() {}
 
-func (x *DriverStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[94]
+func (x *DeleteSwarmServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6792,49 +10134,41 @@ func (x *DriverStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DriverStatus.ProtoReflect.Descriptor instead.
-func (*DriverStatus) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *DriverStatus) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use DeleteSwarmServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSwarmServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *DriverStatus) GetValue() string {
+func (x *DeleteSwarmServiceRequest) GetServiceId() string {
 	if x != nil {
-		return x.Value
+		return x.ServiceId
 	}
 	return ""
 }
 
-type RegistryConfig struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	InsecureRegistryCidrs []string               `protobuf:"bytes,1,rep,name=insecure_registry_cidrs,json=insecureRegistryCidrs,proto3" json:"insecure_registry_cidrs,omitempty"`
-	IndexConfigs          []string               `protobuf:"bytes,2,rep,name=index_configs,json=indexConfigs,proto3" json:"index_configs,omitempty"`
-	Mirrors               []string               `protobuf:"bytes,3,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type DeleteSwarmServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegistryConfig) Reset() {
-	*x = RegistryConfig{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[95]
+func (x *DeleteSwarmServiceResponse) Reset() {
+	*x = DeleteSwarmServiceResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegistryConfig) String() string {
+func (x *DeleteSwarmServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryConfig) ProtoMessage() {}
+func (*DeleteSwarmServiceResponse) ProtoMessage() {}
 
-func (x *RegistryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[95]
+func (x *DeleteSwarmServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6845,53 +10179,49 @@ func (x *RegistryConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryConfig.ProtoReflect.Descriptor instead.
-func (*RegistryConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *RegistryConfig) GetInsecureRegistryCidrs() []string {
-	if x != nil {
-		return x.InsecureRegistryCidrs
-	}
-	return nil
+// Deprecated: Use DeleteSwarmServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSwarmServiceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *RegistryConfig) GetIndexConfigs() []string {
+func (x *DeleteSwarmServiceResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IndexConfigs
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *RegistryConfig) GetMirrors() []string {
+func (x *DeleteSwarmServiceResponse) GetMessage() string {
 	if x != nil {
-		return x.Mirrors
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type GetVersionRequest struct {
+type ListSwarmTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	DesiredState  string                 `protobuf:"bytes,3,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"` // running, shutdown or accepted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionRequest) Reset() {
-	*x = GetVersionRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[96]
+func (x *ListSwarmTasksRequest) Reset() {
+	*x = ListSwarmTasksRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionRequest) String() string {
+func (x *ListSwarmTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionRequest) ProtoMessage() {}
+func (*ListSwarmTasksRequest) ProtoMessage() {}
 
-func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[96]
+func (x *ListSwarmTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6902,33 +10232,54 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
-func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use ListSwarmTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListSwarmTasksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{146}
 }
 
-type GetVersionResponse struct {
+func (x *ListSwarmTasksRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListSwarmTasksRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListSwarmTasksRequest) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+type ListSwarmTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       *VersionInfo           `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Tasks         []*SwarmTask           `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionResponse) Reset() {
-	*x = GetVersionResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[97]
+func (x *ListSwarmTasksResponse) Reset() {
+	*x = ListSwarmTasksResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionResponse) String() string {
+func (x *ListSwarmTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionResponse) ProtoMessage() {}
+func (*ListSwarmTasksResponse) ProtoMessage() {}
 
-func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[97]
+func (x *ListSwarmTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6939,49 +10290,52 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
-func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use ListSwarmTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListSwarmTasksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *GetVersionResponse) GetVersion() *VersionInfo {
+func (x *ListSwarmTasksResponse) GetTasks() []*SwarmTask {
 	if x != nil {
-		return x.Version
-	}
-	return nil
-}
-
-type VersionInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	ApiVersion    string                 `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	MinApiVersion string                 `protobuf:"bytes,3,opt,name=min_api_version,json=minApiVersion,proto3" json:"min_api_version,omitempty"`
-	GitCommit     string                 `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
-	GoVersion     string                 `protobuf:"bytes,5,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
-	Os            string                 `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`
-	Arch          string                 `protobuf:"bytes,7,opt,name=arch,proto3" json:"arch,omitempty"`
-	KernelVersion string                 `protobuf:"bytes,8,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
-	BuildTime     string                 `protobuf:"bytes,9,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`
-	Components    []*ComponentVersion    `protobuf:"bytes,10,rep,name=components,proto3" json:"components,omitempty"`
+		return x.Tasks
+	}
+	return nil
+}
+
+type SwarmTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Slot          int32                  `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	DesiredState  string                 `protobuf:"bytes,6,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,10,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,11,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionInfo) Reset() {
-	*x = VersionInfo{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[98]
+func (x *SwarmTask) Reset() {
+	*x = SwarmTask{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionInfo) String() string {
+func (x *SwarmTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionInfo) ProtoMessage() {}
+func (*SwarmTask) ProtoMessage() {}
 
-func (x *VersionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[98]
+func (x *SwarmTask) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6992,105 +10346,128 @@ func (x *VersionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
-func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use SwarmTask.ProtoReflect.Descriptor instead.
+func (*SwarmTask) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *VersionInfo) GetVersion() string {
+func (x *SwarmTask) GetId() string {
 	if x != nil {
-		return x.Version
+		return x.Id
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetApiVersion() string {
+func (x *SwarmTask) GetServiceId() string {
 	if x != nil {
-		return x.ApiVersion
+		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetMinApiVersion() string {
+func (x *SwarmTask) GetNodeId() string {
 	if x != nil {
-		return x.MinApiVersion
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetGitCommit() string {
+func (x *SwarmTask) GetSlot() int32 {
 	if x != nil {
-		return x.GitCommit
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SwarmTask) GetImage() string {
+	if x != nil {
+		return x.Image
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetGoVersion() string {
+func (x *SwarmTask) GetDesiredState() string {
 	if x != nil {
-		return x.GoVersion
+		return x.DesiredState
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetOs() string {
+func (x *SwarmTask) GetState() string {
 	if x != nil {
-		return x.Os
+		return x.State
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetArch() string {
+func (x *SwarmTask) GetMessage() string {
 	if x != nil {
-		return x.Arch
+		return x.Message
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetKernelVersion() string {
+func (x *SwarmTask) GetError() string {
 	if x != nil {
-		return x.KernelVersion
+		return x.Error
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetBuildTime() string {
+func (x *SwarmTask) GetContainerId() string {
 	if x != nil {
-		return x.BuildTime
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetComponents() []*ComponentVersion {
+func (x *SwarmTask) GetExitCode() int32 {
 	if x != nil {
-		return x.Components
+		return x.ExitCode
 	}
-	return nil
+	return 0
 }
 
-type ComponentVersion struct {
+func (x *SwarmTask) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SwarmTask) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SwarmSecret struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Details       map[string]string      `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComponentVersion) Reset() {
-	*x = ComponentVersion{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[99]
+func (x *SwarmSecret) Reset() {
+	*x = SwarmSecret{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComponentVersion) String() string {
+func (x *SwarmSecret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComponentVersion) ProtoMessage() {}
+func (*SwarmSecret) ProtoMessage() {}
 
-func (x *ComponentVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[99]
+func (x *SwarmSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7101,53 +10478,67 @@ func (x *ComponentVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComponentVersion.ProtoReflect.Descriptor instead.
-func (*ComponentVersion) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use SwarmSecret.ProtoReflect.Descriptor instead.
+func (*SwarmSecret) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *ComponentVersion) GetName() string {
+func (x *SwarmSecret) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ComponentVersion) GetVersion() string {
+func (x *SwarmSecret) GetName() string {
 	if x != nil {
-		return x.Version
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ComponentVersion) GetDetails() map[string]string {
+func (x *SwarmSecret) GetLabels() map[string]string {
 	if x != nil {
-		return x.Details
+		return x.Labels
 	}
 	return nil
 }
 
-type GetDiskUsageRequest struct {
+func (x *SwarmSecret) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SwarmSecret) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListSwarmSecretsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDiskUsageRequest) Reset() {
-	*x = GetDiskUsageRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[100]
+func (x *ListSwarmSecretsRequest) Reset() {
+	*x = ListSwarmSecretsRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDiskUsageRequest) String() string {
+func (x *ListSwarmSecretsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiskUsageRequest) ProtoMessage() {}
+func (*ListSwarmSecretsRequest) ProtoMessage() {}
 
-func (x *GetDiskUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[100]
+func (x *ListSwarmSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7158,33 +10549,33 @@ func (x *GetDiskUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiskUsageRequest.ProtoReflect.Descriptor instead.
-func (*GetDiskUsageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use ListSwarmSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwarmSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{150}
 }
 
-type GetDiskUsageResponse struct {
+type ListSwarmSecretsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Usage         *DiskUsage             `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	Secrets       []*SwarmSecret         `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDiskUsageResponse) Reset() {
-	*x = GetDiskUsageResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[101]
+func (x *ListSwarmSecretsResponse) Reset() {
+	*x = ListSwarmSecretsResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDiskUsageResponse) String() string {
+func (x *ListSwarmSecretsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiskUsageResponse) ProtoMessage() {}
+func (*ListSwarmSecretsResponse) ProtoMessage() {}
 
-func (x *GetDiskUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[101]
+func (x *ListSwarmSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7195,44 +10586,42 @@ func (x *GetDiskUsageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiskUsageResponse.ProtoReflect.Descriptor instead.
-func (*GetDiskUsageResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use ListSwarmSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwarmSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *GetDiskUsageResponse) GetUsage() *DiskUsage {
+func (x *ListSwarmSecretsResponse) GetSecrets() []*SwarmSecret {
 	if x != nil {
-		return x.Usage
+		return x.Secrets
 	}
 	return nil
 }
 
-type DiskUsage struct {
+type CreateSwarmSecretRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Images        []*ImageSummary        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
-	Containers    []*ContainerSummary    `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
-	Volumes       []*VolumeSummary       `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	BuildCache    []*BuildCacheSummary   `protobuf:"bytes,4,rep,name=build_cache,json=buildCache,proto3" json:"build_cache,omitempty"`
-	LayersSize    int64                  `protobuf:"varint,5,opt,name=layers_size,json=layersSize,proto3" json:"layers_size,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskUsage) Reset() {
-	*x = DiskUsage{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[102]
+func (x *CreateSwarmSecretRequest) Reset() {
+	*x = CreateSwarmSecretRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskUsage) String() string {
+func (x *CreateSwarmSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskUsage) ProtoMessage() {}
+func (*CreateSwarmSecretRequest) ProtoMessage() {}
 
-func (x *DiskUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[102]
+func (x *CreateSwarmSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7243,74 +10632,199 @@ func (x *DiskUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
-func (*DiskUsage) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use CreateSwarmSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwarmSecretRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *DiskUsage) GetImages() []*ImageSummary {
+func (x *CreateSwarmSecretRequest) GetName() string {
 	if x != nil {
-		return x.Images
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *DiskUsage) GetContainers() []*ContainerSummary {
+func (x *CreateSwarmSecretRequest) GetData() []byte {
 	if x != nil {
-		return x.Containers
+		return x.Data
 	}
 	return nil
 }
 
-func (x *DiskUsage) GetVolumes() []*VolumeSummary {
+func (x *CreateSwarmSecretRequest) GetLabels() map[string]string {
 	if x != nil {
-		return x.Volumes
+		return x.Labels
 	}
 	return nil
 }
 
-func (x *DiskUsage) GetBuildCache() []*BuildCacheSummary {
+type CreateSwarmSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSwarmSecretResponse) Reset() {
+	*x = CreateSwarmSecretResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSwarmSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwarmSecretResponse) ProtoMessage() {}
+
+func (x *CreateSwarmSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[153]
 	if x != nil {
-		return x.BuildCache
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DiskUsage) GetLayersSize() int64 {
+// Deprecated: Use CreateSwarmSecretResponse.ProtoReflect.Descriptor instead.
+func (*CreateSwarmSecretResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CreateSwarmSecretResponse) GetId() string {
 	if x != nil {
-		return x.LayersSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type ImageSummary struct {
+type DeleteSwarmSecretRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RepoTags      []string               `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
-	Created       int64                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
-	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	SharedSize    int64                  `protobuf:"varint,5,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
-	VirtualSize   int64                  `protobuf:"varint,6,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
-	Containers    int32                  `protobuf:"varint,7,opt,name=containers,proto3" json:"containers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImageSummary) Reset() {
-	*x = ImageSummary{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[103]
+func (x *DeleteSwarmSecretRequest) Reset() {
+	*x = DeleteSwarmSecretRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSwarmSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSwarmSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSwarmSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSwarmSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSwarmSecretRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *DeleteSwarmSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSwarmSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSwarmSecretResponse) Reset() {
+	*x = DeleteSwarmSecretResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSwarmSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSwarmSecretResponse) ProtoMessage() {}
+
+func (x *DeleteSwarmSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSwarmSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSwarmSecretResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DeleteSwarmSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSwarmSecretResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SwarmConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwarmConfig) Reset() {
+	*x = SwarmConfig{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImageSummary) String() string {
+func (x *SwarmConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageSummary) ProtoMessage() {}
+func (*SwarmConfig) ProtoMessage() {}
 
-func (x *ImageSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[103]
+func (x *SwarmConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7321,91 +10835,74 @@ func (x *ImageSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageSummary.ProtoReflect.Descriptor instead.
-func (*ImageSummary) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use SwarmConfig.ProtoReflect.Descriptor instead.
+func (*SwarmConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *ImageSummary) GetId() string {
+func (x *SwarmConfig) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ImageSummary) GetRepoTags() []string {
-	if x != nil {
-		return x.RepoTags
-	}
-	return nil
-}
-
-func (x *ImageSummary) GetCreated() int64 {
+func (x *SwarmConfig) GetName() string {
 	if x != nil {
-		return x.Created
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ImageSummary) GetSize() int64 {
+func (x *SwarmConfig) GetLabels() map[string]string {
 	if x != nil {
-		return x.Size
+		return x.Labels
 	}
-	return 0
+	return nil
 }
 
-func (x *ImageSummary) GetSharedSize() int64 {
+func (x *SwarmConfig) GetData() []byte {
 	if x != nil {
-		return x.SharedSize
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *ImageSummary) GetVirtualSize() int64 {
+func (x *SwarmConfig) GetCreatedAt() int64 {
 	if x != nil {
-		return x.VirtualSize
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *ImageSummary) GetContainers() int32 {
+func (x *SwarmConfig) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.Containers
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-type ContainerSummary struct {
+type ListSwarmConfigsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	ImageId       string                 `protobuf:"bytes,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	Command       string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
-	Created       int64                  `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
-	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	SizeRw        int64                  `protobuf:"varint,9,opt,name=size_rw,json=sizeRw,proto3" json:"size_rw,omitempty"`
-	SizeRootFs    int64                  `protobuf:"varint,10,opt,name=size_root_fs,json=sizeRootFs,proto3" json:"size_root_fs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerSummary) Reset() {
-	*x = ContainerSummary{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[104]
+func (x *ListSwarmConfigsRequest) Reset() {
+	*x = ListSwarmConfigsRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerSummary) String() string {
+func (x *ListSwarmConfigsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerSummary) ProtoMessage() {}
+func (*ListSwarmConfigsRequest) ProtoMessage() {}
 
-func (x *ContainerSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[104]
+func (x *ListSwarmConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7416,107 +10913,137 @@ func (x *ContainerSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerSummary.ProtoReflect.Descriptor instead.
-func (*ContainerSummary) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use ListSwarmConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwarmConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *ContainerSummary) GetId() string {
+type ListSwarmConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*SwarmConfig         `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSwarmConfigsResponse) Reset() {
+	*x = ListSwarmConfigsResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSwarmConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwarmConfigsResponse) ProtoMessage() {}
+
+func (x *ListSwarmConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[158]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerSummary) GetNames() []string {
+// Deprecated: Use ListSwarmConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwarmConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ListSwarmConfigsResponse) GetConfigs() []*SwarmConfig {
 	if x != nil {
-		return x.Names
+		return x.Configs
 	}
 	return nil
 }
 
-func (x *ContainerSummary) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+type CreateSwarmConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerSummary) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
+func (x *CreateSwarmConfigRequest) Reset() {
+	*x = CreateSwarmConfigRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerSummary) GetCommand() string {
-	if x != nil {
-		return x.Command
-	}
-	return ""
+func (x *CreateSwarmConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ContainerSummary) GetCreated() int64 {
+func (*CreateSwarmConfigRequest) ProtoMessage() {}
+
+func (x *CreateSwarmConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[159]
 	if x != nil {
-		return x.Created
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerSummary) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+// Deprecated: Use CreateSwarmConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwarmConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *ContainerSummary) GetStatus() string {
+func (x *CreateSwarmConfigRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerSummary) GetSizeRw() int64 {
+func (x *CreateSwarmConfigRequest) GetData() []byte {
 	if x != nil {
-		return x.SizeRw
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *ContainerSummary) GetSizeRootFs() int64 {
+func (x *CreateSwarmConfigRequest) GetLabels() map[string]string {
 	if x != nil {
-		return x.SizeRootFs
+		return x.Labels
 	}
-	return 0
+	return nil
 }
 
-type VolumeSummary struct {
+type CreateSwarmConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
-	Mountpoint    string                 `protobuf:"bytes,3,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
-	UsageRefCount int64                  `protobuf:"varint,4,opt,name=usage_ref_count,json=usageRefCount,proto3" json:"usage_ref_count,omitempty"`
-	UsageSize     int64                  `protobuf:"varint,5,opt,name=usage_size,json=usageSize,proto3" json:"usage_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeSummary) Reset() {
-	*x = VolumeSummary{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[105]
+func (x *CreateSwarmConfigResponse) Reset() {
+	*x = CreateSwarmConfigResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeSummary) String() string {
+func (x *CreateSwarmConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeSummary) ProtoMessage() {}
+func (*CreateSwarmConfigResponse) ProtoMessage() {}
 
-func (x *VolumeSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[105]
+func (x *CreateSwarmConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7527,77 +11054,85 @@ func (x *VolumeSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeSummary.ProtoReflect.Descriptor instead.
-func (*VolumeSummary) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use CreateSwarmConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateSwarmConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *VolumeSummary) GetName() string {
+func (x *CreateSwarmConfigResponse) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *VolumeSummary) GetDriver() string {
-	if x != nil {
-		return x.Driver
-	}
-	return ""
+type DeleteSwarmConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeSummary) GetMountpoint() string {
-	if x != nil {
-		return x.Mountpoint
-	}
-	return ""
+func (x *DeleteSwarmConfigRequest) Reset() {
+	*x = DeleteSwarmConfigRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeSummary) GetUsageRefCount() int64 {
+func (x *DeleteSwarmConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSwarmConfigRequest) ProtoMessage() {}
+
+func (x *DeleteSwarmConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[161]
 	if x != nil {
-		return x.UsageRefCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *VolumeSummary) GetUsageSize() int64 {
+// Deprecated: Use DeleteSwarmConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSwarmConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *DeleteSwarmConfigRequest) GetId() string {
 	if x != nil {
-		return x.UsageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type BuildCacheSummary struct {
+type DeleteSwarmConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Parent        string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	InUse         bool                   `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
-	Shared        bool                   `protobuf:"varint,6,opt,name=shared,proto3" json:"shared,omitempty"`
-	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUsedAt    int64                  `protobuf:"varint,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
-	UsageCount    int64                  `protobuf:"varint,10,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildCacheSummary) Reset() {
-	*x = BuildCacheSummary{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[106]
+func (x *DeleteSwarmConfigResponse) Reset() {
+	*x = DeleteSwarmConfigResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildCacheSummary) String() string {
+func (x *DeleteSwarmConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildCacheSummary) ProtoMessage() {}
+func (*DeleteSwarmConfigResponse) ProtoMessage() {}
 
-func (x *BuildCacheSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[106]
+func (x *DeleteSwarmConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7608,102 +11143,98 @@ func (x *BuildCacheSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildCacheSummary.ProtoReflect.Descriptor instead.
-func (*BuildCacheSummary) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{106}
-}
-
-func (x *BuildCacheSummary) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use DeleteSwarmConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSwarmConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *BuildCacheSummary) GetParent() string {
+func (x *DeleteSwarmConfigResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Parent
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *BuildCacheSummary) GetType() string {
+func (x *DeleteSwarmConfigResponse) GetMessage() string {
 	if x != nil {
-		return x.Type
+		return x.Message
 	}
 	return ""
 }
 
-func (x *BuildCacheSummary) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type Stack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Services      int32                  `protobuf:"varint,2,opt,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildCacheSummary) GetInUse() bool {
-	if x != nil {
-		return x.InUse
-	}
-	return false
+func (x *Stack) Reset() {
+	*x = Stack{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildCacheSummary) GetShared() bool {
-	if x != nil {
-		return x.Shared
-	}
-	return false
+func (x *Stack) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BuildCacheSummary) GetSize() int64 {
+func (*Stack) ProtoMessage() {}
+
+func (x *Stack) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[163]
 	if x != nil {
-		return x.Size
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *BuildCacheSummary) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
+// Deprecated: Use Stack.ProtoReflect.Descriptor instead.
+func (*Stack) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *BuildCacheSummary) GetLastUsedAt() int64 {
+func (x *Stack) GetName() string {
 	if x != nil {
-		return x.LastUsedAt
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *BuildCacheSummary) GetUsageCount() int64 {
+func (x *Stack) GetServices() int32 {
 	if x != nil {
-		return x.UsageCount
+		return x.Services
 	}
 	return 0
 }
 
-type PingRequest struct {
+type ListStacksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[107]
+func (x *ListStacksRequest) Reset() {
+	*x = ListStacksRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingRequest) String() string {
+func (x *ListStacksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*ListStacksRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[107]
+func (x *ListStacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7714,36 +11245,33 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use ListStacksRequest.ProtoReflect.Descriptor instead.
+func (*ListStacksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{164}
 }
 
-type PingResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ApiVersion     string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	OsType         string                 `protobuf:"bytes,2,opt,name=os_type,json=osType,proto3" json:"os_type,omitempty"`
-	Experimental   bool                   `protobuf:"varint,3,opt,name=experimental,proto3" json:"experimental,omitempty"`
-	BuilderVersion string                 `protobuf:"bytes,4,opt,name=builder_version,json=builderVersion,proto3" json:"builder_version,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListStacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stacks        []*Stack               `protobuf:"bytes,1,rep,name=stacks,proto3" json:"stacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[108]
+func (x *ListStacksResponse) Reset() {
+	*x = ListStacksResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingResponse) String() string {
+func (x *ListStacksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*ListStacksResponse) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[108]
+func (x *ListStacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7754,63 +11282,43 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{108}
-}
-
-func (x *PingResponse) GetApiVersion() string {
-	if x != nil {
-		return x.ApiVersion
-	}
-	return ""
-}
-
-func (x *PingResponse) GetOsType() string {
-	if x != nil {
-		return x.OsType
-	}
-	return ""
-}
-
-func (x *PingResponse) GetExperimental() bool {
-	if x != nil {
-		return x.Experimental
-	}
-	return false
+// Deprecated: Use ListStacksResponse.ProtoReflect.Descriptor instead.
+func (*ListStacksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *PingResponse) GetBuilderVersion() string {
+func (x *ListStacksResponse) GetStacks() []*Stack {
 	if x != nil {
-		return x.BuilderVersion
+		return x.Stacks
 	}
-	return ""
+	return nil
 }
 
-type GetEventsRequest struct {
+type DeployStackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Since         string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`     // Show events since timestamp or relative (e.g., "10m")
-	Until         string                 `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`     // Show events until timestamp or relative
-	Filters       string                 `protobuf:"bytes,3,opt,name=filters,proto3" json:"filters,omitempty"` // Event filters in JSON format
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Compose       string                 `protobuf:"bytes,2,opt,name=compose,proto3" json:"compose,omitempty"`                                                                                                         // Compose file (version 3) content
+	Prune         bool                   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`                                                                                                            // Remove services of the stack missing from the compose file
+	RegistryAuth  map[string]string      `protobuf:"bytes,4,rep,name=registry_auth,json=registryAuth,proto3" json:"registry_auth,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Base64 encoded auth config by image reference
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventsRequest) Reset() {
-	*x = GetEventsRequest{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[109]
+func (x *DeployStackRequest) Reset() {
+	*x = DeployStackRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsRequest) String() string {
+func (x *DeployStackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsRequest) ProtoMessage() {}
+func (*DeployStackRequest) ProtoMessage() {}
 
-func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[109]
+func (x *DeployStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7821,59 +11329,65 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use DeployStackRequest.ProtoReflect.Descriptor instead.
+func (*DeployStackRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetEventsRequest) GetSince() string {
+func (x *DeployStackRequest) GetName() string {
 	if x != nil {
-		return x.Since
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetEventsRequest) GetUntil() string {
+func (x *DeployStackRequest) GetCompose() string {
 	if x != nil {
-		return x.Until
+		return x.Compose
 	}
 	return ""
 }
 
-func (x *GetEventsRequest) GetFilters() string {
+func (x *DeployStackRequest) GetPrune() bool {
 	if x != nil {
-		return x.Filters
+		return x.Prune
 	}
-	return ""
+	return false
 }
 
-type DockerEvent struct {
+func (x *DeployStackRequest) GetRegistryAuth() map[string]string {
+	if x != nil {
+		return x.RegistryAuth
+	}
+	return nil
+}
+
+type DeployStackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`     // container, image, volume, network, daemon, etc.
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // create, start, stop, destroy, pull, etc.
-	Actor         *Actor                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
-	Time          int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"` // Unix timestamp
-	TimeNano      int64                  `protobuf:"varint,5,opt,name=time_nano,json=timeNano,proto3" json:"time_nano,omitempty"`
-	Scope         string                 `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"` // local or swarm
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Created       []string               `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"` // Names of created services, networks, secrets and configs
+	Updated       []string               `protobuf:"bytes,3,rep,name=updated,proto3" json:"updated,omitempty"`
+	Removed       []string               `protobuf:"bytes,4,rep,name=removed,proto3" json:"removed,omitempty"`
+	Warnings      []string               `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DockerEvent) Reset() {
-	*x = DockerEvent{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[110]
+func (x *DeployStackResponse) Reset() {
+	*x = DeployStackResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DockerEvent) String() string {
+func (x *DeployStackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerEvent) ProtoMessage() {}
+func (*DeployStackResponse) ProtoMessage() {}
 
-func (x *DockerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[110]
+func (x *DeployStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7884,76 +11398,114 @@ func (x *DockerEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerEvent.ProtoReflect.Descriptor instead.
-func (*DockerEvent) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use DeployStackResponse.ProtoReflect.Descriptor instead.
+func (*DeployStackResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *DockerEvent) GetType() string {
+func (x *DeployStackResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Type
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *DockerEvent) GetAction() string {
+func (x *DeployStackResponse) GetCreated() []string {
 	if x != nil {
-		return x.Action
+		return x.Created
 	}
-	return ""
+	return nil
 }
 
-func (x *DockerEvent) GetActor() *Actor {
+func (x *DeployStackResponse) GetUpdated() []string {
 	if x != nil {
-		return x.Actor
+		return x.Updated
 	}
 	return nil
 }
 
-func (x *DockerEvent) GetTime() int64 {
+func (x *DeployStackResponse) GetRemoved() []string {
 	if x != nil {
-		return x.Time
+		return x.Removed
 	}
-	return 0
+	return nil
 }
 
-func (x *DockerEvent) GetTimeNano() int64 {
+func (x *DeployStackResponse) GetWarnings() []string {
 	if x != nil {
-		return x.TimeNano
+		return x.Warnings
 	}
-	return 0
+	return nil
 }
 
-func (x *DockerEvent) GetScope() string {
+type RemoveStackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStackRequest) Reset() {
+	*x = RemoveStackRequest{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStackRequest) ProtoMessage() {}
+
+func (x *RemoveStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[168]
 	if x != nil {
-		return x.Scope
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStackRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStackRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *RemoveStackRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type Actor struct {
+type RemoveStackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Attributes    map[string]string      `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Removed       []string               `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"` // Objects that could not be removed yet
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Actor) Reset() {
-	*x = Actor{}
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[111]
+func (x *RemoveStackResponse) Reset() {
+	*x = RemoveStackResponse{}
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Actor) String() string {
+func (x *RemoveStackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Actor) ProtoMessage() {}
+func (*RemoveStackResponse) ProtoMessage() {}
 
-func (x *Actor) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[111]
+func (x *RemoveStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_proto_docker_docker_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7964,21 +11516,28 @@ func (x *Actor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
-func (*Actor) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use RemoveStackResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStackResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *Actor) GetId() string {
+func (x *RemoveStackResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *Actor) GetAttributes() map[string]string {
+func (x *RemoveStackResponse) GetRemoved() []string {
 	if x != nil {
-		return x.Attributes
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *RemoveStackResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
 	}
 	return nil
 }
@@ -8221,7 +11780,36 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\x0eremove_volumes\x18\x03 \x01(\bR\rremoveVolumes\"M\n" +
 	"\x17DeleteContainerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Y\n" +
+	"\x16PruneContainersRequest\x12&\n" +
+	"\x0folder_than_days\x18\x01 \x01(\x05R\rolderThanDays\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x8a\x01\n" +
+	"\x17PruneContainersResponse\x12-\n" +
+	"\x12containers_deleted\x18\x01 \x03(\tR\x11containersDeleted\x12'\n" +
+	"\x0fspace_reclaimed\x18\x02 \x01(\x04R\x0espaceReclaimed\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xd7\x01\n" +
+	"\x18RecreateContainerRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x128\n" +
+	"\tresources\x18\x03 \x01(\v2\x1a.docker.ContainerResourcesR\tresources\x12!\n" +
+	"\fstop_timeout\x18\x04 \x01(\x05R\vstopTimeout\x12%\n" +
+	"\x0ehealth_timeout\x18\x05 \x01(\x05R\rhealthTimeout\"\x89\x01\n" +
+	"\x12ContainerResources\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\x03R\x06memory\x12\x1f\n" +
+	"\vmemory_swap\x18\x02 \x01(\x03R\n" +
+	"memorySwap\x12\x1b\n" +
+	"\tnano_cpus\x18\x03 \x01(\x03R\bnanoCpus\x12\x1d\n" +
+	"\n" +
+	"cpu_shares\x18\x04 \x01(\x03R\tcpuShares\"\xf0\x01\n" +
+	"\x19RecreateContainerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fcontainer_id\x18\x03 \x01(\tR\vcontainerId\x12(\n" +
+	"\x10old_container_id\x18\x04 \x01(\tR\x0eoldContainerId\x12\x1b\n" +
+	"\told_image\x18\x05 \x01(\tR\boldImage\x12\x14\n" +
+	"\x05image\x18\x06 \x01(\tR\x05image\x12\x1f\n" +
+	"\vrolled_back\x18\a \x01(\bR\n" +
+	"rolledBack\"U\n" +
 	"\x18GetContainerStatsRequest\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
 	"\x06stream\x18\x02 \x01(\bR\x06stream\"\xfd\x03\n" +
@@ -8334,7 +11922,39 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\tExecError\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"'\n" +
 	"\bExecExit\x12\x1b\n" +
-	"\texit_code\x18\x01 \x01(\x05R\bexitCode\"?\n" +
+	"\texit_code\x18\x01 \x01(\x05R\bexitCode\"R\n" +
+	"\x19ListContainerFilesRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"{\n" +
+	"\x1aListContainerFilesResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12+\n" +
+	"\x05files\x18\x02 \x03(\v2\x15.docker.ContainerFileR\x05files\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\xd7\x01\n" +
+	"\rContainerFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x15\n" +
+	"\x06is_dir\x18\x03 \x01(\bR\x05isDir\x12\x1d\n" +
+	"\n" +
+	"is_symlink\x18\x04 \x01(\bR\tisSymlink\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vmodified_at\x18\a \x01(\x03R\n" +
+	"modifiedAt\x12\x1f\n" +
+	"\vlink_target\x18\b \x01(\tR\n" +
+	"linkTarget\"m\n" +
+	"\x18CopyFromContainerRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
+	"\bcompress\x18\x03 \x01(\bR\bcompress\"\"\n" +
+	"\fArchiveChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"c\n" +
+	"\x16CopyToContainerRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"M\n" +
+	"\x17CopyToContainerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"?\n" +
 	"\x11ListImagesRequest\x12\x10\n" +
 	"\x03all\x18\x01 \x01(\bR\x03all\x12\x18\n" +
 	"\afilters\x18\x02 \x01(\tR\afilters\";\n" +
@@ -8434,7 +12054,15 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\x06target\x18\x02 \x01(\tR\x06target\"F\n" +
 	"\x10TagImageResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x14\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"g\n" +
+	"\x12PruneImagesRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\x12&\n" +
+	"\x0folder_than_days\x18\x02 \x01(\x05R\rolderThanDays\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"~\n" +
+	"\x13PruneImagesResponse\x12%\n" +
+	"\x0eimages_deleted\x18\x01 \x03(\tR\rimagesDeleted\x12'\n" +
+	"\x0fspace_reclaimed\x18\x02 \x01(\x04R\x0espaceReclaimed\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x14\n" +
 	"\x12ListVolumesRequest\"[\n" +
 	"\x13ListVolumesResponse\x12(\n" +
 	"\avolumes\x18\x01 \x03(\v2\x0e.docker.VolumeR\avolumes\x12\x1a\n" +
@@ -8615,7 +12243,7 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\x16\n" +
 	"\x14GetSystemInfoRequest\"?\n" +
 	"\x15GetSystemInfoResponse\x12&\n" +
-	"\x04info\x18\x01 \x01(\v2\x12.docker.SystemInfoR\x04info\"\xfd\v\n" +
+	"\x04info\x18\x01 \x01(\v2\x12.docker.SystemInfoR\x04info\"\xa6\f\n" +
 	"\n" +
 	"SystemInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
@@ -8663,10 +12291,21 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\tmem_total\x18$ \x01(\x03R\bmemTotal\x120\n" +
 	"\x14index_server_address\x18% \x01(\tR\x12indexServerAddress\x12?\n" +
 	"\x0fregistry_config\x18& \x01(\v2\x16.docker.RegistryConfigR\x0eregistryConfig\x12\x1a\n" +
-	"\bwarnings\x18' \x03(\tR\bwarnings\x1a?\n" +
+	"\bwarnings\x18' \x03(\tR\bwarnings\x12'\n" +
+	"\x05swarm\x18( \x01(\v2\x11.docker.SwarmInfoR\x05swarm\x1a?\n" +
 	"\x11SystemStatusEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xff\x01\n" +
+	"\tSwarmInfo\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_addr\x18\x02 \x01(\tR\bnodeAddr\x12(\n" +
+	"\x10local_node_state\x18\x03 \x01(\tR\x0elocalNodeState\x12+\n" +
+	"\x11control_available\x18\x04 \x01(\bR\x10controlAvailable\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x05 \x01(\tR\tclusterId\x12\x14\n" +
+	"\x05nodes\x18\x06 \x01(\x05R\x05nodes\x12\x1a\n" +
+	"\bmanagers\x18\a \x01(\x05R\bmanagers\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"0\n" +
 	"\x06Plugin\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"8\n" +
@@ -8707,7 +12346,15 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x15\n" +
 	"\x13GetDiskUsageRequest\"?\n" +
 	"\x14GetDiskUsageResponse\x12'\n" +
-	"\x05usage\x18\x01 \x01(\v2\x11.docker.DiskUsageR\x05usage\"\x81\x02\n" +
+	"\x05usage\x18\x01 \x01(\v2\x11.docker.DiskUsageR\x05usage\"k\n" +
+	"\x16PruneBuildCacheRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\x12&\n" +
+	"\x0folder_than_days\x18\x02 \x01(\x05R\rolderThanDays\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x82\x01\n" +
+	"\x17PruneBuildCacheResponse\x12%\n" +
+	"\x0ecaches_deleted\x18\x01 \x03(\tR\rcachesDeleted\x12'\n" +
+	"\x0fspace_reclaimed\x18\x02 \x01(\x04R\x0espaceReclaimed\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x81\x02\n" +
 	"\tDiskUsage\x12,\n" +
 	"\x06images\x18\x01 \x03(\v2\x14.docker.ImageSummaryR\x06images\x128\n" +
 	"\n" +
@@ -8791,7 +12438,209 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"attributes\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xa5\x13\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x17\n" +
+	"\x15ListSwarmNodesRequest\"A\n" +
+	"\x16ListSwarmNodesResponse\x12'\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x11.docker.SwarmNodeR\x05nodes\"\xaf\x04\n" +
+	"\tSwarmNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\"\n" +
+	"\favailability\x18\x04 \x01(\tR\favailability\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x12\n" +
+	"\x04addr\x18\x06 \x01(\tR\x04addr\x12\x16\n" +
+	"\x06leader\x18\a \x01(\bR\x06leader\x121\n" +
+	"\x14manager_reachability\x18\b \x01(\tR\x13managerReachability\x12%\n" +
+	"\x0eengine_version\x18\t \x01(\tR\rengineVersion\x12\x0e\n" +
+	"\x02os\x18\n" +
+	" \x01(\tR\x02os\x12\"\n" +
+	"\farchitecture\x18\v \x01(\tR\farchitecture\x12\x1b\n" +
+	"\tnano_cpus\x18\f \x01(\x03R\bnanoCpus\x12!\n" +
+	"\fmemory_bytes\x18\r \x01(\x03R\vmemoryBytes\x125\n" +
+	"\x06labels\x18\x0e \x03(\v2\x1d.docker.SwarmNode.LabelsEntryR\x06labels\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\x03R\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
+	"\x16UpdateSwarmNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\"\n" +
+	"\favailability\x18\x02 \x01(\tR\favailability\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"M\n" +
+	"\x17UpdateSwarmNodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"0\n" +
+	"\x18ListSwarmServicesRequest\x12\x14\n" +
+	"\x05stack\x18\x01 \x01(\tR\x05stack\"M\n" +
+	"\x19ListSwarmServicesResponse\x120\n" +
+	"\bservices\x18\x01 \x03(\v2\x14.docker.SwarmServiceR\bservices\"\xbb\x04\n" +
+	"\fSwarmService\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x1a\n" +
+	"\breplicas\x18\x05 \x01(\x04R\breplicas\x12#\n" +
+	"\rrunning_tasks\x18\x06 \x01(\x04R\frunningTasks\x12#\n" +
+	"\rdesired_tasks\x18\a \x01(\x04R\fdesiredTasks\x12\x14\n" +
+	"\x05stack\x18\b \x01(\tR\x05stack\x128\n" +
+	"\x06labels\x18\t \x03(\v2 .docker.SwarmService.LabelsEntryR\x06labels\x12'\n" +
+	"\x05ports\x18\n" +
+	" \x03(\v2\x11.docker.SwarmPortR\x05ports\x12!\n" +
+	"\fupdate_state\x18\v \x01(\tR\vupdateState\x12%\n" +
+	"\x0eupdate_message\x18\f \x01(\tR\rupdateMessage\x12!\n" +
+	"\fcan_rollback\x18\r \x01(\bR\vcanRollback\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x04R\aversion\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\x03R\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x01\n" +
+	"\tSwarmPort\x12\x1a\n" +
+	"\bprotocol\x18\x01 \x01(\tR\bprotocol\x12\x1f\n" +
+	"\vtarget_port\x18\x02 \x01(\rR\n" +
+	"targetPort\x12%\n" +
+	"\x0epublished_port\x18\x03 \x01(\rR\rpublishedPort\x12!\n" +
+	"\fpublish_mode\x18\x04 \x01(\tR\vpublishMode\"7\n" +
+	"\x16GetSwarmServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"f\n" +
+	"\x17GetSwarmServiceResponse\x12.\n" +
+	"\aservice\x18\x01 \x01(\v2\x14.docker.SwarmServiceR\aservice\x12\x1b\n" +
+	"\tspec_json\x18\x02 \x01(\tR\bspecJson\"U\n" +
+	"\x18ScaleSwarmServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x04R\breplicas\"z\n" +
+	"\x1eUpdateSwarmServiceImageRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12#\n" +
+	"\rregistry_auth\x18\x03 \x01(\tR\fregistryAuth\"<\n" +
+	"\x1bRollbackSwarmServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"l\n" +
+	"\x1aUpdateSwarmServiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\":\n" +
+	"\x19DeleteSwarmServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"P\n" +
+	"\x1aDeleteSwarmServiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"t\n" +
+	"\x15ListSwarmTasksRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12#\n" +
+	"\rdesired_state\x18\x03 \x01(\tR\fdesiredState\"A\n" +
+	"\x16ListSwarmTasksResponse\x12'\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x11.docker.SwarmTaskR\x05tasks\"\xe6\x02\n" +
+	"\tSwarmTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04slot\x18\x04 \x01(\x05R\x04slot\x12\x14\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12#\n" +
+	"\rdesired_state\x18\x06 \x01(\tR\fdesiredState\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12!\n" +
+	"\fcontainer_id\x18\n" +
+	" \x01(\tR\vcontainerId\x12\x1b\n" +
+	"\texit_code\x18\v \x01(\x05R\bexitCode\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\"\xe3\x01\n" +
+	"\vSwarmSecret\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\x06labels\x18\x03 \x03(\v2\x1f.docker.SwarmSecret.LabelsEntryR\x06labels\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x19\n" +
+	"\x17ListSwarmSecretsRequest\"I\n" +
+	"\x18ListSwarmSecretsResponse\x12-\n" +
+	"\asecrets\x18\x01 \x03(\v2\x13.docker.SwarmSecretR\asecrets\"\xc3\x01\n" +
+	"\x18CreateSwarmSecretRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12D\n" +
+	"\x06labels\x18\x03 \x03(\v2,.docker.CreateSwarmSecretRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"+\n" +
+	"\x19CreateSwarmSecretResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18DeleteSwarmSecretRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x19DeleteSwarmSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf7\x01\n" +
+	"\vSwarmConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\x06labels\x18\x03 \x03(\v2\x1f.docker.SwarmConfig.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x19\n" +
+	"\x17ListSwarmConfigsRequest\"I\n" +
+	"\x18ListSwarmConfigsResponse\x12-\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x13.docker.SwarmConfigR\aconfigs\"\xc3\x01\n" +
+	"\x18CreateSwarmConfigRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12D\n" +
+	"\x06labels\x18\x03 \x03(\v2,.docker.CreateSwarmConfigRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"+\n" +
+	"\x19CreateSwarmConfigResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18DeleteSwarmConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x19DeleteSwarmConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
+	"\x05Stack\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bservices\x18\x02 \x01(\x05R\bservices\"\x13\n" +
+	"\x11ListStacksRequest\";\n" +
+	"\x12ListStacksResponse\x12%\n" +
+	"\x06stacks\x18\x01 \x03(\v2\r.docker.StackR\x06stacks\"\xec\x01\n" +
+	"\x12DeployStackRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acompose\x18\x02 \x01(\tR\acompose\x12\x14\n" +
+	"\x05prune\x18\x03 \x01(\bR\x05prune\x12Q\n" +
+	"\rregistry_auth\x18\x04 \x03(\v2,.docker.DeployStackRequest.RegistryAuthEntryR\fregistryAuth\x1a?\n" +
+	"\x11RegistryAuthEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\x13DeployStackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\acreated\x18\x02 \x03(\tR\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x03(\tR\aupdated\x12\x18\n" +
+	"\aremoved\x18\x04 \x03(\tR\aremoved\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\"(\n" +
+	"\x12RemoveStackRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"e\n" +
+	"\x13RemoveStackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings2\x80$\n" +
 	"\rDockerService\x12L\n" +
 	"\rGetDockerInfo\x12\x1c.docker.GetDockerInfoRequest\x1a\x1d.docker.GetDockerInfoResponse\x12O\n" +
 	"\x0eListContainers\x12\x1d.docker.ListContainersRequest\x1a\x1e.docker.ListContainersResponse\x12I\n" +
@@ -8801,16 +12650,22 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\x10RestartContainer\x12\x1f.docker.RestartContainerRequest\x1a .docker.RestartContainerResponse\x12O\n" +
 	"\x0ePauseContainer\x12\x1d.docker.PauseContainerRequest\x1a\x1e.docker.PauseContainerResponse\x12U\n" +
 	"\x10UnpauseContainer\x12\x1f.docker.UnpauseContainerRequest\x1a .docker.UnpauseContainerResponse\x12R\n" +
-	"\x0fDeleteContainer\x12\x1e.docker.DeleteContainerRequest\x1a\x1f.docker.DeleteContainerResponse\x12O\n" +
+	"\x0fDeleteContainer\x12\x1e.docker.DeleteContainerRequest\x1a\x1f.docker.DeleteContainerResponse\x12R\n" +
+	"\x0fPruneContainers\x12\x1e.docker.PruneContainersRequest\x1a\x1f.docker.PruneContainersResponse\x12X\n" +
+	"\x11RecreateContainer\x12 .docker.RecreateContainerRequest\x1a!.docker.RecreateContainerResponse\x12O\n" +
 	"\x11GetContainerStats\x12 .docker.GetContainerStatsRequest\x1a\x16.docker.ContainerStats0\x01\x12G\n" +
 	"\x10GetContainerLogs\x12\x1f.docker.GetContainerLogsRequest\x1a\x10.docker.LogEntry0\x01\x12>\n" +
-	"\rExecContainer\x12\x13.docker.ExecRequest\x1a\x14.docker.ExecResponse(\x010\x01\x12C\n" +
+	"\rExecContainer\x12\x13.docker.ExecRequest\x1a\x14.docker.ExecResponse(\x010\x01\x12[\n" +
+	"\x12ListContainerFiles\x12!.docker.ListContainerFilesRequest\x1a\".docker.ListContainerFilesResponse\x12M\n" +
+	"\x11CopyFromContainer\x12 .docker.CopyFromContainerRequest\x1a\x14.docker.ArchiveChunk0\x01\x12T\n" +
+	"\x0fCopyToContainer\x12\x1e.docker.CopyToContainerRequest\x1a\x1f.docker.CopyToContainerResponse(\x01\x12C\n" +
 	"\n" +
 	"ListImages\x12\x19.docker.ListImagesRequest\x1a\x1a.docker.ListImagesResponse\x12=\n" +
 	"\bGetImage\x12\x17.docker.GetImageRequest\x1a\x18.docker.GetImageResponse\x12F\n" +
 	"\vDeleteImage\x12\x1a.docker.DeleteImageRequest\x1a\x1b.docker.DeleteImageResponse\x12B\n" +
 	"\tPullImage\x12\x18.docker.PullImageRequest\x1a\x19.docker.PullImageProgress0\x01\x12=\n" +
 	"\bTagImage\x12\x17.docker.TagImageRequest\x1a\x18.docker.TagImageResponse\x12F\n" +
+	"\vPruneImages\x12\x1a.docker.PruneImagesRequest\x1a\x1b.docker.PruneImagesResponse\x12F\n" +
 	"\vListVolumes\x12\x1a.docker.ListVolumesRequest\x1a\x1b.docker.ListVolumesResponse\x12@\n" +
 	"\tGetVolume\x12\x18.docker.GetVolumeRequest\x1a\x19.docker.GetVolumeResponse\x12I\n" +
 	"\fCreateVolume\x12\x1b.docker.CreateVolumeRequest\x1a\x1c.docker.CreateVolumeResponse\x12I\n" +
@@ -8826,9 +12681,29 @@ const file_pkg_grpc_proto_docker_docker_proto_rawDesc = "" +
 	"\rGetSystemInfo\x12\x1c.docker.GetSystemInfoRequest\x1a\x1d.docker.GetSystemInfoResponse\x12C\n" +
 	"\n" +
 	"GetVersion\x12\x19.docker.GetVersionRequest\x1a\x1a.docker.GetVersionResponse\x12I\n" +
-	"\fGetDiskUsage\x12\x1b.docker.GetDiskUsageRequest\x1a\x1c.docker.GetDiskUsageResponse\x121\n" +
+	"\fGetDiskUsage\x12\x1b.docker.GetDiskUsageRequest\x1a\x1c.docker.GetDiskUsageResponse\x12R\n" +
+	"\x0fPruneBuildCache\x12\x1e.docker.PruneBuildCacheRequest\x1a\x1f.docker.PruneBuildCacheResponse\x121\n" +
 	"\x04Ping\x12\x13.docker.PingRequest\x1a\x14.docker.PingResponse\x12<\n" +
-	"\tGetEvents\x12\x18.docker.GetEventsRequest\x1a\x13.docker.DockerEvent0\x01B/Z-github.com/ysicing/tiga/pkg/grpc/proto/dockerb\x06proto3"
+	"\tGetEvents\x12\x18.docker.GetEventsRequest\x1a\x13.docker.DockerEvent0\x01\x12O\n" +
+	"\x0eListSwarmNodes\x12\x1d.docker.ListSwarmNodesRequest\x1a\x1e.docker.ListSwarmNodesResponse\x12R\n" +
+	"\x0fUpdateSwarmNode\x12\x1e.docker.UpdateSwarmNodeRequest\x1a\x1f.docker.UpdateSwarmNodeResponse\x12X\n" +
+	"\x11ListSwarmServices\x12 .docker.ListSwarmServicesRequest\x1a!.docker.ListSwarmServicesResponse\x12R\n" +
+	"\x0fGetSwarmService\x12\x1e.docker.GetSwarmServiceRequest\x1a\x1f.docker.GetSwarmServiceResponse\x12Y\n" +
+	"\x11ScaleSwarmService\x12 .docker.ScaleSwarmServiceRequest\x1a\".docker.UpdateSwarmServiceResponse\x12e\n" +
+	"\x17UpdateSwarmServiceImage\x12&.docker.UpdateSwarmServiceImageRequest\x1a\".docker.UpdateSwarmServiceResponse\x12_\n" +
+	"\x14RollbackSwarmService\x12#.docker.RollbackSwarmServiceRequest\x1a\".docker.UpdateSwarmServiceResponse\x12[\n" +
+	"\x12DeleteSwarmService\x12!.docker.DeleteSwarmServiceRequest\x1a\".docker.DeleteSwarmServiceResponse\x12O\n" +
+	"\x0eListSwarmTasks\x12\x1d.docker.ListSwarmTasksRequest\x1a\x1e.docker.ListSwarmTasksResponse\x12U\n" +
+	"\x10ListSwarmSecrets\x12\x1f.docker.ListSwarmSecretsRequest\x1a .docker.ListSwarmSecretsResponse\x12X\n" +
+	"\x11CreateSwarmSecret\x12 .docker.CreateSwarmSecretRequest\x1a!.docker.CreateSwarmSecretResponse\x12X\n" +
+	"\x11DeleteSwarmSecret\x12 .docker.DeleteSwarmSecretRequest\x1a!.docker.DeleteSwarmSecretResponse\x12U\n" +
+	"\x10ListSwarmConfigs\x12\x1f.docker.ListSwarmConfigsRequest\x1a .docker.ListSwarmConfigsResponse\x12X\n" +
+	"\x11CreateSwarmConfig\x12 .docker.CreateSwarmConfigRequest\x1a!.docker.CreateSwarmConfigResponse\x12X\n" +
+	"\x11DeleteSwarmConfig\x12 .docker.DeleteSwarmConfigRequest\x1a!.docker.DeleteSwarmConfigResponse\x12C\n" +
+	"\n" +
+	"ListStacks\x12\x19.docker.ListStacksRequest\x1a\x1a.docker.ListStacksResponse\x12F\n" +
+	"\vDeployStack\x12\x1a.docker.DeployStackRequest\x1a\x1b.docker.DeployStackResponse\x12F\n" +
+	"\vRemoveStack\x12\x1a.docker.RemoveStackRequest\x1a\x1b.docker.RemoveStackResponseB/Z-github.com/ysicing/tiga/pkg/grpc/proto/dockerb\x06proto3"
 
 var (
 	file_pkg_grpc_proto_docker_docker_proto_rawDescOnce sync.Once
@@ -8842,302 +12717,435 @@ func file_pkg_grpc_proto_docker_docker_proto_rawDescGZIP() []byte {
 	return file_pkg_grpc_proto_docker_docker_proto_rawDescData
 }
 
-var file_pkg_grpc_proto_docker_docker_proto_msgTypes = make([]protoimpl.MessageInfo, 140)
+var file_pkg_grpc_proto_docker_docker_proto_msgTypes = make([]protoimpl.MessageInfo, 205)
 var file_pkg_grpc_proto_docker_docker_proto_goTypes = []any{
-	(*GetDockerInfoRequest)(nil),      // 0: docker.GetDockerInfoRequest
-	(*GetDockerInfoResponse)(nil),     // 1: docker.GetDockerInfoResponse
-	(*ListContainersRequest)(nil),     // 2: docker.ListContainersRequest
-	(*ListContainersResponse)(nil),    // 3: docker.ListContainersResponse
-	(*Container)(nil),                 // 4: docker.Container
-	(*Port)(nil),                      // 5: docker.Port
-	(*Mount)(nil),                     // 6: docker.Mount
-	(*NetworkConfig)(nil),             // 7: docker.NetworkConfig
-	(*GetContainerRequest)(nil),       // 8: docker.GetContainerRequest
-	(*GetContainerResponse)(nil),      // 9: docker.GetContainerResponse
-	(*ContainerDetail)(nil),           // 10: docker.ContainerDetail
-	(*ContainerState)(nil),            // 11: docker.ContainerState
-	(*ContainerConfig)(nil),           // 12: docker.ContainerConfig
-	(*HostConfig)(nil),                // 13: docker.HostConfig
-	(*PortBinding)(nil),               // 14: docker.PortBinding
-	(*NetworkSettings)(nil),           // 15: docker.NetworkSettings
-	(*StartContainerRequest)(nil),     // 16: docker.StartContainerRequest
-	(*StartContainerResponse)(nil),    // 17: docker.StartContainerResponse
-	(*StopContainerRequest)(nil),      // 18: docker.StopContainerRequest
-	(*StopContainerResponse)(nil),     // 19: docker.StopContainerResponse
-	(*RestartContainerRequest)(nil),   // 20: docker.RestartContainerRequest
-	(*RestartContainerResponse)(nil),  // 21: docker.RestartContainerResponse
-	(*PauseContainerRequest)(nil),     // 22: docker.PauseContainerRequest
-	(*PauseContainerResponse)(nil),    // 23: docker.PauseContainerResponse
-	(*UnpauseContainerRequest)(nil),   // 24: docker.UnpauseContainerRequest
-	(*UnpauseContainerResponse)(nil),  // 25: docker.UnpauseContainerResponse
-	(*DeleteContainerRequest)(nil),    // 26: docker.DeleteContainerRequest
-	(*DeleteContainerResponse)(nil),   // 27: docker.DeleteContainerResponse
-	(*GetContainerStatsRequest)(nil),  // 28: docker.GetContainerStatsRequest
-	(*ContainerStats)(nil),            // 29: docker.ContainerStats
-	(*CPUStats)(nil),                  // 30: docker.CPUStats
-	(*MemoryStats)(nil),               // 31: docker.MemoryStats
-	(*BlkioStats)(nil),                // 32: docker.BlkioStats
-	(*BlkioStatEntry)(nil),            // 33: docker.BlkioStatEntry
-	(*NetworkStats)(nil),              // 34: docker.NetworkStats
-	(*PidsStats)(nil),                 // 35: docker.PidsStats
-	(*GetContainerLogsRequest)(nil),   // 36: docker.GetContainerLogsRequest
-	(*LogEntry)(nil),                  // 37: docker.LogEntry
-	(*ExecRequest)(nil),               // 38: docker.ExecRequest
-	(*ExecStart)(nil),                 // 39: docker.ExecStart
-	(*ExecInput)(nil),                 // 40: docker.ExecInput
-	(*ExecResize)(nil),                // 41: docker.ExecResize
-	(*ExecResponse)(nil),              // 42: docker.ExecResponse
-	(*ExecOutput)(nil),                // 43: docker.ExecOutput
-	(*ExecError)(nil),                 // 44: docker.ExecError
-	(*ExecExit)(nil),                  // 45: docker.ExecExit
-	(*ListImagesRequest)(nil),         // 46: docker.ListImagesRequest
-	(*ListImagesResponse)(nil),        // 47: docker.ListImagesResponse
-	(*Image)(nil),                     // 48: docker.Image
-	(*GetImageRequest)(nil),           // 49: docker.GetImageRequest
-	(*GetImageResponse)(nil),          // 50: docker.GetImageResponse
-	(*ImageDetail)(nil),               // 51: docker.ImageDetail
-	(*ImageConfig)(nil),               // 52: docker.ImageConfig
-	(*RootFS)(nil),                    // 53: docker.RootFS
-	(*DeleteImageRequest)(nil),        // 54: docker.DeleteImageRequest
-	(*DeleteImageResponse)(nil),       // 55: docker.DeleteImageResponse
-	(*ImageDeleteResponse)(nil),       // 56: docker.ImageDeleteResponse
-	(*PullImageRequest)(nil),          // 57: docker.PullImageRequest
-	(*PullImageProgress)(nil),         // 58: docker.PullImageProgress
-	(*TagImageRequest)(nil),           // 59: docker.TagImageRequest
-	(*TagImageResponse)(nil),          // 60: docker.TagImageResponse
-	(*ListVolumesRequest)(nil),        // 61: docker.ListVolumesRequest
-	(*ListVolumesResponse)(nil),       // 62: docker.ListVolumesResponse
-	(*Volume)(nil),                    // 63: docker.Volume
-	(*VolumeUsageData)(nil),           // 64: docker.VolumeUsageData
-	(*GetVolumeRequest)(nil),          // 65: docker.GetVolumeRequest
-	(*GetVolumeResponse)(nil),         // 66: docker.GetVolumeResponse
-	(*CreateVolumeRequest)(nil),       // 67: docker.CreateVolumeRequest
-	(*CreateVolumeResponse)(nil),      // 68: docker.CreateVolumeResponse
-	(*DeleteVolumeRequest)(nil),       // 69: docker.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),      // 70: docker.DeleteVolumeResponse
-	(*PruneVolumesRequest)(nil),       // 71: docker.PruneVolumesRequest
-	(*PruneVolumesResponse)(nil),      // 72: docker.PruneVolumesResponse
-	(*ListNetworksRequest)(nil),       // 73: docker.ListNetworksRequest
-	(*ListNetworksResponse)(nil),      // 74: docker.ListNetworksResponse
-	(*Network)(nil),                   // 75: docker.Network
-	(*IPAMConfig)(nil),                // 76: docker.IPAMConfig
-	(*IPAMPool)(nil),                  // 77: docker.IPAMPool
-	(*NetworkContainer)(nil),          // 78: docker.NetworkContainer
-	(*GetNetworkRequest)(nil),         // 79: docker.GetNetworkRequest
-	(*GetNetworkResponse)(nil),        // 80: docker.GetNetworkResponse
-	(*CreateNetworkRequest)(nil),      // 81: docker.CreateNetworkRequest
-	(*CreateNetworkResponse)(nil),     // 82: docker.CreateNetworkResponse
-	(*DeleteNetworkRequest)(nil),      // 83: docker.DeleteNetworkRequest
-	(*DeleteNetworkResponse)(nil),     // 84: docker.DeleteNetworkResponse
-	(*ConnectNetworkRequest)(nil),     // 85: docker.ConnectNetworkRequest
-	(*EndpointConfig)(nil),            // 86: docker.EndpointConfig
-	(*ConnectNetworkResponse)(nil),    // 87: docker.ConnectNetworkResponse
-	(*DisconnectNetworkRequest)(nil),  // 88: docker.DisconnectNetworkRequest
-	(*DisconnectNetworkResponse)(nil), // 89: docker.DisconnectNetworkResponse
-	(*GetSystemInfoRequest)(nil),      // 90: docker.GetSystemInfoRequest
-	(*GetSystemInfoResponse)(nil),     // 91: docker.GetSystemInfoResponse
-	(*SystemInfo)(nil),                // 92: docker.SystemInfo
-	(*Plugin)(nil),                    // 93: docker.Plugin
-	(*DriverStatus)(nil),              // 94: docker.DriverStatus
-	(*RegistryConfig)(nil),            // 95: docker.RegistryConfig
-	(*GetVersionRequest)(nil),         // 96: docker.GetVersionRequest
-	(*GetVersionResponse)(nil),        // 97: docker.GetVersionResponse
-	(*VersionInfo)(nil),               // 98: docker.VersionInfo
-	(*ComponentVersion)(nil),          // 99: docker.ComponentVersion
-	(*GetDiskUsageRequest)(nil),       // 100: docker.GetDiskUsageRequest
-	(*GetDiskUsageResponse)(nil),      // 101: docker.GetDiskUsageResponse
-	(*DiskUsage)(nil),                 // 102: docker.DiskUsage
-	(*ImageSummary)(nil),              // 103: docker.ImageSummary
-	(*ContainerSummary)(nil),          // 104: docker.ContainerSummary
-	(*VolumeSummary)(nil),             // 105: docker.VolumeSummary
-	(*BuildCacheSummary)(nil),         // 106: docker.BuildCacheSummary
-	(*PingRequest)(nil),               // 107: docker.PingRequest
-	(*PingResponse)(nil),              // 108: docker.PingResponse
-	(*GetEventsRequest)(nil),          // 109: docker.GetEventsRequest
-	(*DockerEvent)(nil),               // 110: docker.DockerEvent
-	(*Actor)(nil),                     // 111: docker.Actor
-	nil,                               // 112: docker.Container.LabelsEntry
-	nil,                               // 113: docker.Container.NetworksEntry
-	nil,                               // 114: docker.ContainerDetail.NetworkSettingsEntry
-	nil,                               // 115: docker.ContainerConfig.ExposedPortsEntry
-	nil,                               // 116: docker.ContainerConfig.VolumesEntry
-	nil,                               // 117: docker.ContainerConfig.LabelsEntry
-	nil,                               // 118: docker.ContainerStats.NetworksEntry
-	nil,                               // 119: docker.ExecStart.EnvEntry
-	nil,                               // 120: docker.Image.LabelsEntry
-	nil,                               // 121: docker.ImageConfig.VolumesEntry
-	nil,                               // 122: docker.ImageConfig.ExposedPortsEntry
-	nil,                               // 123: docker.ImageConfig.LabelsEntry
-	nil,                               // 124: docker.Volume.LabelsEntry
-	nil,                               // 125: docker.Volume.OptionsEntry
-	nil,                               // 126: docker.CreateVolumeRequest.DriverOptsEntry
-	nil,                               // 127: docker.CreateVolumeRequest.LabelsEntry
-	nil,                               // 128: docker.PruneVolumesRequest.FiltersEntry
-	nil,                               // 129: docker.Network.ContainersEntry
-	nil,                               // 130: docker.Network.OptionsEntry
-	nil,                               // 131: docker.Network.LabelsEntry
-	nil,                               // 132: docker.IPAMConfig.OptionsEntry
-	nil,                               // 133: docker.IPAMPool.AuxAddressesEntry
-	nil,                               // 134: docker.CreateNetworkRequest.OptionsEntry
-	nil,                               // 135: docker.CreateNetworkRequest.LabelsEntry
-	nil,                               // 136: docker.EndpointConfig.IpamConfigEntry
-	nil,                               // 137: docker.SystemInfo.SystemStatusEntry
-	nil,                               // 138: docker.ComponentVersion.DetailsEntry
-	nil,                               // 139: docker.Actor.AttributesEntry
+	(*GetDockerInfoRequest)(nil),           // 0: docker.GetDockerInfoRequest
+	(*GetDockerInfoResponse)(nil),          // 1: docker.GetDockerInfoResponse
+	(*ListContainersRequest)(nil),          // 2: docker.ListContainersRequest
+	(*ListContainersResponse)(nil),         // 3: docker.ListContainersResponse
+	(*Container)(nil),                      // 4: docker.Container
+	(*Port)(nil),                           // 5: docker.Port
+	(*Mount)(nil),                          // 6: docker.Mount
+	(*NetworkConfig)(nil),                  // 7: docker.NetworkConfig
+	(*GetContainerRequest)(nil),            // 8: docker.GetContainerRequest
+	(*GetContainerResponse)(nil),           // 9: docker.GetContainerResponse
+	(*ContainerDetail)(nil),                // 10: docker.ContainerDetail
+	(*ContainerState)(nil),                 // 11: docker.ContainerState
+	(*ContainerConfig)(nil),                // 12: docker.ContainerConfig
+	(*HostConfig)(nil),                     // 13: docker.HostConfig
+	(*PortBinding)(nil),                    // 14: docker.PortBinding
+	(*NetworkSettings)(nil),                // 15: docker.NetworkSettings
+	(*StartContainerRequest)(nil),          // 16: docker.StartContainerRequest
+	(*StartContainerResponse)(nil),         // 17: docker.StartContainerResponse
+	(*StopContainerRequest)(nil),           // 18: docker.StopContainerRequest
+	(*StopContainerResponse)(nil),          // 19: docker.StopContainerResponse
+	(*RestartContainerRequest)(nil),        // 20: docker.RestartContainerRequest
+	(*RestartContainerResponse)(nil),       // 21: docker.RestartContainerResponse
+	(*PauseContainerRequest)(nil),          // 22: docker.PauseContainerRequest
+	(*PauseContainerResponse)(nil),         // 23: docker.PauseContainerResponse
+	(*UnpauseContainerRequest)(nil),        // 24: docker.UnpauseContainerRequest
+	(*UnpauseContainerResponse)(nil),       // 25: docker.UnpauseContainerResponse
+	(*DeleteContainerRequest)(nil),         // 26: docker.DeleteContainerRequest
+	(*DeleteContainerResponse)(nil),        // 27: docker.DeleteContainerResponse
+	(*PruneContainersRequest)(nil),         // 28: docker.PruneContainersRequest
+	(*PruneContainersResponse)(nil),        // 29: docker.PruneContainersResponse
+	(*RecreateContainerRequest)(nil),       // 30: docker.RecreateContainerRequest
+	(*ContainerResources)(nil),             // 31: docker.ContainerResources
+	(*RecreateContainerResponse)(nil),      // 32: docker.RecreateContainerResponse
+	(*GetContainerStatsRequest)(nil),       // 33: docker.GetContainerStatsRequest
+	(*ContainerStats)(nil),                 // 34: docker.ContainerStats
+	(*CPUStats)(nil),                       // 35: docker.CPUStats
+	(*MemoryStats)(nil),                    // 36: docker.MemoryStats
+	(*BlkioStats)(nil),                     // 37: docker.BlkioStats
+	(*BlkioStatEntry)(nil),                 // 38: docker.BlkioStatEntry
+	(*NetworkStats)(nil),                   // 39: docker.NetworkStats
+	(*PidsStats)(nil),                      // 40: docker.PidsStats
+	(*GetContainerLogsRequest)(nil),        // 41: docker.GetContainerLogsRequest
+	(*LogEntry)(nil),                       // 42: docker.LogEntry
+	(*ExecRequest)(nil),                    // 43: docker.ExecRequest
+	(*ExecStart)(nil),                      // 44: docker.ExecStart
+	(*ExecInput)(nil),                      // 45: docker.ExecInput
+	(*ExecResize)(nil),                     // 46: docker.ExecResize
+	(*ExecResponse)(nil),                   // 47: docker.ExecResponse
+	(*ExecOutput)(nil),                     // 48: docker.ExecOutput
+	(*ExecError)(nil),                      // 49: docker.ExecError
+	(*ExecExit)(nil),                       // 50: docker.ExecExit
+	(*ListContainerFilesRequest)(nil),      // 51: docker.ListContainerFilesRequest
+	(*ListContainerFilesResponse)(nil),     // 52: docker.ListContainerFilesResponse
+	(*ContainerFile)(nil),                  // 53: docker.ContainerFile
+	(*CopyFromContainerRequest)(nil),       // 54: docker.CopyFromContainerRequest
+	(*ArchiveChunk)(nil),                   // 55: docker.ArchiveChunk
+	(*CopyToContainerRequest)(nil),         // 56: docker.CopyToContainerRequest
+	(*CopyToContainerResponse)(nil),        // 57: docker.CopyToContainerResponse
+	(*ListImagesRequest)(nil),              // 58: docker.ListImagesRequest
+	(*ListImagesResponse)(nil),             // 59: docker.ListImagesResponse
+	(*Image)(nil),                          // 60: docker.Image
+	(*GetImageRequest)(nil),                // 61: docker.GetImageRequest
+	(*GetImageResponse)(nil),               // 62: docker.GetImageResponse
+	(*ImageDetail)(nil),                    // 63: docker.ImageDetail
+	(*ImageConfig)(nil),                    // 64: docker.ImageConfig
+	(*RootFS)(nil),                         // 65: docker.RootFS
+	(*DeleteImageRequest)(nil),             // 66: docker.DeleteImageRequest
+	(*DeleteImageResponse)(nil),            // 67: docker.DeleteImageResponse
+	(*ImageDeleteResponse)(nil),            // 68: docker.ImageDeleteResponse
+	(*PullImageRequest)(nil),               // 69: docker.PullImageRequest
+	(*PullImageProgress)(nil),              // 70: docker.PullImageProgress
+	(*TagImageRequest)(nil),                // 71: docker.TagImageRequest
+	(*TagImageResponse)(nil),               // 72: docker.TagImageResponse
+	(*PruneImagesRequest)(nil),             // 73: docker.PruneImagesRequest
+	(*PruneImagesResponse)(nil),            // 74: docker.PruneImagesResponse
+	(*ListVolumesRequest)(nil),             // 75: docker.ListVolumesRequest
+	(*ListVolumesResponse)(nil),            // 76: docker.ListVolumesResponse
+	(*Volume)(nil),                         // 77: docker.Volume
+	(*VolumeUsageData)(nil),                // 78: docker.VolumeUsageData
+	(*GetVolumeRequest)(nil),               // 79: docker.GetVolumeRequest
+	(*GetVolumeResponse)(nil),              // 80: docker.GetVolumeResponse
+	(*CreateVolumeRequest)(nil),            // 81: docker.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),           // 82: docker.CreateVolumeResponse
+	(*DeleteVolumeRequest)(nil),            // 83: docker.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),           // 84: docker.DeleteVolumeResponse
+	(*PruneVolumesRequest)(nil),            // 85: docker.PruneVolumesRequest
+	(*PruneVolumesResponse)(nil),           // 86: docker.PruneVolumesResponse
+	(*ListNetworksRequest)(nil),            // 87: docker.ListNetworksRequest
+	(*ListNetworksResponse)(nil),           // 88: docker.ListNetworksResponse
+	(*Network)(nil),                        // 89: docker.Network
+	(*IPAMConfig)(nil),                     // 90: docker.IPAMConfig
+	(*IPAMPool)(nil),                       // 91: docker.IPAMPool
+	(*NetworkContainer)(nil),               // 92: docker.NetworkContainer
+	(*GetNetworkRequest)(nil),              // 93: docker.GetNetworkRequest
+	(*GetNetworkResponse)(nil),             // 94: docker.GetNetworkResponse
+	(*CreateNetworkRequest)(nil),           // 95: docker.CreateNetworkRequest
+	(*CreateNetworkResponse)(nil),          // 96: docker.CreateNetworkResponse
+	(*DeleteNetworkRequest)(nil),           // 97: docker.DeleteNetworkRequest
+	(*DeleteNetworkResponse)(nil),          // 98: docker.DeleteNetworkResponse
+	(*ConnectNetworkRequest)(nil),          // 99: docker.ConnectNetworkRequest
+	(*EndpointConfig)(nil),                 // 100: docker.EndpointConfig
+	(*ConnectNetworkResponse)(nil),         // 101: docker.ConnectNetworkResponse
+	(*DisconnectNetworkRequest)(nil),       // 102: docker.DisconnectNetworkRequest
+	(*DisconnectNetworkResponse)(nil),      // 103: docker.DisconnectNetworkResponse
+	(*GetSystemInfoRequest)(nil),           // 104: docker.GetSystemInfoRequest
+	(*GetSystemInfoResponse)(nil),          // 105: docker.GetSystemInfoResponse
+	(*SystemInfo)(nil),                     // 106: docker.SystemInfo
+	(*SwarmInfo)(nil),                      // 107: docker.SwarmInfo
+	(*Plugin)(nil),                         // 108: docker.Plugin
+	(*DriverStatus)(nil),                   // 109: docker.DriverStatus
+	(*RegistryConfig)(nil),                 // 110: docker.RegistryConfig
+	(*GetVersionRequest)(nil),              // 111: docker.GetVersionRequest
+	(*GetVersionResponse)(nil),             // 112: docker.GetVersionResponse
+	(*VersionInfo)(nil),                    // 113: docker.VersionInfo
+	(*ComponentVersion)(nil),               // 114: docker.ComponentVersion
+	(*GetDiskUsageRequest)(nil),            // 115: docker.GetDiskUsageRequest
+	(*GetDiskUsageResponse)(nil),           // 116: docker.GetDiskUsageResponse
+	(*PruneBuildCacheRequest)(nil),         // 117: docker.PruneBuildCacheRequest
+	(*PruneBuildCacheResponse)(nil),        // 118: docker.PruneBuildCacheResponse
+	(*DiskUsage)(nil),                      // 119: docker.DiskUsage
+	(*ImageSummary)(nil),                   // 120: docker.ImageSummary
+	(*ContainerSummary)(nil),               // 121: docker.ContainerSummary
+	(*VolumeSummary)(nil),                  // 122: docker.VolumeSummary
+	(*BuildCacheSummary)(nil),              // 123: docker.BuildCacheSummary
+	(*PingRequest)(nil),                    // 124: docker.PingRequest
+	(*PingResponse)(nil),                   // 125: docker.PingResponse
+	(*GetEventsRequest)(nil),               // 126: docker.GetEventsRequest
+	(*DockerEvent)(nil),                    // 127: docker.DockerEvent
+	(*Actor)(nil),                          // 128: docker.Actor
+	(*ListSwarmNodesRequest)(nil),          // 129: docker.ListSwarmNodesRequest
+	(*ListSwarmNodesResponse)(nil),         // 130: docker.ListSwarmNodesResponse
+	(*SwarmNode)(nil),                      // 131: docker.SwarmNode
+	(*UpdateSwarmNodeRequest)(nil),         // 132: docker.UpdateSwarmNodeRequest
+	(*UpdateSwarmNodeResponse)(nil),        // 133: docker.UpdateSwarmNodeResponse
+	(*ListSwarmServicesRequest)(nil),       // 134: docker.ListSwarmServicesRequest
+	(*ListSwarmServicesResponse)(nil),      // 135: docker.ListSwarmServicesResponse
+	(*SwarmService)(nil),                   // 136: docker.SwarmService
+	(*SwarmPort)(nil),                      // 137: docker.SwarmPort
+	(*GetSwarmServiceRequest)(nil),         // 138: docker.GetSwarmServiceRequest
+	(*GetSwarmServiceResponse)(nil),        // 139: docker.GetSwarmServiceResponse
+	(*ScaleSwarmServiceRequest)(nil),       // 140: docker.ScaleSwarmServiceRequest
+	(*UpdateSwarmServiceImageRequest)(nil), // 141: docker.UpdateSwarmServiceImageRequest
+	(*RollbackSwarmServiceRequest)(nil),    // 142: docker.RollbackSwarmServiceRequest
+	(*UpdateSwarmServiceResponse)(nil),     // 143: docker.UpdateSwarmServiceResponse
+	(*DeleteSwarmServiceRequest)(nil),      // 144: docker.DeleteSwarmServiceRequest
+	(*DeleteSwarmServiceResponse)(nil),     // 145: docker.DeleteSwarmServiceResponse
+	(*ListSwarmTasksRequest)(nil),          // 146: docker.ListSwarmTasksRequest
+	(*ListSwarmTasksResponse)(nil),         // 147: docker.ListSwarmTasksResponse
+	(*SwarmTask)(nil),                      // 148: docker.SwarmTask
+	(*SwarmSecret)(nil),                    // 149: docker.SwarmSecret
+	(*ListSwarmSecretsRequest)(nil),        // 150: docker.ListSwarmSecretsRequest
+	(*ListSwarmSecretsResponse)(nil),       // 151: docker.ListSwarmSecretsResponse
+	(*CreateSwarmSecretRequest)(nil),       // 152: docker.CreateSwarmSecretRequest
+	(*CreateSwarmSecretResponse)(nil),      // 153: docker.CreateSwarmSecretResponse
+	(*DeleteSwarmSecretRequest)(nil),       // 154: docker.DeleteSwarmSecretRequest
+	(*DeleteSwarmSecretResponse)(nil),      // 155: docker.DeleteSwarmSecretResponse
+	(*SwarmConfig)(nil),                    // 156: docker.SwarmConfig
+	(*ListSwarmConfigsRequest)(nil),        // 157: docker.ListSwarmConfigsRequest
+	(*ListSwarmConfigsResponse)(nil),       // 158: docker.ListSwarmConfigsResponse
+	(*CreateSwarmConfigRequest)(nil),       // 159: docker.CreateSwarmConfigRequest
+	(*CreateSwarmConfigResponse)(nil),      // 160: docker.CreateSwarmConfigResponse
+	(*DeleteSwarmConfigRequest)(nil),       // 161: docker.DeleteSwarmConfigRequest
+	(*DeleteSwarmConfigResponse)(nil),      // 162: docker.DeleteSwarmConfigResponse
+	(*Stack)(nil),                          // 163: docker.Stack
+	(*ListStacksRequest)(nil),              // 164: docker.ListStacksRequest
+	(*ListStacksResponse)(nil),             // 165: docker.ListStacksResponse
+	(*DeployStackRequest)(nil),             // 166: docker.DeployStackRequest
+	(*DeployStackResponse)(nil),            // 167: docker.DeployStackResponse
+	(*RemoveStackRequest)(nil),             // 168: docker.RemoveStackRequest
+	(*RemoveStackResponse)(nil),            // 169: docker.RemoveStackResponse
+	nil,                                    // 170: docker.Container.LabelsEntry
+	nil,                                    // 171: docker.Container.NetworksEntry
+	nil,                                    // 172: docker.ContainerDetail.NetworkSettingsEntry
+	nil,                                    // 173: docker.ContainerConfig.ExposedPortsEntry
+	nil,                                    // 174: docker.ContainerConfig.VolumesEntry
+	nil,                                    // 175: docker.ContainerConfig.LabelsEntry
+	nil,                                    // 176: docker.ContainerStats.NetworksEntry
+	nil,                                    // 177: docker.ExecStart.EnvEntry
+	nil,                                    // 178: docker.Image.LabelsEntry
+	nil,                                    // 179: docker.ImageConfig.VolumesEntry
+	nil,                                    // 180: docker.ImageConfig.ExposedPortsEntry
+	nil,                                    // 181: docker.ImageConfig.LabelsEntry
+	nil,                                    // 182: docker.Volume.LabelsEntry
+	nil,                                    // 183: docker.Volume.OptionsEntry
+	nil,                                    // 184: docker.CreateVolumeRequest.DriverOptsEntry
+	nil,                                    // 185: docker.CreateVolumeRequest.LabelsEntry
+	nil,                                    // 186: docker.PruneVolumesRequest.FiltersEntry
+	nil,                                    // 187: docker.Network.ContainersEntry
+	nil,                                    // 188: docker.Network.OptionsEntry
+	nil,                                    // 189: docker.Network.LabelsEntry
+	nil,                                    // 190: docker.IPAMConfig.OptionsEntry
+	nil,                                    // 191: docker.IPAMPool.AuxAddressesEntry
+	nil,                                    // 192: docker.CreateNetworkRequest.OptionsEntry
+	nil,                                    // 193: docker.CreateNetworkRequest.LabelsEntry
+	nil,                                    // 194: docker.EndpointConfig.IpamConfigEntry
+	nil,                                    // 195: docker.SystemInfo.SystemStatusEntry
+	nil,                                    // 196: docker.ComponentVersion.DetailsEntry
+	nil,                                    // 197: docker.Actor.AttributesEntry
+	nil,                                    // 198: docker.SwarmNode.LabelsEntry
+	nil,                                    // 199: docker.SwarmService.LabelsEntry
+	nil,                                    // 200: docker.SwarmSecret.LabelsEntry
+	nil,                                    // 201: docker.CreateSwarmSecretRequest.LabelsEntry
+	nil,                                    // 202: docker.SwarmConfig.LabelsEntry
+	nil,                                    // 203: docker.CreateSwarmConfigRequest.LabelsEntry
+	nil,                                    // 204: docker.DeployStackRequest.RegistryAuthEntry
 }
 var file_pkg_grpc_proto_docker_docker_proto_depIdxs = []int32{
 	4,   // 0: docker.ListContainersResponse.containers:type_name -> docker.Container
 	5,   // 1: docker.Container.ports:type_name -> docker.Port
-	112, // 2: docker.Container.labels:type_name -> docker.Container.LabelsEntry
+	170, // 2: docker.Container.labels:type_name -> docker.Container.LabelsEntry
 	6,   // 3: docker.Container.mounts:type_name -> docker.Mount
-	113, // 4: docker.Container.networks:type_name -> docker.Container.NetworksEntry
+	171, // 4: docker.Container.networks:type_name -> docker.Container.NetworksEntry
 	10,  // 5: docker.GetContainerResponse.container:type_name -> docker.ContainerDetail
 	11,  // 6: docker.ContainerDetail.state:type_name -> docker.ContainerState
 	12,  // 7: docker.ContainerDetail.config:type_name -> docker.ContainerConfig
 	13,  // 8: docker.ContainerDetail.host_config:type_name -> docker.HostConfig
-	114, // 9: docker.ContainerDetail.network_settings:type_name -> docker.ContainerDetail.NetworkSettingsEntry
+	172, // 9: docker.ContainerDetail.network_settings:type_name -> docker.ContainerDetail.NetworkSettingsEntry
 	6,   // 10: docker.ContainerDetail.mounts:type_name -> docker.Mount
-	115, // 11: docker.ContainerConfig.exposed_ports:type_name -> docker.ContainerConfig.ExposedPortsEntry
-	116, // 12: docker.ContainerConfig.volumes:type_name -> docker.ContainerConfig.VolumesEntry
-	117, // 13: docker.ContainerConfig.labels:type_name -> docker.ContainerConfig.LabelsEntry
+	173, // 11: docker.ContainerConfig.exposed_ports:type_name -> docker.ContainerConfig.ExposedPortsEntry
+	174, // 12: docker.ContainerConfig.volumes:type_name -> docker.ContainerConfig.VolumesEntry
+	175, // 13: docker.ContainerConfig.labels:type_name -> docker.ContainerConfig.LabelsEntry
 	14,  // 14: docker.HostConfig.port_bindings:type_name -> docker.PortBinding
-	30,  // 15: docker.ContainerStats.cpu_stats:type_name -> docker.CPUStats
-	30,  // 16: docker.ContainerStats.precpu_stats:type_name -> docker.CPUStats
-	31,  // 17: docker.ContainerStats.memory_stats:type_name -> docker.MemoryStats
-	32,  // 18: docker.ContainerStats.blkio_stats:type_name -> docker.BlkioStats
-	118, // 19: docker.ContainerStats.networks:type_name -> docker.ContainerStats.NetworksEntry
-	35,  // 20: docker.ContainerStats.pids_stats:type_name -> docker.PidsStats
-	33,  // 21: docker.BlkioStats.io_service_bytes_recursive:type_name -> docker.BlkioStatEntry
-	33,  // 22: docker.BlkioStats.io_serviced_recursive:type_name -> docker.BlkioStatEntry
-	39,  // 23: docker.ExecRequest.start:type_name -> docker.ExecStart
-	40,  // 24: docker.ExecRequest.input:type_name -> docker.ExecInput
-	41,  // 25: docker.ExecRequest.resize:type_name -> docker.ExecResize
-	119, // 26: docker.ExecStart.env:type_name -> docker.ExecStart.EnvEntry
-	43,  // 27: docker.ExecResponse.output:type_name -> docker.ExecOutput
-	44,  // 28: docker.ExecResponse.error:type_name -> docker.ExecError
-	45,  // 29: docker.ExecResponse.exit:type_name -> docker.ExecExit
-	48,  // 30: docker.ListImagesResponse.images:type_name -> docker.Image
-	120, // 31: docker.Image.labels:type_name -> docker.Image.LabelsEntry
-	51,  // 32: docker.GetImageResponse.image:type_name -> docker.ImageDetail
-	52,  // 33: docker.ImageDetail.config:type_name -> docker.ImageConfig
-	53,  // 34: docker.ImageDetail.root_fs:type_name -> docker.RootFS
-	121, // 35: docker.ImageConfig.volumes:type_name -> docker.ImageConfig.VolumesEntry
-	122, // 36: docker.ImageConfig.exposed_ports:type_name -> docker.ImageConfig.ExposedPortsEntry
-	123, // 37: docker.ImageConfig.labels:type_name -> docker.ImageConfig.LabelsEntry
-	56,  // 38: docker.DeleteImageResponse.deleted:type_name -> docker.ImageDeleteResponse
-	63,  // 39: docker.ListVolumesResponse.volumes:type_name -> docker.Volume
-	124, // 40: docker.Volume.labels:type_name -> docker.Volume.LabelsEntry
-	125, // 41: docker.Volume.options:type_name -> docker.Volume.OptionsEntry
-	64,  // 42: docker.Volume.usage_data:type_name -> docker.VolumeUsageData
-	63,  // 43: docker.GetVolumeResponse.volume:type_name -> docker.Volume
-	126, // 44: docker.CreateVolumeRequest.driver_opts:type_name -> docker.CreateVolumeRequest.DriverOptsEntry
-	127, // 45: docker.CreateVolumeRequest.labels:type_name -> docker.CreateVolumeRequest.LabelsEntry
-	63,  // 46: docker.CreateVolumeResponse.volume:type_name -> docker.Volume
-	128, // 47: docker.PruneVolumesRequest.filters:type_name -> docker.PruneVolumesRequest.FiltersEntry
-	75,  // 48: docker.ListNetworksResponse.networks:type_name -> docker.Network
-	76,  // 49: docker.Network.ipam:type_name -> docker.IPAMConfig
-	129, // 50: docker.Network.containers:type_name -> docker.Network.ContainersEntry
-	130, // 51: docker.Network.options:type_name -> docker.Network.OptionsEntry
-	131, // 52: docker.Network.labels:type_name -> docker.Network.LabelsEntry
-	77,  // 53: docker.IPAMConfig.config:type_name -> docker.IPAMPool
-	132, // 54: docker.IPAMConfig.options:type_name -> docker.IPAMConfig.OptionsEntry
-	133, // 55: docker.IPAMPool.aux_addresses:type_name -> docker.IPAMPool.AuxAddressesEntry
-	75,  // 56: docker.GetNetworkResponse.network:type_name -> docker.Network
-	76,  // 57: docker.CreateNetworkRequest.ipam:type_name -> docker.IPAMConfig
-	134, // 58: docker.CreateNetworkRequest.options:type_name -> docker.CreateNetworkRequest.OptionsEntry
-	135, // 59: docker.CreateNetworkRequest.labels:type_name -> docker.CreateNetworkRequest.LabelsEntry
-	86,  // 60: docker.ConnectNetworkRequest.endpoint_config:type_name -> docker.EndpointConfig
-	136, // 61: docker.EndpointConfig.ipam_config:type_name -> docker.EndpointConfig.IpamConfigEntry
-	92,  // 62: docker.GetSystemInfoResponse.info:type_name -> docker.SystemInfo
-	94,  // 63: docker.SystemInfo.driver_status:type_name -> docker.DriverStatus
-	137, // 64: docker.SystemInfo.system_status:type_name -> docker.SystemInfo.SystemStatusEntry
-	93,  // 65: docker.SystemInfo.plugins:type_name -> docker.Plugin
-	95,  // 66: docker.SystemInfo.registry_config:type_name -> docker.RegistryConfig
-	98,  // 67: docker.GetVersionResponse.version:type_name -> docker.VersionInfo
-	99,  // 68: docker.VersionInfo.components:type_name -> docker.ComponentVersion
-	138, // 69: docker.ComponentVersion.details:type_name -> docker.ComponentVersion.DetailsEntry
-	102, // 70: docker.GetDiskUsageResponse.usage:type_name -> docker.DiskUsage
-	103, // 71: docker.DiskUsage.images:type_name -> docker.ImageSummary
-	104, // 72: docker.DiskUsage.containers:type_name -> docker.ContainerSummary
-	105, // 73: docker.DiskUsage.volumes:type_name -> docker.VolumeSummary
-	106, // 74: docker.DiskUsage.build_cache:type_name -> docker.BuildCacheSummary
-	111, // 75: docker.DockerEvent.actor:type_name -> docker.Actor
-	139, // 76: docker.Actor.attributes:type_name -> docker.Actor.AttributesEntry
-	7,   // 77: docker.Container.NetworksEntry.value:type_name -> docker.NetworkConfig
-	15,  // 78: docker.ContainerDetail.NetworkSettingsEntry.value:type_name -> docker.NetworkSettings
-	34,  // 79: docker.ContainerStats.NetworksEntry.value:type_name -> docker.NetworkStats
-	78,  // 80: docker.Network.ContainersEntry.value:type_name -> docker.NetworkContainer
-	0,   // 81: docker.DockerService.GetDockerInfo:input_type -> docker.GetDockerInfoRequest
-	2,   // 82: docker.DockerService.ListContainers:input_type -> docker.ListContainersRequest
-	8,   // 83: docker.DockerService.GetContainer:input_type -> docker.GetContainerRequest
-	16,  // 84: docker.DockerService.StartContainer:input_type -> docker.StartContainerRequest
-	18,  // 85: docker.DockerService.StopContainer:input_type -> docker.StopContainerRequest
-	20,  // 86: docker.DockerService.RestartContainer:input_type -> docker.RestartContainerRequest
-	22,  // 87: docker.DockerService.PauseContainer:input_type -> docker.PauseContainerRequest
-	24,  // 88: docker.DockerService.UnpauseContainer:input_type -> docker.UnpauseContainerRequest
-	26,  // 89: docker.DockerService.DeleteContainer:input_type -> docker.DeleteContainerRequest
-	28,  // 90: docker.DockerService.GetContainerStats:input_type -> docker.GetContainerStatsRequest
-	36,  // 91: docker.DockerService.GetContainerLogs:input_type -> docker.GetContainerLogsRequest
-	38,  // 92: docker.DockerService.ExecContainer:input_type -> docker.ExecRequest
-	46,  // 93: docker.DockerService.ListImages:input_type -> docker.ListImagesRequest
-	49,  // 94: docker.DockerService.GetImage:input_type -> docker.GetImageRequest
-	54,  // 95: docker.DockerService.DeleteImage:input_type -> docker.DeleteImageRequest
-	57,  // 96: docker.DockerService.PullImage:input_type -> docker.PullImageRequest
-	59,  // 97: docker.DockerService.TagImage:input_type -> docker.TagImageRequest
-	61,  // 98: docker.DockerService.ListVolumes:input_type -> docker.ListVolumesRequest
-	65,  // 99: docker.DockerService.GetVolume:input_type -> docker.GetVolumeRequest
-	67,  // 100: docker.DockerService.CreateVolume:input_type -> docker.CreateVolumeRequest
-	69,  // 101: docker.DockerService.DeleteVolume:input_type -> docker.DeleteVolumeRequest
-	71,  // 102: docker.DockerService.PruneVolumes:input_type -> docker.PruneVolumesRequest
-	73,  // 103: docker.DockerService.ListNetworks:input_type -> docker.ListNetworksRequest
-	79,  // 104: docker.DockerService.GetNetwork:input_type -> docker.GetNetworkRequest
-	81,  // 105: docker.DockerService.CreateNetwork:input_type -> docker.CreateNetworkRequest
-	83,  // 106: docker.DockerService.DeleteNetwork:input_type -> docker.DeleteNetworkRequest
-	85,  // 107: docker.DockerService.ConnectNetwork:input_type -> docker.ConnectNetworkRequest
-	88,  // 108: docker.DockerService.DisconnectNetwork:input_type -> docker.DisconnectNetworkRequest
-	90,  // 109: docker.DockerService.GetSystemInfo:input_type -> docker.GetSystemInfoRequest
-	96,  // 110: docker.DockerService.GetVersion:input_type -> docker.GetVersionRequest
-	100, // 111: docker.DockerService.GetDiskUsage:input_type -> docker.GetDiskUsageRequest
-	107, // 112: docker.DockerService.Ping:input_type -> docker.PingRequest
-	109, // 113: docker.DockerService.GetEvents:input_type -> docker.GetEventsRequest
-	1,   // 114: docker.DockerService.GetDockerInfo:output_type -> docker.GetDockerInfoResponse
-	3,   // 115: docker.DockerService.ListContainers:output_type -> docker.ListContainersResponse
-	9,   // 116: docker.DockerService.GetContainer:output_type -> docker.GetContainerResponse
-	17,  // 117: docker.DockerService.StartContainer:output_type -> docker.StartContainerResponse
-	19,  // 118: docker.DockerService.StopContainer:output_type -> docker.StopContainerResponse
-	21,  // 119: docker.DockerService.RestartContainer:output_type -> docker.RestartContainerResponse
-	23,  // 120: docker.DockerService.PauseContainer:output_type -> docker.PauseContainerResponse
-	25,  // 121: docker.DockerService.UnpauseContainer:output_type -> docker.UnpauseContainerResponse
-	27,  // 122: docker.DockerService.DeleteContainer:output_type -> docker.DeleteContainerResponse
-	29,  // 123: docker.DockerService.GetContainerStats:output_type -> docker.ContainerStats
-	37,  // 124: docker.DockerService.GetContainerLogs:output_type -> docker.LogEntry
-	42,  // 125: docker.DockerService.ExecContainer:output_type -> docker.ExecResponse
-	47,  // 126: docker.DockerService.ListImages:output_type -> docker.ListImagesResponse
-	50,  // 127: docker.DockerService.GetImage:output_type -> docker.GetImageResponse
-	55,  // 128: docker.DockerService.DeleteImage:output_type -> docker.DeleteImageResponse
-	58,  // 129: docker.DockerService.PullImage:output_type -> docker.PullImageProgress
-	60,  // 130: docker.DockerService.TagImage:output_type -> docker.TagImageResponse
-	62,  // 131: docker.DockerService.ListVolumes:output_type -> docker.ListVolumesResponse
-	66,  // 132: docker.DockerService.GetVolume:output_type -> docker.GetVolumeResponse
-	68,  // 133: docker.DockerService.CreateVolume:output_type -> docker.CreateVolumeResponse
-	70,  // 134: docker.DockerService.DeleteVolume:output_type -> docker.DeleteVolumeResponse
-	72,  // 135: docker.DockerService.PruneVolumes:output_type -> docker.PruneVolumesResponse
-	74,  // 136: docker.DockerService.ListNetworks:output_type -> docker.ListNetworksResponse
-	80,  // 137: docker.DockerService.GetNetwork:output_type -> docker.GetNetworkResponse
-	82,  // 138: docker.DockerService.CreateNetwork:output_type -> docker.CreateNetworkResponse
-	84,  // 139: docker.DockerService.DeleteNetwork:output_type -> docker.DeleteNetworkResponse
-	87,  // 140: docker.DockerService.ConnectNetwork:output_type -> docker.ConnectNetworkResponse
-	89,  // 141: docker.DockerService.DisconnectNetwork:output_type -> docker.DisconnectNetworkResponse
-	91,  // 142: docker.DockerService.GetSystemInfo:output_type -> docker.GetSystemInfoResponse
-	97,  // 143: docker.DockerService.GetVersion:output_type -> docker.GetVersionResponse
-	101, // 144: docker.DockerService.GetDiskUsage:output_type -> docker.GetDiskUsageResponse
-	108, // 145: docker.DockerService.Ping:output_type -> docker.PingResponse
-	110, // 146: docker.DockerService.GetEvents:output_type -> docker.DockerEvent
-	114, // [114:147] is the sub-list for method output_type
-	81,  // [81:114] is the sub-list for method input_type
-	81,  // [81:81] is the sub-list for extension type_name
-	81,  // [81:81] is the sub-list for extension extendee
-	0,   // [0:81] is the sub-list for field type_name
+	31,  // 15: docker.RecreateContainerRequest.resources:type_name -> docker.ContainerResources
+	35,  // 16: docker.ContainerStats.cpu_stats:type_name -> docker.CPUStats
+	35,  // 17: docker.ContainerStats.precpu_stats:type_name -> docker.CPUStats
+	36,  // 18: docker.ContainerStats.memory_stats:type_name -> docker.MemoryStats
+	37,  // 19: docker.ContainerStats.blkio_stats:type_name -> docker.BlkioStats
+	176, // 20: docker.ContainerStats.networks:type_name -> docker.ContainerStats.NetworksEntry
+	40,  // 21: docker.ContainerStats.pids_stats:type_name -> docker.PidsStats
+	38,  // 22: docker.BlkioStats.io_service_bytes_recursive:type_name -> docker.BlkioStatEntry
+	38,  // 23: docker.BlkioStats.io_serviced_recursive:type_name -> docker.BlkioStatEntry
+	44,  // 24: docker.ExecRequest.start:type_name -> docker.ExecStart
+	45,  // 25: docker.ExecRequest.input:type_name -> docker.ExecInput
+	46,  // 26: docker.ExecRequest.resize:type_name -> docker.ExecResize
+	177, // 27: docker.ExecStart.env:type_name -> docker.ExecStart.EnvEntry
+	48,  // 28: docker.ExecResponse.output:type_name -> docker.ExecOutput
+	49,  // 29: docker.ExecResponse.error:type_name -> docker.ExecError
+	50,  // 30: docker.ExecResponse.exit:type_name -> docker.ExecExit
+	53,  // 31: docker.ListContainerFilesResponse.files:type_name -> docker.ContainerFile
+	60,  // 32: docker.ListImagesResponse.images:type_name -> docker.Image
+	178, // 33: docker.Image.labels:type_name -> docker.Image.LabelsEntry
+	63,  // 34: docker.GetImageResponse.image:type_name -> docker.ImageDetail
+	64,  // 35: docker.ImageDetail.config:type_name -> docker.ImageConfig
+	65,  // 36: docker.ImageDetail.root_fs:type_name -> docker.RootFS
+	179, // 37: docker.ImageConfig.volumes:type_name -> docker.ImageConfig.VolumesEntry
+	180, // 38: docker.ImageConfig.exposed_ports:type_name -> docker.ImageConfig.ExposedPortsEntry
+	181, // 39: docker.ImageConfig.labels:type_name -> docker.ImageConfig.LabelsEntry
+	68,  // 40: docker.DeleteImageResponse.deleted:type_name -> docker.ImageDeleteResponse
+	77,  // 41: docker.ListVolumesResponse.volumes:type_name -> docker.Volume
+	182, // 42: docker.Volume.labels:type_name -> docker.Volume.LabelsEntry
+	183, // 43: docker.Volume.options:type_name -> docker.Volume.OptionsEntry
+	78,  // 44: docker.Volume.usage_data:type_name -> docker.VolumeUsageData
+	77,  // 45: docker.GetVolumeResponse.volume:type_name -> docker.Volume
+	184, // 46: docker.CreateVolumeRequest.driver_opts:type_name -> docker.CreateVolumeRequest.DriverOptsEntry
+	185, // 47: docker.CreateVolumeRequest.labels:type_name -> docker.CreateVolumeRequest.LabelsEntry
+	77,  // 48: docker.CreateVolumeResponse.volume:type_name -> docker.Volume
+	186, // 49: docker.PruneVolumesRequest.filters:type_name -> docker.PruneVolumesRequest.FiltersEntry
+	89,  // 50: docker.ListNetworksResponse.networks:type_name -> docker.Network
+	90,  // 51: docker.Network.ipam:type_name -> docker.IPAMConfig
+	187, // 52: docker.Network.containers:type_name -> docker.Network.ContainersEntry
+	188, // 53: docker.Network.options:type_name -> docker.Network.OptionsEntry
+	189, // 54: docker.Network.labels:type_name -> docker.Network.LabelsEntry
+	91,  // 55: docker.IPAMConfig.config:type_name -> docker.IPAMPool
+	190, // 56: docker.IPAMConfig.options:type_name -> docker.IPAMConfig.OptionsEntry
+	191, // 57: docker.IPAMPool.aux_addresses:type_name -> docker.IPAMPool.AuxAddressesEntry
+	89,  // 58: docker.GetNetworkResponse.network:type_name -> docker.Network
+	90,  // 59: docker.CreateNetworkRequest.ipam:type_name -> docker.IPAMConfig
+	192, // 60: docker.CreateNetworkRequest.options:type_name -> docker.CreateNetworkRequest.OptionsEntry
+	193, // 61: docker.CreateNetworkRequest.labels:type_name -> docker.CreateNetworkRequest.LabelsEntry
+	100, // 62: docker.ConnectNetworkRequest.endpoint_config:type_name -> docker.EndpointConfig
+	194, // 63: docker.EndpointConfig.ipam_config:type_name -> docker.EndpointConfig.IpamConfigEntry
+	106, // 64: docker.GetSystemInfoResponse.info:type_name -> docker.SystemInfo
+	109, // 65: docker.SystemInfo.driver_status:type_name -> docker.DriverStatus
+	195, // 66: docker.SystemInfo.system_status:type_name -> docker.SystemInfo.SystemStatusEntry
+	108, // 67: docker.SystemInfo.plugins:type_name -> docker.Plugin
+	110, // 68: docker.SystemInfo.registry_config:type_name -> docker.RegistryConfig
+	107, // 69: docker.SystemInfo.swarm:type_name -> docker.SwarmInfo
+	113, // 70: docker.GetVersionResponse.version:type_name -> docker.VersionInfo
+	114, // 71: docker.VersionInfo.components:type_name -> docker.ComponentVersion
+	196, // 72: docker.ComponentVersion.details:type_name -> docker.ComponentVersion.DetailsEntry
+	119, // 73: docker.GetDiskUsageResponse.usage:type_name -> docker.DiskUsage
+	120, // 74: docker.DiskUsage.images:type_name -> docker.ImageSummary
+	121, // 75: docker.DiskUsage.containers:type_name -> docker.ContainerSummary
+	122, // 76: docker.DiskUsage.volumes:type_name -> docker.VolumeSummary
+	123, // 77: docker.DiskUsage.build_cache:type_name -> docker.BuildCacheSummary
+	128, // 78: docker.DockerEvent.actor:type_name -> docker.Actor
+	197, // 79: docker.Actor.attributes:type_name -> docker.Actor.AttributesEntry
+	131, // 80: docker.ListSwarmNodesResponse.nodes:type_name -> docker.SwarmNode
+	198, // 81: docker.SwarmNode.labels:type_name -> docker.SwarmNode.LabelsEntry
+	136, // 82: docker.ListSwarmServicesResponse.services:type_name -> docker.SwarmService
+	199, // 83: docker.SwarmService.labels:type_name -> docker.SwarmService.LabelsEntry
+	137, // 84: docker.SwarmService.ports:type_name -> docker.SwarmPort
+	136, // 85: docker.GetSwarmServiceResponse.service:type_name -> docker.SwarmService
+	148, // 86: docker.ListSwarmTasksResponse.tasks:type_name -> docker.SwarmTask
+	200, // 87: docker.SwarmSecret.labels:type_name -> docker.SwarmSecret.LabelsEntry
+	149, // 88: docker.ListSwarmSecretsResponse.secrets:type_name -> docker.SwarmSecret
+	201, // 89: docker.CreateSwarmSecretRequest.labels:type_name -> docker.CreateSwarmSecretRequest.LabelsEntry
+	202, // 90: docker.SwarmConfig.labels:type_name -> docker.SwarmConfig.LabelsEntry
+	156, // 91: docker.ListSwarmConfigsResponse.configs:type_name -> docker.SwarmConfig
+	203, // 92: docker.CreateSwarmConfigRequest.labels:type_name -> docker.CreateSwarmConfigRequest.LabelsEntry
+	163, // 93: docker.ListStacksResponse.stacks:type_name -> docker.Stack
+	204, // 94: docker.DeployStackRequest.registry_auth:type_name -> docker.DeployStackRequest.RegistryAuthEntry
+	7,   // 95: docker.Container.NetworksEntry.value:type_name -> docker.NetworkConfig
+	15,  // 96: docker.ContainerDetail.NetworkSettingsEntry.value:type_name -> docker.NetworkSettings
+	39,  // 97: docker.ContainerStats.NetworksEntry.value:type_name -> docker.NetworkStats
+	92,  // 98: docker.Network.ContainersEntry.value:type_name -> docker.NetworkContainer
+	0,   // 99: docker.DockerService.GetDockerInfo:input_type -> docker.GetDockerInfoRequest
+	2,   // 100: docker.DockerService.ListContainers:input_type -> docker.ListContainersRequest
+	8,   // 101: docker.DockerService.GetContainer:input_type -> docker.GetContainerRequest
+	16,  // 102: docker.DockerService.StartContainer:input_type -> docker.StartContainerRequest
+	18,  // 103: docker.DockerService.StopContainer:input_type -> docker.StopContainerRequest
+	20,  // 104: docker.DockerService.RestartContainer:input_type -> docker.RestartContainerRequest
+	22,  // 105: docker.DockerService.PauseContainer:input_type -> docker.PauseContainerRequest
+	24,  // 106: docker.DockerService.UnpauseContainer:input_type -> docker.UnpauseContainerRequest
+	26,  // 107: docker.DockerService.DeleteContainer:input_type -> docker.DeleteContainerRequest
+	28,  // 108: docker.DockerService.PruneContainers:input_type -> docker.PruneContainersRequest
+	30,  // 109: docker.DockerService.RecreateContainer:input_type -> docker.RecreateContainerRequest
+	33,  // 110: docker.DockerService.GetContainerStats:input_type -> docker.GetContainerStatsRequest
+	41,  // 111: docker.DockerService.GetContainerLogs:input_type -> docker.GetContainerLogsRequest
+	43,  // 112: docker.DockerService.ExecContainer:input_type -> docker.ExecRequest
+	51,  // 113: docker.DockerService.ListContainerFiles:input_type -> docker.ListContainerFilesRequest
+	54,  // 114: docker.DockerService.CopyFromContainer:input_type -> docker.CopyFromContainerRequest
+	56,  // 115: docker.DockerService.CopyToContainer:input_type -> docker.CopyToContainerRequest
+	58,  // 116: docker.DockerService.ListImages:input_type -> docker.ListImagesRequest
+	61,  // 117: docker.DockerService.GetImage:input_type -> docker.GetImageRequest
+	66,  // 118: docker.DockerService.DeleteImage:input_type -> docker.DeleteImageRequest
+	69,  // 119: docker.DockerService.PullImage:input_type -> docker.PullImageRequest
+	71,  // 120: docker.DockerService.TagImage:input_type -> docker.TagImageRequest
+	73,  // 121: docker.DockerService.PruneImages:input_type -> docker.PruneImagesRequest
+	75,  // 122: docker.DockerService.ListVolumes:input_type -> docker.ListVolumesRequest
+	79,  // 123: docker.DockerService.GetVolume:input_type -> docker.GetVolumeRequest
+	81,  // 124: docker.DockerService.CreateVolume:input_type -> docker.CreateVolumeRequest
+	83,  // 125: docker.DockerService.DeleteVolume:input_type -> docker.DeleteVolumeRequest
+	85,  // 126: docker.DockerService.PruneVolumes:input_type -> docker.PruneVolumesRequest
+	87,  // 127: docker.DockerService.ListNetworks:input_type -> docker.ListNetworksRequest
+	93,  // 128: docker.DockerService.GetNetwork:input_type -> docker.GetNetworkRequest
+	95,  // 129: docker.DockerService.CreateNetwork:input_type -> docker.CreateNetworkRequest
+	97,  // 130: docker.DockerService.DeleteNetwork:input_type -> docker.DeleteNetworkRequest
+	99,  // 131: docker.DockerService.ConnectNetwork:input_type -> docker.ConnectNetworkRequest
+	102, // 132: docker.DockerService.DisconnectNetwork:input_type -> docker.DisconnectNetworkRequest
+	104, // 133: docker.DockerService.GetSystemInfo:input_type -> docker.GetSystemInfoRequest
+	111, // 134: docker.DockerService.GetVersion:input_type -> docker.GetVersionRequest
+	115, // 135: docker.DockerService.GetDiskUsage:input_type -> docker.GetDiskUsageRequest
+	117, // 136: docker.DockerService.PruneBuildCache:input_type -> docker.PruneBuildCacheRequest
+	124, // 137: docker.DockerService.Ping:input_type -> docker.PingRequest
+	126, // 138: docker.DockerService.GetEvents:input_type -> docker.GetEventsRequest
+	129, // 139: docker.DockerService.ListSwarmNodes:input_type -> docker.ListSwarmNodesRequest
+	132, // 140: docker.DockerService.UpdateSwarmNode:input_type -> docker.UpdateSwarmNodeRequest
+	134, // 141: docker.DockerService.ListSwarmServices:input_type -> docker.ListSwarmServicesRequest
+	138, // 142: docker.DockerService.GetSwarmService:input_type -> docker.GetSwarmServiceRequest
+	140, // 143: docker.DockerService.ScaleSwarmService:input_type -> docker.ScaleSwarmServiceRequest
+	141, // 144: docker.DockerService.UpdateSwarmServiceImage:input_type -> docker.UpdateSwarmServiceImageRequest
+	142, // 145: docker.DockerService.RollbackSwarmService:input_type -> docker.RollbackSwarmServiceRequest
+	144, // 146: docker.DockerService.DeleteSwarmService:input_type -> docker.DeleteSwarmServiceRequest
+	146, // 147: docker.DockerService.ListSwarmTasks:input_type -> docker.ListSwarmTasksRequest
+	150, // 148: docker.DockerService.ListSwarmSecrets:input_type -> docker.ListSwarmSecretsRequest
+	152, // 149: docker.DockerService.CreateSwarmSecret:input_type -> docker.CreateSwarmSecretRequest
+	154, // 150: docker.DockerService.DeleteSwarmSecret:input_type -> docker.DeleteSwarmSecretRequest
+	157, // 151: docker.DockerService.ListSwarmConfigs:input_type -> docker.ListSwarmConfigsRequest
+	159, // 152: docker.DockerService.CreateSwarmConfig:input_type -> docker.CreateSwarmConfigRequest
+	161, // 153: docker.DockerService.DeleteSwarmConfig:input_type -> docker.DeleteSwarmConfigRequest
+	164, // 154: docker.DockerService.ListStacks:input_type -> docker.ListStacksRequest
+	166, // 155: docker.DockerService.DeployStack:input_type -> docker.DeployStackRequest
+	168, // 156: docker.DockerService.RemoveStack:input_type -> docker.RemoveStackRequest
+	1,   // 157: docker.DockerService.GetDockerInfo:output_type -> docker.GetDockerInfoResponse
+	3,   // 158: docker.DockerService.ListContainers:output_type -> docker.ListContainersResponse
+	9,   // 159: docker.DockerService.GetContainer:output_type -> docker.GetContainerResponse
+	17,  // 160: docker.DockerService.StartContainer:output_type -> docker.StartContainerResponse
+	19,  // 161: docker.DockerService.StopContainer:output_type -> docker.StopContainerResponse
+	21,  // 162: docker.DockerService.RestartContainer:output_type -> docker.RestartContainerResponse
+	23,  // 163: docker.DockerService.PauseContainer:output_type -> docker.PauseContainerResponse
+	25,  // 164: docker.DockerService.UnpauseContainer:output_type -> docker.UnpauseContainerResponse
+	27,  // 165: docker.DockerService.DeleteContainer:output_type -> docker.DeleteContainerResponse
+	29,  // 166: docker.DockerService.PruneContainers:output_type -> docker.PruneContainersResponse
+	32,  // 167: docker.DockerService.RecreateContainer:output_type -> docker.RecreateContainerResponse
+	34,  // 168: docker.DockerService.GetContainerStats:output_type -> docker.ContainerStats
+	42,  // 169: docker.DockerService.GetContainerLogs:output_type -> docker.LogEntry
+	47,  // 170: docker.DockerService.ExecContainer:output_type -> docker.ExecResponse
+	52,  // 171: docker.DockerService.ListContainerFiles:output_type -> docker.ListContainerFilesResponse
+	55,  // 172: docker.DockerService.CopyFromContainer:output_type -> docker.ArchiveChunk
+	57,  // 173: docker.DockerService.CopyToContainer:output_type -> docker.CopyToContainerResponse
+	59,  // 174: docker.DockerService.ListImages:output_type -> docker.ListImagesResponse
+	62,  // 175: docker.DockerService.GetImage:output_type -> docker.GetImageResponse
+	67,  // 176: docker.DockerService.DeleteImage:output_type -> docker.DeleteImageResponse
+	70,  // 177: docker.DockerService.PullImage:output_type -> docker.PullImageProgress
+	72,  // 178: docker.DockerService.TagImage:output_type -> docker.TagImageResponse
+	74,  // 179: docker.DockerService.PruneImages:output_type -> docker.PruneImagesResponse
+	76,  // 180: docker.DockerService.ListVolumes:output_type -> docker.ListVolumesResponse
+	80,  // 181: docker.DockerService.GetVolume:output_type -> docker.GetVolumeResponse
+	82,  // 182: docker.DockerService.CreateVolume:output_type -> docker.CreateVolumeResponse
+	84,  // 183: docker.DockerService.DeleteVolume:output_type -> docker.DeleteVolumeResponse
+	86,  // 184: docker.DockerService.PruneVolumes:output_type -> docker.PruneVolumesResponse
+	88,  // 185: docker.DockerService.ListNetworks:output_type -> docker.ListNetworksResponse
+	94,  // 186: docker.DockerService.GetNetwork:output_type -> docker.GetNetworkResponse
+	96,  // 187: docker.DockerService.CreateNetwork:output_type -> docker.CreateNetworkResponse
+	98,  // 188: docker.DockerService.DeleteNetwork:output_type -> docker.DeleteNetworkResponse
+	101, // 189: docker.DockerService.ConnectNetwork:output_type -> docker.ConnectNetworkResponse
+	103, // 190: docker.DockerService.DisconnectNetwork:output_type -> docker.DisconnectNetworkResponse
+	105, // 191: docker.DockerService.GetSystemInfo:output_type -> docker.GetSystemInfoResponse
+	112, // 192: docker.DockerService.GetVersion:output_type -> docker.GetVersionResponse
+	116, // 193: docker.DockerService.GetDiskUsage:output_type -> docker.GetDiskUsageResponse
+	118, // 194: docker.DockerService.PruneBuildCache:output_type -> docker.PruneBuildCacheResponse
+	125, // 195: docker.DockerService.Ping:output_type -> docker.PingResponse
+	127, // 196: docker.DockerService.GetEvents:output_type -> docker.DockerEvent
+	130, // 197: docker.DockerService.ListSwarmNodes:output_type -> docker.ListSwarmNodesResponse
+	133, // 198: docker.DockerService.UpdateSwarmNode:output_type -> docker.UpdateSwarmNodeResponse
+	135, // 199: docker.DockerService.ListSwarmServices:output_type -> docker.ListSwarmServicesResponse
+	139, // 200: docker.DockerService.GetSwarmService:output_type -> docker.GetSwarmServiceResponse
+	143, // 201: docker.DockerService.ScaleSwarmService:output_type -> docker.UpdateSwarmServiceResponse
+	143, // 202: docker.DockerService.UpdateSwarmServiceImage:output_type -> docker.UpdateSwarmServiceResponse
+	143, // 203: docker.DockerService.RollbackSwarmService:output_type -> docker.UpdateSwarmServiceResponse
+	145, // 204: docker.DockerService.DeleteSwarmService:output_type -> docker.DeleteSwarmServiceResponse
+	147, // 205: docker.DockerService.ListSwarmTasks:output_type -> docker.ListSwarmTasksResponse
+	151, // 206: docker.DockerService.ListSwarmSecrets:output_type -> docker.ListSwarmSecretsResponse
+	153, // 207: docker.DockerService.CreateSwarmSecret:output_type -> docker.CreateSwarmSecretResponse
+	155, // 208: docker.DockerService.DeleteSwarmSecret:output_type -> docker.DeleteSwarmSecretResponse
+	158, // 209: docker.DockerService.ListSwarmConfigs:output_type -> docker.ListSwarmConfigsResponse
+	160, // 210: docker.DockerService.CreateSwarmConfig:output_type -> docker.CreateSwarmConfigResponse
+	162, // 211: docker.DockerService.DeleteSwarmConfig:output_type -> docker.DeleteSwarmConfigResponse
+	165, // 212: docker.DockerService.ListStacks:output_type -> docker.ListStacksResponse
+	167, // 213: docker.DockerService.DeployStack:output_type -> docker.DeployStackResponse
+	169, // 214: docker.DockerService.RemoveStack:output_type -> docker.RemoveStackResponse
+	157, // [157:215] is the sub-list for method output_type
+	99,  // [99:157] is the sub-list for method input_type
+	99,  // [99:99] is the sub-list for extension type_name
+	99,  // [99:99] is the sub-list for extension extendee
+	0,   // [0:99] is the sub-list for field type_name
 }
 
 func init() { file_pkg_grpc_proto_docker_docker_proto_init() }
@@ -9145,12 +13153,12 @@ func file_pkg_grpc_proto_docker_docker_proto_init() {
 	if File_pkg_grpc_proto_docker_docker_proto != nil {
 		return
 	}
-	file_pkg_grpc_proto_docker_docker_proto_msgTypes[38].OneofWrappers = []any{
+	file_pkg_grpc_proto_docker_docker_proto_msgTypes[43].OneofWrappers = []any{
 		(*ExecRequest_Start)(nil),
 		(*ExecRequest_Input)(nil),
 		(*ExecRequest_Resize)(nil),
 	}
-	file_pkg_grpc_proto_docker_docker_proto_msgTypes[42].OneofWrappers = []any{
+	file_pkg_grpc_proto_docker_docker_proto_msgTypes[47].OneofWrappers = []any{
 		(*ExecResponse_Output)(nil),
 		(*ExecResponse_Error)(nil),
 		(*ExecResponse_Exit)(nil),
@@ -9161,7 +13169,7 @@ func file_pkg_grpc_proto_docker_docker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_grpc_proto_docker_docker_proto_rawDesc), len(file_pkg_grpc_proto_docker_docker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   140,
+			NumMessages:   205,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,39 +20,64 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DockerService_GetDockerInfo_FullMethodName     = "/docker.DockerService/GetDockerInfo"
-	DockerService_ListContainers_FullMethodName    = "/docker.DockerService/ListContainers"
-	DockerService_GetContainer_FullMethodName      = "/docker.DockerService/GetContainer"
-	DockerService_StartContainer_FullMethodName    = "/docker.DockerService/StartContainer"
-	DockerService_StopContainer_FullMethodName     = "/docker.DockerService/StopContainer"
-	DockerService_RestartContainer_FullMethodName  = "/docker.DockerService/RestartContainer"
-	DockerService_PauseContainer_FullMethodName    = "/docker.DockerService/PauseContainer"
-	DockerService_UnpauseContainer_FullMethodName  = "/docker.DockerService/UnpauseContainer"
-	DockerService_DeleteContainer_FullMethodName   = "/docker.DockerService/DeleteContainer"
-	DockerService_GetContainerStats_FullMethodName = "/docker.DockerService/GetContainerStats"
-	DockerService_GetContainerLogs_FullMethodName  = "/docker.DockerService/GetContainerLogs"
-	DockerService_ExecContainer_FullMethodName     = "/docker.DockerService/ExecContainer"
-	DockerService_ListImages_FullMethodName        = "/docker.DockerService/ListImages"
-	DockerService_GetImage_FullMethodName          = "/docker.DockerService/GetImage"
-	DockerService_DeleteImage_FullMethodName       = "/docker.DockerService/DeleteImage"
-	DockerService_PullImage_FullMethodName         = "/docker.DockerService/PullImage"
-	DockerService_TagImage_FullMethodName          = "/docker.DockerService/TagImage"
-	DockerService_ListVolumes_FullMethodName       = "/docker.DockerService/ListVolumes"
-	DockerService_GetVolume_FullMethodName         = "/docker.DockerService/GetVolume"
-	DockerService_CreateVolume_FullMethodName      = "/docker.DockerService/CreateVolume"
-	DockerService_DeleteVolume_FullMethodName      = "/docker.DockerService/DeleteVolume"
-	DockerService_PruneVolumes_FullMethodName      = "/docker.DockerService/PruneVolumes"
-	DockerService_ListNetworks_FullMethodName      = "/docker.DockerService/ListNetworks"
-	DockerService_GetNetwork_FullMethodName        = "/docker.DockerService/GetNetwork"
-	DockerService_CreateNetwork_FullMethodName     = "/docker.DockerService/CreateNetwork"
-	DockerService_DeleteNetwork_FullMethodName     = "/docker.DockerService/DeleteNetwork"
-	DockerService_ConnectNetwork_FullMethodName    = "/docker.DockerService/ConnectNetwork"
-	DockerService_DisconnectNetwork_FullMethodName = "/docker.DockerService/DisconnectNetwork"
-	DockerService_GetSystemInfo_FullMethodName     = "/docker.DockerService/GetSystemInfo"
-	DockerService_GetVersion_FullMethodName        = "/docker.DockerService/GetVersion"
-	DockerService_GetDiskUsage_FullMethodName      = "/docker.DockerService/GetDiskUsage"
-	DockerService_Ping_FullMethodName              = "/docker.DockerService/Ping"
-	DockerService_GetEvents_FullMethodName         = "/docker.DockerService/GetEvents"
+	DockerService_GetDockerInfo_FullMethodName           = "/docker.DockerService/GetDockerInfo"
+	DockerService_ListContainers_FullMethodName          = "/docker.DockerService/ListContainers"
+	DockerService_GetContainer_FullMethodName            = "/docker.DockerService/GetContainer"
+	DockerService_StartContainer_FullMethodName          = "/docker.DockerService/StartContainer"
+	DockerService_StopContainer_FullMethodName           = "/docker.DockerService/StopContainer"
+	DockerService_RestartContainer_FullMethodName        = "/docker.DockerService/RestartContainer"
+	DockerService_PauseContainer_FullMethodName          = "/docker.DockerService/PauseContainer"
+	DockerService_UnpauseContainer_FullMethodName        = "/docker.DockerService/UnpauseContainer"
+	DockerService_DeleteContainer_FullMethodName         = "/docker.DockerService/DeleteContainer"
+	DockerService_PruneContainers_FullMethodName         = "/docker.DockerService/PruneContainers"
+	DockerService_RecreateContainer_FullMethodName       = "/docker.DockerService/RecreateContainer"
+	DockerService_GetContainerStats_FullMethodName       = "/docker.DockerService/GetContainerStats"
+	DockerService_GetContainerLogs_FullMethodName        = "/docker.DockerService/GetContainerLogs"
+	DockerService_ExecContainer_FullMethodName           = "/docker.DockerService/ExecContainer"
+	DockerService_ListContainerFiles_FullMethodName      = "/docker.DockerService/ListContainerFiles"
+	DockerService_CopyFromContainer_FullMethodName       = "/docker.DockerService/CopyFromContainer"
+	DockerService_CopyToContainer_FullMethodName         = "/docker.DockerService/CopyToContainer"
+	DockerService_ListImages_FullMethodName              = "/docker.DockerService/ListImages"
+	DockerService_GetImage_FullMethodName                = "/docker.DockerService/GetImage"
+	DockerService_DeleteImage_FullMethodName             = "/docker.DockerService/DeleteImage"
+	DockerService_PullImage_FullMethodName               = "/docker.DockerService/PullImage"
+	DockerService_TagImage_FullMethodName                = "/docker.DockerService/TagImage"
+	DockerService_PruneImages_FullMethodName             = "/docker.DockerService/PruneImages"
+	DockerService_ListVolumes_FullMethodName             = "/docker.DockerService/ListVolumes"
+	DockerService_GetVolume_FullMethodName               = "/docker.DockerService/GetVolume"
+	DockerService_CreateVolume_FullMethodName            = "/docker.DockerService/CreateVolume"
+	DockerService_DeleteVolume_FullMethodName            = "/docker.DockerService/DeleteVolume"
+	DockerService_PruneVolumes_FullMethodName            = "/docker.DockerService/PruneVolumes"
+	DockerService_ListNetworks_FullMethodName            = "/docker.DockerService/ListNetworks"
+	DockerService_GetNetwork_FullMethodName              = "/docker.DockerService/GetNetwork"
+	DockerService_CreateNetwork_FullMethodName           = "/docker.DockerService/CreateNetwork"
+	DockerService_DeleteNetwork_FullMethodName           = "/docker.DockerService/DeleteNetwork"
+	DockerService_ConnectNetwork_FullMethodName          = "/docker.DockerService/ConnectNetwork"
+	DockerService_DisconnectNetwork_FullMethodName       = "/docker.DockerService/DisconnectNetwork"
+	DockerService_GetSystemInfo_FullMethodName           = "/docker.DockerService/GetSystemInfo"
+	DockerService_GetVersion_FullMethodName              = "/docker.DockerService/GetVersion"
+	DockerService_GetDiskUsage_FullMethodName            = "/docker.DockerService/GetDiskUsage"
+	DockerService_PruneBuildCache_FullMethodName         = "/docker.DockerService/PruneBuildCache"
+	DockerService_Ping_FullMethodName                    = "/docker.DockerService/Ping"
+	DockerService_GetEvents_FullMethodName               = "/docker.DockerService/GetEvents"
+	DockerService_ListSwarmNodes_FullMethodName          = "/docker.DockerService/ListSwarmNodes"
+	DockerService_UpdateSwarmNode_FullMethodName         = "/docker.DockerService/UpdateSwarmNode"
+	DockerService_ListSwarmServices_FullMethodName       = "/docker.DockerService/ListSwarmServices"
+	DockerService_GetSwarmService_FullMethodName         = "/docker.DockerService/GetSwarmService"
+	DockerService_ScaleSwarmService_FullMethodName       = "/docker.DockerService/ScaleSwarmService"
+	DockerService_UpdateSwarmServiceImage_FullMethodName = "/docker.DockerService/UpdateSwarmServiceImage"
+	DockerService_RollbackSwarmService_FullMethodName    = "/docker.DockerService/RollbackSwarmService"
+	DockerService_DeleteSwarmService_FullMethodName      = "/docker.DockerService/DeleteSwarmService"
+	DockerService_ListSwarmTasks_FullMethodName          = "/docker.DockerService/ListSwarmTasks"
+	DockerService_ListSwarmSecrets_FullMethodName        = "/docker.DockerService/ListSwarmSecrets"
+	DockerService_CreateSwarmSecret_FullMethodName       = "/docker.DockerService/CreateSwarmSecret"
+	DockerService_DeleteSwarmSecret_FullMethodName       = "/docker.DockerService/DeleteSwarmSecret"
+	DockerService_ListSwarmConfigs_FullMethodName        = "/docker.DockerService/ListSwarmConfigs"
+	DockerService_CreateSwarmConfig_FullMethodName       = "/docker.DockerService/CreateSwarmConfig"
+	DockerService_DeleteSwarmConfig_FullMethodName       = "/docker.DockerService/DeleteSwarmConfig"
+	DockerService_ListStacks_FullMethodName              = "/docker.DockerService/ListStacks"
+	DockerService_DeployStack_FullMethodName             = "/docker.DockerService/DeployStack"
+	DockerService_RemoveStack_FullMethodName             = "/docker.DockerService/RemoveStack"
 )
 
 // DockerServiceClient is the client API for DockerService service.
@@ -72,16 +97,23 @@ type DockerServiceClient interface {
 	PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc.CallOption) (*PauseContainerResponse, error)
 	UnpauseContainer(ctx context.Context, in *UnpauseContainerRequest, opts ...grpc.CallOption) (*UnpauseContainerResponse, error)
 	DeleteContainer(ctx context.Context, in *DeleteContainerRequest, opts ...grpc.CallOption) (*DeleteContainerResponse, error)
+	PruneContainers(ctx context.Context, in *PruneContainersRequest, opts ...grpc.CallOption) (*PruneContainersResponse, error)
+	RecreateContainer(ctx context.Context, in *RecreateContainerRequest, opts ...grpc.CallOption) (*RecreateContainerResponse, error)
 	// Streaming operations
 	GetContainerStats(ctx context.Context, in *GetContainerStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContainerStats], error)
 	GetContainerLogs(ctx context.Context, in *GetContainerLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error)
 	ExecContainer(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecRequest, ExecResponse], error)
+	// Container filesystem
+	ListContainerFiles(ctx context.Context, in *ListContainerFilesRequest, opts ...grpc.CallOption) (*ListContainerFilesResponse, error)
+	CopyFromContainer(ctx context.Context, in *CopyFromContainerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error)
+	CopyToContainer(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CopyToContainerRequest, CopyToContainerResponse], error)
 	// Image operations
 	ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error)
 	GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*GetImageResponse, error)
 	DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*DeleteImageResponse, error)
 	PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullImageProgress], error)
 	TagImage(ctx context.Context, in *TagImageRequest, opts ...grpc.CallOption) (*TagImageResponse, error)
+	PruneImages(ctx context.Context, in *PruneImagesRequest, opts ...grpc.CallOption) (*PruneImagesResponse, error)
 	// Volume operations
 	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
 	GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error)
@@ -99,8 +131,28 @@ type DockerServiceClient interface {
 	GetSystemInfo(ctx context.Context, in *GetSystemInfoRequest, opts ...grpc.CallOption) (*GetSystemInfoResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	GetDiskUsage(ctx context.Context, in *GetDiskUsageRequest, opts ...grpc.CallOption) (*GetDiskUsageResponse, error)
+	PruneBuildCache(ctx context.Context, in *PruneBuildCacheRequest, opts ...grpc.CallOption) (*PruneBuildCacheResponse, error)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DockerEvent], error)
+	// Swarm operations (the engine has to be a swarm manager)
+	ListSwarmNodes(ctx context.Context, in *ListSwarmNodesRequest, opts ...grpc.CallOption) (*ListSwarmNodesResponse, error)
+	UpdateSwarmNode(ctx context.Context, in *UpdateSwarmNodeRequest, opts ...grpc.CallOption) (*UpdateSwarmNodeResponse, error)
+	ListSwarmServices(ctx context.Context, in *ListSwarmServicesRequest, opts ...grpc.CallOption) (*ListSwarmServicesResponse, error)
+	GetSwarmService(ctx context.Context, in *GetSwarmServiceRequest, opts ...grpc.CallOption) (*GetSwarmServiceResponse, error)
+	ScaleSwarmService(ctx context.Context, in *ScaleSwarmServiceRequest, opts ...grpc.CallOption) (*UpdateSwarmServiceResponse, error)
+	UpdateSwarmServiceImage(ctx context.Context, in *UpdateSwarmServiceImageRequest, opts ...grpc.CallOption) (*UpdateSwarmServiceResponse, error)
+	RollbackSwarmService(ctx context.Context, in *RollbackSwarmServiceRequest, opts ...grpc.CallOption) (*UpdateSwarmServiceResponse, error)
+	DeleteSwarmService(ctx context.Context, in *DeleteSwarmServiceRequest, opts ...grpc.CallOption) (*DeleteSwarmServiceResponse, error)
+	ListSwarmTasks(ctx context.Context, in *ListSwarmTasksRequest, opts ...grpc.CallOption) (*ListSwarmTasksResponse, error)
+	ListSwarmSecrets(ctx context.Context, in *ListSwarmSecretsRequest, opts ...grpc.CallOption) (*ListSwarmSecretsResponse, error)
+	CreateSwarmSecret(ctx context.Context, in *CreateSwarmSecretRequest, opts ...grpc.CallOption) (*CreateSwarmSecretResponse, error)
+	DeleteSwarmSecret(ctx context.Context, in *DeleteSwarmSecretRequest, opts ...grpc.CallOption) (*DeleteSwarmSecretResponse, error)
+	ListSwarmConfigs(ctx context.Context, in *ListSwarmConfigsRequest, opts ...grpc.CallOption) (*ListSwarmConfigsResponse, error)
+	CreateSwarmConfig(ctx context.Context, in *CreateSwarmConfigRequest, opts ...grpc.CallOption) (*CreateSwarmConfigResponse, error)
+	DeleteSwarmConfig(ctx context.Context, in *DeleteSwarmConfigRequest, opts ...grpc.CallOption) (*DeleteSwarmConfigResponse, error)
+	ListStacks(ctx context.Context, in *ListStacksRequest, opts ...grpc.CallOption) (*ListStacksResponse, error)
+	DeployStack(ctx context.Context, in *DeployStackRequest, opts ...grpc.CallOption) (*DeployStackResponse, error)
+	RemoveStack(ctx context.Context, in *RemoveStackRequest, opts ...grpc.CallOption) (*RemoveStackResponse, error)
 }
 
 type dockerServiceClient struct {
@@ -201,6 +253,26 @@ func (c *dockerServiceClient) DeleteContainer(ctx context.Context, in *DeleteCon
 	return out, nil
 }
 
+func (c *dockerServiceClient) PruneContainers(ctx context.Context, in *PruneContainersRequest, opts ...grpc.CallOption) (*PruneContainersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneContainersResponse)
+	err := c.cc.Invoke(ctx, DockerService_PruneContainers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) RecreateContainer(ctx context.Context, in *RecreateContainerRequest, opts ...grpc.CallOption) (*RecreateContainerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecreateContainerResponse)
+	err := c.cc.Invoke(ctx, DockerService_RecreateContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dockerServiceClient) GetContainerStats(ctx context.Context, in *GetContainerStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContainerStats], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[0], DockerService_GetContainerStats_FullMethodName, cOpts...)
@@ -252,6 +324,48 @@ func (c *dockerServiceClient) ExecContainer(ctx context.Context, opts ...grpc.Ca
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DockerService_ExecContainerClient = grpc.BidiStreamingClient[ExecRequest, ExecResponse]
 
+func (c *dockerServiceClient) ListContainerFiles(ctx context.Context, in *ListContainerFilesRequest, opts ...grpc.CallOption) (*ListContainerFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContainerFilesResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListContainerFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CopyFromContainer(ctx context.Context, in *CopyFromContainerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[3], DockerService_CopyFromContainer_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CopyFromContainerRequest, ArchiveChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DockerService_CopyFromContainerClient = grpc.ServerStreamingClient[ArchiveChunk]
+
+func (c *dockerServiceClient) CopyToContainer(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CopyToContainerRequest, CopyToContainerResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[4], DockerService_CopyToContainer_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CopyToContainerRequest, CopyToContainerResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DockerService_CopyToContainerClient = grpc.ClientStreamingClient[CopyToContainerRequest, CopyToContainerResponse]
+
 func (c *dockerServiceClient) ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListImagesResponse)
@@ -284,7 +398,7 @@ func (c *dockerServiceClient) DeleteImage(ctx context.Context, in *DeleteImageRe
 
 func (c *dockerServiceClient) PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullImageProgress], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[3], DockerService_PullImage_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[5], DockerService_PullImage_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -311,6 +425,16 @@ func (c *dockerServiceClient) TagImage(ctx context.Context, in *TagImageRequest,
 	return out, nil
 }
 
+func (c *dockerServiceClient) PruneImages(ctx context.Context, in *PruneImagesRequest, opts ...grpc.CallOption) (*PruneImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneImagesResponse)
+	err := c.cc.Invoke(ctx, DockerService_PruneImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dockerServiceClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListVolumesResponse)
@@ -451,6 +575,16 @@ func (c *dockerServiceClient) GetDiskUsage(ctx context.Context, in *GetDiskUsage
 	return out, nil
 }
 
+func (c *dockerServiceClient) PruneBuildCache(ctx context.Context, in *PruneBuildCacheRequest, opts ...grpc.CallOption) (*PruneBuildCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneBuildCacheResponse)
+	err := c.cc.Invoke(ctx, DockerService_PruneBuildCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dockerServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PingResponse)
@@ -463,7 +597,7 @@ func (c *dockerServiceClient) Ping(ctx context.Context, in *PingRequest, opts ..
 
 func (c *dockerServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DockerEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[4], DockerService_GetEvents_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DockerService_ServiceDesc.Streams[6], DockerService_GetEvents_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -480,6 +614,186 @@ func (c *dockerServiceClient) GetEvents(ctx context.Context, in *GetEventsReques
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DockerService_GetEventsClient = grpc.ServerStreamingClient[DockerEvent]
 
+func (c *dockerServiceClient) ListSwarmNodes(ctx context.Context, in *ListSwarmNodesRequest, opts ...grpc.CallOption) (*ListSwarmNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSwarmNodesResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListSwarmNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) UpdateSwarmNode(ctx context.Context, in *UpdateSwarmNodeRequest, opts ...grpc.CallOption) (*UpdateSwarmNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSwarmNodeResponse)
+	err := c.cc.Invoke(ctx, DockerService_UpdateSwarmNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ListSwarmServices(ctx context.Context, in *ListSwarmServicesRequest, opts ...grpc.CallOption) (*ListSwarmServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSwarmServicesResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListSwarmServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) GetSwarmService(ctx context.Context, in *GetSwarmServiceRequest, opts ...grpc.CallOption) (*GetSwarmServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSwarmServiceResponse)
+	err := c.cc.Invoke(ctx, DockerService_GetSwarmService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ScaleSwarmService(ctx context.Context, in *ScaleSwarmServiceRequest, opts ...grpc.CallOption) (*UpdateSwarmServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSwarmServiceResponse)
+	err := c.cc.Invoke(ctx, DockerService_ScaleSwarmService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) UpdateSwarmServiceImage(ctx context.Context, in *UpdateSwarmServiceImageRequest, opts ...grpc.CallOption) (*UpdateSwarmServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSwarmServiceResponse)
+	err := c.cc.Invoke(ctx, DockerService_UpdateSwarmServiceImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) RollbackSwarmService(ctx context.Context, in *RollbackSwarmServiceRequest, opts ...grpc.CallOption) (*UpdateSwarmServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSwarmServiceResponse)
+	err := c.cc.Invoke(ctx, DockerService_RollbackSwarmService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeleteSwarmService(ctx context.Context, in *DeleteSwarmServiceRequest, opts ...grpc.CallOption) (*DeleteSwarmServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSwarmServiceResponse)
+	err := c.cc.Invoke(ctx, DockerService_DeleteSwarmService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ListSwarmTasks(ctx context.Context, in *ListSwarmTasksRequest, opts ...grpc.CallOption) (*ListSwarmTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSwarmTasksResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListSwarmTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ListSwarmSecrets(ctx context.Context, in *ListSwarmSecretsRequest, opts ...grpc.CallOption) (*ListSwarmSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSwarmSecretsResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListSwarmSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CreateSwarmSecret(ctx context.Context, in *CreateSwarmSecretRequest, opts ...grpc.CallOption) (*CreateSwarmSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSwarmSecretResponse)
+	err := c.cc.Invoke(ctx, DockerService_CreateSwarmSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeleteSwarmSecret(ctx context.Context, in *DeleteSwarmSecretRequest, opts ...grpc.CallOption) (*DeleteSwarmSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSwarmSecretResponse)
+	err := c.cc.Invoke(ctx, DockerService_DeleteSwarmSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ListSwarmConfigs(ctx context.Context, in *ListSwarmConfigsRequest, opts ...grpc.CallOption) (*ListSwarmConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSwarmConfigsResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListSwarmConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) CreateSwarmConfig(ctx context.Context, in *CreateSwarmConfigRequest, opts ...grpc.CallOption) (*CreateSwarmConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSwarmConfigResponse)
+	err := c.cc.Invoke(ctx, DockerService_CreateSwarmConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeleteSwarmConfig(ctx context.Context, in *DeleteSwarmConfigRequest, opts ...grpc.CallOption) (*DeleteSwarmConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSwarmConfigResponse)
+	err := c.cc.Invoke(ctx, DockerService_DeleteSwarmConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) ListStacks(ctx context.Context, in *ListStacksRequest, opts ...grpc.CallOption) (*ListStacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStacksResponse)
+	err := c.cc.Invoke(ctx, DockerService_ListStacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) DeployStack(ctx context.Context, in *DeployStackRequest, opts ...grpc.CallOption) (*DeployStackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeployStackResponse)
+	err := c.cc.Invoke(ctx, DockerService_DeployStack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dockerServiceClient) RemoveStack(ctx context.Context, in *RemoveStackRequest, opts ...grpc.CallOption) (*RemoveStackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStackResponse)
+	err := c.cc.Invoke(ctx, DockerService_RemoveStack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DockerServiceServer is the server API for DockerService service.
 // All implementations must embed UnimplementedDockerServiceServer
 // for forward compatibility.
@@ -497,16 +811,23 @@ type DockerServiceServer interface {
 	PauseContainer(context.Context, *PauseContainerRequest) (*PauseContainerResponse, error)
 	UnpauseContainer(context.Context, *UnpauseContainerRequest) (*UnpauseContainerResponse, error)
 	DeleteContainer(context.Context, *DeleteContainerRequest) (*DeleteContainerResponse, error)
+	PruneContainers(context.Context, *PruneContainersRequest) (*PruneContainersResponse, error)
+	RecreateContainer(context.Context, *RecreateContainerRequest) (*RecreateContainerResponse, error)
 	// Streaming operations
 	GetContainerStats(*GetContainerStatsRequest, grpc.ServerStreamingServer[ContainerStats]) error
 	GetContainerLogs(*GetContainerLogsRequest, grpc.ServerStreamingServer[LogEntry]) error
 	ExecContainer(grpc.BidiStreamingServer[ExecRequest, ExecResponse]) error
+	// Container filesystem
+	ListContainerFiles(context.Context, *ListContainerFilesRequest) (*ListContainerFilesResponse, error)
+	CopyFromContainer(*CopyFromContainerRequest, grpc.ServerStreamingServer[ArchiveChunk]) error
+	CopyToContainer(grpc.ClientStreamingServer[CopyToContainerRequest, CopyToContainerResponse]) error
 	// Image operations
 	ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error)
 	GetImage(context.Context, *GetImageRequest) (*GetImageResponse, error)
 	DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error)
 	PullImage(*PullImageRequest, grpc.ServerStreamingServer[PullImageProgress]) error
 	TagImage(context.Context, *TagImageRequest) (*TagImageResponse, error)
+	PruneImages(context.Context, *PruneImagesRequest) (*PruneImagesResponse, error)
 	// Volume operations
 	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
 	GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeResponse, error)
@@ -524,8 +845,28 @@ type DockerServiceServer interface {
 	GetSystemInfo(context.Context, *GetSystemInfoRequest) (*GetSystemInfoResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	GetDiskUsage(context.Context, *GetDiskUsageRequest) (*GetDiskUsageResponse, error)
+	PruneBuildCache(context.Context, *PruneBuildCacheRequest) (*PruneBuildCacheResponse, error)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	GetEvents(*GetEventsRequest, grpc.ServerStreamingServer[DockerEvent]) error
+	// Swarm operations (the engine has to be a swarm manager)
+	ListSwarmNodes(context.Context, *ListSwarmNodesRequest) (*ListSwarmNodesResponse, error)
+	UpdateSwarmNode(context.Context, *UpdateSwarmNodeRequest) (*UpdateSwarmNodeResponse, error)
+	ListSwarmServices(context.Context, *ListSwarmServicesRequest) (*ListSwarmServicesResponse, error)
+	GetSwarmService(context.Context, *GetSwarmServiceRequest) (*GetSwarmServiceResponse, error)
+	ScaleSwarmService(context.Context, *ScaleSwarmServiceRequest) (*UpdateSwarmServiceResponse, error)
+	UpdateSwarmServiceImage(context.Context, *UpdateSwarmServiceImageRequest) (*UpdateSwarmServiceResponse, error)
+	RollbackSwarmService(context.Context, *RollbackSwarmServiceRequest) (*UpdateSwarmServiceResponse, error)
+	DeleteSwarmService(context.Context, *DeleteSwarmServiceRequest) (*DeleteSwarmServiceResponse, error)
+	ListSwarmTasks(context.Context, *ListSwarmTasksRequest) (*ListSwarmTasksResponse, error)
+	ListSwarmSecrets(context.Context, *ListSwarmSecretsRequest) (*ListSwarmSecretsResponse, error)
+	CreateSwarmSecret(context.Context, *CreateSwarmSecretRequest) (*CreateSwarmSecretResponse, error)
+	DeleteSwarmSecret(context.Context, *DeleteSwarmSecretRequest) (*DeleteSwarmSecretResponse, error)
+	ListSwarmConfigs(context.Context, *ListSwarmConfigsRequest) (*ListSwarmConfigsResponse, error)
+	CreateSwarmConfig(context.Context, *CreateSwarmConfigRequest) (*CreateSwarmConfigResponse, error)
+	DeleteSwarmConfig(context.Context, *DeleteSwarmConfigRequest) (*DeleteSwarmConfigResponse, error)
+	ListStacks(context.Context, *ListStacksRequest) (*ListStacksResponse, error)
+	DeployStack(context.Context, *DeployStackRequest) (*DeployStackResponse, error)
+	RemoveStack(context.Context, *RemoveStackRequest) (*RemoveStackResponse, error)
 	mustEmbedUnimplementedDockerServiceServer()
 }
 
@@ -563,6 +904,12 @@ func (UnimplementedDockerServiceServer) UnpauseContainer(context.Context, *Unpau
 func (UnimplementedDockerServiceServer) DeleteContainer(context.Context, *DeleteContainerRequest) (*DeleteContainerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteContainer not implemented")
 }
+func (UnimplementedDockerServiceServer) PruneContainers(context.Context, *PruneContainersRequest) (*PruneContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneContainers not implemented")
+}
+func (UnimplementedDockerServiceServer) RecreateContainer(context.Context, *RecreateContainerRequest) (*RecreateContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecreateContainer not implemented")
+}
 func (UnimplementedDockerServiceServer) GetContainerStats(*GetContainerStatsRequest, grpc.ServerStreamingServer[ContainerStats]) error {
 	return status.Errorf(codes.Unimplemented, "method GetContainerStats not implemented")
 }
@@ -572,6 +919,15 @@ func (UnimplementedDockerServiceServer) GetContainerLogs(*GetContainerLogsReques
 func (UnimplementedDockerServiceServer) ExecContainer(grpc.BidiStreamingServer[ExecRequest, ExecResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ExecContainer not implemented")
 }
+func (UnimplementedDockerServiceServer) ListContainerFiles(context.Context, *ListContainerFilesRequest) (*ListContainerFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContainerFiles not implemented")
+}
+func (UnimplementedDockerServiceServer) CopyFromContainer(*CopyFromContainerRequest, grpc.ServerStreamingServer[ArchiveChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method CopyFromContainer not implemented")
+}
+func (UnimplementedDockerServiceServer) CopyToContainer(grpc.ClientStreamingServer[CopyToContainerRequest, CopyToContainerResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CopyToContainer not implemented")
+}
 func (UnimplementedDockerServiceServer) ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImages not implemented")
 }
@@ -587,6 +943,9 @@ func (UnimplementedDockerServiceServer) PullImage(*PullImageRequest, grpc.Server
 func (UnimplementedDockerServiceServer) TagImage(context.Context, *TagImageRequest) (*TagImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TagImage not implemented")
 }
+func (UnimplementedDockerServiceServer) PruneImages(context.Context, *PruneImagesRequest) (*PruneImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneImages not implemented")
+}
 func (UnimplementedDockerServiceServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
 }
@@ -629,12 +988,69 @@ func (UnimplementedDockerServiceServer) GetVersion(context.Context, *GetVersionR
 func (UnimplementedDockerServiceServer) GetDiskUsage(context.Context, *GetDiskUsageRequest) (*GetDiskUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDiskUsage not implemented")
 }
+func (UnimplementedDockerServiceServer) PruneBuildCache(context.Context, *PruneBuildCacheRequest) (*PruneBuildCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBuildCache not implemented")
+}
 func (UnimplementedDockerServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
 func (UnimplementedDockerServiceServer) GetEvents(*GetEventsRequest, grpc.ServerStreamingServer[DockerEvent]) error {
 	return status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
+func (UnimplementedDockerServiceServer) ListSwarmNodes(context.Context, *ListSwarmNodesRequest) (*ListSwarmNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwarmNodes not implemented")
+}
+func (UnimplementedDockerServiceServer) UpdateSwarmNode(context.Context, *UpdateSwarmNodeRequest) (*UpdateSwarmNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwarmNode not implemented")
+}
+func (UnimplementedDockerServiceServer) ListSwarmServices(context.Context, *ListSwarmServicesRequest) (*ListSwarmServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwarmServices not implemented")
+}
+func (UnimplementedDockerServiceServer) GetSwarmService(context.Context, *GetSwarmServiceRequest) (*GetSwarmServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwarmService not implemented")
+}
+func (UnimplementedDockerServiceServer) ScaleSwarmService(context.Context, *ScaleSwarmServiceRequest) (*UpdateSwarmServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleSwarmService not implemented")
+}
+func (UnimplementedDockerServiceServer) UpdateSwarmServiceImage(context.Context, *UpdateSwarmServiceImageRequest) (*UpdateSwarmServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwarmServiceImage not implemented")
+}
+func (UnimplementedDockerServiceServer) RollbackSwarmService(context.Context, *RollbackSwarmServiceRequest) (*UpdateSwarmServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSwarmService not implemented")
+}
+func (UnimplementedDockerServiceServer) DeleteSwarmService(context.Context, *DeleteSwarmServiceRequest) (*DeleteSwarmServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSwarmService not implemented")
+}
+func (UnimplementedDockerServiceServer) ListSwarmTasks(context.Context, *ListSwarmTasksRequest) (*ListSwarmTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwarmTasks not implemented")
+}
+func (UnimplementedDockerServiceServer) ListSwarmSecrets(context.Context, *ListSwarmSecretsRequest) (*ListSwarmSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwarmSecrets not implemented")
+}
+func (UnimplementedDockerServiceServer) CreateSwarmSecret(context.Context, *CreateSwarmSecretRequest) (*CreateSwarmSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwarmSecret not implemented")
+}
+func (UnimplementedDockerServiceServer) DeleteSwarmSecret(context.Context, *DeleteSwarmSecretRequest) (*DeleteSwarmSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSwarmSecret not implemented")
+}
+func (UnimplementedDockerServiceServer) ListSwarmConfigs(context.Context, *ListSwarmConfigsRequest) (*ListSwarmConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwarmConfigs not implemented")
+}
+func (UnimplementedDockerServiceServer) CreateSwarmConfig(context.Context, *CreateSwarmConfigRequest) (*CreateSwarmConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwarmConfig not implemented")
+}
+func (UnimplementedDockerServiceServer) DeleteSwarmConfig(context.Context, *DeleteSwarmConfigRequest) (*DeleteSwarmConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSwarmConfig not implemented")
+}
+func (UnimplementedDockerServiceServer) ListStacks(context.Context, *ListStacksRequest) (*ListStacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStacks not implemented")
+}
+func (UnimplementedDockerServiceServer) DeployStack(context.Context, *DeployStackRequest) (*DeployStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployStack not implemented")
+}
+func (UnimplementedDockerServiceServer) RemoveStack(context.Context, *RemoveStackRequest) (*RemoveStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStack not implemented")
+}
 func (UnimplementedDockerServiceServer) mustEmbedUnimplementedDockerServiceServer() {}
 func (UnimplementedDockerServiceServer) testEmbeddedByValue()                       {}
 
@@ -818,6 +1234,42 @@ func _DockerService_DeleteContainer_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DockerService_PruneContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).PruneContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_PruneContainers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).PruneContainers(ctx, req.(*PruneContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_RecreateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecreateContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).RecreateContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_RecreateContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).RecreateContainer(ctx, req.(*RecreateContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DockerService_GetContainerStats_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetContainerStatsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -847,26 +1299,62 @@ func _DockerService_ExecContainer_Handler(srv interface{}, stream grpc.ServerStr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DockerService_ExecContainerServer = grpc.BidiStreamingServer[ExecRequest, ExecResponse]
 
-func _DockerService_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListImagesRequest)
+func _DockerService_ListContainerFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContainerFilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DockerServiceServer).ListImages(ctx, in)
+		return srv.(DockerServiceServer).ListContainerFiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DockerService_ListImages_FullMethodName,
+		FullMethod: DockerService_ListContainerFiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DockerServiceServer).ListImages(ctx, req.(*ListImagesRequest))
+		return srv.(DockerServiceServer).ListContainerFiles(ctx, req.(*ListContainerFilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DockerService_GetImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetImageRequest)
+func _DockerService_CopyFromContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFromContainerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DockerServiceServer).CopyFromContainer(m, &grpc.GenericServerStream[CopyFromContainerRequest, ArchiveChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DockerService_CopyFromContainerServer = grpc.ServerStreamingServer[ArchiveChunk]
+
+func _DockerService_CopyToContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DockerServiceServer).CopyToContainer(&grpc.GenericServerStream[CopyToContainerRequest, CopyToContainerResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DockerService_CopyToContainerServer = grpc.ClientStreamingServer[CopyToContainerRequest, CopyToContainerResponse]
+
+func _DockerService_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListImages(ctx, req.(*ListImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -930,6 +1418,24 @@ func _DockerService_TagImage_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DockerService_PruneImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).PruneImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_PruneImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).PruneImages(ctx, req.(*PruneImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DockerService_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListVolumesRequest)
 	if err := dec(in); err != nil {
@@ -1182,6 +1688,24 @@ func _DockerService_GetDiskUsage_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DockerService_PruneBuildCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneBuildCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).PruneBuildCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_PruneBuildCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).PruneBuildCache(ctx, req.(*PruneBuildCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DockerService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PingRequest)
 	if err := dec(in); err != nil {
@@ -1211,6 +1735,330 @@ func _DockerService_GetEvents_Handler(srv interface{}, stream grpc.ServerStream)
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DockerService_GetEventsServer = grpc.ServerStreamingServer[DockerEvent]
 
+func _DockerService_ListSwarmNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwarmNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListSwarmNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListSwarmNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListSwarmNodes(ctx, req.(*ListSwarmNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_UpdateSwarmNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSwarmNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).UpdateSwarmNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_UpdateSwarmNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).UpdateSwarmNode(ctx, req.(*UpdateSwarmNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ListSwarmServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwarmServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListSwarmServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListSwarmServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListSwarmServices(ctx, req.(*ListSwarmServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_GetSwarmService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSwarmServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).GetSwarmService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_GetSwarmService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).GetSwarmService(ctx, req.(*GetSwarmServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ScaleSwarmService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleSwarmServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ScaleSwarmService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ScaleSwarmService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ScaleSwarmService(ctx, req.(*ScaleSwarmServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_UpdateSwarmServiceImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSwarmServiceImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).UpdateSwarmServiceImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_UpdateSwarmServiceImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).UpdateSwarmServiceImage(ctx, req.(*UpdateSwarmServiceImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_RollbackSwarmService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackSwarmServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).RollbackSwarmService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_RollbackSwarmService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).RollbackSwarmService(ctx, req.(*RollbackSwarmServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_DeleteSwarmService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSwarmServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DeleteSwarmService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_DeleteSwarmService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DeleteSwarmService(ctx, req.(*DeleteSwarmServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ListSwarmTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwarmTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListSwarmTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListSwarmTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListSwarmTasks(ctx, req.(*ListSwarmTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ListSwarmSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwarmSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListSwarmSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListSwarmSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListSwarmSecrets(ctx, req.(*ListSwarmSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_CreateSwarmSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSwarmSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).CreateSwarmSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_CreateSwarmSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).CreateSwarmSecret(ctx, req.(*CreateSwarmSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_DeleteSwarmSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSwarmSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DeleteSwarmSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_DeleteSwarmSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DeleteSwarmSecret(ctx, req.(*DeleteSwarmSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ListSwarmConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwarmConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListSwarmConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListSwarmConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListSwarmConfigs(ctx, req.(*ListSwarmConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_CreateSwarmConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSwarmConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).CreateSwarmConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_CreateSwarmConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).CreateSwarmConfig(ctx, req.(*CreateSwarmConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_DeleteSwarmConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSwarmConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DeleteSwarmConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_DeleteSwarmConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DeleteSwarmConfig(ctx, req.(*DeleteSwarmConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_ListStacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).ListStacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_ListStacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).ListStacks(ctx, req.(*ListStacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_DeployStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).DeployStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_DeployStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).DeployStack(ctx, req.(*DeployStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DockerService_RemoveStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DockerServiceServer).RemoveStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DockerService_RemoveStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DockerServiceServer).RemoveStack(ctx, req.(*RemoveStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DockerService_ServiceDesc is the grpc.ServiceDesc for DockerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1254,6 +2102,18 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteContainer",
 			Handler:    _DockerService_DeleteContainer_Handler,
 		},
+		{
+			MethodName: "PruneContainers",
+			Handler:    _DockerService_PruneContainers_Handler,
+		},
+		{
+			MethodName: "RecreateContainer",
+			Handler:    _DockerService_RecreateContainer_Handler,
+		},
+		{
+			MethodName: "ListContainerFiles",
+			Handler:    _DockerService_ListContainerFiles_Handler,
+		},
 		{
 			MethodName: "ListImages",
 			Handler:    _DockerService_ListImages_Handler,
@@ -1270,6 +2130,10 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TagImage",
 			Handler:    _DockerService_TagImage_Handler,
 		},
+		{
+			MethodName: "PruneImages",
+			Handler:    _DockerService_PruneImages_Handler,
+		},
 		{
 			MethodName: "ListVolumes",
 			Handler:    _DockerService_ListVolumes_Handler,
@@ -1326,10 +2190,86 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDiskUsage",
 			Handler:    _DockerService_GetDiskUsage_Handler,
 		},
+		{
+			MethodName: "PruneBuildCache",
+			Handler:    _DockerService_PruneBuildCache_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _DockerService_Ping_Handler,
 		},
+		{
+			MethodName: "ListSwarmNodes",
+			Handler:    _DockerService_ListSwarmNodes_Handler,
+		},
+		{
+			MethodName: "UpdateSwarmNode",
+			Handler:    _DockerService_UpdateSwarmNode_Handler,
+		},
+		{
+			MethodName: "ListSwarmServices",
+			Handler:    _DockerService_ListSwarmServices_Handler,
+		},
+		{
+			MethodName: "GetSwarmService",
+			Handler:    _DockerService_GetSwarmService_Handler,
+		},
+		{
+			MethodName: "ScaleSwarmService",
+			Handler:    _DockerService_ScaleSwarmService_Handler,
+		},
+		{
+			MethodName: "UpdateSwarmServiceImage",
+			Handler:    _DockerService_UpdateSwarmServiceImage_Handler,
+		},
+		{
+			MethodName: "RollbackSwarmService",
+			Handler:    _DockerService_RollbackSwarmService_Handler,
+		},
+		{
+			MethodName: "DeleteSwarmService",
+			Handler:    _DockerService_DeleteSwarmService_Handler,
+		},
+		{
+			MethodName: "ListSwarmTasks",
+			Handler:    _DockerService_ListSwarmTasks_Handler,
+		},
+		{
+			MethodName: "ListSwarmSecrets",
+			Handler:    _DockerService_ListSwarmSecrets_Handler,
+		},
+		{
+			MethodName: "CreateSwarmSecret",
+			Handler:    _DockerService_CreateSwarmSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSwarmSecret",
+			Handler:    _DockerService_DeleteSwarmSecret_Handler,
+		},
+		{
+			MethodName: "ListSwarmConfigs",
+			Handler:    _DockerService_ListSwarmConfigs_Handler,
+		},
+		{
+			MethodName: "CreateSwarmConfig",
+			Handler:    _DockerService_CreateSwarmConfig_Handler,
+		},
+		{
+			MethodName: "DeleteSwarmConfig",
+			Handler:    _DockerService_DeleteSwarmConfig_Handler,
+		},
+		{
+			MethodName: "ListStacks",
+			Handler:    _DockerService_ListStacks_Handler,
+		},
+		{
+			MethodName: "DeployStack",
+			Handler:    _DockerService_DeployStack_Handler,
+		},
+		{
+			MethodName: "RemoveStack",
+			Handler:    _DockerService_RemoveStack_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1348,6 +2288,16 @@ var DockerService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CopyFromContainer",
+			Handler:       _DockerService_CopyFromContainer_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CopyToContainer",
+			Handler:       _DockerService_CopyToContainer_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "PullImage",
 			Handler:       _DockerService_PullImage_Handler,
@@ -11,6 +11,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/docker"
+	imageregistry "github.com/ysicing/tiga/pkg/registry"
 	"github.com/ysicing/tiga/pkg/utils"
 )
 
@@ -112,8 +115,41 @@ func (d containerRegistryV2) GetTags(ctx context.Context) ([]ImageTagInfo, error
 	return tags, nil
 }
 
-func getRegistry(image string) registry {
+// authenticatedRegistry lists tags of private repositories with a stored
+// registry credential
+type authenticatedRegistry struct {
+	client *imageregistry.Client
+	repo   string
+}
+
+func (d authenticatedRegistry) GetTags(ctx context.Context) ([]ImageTagInfo, error) {
+	names, err := d.client.ListTags(ctx, d.repo)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]ImageTagInfo, 0, len(names))
+	for _, t := range names {
+		if strings.HasPrefix(t, "sha256") {
+			// Skip digest tags
+			continue
+		}
+		tags = append(tags, ImageTagInfo{Name: t})
+	}
+	return tags, nil
+}
+
+func getRegistry(ctx context.Context, image string) registry {
 	r, repo := utils.GetImageRegistryAndRepo(image)
+
+	if models.DB != nil {
+		client, err := docker.NewRegistryCredentialService(models.DB).NewRegistryClient(ctx, image)
+		if err != nil {
+			logrus.Warnf("failed to load registry credentials for %s: %v", image, err)
+		} else if client != nil {
+			return authenticatedRegistry{client: client, repo: repo}
+		}
+	}
+
 	if r == "" || r == "docker.io" {
 		return dockerRegistry{repo}
 	}
@@ -126,7 +162,7 @@ func GetImageTags(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "image param required"})
 		return
 	}
-	reg := getRegistry(image)
+	reg := getRegistry(c.Request.Context(), image)
 	tags, err := reg.GetTags(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -2,11 +2,17 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/websocket"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +23,7 @@ import (
 	"github.com/ysicing/tiga/pkg/kube"
 	"github.com/ysicing/tiga/pkg/rbac"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -88,8 +95,14 @@ func (h *LogsHandler) HandleLogsWebSocket(c *gin.Context) {
 			logOptions.SinceSeconds = &since
 		}
 
+		filter, err := logFilterFromQuery(c)
+		if err != nil {
+			_ = sendErrorMessage(ws, err.Error())
+			return
+		}
+
 		labelSelector := c.Query("labelSelector")
-		bl := kube.NewBatchLogHandler(ws, cs.K8sClient, logOptions)
+		bl := kube.NewBatchLogHandlerWithOptions(ws, cs.K8sClient, logOptions, kube.BatchLogOptions{Filter: filter})
 
 		if podName == "_all" && labelSelector != "" {
 			selector, err := metav1.ParseToLabelSelector(labelSelector)
@@ -172,6 +185,132 @@ func (h *LogsHandler) watchPods(ctx context.Context, cs *cluster.ClientSet, name
 	}
 }
 
+// DownloadLogs returns a zip with the logs of the last N minutes of every pod
+// of a workload (?workload=deployment/web) or a label selector
+func (h *LogsHandler) DownloadLogs(c *gin.Context) {
+	cs := c.MustGet("cluster").(*cluster.ClientSet)
+	user := c.MustGet("user").(models.User)
+	namespace := c.Param("namespace")
+
+	if !rbac.CanAccess(user, "pods", "log", cs.Name, namespace) {
+		c.JSON(http.StatusForbidden, gin.H{"error": rbac.NoAccess(user.Key(), string(common.VerbLog), "pods", namespace, cs.Name)})
+		return
+	}
+
+	minutes, err := strconv.Atoi(c.DefaultQuery("minutes", "15"))
+	if err != nil || minutes <= 0 || minutes > 24*60 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "minutes must be between 1 and 1440"})
+		return
+	}
+
+	filter, err := logFilterFromQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	selector, name, err := h.resolveLogSelector(ctx, cs, namespace, c.Query("workload"), c.Query("labelSelector"))
+	switch {
+	case errors.Is(err, errInvalidLogSelection):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case apierrors.IsNotFound(err):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve workload: " + err.Error()})
+		return
+	}
+
+	podList := &corev1.PodList{}
+	if err := cs.K8sClient.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list pods: " + err.Error()})
+		return
+	}
+	if len(podList.Items) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no pods match the selection"})
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s-logs-%s.zip", namespace, name, time.Now().Format("20060102-150405"))
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	if err := kube.WriteLogArchive(ctx, c.Writer, cs.K8sClient, podList.Items, kube.LogArchiveOptions{
+		Since:     time.Duration(minutes) * time.Minute,
+		Container: c.Query("container"),
+		Filter:    filter,
+	}); err != nil {
+		logrus.Errorf("Failed to write log archive for %s/%s: %v", namespace, name, err)
+	}
+}
+
+// errInvalidLogSelection wraps resolveLogSelector errors caused by the request parameters
+var errInvalidLogSelection = errors.New("invalid log selection")
+
+// resolveLogSelector returns the pod selector of a workload reference
+// (kind/name) or of an explicit label selector, plus a name for the archive
+func (h *LogsHandler) resolveLogSelector(ctx context.Context, cs *cluster.ClientSet, namespace, workload, labelSelector string) (labels.Selector, string, error) {
+	if workload == "" {
+		if labelSelector == "" {
+			return nil, "", fmt.Errorf("%w: workload or labelSelector is required", errInvalidLogSelection)
+		}
+		selector, err := labels.Parse(labelSelector)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: invalid labelSelector parameter: %v", errInvalidLogSelection, err)
+		}
+		return selector, "selector", nil
+	}
+
+	kind, name, ok := strings.Cut(workload, "/")
+	if !ok || name == "" {
+		return nil, "", fmt.Errorf("%w: workload must be <kind>/<name>", errInvalidLogSelection)
+	}
+
+	key := client.ObjectKey{Namespace: namespace, Name: name}
+	var podSelector *metav1.LabelSelector
+	switch strings.ToLower(kind) {
+	case "deployment", "deployments", "deploy":
+		obj := &appsv1.Deployment{}
+		if err := cs.K8sClient.Get(ctx, key, obj); err != nil {
+			return nil, "", err
+		}
+		podSelector = obj.Spec.Selector
+	case "statefulset", "statefulsets", "sts":
+		obj := &appsv1.StatefulSet{}
+		if err := cs.K8sClient.Get(ctx, key, obj); err != nil {
+			return nil, "", err
+		}
+		podSelector = obj.Spec.Selector
+	case "daemonset", "daemonsets", "ds":
+		obj := &appsv1.DaemonSet{}
+		if err := cs.K8sClient.Get(ctx, key, obj); err != nil {
+			return nil, "", err
+		}
+		podSelector = obj.Spec.Selector
+	default:
+		return nil, "", fmt.Errorf("%w: unsupported workload kind %q", errInvalidLogSelection, kind)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(podSelector)
+	if err != nil {
+		return nil, "", err
+	}
+	return selector, name, nil
+}
+
+// logFilterFromQuery builds a log filter from the include, exclude and
+// comma separated levels query parameters
+func logFilterFromQuery(c *gin.Context) (*kube.LogFilter, error) {
+	var levels []string
+	if v := c.Query("levels"); v != "" {
+		levels = strings.Split(v, ",")
+	}
+	return kube.NewLogFilter(c.Query("include"), c.Query("exclude"), levels)
+}
+
 func sendMessage(ws *websocket.Conn, msgType, data string) error {
 	msg := LogsMessage{
 		Type: msgType,
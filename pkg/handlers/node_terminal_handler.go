@@ -14,6 +14,8 @@ import (
 
 	"github.com/ysicing/tiga/internal/config"
 	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/recording"
+	"github.com/ysicing/tiga/internal/services/terminal"
 	"github.com/ysicing/tiga/pkg/cluster"
 	"github.com/ysicing/tiga/pkg/common"
 	"github.com/ysicing/tiga/pkg/kube"
@@ -25,10 +27,19 @@ import (
 )
 
 type NodeTerminalHandler struct {
+	recording terminalRecording
+	terminals *terminal.Registry
 }
 
-func NewNodeTerminalHandler() *NodeTerminalHandler {
-	return &NodeTerminalHandler{}
+// NewNodeTerminalHandler creates a node terminal handler. Sessions are
+// recorded when the recording manager is set, and their commands indexed
+// when the indexer is set. They are registered in terminals for admins to
+// watch.
+func NewNodeTerminalHandler(manager *recording.ManagerService, indexer *recording.CommandIndexService, terminals *terminal.Registry) *NodeTerminalHandler {
+	return &NodeTerminalHandler{
+		recording: terminalRecording{manager: manager, indexer: indexer},
+		terminals: terminals,
+	}
 }
 
 // HandleNodeTerminalWebSocket handles WebSocket connections for node terminal access
@@ -95,6 +106,16 @@ func (h *NodeTerminalHandler) HandleNodeTerminalWebSocket(c *gin.Context) {
 		}
 
 		session := kube.NewTerminalSession(cs.K8sClient, conn, "kube-system", nodeAgentName, common.NodeTerminalPodName)
+		recorder := h.recording.start(ctx, c, user, "k8s_node", map[string]interface{}{
+			"cluster": cs.Name,
+			"node":    nodeName,
+		})
+		if recorder != nil {
+			session.AddRecorder(recorder)
+			defer recorder.Close()
+		}
+		unregister := registerLiveTerminal(h.terminals, c, user, terminal.KindK8sNode, cs.Name+"/"+nodeName, session, cancel)
+		defer unregister()
 		if err := session.Start(ctx, "attach"); err != nil {
 			logrus.Errorf("Terminal session error: %v", err)
 		}
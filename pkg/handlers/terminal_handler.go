@@ -9,6 +9,8 @@ import (
 	"golang.org/x/net/websocket"
 
 	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/recording"
+	"github.com/ysicing/tiga/internal/services/terminal"
 	"github.com/ysicing/tiga/pkg/cluster"
 	"github.com/ysicing/tiga/pkg/common"
 	"github.com/ysicing/tiga/pkg/kube"
@@ -16,10 +18,18 @@ import (
 )
 
 type TerminalHandler struct {
+	recording terminalRecording
+	terminals *terminal.Registry
 }
 
-func NewTerminalHandler() *TerminalHandler {
-	return &TerminalHandler{}
+// NewTerminalHandler creates a pod terminal handler. Sessions are recorded
+// when the recording manager is set, and their commands indexed when the
+// indexer is set. They are registered in terminals for admins to watch.
+func NewTerminalHandler(manager *recording.ManagerService, indexer *recording.CommandIndexService, terminals *terminal.Registry) *TerminalHandler {
+	return &TerminalHandler{
+		recording: terminalRecording{manager: manager, indexer: indexer},
+		terminals: terminals,
+	}
 }
 
 // HandleTerminalWebSocket handles WebSocket connections for terminal sessions
@@ -53,6 +63,24 @@ func (h *TerminalHandler) HandleTerminalWebSocket(c *gin.Context) {
 			return
 		}
 
+		recorder := h.recording.start(ctx, c, user, "k8s_pod", map[string]interface{}{
+			"cluster":   cs.Name,
+			"namespace": namespace,
+			"pod":       podName,
+			"container": container,
+		})
+		if recorder != nil {
+			session.AddRecorder(recorder)
+			defer recorder.Close()
+		}
+
+		target := cs.Name + "/" + namespace + "/" + podName
+		if container != "" {
+			target += "/" + container
+		}
+		unregister := registerLiveTerminal(h.terminals, c, user, terminal.KindK8sPod, target, session, cancel)
+		defer unregister()
+
 		if err := session.Start(ctx, "exec"); err != nil {
 			logrus.Errorf("Terminal session error: %v", err)
 		}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/terminal"
+	"github.com/ysicing/tiga/pkg/kube"
+)
+
+// liveTerminal mirrors a Kubernetes terminal session to admins watching it
+type liveTerminal struct {
+	session *terminal.Session
+}
+
+func (l liveTerminal) RecordInput([]byte) {}
+
+func (l liveTerminal) RecordOutput(data []byte) {
+	l.session.Output(data)
+}
+
+func (l liveTerminal) Resize(cols, rows uint16) {
+	l.session.Resize(cols, rows)
+}
+
+// registerLiveTerminal registers a Kubernetes terminal session for admins to
+// watch and terminate. Terminating it cancels the stream. The returned
+// function unregisters the session.
+func registerLiveTerminal(
+	terminals *terminal.Registry,
+	c *gin.Context,
+	user models.User,
+	kind terminal.Kind,
+	target string,
+	session *kube.TerminalSession,
+	cancel context.CancelFunc,
+) func() {
+	if terminals == nil {
+		return func() {}
+	}
+
+	live := terminals.Register(terminal.SessionInfo{
+		ID:       uuid.New().String(),
+		Kind:     kind,
+		UserID:   user.ID,
+		Username: user.Username,
+		Target:   target,
+		ClientIP: c.ClientIP(),
+	}, terminal.Controls{
+		Notify: func(message string) {
+			session.SendMessage("stdout", message)
+		},
+		Terminate: func(reason string) {
+			session.SendErrorMessage("Session terminated by administrator: " + reason)
+			cancel()
+		},
+	})
+	session.AddRecorder(liveTerminal{session: live})
+
+	return func() {
+		terminals.Unregister(live)
+	}
+}
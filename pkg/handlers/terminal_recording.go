@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+
+	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/internal/services/recording"
+)
+
+// terminalRecording starts the recordings of Kubernetes terminal sessions
+type terminalRecording struct {
+	manager *recording.ManagerService
+	indexer *recording.CommandIndexService
+}
+
+// start creates a recording of the given type for the session of the user.
+// Recording is skipped when not configured, and failures are logged without
+// preventing the session.
+func (r terminalRecording) start(ctx context.Context, c *gin.Context, user models.User, recordingType string, metadata map[string]interface{}) *recording.TerminalRecorder {
+	if r.manager == nil {
+		return nil
+	}
+
+	typeMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		logrus.Errorf("Failed to marshal terminal recording metadata: %v", err)
+		return nil
+	}
+
+	recorder, err := recording.StartTerminalRecording(ctx, r.manager, r.indexer, &models.TerminalRecording{
+		SessionID:     uuid.New(),
+		UserID:        user.ID,
+		Username:      user.Username,
+		RecordingType: recordingType,
+		TypeMetadata:  datatypes.JSON(typeMetadata),
+		StorageType:   "local",
+		Format:        "asciinema",
+		Rows:          30,
+		Cols:          120,
+		ClientIP:      c.ClientIP(),
+	})
+	if err != nil {
+		logrus.Errorf("Failed to start %s terminal recording: %v", recordingType, err)
+		return nil
+	}
+	return recorder
+}
@@ -0,0 +1,162 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DNS record types supported by CheckDNS
+const (
+	DNSRecordA     = "A"
+	DNSRecordAAAA  = "AAAA"
+	DNSRecordCNAME = "CNAME"
+	DNSRecordMX    = "MX"
+	DNSRecordNS    = "NS"
+	DNSRecordTXT   = "TXT"
+	DNSRecordSRV   = "SRV"
+	DNSRecordPTR   = "PTR"
+)
+
+// DNSOptions configures a DNS check
+type DNSOptions struct {
+	// RecordType is the record to query, A when empty
+	RecordType string
+	// Expect is a comma-separated list of answers that must all be present;
+	// when empty any non-empty answer passes
+	Expect string
+	// Resolver is a custom nameserver as host or host:port; the system
+	// resolver is used when empty
+	Resolver string
+}
+
+// ValidDNSRecordType reports whether CheckDNS can query the record type
+func ValidDNSRecordType(recordType string) bool {
+	switch strings.ToUpper(recordType) {
+	case "", DNSRecordA, DNSRecordAAAA, DNSRecordCNAME, DNSRecordMX, DNSRecordNS, DNSRecordTXT, DNSRecordSRV, DNSRecordPTR:
+		return true
+	}
+	return false
+}
+
+// CheckDNS resolves name and verifies the answers against the expectation.
+// The answers are returned sorted, with trailing dots removed from names.
+func CheckDNS(ctx context.Context, name string, opts DNSOptions) ([]string, error) {
+	recordType := strings.ToUpper(opts.RecordType)
+	if recordType == "" {
+		recordType = DNSRecordA
+	}
+	if !ValidDNSRecordType(recordType) {
+		return nil, fmt.Errorf("unsupported DNS record type %q", opts.RecordType)
+	}
+
+	resolver := net.DefaultResolver
+	if opts.Resolver != "" {
+		server := opts.Resolver
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			server = net.JoinHostPort(server, "53")
+		}
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, server)
+			},
+		}
+	}
+
+	answers, err := lookup(ctx, resolver, recordType, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s lookup for %s failed: %w", recordType, name, err)
+	}
+	if len(answers) == 0 {
+		return nil, fmt.Errorf("%s lookup for %s returned no answers", recordType, name)
+	}
+	sort.Strings(answers)
+
+	if opts.Expect != "" {
+		present := make(map[string]bool, len(answers))
+		for _, answer := range answers {
+			present[normalizeAnswer(answer)] = true
+		}
+		for _, want := range strings.Split(opts.Expect, ",") {
+			want = normalizeAnswer(want)
+			if want != "" && !present[want] {
+				return answers, fmt.Errorf("%s lookup for %s: expected answer %q not found in [%s]",
+					recordType, name, want, truncate(strings.Join(answers, ", "), 200))
+			}
+		}
+	}
+
+	return answers, nil
+}
+
+func lookup(ctx context.Context, resolver *net.Resolver, recordType, name string) ([]string, error) {
+	var answers []string
+	switch recordType {
+	case DNSRecordA, DNSRecordAAAA:
+		network := "ip4"
+		if recordType == DNSRecordAAAA {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			answers = append(answers, ip.String())
+		}
+	case DNSRecordCNAME:
+		cname, err := resolver.LookupCNAME(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, trimDot(cname))
+	case DNSRecordMX:
+		records, err := resolver.LookupMX(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range records {
+			answers = append(answers, trimDot(mx.Host))
+		}
+	case DNSRecordNS:
+		records, err := resolver.LookupNS(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range records {
+			answers = append(answers, trimDot(ns.Host))
+		}
+	case DNSRecordTXT:
+		return resolver.LookupTXT(ctx, name)
+	case DNSRecordSRV:
+		_, records, err := resolver.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range records {
+			answers = append(answers, net.JoinHostPort(trimDot(srv.Target), strconv.Itoa(int(srv.Port))))
+		}
+	case DNSRecordPTR:
+		names, err := resolver.LookupAddr(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range names {
+			answers = append(answers, trimDot(n))
+		}
+	}
+	return answers, nil
+}
+
+func trimDot(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
+
+func normalizeAnswer(answer string) string {
+	return strings.ToLower(trimDot(strings.TrimSpace(answer)))
+}
@@ -0,0 +1,50 @@
+package healthcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// GRPCOptions configures a gRPC health check
+type GRPCOptions struct {
+	// Service is the service name sent in the health request; empty checks
+	// the overall server health
+	Service string
+	// TLS enables transport security with certificate verification
+	TLS bool
+	// ServerName overrides the TLS server name
+	ServerName string
+}
+
+// CheckGRPCHealth calls grpc.health.v1.Health/Check on target (host:port)
+// and fails unless the reported status is SERVING. The returned string is
+// the reported status.
+func CheckGRPCHealth(ctx context.Context, target string, opts GRPCOptions) (string, error) {
+	creds := insecure.NewCredentials()
+	if opts.TLS {
+		creds = credentials.NewTLS(&tls.Config{ServerName: opts.ServerName})
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return "", fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: opts.Service})
+	if err != nil {
+		return "", fmt.Errorf("health check failed: %w", err)
+	}
+
+	status := resp.GetStatus().String()
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return status, fmt.Errorf("service %q is %s", opts.Service, status)
+	}
+	return status, nil
+}
@@ -0,0 +1,148 @@
+package healthcheck
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestAssertJSONPath(t *testing.T) {
+	body := []byte(`{
+		"status": "ok",
+		"version": 3,
+		"ready": true,
+		"meta": {"region": "eu-west", "tags": null},
+		"checks": [
+			{"name": "db", "healthy": true},
+			{"name": "cache", "healthy": false}
+		]
+	}`)
+
+	tests := []struct {
+		name    string
+		path    string
+		expect  string
+		wantErr string
+	}{
+		{name: "string member", path: "$.status", expect: "ok"},
+		{name: "bare member", path: "status", expect: "ok"},
+		{name: "number", path: "$.version", expect: "3"},
+		{name: "bool", path: "$.ready", expect: "true"},
+		{name: "nested bracket", path: "$['meta']['region']", expect: "eu-west"},
+		{name: "index", path: "$.checks[1].name", expect: "cache"},
+		{name: "negative index", path: "$.checks[-1].healthy", expect: "false"},
+		{name: "wildcard any match", path: "$.checks[*].name", expect: "db"},
+		{name: "exists", path: "$.meta.region"},
+		{name: "object value", path: "$.checks[0]", expect: `{"healthy":true,"name":"db"}`},
+		{name: "mismatch", path: "$.status", expect: "down", wantErr: "expected down"},
+		{name: "missing", path: "$.nope", wantErr: "matched nothing"},
+		{name: "null", path: "$.meta.tags", wantErr: "is null"},
+		{name: "out of range", path: "$.checks[5]", wantErr: "matched nothing"},
+		{name: "bad index", path: "$.checks[x]", wantErr: "bad index"},
+		{name: "unclosed", path: "$.checks[0", wantErr: "unclosed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AssertJSONPath(body, tt.path, tt.expect)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+
+	err := AssertJSONPath([]byte("<html>"), "$.status", "ok")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not valid JSON")
+}
+
+func TestCheckDNS(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	answers, err := CheckDNS(ctx, "localhost", DNSOptions{Expect: "127.0.0.1"})
+	require.NoError(t, err)
+	assert.Contains(t, answers, "127.0.0.1")
+
+	_, err = CheckDNS(ctx, "localhost", DNSOptions{RecordType: "a", Expect: "10.0.0.1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	_, err = CheckDNS(ctx, "localhost", DNSOptions{RecordType: "HINFO"})
+	assert.Error(t, err)
+	assert.False(t, ValidDNSRecordType("HINFO"))
+	assert.True(t, ValidDNSRecordType("mx"))
+}
+
+func TestCheckGRPCHealth(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("api", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("worker", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	status, err := CheckGRPCHealth(ctx, lis.Addr().String(), GRPCOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "SERVING", status)
+
+	_, err = CheckGRPCHealth(ctx, lis.Addr().String(), GRPCOptions{Service: "api"})
+	assert.NoError(t, err)
+
+	status, err = CheckGRPCHealth(ctx, lis.Addr().String(), GRPCOptions{Service: "worker"})
+	assert.Error(t, err)
+	assert.Equal(t, "NOT_SERVING", status)
+
+	_, err = CheckGRPCHealth(ctx, lis.Addr().String(), GRPCOptions{Service: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestCheckTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	target := strings.TrimPrefix(server.URL, "https://")
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	info, err := CheckTLS(ctx, target, TLSOptions{RootCAs: roots, ServerName: "example.com"})
+	require.NoError(t, err)
+	assert.Contains(t, info.DNSNames, "example.com")
+	assert.Equal(t, 1, info.ChainLength)
+	assert.Greater(t, info.DaysToExpiry, 0)
+
+	// Untrusted chain is reported with the certificate details
+	info, err = CheckTLS(ctx, target, TLSOptions{ServerName: "example.com"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "verification failed")
+	require.NotNil(t, info)
+
+	_, err = CheckTLS(ctx, target, TLSOptions{RootCAs: roots, ServerName: "other.test"})
+	assert.Error(t, err, "server name must match")
+
+	_, err = CheckTLS(ctx, target, TLSOptions{RootCAs: roots, ServerName: "example.com", MinDaysRemaining: 1 << 20})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "minimum")
+}
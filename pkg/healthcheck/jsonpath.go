@@ -0,0 +1,192 @@
+package healthcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathStep is one segment of a compiled JSONPath expression
+type jsonPathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// JSONPath is a compiled JSONPath expression. The supported subset covers
+// member access ($.a.b, $['a']), array indexes ($.items[0], negative indexes
+// count from the end) and wildcards ($.items[*].name, $.*).
+type JSONPath struct {
+	expr  string
+	steps []jsonPathStep
+}
+
+// CompileJSONPath parses a JSONPath expression; the leading "$" is optional
+func CompileJSONPath(expr string) (*JSONPath, error) {
+	path := strings.TrimSpace(expr)
+	if path == "" {
+		return nil, fmt.Errorf("json path is empty")
+	}
+	path = strings.TrimPrefix(path, "$")
+
+	var steps []jsonPathStep
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			name := path[i:end]
+			if name == "" {
+				return nil, fmt.Errorf("invalid json path %q: empty member name at offset %d", expr, i)
+			}
+			if name == "*" {
+				steps = append(steps, jsonPathStep{wildcard: true})
+			} else {
+				steps = append(steps, jsonPathStep{key: name})
+			}
+			i = end
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid json path %q: unclosed bracket", expr)
+			}
+			inner := strings.TrimSpace(path[i+1 : i+end])
+			i += end + 1
+			switch {
+			case inner == "*":
+				steps = append(steps, jsonPathStep{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				steps = append(steps, jsonPathStep{key: inner[1 : len(inner)-1]})
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid json path %q: bad index %q", expr, inner)
+				}
+				steps = append(steps, jsonPathStep{index: n, isIndex: true})
+			}
+		default:
+			if len(steps) > 0 || i > 0 {
+				return nil, fmt.Errorf("invalid json path %q: unexpected %q at offset %d", expr, path[i], i)
+			}
+			// Allow a bare leading member name, e.g. "data.status"
+			path = "." + path
+		}
+	}
+
+	return &JSONPath{expr: expr, steps: steps}, nil
+}
+
+// String returns the original expression
+func (p *JSONPath) String() string {
+	return p.expr
+}
+
+// Eval returns every value the path selects in a decoded JSON document
+func (p *JSONPath) Eval(doc interface{}) []interface{} {
+	nodes := []interface{}{doc}
+	for _, step := range p.steps {
+		var next []interface{}
+		for _, node := range nodes {
+			switch v := node.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					for _, child := range v {
+						next = append(next, child)
+					}
+				} else if !step.isIndex {
+					if child, ok := v[step.key]; ok {
+						next = append(next, child)
+					}
+				}
+			case []interface{}:
+				switch {
+				case step.wildcard:
+					next = append(next, v...)
+				case step.isIndex:
+					idx := step.index
+					if idx < 0 {
+						idx += len(v)
+					}
+					if idx >= 0 && idx < len(v) {
+						next = append(next, v[idx])
+					}
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// AssertJSONPath checks that the path selects a value in the JSON body. With
+// an empty expect any non-null value passes; otherwise at least one selected
+// value must equal expect, compared by its text form (strings unquoted,
+// numbers, booleans and null as written, objects and arrays as compact JSON).
+func AssertJSONPath(body []byte, path, expect string) error {
+	compiled, err := CompileJSONPath(path)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return fmt.Errorf("response body is not valid JSON: %w", err)
+	}
+
+	values := compiled.Eval(doc)
+	if len(values) == 0 {
+		return fmt.Errorf("json path %s matched nothing", path)
+	}
+
+	var got []string
+	for _, value := range values {
+		text := jsonText(value)
+		if expect == "" {
+			if value != nil {
+				return nil
+			}
+		} else if text == expect {
+			return nil
+		}
+		got = append(got, text)
+	}
+
+	if expect == "" {
+		return fmt.Errorf("json path %s is null", path)
+	}
+	return fmt.Errorf("json path %s = %s, expected %s", path, truncate(strings.Join(got, ", "), 200), expect)
+}
+
+func jsonText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
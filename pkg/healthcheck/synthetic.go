@@ -0,0 +1,307 @@
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxSyntheticSteps limits the length of a synthetic transaction
+const MaxSyntheticSteps = 20
+
+// Extraction sources
+const (
+	ExtractFromHeader = "header"
+	ExtractFromJSON   = "json"
+)
+
+var (
+	templateVarPattern  = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+	variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// SyntheticStep is one HTTP request of a synthetic transaction. URL, header
+// values and body may reference variables extracted by earlier steps as
+// {{name}}.
+type SyntheticStep struct {
+	Name           string            `json:"name"`
+	Method         string            `json:"method,omitempty"` // Default GET
+	URL            string            `json:"url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Body           string            `json:"body,omitempty"`
+	ExpectStatus   int               `json:"expect_status,omitempty"`   // Any 2xx/3xx when zero
+	ExpectBody     string            `json:"expect_body,omitempty"`     // Response body substring
+	JSONAssertions []JSONAssertion   `json:"json_assertions,omitempty"` // JSONPath checks on the response body
+	MaxLatency     int               `json:"max_latency,omitempty"`     // Fail when the step takes longer (ms)
+	Extract        []Extraction      `json:"extract,omitempty"`         // Variables for later steps
+}
+
+// JSONAssertion checks a JSONPath of a response body, see AssertJSONPath
+type JSONAssertion struct {
+	Path   string `json:"path"`
+	Expect string `json:"expect,omitempty"`
+}
+
+// Extraction captures a response value into a variable
+type Extraction struct {
+	Name string `json:"name"`
+	From string `json:"from"` // header or json
+	Path string `json:"path"` // Header name or JSONPath
+}
+
+// StepResult is the outcome of one executed step
+type StepResult struct {
+	Index      int    `json:"index"`
+	Name       string `json:"name"`
+	Success    bool   `json:"success"`
+	Latency    int64  `json:"latency"` // Milliseconds
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// SyntheticResult is the outcome of a synthetic transaction. Steps holds
+// the executed steps; the run stops at the first failing step.
+type SyntheticResult struct {
+	Success bool         `json:"success"`
+	Latency int64        `json:"latency"` // Total milliseconds
+	Error   string       `json:"error,omitempty"`
+	Steps   []StepResult `json:"steps"`
+}
+
+// ValidateSyntheticSteps checks a step list before it is saved: every step
+// needs a URL, assertions must compile and templates may only reference
+// variables extracted by an earlier step
+func ValidateSyntheticSteps(steps []SyntheticStep) error {
+	if len(steps) == 0 {
+		return fmt.Errorf("at least one step is required")
+	}
+	if len(steps) > MaxSyntheticSteps {
+		return fmt.Errorf("at most %d steps are allowed", MaxSyntheticSteps)
+	}
+
+	defined := make(map[string]bool)
+	for i, step := range steps {
+		label := stepLabel(i, step)
+		if strings.TrimSpace(step.URL) == "" {
+			return fmt.Errorf("%s: url is required", label)
+		}
+		if step.Method != "" && !validMethod(step.Method) {
+			return fmt.Errorf("%s: unsupported method %q", label, step.Method)
+		}
+
+		templates := []string{step.URL, step.Body}
+		for _, value := range step.Headers {
+			templates = append(templates, value)
+		}
+		for _, text := range templates {
+			for _, match := range templateVarPattern.FindAllStringSubmatch(text, -1) {
+				if !defined[match[1]] {
+					return fmt.Errorf("%s: variable %q is not extracted by an earlier step", label, match[1])
+				}
+			}
+		}
+
+		for _, assertion := range step.JSONAssertions {
+			if _, err := CompileJSONPath(assertion.Path); err != nil {
+				return fmt.Errorf("%s: %w", label, err)
+			}
+		}
+		for _, extraction := range step.Extract {
+			if !variableNamePattern.MatchString(extraction.Name) {
+				return fmt.Errorf("%s: invalid variable name %q", label, extraction.Name)
+			}
+			switch extraction.From {
+			case ExtractFromHeader:
+				if extraction.Path == "" {
+					return fmt.Errorf("%s: header name is required to extract %q", label, extraction.Name)
+				}
+			case ExtractFromJSON:
+				if _, err := CompileJSONPath(extraction.Path); err != nil {
+					return fmt.Errorf("%s: %w", label, err)
+				}
+			default:
+				return fmt.Errorf("%s: extraction source must be header or json", label)
+			}
+			defined[extraction.Name] = true
+		}
+	}
+	return nil
+}
+
+// RunSynthetic executes the steps in order, sharing cookies and extracted
+// variables between them. client supplies the transport and timeout; it is
+// copied so cookies never leak between runs.
+func RunSynthetic(ctx context.Context, client *http.Client, steps []SyntheticStep) *SyntheticResult {
+	result := &SyntheticResult{Steps: make([]StepResult, 0, len(steps))}
+
+	runClient := http.Client{}
+	if client != nil {
+		runClient = *client
+	}
+	jar, _ := cookiejar.New(nil)
+	runClient.Jar = jar
+
+	vars := make(map[string]string)
+	start := time.Now()
+	for i, step := range steps {
+		stepResult := runStep(ctx, &runClient, i, step, vars)
+		result.Steps = append(result.Steps, stepResult)
+		if !stepResult.Success {
+			result.Error = fmt.Sprintf("%s: %s", stepLabel(i, step), stepResult.Error)
+			break
+		}
+	}
+	result.Latency = time.Since(start).Milliseconds()
+	result.Success = result.Error == ""
+	return result
+}
+
+func runStep(ctx context.Context, client *http.Client, index int, step SyntheticStep, vars map[string]string) StepResult {
+	res := StepResult{Index: index, Name: step.Name}
+	fail := func(format string, args ...interface{}) StepResult {
+		res.Error = fmt.Sprintf(format, args...)
+		return res
+	}
+
+	target, err := renderTemplate(step.URL, vars)
+	if err != nil {
+		return fail("%v", err)
+	}
+	body, err := renderTemplate(step.Body, vars)
+	if err != nil {
+		return fail("%v", err)
+	}
+	method := strings.ToUpper(step.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reqBody)
+	if err != nil {
+		return fail("failed to create request: %v", err)
+	}
+	req.Header.Set("User-Agent", "Tiga/1.0 SyntheticProbe")
+	for key, value := range step.Headers {
+		rendered, err := renderTemplate(value, vars)
+		if err != nil {
+			return fail("%v", err)
+		}
+		req.Header.Set(key, rendered)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		res.Latency = time.Since(start).Milliseconds()
+		return fail("request failed: %v", err)
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	resp.Body.Close()
+	res.Latency = time.Since(start).Milliseconds()
+	res.StatusCode = resp.StatusCode
+	if err != nil {
+		return fail("failed to read response: %v", err)
+	}
+
+	if step.ExpectStatus > 0 {
+		if resp.StatusCode != step.ExpectStatus {
+			return fail("unexpected status code: %d (expected %d)", resp.StatusCode, step.ExpectStatus)
+		}
+	} else if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fail("unexpected status code: %d", resp.StatusCode)
+	}
+	if step.ExpectBody != "" && !bytes.Contains(respBody, []byte(step.ExpectBody)) {
+		return fail("expected content not found")
+	}
+	for _, assertion := range step.JSONAssertions {
+		if err := AssertJSONPath(respBody, assertion.Path, assertion.Expect); err != nil {
+			return fail("%v", err)
+		}
+	}
+	if step.MaxLatency > 0 && res.Latency > int64(step.MaxLatency) {
+		return fail("took %dms (max %dms)", res.Latency, step.MaxLatency)
+	}
+
+	for _, extraction := range step.Extract {
+		value, err := extract(resp.Header, respBody, extraction)
+		if err != nil {
+			return fail("failed to extract %s: %v", extraction.Name, err)
+		}
+		vars[extraction.Name] = value
+	}
+
+	res.Success = true
+	return res
+}
+
+func extract(header http.Header, body []byte, extraction Extraction) (string, error) {
+	switch extraction.From {
+	case ExtractFromHeader:
+		value := header.Get(extraction.Path)
+		if value == "" {
+			return "", fmt.Errorf("header %s is missing", extraction.Path)
+		}
+		return value, nil
+	case ExtractFromJSON:
+		path, err := CompileJSONPath(extraction.Path)
+		if err != nil {
+			return "", err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		var doc interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			return "", fmt.Errorf("response body is not valid JSON: %w", err)
+		}
+		values := path.Eval(doc)
+		if len(values) == 0 || values[0] == nil {
+			return "", fmt.Errorf("json path %s matched nothing", extraction.Path)
+		}
+		return jsonText(values[0]), nil
+	default:
+		return "", fmt.Errorf("unknown extraction source %q", extraction.From)
+	}
+}
+
+func renderTemplate(text string, vars map[string]string) (string, error) {
+	var missing string
+	rendered := templateVarPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := templateVarPattern.FindStringSubmatch(match)[1]
+		value, ok := vars[name]
+		if !ok && missing == "" {
+			missing = name
+		}
+		return value
+	})
+	if missing != "" {
+		return "", fmt.Errorf("variable %q is not defined", missing)
+	}
+	return rendered, nil
+}
+
+func stepLabel(index int, step SyntheticStep) string {
+	if step.Name != "" {
+		return fmt.Sprintf("step %d (%s)", index+1, step.Name)
+	}
+	return fmt.Sprintf("step %d", index+1)
+}
+
+func validMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newShopServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc"})
+		w.Header().Set("X-Auth-Token", "token-1")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+	mux.HandleFunc("/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"order":{"id":42,"item":"` + req["item"] + `"}}`))
+	})
+	mux.HandleFunc("/orders/42", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("session"); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":42,"status":"pending"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRunSynthetic(t *testing.T) {
+	server := newShopServer(t)
+
+	steps := []SyntheticStep{
+		{
+			Name:    "login",
+			Method:  "POST",
+			URL:     server.URL + "/login",
+			Extract: []Extraction{{Name: "token", From: ExtractFromHeader, Path: "X-Auth-Token"}},
+		},
+		{
+			Name:           "create order",
+			Method:         "POST",
+			URL:            server.URL + "/orders",
+			Headers:        map[string]string{"Authorization": "Bearer {{ token }}"},
+			Body:           `{"item":"book"}`,
+			ExpectStatus:   http.StatusCreated,
+			JSONAssertions: []JSONAssertion{{Path: "$.order.item", Expect: "book"}},
+			Extract:        []Extraction{{Name: "order_id", From: ExtractFromJSON, Path: "$.order.id"}},
+		},
+		{
+			Name:           "fetch order",
+			URL:            server.URL + "/orders/{{order_id}}",
+			JSONAssertions: []JSONAssertion{{Path: "$.status", Expect: "pending"}},
+		},
+	}
+	require.NoError(t, ValidateSyntheticSteps(steps))
+
+	result := RunSynthetic(context.Background(), http.DefaultClient, steps)
+	assert.True(t, result.Success, result.Error)
+	require.Len(t, result.Steps, 3)
+	assert.Equal(t, http.StatusCreated, result.Steps[1].StatusCode)
+	assert.Nil(t, http.DefaultClient.Jar, "the caller's client is not modified")
+
+	// A failing assertion stops the run at that step
+	steps[2].JSONAssertions[0].Expect = "shipped"
+	result = RunSynthetic(context.Background(), http.DefaultClient, steps)
+	assert.False(t, result.Success)
+	require.Len(t, result.Steps, 3)
+	assert.False(t, result.Steps[2].Success)
+	assert.Contains(t, result.Error, "step 3 (fetch order)")
+
+	steps[1].ExpectStatus = http.StatusOK
+	result = RunSynthetic(context.Background(), http.DefaultClient, steps)
+	assert.False(t, result.Success)
+	assert.Len(t, result.Steps, 2)
+	assert.Contains(t, result.Steps[1].Error, "expected 200")
+}
+
+func TestValidateSyntheticSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []SyntheticStep
+		wantErr string
+	}{
+		{name: "empty", wantErr: "at least one step"},
+		{name: "no url", steps: []SyntheticStep{{Name: "a"}}, wantErr: "url is required"},
+		{name: "bad method", steps: []SyntheticStep{{URL: "http://x", Method: "TRACE"}}, wantErr: "unsupported method"},
+		{
+			name:    "undefined variable",
+			steps:   []SyntheticStep{{URL: "http://x/{{id}}"}},
+			wantErr: `variable "id"`,
+		},
+		{
+			name: "variable used in the step that extracts it",
+			steps: []SyntheticStep{
+				{URL: "http://x", Headers: map[string]string{"A": "{{id}}"}, Extract: []Extraction{{Name: "id", From: ExtractFromJSON, Path: "$.id"}}},
+			},
+			wantErr: `variable "id"`,
+		},
+		{
+			name:    "bad source",
+			steps:   []SyntheticStep{{URL: "http://x", Extract: []Extraction{{Name: "id", From: "cookie", Path: "a"}}}},
+			wantErr: "header or json",
+		},
+		{
+			name:    "bad variable name",
+			steps:   []SyntheticStep{{URL: "http://x", Extract: []Extraction{{Name: "1id", From: ExtractFromHeader, Path: "X-Id"}}}},
+			wantErr: "invalid variable name",
+		},
+		{
+			name:    "bad assertion",
+			steps:   []SyntheticStep{{URL: "http://x", JSONAssertions: []JSONAssertion{{Path: "$.a[b]"}}}},
+			wantErr: "bad index",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSyntheticSteps(tt.steps)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package healthcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+)
+
+// TLSOptions configures a TLS handshake check
+type TLSOptions struct {
+	// ServerName is the SNI and verification name, the target host when empty
+	ServerName string
+	// MinDaysRemaining fails the check when the leaf certificate expires in
+	// fewer days; expired certificates always fail
+	MinDaysRemaining int
+	// RootCAs overrides the system roots used for chain validation
+	RootCAs *x509.CertPool
+}
+
+// CertificateInfo describes the leaf certificate presented by a server
+type CertificateInfo struct {
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	NotBefore    time.Time `json:"not_before"`
+	NotAfter     time.Time `json:"not_after"`
+	DaysToExpiry int       `json:"days_to_expiry"`
+	DNSNames     []string  `json:"dns_names"`
+	ChainLength  int       `json:"chain_length"`
+}
+
+// CheckTLS performs a TLS handshake with target (host:port) without sending
+// application data. The certificate chain is validated against the system
+// roots and the server name, then the leaf expiry is checked. Certificate
+// details are returned whenever the server presented one, including when
+// validation fails.
+func CheckTLS(ctx context.Context, target string, opts TLSOptions) (*CertificateInfo, error) {
+	host, _, err := net.SplitHostPort(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target %q: %w", target, err)
+	}
+	serverName := opts.ServerName
+	if serverName == "" {
+		serverName = host
+	}
+
+	// Verification is done below so the certificate can still be reported
+	// when the chain is invalid
+	dialer := &tls.Dialer{Config: &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true, //nolint:gosec // verified manually after the handshake
+	}}
+	conn, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return nil, fmt.Errorf("TLS handshake failed: %w", err)
+	}
+	defer conn.Close()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	if len(state.PeerCertificates) == 0 {
+		return nil, fmt.Errorf("server presented no certificate")
+	}
+
+	leaf := state.PeerCertificates[0]
+	info := &CertificateInfo{
+		Subject:      leaf.Subject.CommonName,
+		Issuer:       leaf.Issuer.CommonName,
+		NotBefore:    leaf.NotBefore,
+		NotAfter:     leaf.NotAfter,
+		DaysToExpiry: int(time.Until(leaf.NotAfter).Hours() / 24),
+		DNSNames:     leaf.DNSNames,
+		ChainLength:  len(state.PeerCertificates),
+	}
+
+	now := time.Now()
+	if now.After(leaf.NotAfter) {
+		return info, fmt.Errorf("certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         opts.RootCAs,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	}); err != nil {
+		return info, fmt.Errorf("certificate verification failed: %w", err)
+	}
+
+	if opts.MinDaysRemaining > 0 && info.DaysToExpiry < opts.MinDaysRemaining {
+		return info, fmt.Errorf("certificate expires in %d days (minimum %d)", info.DaysToExpiry, opts.MinDaysRemaining)
+	}
+	return info, nil
+}
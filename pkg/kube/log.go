@@ -1,11 +1,15 @@
 package kube
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/websocket"
@@ -19,25 +23,71 @@ type PodLogStream struct {
 	Done   chan struct{}
 }
 
+// DefaultLogMergeWindow is how long lines are held back so that lines from
+// several pods can be emitted in timestamp order
+const DefaultLogMergeWindow = 500 * time.Millisecond
+
+// BatchLogOptions configures filtering and ordering of a BatchLogHandler
+type BatchLogOptions struct {
+	Filter      *LogFilter    // nil streams every line
+	MergeWindow time.Duration // 0 uses DefaultLogMergeWindow
+}
+
 type BatchLogHandler struct {
 	conn      *websocket.Conn
+	mu        sync.Mutex
 	pods      map[string]*PodLogStream // key: namespace/name
 	k8sClient *K8sClient
 	opts      *corev1.PodLogOptions
 	ctx       context.Context
 	cancel    context.CancelFunc
+
+	filter         *LogFilter
+	mergeWindow    time.Duration
+	showTimestamps bool
+	events         chan logEvent
+}
+
+// logEvent is a log line or a notice queued for ordered delivery. Notices
+// are sent after every line queued before them.
+type logEvent struct {
+	line    LogLine
+	msgType string // notice message type; empty for a log line
+	data    string
 }
 
 func NewBatchLogHandler(conn *websocket.Conn, client *K8sClient, opts *corev1.PodLogOptions) *BatchLogHandler {
+	return NewBatchLogHandlerWithOptions(conn, client, opts, BatchLogOptions{})
+}
+
+// NewBatchLogHandlerWithOptions creates a BatchLogHandler that filters lines
+// and merges the streams of all pods by timestamp
+func NewBatchLogHandlerWithOptions(conn *websocket.Conn, client *K8sClient, opts *corev1.PodLogOptions, batchOpts BatchLogOptions) *BatchLogHandler {
 	ctx, cancel := context.WithCancel(context.Background())
+
+	// Timestamps are always requested for ordering and stripped again on
+	// output when the caller did not ask for them
+	streamOpts := opts.DeepCopy()
+	streamOpts.Timestamps = true
+
+	mergeWindow := batchOpts.MergeWindow
+	if mergeWindow <= 0 {
+		mergeWindow = DefaultLogMergeWindow
+	}
+
 	l := &BatchLogHandler{
-		conn:      conn,
-		pods:      make(map[string]*PodLogStream),
-		k8sClient: client,
-		opts:      opts,
-		ctx:       ctx,
-		cancel:    cancel,
+		conn:           conn,
+		pods:           make(map[string]*PodLogStream),
+		k8sClient:      client,
+		opts:           streamOpts,
+		ctx:            ctx,
+		cancel:         cancel,
+		filter:         batchOpts.Filter,
+		mergeWindow:    mergeWindow,
+		showTimestamps: opts.Timestamps,
+		events:         make(chan logEvent, 1024),
 	}
+	go l.emit()
 	return l
 }
 
@@ -56,10 +106,8 @@ func (l *BatchLogHandler) StreamLogs(ctx context.Context) {
 	l.Stop()
 }
 
-func (l *BatchLogHandler) startPodLogStream(podStream *PodLogStream) {
+func (l *BatchLogHandler) startPodLogStream(podCtx context.Context, podStream *PodLogStream) {
 	pod := podStream.Pod
-	podCtx, cancel := context.WithCancel(l.ctx)
-	podStream.Cancel = cancel
 
 	defer func() {
 		close(podStream.Done)
@@ -68,38 +116,143 @@ func (l *BatchLogHandler) startPodLogStream(podStream *PodLogStream) {
 	req := l.k8sClient.ClientSet.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, l.opts)
 	podLogs, err := req.Stream(podCtx)
 	if err != nil {
-		_ = sendErrorMessage(l.conn, fmt.Sprintf("Failed to get pod logs for %s: %v", pod.Name, err))
+		l.notify("error", fmt.Sprintf("Failed to get pod logs for %s: %v", pod.Name, err))
 		return
 	}
 	defer func() {
 		_ = podLogs.Close()
 	}()
 
-	lw := writerFunc(func(p []byte) (int, error) {
-		logString := string(p)
-		logLines := strings.SplitSeq(logString, "\n")
-		for line := range logLines {
-			if line == "" {
-				continue
+	reader := bufio.NewReader(podLogs)
+	for {
+		raw, err := reader.ReadString('\n')
+		if raw = strings.TrimRight(raw, "\r\n"); raw != "" {
+			line := ParseLogLine(raw)
+			line.Pod = pod.Name
+			line.Container = l.opts.Container
+			line.received = time.Now()
+			if line.Timestamp.IsZero() {
+				line.Timestamp = line.received
 			}
-			if len(l.pods) > 1 {
-				line = fmt.Sprintf("[%s]: %s", pod.Name, line)
+			if l.filter.Match(line) {
+				select {
+				case l.events <- logEvent{line: line}:
+				case <-podCtx.Done():
+					return
+				}
 			}
-			err := sendMessage(l.conn, "log", line)
-			if err != nil {
-				return 0, err
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, context.Canceled) {
+				l.notify("error", fmt.Sprintf("Failed to stream pod logs for %s: %v", pod.Name, err))
+			}
+			break
+		}
+	}
+
+	l.notify("close", fmt.Sprintf("{\"status\":\"closed\",\"pod\":\"%s\"}", pod.Name))
+}
+
+// notify queues a notice behind the lines already queued. It is dropped once
+// the handler is stopped.
+func (l *BatchLogHandler) notify(msgType, data string) {
+	select {
+	case l.events <- logEvent{msgType: msgType, data: data}:
+	case <-l.ctx.Done():
+	}
+}
+
+// emit buffers lines for the merge window and sends them sorted by
+// timestamp, so interleaved pod streams arrive in chronological order.
+// Pending lines are flushed before a notice and when the handler stops.
+func (l *BatchLogHandler) emit() {
+	interval := l.mergeWindow / 2
+	if interval < 50*time.Millisecond {
+		interval = 50 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var pending []LogLine
+	flush := func(cutoff time.Time) error {
+		sort.SliceStable(pending, func(i, j int) bool {
+			return pending[i].Timestamp.Before(pending[j].Timestamp)
+		})
+		multiPod := l.podCount() > 1
+		kept := pending[:0]
+		for _, line := range pending {
+			if line.received.After(cutoff) {
+				kept = append(kept, line)
+				continue
+			}
+			if err := sendMessage(l.conn, "log", l.formatLine(line, multiPod)); err != nil {
+				return err
 			}
 		}
+		pending = kept
+		return nil
+	}
 
-		return len(p), nil
-	})
+	handle := func(ev logEvent) error {
+		if ev.msgType == "" {
+			pending = append(pending, ev.line)
+			return nil
+		}
+		if err := flush(time.Now()); err != nil {
+			return err
+		}
+		return sendMessage(l.conn, ev.msgType, ev.data)
+	}
 
-	_, err = io.Copy(lw, podLogs)
-	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, context.Canceled) {
-		_ = sendErrorMessage(l.conn, fmt.Sprintf("Failed to stream pod logs for %s: %v", pod.Name, err))
+	for {
+		select {
+		case <-l.ctx.Done():
+			// Deliver what was already queued; the connection may be gone
+			for {
+				select {
+				case ev := <-l.events:
+					if err := handle(ev); err != nil {
+						return
+					}
+				default:
+					_ = flush(time.Now())
+					return
+				}
+			}
+		case ev := <-l.events:
+			if err := handle(ev); err != nil {
+				logrus.Debugf("Failed to send log lines, cancelling internal context: %v", err)
+				l.cancel()
+				return
+			}
+		case now := <-ticker.C:
+			if len(pending) == 0 {
+				continue
+			}
+			if err := flush(now.Add(-l.mergeWindow)); err != nil {
+				logrus.Debugf("Failed to send log lines, cancelling internal context: %v", err)
+				l.cancel()
+				return
+			}
+		}
 	}
+}
+
+func (l *BatchLogHandler) formatLine(line LogLine, multiPod bool) string {
+	msg := line.Message
+	if l.showTimestamps {
+		msg = line.Timestamp.Format(time.RFC3339Nano) + " " + msg
+	}
+	if multiPod {
+		msg = fmt.Sprintf("[%s]: %s", line.Pod, msg)
+	}
+	return msg
+}
 
-	_ = sendMessage(l.conn, "close", fmt.Sprintf("{\"status\":\"closed\",\"pod\":\"%s\"}", pod.Name))
+func (l *BatchLogHandler) podCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.pods)
 }
 
 func (l *BatchLogHandler) heartbeat(ctx context.Context) {
@@ -137,18 +290,23 @@ func (l *BatchLogHandler) heartbeat(ctx context.Context) {
 func (l *BatchLogHandler) AddPod(pod corev1.Pod) {
 	key := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
 
+	l.mu.Lock()
 	if _, exists := l.pods[key]; exists {
+		l.mu.Unlock()
 		return
 	}
 
+	podCtx, cancel := context.WithCancel(l.ctx)
 	podStream := &PodLogStream{
-		Pod:  pod,
-		Done: make(chan struct{}),
+		Pod:    pod,
+		Cancel: cancel,
+		Done:   make(chan struct{}),
 	}
 	l.pods[key] = podStream
+	l.mu.Unlock()
 
 	// Start streaming for this pod
-	go l.startPodLogStream(podStream)
+	go l.startPodLogStream(podCtx, podStream)
 
 	_ = sendMessage(l.conn, "pod_added", fmt.Sprintf("{\"pod\":\"%s\",\"namespace\":\"%s\"}",
 		pod.Name, pod.Namespace))
@@ -157,7 +315,13 @@ func (l *BatchLogHandler) AddPod(pod corev1.Pod) {
 // RemovePod removes a pod from the batch log handler and stops streaming its logs
 func (l *BatchLogHandler) RemovePod(pod corev1.Pod) {
 	key := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+
+	l.mu.Lock()
 	podStream, exists := l.pods[key]
+	if exists {
+		delete(l.pods, key)
+	}
+	l.mu.Unlock()
 	if !exists {
 		return
 	}
@@ -168,29 +332,21 @@ func (l *BatchLogHandler) RemovePod(pod corev1.Pod) {
 
 	go func() {
 		<-podStream.Done
-		_ = sendMessage(l.conn, "pod_removed", fmt.Sprintf("{\"pod\":\"%s\",\"namespace\":\"%s\"}",
+		l.notify("pod_removed", fmt.Sprintf("{\"pod\":\"%s\",\"namespace\":\"%s\"}",
 			pod.Name, pod.Namespace))
 	}()
-
-	delete(l.pods, key)
 }
 
 func (l *BatchLogHandler) Stop() {
+	l.mu.Lock()
 	for _, podStream := range l.pods {
 		if podStream.Cancel != nil {
 			podStream.Cancel()
 		}
 	}
-	l.cancel()
 	l.pods = make(map[string]*PodLogStream)
-}
-
-// writerFunc adapts a function to io.Writer so we can create
-// small writers inline inside functions and capture local state.
-type writerFunc func([]byte) (int, error)
-
-func (wf writerFunc) Write(p []byte) (int, error) {
-	return wf(p)
+	l.mu.Unlock()
+	l.cancel()
 }
 
 type LogsMessage struct {
@@ -208,7 +364,3 @@ func sendMessage(ws *websocket.Conn, msgType, data string) error {
 	}
 	return nil
 }
-
-func sendErrorMessage(ws *websocket.Conn, errMsg string) error {
-	return sendMessage(ws, "error", errMsg)
-}
@@ -0,0 +1,167 @@
+package kube
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultLogArchiveBytes limits the log size kept per container; the newest lines are kept
+const DefaultLogArchiveBytes = 10 << 20
+
+// logArchiveTruncatedFile lists the containers whose logs were cut short
+const logArchiveTruncatedFile = "TRUNCATED.txt"
+
+// LogArchiveOptions configures WriteLogArchive
+type LogArchiveOptions struct {
+	Since                time.Duration // Only lines newer than this
+	Container            string        // Empty includes every container
+	Filter               *LogFilter
+	MaxBytesPerContainer int64 // 0 uses DefaultLogArchiveBytes
+}
+
+// WriteLogArchive writes a zip with one <pod>/<container>.log entry per
+// container plus merged.log, which interleaves all lines by timestamp.
+// A container log over the size limit keeps its newest lines, starts with a
+// marker line and is listed in TRUNCATED.txt.
+func WriteLogArchive(ctx context.Context, w io.Writer, client *K8sClient, pods []corev1.Pod, opts LogArchiveOptions) error {
+	limit := opts.MaxBytesPerContainer
+	if limit <= 0 {
+		limit = DefaultLogArchiveBytes
+	}
+	var sinceSeconds *int64
+	if opts.Since > 0 {
+		seconds := int64(opts.Since.Seconds())
+		sinceSeconds = &seconds
+	}
+
+	zw := zip.NewWriter(w)
+	var merged []LogLine
+	var truncated []string
+
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			if opts.Container != "" && container.Name != opts.Container {
+				continue
+			}
+
+			// LimitBytes would keep the oldest bytes of the window, so the
+			// whole window is streamed and only the newest lines are kept
+			lines, cut, err := fetchContainerLogs(ctx, client, pod, &corev1.PodLogOptions{
+				Container:    container.Name,
+				Timestamps:   true,
+				SinceSeconds: sinceSeconds,
+			}, opts.Filter, limit)
+
+			entry, createErr := zw.Create(fmt.Sprintf("%s/%s.log", pod.Name, container.Name))
+			if createErr != nil {
+				return createErr
+			}
+			if err != nil {
+				// Keep going so one unavailable container does not fail the archive
+				_, _ = fmt.Fprintf(entry, "failed to fetch logs: %v\n", err)
+				continue
+			}
+			if cut {
+				truncated = append(truncated, fmt.Sprintf("%s/%s", pod.Name, container.Name))
+				if _, err := fmt.Fprintf(entry, "[truncated: earlier lines omitted, only the last %d bytes are kept]\n", limit); err != nil {
+					return err
+				}
+			}
+
+			for _, line := range lines {
+				if _, err := fmt.Fprintf(entry, "%s %s\n", line.Timestamp.Format(time.RFC3339Nano), line.Message); err != nil {
+					return err
+				}
+			}
+			merged = append(merged, lines...)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Timestamp.Before(merged[j].Timestamp)
+	})
+	entry, err := zw.Create("merged.log")
+	if err != nil {
+		return err
+	}
+	for _, line := range merged {
+		if _, err := fmt.Fprintf(entry, "%s [%s/%s] %s\n",
+			line.Timestamp.Format(time.RFC3339Nano), line.Pod, line.Container, line.Message); err != nil {
+			return err
+		}
+	}
+
+	if len(truncated) > 0 {
+		entry, err := zw.Create(logArchiveTruncatedFile)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(entry, "Logs of these containers exceeded %d bytes; only their newest lines are included:\n%s\n",
+			limit, strings.Join(truncated, "\n")); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func fetchContainerLogs(ctx context.Context, client *K8sClient, pod corev1.Pod, opts *corev1.PodLogOptions, filter *LogFilter, limit int64) ([]LogLine, bool, error) {
+	stream, err := client.ClientSet.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	return readLogTail(stream, pod.Name, opts.Container, filter, limit)
+}
+
+// readLogTail parses the lines of r that match filter and keeps the newest
+// ones within limit bytes; it reports whether older lines were dropped
+func readLogTail(r io.Reader, pod, container string, filter *LogFilter, limit int64) ([]LogLine, bool, error) {
+	var lines []LogLine
+	var sizes []int64
+	var size int64
+	start := 0
+	truncated := false
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		raw := strings.TrimRight(scanner.Text(), "\r")
+		if raw == "" {
+			continue
+		}
+		line := ParseLogLine(raw)
+		line.Pod = pod
+		line.Container = container
+		if !filter.Match(line) {
+			continue
+		}
+
+		lines = append(lines, line)
+		sizes = append(sizes, int64(len(raw))+1)
+		size += sizes[len(sizes)-1]
+		for size > limit && start < len(lines) {
+			size -= sizes[start]
+			lines[start] = LogLine{}
+			start++
+			truncated = true
+		}
+		// Reclaim the dropped prefix once it dominates the buffer
+		if start > 1024 && start > len(lines)/2 {
+			lines = append(lines[:0], lines[start:]...)
+			sizes = append(sizes[:0], sizes[start:]...)
+			start = 0
+		}
+	}
+	return lines[start:], truncated, scanner.Err()
+}
@@ -0,0 +1,36 @@
+package kube
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLogTail_KeepsNewestLines(t *testing.T) {
+	input := strings.Join([]string{
+		"2024-01-01T00:00:01Z first",
+		"2024-01-01T00:00:02Z second",
+		"2024-01-01T00:00:03Z third",
+	}, "\n")
+
+	// Each line takes 28 bytes with its newline, so only the last two fit
+	lines, truncated, err := readLogTail(strings.NewReader(input), "web-0", "app", nil, 60)
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "second", lines[0].Message)
+	assert.Equal(t, "third", lines[1].Message)
+	assert.Equal(t, "web-0", lines[1].Pod)
+	assert.Equal(t, "app", lines[1].Container)
+}
+
+func TestReadLogTail_WithinLimit(t *testing.T) {
+	input := "2024-01-01T00:00:01Z first\n2024-01-01T00:00:02Z second\n"
+
+	lines, truncated, err := readLogTail(strings.NewReader(input), "web-0", "app", nil, DefaultLogArchiveBytes)
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Len(t, lines, 2)
+}
@@ -0,0 +1,157 @@
+package kube
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Normalized log levels
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// LogLine is a single parsed container log line
+type LogLine struct {
+	Pod       string
+	Container string
+	Timestamp time.Time // Kubelet timestamp, or receive time when absent
+	Message   string    // Line without the timestamp prefix
+	Level     string    // Detected level, empty when unknown
+
+	received time.Time
+}
+
+// ParseLogLine splits the RFC3339Nano prefix added by PodLogOptions.Timestamps
+// from the message and detects the level of the message
+func ParseLogLine(raw string) LogLine {
+	line := LogLine{Message: raw}
+	if ts, msg, ok := strings.Cut(raw, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			line.Timestamp = t
+			line.Message = msg
+		}
+	}
+	line.Level = DetectLogLevel(line.Message)
+	return line
+}
+
+var (
+	jsonLevelKeys = []string{"level", "lvl", "severity", "log.level", "levelname", "@l"}
+	textLevelRe   = regexp.MustCompile(`\b(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|ERR|FATAL|PANIC|CRITICAL)\b`)
+	klogLevelRe   = regexp.MustCompile(`^([IWEF])\d{4} `)
+)
+
+// DetectLogLevel returns the normalized level of a log message. JSON logs are
+// inspected for a level field (including numeric pino/bunyan levels); plain
+// text falls back to klog prefixes and upper-case level tokens.
+func DetectLogLevel(message string) string {
+	trimmed := strings.TrimSpace(message)
+	if strings.HasPrefix(trimmed, "{") {
+		var fields map[string]interface{}
+		if err := json.Unmarshal([]byte(trimmed), &fields); err == nil {
+			for _, key := range jsonLevelKeys {
+				if v, ok := fields[key]; ok {
+					if level := normalizeLogLevel(v); level != "" {
+						return level
+					}
+				}
+			}
+			return ""
+		}
+	}
+
+	if m := klogLevelRe.FindStringSubmatch(trimmed); m != nil {
+		return map[string]string{"I": LogLevelInfo, "W": LogLevelWarn, "E": LogLevelError, "F": LogLevelError}[m[1]]
+	}
+	if m := textLevelRe.FindString(trimmed); m != "" {
+		return normalizeLogLevel(m)
+	}
+	return ""
+}
+
+func normalizeLogLevel(v interface{}) string {
+	switch level := v.(type) {
+	case float64:
+		// pino / bunyan numeric levels
+		switch {
+		case level >= 50:
+			return LogLevelError
+		case level >= 40:
+			return LogLevelWarn
+		case level >= 30:
+			return LogLevelInfo
+		default:
+			return LogLevelDebug
+		}
+	case string:
+		switch strings.ToLower(level) {
+		case "trace", "debug", "dbg":
+			return LogLevelDebug
+		case "info", "information", "notice":
+			return LogLevelInfo
+		case "warn", "warning":
+			return LogLevelWarn
+		case "error", "err", "fatal", "panic", "critical", "crit", "alert", "emergency":
+			return LogLevelError
+		}
+	}
+	return ""
+}
+
+// LogFilter selects log lines by regular expressions and level
+type LogFilter struct {
+	include *regexp.Regexp
+	exclude *regexp.Regexp
+	levels  map[string]bool
+}
+
+// NewLogFilter compiles a filter. Empty arguments disable the respective check.
+// When levels are given, lines without a detectable level are dropped.
+func NewLogFilter(include, exclude string, levels []string) (*LogFilter, error) {
+	f := &LogFilter{}
+	var err error
+	if include != "" {
+		if f.include, err = regexp.Compile(include); err != nil {
+			return nil, fmt.Errorf("invalid include pattern: %w", err)
+		}
+	}
+	if exclude != "" {
+		if f.exclude, err = regexp.Compile(exclude); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern: %w", err)
+		}
+	}
+	for _, level := range levels {
+		level = normalizeLogLevel(strings.TrimSpace(level))
+		if level == "" {
+			continue
+		}
+		if f.levels == nil {
+			f.levels = make(map[string]bool)
+		}
+		f.levels[level] = true
+	}
+	return f, nil
+}
+
+// Match reports whether a line passes the filter; a nil filter matches everything
+func (f *LogFilter) Match(line LogLine) bool {
+	if f == nil {
+		return true
+	}
+	if f.levels != nil && !f.levels[line.Level] {
+		return false
+	}
+	if f.include != nil && !f.include.MatchString(line.Message) {
+		return false
+	}
+	if f.exclude != nil && f.exclude.MatchString(line.Message) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLine(t *testing.T) {
+	line := ParseLogLine(`2025-03-01T10:00:00.123456789Z {"level":"error","msg":"boom"}`)
+	assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 123456789, time.UTC), line.Timestamp)
+	assert.Equal(t, `{"level":"error","msg":"boom"}`, line.Message)
+	assert.Equal(t, LogLevelError, line.Level)
+
+	line = ParseLogLine("no timestamp here")
+	assert.True(t, line.Timestamp.IsZero())
+	assert.Equal(t, "no timestamp here", line.Message)
+}
+
+func TestDetectLogLevel(t *testing.T) {
+	tests := map[string]string{
+		`{"level":"WARN","msg":"slow"}`:          LogLevelWarn,
+		`{"severity":"INFO","message":"ok"}`:     LogLevelInfo,
+		`{"level":50,"msg":"pino error"}`:        LogLevelError,
+		`{"msg":"no level"}`:                     "",
+		"E0301 10:00:00.000000       1 main.go]": LogLevelError,
+		"2025/03/01 DEBUG cache miss":            LogLevelDebug,
+		"plain output":                           "",
+	}
+	for message, want := range tests {
+		assert.Equal(t, want, DetectLogLevel(message), message)
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	_, err := NewLogFilter("(", "", nil)
+	assert.Error(t, err)
+
+	filter, err := NewLogFilter("checkout", "healthz", []string{"error", "warning"})
+	require.NoError(t, err)
+
+	assert.True(t, filter.Match(ParseLogLine(`{"level":"error","msg":"checkout failed"}`)))
+	assert.False(t, filter.Match(ParseLogLine(`{"level":"info","msg":"checkout ok"}`)), "level not selected")
+	assert.False(t, filter.Match(ParseLogLine(`WARN checkout /healthz slow`)), "excluded")
+	assert.False(t, filter.Match(ParseLogLine(`checkout without level`)), "unknown level is dropped when levels are set")
+
+	var none *LogFilter
+	assert.True(t, none.Match(ParseLogLine("anything")))
+}
@@ -0,0 +1,74 @@
+package kube
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// newTestBatchLogHandler serves a BatchLogHandler over a websocket and hands
+// it to run; the client end is returned for reading the messages
+func newTestBatchLogHandler(t *testing.T, run func(l *BatchLogHandler)) *websocket.Conn {
+	done := make(chan struct{})
+	srv := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		// A long merge window means lines only leave on a notice or on stop
+		run(NewBatchLogHandlerWithOptions(conn, nil, &corev1.PodLogOptions{}, BatchLogOptions{MergeWindow: time.Hour}))
+		<-done
+	}))
+	t.Cleanup(func() {
+		close(done)
+		srv.Close()
+	})
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	conn, err := websocket.Dial(url, "", srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func receiveLogsMessages(t *testing.T, conn *websocket.Conn, n int) []LogsMessage {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	msgs := make([]LogsMessage, 0, n)
+	for len(msgs) < n {
+		var msg LogsMessage
+		require.NoError(t, websocket.JSON.Receive(conn, &msg))
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func queueTestLine(l *BatchLogHandler, ts time.Time, message string) {
+	l.events <- logEvent{line: LogLine{Timestamp: ts, Message: message, Pod: "web-0", received: time.Now()}}
+}
+
+func TestBatchLogHandler_NoticeFollowsBufferedLines(t *testing.T) {
+	now := time.Now()
+	conn := newTestBatchLogHandler(t, func(l *BatchLogHandler) {
+		queueTestLine(l, now.Add(time.Second), "second")
+		queueTestLine(l, now, "first")
+		l.notify("close", `{"status":"closed","pod":"web-0"}`)
+	})
+
+	msgs := receiveLogsMessages(t, conn, 3)
+	assert.Equal(t, LogsMessage{Type: "log", Data: "first"}, msgs[0])
+	assert.Equal(t, LogsMessage{Type: "log", Data: "second"}, msgs[1])
+	assert.Equal(t, "close", msgs[2].Type)
+}
+
+func TestBatchLogHandler_StopFlushesPendingLines(t *testing.T) {
+	conn := newTestBatchLogHandler(t, func(l *BatchLogHandler) {
+		queueTestLine(l, time.Now(), "last words")
+		l.Stop()
+	})
+
+	msgs := receiveLogsMessages(t, conn, 1)
+	assert.Equal(t, LogsMessage{Type: "log", Data: "last words"}, msgs[0])
+}
@@ -24,6 +24,13 @@ type TerminalMessage struct {
 	Cols uint16 `json:"cols,omitempty"`
 }
 
+// TerminalRecorder receives the traffic of a terminal session
+type TerminalRecorder interface {
+	RecordInput(data []byte)
+	RecordOutput(data []byte)
+	Resize(cols, rows uint16)
+}
+
 // TerminalSession manages a WebSocket connection for terminal communication
 type TerminalSession struct {
 	k8sClient *K8sClient
@@ -32,6 +39,7 @@ type TerminalSession struct {
 	namespace string
 	podName   string
 	container string
+	recorders []TerminalRecorder
 
 	lastHeartbeat time.Time // Track last heartbeat for ping/pong
 }
@@ -47,6 +55,12 @@ func NewTerminalSession(client *K8sClient, conn *websocket.Conn, namespace, podN
 	}
 }
 
+// AddRecorder passes the input, output and size changes of the session to
+// the recorder
+func (session *TerminalSession) AddRecorder(recorder TerminalRecorder) {
+	session.recorders = append(session.recorders, recorder)
+}
+
 func (session *TerminalSession) Start(ctx context.Context, subResource string) error {
 	req := session.k8sClient.ClientSet.CoreV1().RESTClient().Post().
 		Resource("pods").
@@ -87,7 +101,10 @@ func (session *TerminalSession) Start(ctx context.Context, subResource string) e
 	})
 
 	if err != nil {
-		session.SendErrorMessage(err.Error())
+		// A canceled session was closed on purpose and already told why
+		if ctx.Err() == nil {
+			session.SendErrorMessage(err.Error())
+		}
 		return err
 	}
 
@@ -111,9 +128,16 @@ func (session *TerminalSession) Read(p []byte) (int, error) {
 	switch msg.Type {
 	case "stdin":
 		data := []byte(msg.Data)
-		return copy(p, data), nil
+		n := copy(p, data)
+		for _, recorder := range session.recorders {
+			recorder.RecordInput(data[:n])
+		}
+		return n, nil
 	case "resize":
 		if msg.Rows > 0 && msg.Cols > 0 {
+			for _, recorder := range session.recorders {
+				recorder.Resize(msg.Cols, msg.Rows)
+			}
 			select {
 			case session.sizeChan <- &remotecommand.TerminalSize{
 				Width:  msg.Cols,
@@ -132,6 +156,9 @@ func (session *TerminalSession) Read(p []byte) (int, error) {
 }
 
 func (session *TerminalSession) Write(p []byte) (int, error) {
+	for _, recorder := range session.recorders {
+		recorder.RecordOutput(p)
+	}
 	msg := TerminalMessage{
 		Type: "stdout",
 		Data: string(p),
@@ -1,15 +1,22 @@
 package rbac
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/ysicing/tiga/internal/models"
 	"github.com/ysicing/tiga/pkg/common"
 )
 
+// ErrRoleAssignmentNotFound is returned when revoking a role the user does not hold
+var ErrRoleAssignmentNotFound = errors.New("role assignment not found")
+
 var (
 	RBACConfig *common.RolesConfig
 	once       sync.Once
@@ -18,17 +25,16 @@ var (
 )
 
 // InitRBAC initializes the RBAC system with database
-// Simplified: No longer loads roles from database, using is_admin flag instead
+// Base access is still decided by the is_admin flag; the only roles loaded
+// from the database are time-bound just-in-time grants (see loadRolesFromDB).
 func InitRBAC(db *gorm.DB) {
 	globalDB = db
 	once.Do(func() {
-		// Initialize empty config as RBAC system is simplified
 		RBACConfig = &common.RolesConfig{
 			Roles:       []common.Role{},
 			RoleMapping: []common.RoleMapping{},
 		}
 		logrus.Info("RBAC system initialized in simplified mode (using is_admin flag)")
-		// Start background sync goroutine (does nothing now but prevents panics if code sends to SyncNow)
 		go SyncRolesConfig()
 	})
 }
@@ -40,18 +46,75 @@ func InitDefaultRole(db *gorm.DB) error {
 	return nil
 }
 
-// loadRolesFromDB populates RBACConfig from DB rows
-// Simplified: No-op as role system is removed
+// jitPermission mirrors one entry of a JIT role's permissions column
+type jitPermission struct {
+	Resource   string   `json:"resource"`
+	Actions    []string `json:"actions"`
+	Clusters   []string `json:"clusters,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	HostGroups []string `json:"host_groups,omitempty"`
+}
+
+// jitBinding is a single unexpired user_roles row bound to a JIT role
+type jitBinding struct {
+	RoleName    string
+	Permissions string
+	Username    string
+}
+
+// loadRolesFromDB populates RBACConfig with the active just-in-time grants
 func loadRolesFromDB() error {
-	// Keep empty config
-	rwlock.Lock()
-	if RBACConfig == nil {
-		RBACConfig = &common.RolesConfig{
-			Roles:       []common.Role{},
-			RoleMapping: []common.RoleMapping{},
+	if globalDB == nil {
+		return nil
+	}
+
+	var bindings []jitBinding
+	err := globalDB.Table("user_roles").
+		Select("roles.name AS role_name, roles.permissions AS permissions, users.username AS username").
+		Joins("JOIN roles ON roles.id = user_roles.role_id AND roles.deleted_at IS NULL").
+		Joins("JOIN users ON users.id = user_roles.user_id AND users.deleted_at IS NULL").
+		Where("roles.name LIKE ?", models.JITRolePrefix+"%").
+		Where("user_roles.expires_at IS NOT NULL AND user_roles.expires_at > ?", time.Now()).
+		Scan(&bindings).Error
+	if err != nil {
+		return err
+	}
+
+	config := &common.RolesConfig{
+		Roles:       make([]common.Role, 0, len(bindings)),
+		RoleMapping: make([]common.RoleMapping, 0, len(bindings)),
+	}
+	for _, b := range bindings {
+		var perms []jitPermission
+		if err := json.Unmarshal([]byte(b.Permissions), &perms); err != nil {
+			logrus.Warnf("Skipping JIT role %s with invalid permissions: %v", b.RoleName, err)
+			continue
+		}
+		for i, p := range perms {
+			name := b.RoleName
+			if i > 0 {
+				name = fmt.Sprintf("%s#%d", b.RoleName, i)
+			}
+			config.Roles = append(config.Roles, common.Role{
+				Name:       name,
+				Clusters:   p.Clusters,
+				Resources:  []string{p.Resource},
+				Namespaces: p.Namespaces,
+				Verbs:      p.Actions,
+				HostGroups: p.HostGroups,
+			})
+			config.RoleMapping = append(config.RoleMapping, common.RoleMapping{
+				Name:  name,
+				Users: []string{b.Username},
+			})
 		}
 	}
+
+	rwlock.Lock()
+	RBACConfig = config
 	rwlock.Unlock()
+
+	logrus.Debugf("RBAC synced %d active JIT role bindings", len(config.RoleMapping))
 	return nil
 }
 
@@ -59,18 +122,34 @@ var (
 	SyncNow = make(chan struct{}, 1)
 )
 
+// TriggerSync asks the background loop to reload roles without blocking the caller
+func TriggerSync() {
+	select {
+	case SyncNow <- struct{}{}:
+	default:
+	}
+}
+
+// SyncRolesConfig keeps RBACConfig in step with the database.
+// Grants are reloaded every minute so expired bindings drop out even if the
+// sweeper has not yet revoked them, and immediately when SyncNow is signalled.
 func SyncRolesConfig() {
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
-	// Drain initial sync signal
-	<-SyncNow
+
+	sync := func() {
+		if err := loadRolesFromDB(); err != nil {
+			logrus.Errorf("Failed to sync RBAC roles: %v", err)
+		}
+	}
+
+	sync()
 	for {
 		select {
 		case <-ticker.C:
-			// No-op: simplified RBAC doesn't need sync
+			sync()
 		case <-SyncNow:
-			// No-op: simplified RBAC doesn't need sync
-			// But we drain the channel to prevent blocking senders
+			sync()
 		}
 	}
 }
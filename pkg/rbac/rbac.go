@@ -13,6 +13,7 @@ import (
 
 // CanAccess checks if user can access resource with verb in cluster/namespace
 // Simplified: Admin users have full access, regular users have read-only access
+// plus whatever their active just-in-time grants allow
 func CanAccess(user models.User, resource, verb, cluster, namespace string) bool {
 	// Admin users have full access
 	if user.IsAdmin {
@@ -31,9 +32,57 @@ func CanAccess(user models.User, resource, verb, cluster, namespace string) bool
 		}
 	}
 
+	// Non-read verbs are only allowed through an active just-in-time grant
+	allowed := isReadVerb
+	if !allowed {
+		for _, role := range grantedRoles(user) {
+			if match(role.Resources, resource) && match(role.Verbs, verb) &&
+				match(role.Clusters, cluster) && matchNamespace(role.Namespaces, namespace) {
+				allowed = true
+				break
+			}
+		}
+	}
+
 	logrus.Debugf("RBAC Check - User: %s (Regular), Resource: %s, Verb: %s, Cluster: %s, Namespace: %s, Access: %t",
-		user.Key(), resource, verb, cluster, namespace, isReadVerb)
-	return isReadVerb
+		user.Key(), resource, verb, cluster, namespace, allowed)
+	return allowed
+}
+
+// CanAccessHostGroup checks if user can perform verb (e.g. "webssh") on hosts of a group
+// Admin users always can; regular users need an active just-in-time grant for the group.
+func CanAccessHostGroup(user models.User, group, verb string) bool {
+	if user.IsAdmin {
+		return true
+	}
+	for _, role := range grantedRoles(user) {
+		if match(role.Resources, "hosts") && match(role.Verbs, verb) && match(role.HostGroups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// grantedRoles returns the synced roles mapped to the user
+func grantedRoles(user models.User) []common.Role {
+	rwlock.RLock()
+	defer rwlock.RUnlock()
+	if RBACConfig == nil {
+		return nil
+	}
+
+	var roles []common.Role
+	for _, mapping := range RBACConfig.RoleMapping {
+		if !contains(mapping.Users, user.Username) {
+			continue
+		}
+		for _, r := range RBACConfig.Roles {
+			if r.Name == mapping.Name {
+				roles = append(roles, r)
+			}
+		}
+	}
+	return roles
 }
 
 func CanAccessCluster(user models.User, name string) bool {
@@ -80,6 +129,16 @@ func match(list []string, val string) bool {
 	return false
 }
 
+// matchNamespace reports whether a grant's namespaces cover namespace.
+// Cluster-scoped checks (empty namespace) are only covered by grants
+// without namespaces; namespaced grants only cover their own namespaces.
+func matchNamespace(list []string, namespace string) bool {
+	if namespace == "" {
+		return len(list) == 0
+	}
+	return match(list, namespace)
+}
+
 func contains(list []string, val string) bool {
 	return slices.Contains(list, val)
 }
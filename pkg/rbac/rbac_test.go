@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ysicing/tiga/internal/models"
+	"github.com/ysicing/tiga/pkg/common"
 )
 
 func TestCanAccess(t *testing.T) {
@@ -168,3 +169,64 @@ func TestUserHasRole(t *testing.T) {
 		})
 	}
 }
+
+func TestJITGrants(t *testing.T) {
+	rwlock.Lock()
+	previous := RBACConfig
+	RBACConfig = &common.RolesConfig{
+		Roles: []common.Role{
+			{Name: "jit:exec", Clusters: []string{"prod"}, Namespaces: []string{"payments"}, Resources: []string{"pods"}, Verbs: []string{"exec"}},
+			{Name: "jit:ssh", Resources: []string{"hosts"}, Verbs: []string{"webssh"}, HostGroups: []string{"db"}},
+			{Name: "jit:all-ns", Clusters: []string{"prod"}, Namespaces: []string{"*"}, Resources: []string{"pods"}, Verbs: []string{"delete"}},
+			{Name: "jit:cluster", Clusters: []string{"prod"}, Resources: []string{"nodes"}, Verbs: []string{"update"}},
+		},
+		RoleMapping: []common.RoleMapping{
+			{Name: "jit:exec", Users: []string{"alice"}},
+			{Name: "jit:ssh", Users: []string{"alice"}},
+			{Name: "jit:all-ns", Users: []string{"carol"}},
+			{Name: "jit:cluster", Users: []string{"carol"}},
+		},
+	}
+	rwlock.Unlock()
+	defer func() {
+		rwlock.Lock()
+		RBACConfig = previous
+		rwlock.Unlock()
+	}()
+
+	alice := models.User{Username: "alice"}
+	bob := models.User{Username: "bob"}
+
+	if !CanAccess(alice, "pods", "exec", "prod", "payments") {
+		t.Error("Expected granted user to exec in the granted namespace")
+	}
+	if CanAccess(alice, "pods", "exec", "prod", "default") {
+		t.Error("Expected exec outside the granted namespace to be denied")
+	}
+	if CanAccess(bob, "pods", "exec", "prod", "payments") {
+		t.Error("Expected exec without a grant to be denied")
+	}
+	if CanAccess(alice, "pods", "exec", "prod", "") {
+		t.Error("Expected a namespaced grant not to cover cluster-scoped checks")
+	}
+
+	carol := models.User{Username: "carol"}
+	if !CanAccess(carol, "pods", "delete", "prod", "default") {
+		t.Error("Expected an all-namespace grant to cover any namespace")
+	}
+	if CanAccess(carol, "pods", "delete", "prod", "") {
+		t.Error("Expected an all-namespace grant not to cover cluster-scoped checks")
+	}
+	if !CanAccess(carol, "nodes", "update", "prod", "") {
+		t.Error("Expected a grant without namespaces to cover cluster-scoped checks")
+	}
+	if !CanAccessHostGroup(alice, "db", "webssh") {
+		t.Error("Expected granted user to open WebSSH in the granted host group")
+	}
+	if CanAccessHostGroup(alice, "web", "webssh") {
+		t.Error("Expected WebSSH outside the granted host group to be denied")
+	}
+	if !CanAccessHostGroup(models.User{Username: "root", IsAdmin: true}, "web", "webssh") {
+		t.Error("Expected admin to open WebSSH in any host group")
+	}
+}
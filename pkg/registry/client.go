@@ -0,0 +1,250 @@
+// Package registry talks to container registries over the Docker Registry
+// HTTP API V2, authenticating with basic auth or bearer tokens.
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DockerHub is the canonical name of Docker Hub
+	DockerHub = "docker.io"
+	// dockerHubAPI serves the registry API of Docker Hub
+	dockerHubAPI = "registry-1.docker.io"
+)
+
+// ErrUnauthorized is returned when the registry rejects the credentials
+var ErrUnauthorized = errors.New("registry rejected the credentials")
+
+// NormalizeHost returns the canonical name of a registry host, mapping the
+// aliases of Docker Hub to docker.io
+func NormalizeHost(host string) string {
+	host = strings.TrimSpace(strings.ToLower(host))
+	host = strings.TrimPrefix(host, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	host = strings.TrimSuffix(host, "/")
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	switch host {
+	case "", "index.docker.io", dockerHubAPI, "hub.docker.com":
+		return DockerHub
+	}
+	return host
+}
+
+// EncodeAuth encodes credentials as the X-Registry-Auth value expected by
+// the Docker Engine API
+func EncodeAuth(host, username, password string) (string, error) {
+	data, err := json.Marshal(map[string]string{
+		"username":      username,
+		"password":      password,
+		"serveraddress": NormalizeHost(host),
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+// Client is a Registry API V2 client for one registry
+type Client struct {
+	host     string
+	username string
+	password string
+	http     *http.Client
+
+	token string
+}
+
+// NewClient creates a client for a registry host. Empty credentials access
+// the registry anonymously. A nil httpClient uses a client with a 10 second
+// timeout.
+func NewClient(host, username, password string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	host = NormalizeHost(host)
+	if host == DockerHub {
+		host = dockerHubAPI
+	}
+	return &Client{host: host, username: username, password: password, http: httpClient}
+}
+
+// Ping checks that the registry accepts the credentials
+func (c *Client) Ping(ctx context.Context) error {
+	resp, err := c.get(ctx, "/v2/", "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry %s returned %s", c.host, resp.Status)
+	}
+	return nil
+}
+
+// ListTags lists the tags of a repository, e.g. library/nginx
+func (c *Client) ListTags(ctx context.Context, repo string) ([]string, error) {
+	resp, err := c.get(ctx, "/v2/"+repo+"/tags/list", "repository:"+repo+":pull")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, ErrUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("registry %s returned %s", c.host, resp.Status)
+	}
+
+	var data struct {
+		Tags []string `json:"tags"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode tags: %w", err)
+	}
+	return data.Tags, nil
+}
+
+// get sends a GET request, answering an authentication challenge once
+func (c *Client) get(ctx context.Context, path, scope string) (*http.Response, error) {
+	resp, err := c.do(ctx, path)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if c.username == "" {
+			return nil, ErrUnauthorized
+		}
+		c.token = ""
+	case "bearer":
+		if err := c.fetchToken(ctx, params, scope); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("registry %s sent an unsupported challenge %q", c.host, challenge)
+	}
+	return c.do(ctx, path)
+}
+
+func (c *Client) do(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+c.host+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach registry %s: %w", c.host, err)
+	}
+	return resp, nil
+}
+
+// fetchToken gets a bearer token from the realm of a challenge
+func (c *Client) fetchToken(ctx context.Context, params map[string]string, scope string) error {
+	realm := params["realm"]
+	if realm == "" {
+		return fmt.Errorf("registry %s sent a bearer challenge without realm", c.host)
+	}
+	u, err := url.Parse(realm)
+	if err != nil {
+		return fmt.Errorf("invalid token realm %q: %w", realm, err)
+	}
+	query := u.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if scope == "" {
+		scope = params["scope"]
+	}
+	if scope != "" {
+		query.Set("scope", scope)
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create token request: %w", err)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach token service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return ErrUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("token service returned %s", resp.Status)
+	}
+
+	var data struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return fmt.Errorf("failed to decode token: %w", err)
+	}
+	c.token = data.Token
+	if c.token == "" {
+		c.token = data.AccessToken
+	}
+	if c.token == "" {
+		return fmt.Errorf("token service returned no token")
+	}
+	return nil
+}
+
+// parseChallenge splits a WWW-Authenticate header into its scheme and
+// parameters, e.g. Bearer realm="https://auth.docker.io/token",service="registry.docker.io"
+func parseChallenge(header string) (string, map[string]string) {
+	params := make(map[string]string)
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.TrimSpace(key); key != "" {
+			params[strings.ToLower(key)] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
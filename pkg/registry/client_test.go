@@ -0,0 +1,99 @@
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenRegistry(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "alice" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, "test-registry", r.URL.Query().Get("service"))
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": "t-" + r.URL.Query().Get("scope")})
+		default:
+			// Tokens are only valid for the scope they were issued for
+			scope := ""
+			if r.URL.Path == "/v2/team/app/tags/list" {
+				scope = "repository:team/app:pull"
+			}
+			if r.Header.Get("Authorization") != "Bearer t-"+scope {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test-registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if scope != "" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"tags": []string{"v1", "v2"}})
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_BearerAuth(t *testing.T) {
+	server := newTokenRegistry(t)
+	host := strings.TrimPrefix(server.URL, "https://")
+	ctx := context.Background()
+
+	client := NewClient(host, "alice", "secret", server.Client())
+	require.NoError(t, client.Ping(ctx))
+
+	tags, err := client.ListTags(ctx, "team/app")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, tags)
+
+	err = NewClient(host, "alice", "wrong", server.Client()).Ping(ctx)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestClient_BasicAuth(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "bob" || password != "pw" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	require.NoError(t, NewClient(host, "bob", "pw", server.Client()).Ping(context.Background()))
+	assert.ErrorIs(t, NewClient(host, "", "", server.Client()).Ping(context.Background()), ErrUnauthorized)
+}
+
+func TestNormalizeHost(t *testing.T) {
+	assert.Equal(t, DockerHub, NormalizeHost(""))
+	assert.Equal(t, DockerHub, NormalizeHost("https://index.docker.io/v1/"))
+	assert.Equal(t, DockerHub, NormalizeHost("registry-1.docker.io"))
+	assert.Equal(t, "ghcr.io", NormalizeHost("GHCR.io/"))
+	assert.Equal(t, "registry.local:5000", NormalizeHost("http://registry.local:5000/v2"))
+}
+
+func TestEncodeAuth(t *testing.T) {
+	encoded, err := EncodeAuth("https://ghcr.io", "alice", "secret")
+	require.NoError(t, err)
+
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	var auth map[string]string
+	require.NoError(t, json.Unmarshal(data, &auth))
+	assert.Equal(t, map[string]string{"username": "alice", "password": "secret", "serveraddress": "ghcr.io"}, auth)
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.0
-// 	protoc        v3.19.4
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
 // source: proto/host_monitor.proto
 
 package proto
@@ -9,6 +9,7 @@ package proto
 import (
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,34 +24,31 @@ const (
 
 // 主机硬件信息
 type HostInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Platform        string      `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`                                      // 操作系统平台(linux/windows/darwin)
-	PlatformVersion string      `protobuf:"bytes,2,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"` // 平台版本(Ubuntu 22.04)
-	Arch            string      `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`                                              // CPU架构(amd64/arm64)
-	Virtualization  string      `protobuf:"bytes,4,opt,name=virtualization,proto3" json:"virtualization,omitempty"`                          // 虚拟化类型(kvm/vmware/none)
-	CpuModel        string      `protobuf:"bytes,5,opt,name=cpu_model,json=cpuModel,proto3" json:"cpu_model,omitempty"`                      // CPU型号
-	CpuCores        int32       `protobuf:"varint,6,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`                     // CPU核心数
-	MemTotal        uint64      `protobuf:"varint,7,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`                     // 总内存(字节)
-	DiskTotal       uint64      `protobuf:"varint,8,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`                  // 总磁盘(字节)
-	SwapTotal       uint64      `protobuf:"varint,9,opt,name=swap_total,json=swapTotal,proto3" json:"swap_total,omitempty"`                  // 总交换分区(字节)
-	AgentVersion    string      `protobuf:"bytes,10,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`         // Agent版本
-	BootTime        int64       `protobuf:"varint,11,opt,name=boot_time,json=bootTime,proto3" json:"boot_time,omitempty"`                    // 启动时间(Unix时间戳)
-	SshEnabled      bool        `protobuf:"varint,12,opt,name=ssh_enabled,json=sshEnabled,proto3" json:"ssh_enabled,omitempty"`              // SSH是否启用
-	SshPort         int32       `protobuf:"varint,13,opt,name=ssh_port,json=sshPort,proto3" json:"ssh_port,omitempty"`                       // SSH端口
-	SshUser         string      `protobuf:"bytes,14,opt,name=ssh_user,json=sshUser,proto3" json:"ssh_user,omitempty"`                        // SSH默认用户
-	DockerInfo      *DockerInfo `protobuf:"bytes,15,opt,name=docker_info,json=dockerInfo,proto3" json:"docker_info,omitempty"`               // Docker信息(如果安装)
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Platform        string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`                                      // 操作系统平台(linux/windows/darwin)
+	PlatformVersion string                 `protobuf:"bytes,2,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"` // 平台版本(Ubuntu 22.04)
+	Arch            string                 `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`                                              // CPU架构(amd64/arm64)
+	Virtualization  string                 `protobuf:"bytes,4,opt,name=virtualization,proto3" json:"virtualization,omitempty"`                          // 虚拟化类型(kvm/vmware/none)
+	CpuModel        string                 `protobuf:"bytes,5,opt,name=cpu_model,json=cpuModel,proto3" json:"cpu_model,omitempty"`                      // CPU型号
+	CpuCores        int32                  `protobuf:"varint,6,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`                     // CPU核心数
+	MemTotal        uint64                 `protobuf:"varint,7,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`                     // 总内存(字节)
+	DiskTotal       uint64                 `protobuf:"varint,8,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`                  // 总磁盘(字节)
+	SwapTotal       uint64                 `protobuf:"varint,9,opt,name=swap_total,json=swapTotal,proto3" json:"swap_total,omitempty"`                  // 总交换分区(字节)
+	AgentVersion    string                 `protobuf:"bytes,10,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`         // Agent版本
+	BootTime        int64                  `protobuf:"varint,11,opt,name=boot_time,json=bootTime,proto3" json:"boot_time,omitempty"`                    // 启动时间(Unix时间戳)
+	SshEnabled      bool                   `protobuf:"varint,12,opt,name=ssh_enabled,json=sshEnabled,proto3" json:"ssh_enabled,omitempty"`              // SSH是否启用
+	SshPort         int32                  `protobuf:"varint,13,opt,name=ssh_port,json=sshPort,proto3" json:"ssh_port,omitempty"`                       // SSH端口
+	SshUser         string                 `protobuf:"bytes,14,opt,name=ssh_user,json=sshUser,proto3" json:"ssh_user,omitempty"`                        // SSH默认用户
+	DockerInfo      *DockerInfo            `protobuf:"bytes,15,opt,name=docker_info,json=dockerInfo,proto3" json:"docker_info,omitempty"`               // Docker信息(如果安装)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *HostInfo) Reset() {
 	*x = HostInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HostInfo) String() string {
@@ -61,7 +59,7 @@ func (*HostInfo) ProtoMessage() {}
 
 func (x *HostInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_host_monitor_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -183,33 +181,30 @@ func (x *HostInfo) GetDockerInfo() *DockerInfo {
 
 // Docker信息
 type DockerInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Installed         bool   `protobuf:"varint,1,opt,name=installed,proto3" json:"installed,omitempty"`                                           // 是否安装Docker
-	Version           string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                                                // Docker版本
-	ApiVersion        string `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`                        // API版本
-	Os                string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`                                                          // 操作系统
-	Arch              string `protobuf:"bytes,5,opt,name=arch,proto3" json:"arch,omitempty"`                                                      // 架构
-	KernelVersion     string `protobuf:"bytes,6,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`               // 内核版本
-	StorageDriver     string `protobuf:"bytes,7,opt,name=storage_driver,json=storageDriver,proto3" json:"storage_driver,omitempty"`               // 存储驱动
-	Containers        int32  `protobuf:"varint,8,opt,name=containers,proto3" json:"containers,omitempty"`                                         // 容器总数
-	ContainersRunning int32  `protobuf:"varint,9,opt,name=containers_running,json=containersRunning,proto3" json:"containers_running,omitempty"`  // 运行中容器数
-	ContainersPaused  int32  `protobuf:"varint,10,opt,name=containers_paused,json=containersPaused,proto3" json:"containers_paused,omitempty"`    // 暂停容器数
-	ContainersStopped int32  `protobuf:"varint,11,opt,name=containers_stopped,json=containersStopped,proto3" json:"containers_stopped,omitempty"` // 停止容器数
-	Images            int32  `protobuf:"varint,12,opt,name=images,proto3" json:"images,omitempty"`                                                // 镜像数
-	MemTotal          uint64 `protobuf:"varint,13,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`                            // Docker主机总内存
-	Ncpu              int32  `protobuf:"varint,14,opt,name=ncpu,proto3" json:"ncpu,omitempty"`                                                    // CPU数量
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Installed         bool                   `protobuf:"varint,1,opt,name=installed,proto3" json:"installed,omitempty"`                                           // 是否安装Docker
+	Version           string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                                                // Docker版本
+	ApiVersion        string                 `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`                        // API版本
+	Os                string                 `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`                                                          // 操作系统
+	Arch              string                 `protobuf:"bytes,5,opt,name=arch,proto3" json:"arch,omitempty"`                                                      // 架构
+	KernelVersion     string                 `protobuf:"bytes,6,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`               // 内核版本
+	StorageDriver     string                 `protobuf:"bytes,7,opt,name=storage_driver,json=storageDriver,proto3" json:"storage_driver,omitempty"`               // 存储驱动
+	Containers        int32                  `protobuf:"varint,8,opt,name=containers,proto3" json:"containers,omitempty"`                                         // 容器总数
+	ContainersRunning int32                  `protobuf:"varint,9,opt,name=containers_running,json=containersRunning,proto3" json:"containers_running,omitempty"`  // 运行中容器数
+	ContainersPaused  int32                  `protobuf:"varint,10,opt,name=containers_paused,json=containersPaused,proto3" json:"containers_paused,omitempty"`    // 暂停容器数
+	ContainersStopped int32                  `protobuf:"varint,11,opt,name=containers_stopped,json=containersStopped,proto3" json:"containers_stopped,omitempty"` // 停止容器数
+	Images            int32                  `protobuf:"varint,12,opt,name=images,proto3" json:"images,omitempty"`                                                // 镜像数
+	MemTotal          uint64                 `protobuf:"varint,13,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`                            // Docker主机总内存
+	Ncpu              int32                  `protobuf:"varint,14,opt,name=ncpu,proto3" json:"ncpu,omitempty"`                                                    // CPU数量
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *DockerInfo) Reset() {
 	*x = DockerInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerInfo) String() string {
@@ -220,7 +215,7 @@ func (*DockerInfo) ProtoMessage() {}
 
 func (x *DockerInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_host_monitor_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -335,44 +330,45 @@ func (x *DockerInfo) GetNcpu() int32 {
 
 // 主机实时监控状态
 type HostState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Timestamp        int64          `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                          // 时间戳(Unix毫秒)
-	CpuUsage         float64        `protobuf:"fixed64,2,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`                           // CPU使用率(%)
-	Load_1           float64        `protobuf:"fixed64,3,opt,name=load_1,json=load1,proto3" json:"load_1,omitempty"`                                    // 1分钟负载
-	Load_5           float64        `protobuf:"fixed64,4,opt,name=load_5,json=load5,proto3" json:"load_5,omitempty"`                                    // 5分钟负载
-	Load_15          float64        `protobuf:"fixed64,5,opt,name=load_15,json=load15,proto3" json:"load_15,omitempty"`                                 // 15分钟负载
-	MemUsed          uint64         `protobuf:"varint,6,opt,name=mem_used,json=memUsed,proto3" json:"mem_used,omitempty"`                               // 已用内存(字节)
-	MemUsage         float64        `protobuf:"fixed64,7,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`                           // 内存使用率(%)
-	SwapUsed         uint64         `protobuf:"varint,8,opt,name=swap_used,json=swapUsed,proto3" json:"swap_used,omitempty"`                            // 已用交换分区(字节)
-	DiskUsed         uint64         `protobuf:"varint,9,opt,name=disk_used,json=diskUsed,proto3" json:"disk_used,omitempty"`                            // 已用磁盘(字节)
-	DiskUsage        float64        `protobuf:"fixed64,10,opt,name=disk_usage,json=diskUsage,proto3" json:"disk_usage,omitempty"`                       // 磁盘使用率(%)
-	NetInTransfer    uint64         `protobuf:"varint,11,opt,name=net_in_transfer,json=netInTransfer,proto3" json:"net_in_transfer,omitempty"`          // 总入站流量(字节)
-	NetOutTransfer   uint64         `protobuf:"varint,12,opt,name=net_out_transfer,json=netOutTransfer,proto3" json:"net_out_transfer,omitempty"`       // 总出站流量(字节)
-	NetInSpeed       uint64         `protobuf:"varint,13,opt,name=net_in_speed,json=netInSpeed,proto3" json:"net_in_speed,omitempty"`                   // 入站速率(字节/秒)
-	NetOutSpeed      uint64         `protobuf:"varint,14,opt,name=net_out_speed,json=netOutSpeed,proto3" json:"net_out_speed,omitempty"`                // 出站速率(字节/秒)
-	TcpConnCount     int32          `protobuf:"varint,15,opt,name=tcp_conn_count,json=tcpConnCount,proto3" json:"tcp_conn_count,omitempty"`             // TCP连接数
-	UdpConnCount     int32          `protobuf:"varint,16,opt,name=udp_conn_count,json=udpConnCount,proto3" json:"udp_conn_count,omitempty"`             // UDP连接数
-	ProcessCount     int32          `protobuf:"varint,17,opt,name=process_count,json=processCount,proto3" json:"process_count,omitempty"`               // 进程数
-	Uptime           int64          `protobuf:"varint,18,opt,name=uptime,proto3" json:"uptime,omitempty"`                                               // 运行时间(秒)
-	Temperatures     []*Temperature `protobuf:"bytes,19,rep,name=temperatures,proto3" json:"temperatures,omitempty"`                                    // 温度传感器
-	GpuUsage         float64        `protobuf:"fixed64,20,opt,name=gpu_usage,json=gpuUsage,proto3" json:"gpu_usage,omitempty"`                          // GPU使用率(%)
-	TrafficSent      uint64         `protobuf:"varint,21,opt,name=traffic_sent,json=trafficSent,proto3" json:"traffic_sent,omitempty"`                  // 总发送流量(字节)
-	TrafficRecv      uint64         `protobuf:"varint,22,opt,name=traffic_recv,json=trafficRecv,proto3" json:"traffic_recv,omitempty"`                  // 总接收流量(字节)
-	TrafficDeltaSent uint64         `protobuf:"varint,23,opt,name=traffic_delta_sent,json=trafficDeltaSent,proto3" json:"traffic_delta_sent,omitempty"` // 增量发送流量(字节)
-	TrafficDeltaRecv uint64         `protobuf:"varint,24,opt,name=traffic_delta_recv,json=trafficDeltaRecv,proto3" json:"traffic_delta_recv,omitempty"` // 增量接收流量(字节)
-	VersionInfo      *VersionInfo   `protobuf:"bytes,25,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`                   // Agent版本信息(可选)
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp        int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                          // 时间戳(Unix毫秒)
+	CpuUsage         float64                `protobuf:"fixed64,2,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`                           // CPU使用率(%)
+	Load_1           float64                `protobuf:"fixed64,3,opt,name=load_1,json=load1,proto3" json:"load_1,omitempty"`                                    // 1分钟负载
+	Load_5           float64                `protobuf:"fixed64,4,opt,name=load_5,json=load5,proto3" json:"load_5,omitempty"`                                    // 5分钟负载
+	Load_15          float64                `protobuf:"fixed64,5,opt,name=load_15,json=load15,proto3" json:"load_15,omitempty"`                                 // 15分钟负载
+	MemUsed          uint64                 `protobuf:"varint,6,opt,name=mem_used,json=memUsed,proto3" json:"mem_used,omitempty"`                               // 已用内存(字节)
+	MemUsage         float64                `protobuf:"fixed64,7,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`                           // 内存使用率(%)
+	SwapUsed         uint64                 `protobuf:"varint,8,opt,name=swap_used,json=swapUsed,proto3" json:"swap_used,omitempty"`                            // 已用交换分区(字节)
+	DiskUsed         uint64                 `protobuf:"varint,9,opt,name=disk_used,json=diskUsed,proto3" json:"disk_used,omitempty"`                            // 已用磁盘(字节)
+	DiskUsage        float64                `protobuf:"fixed64,10,opt,name=disk_usage,json=diskUsage,proto3" json:"disk_usage,omitempty"`                       // 磁盘使用率(%)
+	NetInTransfer    uint64                 `protobuf:"varint,11,opt,name=net_in_transfer,json=netInTransfer,proto3" json:"net_in_transfer,omitempty"`          // 总入站流量(字节)
+	NetOutTransfer   uint64                 `protobuf:"varint,12,opt,name=net_out_transfer,json=netOutTransfer,proto3" json:"net_out_transfer,omitempty"`       // 总出站流量(字节)
+	NetInSpeed       uint64                 `protobuf:"varint,13,opt,name=net_in_speed,json=netInSpeed,proto3" json:"net_in_speed,omitempty"`                   // 入站速率(字节/秒)
+	NetOutSpeed      uint64                 `protobuf:"varint,14,opt,name=net_out_speed,json=netOutSpeed,proto3" json:"net_out_speed,omitempty"`                // 出站速率(字节/秒)
+	TcpConnCount     int32                  `protobuf:"varint,15,opt,name=tcp_conn_count,json=tcpConnCount,proto3" json:"tcp_conn_count,omitempty"`             // TCP连接数
+	UdpConnCount     int32                  `protobuf:"varint,16,opt,name=udp_conn_count,json=udpConnCount,proto3" json:"udp_conn_count,omitempty"`             // UDP连接数
+	ProcessCount     int32                  `protobuf:"varint,17,opt,name=process_count,json=processCount,proto3" json:"process_count,omitempty"`               // 进程数
+	Uptime           int64                  `protobuf:"varint,18,opt,name=uptime,proto3" json:"uptime,omitempty"`                                               // 运行时间(秒)
+	Temperatures     []*Temperature         `protobuf:"bytes,19,rep,name=temperatures,proto3" json:"temperatures,omitempty"`                                    // 温度传感器
+	GpuUsage         float64                `protobuf:"fixed64,20,opt,name=gpu_usage,json=gpuUsage,proto3" json:"gpu_usage,omitempty"`                          // GPU使用率(%)
+	TrafficSent      uint64                 `protobuf:"varint,21,opt,name=traffic_sent,json=trafficSent,proto3" json:"traffic_sent,omitempty"`                  // 总发送流量(字节)
+	TrafficRecv      uint64                 `protobuf:"varint,22,opt,name=traffic_recv,json=trafficRecv,proto3" json:"traffic_recv,omitempty"`                  // 总接收流量(字节)
+	TrafficDeltaSent uint64                 `protobuf:"varint,23,opt,name=traffic_delta_sent,json=trafficDeltaSent,proto3" json:"traffic_delta_sent,omitempty"` // 增量发送流量(字节)
+	TrafficDeltaRecv uint64                 `protobuf:"varint,24,opt,name=traffic_delta_recv,json=trafficDeltaRecv,proto3" json:"traffic_delta_recv,omitempty"` // 增量接收流量(字节)
+	VersionInfo      *VersionInfo           `protobuf:"bytes,25,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`                   // Agent版本信息(可选)
+	Disks            []*DiskPartitionState  `protobuf:"bytes,26,rep,name=disks,proto3" json:"disks,omitempty"`                                                  // 各分区使用情况
+	NetInterfaces    []*NetInterfaceState   `protobuf:"bytes,27,rep,name=net_interfaces,json=netInterfaces,proto3" json:"net_interfaces,omitempty"`             // 各网卡流量
+	TopProcesses     []*ProcessState        `protobuf:"bytes,28,rep,name=top_processes,json=topProcesses,proto3" json:"top_processes,omitempty"`                // 资源占用最高的进程(可选)
+	CustomMetrics    []*CustomMetric        `protobuf:"bytes,29,rep,name=custom_metrics,json=customMetrics,proto3" json:"custom_metrics,omitempty"`             // 插件自定义指标(可选)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *HostState) Reset() {
 	*x = HostState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HostState) String() string {
@@ -383,7 +379,7 @@ func (*HostState) ProtoMessage() {}
 
 func (x *HostState) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_host_monitor_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -573,24 +569,413 @@ func (x *HostState) GetVersionInfo() *VersionInfo {
 	return nil
 }
 
-// Agent版本信息
-type VersionInfo struct {
-	state         protoimpl.MessageState
+func (x *HostState) GetDisks() []*DiskPartitionState {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *HostState) GetNetInterfaces() []*NetInterfaceState {
+	if x != nil {
+		return x.NetInterfaces
+	}
+	return nil
+}
+
+func (x *HostState) GetTopProcesses() []*ProcessState {
+	if x != nil {
+		return x.TopProcesses
+	}
+	return nil
+}
+
+func (x *HostState) GetCustomMetrics() []*CustomMetric {
+	if x != nil {
+		return x.CustomMetrics
+	}
+	return nil
+}
+
+// 插件自定义指标(Gauge)
+type CustomMetric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                               // 指标名
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 标签
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`                                                                           // 值
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomMetric) Reset() {
+	*x = CustomMetric{}
+	mi := &file_proto_host_monitor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomMetric) ProtoMessage() {}
+
+func (x *CustomMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomMetric.ProtoReflect.Descriptor instead.
+func (*CustomMetric) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CustomMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomMetric) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CustomMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// 分区使用情况
+type DiskPartitionState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MountPoint    string                 `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`      // 挂载点
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`                                // 设备
+	Fstype        string                 `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`                                // 文件系统类型
+	Total         uint64                 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                                 // 总容量(字节)
+	Used          uint64                 `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`                                   // 已用容量(字节)
+	Usage         float64                `protobuf:"fixed64,6,opt,name=usage,proto3" json:"usage,omitempty"`                                // 使用率(%)
+	InodesTotal   uint64                 `protobuf:"varint,7,opt,name=inodes_total,json=inodesTotal,proto3" json:"inodes_total,omitempty"`  // inode总数
+	InodesUsed    uint64                 `protobuf:"varint,8,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`     // 已用inode数
+	InodesUsage   float64                `protobuf:"fixed64,9,opt,name=inodes_usage,json=inodesUsage,proto3" json:"inodes_usage,omitempty"` // inode使用率(%)
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Version   string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // 版本号(v1.2.3-a1b2c3d)
-	BuildTime string `protobuf:"bytes,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // 构建时间(RFC3339格式)
-	CommitId  string `protobuf:"bytes,3,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`    // 提交哈希(7位短哈希)
+func (x *DiskPartitionState) Reset() {
+	*x = DiskPartitionState{}
+	mi := &file_proto_host_monitor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionInfo) Reset() {
-	*x = VersionInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[3]
+func (x *DiskPartitionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskPartitionState) ProtoMessage() {}
+
+func (x *DiskPartitionState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[4]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskPartitionState.ProtoReflect.Descriptor instead.
+func (*DiskPartitionState) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiskPartitionState) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *DiskPartitionState) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskPartitionState) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *DiskPartitionState) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskPartitionState) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskPartitionState) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *DiskPartitionState) GetInodesTotal() uint64 {
+	if x != nil {
+		return x.InodesTotal
+	}
+	return 0
+}
+
+func (x *DiskPartitionState) GetInodesUsed() uint64 {
+	if x != nil {
+		return x.InodesUsed
+	}
+	return 0
+}
+
+func (x *DiskPartitionState) GetInodesUsage() float64 {
+	if x != nil {
+		return x.InodesUsage
+	}
+	return 0
+}
+
+// 网卡流量
+type NetInterfaceState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                          // 网卡名称
+	RxBytes       uint64                 `protobuf:"varint,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`    // 总接收(字节)
+	TxBytes       uint64                 `protobuf:"varint,3,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`    // 总发送(字节)
+	RxSpeed       uint64                 `protobuf:"varint,4,opt,name=rx_speed,json=rxSpeed,proto3" json:"rx_speed,omitempty"`    // 接收速率(字节/秒)
+	TxSpeed       uint64                 `protobuf:"varint,5,opt,name=tx_speed,json=txSpeed,proto3" json:"tx_speed,omitempty"`    // 发送速率(字节/秒)
+	RxErrors      uint64                 `protobuf:"varint,6,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"` // 接收错误数
+	TxErrors      uint64                 `protobuf:"varint,7,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"` // 发送错误数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetInterfaceState) Reset() {
+	*x = NetInterfaceState{}
+	mi := &file_proto_host_monitor_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetInterfaceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetInterfaceState) ProtoMessage() {}
+
+func (x *NetInterfaceState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetInterfaceState.ProtoReflect.Descriptor instead.
+func (*NetInterfaceState) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NetInterfaceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetInterfaceState) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *NetInterfaceState) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *NetInterfaceState) GetRxSpeed() uint64 {
+	if x != nil {
+		return x.RxSpeed
+	}
+	return 0
+}
+
+func (x *NetInterfaceState) GetTxSpeed() uint64 {
+	if x != nil {
+		return x.TxSpeed
+	}
+	return 0
+}
+
+func (x *NetInterfaceState) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *NetInterfaceState) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+// 进程资源占用
+type ProcessState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pid           int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`                                         // 进程ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                        // 进程名
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                        // 运行用户
+	CpuUsage      float64                `protobuf:"fixed64,4,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`              // CPU使用率(%, 多核可超过100)
+	MemUsage      float64                `protobuf:"fixed64,5,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`              // 内存使用率(%)
+	MemRss        uint64                 `protobuf:"varint,6,opt,name=mem_rss,json=memRss,proto3" json:"mem_rss,omitempty"`                     // 常驻内存(字节)
+	IoReadSpeed   uint64                 `protobuf:"varint,7,opt,name=io_read_speed,json=ioReadSpeed,proto3" json:"io_read_speed,omitempty"`    // 读速率(字节/秒)
+	IoWriteSpeed  uint64                 `protobuf:"varint,8,opt,name=io_write_speed,json=ioWriteSpeed,proto3" json:"io_write_speed,omitempty"` // 写速率(字节/秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessState) Reset() {
+	*x = ProcessState{}
+	mi := &file_proto_host_monitor_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessState) ProtoMessage() {}
+
+func (x *ProcessState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessState.ProtoReflect.Descriptor instead.
+func (*ProcessState) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProcessState) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessState) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ProcessState) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *ProcessState) GetMemUsage() float64 {
+	if x != nil {
+		return x.MemUsage
+	}
+	return 0
+}
+
+func (x *ProcessState) GetMemRss() uint64 {
+	if x != nil {
+		return x.MemRss
+	}
+	return 0
+}
+
+func (x *ProcessState) GetIoReadSpeed() uint64 {
+	if x != nil {
+		return x.IoReadSpeed
+	}
+	return 0
+}
+
+func (x *ProcessState) GetIoWriteSpeed() uint64 {
+	if x != nil {
+		return x.IoWriteSpeed
 	}
+	return 0
+}
+
+// Agent版本信息
+type VersionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // 版本号(v1.2.3-a1b2c3d)
+	BuildTime     string                 `protobuf:"bytes,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // 构建时间(RFC3339格式)
+	CommitId      string                 `protobuf:"bytes,3,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`    // 提交哈希(7位短哈希)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionInfo) Reset() {
+	*x = VersionInfo{}
+	mi := &file_proto_host_monitor_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *VersionInfo) String() string {
@@ -600,8 +985,8 @@ func (x *VersionInfo) String() string {
 func (*VersionInfo) ProtoMessage() {}
 
 func (x *VersionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[7]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -613,7 +998,7 @@ func (x *VersionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
 func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{3}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *VersionInfo) GetVersion() string {
@@ -639,21 +1024,18 @@ func (x *VersionInfo) GetCommitId() string {
 
 // 温度传感器
 type Temperature struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                 // 传感器名称(CPU/Disk/GPU)
+	Temperature   float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"` // 温度(摄氏度)
 	unknownFields protoimpl.UnknownFields
-
-	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                 // 传感器名称(CPU/Disk/GPU)
-	Temperature float64 `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"` // 温度(摄氏度)
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Temperature) Reset() {
 	*x = Temperature{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Temperature) String() string {
@@ -663,8 +1045,8 @@ func (x *Temperature) String() string {
 func (*Temperature) ProtoMessage() {}
 
 func (x *Temperature) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[8]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -676,7 +1058,7 @@ func (x *Temperature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Temperature.ProtoReflect.Descriptor instead.
 func (*Temperature) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{4}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Temperature) GetName() string {
@@ -695,22 +1077,19 @@ func (x *Temperature) GetTemperature() float64 {
 
 // Agent注册请求
 type RegisterAgentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                            // 主机UUID
+	SecretKey     string                 `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"` // 密钥
+	HostInfo      *HostInfo              `protobuf:"bytes,3,opt,name=host_info,json=hostInfo,proto3" json:"host_info,omitempty"`    // 主机硬件信息
 	unknownFields protoimpl.UnknownFields
-
-	Uuid      string    `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                            // 主机UUID
-	SecretKey string    `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"` // 密钥
-	HostInfo  *HostInfo `protobuf:"bytes,3,opt,name=host_info,json=hostInfo,proto3" json:"host_info,omitempty"`    // 主机硬件信息
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterAgentRequest) Reset() {
 	*x = RegisterAgentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterAgentRequest) String() string {
@@ -720,8 +1099,269 @@ func (x *RegisterAgentRequest) String() string {
 func (*RegisterAgentRequest) ProtoMessage() {}
 
 func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RegisterAgentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RegisterAgentRequest) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *RegisterAgentRequest) GetHostInfo() *HostInfo {
+	if x != nil {
+		return x.HostInfo
+	}
+	return nil
+}
+
+// Agent注册响应
+type RegisterAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                         // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                          // 消息
+	ServerTime    int64                  `protobuf:"varint,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"` // 服务器时间(Unix时间戳)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterAgentResponse) Reset() {
+	*x = RegisterAgentResponse{}
+	mi := &file_proto_host_monitor_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAgentResponse) ProtoMessage() {}
+
+func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
+func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RegisterAgentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterAgentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterAgentResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+// Agent证书签发请求
+type EnrollAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                            // 主机UUID
+	SecretKey     string                 `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"` // 密钥
+	Csr           []byte                 `protobuf:"bytes,3,opt,name=csr,proto3" json:"csr,omitempty"`                              // PEM编码的证书签名请求
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollAgentRequest) Reset() {
+	*x = EnrollAgentRequest{}
+	mi := &file_proto_host_monitor_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollAgentRequest) ProtoMessage() {}
+
+func (x *EnrollAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollAgentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnrollAgentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *EnrollAgentRequest) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *EnrollAgentRequest) GetCsr() []byte {
+	if x != nil {
+		return x.Csr
+	}
+	return nil
+}
+
+// Agent证书签发响应
+type EnrollAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                 // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                  // 消息
+	Certificate   []byte                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`                          // PEM编码的客户端证书
+	CaCertificate []byte                 `protobuf:"bytes,4,opt,name=ca_certificate,json=caCertificate,proto3" json:"ca_certificate,omitempty"` // PEM编码的CA证书
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`            // 证书过期时间(Unix时间戳)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollAgentResponse) Reset() {
+	*x = EnrollAgentResponse{}
+	mi := &file_proto_host_monitor_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollAgentResponse) ProtoMessage() {}
+
+func (x *EnrollAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollAgentResponse.ProtoReflect.Descriptor instead.
+func (*EnrollAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EnrollAgentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EnrollAgentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EnrollAgentResponse) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *EnrollAgentResponse) GetCaCertificate() []byte {
+	if x != nil {
+		return x.CaCertificate
+	}
+	return nil
+}
+
+func (x *EnrollAgentResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Agent二进制下载请求
+type DownloadAgentBinaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`       // 主机UUID
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // 目标版本
+	Os            string                 `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`           // 操作系统(GOOS)
+	Arch          string                 `protobuf:"bytes,4,opt,name=arch,proto3" json:"arch,omitempty"`       // CPU架构(GOARCH)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAgentBinaryRequest) Reset() {
+	*x = DownloadAgentBinaryRequest{}
+	mi := &file_proto_host_monitor_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAgentBinaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAgentBinaryRequest) ProtoMessage() {}
+
+func (x *DownloadAgentBinaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[13]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -731,61 +1371,66 @@ func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
-func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DownloadAgentBinaryRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAgentBinaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RegisterAgentRequest) GetUuid() string {
+func (x *DownloadAgentBinaryRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *RegisterAgentRequest) GetSecretKey() string {
+func (x *DownloadAgentBinaryRequest) GetVersion() string {
 	if x != nil {
-		return x.SecretKey
+		return x.Version
 	}
 	return ""
 }
 
-func (x *RegisterAgentRequest) GetHostInfo() *HostInfo {
+func (x *DownloadAgentBinaryRequest) GetOs() string {
 	if x != nil {
-		return x.HostInfo
+		return x.Os
 	}
-	return nil
+	return ""
 }
 
-// Agent注册响应
-type RegisterAgentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DownloadAgentBinaryRequest) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
 
-	Success    bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                         // 是否成功
-	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                          // 消息
-	ServerTime int64  `protobuf:"varint,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"` // 服务器时间(Unix时间戳)
+// Agent二进制分块, 元数据只在第一块中
+type AgentBinaryChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int64                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`          // 文件大小(字节)
+	Sha256        string                 `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`       // 十六进制SHA-256
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"` // Base64编码的Ed25519签名(签名内容为SHA-256摘要)
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`           // 数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterAgentResponse) Reset() {
-	*x = RegisterAgentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *AgentBinaryChunk) Reset() {
+	*x = AgentBinaryChunk{}
+	mi := &file_proto_host_monitor_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterAgentResponse) String() string {
+func (x *AgentBinaryChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterAgentResponse) ProtoMessage() {}
+func (*AgentBinaryChunk) ProtoMessage() {}
 
-func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *AgentBinaryChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_host_monitor_proto_msgTypes[14]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -795,50 +1440,55 @@ func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
-func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AgentBinaryChunk.ProtoReflect.Descriptor instead.
+func (*AgentBinaryChunk) Descriptor() ([]byte, []int) {
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RegisterAgentResponse) GetSuccess() bool {
+func (x *AgentBinaryChunk) GetSize() int64 {
 	if x != nil {
-		return x.Success
+		return x.Size
 	}
-	return false
+	return 0
 }
 
-func (x *RegisterAgentResponse) GetMessage() string {
+func (x *AgentBinaryChunk) GetSha256() string {
 	if x != nil {
-		return x.Message
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *RegisterAgentResponse) GetServerTime() int64 {
+func (x *AgentBinaryChunk) GetSignature() string {
 	if x != nil {
-		return x.ServerTime
+		return x.Signature
 	}
-	return 0
+	return ""
+}
+
+func (x *AgentBinaryChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
 // 上报状态请求
 type ReportStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                  // 主机UUID
+	State         *HostState             `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                                // 监控状态
+	TaskResults   []*TaskResult          `protobuf:"bytes,3,rep,name=task_results,json=taskResults,proto3" json:"task_results,omitempty"` // 任务执行结果
+	Backfill      []*HostState           `protobuf:"bytes,4,rep,name=backfill,proto3" json:"backfill,omitempty"`                          // 断线期间缓存的状态(按时间升序,补发)
 	unknownFields protoimpl.UnknownFields
-
-	Uuid        string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                  // 主机UUID
-	State       *HostState    `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                                // 监控状态
-	TaskResults []*TaskResult `protobuf:"bytes,3,rep,name=task_results,json=taskResults,proto3" json:"task_results,omitempty"` // 任务执行结果
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportStateRequest) Reset() {
 	*x = ReportStateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ReportStateRequest) String() string {
@@ -848,8 +1498,8 @@ func (x *ReportStateRequest) String() string {
 func (*ReportStateRequest) ProtoMessage() {}
 
 func (x *ReportStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[15]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -861,7 +1511,7 @@ func (x *ReportStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportStateRequest.ProtoReflect.Descriptor instead.
 func (*ReportStateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{7}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReportStateRequest) GetUuid() string {
@@ -885,26 +1535,30 @@ func (x *ReportStateRequest) GetTaskResults() []*TaskResult {
 	return nil
 }
 
+func (x *ReportStateRequest) GetBackfill() []*HostState {
+	if x != nil {
+		return x.Backfill
+	}
+	return nil
+}
+
 // 任务执行结果
 type TaskResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID（对应AgentTask.task_id）
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`            // 是否成功
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                 // 错误信息
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`             // 结果数据（JSON格式）
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`        // 完成时间戳
 	unknownFields protoimpl.UnknownFields
-
-	TaskId    string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID（对应AgentTask.task_id）
-	Success   bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`            // 是否成功
-	Error     string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                 // 错误信息
-	Payload   []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`             // 结果数据（JSON格式）
-	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`        // 完成时间戳
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TaskResult) Reset() {
 	*x = TaskResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TaskResult) String() string {
@@ -914,8 +1568,8 @@ func (x *TaskResult) String() string {
 func (*TaskResult) ProtoMessage() {}
 
 func (x *TaskResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[16]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -927,7 +1581,7 @@ func (x *TaskResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult.ProtoReflect.Descriptor instead.
 func (*TaskResult) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{8}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TaskResult) GetTaskId() string {
@@ -967,22 +1621,19 @@ func (x *TaskResult) GetTimestamp() int64 {
 
 // 上报状态响应
 type ReportStateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Tasks         []*AgentTask           `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`      // 待执行任务
 	unknownFields protoimpl.UnknownFields
-
-	Success bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
-	Tasks   []*AgentTask `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`      // 待执行任务
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportStateResponse) Reset() {
 	*x = ReportStateResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ReportStateResponse) String() string {
@@ -992,8 +1643,8 @@ func (x *ReportStateResponse) String() string {
 func (*ReportStateResponse) ProtoMessage() {}
 
 func (x *ReportStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1005,7 +1656,7 @@ func (x *ReportStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportStateResponse.ProtoReflect.Descriptor instead.
 func (*ReportStateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{9}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReportStateResponse) GetSuccess() bool {
@@ -1031,23 +1682,20 @@ func (x *ReportStateResponse) GetTasks() []*AgentTask {
 
 // Agent任务
 type AgentTask struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                                                             // 任务ID
+	TaskType      string                 `protobuf:"bytes,2,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`                                                       // 任务类型(terminal/probe/command/docker)
+	Params        map[string]string      `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 任务参数
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`                                                                         // 请求数据（JSON格式，用于复杂参数）
 	unknownFields protoimpl.UnknownFields
-
-	TaskId   string            `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                                                                           // 任务ID
-	TaskType string            `protobuf:"bytes,2,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`                                                                     // 任务类型(terminal/probe/command/docker)
-	Params   map[string]string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 任务参数
-	Payload  []byte            `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`                                                                                       // 请求数据（JSON格式，用于复杂参数）
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AgentTask) Reset() {
 	*x = AgentTask{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AgentTask) String() string {
@@ -1057,8 +1705,8 @@ func (x *AgentTask) String() string {
 func (*AgentTask) ProtoMessage() {}
 
 func (x *AgentTask) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[18]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1070,7 +1718,7 @@ func (x *AgentTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentTask.ProtoReflect.Descriptor instead.
 func (*AgentTask) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{10}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AgentTask) GetTaskId() string {
@@ -1103,21 +1751,18 @@ func (x *AgentTask) GetPayload() []byte {
 
 // 心跳请求
 type HeartbeatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`            // 主机UUID
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 时间戳
 	unknownFields protoimpl.UnknownFields
-
-	Uuid      string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`            // 主机UUID
-	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 时间戳
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HeartbeatRequest) Reset() {
 	*x = HeartbeatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HeartbeatRequest) String() string {
@@ -1127,8 +1772,8 @@ func (x *HeartbeatRequest) String() string {
 func (*HeartbeatRequest) ProtoMessage() {}
 
 func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[19]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1140,7 +1785,7 @@ func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
 func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{11}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HeartbeatRequest) GetUuid() string {
@@ -1159,21 +1804,18 @@ func (x *HeartbeatRequest) GetTimestamp() int64 {
 
 // 心跳响应
 type HeartbeatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                         // 是否成功
+	ServerTime    int64                  `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"` // 服务器时间
 	unknownFields protoimpl.UnknownFields
-
-	Success    bool  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                         // 是否成功
-	ServerTime int64 `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"` // 服务器时间
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HeartbeatResponse) Reset() {
 	*x = HeartbeatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HeartbeatResponse) String() string {
@@ -1183,8 +1825,8 @@ func (x *HeartbeatResponse) String() string {
 func (*HeartbeatResponse) ProtoMessage() {}
 
 func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[20]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1196,7 +1838,7 @@ func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{12}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *HeartbeatResponse) GetSuccess() bool {
@@ -1215,20 +1857,17 @@ func (x *HeartbeatResponse) GetServerTime() int64 {
 
 // PTY终端I/O流数据
 type IOStreamData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // 原始字节数据
 	unknownFields protoimpl.UnknownFields
-
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // 原始字节数据
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IOStreamData) Reset() {
 	*x = IOStreamData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *IOStreamData) String() string {
@@ -1238,8 +1877,8 @@ func (x *IOStreamData) String() string {
 func (*IOStreamData) ProtoMessage() {}
 
 func (x *IOStreamData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[21]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1251,7 +1890,7 @@ func (x *IOStreamData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IOStreamData.ProtoReflect.Descriptor instead.
 func (*IOStreamData) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{13}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *IOStreamData) GetData() []byte {
@@ -1263,21 +1902,18 @@ func (x *IOStreamData) GetData() []byte {
 
 // 探测结果项(用于批量上报)
 type ProbeResultItem struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ServiceMonitorId string       `protobuf:"bytes,1,opt,name=service_monitor_id,json=serviceMonitorId,proto3" json:"service_monitor_id,omitempty"` // 服务监控ID
-	Result           *ProbeResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`                                               // 探测结果
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceMonitorId string                 `protobuf:"bytes,1,opt,name=service_monitor_id,json=serviceMonitorId,proto3" json:"service_monitor_id,omitempty"` // 服务监控ID
+	Result           *ProbeResult           `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`                                               // 探测结果
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ProbeResultItem) Reset() {
 	*x = ProbeResultItem{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ProbeResultItem) String() string {
@@ -1287,8 +1923,8 @@ func (x *ProbeResultItem) String() string {
 func (*ProbeResultItem) ProtoMessage() {}
 
 func (x *ProbeResultItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[22]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1300,7 +1936,7 @@ func (x *ProbeResultItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeResultItem.ProtoReflect.Descriptor instead.
 func (*ProbeResultItem) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{14}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProbeResultItem) GetServiceMonitorId() string {
@@ -1319,21 +1955,18 @@ func (x *ProbeResultItem) GetResult() *ProbeResult {
 
 // Agent批量上报探测结果请求
 type ReportProbeResultBatchRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`       // Agent UUID
+	Results       []*ProbeResultItem     `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // 探测结果列表
 	unknownFields protoimpl.UnknownFields
-
-	Uuid    string             `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`       // Agent UUID
-	Results []*ProbeResultItem `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // 探测结果列表
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportProbeResultBatchRequest) Reset() {
 	*x = ReportProbeResultBatchRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ReportProbeResultBatchRequest) String() string {
@@ -1343,8 +1976,8 @@ func (x *ReportProbeResultBatchRequest) String() string {
 func (*ReportProbeResultBatchRequest) ProtoMessage() {}
 
 func (x *ReportProbeResultBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[23]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1356,7 +1989,7 @@ func (x *ReportProbeResultBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportProbeResultBatchRequest.ProtoReflect.Descriptor instead.
 func (*ReportProbeResultBatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{15}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ReportProbeResultBatchRequest) GetUuid() string {
@@ -1375,23 +2008,20 @@ func (x *ReportProbeResultBatchRequest) GetResults() []*ProbeResultItem {
 
 // Agent批量上报探测结果响应
 type ReportProbeResultBatchResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`     // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`      // 消息
+	Processed     int32                  `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"` // 成功处理的结果数量
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`       // 失败的结果数量
 	unknownFields protoimpl.UnknownFields
-
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`     // 是否成功
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`      // 消息
-	Processed int32  `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"` // 成功处理的结果数量
-	Failed    int32  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`       // 失败的结果数量
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportProbeResultBatchResponse) Reset() {
 	*x = ReportProbeResultBatchResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ReportProbeResultBatchResponse) String() string {
@@ -1401,8 +2031,8 @@ func (x *ReportProbeResultBatchResponse) String() string {
 func (*ReportProbeResultBatchResponse) ProtoMessage() {}
 
 func (x *ReportProbeResultBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[24]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1414,7 +2044,7 @@ func (x *ReportProbeResultBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportProbeResultBatchResponse.ProtoReflect.Descriptor instead.
 func (*ReportProbeResultBatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{16}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ReportProbeResultBatchResponse) GetSuccess() bool {
@@ -1447,26 +2077,24 @@ func (x *ReportProbeResultBatchResponse) GetFailed() int32 {
 
 // Docker流式操作消息(双向流)
 type DockerStreamMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Message:
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
 	//	*DockerStreamMessage_Init
 	//	*DockerStreamMessage_Data
 	//	*DockerStreamMessage_Resize
 	//	*DockerStreamMessage_Close
 	//	*DockerStreamMessage_Error
-	Message isDockerStreamMessage_Message `protobuf_oneof:"message"`
+	Message       isDockerStreamMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DockerStreamMessage) Reset() {
 	*x = DockerStreamMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerStreamMessage) String() string {
@@ -1476,8 +2104,8 @@ func (x *DockerStreamMessage) String() string {
 func (*DockerStreamMessage) ProtoMessage() {}
 
 func (x *DockerStreamMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[25]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1489,47 +2117,57 @@ func (x *DockerStreamMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerStreamMessage.ProtoReflect.Descriptor instead.
 func (*DockerStreamMessage) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{17}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{25}
 }
 
-func (m *DockerStreamMessage) GetMessage() isDockerStreamMessage_Message {
-	if m != nil {
-		return m.Message
+func (x *DockerStreamMessage) GetMessage() isDockerStreamMessage_Message {
+	if x != nil {
+		return x.Message
 	}
 	return nil
 }
 
 func (x *DockerStreamMessage) GetInit() *DockerStreamInit {
-	if x, ok := x.GetMessage().(*DockerStreamMessage_Init); ok {
-		return x.Init
+	if x != nil {
+		if x, ok := x.Message.(*DockerStreamMessage_Init); ok {
+			return x.Init
+		}
 	}
 	return nil
 }
 
 func (x *DockerStreamMessage) GetData() *DockerStreamData {
-	if x, ok := x.GetMessage().(*DockerStreamMessage_Data); ok {
-		return x.Data
+	if x != nil {
+		if x, ok := x.Message.(*DockerStreamMessage_Data); ok {
+			return x.Data
+		}
 	}
 	return nil
 }
 
 func (x *DockerStreamMessage) GetResize() *DockerStreamResize {
-	if x, ok := x.GetMessage().(*DockerStreamMessage_Resize); ok {
-		return x.Resize
+	if x != nil {
+		if x, ok := x.Message.(*DockerStreamMessage_Resize); ok {
+			return x.Resize
+		}
 	}
 	return nil
 }
 
 func (x *DockerStreamMessage) GetClose() *DockerStreamClose {
-	if x, ok := x.GetMessage().(*DockerStreamMessage_Close); ok {
-		return x.Close
+	if x != nil {
+		if x, ok := x.Message.(*DockerStreamMessage_Close); ok {
+			return x.Close
+		}
 	}
 	return nil
 }
 
 func (x *DockerStreamMessage) GetError() *DockerStreamError {
-	if x, ok := x.GetMessage().(*DockerStreamMessage_Error); ok {
-		return x.Error
+	if x != nil {
+		if x, ok := x.Message.(*DockerStreamMessage_Error); ok {
+			return x.Error
+		}
 	}
 	return nil
 }
@@ -1570,26 +2208,23 @@ func (*DockerStreamMessage_Error) isDockerStreamMessage_Message() {}
 
 // Docker流初始化
 type DockerStreamInit struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                                                    // 会话ID(Server生成)
+	InstanceId    string                 `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`                                                 // Docker实例ID(Server→Agent)
+	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`                                                                     // 操作类型: exec_container, get_logs, get_stats, pull_image, get_events
+	ContainerId   string                 `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`                                              // 容器ID(用于exec/logs/stats)
+	ImageName     string                 `protobuf:"bytes,5,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`                                                    // 镜像名称(用于pull_image)
+	Params        map[string]string      `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 操作参数
+	Ready         bool                   `protobuf:"varint,7,opt,name=ready,proto3" json:"ready,omitempty"`                                                                            // Agent就绪标志(Agent→Server)
 	unknownFields protoimpl.UnknownFields
-
-	SessionId   string            `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                                                                  // 会话ID(Server生成)
-	InstanceId  string            `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`                                                               // Docker实例ID(Server→Agent)
-	Operation   string            `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`                                                                                   // 操作类型: exec_container, get_logs, get_stats, pull_image, get_events
-	ContainerId string            `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`                                                            // 容器ID(用于exec/logs/stats)
-	ImageName   string            `protobuf:"bytes,5,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`                                                                  // 镜像名称(用于pull_image)
-	Params      map[string]string `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 操作参数
-	Ready       bool              `protobuf:"varint,7,opt,name=ready,proto3" json:"ready,omitempty"`                                                                                          // Agent就绪标志(Agent→Server)
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DockerStreamInit) Reset() {
 	*x = DockerStreamInit{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerStreamInit) String() string {
@@ -1599,8 +2234,8 @@ func (x *DockerStreamInit) String() string {
 func (*DockerStreamInit) ProtoMessage() {}
 
 func (x *DockerStreamInit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[26]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1612,7 +2247,7 @@ func (x *DockerStreamInit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerStreamInit.ProtoReflect.Descriptor instead.
 func (*DockerStreamInit) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{18}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DockerStreamInit) GetSessionId() string {
@@ -1666,22 +2301,19 @@ func (x *DockerStreamInit) GetReady() bool {
 
 // Docker流数据
 type DockerStreamData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                            // 流式数据
+	DataType      string                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`    // 数据类型: stdout, stderr, stats, events
 	unknownFields protoimpl.UnknownFields
-
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
-	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                            // 流式数据
-	DataType  string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`    // 数据类型: stdout, stderr, stats, events
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DockerStreamData) Reset() {
 	*x = DockerStreamData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerStreamData) String() string {
@@ -1691,8 +2323,8 @@ func (x *DockerStreamData) String() string {
 func (*DockerStreamData) ProtoMessage() {}
 
 func (x *DockerStreamData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[27]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1704,7 +2336,7 @@ func (x *DockerStreamData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerStreamData.ProtoReflect.Descriptor instead.
 func (*DockerStreamData) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{19}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DockerStreamData) GetSessionId() string {
@@ -1730,22 +2362,19 @@ func (x *DockerStreamData) GetDataType() string {
 
 // 终端窗口大小调整
 type DockerStreamResize struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Width         uint32                 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`                         // 列数
+	Height        uint32                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                       // 行数
 	unknownFields protoimpl.UnknownFields
-
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
-	Width     uint32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`                         // 列数
-	Height    uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                       // 行数
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DockerStreamResize) Reset() {
 	*x = DockerStreamResize{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerStreamResize) String() string {
@@ -1755,8 +2384,8 @@ func (x *DockerStreamResize) String() string {
 func (*DockerStreamResize) ProtoMessage() {}
 
 func (x *DockerStreamResize) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[28]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1768,7 +2397,7 @@ func (x *DockerStreamResize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerStreamResize.ProtoReflect.Descriptor instead.
 func (*DockerStreamResize) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{20}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DockerStreamResize) GetSessionId() string {
@@ -1794,21 +2423,18 @@ func (x *DockerStreamResize) GetHeight() uint32 {
 
 // Docker流关闭
 type DockerStreamClose struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                        // 关闭原因
 	unknownFields protoimpl.UnknownFields
-
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
-	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                        // 关闭原因
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DockerStreamClose) Reset() {
 	*x = DockerStreamClose{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerStreamClose) String() string {
@@ -1818,8 +2444,8 @@ func (x *DockerStreamClose) String() string {
 func (*DockerStreamClose) ProtoMessage() {}
 
 func (x *DockerStreamClose) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[29]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1831,7 +2457,7 @@ func (x *DockerStreamClose) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerStreamClose.ProtoReflect.Descriptor instead.
 func (*DockerStreamClose) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{21}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DockerStreamClose) GetSessionId() string {
@@ -1850,21 +2476,18 @@ func (x *DockerStreamClose) GetReason() string {
 
 // Docker流错误
 type DockerStreamError struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                          // 错误信息
 	unknownFields protoimpl.UnknownFields
-
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
-	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                          // 错误信息
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DockerStreamError) Reset() {
 	*x = DockerStreamError{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_host_monitor_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_proto_host_monitor_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DockerStreamError) String() string {
@@ -1874,8 +2497,8 @@ func (x *DockerStreamError) String() string {
 func (*DockerStreamError) ProtoMessage() {}
 
 func (x *DockerStreamError) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_host_monitor_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_proto_host_monitor_proto_msgTypes[30]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1887,7 +2510,7 @@ func (x *DockerStreamError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerStreamError.ProtoReflect.Descriptor instead.
 func (*DockerStreamError) Descriptor() ([]byte, []int) {
-	return file_proto_host_monitor_proto_rawDescGZIP(), []int{22}
+	return file_proto_host_monitor_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DockerStreamError) GetSessionId() string {
@@ -1906,391 +2529,346 @@ func (x *DockerStreamError) GetError() string {
 
 var File_proto_host_monitor_proto protoreflect.FileDescriptor
 
-var file_proto_host_monitor_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x03, 0x0a,
-	0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x70, 0x75, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x70, 0x75, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75,
-	0x5f, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70,
-	0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x73, 0x68, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x73, 0x68, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x73, 0x73, 0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x73, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x6f,
-	0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xcb,
-	0x03, 0x0a, 0x0a, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65,
-	0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
-	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x6f, 0x70,
-	0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
-	0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x63, 0x70, 0x75,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6e, 0x63, 0x70, 0x75, 0x22, 0xed, 0x06, 0x0a,
-	0x09, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x31, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x12, 0x15, 0x0a, 0x06,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x35, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f,
-	0x61, 0x64, 0x35, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x31, 0x35, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35, 0x12, 0x19, 0x0a, 0x08,
-	0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x6d, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x75, 0x73, 0x65,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x55, 0x73, 0x65,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a,
-	0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x6e, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
-	0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x53, 0x70, 0x65, 0x65,
-	0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x4f, 0x75, 0x74,
-	0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
-	0x63, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x75,
-	0x64, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x75, 0x64, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x36,
-	0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x13,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x70, 0x75, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73,
-	0x65, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x63, 0x76, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x44, 0x65,
-	0x6c, 0x74, 0x61, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x44, 0x65, 0x6c, 0x74,
-	0x61, 0x52, 0x65, 0x63, 0x76, 0x12, 0x35, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x63, 0x0a, 0x0b,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x64, 0x22, 0x43, 0x0a, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x77, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x6c, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x86, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x34, 0x0a, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x71, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x09, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x39, 0x0a,
-	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4e,
-	0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x22,
-	0x0a, 0x0c, 0x49, 0x4f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x6b, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x65, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72,
-	0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x13, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x69,
-	0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
-	0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x30,
-	0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc0, 0x02,
-	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
-	0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65,
-	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x12, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4a, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x6b, 0x65,
-	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xd0, 0x03,
-	0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a,
-	0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
-	0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x49, 0x4f,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x4f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x4f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61,
-	0x28, 0x01, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0c, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63,
-	0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01,
-	0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79,
-	0x73, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x69, 0x67, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_proto_host_monitor_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/host_monitor.proto\x12\x05proto\x1a\x19proto/service_probe.proto\"\xef\x03\n" +
+	"\bHostInfo\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12)\n" +
+	"\x10platform_version\x18\x02 \x01(\tR\x0fplatformVersion\x12\x12\n" +
+	"\x04arch\x18\x03 \x01(\tR\x04arch\x12&\n" +
+	"\x0evirtualization\x18\x04 \x01(\tR\x0evirtualization\x12\x1b\n" +
+	"\tcpu_model\x18\x05 \x01(\tR\bcpuModel\x12\x1b\n" +
+	"\tcpu_cores\x18\x06 \x01(\x05R\bcpuCores\x12\x1b\n" +
+	"\tmem_total\x18\a \x01(\x04R\bmemTotal\x12\x1d\n" +
+	"\n" +
+	"disk_total\x18\b \x01(\x04R\tdiskTotal\x12\x1d\n" +
+	"\n" +
+	"swap_total\x18\t \x01(\x04R\tswapTotal\x12#\n" +
+	"\ragent_version\x18\n" +
+	" \x01(\tR\fagentVersion\x12\x1b\n" +
+	"\tboot_time\x18\v \x01(\x03R\bbootTime\x12\x1f\n" +
+	"\vssh_enabled\x18\f \x01(\bR\n" +
+	"sshEnabled\x12\x19\n" +
+	"\bssh_port\x18\r \x01(\x05R\asshPort\x12\x19\n" +
+	"\bssh_user\x18\x0e \x01(\tR\asshUser\x122\n" +
+	"\vdocker_info\x18\x0f \x01(\v2\x11.proto.DockerInfoR\n" +
+	"dockerInfo\"\xcb\x03\n" +
+	"\n" +
+	"DockerInfo\x12\x1c\n" +
+	"\tinstalled\x18\x01 \x01(\bR\tinstalled\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1f\n" +
+	"\vapi_version\x18\x03 \x01(\tR\n" +
+	"apiVersion\x12\x0e\n" +
+	"\x02os\x18\x04 \x01(\tR\x02os\x12\x12\n" +
+	"\x04arch\x18\x05 \x01(\tR\x04arch\x12%\n" +
+	"\x0ekernel_version\x18\x06 \x01(\tR\rkernelVersion\x12%\n" +
+	"\x0estorage_driver\x18\a \x01(\tR\rstorageDriver\x12\x1e\n" +
+	"\n" +
+	"containers\x18\b \x01(\x05R\n" +
+	"containers\x12-\n" +
+	"\x12containers_running\x18\t \x01(\x05R\x11containersRunning\x12+\n" +
+	"\x11containers_paused\x18\n" +
+	" \x01(\x05R\x10containersPaused\x12-\n" +
+	"\x12containers_stopped\x18\v \x01(\x05R\x11containersStopped\x12\x16\n" +
+	"\x06images\x18\f \x01(\x05R\x06images\x12\x1b\n" +
+	"\tmem_total\x18\r \x01(\x04R\bmemTotal\x12\x12\n" +
+	"\x04ncpu\x18\x0e \x01(\x05R\x04ncpu\"\xd5\b\n" +
+	"\tHostState\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x1b\n" +
+	"\tcpu_usage\x18\x02 \x01(\x01R\bcpuUsage\x12\x15\n" +
+	"\x06load_1\x18\x03 \x01(\x01R\x05load1\x12\x15\n" +
+	"\x06load_5\x18\x04 \x01(\x01R\x05load5\x12\x17\n" +
+	"\aload_15\x18\x05 \x01(\x01R\x06load15\x12\x19\n" +
+	"\bmem_used\x18\x06 \x01(\x04R\amemUsed\x12\x1b\n" +
+	"\tmem_usage\x18\a \x01(\x01R\bmemUsage\x12\x1b\n" +
+	"\tswap_used\x18\b \x01(\x04R\bswapUsed\x12\x1b\n" +
+	"\tdisk_used\x18\t \x01(\x04R\bdiskUsed\x12\x1d\n" +
+	"\n" +
+	"disk_usage\x18\n" +
+	" \x01(\x01R\tdiskUsage\x12&\n" +
+	"\x0fnet_in_transfer\x18\v \x01(\x04R\rnetInTransfer\x12(\n" +
+	"\x10net_out_transfer\x18\f \x01(\x04R\x0enetOutTransfer\x12 \n" +
+	"\fnet_in_speed\x18\r \x01(\x04R\n" +
+	"netInSpeed\x12\"\n" +
+	"\rnet_out_speed\x18\x0e \x01(\x04R\vnetOutSpeed\x12$\n" +
+	"\x0etcp_conn_count\x18\x0f \x01(\x05R\ftcpConnCount\x12$\n" +
+	"\x0eudp_conn_count\x18\x10 \x01(\x05R\fudpConnCount\x12#\n" +
+	"\rprocess_count\x18\x11 \x01(\x05R\fprocessCount\x12\x16\n" +
+	"\x06uptime\x18\x12 \x01(\x03R\x06uptime\x126\n" +
+	"\ftemperatures\x18\x13 \x03(\v2\x12.proto.TemperatureR\ftemperatures\x12\x1b\n" +
+	"\tgpu_usage\x18\x14 \x01(\x01R\bgpuUsage\x12!\n" +
+	"\ftraffic_sent\x18\x15 \x01(\x04R\vtrafficSent\x12!\n" +
+	"\ftraffic_recv\x18\x16 \x01(\x04R\vtrafficRecv\x12,\n" +
+	"\x12traffic_delta_sent\x18\x17 \x01(\x04R\x10trafficDeltaSent\x12,\n" +
+	"\x12traffic_delta_recv\x18\x18 \x01(\x04R\x10trafficDeltaRecv\x125\n" +
+	"\fversion_info\x18\x19 \x01(\v2\x12.proto.VersionInfoR\vversionInfo\x12/\n" +
+	"\x05disks\x18\x1a \x03(\v2\x19.proto.DiskPartitionStateR\x05disks\x12?\n" +
+	"\x0enet_interfaces\x18\x1b \x03(\v2\x18.proto.NetInterfaceStateR\rnetInterfaces\x128\n" +
+	"\rtop_processes\x18\x1c \x03(\v2\x13.proto.ProcessStateR\ftopProcesses\x12:\n" +
+	"\x0ecustom_metrics\x18\x1d \x03(\v2\x13.proto.CustomMetricR\rcustomMetrics\"\xac\x01\n" +
+	"\fCustomMetric\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\x06labels\x18\x02 \x03(\v2\x1f.proto.CustomMetric.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8c\x02\n" +
+	"\x12DiskPartitionState\x12\x1f\n" +
+	"\vmount_point\x18\x01 \x01(\tR\n" +
+	"mountPoint\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x16\n" +
+	"\x06fstype\x18\x03 \x01(\tR\x06fstype\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x04R\x05total\x12\x12\n" +
+	"\x04used\x18\x05 \x01(\x04R\x04used\x12\x14\n" +
+	"\x05usage\x18\x06 \x01(\x01R\x05usage\x12!\n" +
+	"\finodes_total\x18\a \x01(\x04R\vinodesTotal\x12\x1f\n" +
+	"\vinodes_used\x18\b \x01(\x04R\n" +
+	"inodesUsed\x12!\n" +
+	"\finodes_usage\x18\t \x01(\x01R\vinodesUsage\"\xcd\x01\n" +
+	"\x11NetInterfaceState\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\brx_bytes\x18\x02 \x01(\x04R\arxBytes\x12\x19\n" +
+	"\btx_bytes\x18\x03 \x01(\x04R\atxBytes\x12\x19\n" +
+	"\brx_speed\x18\x04 \x01(\x04R\arxSpeed\x12\x19\n" +
+	"\btx_speed\x18\x05 \x01(\x04R\atxSpeed\x12\x1b\n" +
+	"\trx_errors\x18\x06 \x01(\x04R\brxErrors\x12\x1b\n" +
+	"\ttx_errors\x18\a \x01(\x04R\btxErrors\"\xe5\x01\n" +
+	"\fProcessState\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x1b\n" +
+	"\tcpu_usage\x18\x04 \x01(\x01R\bcpuUsage\x12\x1b\n" +
+	"\tmem_usage\x18\x05 \x01(\x01R\bmemUsage\x12\x17\n" +
+	"\amem_rss\x18\x06 \x01(\x04R\x06memRss\x12\"\n" +
+	"\rio_read_speed\x18\a \x01(\x04R\vioReadSpeed\x12$\n" +
+	"\x0eio_write_speed\x18\b \x01(\x04R\fioWriteSpeed\"c\n" +
+	"\vVersionInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1d\n" +
+	"\n" +
+	"build_time\x18\x02 \x01(\tR\tbuildTime\x12\x1b\n" +
+	"\tcommit_id\x18\x03 \x01(\tR\bcommitId\"C\n" +
+	"\vTemperature\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vtemperature\x18\x02 \x01(\x01R\vtemperature\"w\n" +
+	"\x14RegisterAgentRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\x12,\n" +
+	"\thost_info\x18\x03 \x01(\v2\x0f.proto.HostInfoR\bhostInfo\"l\n" +
+	"\x15RegisterAgentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vserver_time\x18\x03 \x01(\x03R\n" +
+	"serverTime\"Y\n" +
+	"\x12EnrollAgentRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\x12\x10\n" +
+	"\x03csr\x18\x03 \x01(\fR\x03csr\"\xb1\x01\n" +
+	"\x13EnrollAgentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\fR\vcertificate\x12%\n" +
+	"\x0eca_certificate\x18\x04 \x01(\fR\rcaCertificate\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"n\n" +
+	"\x1aDownloadAgentBinaryRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x0e\n" +
+	"\x02os\x18\x03 \x01(\tR\x02os\x12\x12\n" +
+	"\x04arch\x18\x04 \x01(\tR\x04arch\"p\n" +
+	"\x10AgentBinaryChunk\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x02 \x01(\tR\x06sha256\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"\xb4\x01\n" +
+	"\x12ReportStateRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12&\n" +
+	"\x05state\x18\x02 \x01(\v2\x10.proto.HostStateR\x05state\x124\n" +
+	"\ftask_results\x18\x03 \x03(\v2\x11.proto.TaskResultR\vtaskResults\x12,\n" +
+	"\bbackfill\x18\x04 \x03(\v2\x10.proto.HostStateR\bbackfill\"\x8d\x01\n" +
+	"\n" +
+	"TaskResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\"q\n" +
+	"\x13ReportStateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x05tasks\x18\x03 \x03(\v2\x10.proto.AgentTaskR\x05tasks\"\xcc\x01\n" +
+	"\tAgentTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\ttask_type\x18\x02 \x01(\tR\btaskType\x124\n" +
+	"\x06params\x18\x03 \x03(\v2\x1c.proto.AgentTask.ParamsEntryR\x06params\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x10HeartbeatRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"N\n" +
+	"\x11HeartbeatResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vserver_time\x18\x02 \x01(\x03R\n" +
+	"serverTime\"\"\n" +
+	"\fIOStreamData\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"k\n" +
+	"\x0fProbeResultItem\x12,\n" +
+	"\x12service_monitor_id\x18\x01 \x01(\tR\x10serviceMonitorId\x12*\n" +
+	"\x06result\x18\x02 \x01(\v2\x12.proto.ProbeResultR\x06result\"e\n" +
+	"\x1dReportProbeResultBatchRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x120\n" +
+	"\aresults\x18\x02 \x03(\v2\x16.proto.ProbeResultItemR\aresults\"\x8a\x01\n" +
+	"\x1eReportProbeResultBatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tprocessed\x18\x03 \x01(\x05R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"\x97\x02\n" +
+	"\x13DockerStreamMessage\x12-\n" +
+	"\x04init\x18\x01 \x01(\v2\x17.proto.DockerStreamInitH\x00R\x04init\x12-\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.proto.DockerStreamDataH\x00R\x04data\x123\n" +
+	"\x06resize\x18\x03 \x01(\v2\x19.proto.DockerStreamResizeH\x00R\x06resize\x120\n" +
+	"\x05close\x18\x04 \x01(\v2\x18.proto.DockerStreamCloseH\x00R\x05close\x120\n" +
+	"\x05error\x18\x05 \x01(\v2\x18.proto.DockerStreamErrorH\x00R\x05errorB\t\n" +
+	"\amessage\"\xc0\x02\n" +
+	"\x10DockerStreamInit\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12!\n" +
+	"\fcontainer_id\x18\x04 \x01(\tR\vcontainerId\x12\x1d\n" +
+	"\n" +
+	"image_name\x18\x05 \x01(\tR\timageName\x12;\n" +
+	"\x06params\x18\x06 \x03(\v2#.proto.DockerStreamInit.ParamsEntryR\x06params\x12\x14\n" +
+	"\x05ready\x18\a \x01(\bR\x05ready\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"b\n" +
+	"\x10DockerStreamData\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1b\n" +
+	"\tdata_type\x18\x03 \x01(\tR\bdataType\"a\n" +
+	"\x12DockerStreamResize\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\rR\x06height\"J\n" +
+	"\x11DockerStreamClose\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"H\n" +
+	"\x11DockerStreamError\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\xeb\x04\n" +
+	"\vHostMonitor\x12H\n" +
+	"\vReportState\x12\x19.proto.ReportStateRequest\x1a\x1a.proto.ReportStateResponse(\x010\x01\x12J\n" +
+	"\rRegisterAgent\x12\x1b.proto.RegisterAgentRequest\x1a\x1c.proto.RegisterAgentResponse\x12D\n" +
+	"\vEnrollAgent\x12\x19.proto.EnrollAgentRequest\x1a\x1a.proto.EnrollAgentResponse\x12S\n" +
+	"\x13DownloadAgentBinary\x12!.proto.DownloadAgentBinaryRequest\x1a\x17.proto.AgentBinaryChunk0\x01\x12e\n" +
+	"\x16ReportProbeResultBatch\x12$.proto.ReportProbeResultBatchRequest\x1a%.proto.ReportProbeResultBatchResponse\x12>\n" +
+	"\tHeartbeat\x12\x17.proto.HeartbeatRequest\x1a\x18.proto.HeartbeatResponse\x128\n" +
+	"\bIOStream\x12\x13.proto.IOStreamData\x1a\x13.proto.IOStreamData(\x010\x01\x12J\n" +
+	"\fDockerStream\x12\x1a.proto.DockerStreamMessage\x1a\x1a.proto.DockerStreamMessage(\x010\x01B%Z#github.com/ysicing/tiga/proto;protob\x06proto3"
 
 var (
 	file_proto_host_monitor_proto_rawDescOnce sync.Once
-	file_proto_host_monitor_proto_rawDescData = file_proto_host_monitor_proto_rawDesc
+	file_proto_host_monitor_proto_rawDescData []byte
 )
 
 func file_proto_host_monitor_proto_rawDescGZIP() []byte {
 	file_proto_host_monitor_proto_rawDescOnce.Do(func() {
-		file_proto_host_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_host_monitor_proto_rawDescData)
+		file_proto_host_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_host_monitor_proto_rawDesc), len(file_proto_host_monitor_proto_rawDesc)))
 	})
 	return file_proto_host_monitor_proto_rawDescData
 }
 
-var file_proto_host_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_proto_host_monitor_proto_goTypes = []interface{}{
+var file_proto_host_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_proto_host_monitor_proto_goTypes = []any{
 	(*HostInfo)(nil),                       // 0: proto.HostInfo
 	(*DockerInfo)(nil),                     // 1: proto.DockerInfo
 	(*HostState)(nil),                      // 2: proto.HostState
-	(*VersionInfo)(nil),                    // 3: proto.VersionInfo
-	(*Temperature)(nil),                    // 4: proto.Temperature
-	(*RegisterAgentRequest)(nil),           // 5: proto.RegisterAgentRequest
-	(*RegisterAgentResponse)(nil),          // 6: proto.RegisterAgentResponse
-	(*ReportStateRequest)(nil),             // 7: proto.ReportStateRequest
-	(*TaskResult)(nil),                     // 8: proto.TaskResult
-	(*ReportStateResponse)(nil),            // 9: proto.ReportStateResponse
-	(*AgentTask)(nil),                      // 10: proto.AgentTask
-	(*HeartbeatRequest)(nil),               // 11: proto.HeartbeatRequest
-	(*HeartbeatResponse)(nil),              // 12: proto.HeartbeatResponse
-	(*IOStreamData)(nil),                   // 13: proto.IOStreamData
-	(*ProbeResultItem)(nil),                // 14: proto.ProbeResultItem
-	(*ReportProbeResultBatchRequest)(nil),  // 15: proto.ReportProbeResultBatchRequest
-	(*ReportProbeResultBatchResponse)(nil), // 16: proto.ReportProbeResultBatchResponse
-	(*DockerStreamMessage)(nil),            // 17: proto.DockerStreamMessage
-	(*DockerStreamInit)(nil),               // 18: proto.DockerStreamInit
-	(*DockerStreamData)(nil),               // 19: proto.DockerStreamData
-	(*DockerStreamResize)(nil),             // 20: proto.DockerStreamResize
-	(*DockerStreamClose)(nil),              // 21: proto.DockerStreamClose
-	(*DockerStreamError)(nil),              // 22: proto.DockerStreamError
-	nil,                                    // 23: proto.AgentTask.ParamsEntry
-	nil,                                    // 24: proto.DockerStreamInit.ParamsEntry
-	(*ProbeResult)(nil),                    // 25: proto.ProbeResult
+	(*CustomMetric)(nil),                   // 3: proto.CustomMetric
+	(*DiskPartitionState)(nil),             // 4: proto.DiskPartitionState
+	(*NetInterfaceState)(nil),              // 5: proto.NetInterfaceState
+	(*ProcessState)(nil),                   // 6: proto.ProcessState
+	(*VersionInfo)(nil),                    // 7: proto.VersionInfo
+	(*Temperature)(nil),                    // 8: proto.Temperature
+	(*RegisterAgentRequest)(nil),           // 9: proto.RegisterAgentRequest
+	(*RegisterAgentResponse)(nil),          // 10: proto.RegisterAgentResponse
+	(*EnrollAgentRequest)(nil),             // 11: proto.EnrollAgentRequest
+	(*EnrollAgentResponse)(nil),            // 12: proto.EnrollAgentResponse
+	(*DownloadAgentBinaryRequest)(nil),     // 13: proto.DownloadAgentBinaryRequest
+	(*AgentBinaryChunk)(nil),               // 14: proto.AgentBinaryChunk
+	(*ReportStateRequest)(nil),             // 15: proto.ReportStateRequest
+	(*TaskResult)(nil),                     // 16: proto.TaskResult
+	(*ReportStateResponse)(nil),            // 17: proto.ReportStateResponse
+	(*AgentTask)(nil),                      // 18: proto.AgentTask
+	(*HeartbeatRequest)(nil),               // 19: proto.HeartbeatRequest
+	(*HeartbeatResponse)(nil),              // 20: proto.HeartbeatResponse
+	(*IOStreamData)(nil),                   // 21: proto.IOStreamData
+	(*ProbeResultItem)(nil),                // 22: proto.ProbeResultItem
+	(*ReportProbeResultBatchRequest)(nil),  // 23: proto.ReportProbeResultBatchRequest
+	(*ReportProbeResultBatchResponse)(nil), // 24: proto.ReportProbeResultBatchResponse
+	(*DockerStreamMessage)(nil),            // 25: proto.DockerStreamMessage
+	(*DockerStreamInit)(nil),               // 26: proto.DockerStreamInit
+	(*DockerStreamData)(nil),               // 27: proto.DockerStreamData
+	(*DockerStreamResize)(nil),             // 28: proto.DockerStreamResize
+	(*DockerStreamClose)(nil),              // 29: proto.DockerStreamClose
+	(*DockerStreamError)(nil),              // 30: proto.DockerStreamError
+	nil,                                    // 31: proto.CustomMetric.LabelsEntry
+	nil,                                    // 32: proto.AgentTask.ParamsEntry
+	nil,                                    // 33: proto.DockerStreamInit.ParamsEntry
+	(*ProbeResult)(nil),                    // 34: proto.ProbeResult
 }
 var file_proto_host_monitor_proto_depIdxs = []int32{
 	1,  // 0: proto.HostInfo.docker_info:type_name -> proto.DockerInfo
-	4,  // 1: proto.HostState.temperatures:type_name -> proto.Temperature
-	3,  // 2: proto.HostState.version_info:type_name -> proto.VersionInfo
-	0,  // 3: proto.RegisterAgentRequest.host_info:type_name -> proto.HostInfo
-	2,  // 4: proto.ReportStateRequest.state:type_name -> proto.HostState
-	8,  // 5: proto.ReportStateRequest.task_results:type_name -> proto.TaskResult
-	10, // 6: proto.ReportStateResponse.tasks:type_name -> proto.AgentTask
-	23, // 7: proto.AgentTask.params:type_name -> proto.AgentTask.ParamsEntry
-	25, // 8: proto.ProbeResultItem.result:type_name -> proto.ProbeResult
-	14, // 9: proto.ReportProbeResultBatchRequest.results:type_name -> proto.ProbeResultItem
-	18, // 10: proto.DockerStreamMessage.init:type_name -> proto.DockerStreamInit
-	19, // 11: proto.DockerStreamMessage.data:type_name -> proto.DockerStreamData
-	20, // 12: proto.DockerStreamMessage.resize:type_name -> proto.DockerStreamResize
-	21, // 13: proto.DockerStreamMessage.close:type_name -> proto.DockerStreamClose
-	22, // 14: proto.DockerStreamMessage.error:type_name -> proto.DockerStreamError
-	24, // 15: proto.DockerStreamInit.params:type_name -> proto.DockerStreamInit.ParamsEntry
-	7,  // 16: proto.HostMonitor.ReportState:input_type -> proto.ReportStateRequest
-	5,  // 17: proto.HostMonitor.RegisterAgent:input_type -> proto.RegisterAgentRequest
-	15, // 18: proto.HostMonitor.ReportProbeResultBatch:input_type -> proto.ReportProbeResultBatchRequest
-	11, // 19: proto.HostMonitor.Heartbeat:input_type -> proto.HeartbeatRequest
-	13, // 20: proto.HostMonitor.IOStream:input_type -> proto.IOStreamData
-	17, // 21: proto.HostMonitor.DockerStream:input_type -> proto.DockerStreamMessage
-	9,  // 22: proto.HostMonitor.ReportState:output_type -> proto.ReportStateResponse
-	6,  // 23: proto.HostMonitor.RegisterAgent:output_type -> proto.RegisterAgentResponse
-	16, // 24: proto.HostMonitor.ReportProbeResultBatch:output_type -> proto.ReportProbeResultBatchResponse
-	12, // 25: proto.HostMonitor.Heartbeat:output_type -> proto.HeartbeatResponse
-	13, // 26: proto.HostMonitor.IOStream:output_type -> proto.IOStreamData
-	17, // 27: proto.HostMonitor.DockerStream:output_type -> proto.DockerStreamMessage
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	8,  // 1: proto.HostState.temperatures:type_name -> proto.Temperature
+	7,  // 2: proto.HostState.version_info:type_name -> proto.VersionInfo
+	4,  // 3: proto.HostState.disks:type_name -> proto.DiskPartitionState
+	5,  // 4: proto.HostState.net_interfaces:type_name -> proto.NetInterfaceState
+	6,  // 5: proto.HostState.top_processes:type_name -> proto.ProcessState
+	3,  // 6: proto.HostState.custom_metrics:type_name -> proto.CustomMetric
+	31, // 7: proto.CustomMetric.labels:type_name -> proto.CustomMetric.LabelsEntry
+	0,  // 8: proto.RegisterAgentRequest.host_info:type_name -> proto.HostInfo
+	2,  // 9: proto.ReportStateRequest.state:type_name -> proto.HostState
+	16, // 10: proto.ReportStateRequest.task_results:type_name -> proto.TaskResult
+	2,  // 11: proto.ReportStateRequest.backfill:type_name -> proto.HostState
+	18, // 12: proto.ReportStateResponse.tasks:type_name -> proto.AgentTask
+	32, // 13: proto.AgentTask.params:type_name -> proto.AgentTask.ParamsEntry
+	34, // 14: proto.ProbeResultItem.result:type_name -> proto.ProbeResult
+	22, // 15: proto.ReportProbeResultBatchRequest.results:type_name -> proto.ProbeResultItem
+	26, // 16: proto.DockerStreamMessage.init:type_name -> proto.DockerStreamInit
+	27, // 17: proto.DockerStreamMessage.data:type_name -> proto.DockerStreamData
+	28, // 18: proto.DockerStreamMessage.resize:type_name -> proto.DockerStreamResize
+	29, // 19: proto.DockerStreamMessage.close:type_name -> proto.DockerStreamClose
+	30, // 20: proto.DockerStreamMessage.error:type_name -> proto.DockerStreamError
+	33, // 21: proto.DockerStreamInit.params:type_name -> proto.DockerStreamInit.ParamsEntry
+	15, // 22: proto.HostMonitor.ReportState:input_type -> proto.ReportStateRequest
+	9,  // 23: proto.HostMonitor.RegisterAgent:input_type -> proto.RegisterAgentRequest
+	11, // 24: proto.HostMonitor.EnrollAgent:input_type -> proto.EnrollAgentRequest
+	13, // 25: proto.HostMonitor.DownloadAgentBinary:input_type -> proto.DownloadAgentBinaryRequest
+	23, // 26: proto.HostMonitor.ReportProbeResultBatch:input_type -> proto.ReportProbeResultBatchRequest
+	19, // 27: proto.HostMonitor.Heartbeat:input_type -> proto.HeartbeatRequest
+	21, // 28: proto.HostMonitor.IOStream:input_type -> proto.IOStreamData
+	25, // 29: proto.HostMonitor.DockerStream:input_type -> proto.DockerStreamMessage
+	17, // 30: proto.HostMonitor.ReportState:output_type -> proto.ReportStateResponse
+	10, // 31: proto.HostMonitor.RegisterAgent:output_type -> proto.RegisterAgentResponse
+	12, // 32: proto.HostMonitor.EnrollAgent:output_type -> proto.EnrollAgentResponse
+	14, // 33: proto.HostMonitor.DownloadAgentBinary:output_type -> proto.AgentBinaryChunk
+	24, // 34: proto.HostMonitor.ReportProbeResultBatch:output_type -> proto.ReportProbeResultBatchResponse
+	20, // 35: proto.HostMonitor.Heartbeat:output_type -> proto.HeartbeatResponse
+	21, // 36: proto.HostMonitor.IOStream:output_type -> proto.IOStreamData
+	25, // 37: proto.HostMonitor.DockerStream:output_type -> proto.DockerStreamMessage
+	30, // [30:38] is the sub-list for method output_type
+	22, // [22:30] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_proto_host_monitor_proto_init() }
@@ -2299,285 +2877,7 @@ func file_proto_host_monitor_proto_init() {
 		return
 	}
 	file_proto_service_probe_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_proto_host_monitor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Temperature); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterAgentRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterAgentResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportStateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportStateResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentTask); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartbeatRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartbeatResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IOStreamData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProbeResultItem); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportProbeResultBatchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportProbeResultBatchResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerStreamMessage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerStreamInit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerStreamData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerStreamResize); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerStreamClose); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_host_monitor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerStreamError); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	file_proto_host_monitor_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_proto_host_monitor_proto_msgTypes[25].OneofWrappers = []any{
 		(*DockerStreamMessage_Init)(nil),
 		(*DockerStreamMessage_Data)(nil),
 		(*DockerStreamMessage_Resize)(nil),
@@ -2588,9 +2888,9 @@ func file_proto_host_monitor_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_host_monitor_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_host_monitor_proto_rawDesc), len(file_proto_host_monitor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2599,7 +2899,6 @@ func file_proto_host_monitor_proto_init() {
 		MessageInfos:      file_proto_host_monitor_proto_msgTypes,
 	}.Build()
 	File_proto_host_monitor_proto = out.File
-	file_proto_host_monitor_proto_rawDesc = nil
 	file_proto_host_monitor_proto_goTypes = nil
 	file_proto_host_monitor_proto_depIdxs = nil
 }
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.19.4
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
 // source: proto/host_monitor.proto
 
 package proto
@@ -16,25 +16,42 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HostMonitor_ReportState_FullMethodName            = "/proto.HostMonitor/ReportState"
+	HostMonitor_RegisterAgent_FullMethodName          = "/proto.HostMonitor/RegisterAgent"
+	HostMonitor_EnrollAgent_FullMethodName            = "/proto.HostMonitor/EnrollAgent"
+	HostMonitor_DownloadAgentBinary_FullMethodName    = "/proto.HostMonitor/DownloadAgentBinary"
+	HostMonitor_ReportProbeResultBatch_FullMethodName = "/proto.HostMonitor/ReportProbeResultBatch"
+	HostMonitor_Heartbeat_FullMethodName              = "/proto.HostMonitor/Heartbeat"
+	HostMonitor_IOStream_FullMethodName               = "/proto.HostMonitor/IOStream"
+	HostMonitor_DockerStream_FullMethodName           = "/proto.HostMonitor/DockerStream"
+)
 
 // HostMonitorClient is the client API for HostMonitor service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HostMonitor 主机监控服务
 type HostMonitorClient interface {
 	// ReportState Agent上报监控数据(双向流)
-	ReportState(ctx context.Context, opts ...grpc.CallOption) (HostMonitor_ReportStateClient, error)
+	ReportState(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ReportStateRequest, ReportStateResponse], error)
 	// RegisterAgent Agent注册
 	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
+	// EnrollAgent Agent用密钥换取客户端证书(mTLS)
+	EnrollAgent(ctx context.Context, in *EnrollAgentRequest, opts ...grpc.CallOption) (*EnrollAgentResponse, error)
+	// DownloadAgentBinary Agent下载升级用的二进制(服务端流)
+	DownloadAgentBinary(ctx context.Context, in *DownloadAgentBinaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AgentBinaryChunk], error)
 	// ReportProbeResultBatch Agent批量上报探测结果
 	ReportProbeResultBatch(ctx context.Context, in *ReportProbeResultBatchRequest, opts ...grpc.CallOption) (*ReportProbeResultBatchResponse, error)
 	// Heartbeat 心跳保持
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	// IOStream PTY终端I/O流(双向流)
-	IOStream(ctx context.Context, opts ...grpc.CallOption) (HostMonitor_IOStreamClient, error)
+	IOStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[IOStreamData, IOStreamData], error)
 	// DockerStream Docker流式操作(双向流) - 用于容器终端、日志、统计等流式数据
-	DockerStream(ctx context.Context, opts ...grpc.CallOption) (HostMonitor_DockerStreamClient, error)
+	DockerStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DockerStreamMessage, DockerStreamMessage], error)
 }
 
 type hostMonitorClient struct {
@@ -45,49 +62,62 @@ func NewHostMonitorClient(cc grpc.ClientConnInterface) HostMonitorClient {
 	return &hostMonitorClient{cc}
 }
 
-func (c *hostMonitorClient) ReportState(ctx context.Context, opts ...grpc.CallOption) (HostMonitor_ReportStateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[0], "/proto.HostMonitor/ReportState", opts...)
+func (c *hostMonitorClient) ReportState(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ReportStateRequest, ReportStateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[0], HostMonitor_ReportState_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &hostMonitorReportStateClient{stream}
+	x := &grpc.GenericClientStream[ReportStateRequest, ReportStateResponse]{ClientStream: stream}
 	return x, nil
 }
 
-type HostMonitor_ReportStateClient interface {
-	Send(*ReportStateRequest) error
-	Recv() (*ReportStateResponse, error)
-	grpc.ClientStream
-}
-
-type hostMonitorReportStateClient struct {
-	grpc.ClientStream
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_ReportStateClient = grpc.BidiStreamingClient[ReportStateRequest, ReportStateResponse]
 
-func (x *hostMonitorReportStateClient) Send(m *ReportStateRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (c *hostMonitorClient) RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterAgentResponse)
+	err := c.cc.Invoke(ctx, HostMonitor_RegisterAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *hostMonitorReportStateClient) Recv() (*ReportStateResponse, error) {
-	m := new(ReportStateResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *hostMonitorClient) EnrollAgent(ctx context.Context, in *EnrollAgentRequest, opts ...grpc.CallOption) (*EnrollAgentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollAgentResponse)
+	err := c.cc.Invoke(ctx, HostMonitor_EnrollAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *hostMonitorClient) RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error) {
-	out := new(RegisterAgentResponse)
-	err := c.cc.Invoke(ctx, "/proto.HostMonitor/RegisterAgent", in, out, opts...)
+func (c *hostMonitorClient) DownloadAgentBinary(ctx context.Context, in *DownloadAgentBinaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AgentBinaryChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[1], HostMonitor_DownloadAgentBinary_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[DownloadAgentBinaryRequest, AgentBinaryChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_DownloadAgentBinaryClient = grpc.ServerStreamingClient[AgentBinaryChunk]
+
 func (c *hostMonitorClient) ReportProbeResultBatch(ctx context.Context, in *ReportProbeResultBatchRequest, opts ...grpc.CallOption) (*ReportProbeResultBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReportProbeResultBatchResponse)
-	err := c.cc.Invoke(ctx, "/proto.HostMonitor/ReportProbeResultBatch", in, out, opts...)
+	err := c.cc.Invoke(ctx, HostMonitor_ReportProbeResultBatch_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,118 +125,99 @@ func (c *hostMonitorClient) ReportProbeResultBatch(ctx context.Context, in *Repo
 }
 
 func (c *hostMonitorClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/proto.HostMonitor/Heartbeat", in, out, opts...)
+	err := c.cc.Invoke(ctx, HostMonitor_Heartbeat_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *hostMonitorClient) IOStream(ctx context.Context, opts ...grpc.CallOption) (HostMonitor_IOStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[1], "/proto.HostMonitor/IOStream", opts...)
+func (c *hostMonitorClient) IOStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[IOStreamData, IOStreamData], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[2], HostMonitor_IOStream_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &hostMonitorIOStreamClient{stream}
+	x := &grpc.GenericClientStream[IOStreamData, IOStreamData]{ClientStream: stream}
 	return x, nil
 }
 
-type HostMonitor_IOStreamClient interface {
-	Send(*IOStreamData) error
-	Recv() (*IOStreamData, error)
-	grpc.ClientStream
-}
-
-type hostMonitorIOStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *hostMonitorIOStreamClient) Send(m *IOStreamData) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *hostMonitorIOStreamClient) Recv() (*IOStreamData, error) {
-	m := new(IOStreamData)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_IOStreamClient = grpc.BidiStreamingClient[IOStreamData, IOStreamData]
 
-func (c *hostMonitorClient) DockerStream(ctx context.Context, opts ...grpc.CallOption) (HostMonitor_DockerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[2], "/proto.HostMonitor/DockerStream", opts...)
+func (c *hostMonitorClient) DockerStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DockerStreamMessage, DockerStreamMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HostMonitor_ServiceDesc.Streams[3], HostMonitor_DockerStream_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &hostMonitorDockerStreamClient{stream}
+	x := &grpc.GenericClientStream[DockerStreamMessage, DockerStreamMessage]{ClientStream: stream}
 	return x, nil
 }
 
-type HostMonitor_DockerStreamClient interface {
-	Send(*DockerStreamMessage) error
-	Recv() (*DockerStreamMessage, error)
-	grpc.ClientStream
-}
-
-type hostMonitorDockerStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *hostMonitorDockerStreamClient) Send(m *DockerStreamMessage) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *hostMonitorDockerStreamClient) Recv() (*DockerStreamMessage, error) {
-	m := new(DockerStreamMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_DockerStreamClient = grpc.BidiStreamingClient[DockerStreamMessage, DockerStreamMessage]
 
 // HostMonitorServer is the server API for HostMonitor service.
 // All implementations must embed UnimplementedHostMonitorServer
-// for forward compatibility
+// for forward compatibility.
+//
+// HostMonitor 主机监控服务
 type HostMonitorServer interface {
 	// ReportState Agent上报监控数据(双向流)
-	ReportState(HostMonitor_ReportStateServer) error
+	ReportState(grpc.BidiStreamingServer[ReportStateRequest, ReportStateResponse]) error
 	// RegisterAgent Agent注册
 	RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
+	// EnrollAgent Agent用密钥换取客户端证书(mTLS)
+	EnrollAgent(context.Context, *EnrollAgentRequest) (*EnrollAgentResponse, error)
+	// DownloadAgentBinary Agent下载升级用的二进制(服务端流)
+	DownloadAgentBinary(*DownloadAgentBinaryRequest, grpc.ServerStreamingServer[AgentBinaryChunk]) error
 	// ReportProbeResultBatch Agent批量上报探测结果
 	ReportProbeResultBatch(context.Context, *ReportProbeResultBatchRequest) (*ReportProbeResultBatchResponse, error)
 	// Heartbeat 心跳保持
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	// IOStream PTY终端I/O流(双向流)
-	IOStream(HostMonitor_IOStreamServer) error
+	IOStream(grpc.BidiStreamingServer[IOStreamData, IOStreamData]) error
 	// DockerStream Docker流式操作(双向流) - 用于容器终端、日志、统计等流式数据
-	DockerStream(HostMonitor_DockerStreamServer) error
+	DockerStream(grpc.BidiStreamingServer[DockerStreamMessage, DockerStreamMessage]) error
 	mustEmbedUnimplementedHostMonitorServer()
 }
 
-// UnimplementedHostMonitorServer must be embedded to have forward compatible implementations.
-type UnimplementedHostMonitorServer struct {
-}
+// UnimplementedHostMonitorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHostMonitorServer struct{}
 
-func (UnimplementedHostMonitorServer) ReportState(HostMonitor_ReportStateServer) error {
+func (UnimplementedHostMonitorServer) ReportState(grpc.BidiStreamingServer[ReportStateRequest, ReportStateResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ReportState not implemented")
 }
 func (UnimplementedHostMonitorServer) RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
 }
+func (UnimplementedHostMonitorServer) EnrollAgent(context.Context, *EnrollAgentRequest) (*EnrollAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollAgent not implemented")
+}
+func (UnimplementedHostMonitorServer) DownloadAgentBinary(*DownloadAgentBinaryRequest, grpc.ServerStreamingServer[AgentBinaryChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAgentBinary not implemented")
+}
 func (UnimplementedHostMonitorServer) ReportProbeResultBatch(context.Context, *ReportProbeResultBatchRequest) (*ReportProbeResultBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportProbeResultBatch not implemented")
 }
 func (UnimplementedHostMonitorServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
-func (UnimplementedHostMonitorServer) IOStream(HostMonitor_IOStreamServer) error {
+func (UnimplementedHostMonitorServer) IOStream(grpc.BidiStreamingServer[IOStreamData, IOStreamData]) error {
 	return status.Errorf(codes.Unimplemented, "method IOStream not implemented")
 }
-func (UnimplementedHostMonitorServer) DockerStream(HostMonitor_DockerStreamServer) error {
+func (UnimplementedHostMonitorServer) DockerStream(grpc.BidiStreamingServer[DockerStreamMessage, DockerStreamMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method DockerStream not implemented")
 }
 func (UnimplementedHostMonitorServer) mustEmbedUnimplementedHostMonitorServer() {}
+func (UnimplementedHostMonitorServer) testEmbeddedByValue()                     {}
 
 // UnsafeHostMonitorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HostMonitorServer will
@@ -216,34 +227,22 @@ type UnsafeHostMonitorServer interface {
 }
 
 func RegisterHostMonitorServer(s grpc.ServiceRegistrar, srv HostMonitorServer) {
+	// If the following call pancis, it indicates UnimplementedHostMonitorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&HostMonitor_ServiceDesc, srv)
 }
 
 func _HostMonitor_ReportState_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(HostMonitorServer).ReportState(&hostMonitorReportStateServer{stream})
+	return srv.(HostMonitorServer).ReportState(&grpc.GenericServerStream[ReportStateRequest, ReportStateResponse]{ServerStream: stream})
 }
 
-type HostMonitor_ReportStateServer interface {
-	Send(*ReportStateResponse) error
-	Recv() (*ReportStateRequest, error)
-	grpc.ServerStream
-}
-
-type hostMonitorReportStateServer struct {
-	grpc.ServerStream
-}
-
-func (x *hostMonitorReportStateServer) Send(m *ReportStateResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *hostMonitorReportStateServer) Recv() (*ReportStateRequest, error) {
-	m := new(ReportStateRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_ReportStateServer = grpc.BidiStreamingServer[ReportStateRequest, ReportStateResponse]
 
 func _HostMonitor_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterAgentRequest)
@@ -255,7 +254,7 @@ func _HostMonitor_RegisterAgent_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.HostMonitor/RegisterAgent",
+		FullMethod: HostMonitor_RegisterAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HostMonitorServer).RegisterAgent(ctx, req.(*RegisterAgentRequest))
@@ -263,6 +262,35 @@ func _HostMonitor_RegisterAgent_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostMonitor_EnrollAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostMonitorServer).EnrollAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostMonitor_EnrollAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostMonitorServer).EnrollAgent(ctx, req.(*EnrollAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostMonitor_DownloadAgentBinary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAgentBinaryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HostMonitorServer).DownloadAgentBinary(m, &grpc.GenericServerStream[DownloadAgentBinaryRequest, AgentBinaryChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_DownloadAgentBinaryServer = grpc.ServerStreamingServer[AgentBinaryChunk]
+
 func _HostMonitor_ReportProbeResultBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportProbeResultBatchRequest)
 	if err := dec(in); err != nil {
@@ -273,7 +301,7 @@ func _HostMonitor_ReportProbeResultBatch_Handler(srv interface{}, ctx context.Co
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.HostMonitor/ReportProbeResultBatch",
+		FullMethod: HostMonitor_ReportProbeResultBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HostMonitorServer).ReportProbeResultBatch(ctx, req.(*ReportProbeResultBatchRequest))
@@ -291,7 +319,7 @@ func _HostMonitor_Heartbeat_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.HostMonitor/Heartbeat",
+		FullMethod: HostMonitor_Heartbeat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HostMonitorServer).Heartbeat(ctx, req.(*HeartbeatRequest))
@@ -300,56 +328,18 @@ func _HostMonitor_Heartbeat_Handler(srv interface{}, ctx context.Context, dec fu
 }
 
 func _HostMonitor_IOStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(HostMonitorServer).IOStream(&hostMonitorIOStreamServer{stream})
+	return srv.(HostMonitorServer).IOStream(&grpc.GenericServerStream[IOStreamData, IOStreamData]{ServerStream: stream})
 }
 
-type HostMonitor_IOStreamServer interface {
-	Send(*IOStreamData) error
-	Recv() (*IOStreamData, error)
-	grpc.ServerStream
-}
-
-type hostMonitorIOStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *hostMonitorIOStreamServer) Send(m *IOStreamData) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *hostMonitorIOStreamServer) Recv() (*IOStreamData, error) {
-	m := new(IOStreamData)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_IOStreamServer = grpc.BidiStreamingServer[IOStreamData, IOStreamData]
 
 func _HostMonitor_DockerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(HostMonitorServer).DockerStream(&hostMonitorDockerStreamServer{stream})
+	return srv.(HostMonitorServer).DockerStream(&grpc.GenericServerStream[DockerStreamMessage, DockerStreamMessage]{ServerStream: stream})
 }
 
-type HostMonitor_DockerStreamServer interface {
-	Send(*DockerStreamMessage) error
-	Recv() (*DockerStreamMessage, error)
-	grpc.ServerStream
-}
-
-type hostMonitorDockerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *hostMonitorDockerStreamServer) Send(m *DockerStreamMessage) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *hostMonitorDockerStreamServer) Recv() (*DockerStreamMessage, error) {
-	m := new(DockerStreamMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HostMonitor_DockerStreamServer = grpc.BidiStreamingServer[DockerStreamMessage, DockerStreamMessage]
 
 // HostMonitor_ServiceDesc is the grpc.ServiceDesc for HostMonitor service.
 // It's only intended for direct use with grpc.RegisterService,
@@ -362,6 +352,10 @@ var HostMonitor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterAgent",
 			Handler:    _HostMonitor_RegisterAgent_Handler,
 		},
+		{
+			MethodName: "EnrollAgent",
+			Handler:    _HostMonitor_EnrollAgent_Handler,
+		},
 		{
 			MethodName: "ReportProbeResultBatch",
 			Handler:    _HostMonitor_ReportProbeResultBatch_Handler,
@@ -378,6 +372,11 @@ var HostMonitor_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DownloadAgentBinary",
+			Handler:       _HostMonitor_DownloadAgentBinary_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "IOStream",
 			Handler:       _HostMonitor_IOStream_Handler,
@@ -26,9 +26,13 @@ const (
 type ProbeType int32
 
 const (
-	ProbeType_HTTP ProbeType = 0 // HTTP/HTTPS探测
-	ProbeType_TCP  ProbeType = 1 // TCP端口探测
-	ProbeType_ICMP ProbeType = 2 // ICMP Ping探测
+	ProbeType_HTTP      ProbeType = 0 // HTTP/HTTPS探测
+	ProbeType_TCP       ProbeType = 1 // TCP端口探测
+	ProbeType_ICMP      ProbeType = 2 // ICMP Ping探测
+	ProbeType_DNS       ProbeType = 3 // DNS解析探测
+	ProbeType_GRPC      ProbeType = 4 // gRPC健康检查(grpc.health.v1)
+	ProbeType_TLS       ProbeType = 5 // TLS握手及证书探测
+	ProbeType_SYNTHETIC ProbeType = 6 // 多步骤HTTP事务探测
 )
 
 // Enum value maps for ProbeType.
@@ -37,11 +41,19 @@ var (
 		0: "HTTP",
 		1: "TCP",
 		2: "ICMP",
+		3: "DNS",
+		4: "GRPC",
+		5: "TLS",
+		6: "SYNTHETIC",
 	}
 	ProbeType_value = map[string]int32{
-		"HTTP": 0,
-		"TCP":  1,
-		"ICMP": 2,
+		"HTTP":      0,
+		"TCP":       1,
+		"ICMP":      2,
+		"DNS":       3,
+		"GRPC":      4,
+		"TLS":       5,
+		"SYNTHETIC": 6,
 	}
 )
 
@@ -86,11 +98,25 @@ type ProbeTask struct {
 	HttpBody     string            `protobuf:"bytes,12,opt,name=http_body,json=httpBody,proto3" json:"http_body,omitempty"`                                                                                    // HTTP请求体
 	ExpectStatus int32             `protobuf:"varint,13,opt,name=expect_status,json=expectStatus,proto3" json:"expect_status,omitempty"`                                                                       // 期望状态码
 	ExpectBody   string            `protobuf:"bytes,14,opt,name=expect_body,json=expectBody,proto3" json:"expect_body,omitempty"`                                                                              // 期望响应体(包含字符串)
+	JsonPath     string            `protobuf:"bytes,15,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`                                                                                    // 响应体JSONPath断言
+	JsonExpect   string            `protobuf:"bytes,16,opt,name=json_expect,json=jsonExpect,proto3" json:"json_expect,omitempty"`                                                                              // JSONPath期望值(为空时要求非null)
 	// TCP特定配置
-	TcpSend       string `protobuf:"bytes,20,opt,name=tcp_send,json=tcpSend,proto3" json:"tcp_send,omitempty"`       // TCP发送数据
-	TcpExpect     string `protobuf:"bytes,21,opt,name=tcp_expect,json=tcpExpect,proto3" json:"tcp_expect,omitempty"` // TCP期望接收数据
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TcpSend   string `protobuf:"bytes,20,opt,name=tcp_send,json=tcpSend,proto3" json:"tcp_send,omitempty"`       // TCP发送数据
+	TcpExpect string `protobuf:"bytes,21,opt,name=tcp_expect,json=tcpExpect,proto3" json:"tcp_expect,omitempty"` // TCP期望接收数据
+	// DNS特定配置
+	DnsRecordType string `protobuf:"bytes,30,opt,name=dns_record_type,json=dnsRecordType,proto3" json:"dns_record_type,omitempty"` // 记录类型(A/AAAA/CNAME/MX/NS/TXT/SRV/PTR)
+	DnsExpect     string `protobuf:"bytes,31,opt,name=dns_expect,json=dnsExpect,proto3" json:"dns_expect,omitempty"`               // 期望解析结果(逗号分隔)
+	DnsResolver   string `protobuf:"bytes,32,opt,name=dns_resolver,json=dnsResolver,proto3" json:"dns_resolver,omitempty"`         // 自定义DNS服务器(host[:port])
+	// gRPC特定配置
+	GrpcService string `protobuf:"bytes,40,opt,name=grpc_service,json=grpcService,proto3" json:"grpc_service,omitempty"` // 健康检查服务名
+	GrpcTls     bool   `protobuf:"varint,41,opt,name=grpc_tls,json=grpcTls,proto3" json:"grpc_tls,omitempty"`            // 是否使用TLS
+	// TLS特定配置
+	TlsServerName       string `protobuf:"bytes,50,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`                      // SNI/证书校验域名
+	TlsMinDaysRemaining int32  `protobuf:"varint,51,opt,name=tls_min_days_remaining,json=tlsMinDaysRemaining,proto3" json:"tls_min_days_remaining,omitempty"` // 证书最少剩余天数
+	// 多步骤事务特定配置
+	SyntheticSteps string `protobuf:"bytes,60,opt,name=synthetic_steps,json=syntheticSteps,proto3" json:"synthetic_steps,omitempty"` // 步骤定义(JSON数组)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProbeTask) Reset() {
@@ -193,6 +219,20 @@ func (x *ProbeTask) GetExpectBody() string {
 	return ""
 }
 
+func (x *ProbeTask) GetJsonPath() string {
+	if x != nil {
+		return x.JsonPath
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetJsonExpect() string {
+	if x != nil {
+		return x.JsonExpect
+	}
+	return ""
+}
+
 func (x *ProbeTask) GetTcpSend() string {
 	if x != nil {
 		return x.TcpSend
@@ -207,6 +247,62 @@ func (x *ProbeTask) GetTcpExpect() string {
 	return ""
 }
 
+func (x *ProbeTask) GetDnsRecordType() string {
+	if x != nil {
+		return x.DnsRecordType
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetDnsExpect() string {
+	if x != nil {
+		return x.DnsExpect
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetDnsResolver() string {
+	if x != nil {
+		return x.DnsResolver
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetGrpcService() string {
+	if x != nil {
+		return x.GrpcService
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetGrpcTls() bool {
+	if x != nil {
+		return x.GrpcTls
+	}
+	return false
+}
+
+func (x *ProbeTask) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *ProbeTask) GetTlsMinDaysRemaining() int32 {
+	if x != nil {
+		return x.TlsMinDaysRemaining
+	}
+	return 0
+}
+
+func (x *ProbeTask) GetSyntheticSteps() string {
+	if x != nil {
+		return x.SyntheticSteps
+	}
+	return ""
+}
+
 // 探测结果
 type ProbeResult struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
@@ -219,7 +315,16 @@ type ProbeResult struct {
 	HttpStatusCode   int32  `protobuf:"varint,10,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`      // HTTP状态码
 	HttpResponseBody string `protobuf:"bytes,11,opt,name=http_response_body,json=httpResponseBody,proto3" json:"http_response_body,omitempty"` // HTTP响应体(前1KB)
 	// TCP特定结果
-	TcpResponse   string `protobuf:"bytes,20,opt,name=tcp_response,json=tcpResponse,proto3" json:"tcp_response,omitempty"` // TCP响应数据
+	TcpResponse string `protobuf:"bytes,20,opt,name=tcp_response,json=tcpResponse,proto3" json:"tcp_response,omitempty"` // TCP响应数据
+	// DNS特定结果
+	DnsAnswers []string `protobuf:"bytes,30,rep,name=dns_answers,json=dnsAnswers,proto3" json:"dns_answers,omitempty"` // 解析结果
+	// gRPC特定结果
+	GrpcStatus string `protobuf:"bytes,40,opt,name=grpc_status,json=grpcStatus,proto3" json:"grpc_status,omitempty"` // 健康检查状态
+	// TLS特定结果
+	TlsNotAfter     int64 `protobuf:"varint,50,opt,name=tls_not_after,json=tlsNotAfter,proto3" json:"tls_not_after,omitempty"`               // 证书过期时间(Unix毫秒)
+	TlsDaysToExpiry int32 `protobuf:"varint,51,opt,name=tls_days_to_expiry,json=tlsDaysToExpiry,proto3" json:"tls_days_to_expiry,omitempty"` // 证书剩余天数
+	// 多步骤事务特定结果
+	Steps         []*ProbeStepResult `protobuf:"bytes,60,rep,name=steps,proto3" json:"steps,omitempty"` // 各步骤结果
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,6 +415,126 @@ func (x *ProbeResult) GetTcpResponse() string {
 	return ""
 }
 
+func (x *ProbeResult) GetDnsAnswers() []string {
+	if x != nil {
+		return x.DnsAnswers
+	}
+	return nil
+}
+
+func (x *ProbeResult) GetGrpcStatus() string {
+	if x != nil {
+		return x.GrpcStatus
+	}
+	return ""
+}
+
+func (x *ProbeResult) GetTlsNotAfter() int64 {
+	if x != nil {
+		return x.TlsNotAfter
+	}
+	return 0
+}
+
+func (x *ProbeResult) GetTlsDaysToExpiry() int32 {
+	if x != nil {
+		return x.TlsDaysToExpiry
+	}
+	return 0
+}
+
+func (x *ProbeResult) GetSteps() []*ProbeStepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// 多步骤事务单步结果
+type ProbeStepResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Index          int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                           // 步骤序号(从0开始)
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // 步骤名称
+	Success        bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                                       // 是否成功
+	Latency        int32                  `protobuf:"varint,4,opt,name=latency,proto3" json:"latency,omitempty"`                                       // 延迟(毫秒)
+	HttpStatusCode int32                  `protobuf:"varint,5,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"` // HTTP状态码
+	ErrorMessage   string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`          // 错误信息
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProbeStepResult) Reset() {
+	*x = ProbeStepResult{}
+	mi := &file_proto_service_probe_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeStepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeStepResult) ProtoMessage() {}
+
+func (x *ProbeStepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_probe_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeStepResult.ProtoReflect.Descriptor instead.
+func (*ProbeStepResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_probe_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProbeStepResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ProbeStepResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProbeStepResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProbeStepResult) GetLatency() int32 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *ProbeStepResult) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *ProbeStepResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 // 探测任务请求
 type ProbeTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -321,7 +546,7 @@ type ProbeTaskRequest struct {
 
 func (x *ProbeTaskRequest) Reset() {
 	*x = ProbeTaskRequest{}
-	mi := &file_proto_service_probe_proto_msgTypes[2]
+	mi := &file_proto_service_probe_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -333,7 +558,7 @@ func (x *ProbeTaskRequest) String() string {
 func (*ProbeTaskRequest) ProtoMessage() {}
 
 func (x *ProbeTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_probe_proto_msgTypes[2]
+	mi := &file_proto_service_probe_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +571,7 @@ func (x *ProbeTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeTaskRequest.ProtoReflect.Descriptor instead.
 func (*ProbeTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_probe_proto_rawDescGZIP(), []int{2}
+	return file_proto_service_probe_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ProbeTaskRequest) GetUuid() string {
@@ -375,7 +600,7 @@ type ProbeResultResponse struct {
 
 func (x *ProbeResultResponse) Reset() {
 	*x = ProbeResultResponse{}
-	mi := &file_proto_service_probe_proto_msgTypes[3]
+	mi := &file_proto_service_probe_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +612,7 @@ func (x *ProbeResultResponse) String() string {
 func (*ProbeResultResponse) ProtoMessage() {}
 
 func (x *ProbeResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_probe_proto_msgTypes[3]
+	mi := &file_proto_service_probe_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +625,7 @@ func (x *ProbeResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeResultResponse.ProtoReflect.Descriptor instead.
 func (*ProbeResultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_probe_proto_rawDescGZIP(), []int{3}
+	return file_proto_service_probe_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ProbeResultResponse) GetSuccess() bool {
@@ -428,7 +653,7 @@ var File_proto_service_probe_proto protoreflect.FileDescriptor
 
 const file_proto_service_probe_proto_rawDesc = "" +
 	"\n" +
-	"\x19proto/service_probe.proto\x12\x05proto\"\xdc\x03\n" +
+	"\x19proto/service_probe.proto\x12\x05proto\"\xc8\x06\n" +
 	"\tProbeTask\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12$\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x10.proto.ProbeTypeR\x04type\x12\x16\n" +
@@ -442,13 +667,25 @@ const file_proto_service_probe_proto_rawDesc = "" +
 	"\thttp_body\x18\f \x01(\tR\bhttpBody\x12#\n" +
 	"\rexpect_status\x18\r \x01(\x05R\fexpectStatus\x12\x1f\n" +
 	"\vexpect_body\x18\x0e \x01(\tR\n" +
-	"expectBody\x12\x19\n" +
+	"expectBody\x12\x1b\n" +
+	"\tjson_path\x18\x0f \x01(\tR\bjsonPath\x12\x1f\n" +
+	"\vjson_expect\x18\x10 \x01(\tR\n" +
+	"jsonExpect\x12\x19\n" +
 	"\btcp_send\x18\x14 \x01(\tR\atcpSend\x12\x1d\n" +
 	"\n" +
-	"tcp_expect\x18\x15 \x01(\tR\ttcpExpect\x1a>\n" +
+	"tcp_expect\x18\x15 \x01(\tR\ttcpExpect\x12&\n" +
+	"\x0fdns_record_type\x18\x1e \x01(\tR\rdnsRecordType\x12\x1d\n" +
+	"\n" +
+	"dns_expect\x18\x1f \x01(\tR\tdnsExpect\x12!\n" +
+	"\fdns_resolver\x18  \x01(\tR\vdnsResolver\x12!\n" +
+	"\fgrpc_service\x18( \x01(\tR\vgrpcService\x12\x19\n" +
+	"\bgrpc_tls\x18) \x01(\bR\agrpcTls\x12&\n" +
+	"\x0ftls_server_name\x182 \x01(\tR\rtlsServerName\x123\n" +
+	"\x16tls_min_days_remaining\x183 \x01(\x05R\x13tlsMinDaysRemaining\x12'\n" +
+	"\x0fsynthetic_steps\x18< \x01(\tR\x0esyntheticSteps\x1a>\n" +
 	"\x10HttpHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd9\x03\n" +
 	"\vProbeResult\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x18\n" +
@@ -458,18 +695,36 @@ const file_proto_service_probe_proto_rawDesc = "" +
 	"\x10http_status_code\x18\n" +
 	" \x01(\x05R\x0ehttpStatusCode\x12,\n" +
 	"\x12http_response_body\x18\v \x01(\tR\x10httpResponseBody\x12!\n" +
-	"\ftcp_response\x18\x14 \x01(\tR\vtcpResponse\"L\n" +
+	"\ftcp_response\x18\x14 \x01(\tR\vtcpResponse\x12\x1f\n" +
+	"\vdns_answers\x18\x1e \x03(\tR\n" +
+	"dnsAnswers\x12\x1f\n" +
+	"\vgrpc_status\x18( \x01(\tR\n" +
+	"grpcStatus\x12\"\n" +
+	"\rtls_not_after\x182 \x01(\x03R\vtlsNotAfter\x12+\n" +
+	"\x12tls_days_to_expiry\x183 \x01(\x05R\x0ftlsDaysToExpiry\x12,\n" +
+	"\x05steps\x18< \x03(\v2\x16.proto.ProbeStepResultR\x05steps\"\xbe\x01\n" +
+	"\x0fProbeStepResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\alatency\x18\x04 \x01(\x05R\alatency\x12(\n" +
+	"\x10http_status_code\x18\x05 \x01(\x05R\x0ehttpStatusCode\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"L\n" +
 	"\x10ProbeTaskRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12$\n" +
 	"\x04task\x18\x02 \x01(\v2\x10.proto.ProbeTaskR\x04task\"u\n" +
 	"\x13ProbeResultResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\x06result\x18\x03 \x01(\v2\x12.proto.ProbeResultR\x06result*(\n" +
+	"\x06result\x18\x03 \x01(\v2\x12.proto.ProbeResultR\x06result*S\n" +
 	"\tProbeType\x12\b\n" +
 	"\x04HTTP\x10\x00\x12\a\n" +
 	"\x03TCP\x10\x01\x12\b\n" +
-	"\x04ICMP\x10\x022W\n" +
+	"\x04ICMP\x10\x02\x12\a\n" +
+	"\x03DNS\x10\x03\x12\b\n" +
+	"\x04GRPC\x10\x04\x12\a\n" +
+	"\x03TLS\x10\x05\x12\r\n" +
+	"\tSYNTHETIC\x10\x062W\n" +
 	"\fServiceProbe\x12G\n" +
 	"\fExecuteProbe\x12\x17.proto.ProbeTaskRequest\x1a\x1a.proto.ProbeResultResponse(\x010\x01B%Z#github.com/ysicing/tiga/proto;protob\x06proto3"
 
@@ -486,27 +741,29 @@ func file_proto_service_probe_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_service_probe_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_service_probe_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_service_probe_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_service_probe_proto_goTypes = []any{
 	(ProbeType)(0),              // 0: proto.ProbeType
 	(*ProbeTask)(nil),           // 1: proto.ProbeTask
 	(*ProbeResult)(nil),         // 2: proto.ProbeResult
-	(*ProbeTaskRequest)(nil),    // 3: proto.ProbeTaskRequest
-	(*ProbeResultResponse)(nil), // 4: proto.ProbeResultResponse
-	nil,                         // 5: proto.ProbeTask.HttpHeadersEntry
+	(*ProbeStepResult)(nil),     // 3: proto.ProbeStepResult
+	(*ProbeTaskRequest)(nil),    // 4: proto.ProbeTaskRequest
+	(*ProbeResultResponse)(nil), // 5: proto.ProbeResultResponse
+	nil,                         // 6: proto.ProbeTask.HttpHeadersEntry
 }
 var file_proto_service_probe_proto_depIdxs = []int32{
 	0, // 0: proto.ProbeTask.type:type_name -> proto.ProbeType
-	5, // 1: proto.ProbeTask.http_headers:type_name -> proto.ProbeTask.HttpHeadersEntry
-	1, // 2: proto.ProbeTaskRequest.task:type_name -> proto.ProbeTask
-	2, // 3: proto.ProbeResultResponse.result:type_name -> proto.ProbeResult
-	3, // 4: proto.ServiceProbe.ExecuteProbe:input_type -> proto.ProbeTaskRequest
-	4, // 5: proto.ServiceProbe.ExecuteProbe:output_type -> proto.ProbeResultResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 1: proto.ProbeTask.http_headers:type_name -> proto.ProbeTask.HttpHeadersEntry
+	3, // 2: proto.ProbeResult.steps:type_name -> proto.ProbeStepResult
+	1, // 3: proto.ProbeTaskRequest.task:type_name -> proto.ProbeTask
+	2, // 4: proto.ProbeResultResponse.result:type_name -> proto.ProbeResult
+	4, // 5: proto.ServiceProbe.ExecuteProbe:input_type -> proto.ProbeTaskRequest
+	5, // 6: proto.ServiceProbe.ExecuteProbe:output_type -> proto.ProbeResultResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_service_probe_proto_init() }
@@ -520,7 +777,7 @@ func file_proto_service_probe_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_service_probe_proto_rawDesc), len(file_proto_service_probe_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
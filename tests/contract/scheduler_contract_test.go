@@ -121,6 +121,7 @@ func setupTestRouter(t *testing.T) (*gin.Engine, *gorm.DB, string) {
 		nil, // dockerStreamManager - not needed for scheduler tests
 		probeScheduler,
 		nil, // agentManager - not needed for scheduler tests
+		nil, // accessRequestService - not needed for scheduler tests
 		cfg,
 	)
 
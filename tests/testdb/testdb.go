@@ -0,0 +1,30 @@
+// Package testdb opens throwaway databases for unit tests.
+package testdb
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// Open opens an in-memory SQLite database and migrates the given models.
+//
+// SQLite :memory: databases are per-connection, so the pool is limited to a
+// single connection to let every goroutine of the test share the database.
+func Open(t testing.TB, models ...interface{}) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "Failed to open test database")
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err, "Failed to get database connection")
+	sqlDB.SetMaxOpenConns(1)
+
+	if len(models) > 0 {
+		require.NoError(t, db.AutoMigrate(models...), "Failed to migrate test database")
+	}
+	return db
+}